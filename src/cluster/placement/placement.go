@@ -429,9 +429,10 @@ func NewInstanceFromProto(instance *placementpb.Instance) (Instance, error) {
 	if err != nil {
 		return nil, err
 	}
-	debugPort := uint32(0)
+	var debugPort, grpcPort uint32
 	if instance.Metadata != nil {
 		debugPort = instance.Metadata.DebugPort
+		grpcPort = instance.Metadata.GrpcPort
 	}
 
 	return NewInstance().
@@ -446,6 +447,7 @@ func NewInstanceFromProto(instance *placementpb.Instance) (Instance, error) {
 		SetPort(instance.Port).
 		SetMetadata(InstanceMetadata{
 			DebugPort: debugPort,
+			GRPCPort:  grpcPort,
 		}), nil
 }
 
@@ -577,6 +579,7 @@ func (i *instance) Proto() (*placementpb.Instance, error) {
 		Port:           i.Port(),
 		Metadata: &placementpb.InstanceMetadata{
 			DebugPort: i.Metadata().DebugPort,
+			GrpcPort:  i.Metadata().GRPCPort,
 		},
 	}, nil
 }
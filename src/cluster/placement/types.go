@@ -117,6 +117,7 @@ type Instance interface {
 // InstanceMetadata represents the metadata for a single Instance in the placement.
 type InstanceMetadata struct {
 	DebugPort uint32
+	GRPCPort  uint32
 }
 
 // Placement describes how instances are placed.
@@ -758,6 +758,8 @@ func NewServiceInstanceFromProto(
 		SetServiceID(sid).
 		SetInstanceID(instance.Id).
 		SetEndpoint(instance.Endpoint).
+		SetIsolationGroup(instance.IsolationGroup).
+		SetGRPCPort(instance.GetMetadata().GetGrpcPort()).
 		SetShards(shards), nil
 }
 
@@ -770,24 +772,40 @@ func NewServiceInstanceFromPlacementInstance(
 		SetServiceID(sid).
 		SetInstanceID(instance.ID()).
 		SetEndpoint(instance.Endpoint()).
+		SetIsolationGroup(instance.IsolationGroup()).
+		SetGRPCPort(instance.Metadata().GRPCPort).
 		SetShards(instance.Shards())
 }
 
 type serviceInstance struct {
-	service  ServiceID
-	id       string
-	endpoint string
-	shards   shard.Shards
+	service        ServiceID
+	id             string
+	endpoint       string
+	isolationGroup string
+	grpcPort       uint32
+	shards         shard.Shards
 }
 
 func (i *serviceInstance) InstanceID() string                       { return i.id }
 func (i *serviceInstance) Endpoint() string                         { return i.endpoint }
+func (i *serviceInstance) IsolationGroup() string                   { return i.isolationGroup }
+func (i *serviceInstance) GRPCPort() uint32                         { return i.grpcPort }
 func (i *serviceInstance) Shards() shard.Shards                     { return i.shards }
 func (i *serviceInstance) ServiceID() ServiceID                     { return i.service }
 func (i *serviceInstance) SetInstanceID(id string) ServiceInstance  { i.id = id; return i }
 func (i *serviceInstance) SetEndpoint(e string) ServiceInstance     { i.endpoint = e; return i }
 func (i *serviceInstance) SetShards(s shard.Shards) ServiceInstance { i.shards = s; return i }
 
+func (i *serviceInstance) SetIsolationGroup(g string) ServiceInstance {
+	i.isolationGroup = g
+	return i
+}
+
+func (i *serviceInstance) SetGRPCPort(p uint32) ServiceInstance {
+	i.grpcPort = p
+	return i
+}
+
 func (i *serviceInstance) SetServiceID(service ServiceID) ServiceInstance {
 	i.service = service
 	return i
@@ -964,6 +964,62 @@ func (mr *MockServiceInstanceMockRecorder) SetEndpoint(e interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEndpoint", reflect.TypeOf((*MockServiceInstance)(nil).SetEndpoint), e)
 }
 
+// IsolationGroup mocks base method
+func (m *MockServiceInstance) IsolationGroup() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsolationGroup")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// IsolationGroup indicates an expected call of IsolationGroup
+func (mr *MockServiceInstanceMockRecorder) IsolationGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsolationGroup", reflect.TypeOf((*MockServiceInstance)(nil).IsolationGroup))
+}
+
+// SetIsolationGroup mocks base method
+func (m *MockServiceInstance) SetIsolationGroup(g string) ServiceInstance {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIsolationGroup", g)
+	ret0, _ := ret[0].(ServiceInstance)
+	return ret0
+}
+
+// SetIsolationGroup indicates an expected call of SetIsolationGroup
+func (mr *MockServiceInstanceMockRecorder) SetIsolationGroup(g interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIsolationGroup", reflect.TypeOf((*MockServiceInstance)(nil).SetIsolationGroup), g)
+}
+
+// GRPCPort mocks base method
+func (m *MockServiceInstance) GRPCPort() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCPort")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GRPCPort indicates an expected call of GRPCPort
+func (mr *MockServiceInstanceMockRecorder) GRPCPort() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCPort", reflect.TypeOf((*MockServiceInstance)(nil).GRPCPort))
+}
+
+// SetGRPCPort mocks base method
+func (m *MockServiceInstance) SetGRPCPort(p uint32) ServiceInstance {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGRPCPort", p)
+	ret0, _ := ret[0].(ServiceInstance)
+	return ret0
+}
+
+// SetGRPCPort indicates an expected call of SetGRPCPort
+func (mr *MockServiceInstanceMockRecorder) SetGRPCPort(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCPort", reflect.TypeOf((*MockServiceInstance)(nil).SetGRPCPort), p)
+}
+
 // Shards mocks base method
 func (m *MockServiceInstance) Shards() shard.Shards {
 	m.ctrl.T.Helper()
@@ -57,6 +57,7 @@ func TestConvertBetweenProtoAndService(t *testing.T) {
 				Endpoint:       "e1",
 				Weight:         1,
 				Shards:         protoShards,
+				Metadata:       &placementpb.InstanceMetadata{GrpcPort: 9005},
 			},
 			"i2": &placementpb.Instance{
 				Id:             "i2",
@@ -82,6 +83,8 @@ func TestConvertBetweenProtoAndService(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "i1", i1.InstanceID())
 	assert.Equal(t, "e1", i1.Endpoint())
+	assert.Equal(t, "r1", i1.IsolationGroup())
+	assert.Equal(t, uint32(9005), i1.GRPCPort())
 	assert.Equal(t, 3, i1.Shards().NumShards())
 	assert.Equal(t, sid, i1.ServiceID())
 	assert.True(t, i1.Shards().Contains(0))
@@ -92,6 +95,8 @@ func TestConvertBetweenProtoAndService(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "i2", i2.InstanceID())
 	assert.Equal(t, "e2", i2.Endpoint())
+	assert.Equal(t, "r2", i2.IsolationGroup())
+	assert.Equal(t, uint32(0), i2.GRPCPort())
 	assert.Equal(t, 3, i2.Shards().NumShards())
 	assert.Equal(t, sid, i2.ServiceID())
 	assert.True(t, i2.Shards().Contains(0))
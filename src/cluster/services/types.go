@@ -224,6 +224,19 @@ type ServiceInstance interface {
 	// SetEndpoint sets the endpoint of the instance.
 	SetEndpoint(e string) ServiceInstance
 
+	// IsolationGroup returns the isolation group of the instance.
+	IsolationGroup() string
+
+	// SetIsolationGroup sets the isolation group of the instance.
+	SetIsolationGroup(g string) ServiceInstance
+
+	// GRPCPort returns the port the instance serves gRPC on, or zero if
+	// unknown.
+	GRPCPort() uint32
+
+	// SetGRPCPort sets the port the instance serves gRPC on.
+	SetGRPCPort(p uint32) ServiceInstance
+
 	// Shards returns the shards of the instance.
 	Shards() shard.Shards
 
@@ -23,9 +23,11 @@ package config
 import "github.com/m3db/m3/src/dbnode/storage/series"
 
 var (
-	defaultPostingsListCacheSize   = 2 << 11 // 4096
-	defaultPostingsListCacheRegexp = true
-	defaultPostingsListCacheTerms  = true
+	defaultPostingsListCacheSize           = 2 << 11 // 4096
+	defaultPostingsListCacheRegexp         = true
+	defaultPostingsListCacheTerms          = true
+	defaultPostingsListCacheSearches       = true
+	defaultPostingsListCacheHotQueriesSize = 256
 )
 
 // CacheConfigurations is the cache configurations.
@@ -72,9 +74,11 @@ type LRUSeriesCachePolicyConfiguration struct {
 
 // PostingsListCacheConfiguration is the postings list cache configuration.
 type PostingsListCacheConfiguration struct {
-	Size        *int  `yaml:"size"`
-	CacheRegexp *bool `yaml:"cacheRegexp"`
-	CacheTerms  *bool `yaml:"cacheTerms"`
+	Size           *int  `yaml:"size"`
+	CacheRegexp    *bool `yaml:"cacheRegexp"`
+	CacheTerms     *bool `yaml:"cacheTerms"`
+	CacheSearches  *bool `yaml:"cacheSearches"`
+	HotQueriesSize *int  `yaml:"hotQueriesSize"`
 }
 
 // SizeOrDefault returns the provided size or the default value is none is
@@ -106,3 +110,23 @@ func (p *PostingsListCacheConfiguration) CacheTermsOrDefault() bool {
 
 	return *p.CacheTerms
 }
+
+// CacheSearchesOrDefault returns the provided cache searches configuration
+// value or the default value is none is provided.
+func (p *PostingsListCacheConfiguration) CacheSearchesOrDefault() bool {
+	if p.CacheSearches == nil {
+		return defaultPostingsListCacheSearches
+	}
+
+	return *p.CacheSearches
+}
+
+// HotQueriesSizeOrDefault returns the provided hot queries size or the
+// default value is none is provided, zero disables warming the cache.
+func (p *PostingsListCacheConfiguration) HotQueriesSizeOrDefault() int {
+	if p.HotQueriesSize == nil {
+		return defaultPostingsListCacheHotQueriesSize
+	}
+
+	return *p.HotQueriesSize
+}
@@ -22,8 +22,11 @@ package config
 
 import (
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"path"
 	"strings"
@@ -32,6 +35,8 @@ import (
 	coordinatorcfg "github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/environment"
+	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/storage/coldcompaction"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/x/config/hostid"
 	"github.com/m3db/m3/src/x/instrument"
@@ -41,6 +46,8 @@ import (
 	"go.etcd.io/etcd/embed"
 	"go.etcd.io/etcd/pkg/transport"
 	"go.etcd.io/etcd/pkg/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -95,6 +102,13 @@ type DBConfiguration struct {
 	// The host and port on which to listen for debug endpoints.
 	DebugListenAddress string `yaml:"debugListenAddress"`
 
+	// The host and port on which to listen for the node service over gRPC,
+	// the gRPC node service is not started when unset.
+	GRPCListenAddress string `yaml:"grpcListenAddress"`
+
+	// GRPC exposes gRPC config options for the node service.
+	GRPC *GRPCConfiguration `yaml:"grpc"`
+
 	// HostID is the local host ID configuration.
 	HostID hostid.Configuration `yaml:"hostID"`
 
@@ -115,6 +129,10 @@ type DBConfiguration struct {
 	// The tick configuration, omit this to use default settings.
 	Tick *TickConfiguration `yaml:"tick"`
 
+	// The cold flush planner configuration, omit this to merge every block
+	// with cold writes into a new volume on each cold flush.
+	ColdFlushPlanner *ColdFlushPlannerConfiguration `yaml:"coldFlushPlanner"`
+
 	// Bootstrap configuration.
 	Bootstrap BootstrapConfiguration `yaml:"bootstrap"`
 
@@ -217,6 +235,11 @@ type IndexConfiguration struct {
 	// block boundaries by eagerly writing the series to the next block
 	// preemptively.
 	ForwardIndexThreshold float64 `yaml:"forwardIndexThreshold" validate:"min=0.0,max=1.0"`
+
+	// CardinalityTrackingEnabled enables tracking the approximate number of
+	// series per tag name and tag value of each index block, which can be
+	// reported with the cardinality endpoint.
+	CardinalityTrackingEnabled bool `yaml:"cardinalityTrackingEnabled"`
 }
 
 // TransformConfiguration contains configuration options that can transform
@@ -256,6 +279,42 @@ type TickConfiguration struct {
 	MinimumInterval time.Duration `yaml:"minimumInterval"`
 }
 
+// ColdFlushPlannerConfiguration is the configuration for deciding which
+// blocks with cold writes are merged into new volumes on a cold flush.
+type ColdFlushPlannerConfiguration struct {
+	// MaxPendingAge is the maximum amount of time cold writes for a block
+	// are deferred for before being merged regardless of other thresholds.
+	MaxPendingAge time.Duration `yaml:"maxPendingAge"`
+
+	// MinDirtySeries is the minimum number of dirty series a block requires
+	// to be merged before reaching the max pending age.
+	MinDirtySeries int `yaml:"minDirtySeries"`
+
+	// MaxWriteAmplification is the maximum number of bytes of the latest
+	// volume rewritten per dirty series for a block to be merged before
+	// reaching the max pending age.
+	MaxWriteAmplification float64 `yaml:"maxWriteAmplification"`
+
+	// SmallVolumeSize is the volume size in bytes below which blocks are
+	// always merged.
+	SmallVolumeSize int64 `yaml:"smallVolumeSize"`
+
+	// MaxBlocksPerFlush bounds the number of new volumes created per shard
+	// by a single cold flush.
+	MaxBlocksPerFlush int `yaml:"maxBlocksPerFlush"`
+}
+
+// PlannerOptions returns the cold flush planner options.
+func (c ColdFlushPlannerConfiguration) PlannerOptions() coldcompaction.PlannerOptions {
+	return coldcompaction.PlannerOptions{
+		MaxPendingAge:         c.MaxPendingAge,
+		MinDirtySeries:        c.MinDirtySeries,
+		MaxWriteAmplification: c.MaxWriteAmplification,
+		SmallVolumeSize:       c.SmallVolumeSize,
+		MaxBlocksPerFlush:     c.MaxBlocksPerFlush,
+	}
+}
+
 // BlockRetrievePolicy is the block retrieve policy.
 type BlockRetrievePolicy struct {
 	// FetchConcurrency is the concurrency to fetch blocks from disk. For
@@ -289,6 +348,11 @@ type CommitLogPolicy struct {
 	// enough for almost all workloads assuming a reasonable batch size is used.
 	QueueChannel *CommitLogQueuePolicy `yaml:"queueChannel"`
 
+	// Compression is the compression applied to commit log chunks, one of
+	// "none" or "snappy". Defaults to none which keeps commit log files
+	// readable by older versions.
+	Compression *commitlog.CompressionType `yaml:"compression"`
+
 	// Deprecated. Left in struct to keep old YAMLs parseable.
 	// TODO(V1): remove
 	DeprecatedBlockSize *time.Duration `yaml:"blockSize"`
@@ -356,11 +420,13 @@ func (r *ReplicationPolicy) Validate() error {
 	return nil
 }
 
-// ReplicatedCluster defines a cluster to replicate data from.
+// ReplicatedCluster defines a cluster to replicate data from, or to
+// asynchronously replicate namespaces to.
 type ReplicatedCluster struct {
-	Name          string                `yaml:"name"`
-	RepairEnabled bool                  `yaml:"repairEnabled"`
-	Client        *client.Configuration `yaml:"client"`
+	Name             string                  `yaml:"name"`
+	RepairEnabled    bool                    `yaml:"repairEnabled"`
+	Client           *client.Configuration   `yaml:"client"`
+	AsyncReplication *AsyncReplicationPolicy `yaml:"asyncReplication"`
 }
 
 // Validate validates the configuration for a replicated cluster.
@@ -374,9 +440,40 @@ func (r *ReplicatedCluster) Validate() error {
 			"replicated cluster: %s has repair enabled but not client configuration", r.Name)
 	}
 
+	if r.AsyncReplication != nil {
+		if r.Client == nil {
+			return fmt.Errorf(
+				"replicated cluster: %s has async replication but not client configuration", r.Name)
+		}
+		if r.Client.EnvironmentConfig == nil {
+			return fmt.Errorf(
+				"replicated cluster: %s has async replication but not client environment configuration", r.Name)
+		}
+	}
+
 	return nil
 }
 
+// AsyncReplicationPolicy is the policy for asynchronously replicating the
+// flushed filesets and rotated commit logs of namespaces to a cluster, such
+// as for disaster recovery between regions.
+type AsyncReplicationPolicy struct {
+	// Namespaces are the namespaces to replicate, in addition to any
+	// namespaces whose options name this cluster as a replication cluster.
+	Namespaces []string `yaml:"namespaces"`
+
+	// Interval is the interval between replicating newly flushed filesets
+	// and rotated commit logs.
+	Interval *time.Duration `yaml:"interval"`
+
+	// BatchSize is the number of datapoints written to the cluster before
+	// progress is checkpointed.
+	BatchSize *int `yaml:"batchSize"`
+
+	// WriteConcurrency is the number of concurrent writes to the cluster.
+	WriteConcurrency *int `yaml:"writeConcurrency"`
+}
+
 // HashingConfiguration is the configuration for hashing.
 type HashingConfiguration struct {
 	// Murmur32 seed value.
@@ -582,3 +679,71 @@ type TChannelConfiguration struct {
 	MaxIdleTime       time.Duration `yaml:"maxIdleTime"`
 	IdleCheckInterval time.Duration `yaml:"idleCheckInterval"`
 }
+
+// GRPCConfiguration holds gRPC config options for the node service.
+type GRPCConfiguration struct {
+	// MaxMsgSize is the maximum size of messages received and sent.
+	MaxMsgSize *int `yaml:"maxMsgSize"`
+
+	// TLS is the TLS configuration, the service is insecure when unset.
+	TLS *GRPCTLSConfiguration `yaml:"tls"`
+}
+
+// ServerOptions returns the gRPC server options for the configuration.
+func (c GRPCConfiguration) ServerOptions() ([]grpc.ServerOption, error) {
+	var opts []grpc.ServerOption
+	if c.MaxMsgSize != nil {
+		if *c.MaxMsgSize <= 0 {
+			return nil, fmt.Errorf("grpc max message size was: %d but must be >0", *c.MaxMsgSize)
+		}
+		opts = append(opts,
+			grpc.MaxRecvMsgSize(*c.MaxMsgSize),
+			grpc.MaxSendMsgSize(*c.MaxMsgSize))
+	}
+	if c.TLS != nil {
+		creds, err := c.TLS.Credentials()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	return opts, nil
+}
+
+// GRPCTLSConfiguration is the TLS configuration of the gRPC node service.
+type GRPCTLSConfiguration struct {
+	// CrtPath is the path to the server certificate.
+	CrtPath string `yaml:"crt" validate:"nonzero"`
+
+	// KeyPath is the path to the server key.
+	KeyPath string `yaml:"key" validate:"nonzero"`
+
+	// ClientCACrtPath is the path to the CA certificate used to verify
+	// clients, client certificates are not required when unset.
+	ClientCACrtPath string `yaml:"clientCACrt"`
+}
+
+// Credentials returns the transport credentials for the TLS configuration.
+func (c GRPCTLSConfiguration) Credentials() (credentials.TransportCredentials, error) {
+	certificate, err := tls.LoadX509KeyPair(c.CrtPath, c.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+	}
+	if c.ClientCACrtPath != "" {
+		caCrt, err := ioutil.ReadFile(c.ClientCACrtPath)
+		if err != nil {
+			return nil, err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCrt) {
+			return nil, fmt.Errorf("unable to append CA certificate: %s", c.ClientCACrtPath)
+		}
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		tlsConfig.ClientCAs = certPool
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
@@ -345,6 +345,7 @@ func TestConfiguration(t *testing.T) {
     maxQueryIDsConcurrency: 0
     forwardIndexProbability: 0
     forwardIndexThreshold: 0
+    cardinalityTrackingEnabled: false
   transforms:
     truncateBy: 0
     forceValue: null
@@ -370,6 +371,8 @@ func TestConfiguration(t *testing.T) {
   httpNodeListenAddress: 0.0.0.0:9002
   httpClusterListenAddress: 0.0.0.0:9003
   debugListenAddress: 0.0.0.0:9004
+  grpcListenAddress: ""
+  grpc: null
   hostID:
     resolver: config
     value: host1
@@ -408,10 +411,18 @@ func TestConfiguration(t *testing.T) {
     asyncWriteMaxConcurrency: null
     useV2BatchAPIs: null
     writeTimestampOffset: null
+    readRepair: null
+    hintedHandoff: null
+    transport: null
+    adaptiveWriteBatch: null
+    hostQueueSaturationThreshold: null
+    hedgedReads: null
+    readPreference: null
   gcPercentage: 100
   writeNewSeriesLimitPerSecond: 1048576
   writeNewSeriesBackoffDuration: 2ms
   tick: null
+  coldFlushPlanner: null
   bootstrap:
     bootstrappers:
     - filesystem
@@ -429,6 +440,8 @@ func TestConfiguration(t *testing.T) {
       size: 100
       cacheRegexp: false
       cacheTerms: false
+      cacheSearches: null
+      hotQueriesSize: null
   fs:
     filePathPrefix: /var/lib/m3db
     writeBufferSize: 65536
@@ -443,6 +456,8 @@ func TestConfiguration(t *testing.T) {
     force_index_summaries_mmap_memory: true
     force_bloom_filter_mmap_memory: true
     bloomFilterFalsePositivePercent: null
+    remote: null
+    rewrite: null
   commitlog:
     flushMaxBytes: 524288
     flushEvery: 1s
@@ -450,6 +465,7 @@ func TestConfiguration(t *testing.T) {
       calculationType: fixed
       size: 2097152
     queueChannel: null
+    compression: null
     blockSize: null
   repair:
     enabled: false
@@ -520,6 +536,10 @@ func TestConfiguration(t *testing.T) {
       size: 25165824
       lowWatermark: 0.01
       highWatermark: 0.02
+    histogramEncoderPool:
+      size: null
+      lowWatermark: null
+      highWatermark: null
     iteratorPool:
       size: 2048
       lowWatermark: 0.01
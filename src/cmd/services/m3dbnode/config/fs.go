@@ -23,6 +23,9 @@ package config
 import (
 	"fmt"
 	"os"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/remote"
 )
 
 const (
@@ -41,6 +44,7 @@ const (
 	defaultForceIndexSummariesMmapMemory   = false
 	defaultForceBloomFilterMmapMemory      = false
 	defaultBloomFilterFalsePositivePercent = 0.02
+	defaultRewriteMaxVolumesPerShardFlush  = 1
 )
 
 // DefaultMmapConfiguration is the default mmap configuration.
@@ -98,6 +102,41 @@ type FilesystemConfiguration struct {
 	// BloomFilterFalsePositivePercent controls the target false positive percentage
 	// for the bloom filters for the fileset files.
 	BloomFilterFalsePositivePercent *float64 `yaml:"bloomFilterFalsePositivePercent"`
+
+	// Remote configures offloading of cold data filesets to a remote object store.
+	Remote *remote.Configuration `yaml:"remote"`
+
+	// Rewrite enables rewriting flushed filesets in the background.
+	Rewrite *FileSetRewriteConfiguration `yaml:"rewrite"`
+}
+
+// FileSetRewriteConfiguration is the configuration for rewriting flushed
+// filesets in the background, re-encoding them with the current encoding
+// options. Dropping series is only supported by the offline
+// rewrite_filesets tool since background rewrites only change the filesets
+// on disk and not the series held in memory or the reverse index.
+type FileSetRewriteConfiguration struct {
+	// Enabled enables background fileset rewrites.
+	Enabled bool `yaml:"enabled"`
+
+	// MaxVolumesPerShardFlush limits how many volumes each shard rewrites
+	// per flush, zero means unlimited.
+	MaxVolumesPerShardFlush *int `yaml:"maxVolumesPerShardFlush"`
+}
+
+// RewriteOptions returns the fileset rewrite options.
+func (c FileSetRewriteConfiguration) RewriteOptions() (fs.RewriteOptions, error) {
+	opts := fs.RewriteOptions{
+		MaxVolumesPerShardFlush: defaultRewriteMaxVolumesPerShardFlush,
+	}
+	if v := c.MaxVolumesPerShardFlush; v != nil {
+		if *v < 0 {
+			return opts, fmt.Errorf(
+				"fs rewrite maxVolumesPerShardFlush is set to: %d, but must be at least 0", *v)
+		}
+		opts.MaxVolumesPerShardFlush = *v
+	}
+	return opts, nil
 }
 
 // Validate validates the Filesystem configuration. We use this method to validate
@@ -138,6 +177,12 @@ func (f FilesystemConfiguration) Validate() error {
 			"fs throughputCheckEvery is set to: %d, but must be at least 1",
 			*f.ThroughputCheckEvery)
 	}
+	if f.Rewrite != nil {
+		if _, err := f.Rewrite.RewriteOptions(); err != nil {
+			return err
+		}
+	}
+
 	if f.BloomFilterFalsePositivePercent != nil &&
 		(*f.BloomFilterFalsePositivePercent < 0 || *f.BloomFilterFalsePositivePercent > 1) {
 		return fmt.Errorf(
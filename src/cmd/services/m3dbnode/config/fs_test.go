@@ -47,3 +47,22 @@ func TestFilesystemConfigurationParseNewDirectoryMode(t *testing.T) {
 
 	assert.Equal(t, os.FileMode(0775)|os.ModeDir, v)
 }
+
+func TestFileSetRewriteConfigurationMaxVolumesPerShardFlush(t *testing.T) {
+	opts, err := FileSetRewriteConfiguration{}.RewriteOptions()
+	require.NoError(t, err)
+	assert.Equal(t, defaultRewriteMaxVolumesPerShardFlush, opts.MaxVolumesPerShardFlush)
+
+	unlimited := 0
+	opts, err = FileSetRewriteConfiguration{
+		MaxVolumesPerShardFlush: &unlimited,
+	}.RewriteOptions()
+	require.NoError(t, err)
+	assert.Equal(t, 0, opts.MaxVolumesPerShardFlush)
+
+	invalid := -1
+	_, err = FileSetRewriteConfiguration{
+		MaxVolumesPerShardFlush: &invalid,
+	}.RewriteOptions()
+	require.Error(t, err)
+}
@@ -109,6 +109,13 @@ var (
 			refillLowWaterMark:  defaultRefillLowWaterMark,
 			refillHighWaterMark: defaultRefillHighWaterMark,
 		},
+		"histogramEncoder": poolPolicyDefault{
+			// NB: Only series of namespaces with histograms enabled use
+			// histogram encoders so keep this small by default.
+			size:                4096,
+			refillLowWaterMark:  defaultRefillLowWaterMark,
+			refillHighWaterMark: defaultRefillHighWaterMark,
+		},
 		"closers": poolPolicyDefault{
 			// NB(r): Note this has to be bigger than context pool by
 			// big fraction (by factor of say 4) since each context
@@ -309,6 +316,9 @@ type PoolingPolicy struct {
 	// The policy for the Encoder pool.
 	EncoderPool PoolPolicy `yaml:"encoderPool"`
 
+	// The policy for the histogram Encoder pool.
+	HistogramEncoderPool PoolPolicy `yaml:"histogramEncoderPool"`
+
 	// The policy for the Iterator pool.
 	IteratorPool PoolPolicy `yaml:"iteratorPool"`
 
@@ -390,6 +400,9 @@ func (p *PoolingPolicy) InitDefaultsAndValidate() error {
 	if err := p.EncoderPool.initDefaultsAndValidate("encoder"); err != nil {
 		return err
 	}
+	if err := p.HistogramEncoderPool.initDefaultsAndValidate("histogramEncoder"); err != nil {
+		return err
+	}
 	if err := p.IteratorPool.initDefaultsAndValidate("iterator"); err != nil {
 		return err
 	}
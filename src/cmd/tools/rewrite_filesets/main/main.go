@@ -0,0 +1,264 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/tools"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/pool"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/pborman/getopt"
+	"go.uber.org/zap"
+)
+
+const allShards = -1
+
+func main() {
+	var (
+		optPathPrefix       = getopt.StringLong("path-prefix", 'p', "", "Path prefix [e.g. /var/lib/m3db]")
+		optNamespace        = getopt.StringLong("namespace", 'n', "", "Namespace [e.g. metrics]")
+		optShard            = getopt.IntLong("shard", 's', allShards, "Shard [expected format uint32], defaults to all shards")
+		optBlockSize        = getopt.DurationLong("block-size", 'b', 0, "Block size of the source filesets [e.g. 2h]")
+		optDestPathPrefix   = getopt.StringLong("dest-path-prefix", 'P', "", "Destination path prefix, defaults to the path prefix")
+		optDestNamespace    = getopt.StringLong("dest-namespace", 'N', "", "Destination namespace, defaults to the namespace")
+		optDestBlockSize    = getopt.DurationLong("dest-block-size", 'B', 0, "Destination block size, defaults to the block size")
+		optDropSeriesRegexp = getopt.StringLong("drop-series-regexp", 'd', "", "Drop series with IDs matching the regexp (optional)")
+		optIntOptimization  = getopt.BoolLong("int-optimization", 'i', "Re-encode with m3tsz int optimization enabled")
+	)
+	getopt.Parse()
+
+	rawLogger, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatalf("unable to create logger: %+v", err)
+	}
+	log := rawLogger.Sugar()
+
+	if *optPathPrefix == "" ||
+		*optNamespace == "" ||
+		*optShard < allShards ||
+		*optBlockSize <= 0 ||
+		*optDestBlockSize < 0 {
+		getopt.Usage()
+		os.Exit(1)
+	}
+
+	if *optDestPathPrefix == "" {
+		*optDestPathPrefix = *optPathPrefix
+	}
+	if *optDestNamespace == "" {
+		*optDestNamespace = *optNamespace
+	}
+	if *optDestBlockSize == 0 {
+		*optDestBlockSize = *optBlockSize
+	}
+
+	inPlace := *optDestPathPrefix == *optPathPrefix && *optDestNamespace == *optNamespace
+	if inPlace && *optDestBlockSize != *optBlockSize {
+		// Filesets of different block sizes cannot be mixed within a namespace.
+		log.Fatalf("dest-path-prefix or dest-namespace must be set when changing the block size")
+	}
+
+	rewriteOpts := fs.RewriteOptions{
+		EncodingFormat: fs.M3TSZEncodingFormat(*optIntOptimization),
+	}
+	if *optDropSeriesRegexp != "" {
+		rewriteOpts.DropSeriesRegexp, err = regexp.Compile(*optDropSeriesRegexp)
+		if err != nil {
+			log.Fatalf("invalid drop-series-regexp: %v", err)
+		}
+	}
+
+	var (
+		srcNamespace  = ident.StringID(*optNamespace)
+		destNamespace = ident.StringID(*optDestNamespace)
+		bytesPool     = tools.NewCheckedBytesPool()
+		poolOpts      = pool.NewObjectPoolOptions().SetSize(1)
+	)
+	bytesPool.Init()
+	encodingOpts := encoding.NewOptions().SetBytesPool(bytesPool)
+
+	srPool := xio.NewSegmentReaderPool(poolOpts)
+	srPool.Init()
+	multiIterPool := encoding.NewMultiReaderIteratorPool(poolOpts)
+	multiIterPool.Init(func(r io.Reader, _ namespace.SchemaDescr) encoding.ReaderIterator {
+		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+	})
+	encoderPool := encoding.NewEncoderPool(poolOpts)
+	encoderPool.Init(func() encoding.Encoder {
+		return m3tsz.NewEncoder(time.Time{}, nil, *optIntOptimization, encodingOpts)
+	})
+	identPool := ident.NewPool(bytesPool, ident.PoolOptions{})
+
+	srcFsOpts := fs.NewOptions().SetFilePathPrefix(*optPathPrefix)
+	reader, err := fs.NewReader(bytesPool, srcFsOpts)
+	if err != nil {
+		log.Fatalf("could not create new reader: %v", err)
+	}
+
+	destFsOpts := fs.NewOptions().SetFilePathPrefix(*optDestPathPrefix)
+	pm, err := fs.NewPersistManager(destFsOpts)
+	if err != nil {
+		log.Fatalf("could not create persist manager: %v", err)
+	}
+
+	destNsOpts := namespace.NewOptions().SetRetentionOptions(
+		retention.NewOptions().SetBlockSize(*optDestBlockSize))
+	destNsMd, err := namespace.NewMetadata(destNamespace, destNsOpts)
+	if err != nil {
+		log.Fatalf("could not create destination namespace metadata: %v", err)
+	}
+
+	shards := []uint32{uint32(*optShard)}
+	if *optShard == allShards {
+		shards, err = namespaceShards(*optPathPrefix, srcNamespace)
+		if err != nil {
+			log.Fatalf("could not list shards: %v", err)
+		}
+	}
+
+	rewriter := fs.NewRewriter(reader, 0, srPool, multiIterPool,
+		identPool, encoderPool, rewriteOpts)
+	for _, shard := range shards {
+		srcFiles, err := fs.DataFiles(*optPathPrefix, srcNamespace, shard)
+		if err != nil {
+			log.Fatalf("could not list filesets for shard %d: %v", shard, err)
+		}
+		destFiles, err := fs.DataFiles(*optDestPathPrefix, destNamespace, shard)
+		if err != nil {
+			log.Fatalf("could not list destination filesets for shard %d: %v", shard, err)
+		}
+
+		plan := planRewrites(srcFiles, *optBlockSize, *optDestBlockSize)
+		if len(plan) == 0 {
+			continue
+		}
+
+		flushPreparer, err := pm.StartFlushPersist()
+		if err != nil {
+			log.Fatalf("could not start flush: %v", err)
+		}
+		for _, destBlock := range plan {
+			volume := 0
+			if latest, ok := destFiles.LatestVolumeForBlock(destBlock.blockStart); ok {
+				volume = latest.ID.VolumeIndex + 1
+			}
+
+			err := rewriter.Rewrite(destBlock.srcFileIDs, persist.DataPrepareOptions{
+				NamespaceMetadata: destNsMd,
+				Shard:             shard,
+				BlockStart:        destBlock.blockStart,
+				VolumeIndex:       volume,
+				FileSetType:       persist.FileSetFlushType,
+			}, flushPreparer, namespace.Context{})
+			if err != nil {
+				log.Fatalf("could not rewrite shard %d block %v: %v",
+					shard, destBlock.blockStart, err)
+			}
+			log.Infof("rewrote shard %d block %v as volume %d",
+				shard, destBlock.blockStart, volume)
+		}
+		if err := flushPreparer.DoneFlush(); err != nil {
+			log.Fatalf("could not finish flush: %v", err)
+		}
+	}
+
+	log.Infof("successfully rewrote filesets")
+}
+
+type destBlockRewrite struct {
+	blockStart time.Time
+	srcFileIDs []fs.FileSetFileIdentifier
+}
+
+// planRewrites returns the destination blocks to write along with the latest
+// volume of each source fileset that overlaps them.
+func planRewrites(
+	srcFiles fs.FileSetFilesSlice,
+	srcBlockSize time.Duration,
+	destBlockSize time.Duration,
+) []destBlockRewrite {
+	byDestBlock := make(map[xtime.UnixNano][]fs.FileSetFileIdentifier)
+	for i, file := range srcFiles {
+		if i+1 < len(srcFiles) && srcFiles[i+1].ID.BlockStart.Equal(file.ID.BlockStart) {
+			// Only the latest volume of each block is rewritten.
+			continue
+		}
+		if !file.HasCompleteCheckpointFile() {
+			continue
+		}
+
+		srcStart := file.ID.BlockStart
+		srcEnd := srcStart.Add(srcBlockSize)
+		for t := srcStart.Truncate(destBlockSize); t.Before(srcEnd); t = t.Add(destBlockSize) {
+			byDestBlock[xtime.ToUnixNano(t)] = append(byDestBlock[xtime.ToUnixNano(t)], file.ID)
+		}
+	}
+
+	plan := make([]destBlockRewrite, 0, len(byDestBlock))
+	for blockStart, srcFileIDs := range byDestBlock {
+		plan = append(plan, destBlockRewrite{
+			blockStart: blockStart.ToTime(),
+			srcFileIDs: srcFileIDs,
+		})
+	}
+	sort.Slice(plan, func(i, j int) bool {
+		return plan[i].blockStart.Before(plan[j].blockStart)
+	})
+	return plan
+}
+
+func namespaceShards(filePathPrefix string, namespace ident.ID) ([]uint32, error) {
+	dirs, err := ioutil.ReadDir(fs.NamespaceDataDirPath(filePathPrefix, namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	shards := make([]uint32, 0, len(dirs))
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		shard, err := strconv.ParseUint(dir.Name(), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected shard directory %s: %v", dir.Name(), err)
+		}
+		shards = append(shards, uint32(shard))
+	}
+	return shards, nil
+}
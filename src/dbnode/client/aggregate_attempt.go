@@ -21,6 +21,8 @@
 package client
 
 import (
+	stdctx "context"
+
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
@@ -32,8 +34,9 @@ var (
 )
 
 type aggregateAttempt struct {
-	session   *session
-	attemptFn xretry.Fn
+	session    *session
+	attemptFn  xretry.Fn
+	continueFn xretry.ContinueFn
 
 	args           aggregateAttemptArgs
 	resultIter     AggregatedTagsIterator
@@ -41,6 +44,7 @@ type aggregateAttempt struct {
 }
 
 type aggregateAttemptArgs struct {
+	ctx   stdctx.Context
 	ns    ident.ID
 	query index.Query
 	opts  index.AggregationOptions
@@ -52,10 +56,14 @@ func (f *aggregateAttempt) reset() {
 	f.resultMetadata = FetchResponseMetadata{}
 }
 
+func (f *aggregateAttempt) shouldContinue(attempt int) bool {
+	return f.args.ctx.Err() == nil
+}
+
 func (f *aggregateAttempt) performAttempt() error {
 	var err error
 	f.resultIter, f.resultMetadata, err = f.session.aggregateAttempt(
-		f.args.ns, f.args.query, f.args.opts)
+		f.args.ctx, f.args.ns, f.args.query, f.args.opts)
 	return err
 }
 
@@ -84,6 +92,7 @@ func (p *aggregateAttemptPoolImpl) Init() {
 		// NB(prateek): Bind fn once to avoid creating receiver
 		// and function method pointer over and over again
 		f.attemptFn = f.performAttempt
+		f.continueFn = f.shouldContinue
 		f.reset()
 		return f
 	})
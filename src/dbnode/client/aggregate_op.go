@@ -31,6 +31,8 @@ var (
 
 type aggregateOp struct {
 	refCounter
+	opContext
+
 	request      rpc.AggregateQueryRawRequest
 	completionFn completionFn
 
@@ -55,6 +57,7 @@ func (f *aggregateOp) requestLimit(defaultValue int) int {
 func (f *aggregateOp) close() {
 	f.completionFn = nil
 	f.request = aggregateOpRequestZeroed
+	f.setContext(nil)
 	// return to pool
 	if f.pool == nil {
 		return
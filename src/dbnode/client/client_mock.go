@@ -25,6 +25,7 @@
 package client
 
 import (
+	context0 "context"
 	"reflect"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
@@ -49,6 +51,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	tchannel_go "github.com/uber/tchannel-go"
+	"google.golang.org/grpc/credentials"
 )
 
 // MockClient is a mock of Client interface
@@ -169,6 +172,20 @@ func (mr *MockSessionMockRecorder) Write(namespace, id, t, value, unit, annotati
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockSession)(nil).Write), namespace, id, t, value, unit, annotation)
 }
 
+// WriteContext mocks base method
+func (m *MockSession) WriteContext(ctx context0.Context, namespace, id ident.ID, t time.Time, value float64, unit time0.Unit, annotation []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteContext", ctx, namespace, id, t, value, unit, annotation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteContext indicates an expected call of WriteContext
+func (mr *MockSessionMockRecorder) WriteContext(ctx, namespace, id, t, value, unit, annotation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteContext", reflect.TypeOf((*MockSession)(nil).WriteContext), ctx, namespace, id, t, value, unit, annotation)
+}
+
 // WriteTagged mocks base method
 func (m *MockSession) WriteTagged(namespace, id ident.ID, tags ident.TagIterator, t time.Time, value float64, unit time0.Unit, annotation []byte) error {
 	m.ctrl.T.Helper()
@@ -183,6 +200,34 @@ func (mr *MockSessionMockRecorder) WriteTagged(namespace, id, tags, t, value, un
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTagged", reflect.TypeOf((*MockSession)(nil).WriteTagged), namespace, id, tags, t, value, unit, annotation)
 }
 
+// WriteDocument mocks base method
+func (m *MockSession) WriteDocument(namespace ident.ID, d doc.Document, t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDocument", namespace, d, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteDocument indicates an expected call of WriteDocument
+func (mr *MockSessionMockRecorder) WriteDocument(namespace, d, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDocument", reflect.TypeOf((*MockSession)(nil).WriteDocument), namespace, d, t)
+}
+
+// WriteTaggedContext mocks base method
+func (m *MockSession) WriteTaggedContext(ctx context0.Context, namespace, id ident.ID, tags ident.TagIterator, t time.Time, value float64, unit time0.Unit, annotation []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteTaggedContext", ctx, namespace, id, tags, t, value, unit, annotation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteTaggedContext indicates an expected call of WriteTaggedContext
+func (mr *MockSessionMockRecorder) WriteTaggedContext(ctx, namespace, id, tags, t, value, unit, annotation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTaggedContext", reflect.TypeOf((*MockSession)(nil).WriteTaggedContext), ctx, namespace, id, tags, t, value, unit, annotation)
+}
+
 // Fetch mocks base method
 func (m *MockSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +243,21 @@ func (mr *MockSessionMockRecorder) Fetch(namespace, id, startInclusive, endExclu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockSession)(nil).Fetch), namespace, id, startInclusive, endExclusive)
 }
 
+// FetchContext mocks base method
+func (m *MockSession) FetchContext(ctx context0.Context, namespace, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchContext", ctx, namespace, id, startInclusive, endExclusive)
+	ret0, _ := ret[0].(encoding.SeriesIterator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchContext indicates an expected call of FetchContext
+func (mr *MockSessionMockRecorder) FetchContext(ctx, namespace, id, startInclusive, endExclusive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchContext", reflect.TypeOf((*MockSession)(nil).FetchContext), ctx, namespace, id, startInclusive, endExclusive)
+}
+
 // FetchIDs mocks base method
 func (m *MockSession) FetchIDs(namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +273,21 @@ func (mr *MockSessionMockRecorder) FetchIDs(namespace, ids, startInclusive, endE
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchIDs", reflect.TypeOf((*MockSession)(nil).FetchIDs), namespace, ids, startInclusive, endExclusive)
 }
 
+// FetchIDsContext mocks base method
+func (m *MockSession) FetchIDsContext(ctx context0.Context, namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchIDsContext", ctx, namespace, ids, startInclusive, endExclusive)
+	ret0, _ := ret[0].(encoding.SeriesIterators)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchIDsContext indicates an expected call of FetchIDsContext
+func (mr *MockSessionMockRecorder) FetchIDsContext(ctx, namespace, ids, startInclusive, endExclusive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchIDsContext", reflect.TypeOf((*MockSession)(nil).FetchIDsContext), ctx, namespace, ids, startInclusive, endExclusive)
+}
+
 // FetchTagged mocks base method
 func (m *MockSession) FetchTagged(namespace ident.ID, q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, FetchResponseMetadata, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +304,22 @@ func (mr *MockSessionMockRecorder) FetchTagged(namespace, q, opts interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTagged", reflect.TypeOf((*MockSession)(nil).FetchTagged), namespace, q, opts)
 }
 
+// FetchTaggedContext mocks base method
+func (m *MockSession) FetchTaggedContext(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedContext", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(encoding.SeriesIterators)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedContext indicates an expected call of FetchTaggedContext
+func (mr *MockSessionMockRecorder) FetchTaggedContext(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedContext", reflect.TypeOf((*MockSession)(nil).FetchTaggedContext), ctx, namespace, q, opts)
+}
+
 // FetchTaggedIDs mocks base method
 func (m *MockSession) FetchTaggedIDs(namespace ident.ID, q index.Query, opts index.QueryOptions) (TaggedIDsIterator, FetchResponseMetadata, error) {
 	m.ctrl.T.Helper()
@@ -245,6 +336,54 @@ func (mr *MockSessionMockRecorder) FetchTaggedIDs(namespace, q, opts interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDs", reflect.TypeOf((*MockSession)(nil).FetchTaggedIDs), namespace, q, opts)
 }
 
+// FetchTaggedIDsContext mocks base method
+func (m *MockSession) FetchTaggedIDsContext(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedIDsContext", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(TaggedIDsIterator)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedIDsContext indicates an expected call of FetchTaggedIDsContext
+func (mr *MockSessionMockRecorder) FetchTaggedIDsContext(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDsContext", reflect.TypeOf((*MockSession)(nil).FetchTaggedIDsContext), ctx, namespace, q, opts)
+}
+
+// FetchTaggedPage mocks base method
+func (m *MockSession) FetchTaggedPage(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedPage", ctx, namespace, q, opts, pageToken)
+	ret0, _ := ret[0].(encoding.SeriesIterators)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedPage indicates an expected call of FetchTaggedPage
+func (mr *MockSessionMockRecorder) FetchTaggedPage(ctx, namespace, q, opts, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedPage", reflect.TypeOf((*MockSession)(nil).FetchTaggedPage), ctx, namespace, q, opts, pageToken)
+}
+
+// FetchTaggedIDsPage mocks base method
+func (m *MockSession) FetchTaggedIDsPage(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedIDsPage", ctx, namespace, q, opts, pageToken)
+	ret0, _ := ret[0].(TaggedIDsIterator)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedIDsPage indicates an expected call of FetchTaggedIDsPage
+func (mr *MockSessionMockRecorder) FetchTaggedIDsPage(ctx, namespace, q, opts, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDsPage", reflect.TypeOf((*MockSession)(nil).FetchTaggedIDsPage), ctx, namespace, q, opts, pageToken)
+}
+
 // Aggregate mocks base method
 func (m *MockSession) Aggregate(namespace ident.ID, q index.Query, opts index.AggregationOptions) (AggregatedTagsIterator, FetchResponseMetadata, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +400,52 @@ func (mr *MockSessionMockRecorder) Aggregate(namespace, q, opts interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockSession)(nil).Aggregate), namespace, q, opts)
 }
 
+// AggregateContext mocks base method
+func (m *MockSession) AggregateContext(ctx context0.Context, namespace ident.ID, q index.Query, opts index.AggregationOptions) (AggregatedTagsIterator, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateContext", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(AggregatedTagsIterator)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AggregateContext indicates an expected call of AggregateContext
+func (mr *MockSessionMockRecorder) AggregateContext(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateContext", reflect.TypeOf((*MockSession)(nil).AggregateContext), ctx, namespace, q, opts)
+}
+
+// Cardinality mocks base method
+func (m *MockSession) Cardinality(namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockSessionMockRecorder) Cardinality(namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockSession)(nil).Cardinality), namespace, opts)
+}
+
+// CardinalityContext mocks base method
+func (m *MockSession) CardinalityContext(ctx context0.Context, namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityContext", ctx, namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardinalityContext indicates an expected call of CardinalityContext
+func (mr *MockSessionMockRecorder) CardinalityContext(ctx, namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityContext", reflect.TypeOf((*MockSession)(nil).CardinalityContext), ctx, namespace, opts)
+}
+
 // ShardID mocks base method
 func (m *MockSession) ShardID(id ident.ID) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -291,6 +476,21 @@ func (mr *MockSessionMockRecorder) IteratorPools() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IteratorPools", reflect.TypeOf((*MockSession)(nil).IteratorPools))
 }
 
+// HostQueueSaturation mocks base method
+func (m *MockSession) HostQueueSaturation() ([]HostQueueSaturation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostQueueSaturation")
+	ret0, _ := ret[0].([]HostQueueSaturation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HostQueueSaturation indicates an expected call of HostQueueSaturation
+func (mr *MockSessionMockRecorder) HostQueueSaturation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueSaturation", reflect.TypeOf((*MockSession)(nil).HostQueueSaturation))
+}
+
 // Close mocks base method
 func (m *MockSession) Close() error {
 	m.ctrl.T.Helper()
@@ -771,6 +971,20 @@ func (mr *MockAdminSessionMockRecorder) Write(namespace, id, t, value, unit, ann
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockAdminSession)(nil).Write), namespace, id, t, value, unit, annotation)
 }
 
+// WriteContext mocks base method
+func (m *MockAdminSession) WriteContext(ctx context0.Context, namespace, id ident.ID, t time.Time, value float64, unit time0.Unit, annotation []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteContext", ctx, namespace, id, t, value, unit, annotation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteContext indicates an expected call of WriteContext
+func (mr *MockAdminSessionMockRecorder) WriteContext(ctx, namespace, id, t, value, unit, annotation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteContext", reflect.TypeOf((*MockAdminSession)(nil).WriteContext), ctx, namespace, id, t, value, unit, annotation)
+}
+
 // WriteTagged mocks base method
 func (m *MockAdminSession) WriteTagged(namespace, id ident.ID, tags ident.TagIterator, t time.Time, value float64, unit time0.Unit, annotation []byte) error {
 	m.ctrl.T.Helper()
@@ -785,6 +999,34 @@ func (mr *MockAdminSessionMockRecorder) WriteTagged(namespace, id, tags, t, valu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTagged", reflect.TypeOf((*MockAdminSession)(nil).WriteTagged), namespace, id, tags, t, value, unit, annotation)
 }
 
+// WriteDocument mocks base method
+func (m *MockAdminSession) WriteDocument(namespace ident.ID, d doc.Document, t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDocument", namespace, d, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteDocument indicates an expected call of WriteDocument
+func (mr *MockAdminSessionMockRecorder) WriteDocument(namespace, d, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDocument", reflect.TypeOf((*MockAdminSession)(nil).WriteDocument), namespace, d, t)
+}
+
+// WriteTaggedContext mocks base method
+func (m *MockAdminSession) WriteTaggedContext(ctx context0.Context, namespace, id ident.ID, tags ident.TagIterator, t time.Time, value float64, unit time0.Unit, annotation []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteTaggedContext", ctx, namespace, id, tags, t, value, unit, annotation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteTaggedContext indicates an expected call of WriteTaggedContext
+func (mr *MockAdminSessionMockRecorder) WriteTaggedContext(ctx, namespace, id, tags, t, value, unit, annotation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTaggedContext", reflect.TypeOf((*MockAdminSession)(nil).WriteTaggedContext), ctx, namespace, id, tags, t, value, unit, annotation)
+}
+
 // Fetch mocks base method
 func (m *MockAdminSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error) {
 	m.ctrl.T.Helper()
@@ -800,6 +1042,21 @@ func (mr *MockAdminSessionMockRecorder) Fetch(namespace, id, startInclusive, end
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockAdminSession)(nil).Fetch), namespace, id, startInclusive, endExclusive)
 }
 
+// FetchContext mocks base method
+func (m *MockAdminSession) FetchContext(ctx context0.Context, namespace, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchContext", ctx, namespace, id, startInclusive, endExclusive)
+	ret0, _ := ret[0].(encoding.SeriesIterator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchContext indicates an expected call of FetchContext
+func (mr *MockAdminSessionMockRecorder) FetchContext(ctx, namespace, id, startInclusive, endExclusive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchContext", reflect.TypeOf((*MockAdminSession)(nil).FetchContext), ctx, namespace, id, startInclusive, endExclusive)
+}
+
 // FetchIDs mocks base method
 func (m *MockAdminSession) FetchIDs(namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error) {
 	m.ctrl.T.Helper()
@@ -815,6 +1072,21 @@ func (mr *MockAdminSessionMockRecorder) FetchIDs(namespace, ids, startInclusive,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchIDs", reflect.TypeOf((*MockAdminSession)(nil).FetchIDs), namespace, ids, startInclusive, endExclusive)
 }
 
+// FetchIDsContext mocks base method
+func (m *MockAdminSession) FetchIDsContext(ctx context0.Context, namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchIDsContext", ctx, namespace, ids, startInclusive, endExclusive)
+	ret0, _ := ret[0].(encoding.SeriesIterators)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchIDsContext indicates an expected call of FetchIDsContext
+func (mr *MockAdminSessionMockRecorder) FetchIDsContext(ctx, namespace, ids, startInclusive, endExclusive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchIDsContext", reflect.TypeOf((*MockAdminSession)(nil).FetchIDsContext), ctx, namespace, ids, startInclusive, endExclusive)
+}
+
 // FetchTagged mocks base method
 func (m *MockAdminSession) FetchTagged(namespace ident.ID, q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, FetchResponseMetadata, error) {
 	m.ctrl.T.Helper()
@@ -831,6 +1103,22 @@ func (mr *MockAdminSessionMockRecorder) FetchTagged(namespace, q, opts interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTagged", reflect.TypeOf((*MockAdminSession)(nil).FetchTagged), namespace, q, opts)
 }
 
+// FetchTaggedContext mocks base method
+func (m *MockAdminSession) FetchTaggedContext(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedContext", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(encoding.SeriesIterators)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedContext indicates an expected call of FetchTaggedContext
+func (mr *MockAdminSessionMockRecorder) FetchTaggedContext(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedContext", reflect.TypeOf((*MockAdminSession)(nil).FetchTaggedContext), ctx, namespace, q, opts)
+}
+
 // FetchTaggedIDs mocks base method
 func (m *MockAdminSession) FetchTaggedIDs(namespace ident.ID, q index.Query, opts index.QueryOptions) (TaggedIDsIterator, FetchResponseMetadata, error) {
 	m.ctrl.T.Helper()
@@ -847,6 +1135,54 @@ func (mr *MockAdminSessionMockRecorder) FetchTaggedIDs(namespace, q, opts interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDs", reflect.TypeOf((*MockAdminSession)(nil).FetchTaggedIDs), namespace, q, opts)
 }
 
+// FetchTaggedIDsContext mocks base method
+func (m *MockAdminSession) FetchTaggedIDsContext(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedIDsContext", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(TaggedIDsIterator)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedIDsContext indicates an expected call of FetchTaggedIDsContext
+func (mr *MockAdminSessionMockRecorder) FetchTaggedIDsContext(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDsContext", reflect.TypeOf((*MockAdminSession)(nil).FetchTaggedIDsContext), ctx, namespace, q, opts)
+}
+
+// FetchTaggedPage mocks base method
+func (m *MockAdminSession) FetchTaggedPage(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedPage", ctx, namespace, q, opts, pageToken)
+	ret0, _ := ret[0].(encoding.SeriesIterators)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedPage indicates an expected call of FetchTaggedPage
+func (mr *MockAdminSessionMockRecorder) FetchTaggedPage(ctx, namespace, q, opts, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedPage", reflect.TypeOf((*MockAdminSession)(nil).FetchTaggedPage), ctx, namespace, q, opts, pageToken)
+}
+
+// FetchTaggedIDsPage mocks base method
+func (m *MockAdminSession) FetchTaggedIDsPage(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedIDsPage", ctx, namespace, q, opts, pageToken)
+	ret0, _ := ret[0].(TaggedIDsIterator)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedIDsPage indicates an expected call of FetchTaggedIDsPage
+func (mr *MockAdminSessionMockRecorder) FetchTaggedIDsPage(ctx, namespace, q, opts, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDsPage", reflect.TypeOf((*MockAdminSession)(nil).FetchTaggedIDsPage), ctx, namespace, q, opts, pageToken)
+}
+
 // Aggregate mocks base method
 func (m *MockAdminSession) Aggregate(namespace ident.ID, q index.Query, opts index.AggregationOptions) (AggregatedTagsIterator, FetchResponseMetadata, error) {
 	m.ctrl.T.Helper()
@@ -863,6 +1199,52 @@ func (mr *MockAdminSessionMockRecorder) Aggregate(namespace, q, opts interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockAdminSession)(nil).Aggregate), namespace, q, opts)
 }
 
+// AggregateContext mocks base method
+func (m *MockAdminSession) AggregateContext(ctx context0.Context, namespace ident.ID, q index.Query, opts index.AggregationOptions) (AggregatedTagsIterator, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateContext", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(AggregatedTagsIterator)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AggregateContext indicates an expected call of AggregateContext
+func (mr *MockAdminSessionMockRecorder) AggregateContext(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateContext", reflect.TypeOf((*MockAdminSession)(nil).AggregateContext), ctx, namespace, q, opts)
+}
+
+// Cardinality mocks base method
+func (m *MockAdminSession) Cardinality(namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockAdminSessionMockRecorder) Cardinality(namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockAdminSession)(nil).Cardinality), namespace, opts)
+}
+
+// CardinalityContext mocks base method
+func (m *MockAdminSession) CardinalityContext(ctx context0.Context, namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityContext", ctx, namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardinalityContext indicates an expected call of CardinalityContext
+func (mr *MockAdminSessionMockRecorder) CardinalityContext(ctx, namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityContext", reflect.TypeOf((*MockAdminSession)(nil).CardinalityContext), ctx, namespace, opts)
+}
+
 // ShardID mocks base method
 func (m *MockAdminSession) ShardID(id ident.ID) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -893,6 +1275,21 @@ func (mr *MockAdminSessionMockRecorder) IteratorPools() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IteratorPools", reflect.TypeOf((*MockAdminSession)(nil).IteratorPools))
 }
 
+// HostQueueSaturation mocks base method
+func (m *MockAdminSession) HostQueueSaturation() ([]HostQueueSaturation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostQueueSaturation")
+	ret0, _ := ret[0].([]HostQueueSaturation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HostQueueSaturation indicates an expected call of HostQueueSaturation
+func (mr *MockAdminSessionMockRecorder) HostQueueSaturation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueSaturation", reflect.TypeOf((*MockAdminSession)(nil).HostQueueSaturation))
+}
+
 // Close mocks base method
 func (m *MockAdminSession) Close() error {
 	m.ctrl.T.Helper()
@@ -965,6 +1362,21 @@ func (mr *MockAdminSessionMockRecorder) Truncate(namespace interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*MockAdminSession)(nil).Truncate), namespace)
 }
 
+// DeleteTagged mocks base method
+func (m *MockAdminSession) DeleteTagged(namespace ident.ID, q index.Query, start, end time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", namespace, q, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged
+func (mr *MockAdminSessionMockRecorder) DeleteTagged(namespace, q, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*MockAdminSession)(nil).DeleteTagged), namespace, q, start, end)
+}
+
 // FetchBootstrapBlocksFromPeers mocks base method
 func (m *MockAdminSession) FetchBootstrapBlocksFromPeers(namespace namespace.Metadata, shard uint32, start, end time.Time, opts result.Options) (result.ShardResult, error) {
 	m.ctrl.T.Helper()
@@ -1552,37 +1964,65 @@ func (mr *MockOptionsMockRecorder) TruncateRequestTimeout() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateRequestTimeout", reflect.TypeOf((*MockOptions)(nil).TruncateRequestTimeout))
 }
 
-// SetBackgroundConnectInterval mocks base method
-func (m *MockOptions) SetBackgroundConnectInterval(value time.Duration) Options {
+// SetDeleteTaggedRequestTimeout mocks base method
+func (m *MockOptions) SetDeleteTaggedRequestTimeout(value time.Duration) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBackgroundConnectInterval", value)
+	ret := m.ctrl.Call(m, "SetDeleteTaggedRequestTimeout", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetBackgroundConnectInterval indicates an expected call of SetBackgroundConnectInterval
-func (mr *MockOptionsMockRecorder) SetBackgroundConnectInterval(value interface{}) *gomock.Call {
+// SetDeleteTaggedRequestTimeout indicates an expected call of SetDeleteTaggedRequestTimeout
+func (mr *MockOptionsMockRecorder) SetDeleteTaggedRequestTimeout(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundConnectInterval", reflect.TypeOf((*MockOptions)(nil).SetBackgroundConnectInterval), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeleteTaggedRequestTimeout", reflect.TypeOf((*MockOptions)(nil).SetDeleteTaggedRequestTimeout), value)
 }
 
-// BackgroundConnectInterval mocks base method
-func (m *MockOptions) BackgroundConnectInterval() time.Duration {
+// DeleteTaggedRequestTimeout mocks base method
+func (m *MockOptions) DeleteTaggedRequestTimeout() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BackgroundConnectInterval")
+	ret := m.ctrl.Call(m, "DeleteTaggedRequestTimeout")
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// BackgroundConnectInterval indicates an expected call of BackgroundConnectInterval
-func (mr *MockOptionsMockRecorder) BackgroundConnectInterval() *gomock.Call {
+// DeleteTaggedRequestTimeout indicates an expected call of DeleteTaggedRequestTimeout
+func (mr *MockOptionsMockRecorder) DeleteTaggedRequestTimeout() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundConnectInterval", reflect.TypeOf((*MockOptions)(nil).BackgroundConnectInterval))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaggedRequestTimeout", reflect.TypeOf((*MockOptions)(nil).DeleteTaggedRequestTimeout))
 }
 
-// SetBackgroundConnectStutter mocks base method
-func (m *MockOptions) SetBackgroundConnectStutter(value time.Duration) Options {
-	m.ctrl.T.Helper()
+// SetBackgroundConnectInterval mocks base method
+func (m *MockOptions) SetBackgroundConnectInterval(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBackgroundConnectInterval", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetBackgroundConnectInterval indicates an expected call of SetBackgroundConnectInterval
+func (mr *MockOptionsMockRecorder) SetBackgroundConnectInterval(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundConnectInterval", reflect.TypeOf((*MockOptions)(nil).SetBackgroundConnectInterval), value)
+}
+
+// BackgroundConnectInterval mocks base method
+func (m *MockOptions) BackgroundConnectInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackgroundConnectInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackgroundConnectInterval indicates an expected call of BackgroundConnectInterval
+func (mr *MockOptionsMockRecorder) BackgroundConnectInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundConnectInterval", reflect.TypeOf((*MockOptions)(nil).BackgroundConnectInterval))
+}
+
+// SetBackgroundConnectStutter mocks base method
+func (m *MockOptions) SetBackgroundConnectStutter(value time.Duration) Options {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetBackgroundConnectStutter", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
@@ -2476,1455 +2916,2659 @@ func (mr *MockOptionsMockRecorder) WriteTimestampOffset() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTimestampOffset", reflect.TypeOf((*MockOptions)(nil).WriteTimestampOffset))
 }
 
-// MockAdminOptions is a mock of AdminOptions interface
-type MockAdminOptions struct {
-	ctrl     *gomock.Controller
-	recorder *MockAdminOptionsMockRecorder
+// SetReadRepairEnabled mocks base method
+func (m *MockOptions) SetReadRepairEnabled(value bool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairEnabled", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
 }
 
-// MockAdminOptionsMockRecorder is the mock recorder for MockAdminOptions
-type MockAdminOptionsMockRecorder struct {
-	mock *MockAdminOptions
+// SetReadRepairEnabled indicates an expected call of SetReadRepairEnabled
+func (mr *MockOptionsMockRecorder) SetReadRepairEnabled(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairEnabled", reflect.TypeOf((*MockOptions)(nil).SetReadRepairEnabled), value)
 }
 
-// NewMockAdminOptions creates a new mock instance
-func NewMockAdminOptions(ctrl *gomock.Controller) *MockAdminOptions {
-	mock := &MockAdminOptions{ctrl: ctrl}
-	mock.recorder = &MockAdminOptionsMockRecorder{mock}
-	return mock
+// ReadRepairEnabled mocks base method
+func (m *MockOptions) ReadRepairEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockAdminOptions) EXPECT() *MockAdminOptionsMockRecorder {
-	return m.recorder
+// ReadRepairEnabled indicates an expected call of ReadRepairEnabled
+func (mr *MockOptionsMockRecorder) ReadRepairEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairEnabled", reflect.TypeOf((*MockOptions)(nil).ReadRepairEnabled))
 }
 
-// Validate mocks base method
-func (m *MockAdminOptions) Validate() error {
+// SetReadRepairMaxDatapointsPerSecond mocks base method
+func (m *MockOptions) SetReadRepairMaxDatapointsPerSecond(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Validate")
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "SetReadRepairMaxDatapointsPerSecond", value)
+	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// Validate indicates an expected call of Validate
-func (mr *MockAdminOptionsMockRecorder) Validate() *gomock.Call {
+// SetReadRepairMaxDatapointsPerSecond indicates an expected call of SetReadRepairMaxDatapointsPerSecond
+func (mr *MockOptionsMockRecorder) SetReadRepairMaxDatapointsPerSecond(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockAdminOptions)(nil).Validate))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairMaxDatapointsPerSecond", reflect.TypeOf((*MockOptions)(nil).SetReadRepairMaxDatapointsPerSecond), value)
 }
 
-// SetEncodingM3TSZ mocks base method
-func (m *MockAdminOptions) SetEncodingM3TSZ() Options {
+// ReadRepairMaxDatapointsPerSecond mocks base method
+func (m *MockOptions) ReadRepairMaxDatapointsPerSecond() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetEncodingM3TSZ")
-	ret0, _ := ret[0].(Options)
+	ret := m.ctrl.Call(m, "ReadRepairMaxDatapointsPerSecond")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// SetEncodingM3TSZ indicates an expected call of SetEncodingM3TSZ
-func (mr *MockAdminOptionsMockRecorder) SetEncodingM3TSZ() *gomock.Call {
+// ReadRepairMaxDatapointsPerSecond indicates an expected call of ReadRepairMaxDatapointsPerSecond
+func (mr *MockOptionsMockRecorder) ReadRepairMaxDatapointsPerSecond() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEncodingM3TSZ", reflect.TypeOf((*MockAdminOptions)(nil).SetEncodingM3TSZ))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairMaxDatapointsPerSecond", reflect.TypeOf((*MockOptions)(nil).ReadRepairMaxDatapointsPerSecond))
 }
 
-// SetEncodingProto mocks base method
-func (m *MockAdminOptions) SetEncodingProto(encodingOpts encoding.Options) Options {
+// SetReadRepairQueueSize mocks base method
+func (m *MockOptions) SetReadRepairQueueSize(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetEncodingProto", encodingOpts)
+	ret := m.ctrl.Call(m, "SetReadRepairQueueSize", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetEncodingProto indicates an expected call of SetEncodingProto
-func (mr *MockAdminOptionsMockRecorder) SetEncodingProto(encodingOpts interface{}) *gomock.Call {
+// SetReadRepairQueueSize indicates an expected call of SetReadRepairQueueSize
+func (mr *MockOptionsMockRecorder) SetReadRepairQueueSize(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEncodingProto", reflect.TypeOf((*MockAdminOptions)(nil).SetEncodingProto), encodingOpts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairQueueSize", reflect.TypeOf((*MockOptions)(nil).SetReadRepairQueueSize), value)
 }
 
-// IsSetEncodingProto mocks base method
-func (m *MockAdminOptions) IsSetEncodingProto() bool {
+// ReadRepairQueueSize mocks base method
+func (m *MockOptions) ReadRepairQueueSize() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsSetEncodingProto")
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "ReadRepairQueueSize")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// IsSetEncodingProto indicates an expected call of IsSetEncodingProto
-func (mr *MockAdminOptionsMockRecorder) IsSetEncodingProto() *gomock.Call {
+// ReadRepairQueueSize indicates an expected call of ReadRepairQueueSize
+func (mr *MockOptionsMockRecorder) ReadRepairQueueSize() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSetEncodingProto", reflect.TypeOf((*MockAdminOptions)(nil).IsSetEncodingProto))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairQueueSize", reflect.TypeOf((*MockOptions)(nil).ReadRepairQueueSize))
 }
 
-// SetRuntimeOptionsManager mocks base method
-func (m *MockAdminOptions) SetRuntimeOptionsManager(value runtime.OptionsManager) Options {
+// SetReadRepairNamespaceInitializer mocks base method
+func (m *MockOptions) SetReadRepairNamespaceInitializer(value namespace.Initializer) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetRuntimeOptionsManager", value)
+	ret := m.ctrl.Call(m, "SetReadRepairNamespaceInitializer", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetRuntimeOptionsManager indicates an expected call of SetRuntimeOptionsManager
-func (mr *MockAdminOptionsMockRecorder) SetRuntimeOptionsManager(value interface{}) *gomock.Call {
+// SetReadRepairNamespaceInitializer indicates an expected call of SetReadRepairNamespaceInitializer
+func (mr *MockOptionsMockRecorder) SetReadRepairNamespaceInitializer(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRuntimeOptionsManager", reflect.TypeOf((*MockAdminOptions)(nil).SetRuntimeOptionsManager), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairNamespaceInitializer", reflect.TypeOf((*MockOptions)(nil).SetReadRepairNamespaceInitializer), value)
 }
 
-// RuntimeOptionsManager mocks base method
-func (m *MockAdminOptions) RuntimeOptionsManager() runtime.OptionsManager {
+// ReadRepairNamespaceInitializer mocks base method
+func (m *MockOptions) ReadRepairNamespaceInitializer() namespace.Initializer {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RuntimeOptionsManager")
-	ret0, _ := ret[0].(runtime.OptionsManager)
+	ret := m.ctrl.Call(m, "ReadRepairNamespaceInitializer")
+	ret0, _ := ret[0].(namespace.Initializer)
 	return ret0
 }
 
-// RuntimeOptionsManager indicates an expected call of RuntimeOptionsManager
-func (mr *MockAdminOptionsMockRecorder) RuntimeOptionsManager() *gomock.Call {
+// ReadRepairNamespaceInitializer indicates an expected call of ReadRepairNamespaceInitializer
+func (mr *MockOptionsMockRecorder) ReadRepairNamespaceInitializer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RuntimeOptionsManager", reflect.TypeOf((*MockAdminOptions)(nil).RuntimeOptionsManager))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairNamespaceInitializer", reflect.TypeOf((*MockOptions)(nil).ReadRepairNamespaceInitializer))
 }
 
-// SetClockOptions mocks base method
-func (m *MockAdminOptions) SetClockOptions(value clock.Options) Options {
+// SetHintedHandoffEnabled mocks base method
+func (m *MockOptions) SetHintedHandoffEnabled(value bool) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetClockOptions", value)
+	ret := m.ctrl.Call(m, "SetHintedHandoffEnabled", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetClockOptions indicates an expected call of SetClockOptions
-func (mr *MockAdminOptionsMockRecorder) SetClockOptions(value interface{}) *gomock.Call {
+// SetHintedHandoffEnabled indicates an expected call of SetHintedHandoffEnabled
+func (mr *MockOptionsMockRecorder) SetHintedHandoffEnabled(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClockOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetClockOptions), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffEnabled", reflect.TypeOf((*MockOptions)(nil).SetHintedHandoffEnabled), value)
 }
 
-// ClockOptions mocks base method
-func (m *MockAdminOptions) ClockOptions() clock.Options {
+// HintedHandoffEnabled mocks base method
+func (m *MockOptions) HintedHandoffEnabled() bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ClockOptions")
-	ret0, _ := ret[0].(clock.Options)
+	ret := m.ctrl.Call(m, "HintedHandoffEnabled")
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// ClockOptions indicates an expected call of ClockOptions
-func (mr *MockAdminOptionsMockRecorder) ClockOptions() *gomock.Call {
+// HintedHandoffEnabled indicates an expected call of HintedHandoffEnabled
+func (mr *MockOptionsMockRecorder) HintedHandoffEnabled() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockOptions", reflect.TypeOf((*MockAdminOptions)(nil).ClockOptions))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffEnabled", reflect.TypeOf((*MockOptions)(nil).HintedHandoffEnabled))
 }
 
-// SetInstrumentOptions mocks base method
-func (m *MockAdminOptions) SetInstrumentOptions(value instrument.Options) Options {
+// SetHintedHandoffMaxHintsPerHost mocks base method
+func (m *MockOptions) SetHintedHandoffMaxHintsPerHost(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetInstrumentOptions", value)
+	ret := m.ctrl.Call(m, "SetHintedHandoffMaxHintsPerHost", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetInstrumentOptions indicates an expected call of SetInstrumentOptions
-func (mr *MockAdminOptionsMockRecorder) SetInstrumentOptions(value interface{}) *gomock.Call {
+// SetHintedHandoffMaxHintsPerHost indicates an expected call of SetHintedHandoffMaxHintsPerHost
+func (mr *MockOptionsMockRecorder) SetHintedHandoffMaxHintsPerHost(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInstrumentOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetInstrumentOptions), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffMaxHintsPerHost", reflect.TypeOf((*MockOptions)(nil).SetHintedHandoffMaxHintsPerHost), value)
 }
 
-// InstrumentOptions mocks base method
-func (m *MockAdminOptions) InstrumentOptions() instrument.Options {
+// HintedHandoffMaxHintsPerHost mocks base method
+func (m *MockOptions) HintedHandoffMaxHintsPerHost() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstrumentOptions")
-	ret0, _ := ret[0].(instrument.Options)
+	ret := m.ctrl.Call(m, "HintedHandoffMaxHintsPerHost")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// InstrumentOptions indicates an expected call of InstrumentOptions
-func (mr *MockAdminOptionsMockRecorder) InstrumentOptions() *gomock.Call {
+// HintedHandoffMaxHintsPerHost indicates an expected call of HintedHandoffMaxHintsPerHost
+func (mr *MockOptionsMockRecorder) HintedHandoffMaxHintsPerHost() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstrumentOptions", reflect.TypeOf((*MockAdminOptions)(nil).InstrumentOptions))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffMaxHintsPerHost", reflect.TypeOf((*MockOptions)(nil).HintedHandoffMaxHintsPerHost))
 }
 
-// SetLogErrorSampleRate mocks base method
-func (m *MockAdminOptions) SetLogErrorSampleRate(value sampler.Rate) Options {
+// SetHintedHandoffMaxHintAge mocks base method
+func (m *MockOptions) SetHintedHandoffMaxHintAge(value time.Duration) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetLogErrorSampleRate", value)
+	ret := m.ctrl.Call(m, "SetHintedHandoffMaxHintAge", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetLogErrorSampleRate indicates an expected call of SetLogErrorSampleRate
-func (mr *MockAdminOptionsMockRecorder) SetLogErrorSampleRate(value interface{}) *gomock.Call {
+// SetHintedHandoffMaxHintAge indicates an expected call of SetHintedHandoffMaxHintAge
+func (mr *MockOptionsMockRecorder) SetHintedHandoffMaxHintAge(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLogErrorSampleRate", reflect.TypeOf((*MockAdminOptions)(nil).SetLogErrorSampleRate), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffMaxHintAge", reflect.TypeOf((*MockOptions)(nil).SetHintedHandoffMaxHintAge), value)
 }
 
-// LogErrorSampleRate mocks base method
-func (m *MockAdminOptions) LogErrorSampleRate() sampler.Rate {
+// HintedHandoffMaxHintAge mocks base method
+func (m *MockOptions) HintedHandoffMaxHintAge() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogErrorSampleRate")
-	ret0, _ := ret[0].(sampler.Rate)
+	ret := m.ctrl.Call(m, "HintedHandoffMaxHintAge")
+	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// LogErrorSampleRate indicates an expected call of LogErrorSampleRate
-func (mr *MockAdminOptionsMockRecorder) LogErrorSampleRate() *gomock.Call {
+// HintedHandoffMaxHintAge indicates an expected call of HintedHandoffMaxHintAge
+func (mr *MockOptionsMockRecorder) HintedHandoffMaxHintAge() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogErrorSampleRate", reflect.TypeOf((*MockAdminOptions)(nil).LogErrorSampleRate))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffMaxHintAge", reflect.TypeOf((*MockOptions)(nil).HintedHandoffMaxHintAge))
 }
 
-// SetTopologyInitializer mocks base method
-func (m *MockAdminOptions) SetTopologyInitializer(value topology.Initializer) Options {
+// SetHintedHandoffReplayInterval mocks base method
+func (m *MockOptions) SetHintedHandoffReplayInterval(value time.Duration) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetTopologyInitializer", value)
+	ret := m.ctrl.Call(m, "SetHintedHandoffReplayInterval", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetTopologyInitializer indicates an expected call of SetTopologyInitializer
-func (mr *MockAdminOptionsMockRecorder) SetTopologyInitializer(value interface{}) *gomock.Call {
+// SetHintedHandoffReplayInterval indicates an expected call of SetHintedHandoffReplayInterval
+func (mr *MockOptionsMockRecorder) SetHintedHandoffReplayInterval(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTopologyInitializer", reflect.TypeOf((*MockAdminOptions)(nil).SetTopologyInitializer), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffReplayInterval", reflect.TypeOf((*MockOptions)(nil).SetHintedHandoffReplayInterval), value)
 }
 
-// TopologyInitializer mocks base method
-func (m *MockAdminOptions) TopologyInitializer() topology.Initializer {
+// HintedHandoffReplayInterval mocks base method
+func (m *MockOptions) HintedHandoffReplayInterval() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TopologyInitializer")
-	ret0, _ := ret[0].(topology.Initializer)
+	ret := m.ctrl.Call(m, "HintedHandoffReplayInterval")
+	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// TopologyInitializer indicates an expected call of TopologyInitializer
-func (mr *MockAdminOptionsMockRecorder) TopologyInitializer() *gomock.Call {
+// HintedHandoffReplayInterval indicates an expected call of HintedHandoffReplayInterval
+func (mr *MockOptionsMockRecorder) HintedHandoffReplayInterval() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopologyInitializer", reflect.TypeOf((*MockAdminOptions)(nil).TopologyInitializer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffReplayInterval", reflect.TypeOf((*MockOptions)(nil).HintedHandoffReplayInterval))
 }
 
-// SetReadConsistencyLevel mocks base method
-func (m *MockAdminOptions) SetReadConsistencyLevel(value topology.ReadConsistencyLevel) Options {
+// SetTransportType mocks base method
+func (m *MockOptions) SetTransportType(value TransportType) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetReadConsistencyLevel", value)
+	ret := m.ctrl.Call(m, "SetTransportType", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetReadConsistencyLevel indicates an expected call of SetReadConsistencyLevel
-func (mr *MockAdminOptionsMockRecorder) SetReadConsistencyLevel(value interface{}) *gomock.Call {
+// SetTransportType indicates an expected call of SetTransportType
+func (mr *MockOptionsMockRecorder) SetTransportType(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).SetReadConsistencyLevel), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransportType", reflect.TypeOf((*MockOptions)(nil).SetTransportType), value)
 }
 
-// ReadConsistencyLevel mocks base method
-func (m *MockAdminOptions) ReadConsistencyLevel() topology.ReadConsistencyLevel {
+// TransportType mocks base method
+func (m *MockOptions) TransportType() TransportType {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadConsistencyLevel")
-	ret0, _ := ret[0].(topology.ReadConsistencyLevel)
+	ret := m.ctrl.Call(m, "TransportType")
+	ret0, _ := ret[0].(TransportType)
 	return ret0
 }
 
-// ReadConsistencyLevel indicates an expected call of ReadConsistencyLevel
-func (mr *MockAdminOptionsMockRecorder) ReadConsistencyLevel() *gomock.Call {
+// TransportType indicates an expected call of TransportType
+func (mr *MockOptionsMockRecorder) TransportType() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).ReadConsistencyLevel))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransportType", reflect.TypeOf((*MockOptions)(nil).TransportType))
 }
 
-// SetWriteConsistencyLevel mocks base method
-func (m *MockAdminOptions) SetWriteConsistencyLevel(value topology.ConsistencyLevel) Options {
+// SetGRPCPort mocks base method
+func (m *MockOptions) SetGRPCPort(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetWriteConsistencyLevel", value)
+	ret := m.ctrl.Call(m, "SetGRPCPort", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetWriteConsistencyLevel indicates an expected call of SetWriteConsistencyLevel
-func (mr *MockAdminOptionsMockRecorder) SetWriteConsistencyLevel(value interface{}) *gomock.Call {
+// SetGRPCPort indicates an expected call of SetGRPCPort
+func (mr *MockOptionsMockRecorder) SetGRPCPort(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteConsistencyLevel), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCPort", reflect.TypeOf((*MockOptions)(nil).SetGRPCPort), value)
 }
 
-// WriteConsistencyLevel mocks base method
-func (m *MockAdminOptions) WriteConsistencyLevel() topology.ConsistencyLevel {
+// GRPCPort mocks base method
+func (m *MockOptions) GRPCPort() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteConsistencyLevel")
-	ret0, _ := ret[0].(topology.ConsistencyLevel)
+	ret := m.ctrl.Call(m, "GRPCPort")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// WriteConsistencyLevel indicates an expected call of WriteConsistencyLevel
-func (mr *MockAdminOptionsMockRecorder) WriteConsistencyLevel() *gomock.Call {
+// GRPCPort indicates an expected call of GRPCPort
+func (mr *MockOptionsMockRecorder) GRPCPort() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).WriteConsistencyLevel))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCPort", reflect.TypeOf((*MockOptions)(nil).GRPCPort))
 }
 
-// SetChannelOptions mocks base method
-func (m *MockAdminOptions) SetChannelOptions(value *tchannel_go.ChannelOptions) Options {
+// SetGRPCMaxMsgSize mocks base method
+func (m *MockOptions) SetGRPCMaxMsgSize(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetChannelOptions", value)
+	ret := m.ctrl.Call(m, "SetGRPCMaxMsgSize", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetChannelOptions indicates an expected call of SetChannelOptions
-func (mr *MockAdminOptionsMockRecorder) SetChannelOptions(value interface{}) *gomock.Call {
+// SetGRPCMaxMsgSize indicates an expected call of SetGRPCMaxMsgSize
+func (mr *MockOptionsMockRecorder) SetGRPCMaxMsgSize(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetChannelOptions), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCMaxMsgSize", reflect.TypeOf((*MockOptions)(nil).SetGRPCMaxMsgSize), value)
 }
 
-// ChannelOptions mocks base method
-func (m *MockAdminOptions) ChannelOptions() *tchannel_go.ChannelOptions {
+// GRPCMaxMsgSize mocks base method
+func (m *MockOptions) GRPCMaxMsgSize() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChannelOptions")
-	ret0, _ := ret[0].(*tchannel_go.ChannelOptions)
+	ret := m.ctrl.Call(m, "GRPCMaxMsgSize")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// ChannelOptions indicates an expected call of ChannelOptions
-func (mr *MockAdminOptionsMockRecorder) ChannelOptions() *gomock.Call {
+// GRPCMaxMsgSize indicates an expected call of GRPCMaxMsgSize
+func (mr *MockOptionsMockRecorder) GRPCMaxMsgSize() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChannelOptions", reflect.TypeOf((*MockAdminOptions)(nil).ChannelOptions))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCMaxMsgSize", reflect.TypeOf((*MockOptions)(nil).GRPCMaxMsgSize))
 }
 
-// SetMaxConnectionCount mocks base method
-func (m *MockAdminOptions) SetMaxConnectionCount(value int) Options {
+// SetGRPCTransportCredentials mocks base method
+func (m *MockOptions) SetGRPCTransportCredentials(value credentials.TransportCredentials) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetMaxConnectionCount", value)
+	ret := m.ctrl.Call(m, "SetGRPCTransportCredentials", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetMaxConnectionCount indicates an expected call of SetMaxConnectionCount
-func (mr *MockAdminOptionsMockRecorder) SetMaxConnectionCount(value interface{}) *gomock.Call {
+// SetGRPCTransportCredentials indicates an expected call of SetGRPCTransportCredentials
+func (mr *MockOptionsMockRecorder) SetGRPCTransportCredentials(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxConnectionCount", reflect.TypeOf((*MockAdminOptions)(nil).SetMaxConnectionCount), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCTransportCredentials", reflect.TypeOf((*MockOptions)(nil).SetGRPCTransportCredentials), value)
 }
 
-// MaxConnectionCount mocks base method
-func (m *MockAdminOptions) MaxConnectionCount() int {
+// GRPCTransportCredentials mocks base method
+func (m *MockOptions) GRPCTransportCredentials() credentials.TransportCredentials {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MaxConnectionCount")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "GRPCTransportCredentials")
+	ret0, _ := ret[0].(credentials.TransportCredentials)
 	return ret0
 }
 
-// MaxConnectionCount indicates an expected call of MaxConnectionCount
-func (mr *MockAdminOptionsMockRecorder) MaxConnectionCount() *gomock.Call {
+// GRPCTransportCredentials indicates an expected call of GRPCTransportCredentials
+func (mr *MockOptionsMockRecorder) GRPCTransportCredentials() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxConnectionCount", reflect.TypeOf((*MockAdminOptions)(nil).MaxConnectionCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCTransportCredentials", reflect.TypeOf((*MockOptions)(nil).GRPCTransportCredentials))
 }
 
-// SetMinConnectionCount mocks base method
-func (m *MockAdminOptions) SetMinConnectionCount(value int) Options {
+// SetAdaptiveWriteBatchEnabled mocks base method
+func (m *MockOptions) SetAdaptiveWriteBatchEnabled(value bool) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetMinConnectionCount", value)
+	ret := m.ctrl.Call(m, "SetAdaptiveWriteBatchEnabled", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetMinConnectionCount indicates an expected call of SetMinConnectionCount
-func (mr *MockAdminOptionsMockRecorder) SetMinConnectionCount(value interface{}) *gomock.Call {
+// SetAdaptiveWriteBatchEnabled indicates an expected call of SetAdaptiveWriteBatchEnabled
+func (mr *MockOptionsMockRecorder) SetAdaptiveWriteBatchEnabled(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMinConnectionCount", reflect.TypeOf((*MockAdminOptions)(nil).SetMinConnectionCount), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAdaptiveWriteBatchEnabled", reflect.TypeOf((*MockOptions)(nil).SetAdaptiveWriteBatchEnabled), value)
 }
 
-// MinConnectionCount mocks base method
-func (m *MockAdminOptions) MinConnectionCount() int {
+// AdaptiveWriteBatchEnabled mocks base method
+func (m *MockOptions) AdaptiveWriteBatchEnabled() bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MinConnectionCount")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "AdaptiveWriteBatchEnabled")
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// MinConnectionCount indicates an expected call of MinConnectionCount
-func (mr *MockAdminOptionsMockRecorder) MinConnectionCount() *gomock.Call {
+// AdaptiveWriteBatchEnabled indicates an expected call of AdaptiveWriteBatchEnabled
+func (mr *MockOptionsMockRecorder) AdaptiveWriteBatchEnabled() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MinConnectionCount", reflect.TypeOf((*MockAdminOptions)(nil).MinConnectionCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdaptiveWriteBatchEnabled", reflect.TypeOf((*MockOptions)(nil).AdaptiveWriteBatchEnabled))
 }
 
-// SetHostConnectTimeout mocks base method
-func (m *MockAdminOptions) SetHostConnectTimeout(value time.Duration) Options {
+// SetAdaptiveWriteBatchTargetLatency mocks base method
+func (m *MockOptions) SetAdaptiveWriteBatchTargetLatency(value time.Duration) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetHostConnectTimeout", value)
+	ret := m.ctrl.Call(m, "SetAdaptiveWriteBatchTargetLatency", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetHostConnectTimeout indicates an expected call of SetHostConnectTimeout
-func (mr *MockAdminOptionsMockRecorder) SetHostConnectTimeout(value interface{}) *gomock.Call {
+// SetAdaptiveWriteBatchTargetLatency indicates an expected call of SetAdaptiveWriteBatchTargetLatency
+func (mr *MockOptionsMockRecorder) SetAdaptiveWriteBatchTargetLatency(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostConnectTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetHostConnectTimeout), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAdaptiveWriteBatchTargetLatency", reflect.TypeOf((*MockOptions)(nil).SetAdaptiveWriteBatchTargetLatency), value)
 }
 
-// HostConnectTimeout mocks base method
-func (m *MockAdminOptions) HostConnectTimeout() time.Duration {
+// AdaptiveWriteBatchTargetLatency mocks base method
+func (m *MockOptions) AdaptiveWriteBatchTargetLatency() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HostConnectTimeout")
+	ret := m.ctrl.Call(m, "AdaptiveWriteBatchTargetLatency")
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// HostConnectTimeout indicates an expected call of HostConnectTimeout
-func (mr *MockAdminOptionsMockRecorder) HostConnectTimeout() *gomock.Call {
+// AdaptiveWriteBatchTargetLatency indicates an expected call of AdaptiveWriteBatchTargetLatency
+func (mr *MockOptionsMockRecorder) AdaptiveWriteBatchTargetLatency() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostConnectTimeout", reflect.TypeOf((*MockAdminOptions)(nil).HostConnectTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdaptiveWriteBatchTargetLatency", reflect.TypeOf((*MockOptions)(nil).AdaptiveWriteBatchTargetLatency))
 }
 
-// SetClusterConnectTimeout mocks base method
-func (m *MockAdminOptions) SetClusterConnectTimeout(value time.Duration) Options {
+// SetAdaptiveWriteBatchMinSize mocks base method
+func (m *MockOptions) SetAdaptiveWriteBatchMinSize(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetClusterConnectTimeout", value)
+	ret := m.ctrl.Call(m, "SetAdaptiveWriteBatchMinSize", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetClusterConnectTimeout indicates an expected call of SetClusterConnectTimeout
-func (mr *MockAdminOptionsMockRecorder) SetClusterConnectTimeout(value interface{}) *gomock.Call {
+// SetAdaptiveWriteBatchMinSize indicates an expected call of SetAdaptiveWriteBatchMinSize
+func (mr *MockOptionsMockRecorder) SetAdaptiveWriteBatchMinSize(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClusterConnectTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetClusterConnectTimeout), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAdaptiveWriteBatchMinSize", reflect.TypeOf((*MockOptions)(nil).SetAdaptiveWriteBatchMinSize), value)
 }
 
-// ClusterConnectTimeout mocks base method
-func (m *MockAdminOptions) ClusterConnectTimeout() time.Duration {
+// AdaptiveWriteBatchMinSize mocks base method
+func (m *MockOptions) AdaptiveWriteBatchMinSize() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ClusterConnectTimeout")
-	ret0, _ := ret[0].(time.Duration)
+	ret := m.ctrl.Call(m, "AdaptiveWriteBatchMinSize")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// ClusterConnectTimeout indicates an expected call of ClusterConnectTimeout
-func (mr *MockAdminOptionsMockRecorder) ClusterConnectTimeout() *gomock.Call {
+// AdaptiveWriteBatchMinSize indicates an expected call of AdaptiveWriteBatchMinSize
+func (mr *MockOptionsMockRecorder) AdaptiveWriteBatchMinSize() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterConnectTimeout", reflect.TypeOf((*MockAdminOptions)(nil).ClusterConnectTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdaptiveWriteBatchMinSize", reflect.TypeOf((*MockOptions)(nil).AdaptiveWriteBatchMinSize))
 }
 
-// SetClusterConnectConsistencyLevel mocks base method
-func (m *MockAdminOptions) SetClusterConnectConsistencyLevel(value topology.ConnectConsistencyLevel) Options {
+// SetHostQueueSaturationThreshold mocks base method
+func (m *MockOptions) SetHostQueueSaturationThreshold(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetClusterConnectConsistencyLevel", value)
+	ret := m.ctrl.Call(m, "SetHostQueueSaturationThreshold", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetClusterConnectConsistencyLevel indicates an expected call of SetClusterConnectConsistencyLevel
-func (mr *MockAdminOptionsMockRecorder) SetClusterConnectConsistencyLevel(value interface{}) *gomock.Call {
+// SetHostQueueSaturationThreshold indicates an expected call of SetHostQueueSaturationThreshold
+func (mr *MockOptionsMockRecorder) SetHostQueueSaturationThreshold(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClusterConnectConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).SetClusterConnectConsistencyLevel), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostQueueSaturationThreshold", reflect.TypeOf((*MockOptions)(nil).SetHostQueueSaturationThreshold), value)
 }
 
-// ClusterConnectConsistencyLevel mocks base method
-func (m *MockAdminOptions) ClusterConnectConsistencyLevel() topology.ConnectConsistencyLevel {
+// HostQueueSaturationThreshold mocks base method
+func (m *MockOptions) HostQueueSaturationThreshold() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ClusterConnectConsistencyLevel")
-	ret0, _ := ret[0].(topology.ConnectConsistencyLevel)
+	ret := m.ctrl.Call(m, "HostQueueSaturationThreshold")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// ClusterConnectConsistencyLevel indicates an expected call of ClusterConnectConsistencyLevel
-func (mr *MockAdminOptionsMockRecorder) ClusterConnectConsistencyLevel() *gomock.Call {
+// HostQueueSaturationThreshold indicates an expected call of HostQueueSaturationThreshold
+func (mr *MockOptionsMockRecorder) HostQueueSaturationThreshold() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterConnectConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).ClusterConnectConsistencyLevel))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueSaturationThreshold", reflect.TypeOf((*MockOptions)(nil).HostQueueSaturationThreshold))
 }
 
-// SetWriteRequestTimeout mocks base method
-func (m *MockAdminOptions) SetWriteRequestTimeout(value time.Duration) Options {
+// SetHostQueueSaturationFn mocks base method
+func (m *MockOptions) SetHostQueueSaturationFn(value HostQueueSaturationFn) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetWriteRequestTimeout", value)
+	ret := m.ctrl.Call(m, "SetHostQueueSaturationFn", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetWriteRequestTimeout indicates an expected call of SetWriteRequestTimeout
-func (mr *MockAdminOptionsMockRecorder) SetWriteRequestTimeout(value interface{}) *gomock.Call {
+// SetHostQueueSaturationFn indicates an expected call of SetHostQueueSaturationFn
+func (mr *MockOptionsMockRecorder) SetHostQueueSaturationFn(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteRequestTimeout), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostQueueSaturationFn", reflect.TypeOf((*MockOptions)(nil).SetHostQueueSaturationFn), value)
 }
 
-// WriteRequestTimeout mocks base method
-func (m *MockAdminOptions) WriteRequestTimeout() time.Duration {
+// HostQueueSaturationFn mocks base method
+func (m *MockOptions) HostQueueSaturationFn() HostQueueSaturationFn {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteRequestTimeout")
-	ret0, _ := ret[0].(time.Duration)
+	ret := m.ctrl.Call(m, "HostQueueSaturationFn")
+	ret0, _ := ret[0].(HostQueueSaturationFn)
 	return ret0
 }
 
-// WriteRequestTimeout indicates an expected call of WriteRequestTimeout
-func (mr *MockAdminOptionsMockRecorder) WriteRequestTimeout() *gomock.Call {
+// HostQueueSaturationFn indicates an expected call of HostQueueSaturationFn
+func (mr *MockOptionsMockRecorder) HostQueueSaturationFn() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).WriteRequestTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueSaturationFn", reflect.TypeOf((*MockOptions)(nil).HostQueueSaturationFn))
 }
 
-// SetFetchRequestTimeout mocks base method
-func (m *MockAdminOptions) SetFetchRequestTimeout(value time.Duration) Options {
+// SetHedgedReadsEnabled mocks base method
+func (m *MockOptions) SetHedgedReadsEnabled(value bool) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetFetchRequestTimeout", value)
+	ret := m.ctrl.Call(m, "SetHedgedReadsEnabled", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetFetchRequestTimeout indicates an expected call of SetFetchRequestTimeout
-func (mr *MockAdminOptionsMockRecorder) SetFetchRequestTimeout(value interface{}) *gomock.Call {
+// SetHedgedReadsEnabled indicates an expected call of SetHedgedReadsEnabled
+func (mr *MockOptionsMockRecorder) SetHedgedReadsEnabled(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetFetchRequestTimeout), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHedgedReadsEnabled", reflect.TypeOf((*MockOptions)(nil).SetHedgedReadsEnabled), value)
 }
 
-// FetchRequestTimeout mocks base method
-func (m *MockAdminOptions) FetchRequestTimeout() time.Duration {
+// HedgedReadsEnabled mocks base method
+func (m *MockOptions) HedgedReadsEnabled() bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchRequestTimeout")
-	ret0, _ := ret[0].(time.Duration)
+	ret := m.ctrl.Call(m, "HedgedReadsEnabled")
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// FetchRequestTimeout indicates an expected call of FetchRequestTimeout
-func (mr *MockAdminOptionsMockRecorder) FetchRequestTimeout() *gomock.Call {
+// HedgedReadsEnabled indicates an expected call of HedgedReadsEnabled
+func (mr *MockOptionsMockRecorder) HedgedReadsEnabled() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).FetchRequestTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HedgedReadsEnabled", reflect.TypeOf((*MockOptions)(nil).HedgedReadsEnabled))
 }
 
-// SetTruncateRequestTimeout mocks base method
-func (m *MockAdminOptions) SetTruncateRequestTimeout(value time.Duration) Options {
+// SetHedgedReadsDelayPercentile mocks base method
+func (m *MockOptions) SetHedgedReadsDelayPercentile(value float64) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetTruncateRequestTimeout", value)
+	ret := m.ctrl.Call(m, "SetHedgedReadsDelayPercentile", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetTruncateRequestTimeout indicates an expected call of SetTruncateRequestTimeout
-func (mr *MockAdminOptionsMockRecorder) SetTruncateRequestTimeout(value interface{}) *gomock.Call {
+// SetHedgedReadsDelayPercentile indicates an expected call of SetHedgedReadsDelayPercentile
+func (mr *MockOptionsMockRecorder) SetHedgedReadsDelayPercentile(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTruncateRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetTruncateRequestTimeout), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHedgedReadsDelayPercentile", reflect.TypeOf((*MockOptions)(nil).SetHedgedReadsDelayPercentile), value)
 }
 
-// TruncateRequestTimeout mocks base method
-func (m *MockAdminOptions) TruncateRequestTimeout() time.Duration {
+// HedgedReadsDelayPercentile mocks base method
+func (m *MockOptions) HedgedReadsDelayPercentile() float64 {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TruncateRequestTimeout")
-	ret0, _ := ret[0].(time.Duration)
+	ret := m.ctrl.Call(m, "HedgedReadsDelayPercentile")
+	ret0, _ := ret[0].(float64)
 	return ret0
 }
 
-// TruncateRequestTimeout indicates an expected call of TruncateRequestTimeout
-func (mr *MockAdminOptionsMockRecorder) TruncateRequestTimeout() *gomock.Call {
+// HedgedReadsDelayPercentile indicates an expected call of HedgedReadsDelayPercentile
+func (mr *MockOptionsMockRecorder) HedgedReadsDelayPercentile() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).TruncateRequestTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HedgedReadsDelayPercentile", reflect.TypeOf((*MockOptions)(nil).HedgedReadsDelayPercentile))
 }
 
-// SetBackgroundConnectInterval mocks base method
-func (m *MockAdminOptions) SetBackgroundConnectInterval(value time.Duration) Options {
+// SetHedgedReadsMinDelay mocks base method
+func (m *MockOptions) SetHedgedReadsMinDelay(value time.Duration) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBackgroundConnectInterval", value)
+	ret := m.ctrl.Call(m, "SetHedgedReadsMinDelay", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetBackgroundConnectInterval indicates an expected call of SetBackgroundConnectInterval
-func (mr *MockAdminOptionsMockRecorder) SetBackgroundConnectInterval(value interface{}) *gomock.Call {
+// SetHedgedReadsMinDelay indicates an expected call of SetHedgedReadsMinDelay
+func (mr *MockOptionsMockRecorder) SetHedgedReadsMinDelay(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundConnectInterval", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundConnectInterval), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHedgedReadsMinDelay", reflect.TypeOf((*MockOptions)(nil).SetHedgedReadsMinDelay), value)
 }
 
-// BackgroundConnectInterval mocks base method
-func (m *MockAdminOptions) BackgroundConnectInterval() time.Duration {
+// HedgedReadsMinDelay mocks base method
+func (m *MockOptions) HedgedReadsMinDelay() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BackgroundConnectInterval")
+	ret := m.ctrl.Call(m, "HedgedReadsMinDelay")
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// BackgroundConnectInterval indicates an expected call of BackgroundConnectInterval
-func (mr *MockAdminOptionsMockRecorder) BackgroundConnectInterval() *gomock.Call {
+// HedgedReadsMinDelay indicates an expected call of HedgedReadsMinDelay
+func (mr *MockOptionsMockRecorder) HedgedReadsMinDelay() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundConnectInterval", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundConnectInterval))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HedgedReadsMinDelay", reflect.TypeOf((*MockOptions)(nil).HedgedReadsMinDelay))
 }
 
-// SetBackgroundConnectStutter mocks base method
-func (m *MockAdminOptions) SetBackgroundConnectStutter(value time.Duration) Options {
+// SetPreferredReadIsolationGroup mocks base method
+func (m *MockOptions) SetPreferredReadIsolationGroup(value string) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBackgroundConnectStutter", value)
+	ret := m.ctrl.Call(m, "SetPreferredReadIsolationGroup", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetBackgroundConnectStutter indicates an expected call of SetBackgroundConnectStutter
-func (mr *MockAdminOptionsMockRecorder) SetBackgroundConnectStutter(value interface{}) *gomock.Call {
+// SetPreferredReadIsolationGroup indicates an expected call of SetPreferredReadIsolationGroup
+func (mr *MockOptionsMockRecorder) SetPreferredReadIsolationGroup(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundConnectStutter", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundConnectStutter), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreferredReadIsolationGroup", reflect.TypeOf((*MockOptions)(nil).SetPreferredReadIsolationGroup), value)
 }
 
-// BackgroundConnectStutter mocks base method
-func (m *MockAdminOptions) BackgroundConnectStutter() time.Duration {
+// PreferredReadIsolationGroup mocks base method
+func (m *MockOptions) PreferredReadIsolationGroup() string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BackgroundConnectStutter")
-	ret0, _ := ret[0].(time.Duration)
+	ret := m.ctrl.Call(m, "PreferredReadIsolationGroup")
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// BackgroundConnectStutter indicates an expected call of BackgroundConnectStutter
-func (mr *MockAdminOptionsMockRecorder) BackgroundConnectStutter() *gomock.Call {
+// PreferredReadIsolationGroup indicates an expected call of PreferredReadIsolationGroup
+func (mr *MockOptionsMockRecorder) PreferredReadIsolationGroup() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundConnectStutter", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundConnectStutter))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreferredReadIsolationGroup", reflect.TypeOf((*MockOptions)(nil).PreferredReadIsolationGroup))
 }
 
-// SetBackgroundHealthCheckInterval mocks base method
-func (m *MockAdminOptions) SetBackgroundHealthCheckInterval(value time.Duration) Options {
+// MockAdminOptions is a mock of AdminOptions interface
+type MockAdminOptions struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminOptionsMockRecorder
+}
+
+// MockAdminOptionsMockRecorder is the mock recorder for MockAdminOptions
+type MockAdminOptionsMockRecorder struct {
+	mock *MockAdminOptions
+}
+
+// NewMockAdminOptions creates a new mock instance
+func NewMockAdminOptions(ctrl *gomock.Controller) *MockAdminOptions {
+	mock := &MockAdminOptions{ctrl: ctrl}
+	mock.recorder = &MockAdminOptionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAdminOptions) EXPECT() *MockAdminOptionsMockRecorder {
+	return m.recorder
+}
+
+// Validate mocks base method
+func (m *MockAdminOptions) Validate() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBackgroundHealthCheckInterval", value)
-	ret0, _ := ret[0].(Options)
+	ret := m.ctrl.Call(m, "Validate")
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetBackgroundHealthCheckInterval indicates an expected call of SetBackgroundHealthCheckInterval
-func (mr *MockAdminOptionsMockRecorder) SetBackgroundHealthCheckInterval(value interface{}) *gomock.Call {
+// Validate indicates an expected call of Validate
+func (mr *MockAdminOptionsMockRecorder) Validate() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundHealthCheckInterval", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundHealthCheckInterval), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockAdminOptions)(nil).Validate))
 }
 
-// BackgroundHealthCheckInterval mocks base method
-func (m *MockAdminOptions) BackgroundHealthCheckInterval() time.Duration {
+// SetEncodingM3TSZ mocks base method
+func (m *MockAdminOptions) SetEncodingM3TSZ() Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BackgroundHealthCheckInterval")
-	ret0, _ := ret[0].(time.Duration)
+	ret := m.ctrl.Call(m, "SetEncodingM3TSZ")
+	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// BackgroundHealthCheckInterval indicates an expected call of BackgroundHealthCheckInterval
-func (mr *MockAdminOptionsMockRecorder) BackgroundHealthCheckInterval() *gomock.Call {
+// SetEncodingM3TSZ indicates an expected call of SetEncodingM3TSZ
+func (mr *MockAdminOptionsMockRecorder) SetEncodingM3TSZ() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundHealthCheckInterval", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundHealthCheckInterval))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEncodingM3TSZ", reflect.TypeOf((*MockAdminOptions)(nil).SetEncodingM3TSZ))
 }
 
-// SetBackgroundHealthCheckStutter mocks base method
-func (m *MockAdminOptions) SetBackgroundHealthCheckStutter(value time.Duration) Options {
+// SetEncodingProto mocks base method
+func (m *MockAdminOptions) SetEncodingProto(encodingOpts encoding.Options) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBackgroundHealthCheckStutter", value)
+	ret := m.ctrl.Call(m, "SetEncodingProto", encodingOpts)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetBackgroundHealthCheckStutter indicates an expected call of SetBackgroundHealthCheckStutter
-func (mr *MockAdminOptionsMockRecorder) SetBackgroundHealthCheckStutter(value interface{}) *gomock.Call {
+// SetEncodingProto indicates an expected call of SetEncodingProto
+func (mr *MockAdminOptionsMockRecorder) SetEncodingProto(encodingOpts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundHealthCheckStutter", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundHealthCheckStutter), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEncodingProto", reflect.TypeOf((*MockAdminOptions)(nil).SetEncodingProto), encodingOpts)
 }
 
-// BackgroundHealthCheckStutter mocks base method
-func (m *MockAdminOptions) BackgroundHealthCheckStutter() time.Duration {
+// IsSetEncodingProto mocks base method
+func (m *MockAdminOptions) IsSetEncodingProto() bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BackgroundHealthCheckStutter")
-	ret0, _ := ret[0].(time.Duration)
+	ret := m.ctrl.Call(m, "IsSetEncodingProto")
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// BackgroundHealthCheckStutter indicates an expected call of BackgroundHealthCheckStutter
-func (mr *MockAdminOptionsMockRecorder) BackgroundHealthCheckStutter() *gomock.Call {
+// IsSetEncodingProto indicates an expected call of IsSetEncodingProto
+func (mr *MockAdminOptionsMockRecorder) IsSetEncodingProto() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundHealthCheckStutter", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundHealthCheckStutter))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSetEncodingProto", reflect.TypeOf((*MockAdminOptions)(nil).IsSetEncodingProto))
 }
 
-// SetBackgroundHealthCheckFailLimit mocks base method
-func (m *MockAdminOptions) SetBackgroundHealthCheckFailLimit(value int) Options {
+// SetRuntimeOptionsManager mocks base method
+func (m *MockAdminOptions) SetRuntimeOptionsManager(value runtime.OptionsManager) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBackgroundHealthCheckFailLimit", value)
+	ret := m.ctrl.Call(m, "SetRuntimeOptionsManager", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetBackgroundHealthCheckFailLimit indicates an expected call of SetBackgroundHealthCheckFailLimit
-func (mr *MockAdminOptionsMockRecorder) SetBackgroundHealthCheckFailLimit(value interface{}) *gomock.Call {
+// SetRuntimeOptionsManager indicates an expected call of SetRuntimeOptionsManager
+func (mr *MockAdminOptionsMockRecorder) SetRuntimeOptionsManager(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundHealthCheckFailLimit", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundHealthCheckFailLimit), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRuntimeOptionsManager", reflect.TypeOf((*MockAdminOptions)(nil).SetRuntimeOptionsManager), value)
 }
 
-// BackgroundHealthCheckFailLimit mocks base method
-func (m *MockAdminOptions) BackgroundHealthCheckFailLimit() int {
+// RuntimeOptionsManager mocks base method
+func (m *MockAdminOptions) RuntimeOptionsManager() runtime.OptionsManager {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BackgroundHealthCheckFailLimit")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "RuntimeOptionsManager")
+	ret0, _ := ret[0].(runtime.OptionsManager)
 	return ret0
 }
 
-// BackgroundHealthCheckFailLimit indicates an expected call of BackgroundHealthCheckFailLimit
-func (mr *MockAdminOptionsMockRecorder) BackgroundHealthCheckFailLimit() *gomock.Call {
+// RuntimeOptionsManager indicates an expected call of RuntimeOptionsManager
+func (mr *MockAdminOptionsMockRecorder) RuntimeOptionsManager() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundHealthCheckFailLimit", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundHealthCheckFailLimit))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RuntimeOptionsManager", reflect.TypeOf((*MockAdminOptions)(nil).RuntimeOptionsManager))
 }
 
-// SetBackgroundHealthCheckFailThrottleFactor mocks base method
-func (m *MockAdminOptions) SetBackgroundHealthCheckFailThrottleFactor(value float64) Options {
+// SetClockOptions mocks base method
+func (m *MockAdminOptions) SetClockOptions(value clock.Options) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBackgroundHealthCheckFailThrottleFactor", value)
+	ret := m.ctrl.Call(m, "SetClockOptions", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetBackgroundHealthCheckFailThrottleFactor indicates an expected call of SetBackgroundHealthCheckFailThrottleFactor
-func (mr *MockAdminOptionsMockRecorder) SetBackgroundHealthCheckFailThrottleFactor(value interface{}) *gomock.Call {
+// SetClockOptions indicates an expected call of SetClockOptions
+func (mr *MockAdminOptionsMockRecorder) SetClockOptions(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundHealthCheckFailThrottleFactor", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundHealthCheckFailThrottleFactor), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClockOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetClockOptions), value)
 }
 
-// BackgroundHealthCheckFailThrottleFactor mocks base method
-func (m *MockAdminOptions) BackgroundHealthCheckFailThrottleFactor() float64 {
+// ClockOptions mocks base method
+func (m *MockAdminOptions) ClockOptions() clock.Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BackgroundHealthCheckFailThrottleFactor")
-	ret0, _ := ret[0].(float64)
+	ret := m.ctrl.Call(m, "ClockOptions")
+	ret0, _ := ret[0].(clock.Options)
 	return ret0
 }
 
-// BackgroundHealthCheckFailThrottleFactor indicates an expected call of BackgroundHealthCheckFailThrottleFactor
-func (mr *MockAdminOptionsMockRecorder) BackgroundHealthCheckFailThrottleFactor() *gomock.Call {
+// ClockOptions indicates an expected call of ClockOptions
+func (mr *MockAdminOptionsMockRecorder) ClockOptions() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundHealthCheckFailThrottleFactor", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundHealthCheckFailThrottleFactor))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockOptions", reflect.TypeOf((*MockAdminOptions)(nil).ClockOptions))
 }
 
-// SetWriteRetrier mocks base method
-func (m *MockAdminOptions) SetWriteRetrier(value retry.Retrier) Options {
+// SetInstrumentOptions mocks base method
+func (m *MockAdminOptions) SetInstrumentOptions(value instrument.Options) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetWriteRetrier", value)
+	ret := m.ctrl.Call(m, "SetInstrumentOptions", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetWriteRetrier indicates an expected call of SetWriteRetrier
-func (mr *MockAdminOptionsMockRecorder) SetWriteRetrier(value interface{}) *gomock.Call {
+// SetInstrumentOptions indicates an expected call of SetInstrumentOptions
+func (mr *MockAdminOptionsMockRecorder) SetInstrumentOptions(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteRetrier", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteRetrier), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInstrumentOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetInstrumentOptions), value)
 }
 
-// WriteRetrier mocks base method
-func (m *MockAdminOptions) WriteRetrier() retry.Retrier {
+// InstrumentOptions mocks base method
+func (m *MockAdminOptions) InstrumentOptions() instrument.Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteRetrier")
-	ret0, _ := ret[0].(retry.Retrier)
+	ret := m.ctrl.Call(m, "InstrumentOptions")
+	ret0, _ := ret[0].(instrument.Options)
 	return ret0
 }
 
-// WriteRetrier indicates an expected call of WriteRetrier
-func (mr *MockAdminOptionsMockRecorder) WriteRetrier() *gomock.Call {
+// InstrumentOptions indicates an expected call of InstrumentOptions
+func (mr *MockAdminOptionsMockRecorder) InstrumentOptions() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteRetrier", reflect.TypeOf((*MockAdminOptions)(nil).WriteRetrier))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstrumentOptions", reflect.TypeOf((*MockAdminOptions)(nil).InstrumentOptions))
 }
 
-// SetFetchRetrier mocks base method
-func (m *MockAdminOptions) SetFetchRetrier(value retry.Retrier) Options {
+// SetLogErrorSampleRate mocks base method
+func (m *MockAdminOptions) SetLogErrorSampleRate(value sampler.Rate) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetFetchRetrier", value)
+	ret := m.ctrl.Call(m, "SetLogErrorSampleRate", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetFetchRetrier indicates an expected call of SetFetchRetrier
-func (mr *MockAdminOptionsMockRecorder) SetFetchRetrier(value interface{}) *gomock.Call {
+// SetLogErrorSampleRate indicates an expected call of SetLogErrorSampleRate
+func (mr *MockAdminOptionsMockRecorder) SetLogErrorSampleRate(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchRetrier", reflect.TypeOf((*MockAdminOptions)(nil).SetFetchRetrier), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLogErrorSampleRate", reflect.TypeOf((*MockAdminOptions)(nil).SetLogErrorSampleRate), value)
 }
 
-// FetchRetrier mocks base method
-func (m *MockAdminOptions) FetchRetrier() retry.Retrier {
+// LogErrorSampleRate mocks base method
+func (m *MockAdminOptions) LogErrorSampleRate() sampler.Rate {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchRetrier")
-	ret0, _ := ret[0].(retry.Retrier)
+	ret := m.ctrl.Call(m, "LogErrorSampleRate")
+	ret0, _ := ret[0].(sampler.Rate)
 	return ret0
 }
 
-// FetchRetrier indicates an expected call of FetchRetrier
-func (mr *MockAdminOptionsMockRecorder) FetchRetrier() *gomock.Call {
+// LogErrorSampleRate indicates an expected call of LogErrorSampleRate
+func (mr *MockAdminOptionsMockRecorder) LogErrorSampleRate() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchRetrier", reflect.TypeOf((*MockAdminOptions)(nil).FetchRetrier))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogErrorSampleRate", reflect.TypeOf((*MockAdminOptions)(nil).LogErrorSampleRate))
 }
 
-// SetTagEncoderOptions mocks base method
-func (m *MockAdminOptions) SetTagEncoderOptions(value serialize.TagEncoderOptions) Options {
+// SetTopologyInitializer mocks base method
+func (m *MockAdminOptions) SetTopologyInitializer(value topology.Initializer) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTopologyInitializer", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTopologyInitializer indicates an expected call of SetTopologyInitializer
+func (mr *MockAdminOptionsMockRecorder) SetTopologyInitializer(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTopologyInitializer", reflect.TypeOf((*MockAdminOptions)(nil).SetTopologyInitializer), value)
+}
+
+// TopologyInitializer mocks base method
+func (m *MockAdminOptions) TopologyInitializer() topology.Initializer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopologyInitializer")
+	ret0, _ := ret[0].(topology.Initializer)
+	return ret0
+}
+
+// TopologyInitializer indicates an expected call of TopologyInitializer
+func (mr *MockAdminOptionsMockRecorder) TopologyInitializer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopologyInitializer", reflect.TypeOf((*MockAdminOptions)(nil).TopologyInitializer))
+}
+
+// SetReadConsistencyLevel mocks base method
+func (m *MockAdminOptions) SetReadConsistencyLevel(value topology.ReadConsistencyLevel) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadConsistencyLevel", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadConsistencyLevel indicates an expected call of SetReadConsistencyLevel
+func (mr *MockAdminOptionsMockRecorder) SetReadConsistencyLevel(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).SetReadConsistencyLevel), value)
+}
+
+// ReadConsistencyLevel mocks base method
+func (m *MockAdminOptions) ReadConsistencyLevel() topology.ReadConsistencyLevel {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadConsistencyLevel")
+	ret0, _ := ret[0].(topology.ReadConsistencyLevel)
+	return ret0
+}
+
+// ReadConsistencyLevel indicates an expected call of ReadConsistencyLevel
+func (mr *MockAdminOptionsMockRecorder) ReadConsistencyLevel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).ReadConsistencyLevel))
+}
+
+// SetWriteConsistencyLevel mocks base method
+func (m *MockAdminOptions) SetWriteConsistencyLevel(value topology.ConsistencyLevel) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteConsistencyLevel", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetWriteConsistencyLevel indicates an expected call of SetWriteConsistencyLevel
+func (mr *MockAdminOptionsMockRecorder) SetWriteConsistencyLevel(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteConsistencyLevel), value)
+}
+
+// WriteConsistencyLevel mocks base method
+func (m *MockAdminOptions) WriteConsistencyLevel() topology.ConsistencyLevel {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteConsistencyLevel")
+	ret0, _ := ret[0].(topology.ConsistencyLevel)
+	return ret0
+}
+
+// WriteConsistencyLevel indicates an expected call of WriteConsistencyLevel
+func (mr *MockAdminOptionsMockRecorder) WriteConsistencyLevel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).WriteConsistencyLevel))
+}
+
+// SetChannelOptions mocks base method
+func (m *MockAdminOptions) SetChannelOptions(value *tchannel_go.ChannelOptions) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChannelOptions", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetChannelOptions indicates an expected call of SetChannelOptions
+func (mr *MockAdminOptionsMockRecorder) SetChannelOptions(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetChannelOptions), value)
+}
+
+// ChannelOptions mocks base method
+func (m *MockAdminOptions) ChannelOptions() *tchannel_go.ChannelOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChannelOptions")
+	ret0, _ := ret[0].(*tchannel_go.ChannelOptions)
+	return ret0
+}
+
+// ChannelOptions indicates an expected call of ChannelOptions
+func (mr *MockAdminOptionsMockRecorder) ChannelOptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChannelOptions", reflect.TypeOf((*MockAdminOptions)(nil).ChannelOptions))
+}
+
+// SetMaxConnectionCount mocks base method
+func (m *MockAdminOptions) SetMaxConnectionCount(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxConnectionCount", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetMaxConnectionCount indicates an expected call of SetMaxConnectionCount
+func (mr *MockAdminOptionsMockRecorder) SetMaxConnectionCount(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxConnectionCount", reflect.TypeOf((*MockAdminOptions)(nil).SetMaxConnectionCount), value)
+}
+
+// MaxConnectionCount mocks base method
+func (m *MockAdminOptions) MaxConnectionCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxConnectionCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// MaxConnectionCount indicates an expected call of MaxConnectionCount
+func (mr *MockAdminOptionsMockRecorder) MaxConnectionCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxConnectionCount", reflect.TypeOf((*MockAdminOptions)(nil).MaxConnectionCount))
+}
+
+// SetMinConnectionCount mocks base method
+func (m *MockAdminOptions) SetMinConnectionCount(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMinConnectionCount", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetMinConnectionCount indicates an expected call of SetMinConnectionCount
+func (mr *MockAdminOptionsMockRecorder) SetMinConnectionCount(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMinConnectionCount", reflect.TypeOf((*MockAdminOptions)(nil).SetMinConnectionCount), value)
+}
+
+// MinConnectionCount mocks base method
+func (m *MockAdminOptions) MinConnectionCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MinConnectionCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// MinConnectionCount indicates an expected call of MinConnectionCount
+func (mr *MockAdminOptionsMockRecorder) MinConnectionCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MinConnectionCount", reflect.TypeOf((*MockAdminOptions)(nil).MinConnectionCount))
+}
+
+// SetHostConnectTimeout mocks base method
+func (m *MockAdminOptions) SetHostConnectTimeout(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHostConnectTimeout", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHostConnectTimeout indicates an expected call of SetHostConnectTimeout
+func (mr *MockAdminOptionsMockRecorder) SetHostConnectTimeout(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostConnectTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetHostConnectTimeout), value)
+}
+
+// HostConnectTimeout mocks base method
+func (m *MockAdminOptions) HostConnectTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostConnectTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// HostConnectTimeout indicates an expected call of HostConnectTimeout
+func (mr *MockAdminOptionsMockRecorder) HostConnectTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostConnectTimeout", reflect.TypeOf((*MockAdminOptions)(nil).HostConnectTimeout))
+}
+
+// SetClusterConnectTimeout mocks base method
+func (m *MockAdminOptions) SetClusterConnectTimeout(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetClusterConnectTimeout", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetClusterConnectTimeout indicates an expected call of SetClusterConnectTimeout
+func (mr *MockAdminOptionsMockRecorder) SetClusterConnectTimeout(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClusterConnectTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetClusterConnectTimeout), value)
+}
+
+// ClusterConnectTimeout mocks base method
+func (m *MockAdminOptions) ClusterConnectTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterConnectTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// ClusterConnectTimeout indicates an expected call of ClusterConnectTimeout
+func (mr *MockAdminOptionsMockRecorder) ClusterConnectTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterConnectTimeout", reflect.TypeOf((*MockAdminOptions)(nil).ClusterConnectTimeout))
+}
+
+// SetClusterConnectConsistencyLevel mocks base method
+func (m *MockAdminOptions) SetClusterConnectConsistencyLevel(value topology.ConnectConsistencyLevel) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetClusterConnectConsistencyLevel", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetClusterConnectConsistencyLevel indicates an expected call of SetClusterConnectConsistencyLevel
+func (mr *MockAdminOptionsMockRecorder) SetClusterConnectConsistencyLevel(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClusterConnectConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).SetClusterConnectConsistencyLevel), value)
+}
+
+// ClusterConnectConsistencyLevel mocks base method
+func (m *MockAdminOptions) ClusterConnectConsistencyLevel() topology.ConnectConsistencyLevel {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterConnectConsistencyLevel")
+	ret0, _ := ret[0].(topology.ConnectConsistencyLevel)
+	return ret0
+}
+
+// ClusterConnectConsistencyLevel indicates an expected call of ClusterConnectConsistencyLevel
+func (mr *MockAdminOptionsMockRecorder) ClusterConnectConsistencyLevel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterConnectConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).ClusterConnectConsistencyLevel))
+}
+
+// SetWriteRequestTimeout mocks base method
+func (m *MockAdminOptions) SetWriteRequestTimeout(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteRequestTimeout", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetWriteRequestTimeout indicates an expected call of SetWriteRequestTimeout
+func (mr *MockAdminOptionsMockRecorder) SetWriteRequestTimeout(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteRequestTimeout), value)
+}
+
+// WriteRequestTimeout mocks base method
+func (m *MockAdminOptions) WriteRequestTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteRequestTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// WriteRequestTimeout indicates an expected call of WriteRequestTimeout
+func (mr *MockAdminOptionsMockRecorder) WriteRequestTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).WriteRequestTimeout))
+}
+
+// SetFetchRequestTimeout mocks base method
+func (m *MockAdminOptions) SetFetchRequestTimeout(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFetchRequestTimeout", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFetchRequestTimeout indicates an expected call of SetFetchRequestTimeout
+func (mr *MockAdminOptionsMockRecorder) SetFetchRequestTimeout(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetFetchRequestTimeout), value)
+}
+
+// FetchRequestTimeout mocks base method
+func (m *MockAdminOptions) FetchRequestTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchRequestTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// FetchRequestTimeout indicates an expected call of FetchRequestTimeout
+func (mr *MockAdminOptionsMockRecorder) FetchRequestTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).FetchRequestTimeout))
+}
+
+// SetTruncateRequestTimeout mocks base method
+func (m *MockAdminOptions) SetTruncateRequestTimeout(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTruncateRequestTimeout", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTruncateRequestTimeout indicates an expected call of SetTruncateRequestTimeout
+func (mr *MockAdminOptionsMockRecorder) SetTruncateRequestTimeout(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTruncateRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetTruncateRequestTimeout), value)
+}
+
+// TruncateRequestTimeout mocks base method
+func (m *MockAdminOptions) TruncateRequestTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TruncateRequestTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// TruncateRequestTimeout indicates an expected call of TruncateRequestTimeout
+func (mr *MockAdminOptionsMockRecorder) TruncateRequestTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).TruncateRequestTimeout))
+}
+
+// SetDeleteTaggedRequestTimeout mocks base method
+func (m *MockAdminOptions) SetDeleteTaggedRequestTimeout(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeleteTaggedRequestTimeout", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetDeleteTaggedRequestTimeout indicates an expected call of SetDeleteTaggedRequestTimeout
+func (mr *MockAdminOptionsMockRecorder) SetDeleteTaggedRequestTimeout(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeleteTaggedRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetDeleteTaggedRequestTimeout), value)
+}
+
+// DeleteTaggedRequestTimeout mocks base method
+func (m *MockAdminOptions) DeleteTaggedRequestTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaggedRequestTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DeleteTaggedRequestTimeout indicates an expected call of DeleteTaggedRequestTimeout
+func (mr *MockAdminOptionsMockRecorder) DeleteTaggedRequestTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaggedRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).DeleteTaggedRequestTimeout))
+}
+
+// SetBackgroundConnectInterval mocks base method
+func (m *MockAdminOptions) SetBackgroundConnectInterval(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBackgroundConnectInterval", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetBackgroundConnectInterval indicates an expected call of SetBackgroundConnectInterval
+func (mr *MockAdminOptionsMockRecorder) SetBackgroundConnectInterval(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundConnectInterval", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundConnectInterval), value)
+}
+
+// BackgroundConnectInterval mocks base method
+func (m *MockAdminOptions) BackgroundConnectInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackgroundConnectInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackgroundConnectInterval indicates an expected call of BackgroundConnectInterval
+func (mr *MockAdminOptionsMockRecorder) BackgroundConnectInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundConnectInterval", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundConnectInterval))
+}
+
+// SetBackgroundConnectStutter mocks base method
+func (m *MockAdminOptions) SetBackgroundConnectStutter(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBackgroundConnectStutter", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetBackgroundConnectStutter indicates an expected call of SetBackgroundConnectStutter
+func (mr *MockAdminOptionsMockRecorder) SetBackgroundConnectStutter(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundConnectStutter", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundConnectStutter), value)
+}
+
+// BackgroundConnectStutter mocks base method
+func (m *MockAdminOptions) BackgroundConnectStutter() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackgroundConnectStutter")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackgroundConnectStutter indicates an expected call of BackgroundConnectStutter
+func (mr *MockAdminOptionsMockRecorder) BackgroundConnectStutter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundConnectStutter", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundConnectStutter))
+}
+
+// SetBackgroundHealthCheckInterval mocks base method
+func (m *MockAdminOptions) SetBackgroundHealthCheckInterval(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBackgroundHealthCheckInterval", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetBackgroundHealthCheckInterval indicates an expected call of SetBackgroundHealthCheckInterval
+func (mr *MockAdminOptionsMockRecorder) SetBackgroundHealthCheckInterval(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundHealthCheckInterval", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundHealthCheckInterval), value)
+}
+
+// BackgroundHealthCheckInterval mocks base method
+func (m *MockAdminOptions) BackgroundHealthCheckInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackgroundHealthCheckInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackgroundHealthCheckInterval indicates an expected call of BackgroundHealthCheckInterval
+func (mr *MockAdminOptionsMockRecorder) BackgroundHealthCheckInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundHealthCheckInterval", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundHealthCheckInterval))
+}
+
+// SetBackgroundHealthCheckStutter mocks base method
+func (m *MockAdminOptions) SetBackgroundHealthCheckStutter(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBackgroundHealthCheckStutter", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetBackgroundHealthCheckStutter indicates an expected call of SetBackgroundHealthCheckStutter
+func (mr *MockAdminOptionsMockRecorder) SetBackgroundHealthCheckStutter(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundHealthCheckStutter", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundHealthCheckStutter), value)
+}
+
+// BackgroundHealthCheckStutter mocks base method
+func (m *MockAdminOptions) BackgroundHealthCheckStutter() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackgroundHealthCheckStutter")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackgroundHealthCheckStutter indicates an expected call of BackgroundHealthCheckStutter
+func (mr *MockAdminOptionsMockRecorder) BackgroundHealthCheckStutter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundHealthCheckStutter", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundHealthCheckStutter))
+}
+
+// SetBackgroundHealthCheckFailLimit mocks base method
+func (m *MockAdminOptions) SetBackgroundHealthCheckFailLimit(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBackgroundHealthCheckFailLimit", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetBackgroundHealthCheckFailLimit indicates an expected call of SetBackgroundHealthCheckFailLimit
+func (mr *MockAdminOptionsMockRecorder) SetBackgroundHealthCheckFailLimit(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundHealthCheckFailLimit", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundHealthCheckFailLimit), value)
+}
+
+// BackgroundHealthCheckFailLimit mocks base method
+func (m *MockAdminOptions) BackgroundHealthCheckFailLimit() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackgroundHealthCheckFailLimit")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// BackgroundHealthCheckFailLimit indicates an expected call of BackgroundHealthCheckFailLimit
+func (mr *MockAdminOptionsMockRecorder) BackgroundHealthCheckFailLimit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundHealthCheckFailLimit", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundHealthCheckFailLimit))
+}
+
+// SetBackgroundHealthCheckFailThrottleFactor mocks base method
+func (m *MockAdminOptions) SetBackgroundHealthCheckFailThrottleFactor(value float64) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBackgroundHealthCheckFailThrottleFactor", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetBackgroundHealthCheckFailThrottleFactor indicates an expected call of SetBackgroundHealthCheckFailThrottleFactor
+func (mr *MockAdminOptionsMockRecorder) SetBackgroundHealthCheckFailThrottleFactor(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundHealthCheckFailThrottleFactor", reflect.TypeOf((*MockAdminOptions)(nil).SetBackgroundHealthCheckFailThrottleFactor), value)
+}
+
+// BackgroundHealthCheckFailThrottleFactor mocks base method
+func (m *MockAdminOptions) BackgroundHealthCheckFailThrottleFactor() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackgroundHealthCheckFailThrottleFactor")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// BackgroundHealthCheckFailThrottleFactor indicates an expected call of BackgroundHealthCheckFailThrottleFactor
+func (mr *MockAdminOptionsMockRecorder) BackgroundHealthCheckFailThrottleFactor() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundHealthCheckFailThrottleFactor", reflect.TypeOf((*MockAdminOptions)(nil).BackgroundHealthCheckFailThrottleFactor))
+}
+
+// SetWriteRetrier mocks base method
+func (m *MockAdminOptions) SetWriteRetrier(value retry.Retrier) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteRetrier", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetWriteRetrier indicates an expected call of SetWriteRetrier
+func (mr *MockAdminOptionsMockRecorder) SetWriteRetrier(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteRetrier", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteRetrier), value)
+}
+
+// WriteRetrier mocks base method
+func (m *MockAdminOptions) WriteRetrier() retry.Retrier {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteRetrier")
+	ret0, _ := ret[0].(retry.Retrier)
+	return ret0
+}
+
+// WriteRetrier indicates an expected call of WriteRetrier
+func (mr *MockAdminOptionsMockRecorder) WriteRetrier() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteRetrier", reflect.TypeOf((*MockAdminOptions)(nil).WriteRetrier))
+}
+
+// SetFetchRetrier mocks base method
+func (m *MockAdminOptions) SetFetchRetrier(value retry.Retrier) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFetchRetrier", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFetchRetrier indicates an expected call of SetFetchRetrier
+func (mr *MockAdminOptionsMockRecorder) SetFetchRetrier(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchRetrier", reflect.TypeOf((*MockAdminOptions)(nil).SetFetchRetrier), value)
+}
+
+// FetchRetrier mocks base method
+func (m *MockAdminOptions) FetchRetrier() retry.Retrier {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchRetrier")
+	ret0, _ := ret[0].(retry.Retrier)
+	return ret0
+}
+
+// FetchRetrier indicates an expected call of FetchRetrier
+func (mr *MockAdminOptionsMockRecorder) FetchRetrier() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchRetrier", reflect.TypeOf((*MockAdminOptions)(nil).FetchRetrier))
+}
+
+// SetTagEncoderOptions mocks base method
+func (m *MockAdminOptions) SetTagEncoderOptions(value serialize.TagEncoderOptions) Options {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetTagEncoderOptions", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetTagEncoderOptions indicates an expected call of SetTagEncoderOptions
-func (mr *MockAdminOptionsMockRecorder) SetTagEncoderOptions(value interface{}) *gomock.Call {
+// SetTagEncoderOptions indicates an expected call of SetTagEncoderOptions
+func (mr *MockAdminOptionsMockRecorder) SetTagEncoderOptions(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagEncoderOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetTagEncoderOptions), value)
+}
+
+// TagEncoderOptions mocks base method
+func (m *MockAdminOptions) TagEncoderOptions() serialize.TagEncoderOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagEncoderOptions")
+	ret0, _ := ret[0].(serialize.TagEncoderOptions)
+	return ret0
+}
+
+// TagEncoderOptions indicates an expected call of TagEncoderOptions
+func (mr *MockAdminOptionsMockRecorder) TagEncoderOptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagEncoderOptions", reflect.TypeOf((*MockAdminOptions)(nil).TagEncoderOptions))
+}
+
+// SetTagEncoderPoolSize mocks base method
+func (m *MockAdminOptions) SetTagEncoderPoolSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTagEncoderPoolSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTagEncoderPoolSize indicates an expected call of SetTagEncoderPoolSize
+func (mr *MockAdminOptionsMockRecorder) SetTagEncoderPoolSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagEncoderPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetTagEncoderPoolSize), value)
+}
+
+// TagEncoderPoolSize mocks base method
+func (m *MockAdminOptions) TagEncoderPoolSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagEncoderPoolSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// TagEncoderPoolSize indicates an expected call of TagEncoderPoolSize
+func (mr *MockAdminOptionsMockRecorder) TagEncoderPoolSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagEncoderPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).TagEncoderPoolSize))
+}
+
+// SetTagDecoderOptions mocks base method
+func (m *MockAdminOptions) SetTagDecoderOptions(value serialize.TagDecoderOptions) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTagDecoderOptions", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTagDecoderOptions indicates an expected call of SetTagDecoderOptions
+func (mr *MockAdminOptionsMockRecorder) SetTagDecoderOptions(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagDecoderOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetTagDecoderOptions), value)
+}
+
+// TagDecoderOptions mocks base method
+func (m *MockAdminOptions) TagDecoderOptions() serialize.TagDecoderOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagDecoderOptions")
+	ret0, _ := ret[0].(serialize.TagDecoderOptions)
+	return ret0
+}
+
+// TagDecoderOptions indicates an expected call of TagDecoderOptions
+func (mr *MockAdminOptionsMockRecorder) TagDecoderOptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagDecoderOptions", reflect.TypeOf((*MockAdminOptions)(nil).TagDecoderOptions))
+}
+
+// SetTagDecoderPoolSize mocks base method
+func (m *MockAdminOptions) SetTagDecoderPoolSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTagDecoderPoolSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTagDecoderPoolSize indicates an expected call of SetTagDecoderPoolSize
+func (mr *MockAdminOptionsMockRecorder) SetTagDecoderPoolSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagDecoderPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetTagDecoderPoolSize), value)
+}
+
+// TagDecoderPoolSize mocks base method
+func (m *MockAdminOptions) TagDecoderPoolSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagDecoderPoolSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// TagDecoderPoolSize indicates an expected call of TagDecoderPoolSize
+func (mr *MockAdminOptionsMockRecorder) TagDecoderPoolSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagDecoderPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).TagDecoderPoolSize))
+}
+
+// SetWriteBatchSize mocks base method
+func (m *MockAdminOptions) SetWriteBatchSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteBatchSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetWriteBatchSize indicates an expected call of SetWriteBatchSize
+func (mr *MockAdminOptionsMockRecorder) SetWriteBatchSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteBatchSize", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteBatchSize), value)
+}
+
+// WriteBatchSize mocks base method
+func (m *MockAdminOptions) WriteBatchSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteBatchSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// WriteBatchSize indicates an expected call of WriteBatchSize
+func (mr *MockAdminOptionsMockRecorder) WriteBatchSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteBatchSize", reflect.TypeOf((*MockAdminOptions)(nil).WriteBatchSize))
+}
+
+// SetFetchBatchSize mocks base method
+func (m *MockAdminOptions) SetFetchBatchSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFetchBatchSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFetchBatchSize indicates an expected call of SetFetchBatchSize
+func (mr *MockAdminOptionsMockRecorder) SetFetchBatchSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchBatchSize", reflect.TypeOf((*MockAdminOptions)(nil).SetFetchBatchSize), value)
+}
+
+// FetchBatchSize mocks base method
+func (m *MockAdminOptions) FetchBatchSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBatchSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// FetchBatchSize indicates an expected call of FetchBatchSize
+func (mr *MockAdminOptionsMockRecorder) FetchBatchSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBatchSize", reflect.TypeOf((*MockAdminOptions)(nil).FetchBatchSize))
+}
+
+// SetWriteOpPoolSize mocks base method
+func (m *MockAdminOptions) SetWriteOpPoolSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteOpPoolSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetWriteOpPoolSize indicates an expected call of SetWriteOpPoolSize
+func (mr *MockAdminOptionsMockRecorder) SetWriteOpPoolSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteOpPoolSize), value)
+}
+
+// WriteOpPoolSize mocks base method
+func (m *MockAdminOptions) WriteOpPoolSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteOpPoolSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// WriteOpPoolSize indicates an expected call of WriteOpPoolSize
+func (mr *MockAdminOptionsMockRecorder) WriteOpPoolSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).WriteOpPoolSize))
+}
+
+// SetWriteTaggedOpPoolSize mocks base method
+func (m *MockAdminOptions) SetWriteTaggedOpPoolSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteTaggedOpPoolSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetWriteTaggedOpPoolSize indicates an expected call of SetWriteTaggedOpPoolSize
+func (mr *MockAdminOptionsMockRecorder) SetWriteTaggedOpPoolSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteTaggedOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteTaggedOpPoolSize), value)
+}
+
+// WriteTaggedOpPoolSize mocks base method
+func (m *MockAdminOptions) WriteTaggedOpPoolSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteTaggedOpPoolSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// WriteTaggedOpPoolSize indicates an expected call of WriteTaggedOpPoolSize
+func (mr *MockAdminOptionsMockRecorder) WriteTaggedOpPoolSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTaggedOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).WriteTaggedOpPoolSize))
+}
+
+// SetFetchBatchOpPoolSize mocks base method
+func (m *MockAdminOptions) SetFetchBatchOpPoolSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFetchBatchOpPoolSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFetchBatchOpPoolSize indicates an expected call of SetFetchBatchOpPoolSize
+func (mr *MockAdminOptionsMockRecorder) SetFetchBatchOpPoolSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchBatchOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetFetchBatchOpPoolSize), value)
+}
+
+// FetchBatchOpPoolSize mocks base method
+func (m *MockAdminOptions) FetchBatchOpPoolSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBatchOpPoolSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// FetchBatchOpPoolSize indicates an expected call of FetchBatchOpPoolSize
+func (mr *MockAdminOptionsMockRecorder) FetchBatchOpPoolSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBatchOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).FetchBatchOpPoolSize))
+}
+
+// SetCheckedBytesWrapperPoolSize mocks base method
+func (m *MockAdminOptions) SetCheckedBytesWrapperPoolSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCheckedBytesWrapperPoolSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetCheckedBytesWrapperPoolSize indicates an expected call of SetCheckedBytesWrapperPoolSize
+func (mr *MockAdminOptionsMockRecorder) SetCheckedBytesWrapperPoolSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCheckedBytesWrapperPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetCheckedBytesWrapperPoolSize), value)
+}
+
+// CheckedBytesWrapperPoolSize mocks base method
+func (m *MockAdminOptions) CheckedBytesWrapperPoolSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckedBytesWrapperPoolSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// CheckedBytesWrapperPoolSize indicates an expected call of CheckedBytesWrapperPoolSize
+func (mr *MockAdminOptionsMockRecorder) CheckedBytesWrapperPoolSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckedBytesWrapperPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).CheckedBytesWrapperPoolSize))
+}
+
+// SetHostQueueOpsFlushSize mocks base method
+func (m *MockAdminOptions) SetHostQueueOpsFlushSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHostQueueOpsFlushSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHostQueueOpsFlushSize indicates an expected call of SetHostQueueOpsFlushSize
+func (mr *MockAdminOptionsMockRecorder) SetHostQueueOpsFlushSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostQueueOpsFlushSize", reflect.TypeOf((*MockAdminOptions)(nil).SetHostQueueOpsFlushSize), value)
+}
+
+// HostQueueOpsFlushSize mocks base method
+func (m *MockAdminOptions) HostQueueOpsFlushSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostQueueOpsFlushSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// HostQueueOpsFlushSize indicates an expected call of HostQueueOpsFlushSize
+func (mr *MockAdminOptionsMockRecorder) HostQueueOpsFlushSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueOpsFlushSize", reflect.TypeOf((*MockAdminOptions)(nil).HostQueueOpsFlushSize))
+}
+
+// SetHostQueueOpsFlushInterval mocks base method
+func (m *MockAdminOptions) SetHostQueueOpsFlushInterval(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHostQueueOpsFlushInterval", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHostQueueOpsFlushInterval indicates an expected call of SetHostQueueOpsFlushInterval
+func (mr *MockAdminOptionsMockRecorder) SetHostQueueOpsFlushInterval(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostQueueOpsFlushInterval", reflect.TypeOf((*MockAdminOptions)(nil).SetHostQueueOpsFlushInterval), value)
+}
+
+// HostQueueOpsFlushInterval mocks base method
+func (m *MockAdminOptions) HostQueueOpsFlushInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostQueueOpsFlushInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// HostQueueOpsFlushInterval indicates an expected call of HostQueueOpsFlushInterval
+func (mr *MockAdminOptionsMockRecorder) HostQueueOpsFlushInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueOpsFlushInterval", reflect.TypeOf((*MockAdminOptions)(nil).HostQueueOpsFlushInterval))
+}
+
+// SetContextPool mocks base method
+func (m *MockAdminOptions) SetContextPool(value context.Pool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContextPool", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetContextPool indicates an expected call of SetContextPool
+func (mr *MockAdminOptionsMockRecorder) SetContextPool(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContextPool", reflect.TypeOf((*MockAdminOptions)(nil).SetContextPool), value)
+}
+
+// ContextPool mocks base method
+func (m *MockAdminOptions) ContextPool() context.Pool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContextPool")
+	ret0, _ := ret[0].(context.Pool)
+	return ret0
+}
+
+// ContextPool indicates an expected call of ContextPool
+func (mr *MockAdminOptionsMockRecorder) ContextPool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContextPool", reflect.TypeOf((*MockAdminOptions)(nil).ContextPool))
+}
+
+// SetIdentifierPool mocks base method
+func (m *MockAdminOptions) SetIdentifierPool(value ident.Pool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIdentifierPool", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetIdentifierPool indicates an expected call of SetIdentifierPool
+func (mr *MockAdminOptionsMockRecorder) SetIdentifierPool(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagEncoderOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetTagEncoderOptions), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIdentifierPool", reflect.TypeOf((*MockAdminOptions)(nil).SetIdentifierPool), value)
+}
+
+// IdentifierPool mocks base method
+func (m *MockAdminOptions) IdentifierPool() ident.Pool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IdentifierPool")
+	ret0, _ := ret[0].(ident.Pool)
+	return ret0
+}
+
+// IdentifierPool indicates an expected call of IdentifierPool
+func (mr *MockAdminOptionsMockRecorder) IdentifierPool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdentifierPool", reflect.TypeOf((*MockAdminOptions)(nil).IdentifierPool))
+}
+
+// SetHostQueueOpsArrayPoolSize mocks base method
+func (m *MockAdminOptions) SetHostQueueOpsArrayPoolSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHostQueueOpsArrayPoolSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHostQueueOpsArrayPoolSize indicates an expected call of SetHostQueueOpsArrayPoolSize
+func (mr *MockAdminOptionsMockRecorder) SetHostQueueOpsArrayPoolSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostQueueOpsArrayPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetHostQueueOpsArrayPoolSize), value)
+}
+
+// HostQueueOpsArrayPoolSize mocks base method
+func (m *MockAdminOptions) HostQueueOpsArrayPoolSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostQueueOpsArrayPoolSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// HostQueueOpsArrayPoolSize indicates an expected call of HostQueueOpsArrayPoolSize
+func (mr *MockAdminOptionsMockRecorder) HostQueueOpsArrayPoolSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueOpsArrayPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).HostQueueOpsArrayPoolSize))
+}
+
+// SetSeriesIteratorPoolSize mocks base method
+func (m *MockAdminOptions) SetSeriesIteratorPoolSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSeriesIteratorPoolSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetSeriesIteratorPoolSize indicates an expected call of SetSeriesIteratorPoolSize
+func (mr *MockAdminOptionsMockRecorder) SetSeriesIteratorPoolSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeriesIteratorPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetSeriesIteratorPoolSize), value)
+}
+
+// SeriesIteratorPoolSize mocks base method
+func (m *MockAdminOptions) SeriesIteratorPoolSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesIteratorPoolSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// SeriesIteratorPoolSize indicates an expected call of SeriesIteratorPoolSize
+func (mr *MockAdminOptionsMockRecorder) SeriesIteratorPoolSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesIteratorPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SeriesIteratorPoolSize))
+}
+
+// SetSeriesIteratorArrayPoolBuckets mocks base method
+func (m *MockAdminOptions) SetSeriesIteratorArrayPoolBuckets(value []pool.Bucket) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSeriesIteratorArrayPoolBuckets", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetSeriesIteratorArrayPoolBuckets indicates an expected call of SetSeriesIteratorArrayPoolBuckets
+func (mr *MockAdminOptionsMockRecorder) SetSeriesIteratorArrayPoolBuckets(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeriesIteratorArrayPoolBuckets", reflect.TypeOf((*MockAdminOptions)(nil).SetSeriesIteratorArrayPoolBuckets), value)
+}
+
+// SeriesIteratorArrayPoolBuckets mocks base method
+func (m *MockAdminOptions) SeriesIteratorArrayPoolBuckets() []pool.Bucket {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesIteratorArrayPoolBuckets")
+	ret0, _ := ret[0].([]pool.Bucket)
+	return ret0
+}
+
+// SeriesIteratorArrayPoolBuckets indicates an expected call of SeriesIteratorArrayPoolBuckets
+func (mr *MockAdminOptionsMockRecorder) SeriesIteratorArrayPoolBuckets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesIteratorArrayPoolBuckets", reflect.TypeOf((*MockAdminOptions)(nil).SeriesIteratorArrayPoolBuckets))
+}
+
+// SetReaderIteratorAllocate mocks base method
+func (m *MockAdminOptions) SetReaderIteratorAllocate(value encoding.ReaderIteratorAllocate) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReaderIteratorAllocate", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReaderIteratorAllocate indicates an expected call of SetReaderIteratorAllocate
+func (mr *MockAdminOptionsMockRecorder) SetReaderIteratorAllocate(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReaderIteratorAllocate", reflect.TypeOf((*MockAdminOptions)(nil).SetReaderIteratorAllocate), value)
+}
+
+// ReaderIteratorAllocate mocks base method
+func (m *MockAdminOptions) ReaderIteratorAllocate() encoding.ReaderIteratorAllocate {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReaderIteratorAllocate")
+	ret0, _ := ret[0].(encoding.ReaderIteratorAllocate)
+	return ret0
+}
+
+// ReaderIteratorAllocate indicates an expected call of ReaderIteratorAllocate
+func (mr *MockAdminOptionsMockRecorder) ReaderIteratorAllocate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReaderIteratorAllocate", reflect.TypeOf((*MockAdminOptions)(nil).ReaderIteratorAllocate))
+}
+
+// SetSchemaRegistry mocks base method
+func (m *MockAdminOptions) SetSchemaRegistry(registry namespace.SchemaRegistry) AdminOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSchemaRegistry", registry)
+	ret0, _ := ret[0].(AdminOptions)
+	return ret0
+}
+
+// SetSchemaRegistry indicates an expected call of SetSchemaRegistry
+func (mr *MockAdminOptionsMockRecorder) SetSchemaRegistry(registry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchemaRegistry", reflect.TypeOf((*MockAdminOptions)(nil).SetSchemaRegistry), registry)
+}
+
+// SchemaRegistry mocks base method
+func (m *MockAdminOptions) SchemaRegistry() namespace.SchemaRegistry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchemaRegistry")
+	ret0, _ := ret[0].(namespace.SchemaRegistry)
+	return ret0
+}
+
+// SchemaRegistry indicates an expected call of SchemaRegistry
+func (mr *MockAdminOptionsMockRecorder) SchemaRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaRegistry", reflect.TypeOf((*MockAdminOptions)(nil).SchemaRegistry))
+}
+
+// SetAsyncTopologyInitializers mocks base method
+func (m *MockAdminOptions) SetAsyncTopologyInitializers(value []topology.Initializer) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAsyncTopologyInitializers", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetAsyncTopologyInitializers indicates an expected call of SetAsyncTopologyInitializers
+func (mr *MockAdminOptionsMockRecorder) SetAsyncTopologyInitializers(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAsyncTopologyInitializers", reflect.TypeOf((*MockAdminOptions)(nil).SetAsyncTopologyInitializers), value)
+}
+
+// AsyncTopologyInitializers mocks base method
+func (m *MockAdminOptions) AsyncTopologyInitializers() []topology.Initializer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AsyncTopologyInitializers")
+	ret0, _ := ret[0].([]topology.Initializer)
+	return ret0
+}
+
+// AsyncTopologyInitializers indicates an expected call of AsyncTopologyInitializers
+func (mr *MockAdminOptionsMockRecorder) AsyncTopologyInitializers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AsyncTopologyInitializers", reflect.TypeOf((*MockAdminOptions)(nil).AsyncTopologyInitializers))
+}
+
+// SetAsyncWriteWorkerPool mocks base method
+func (m *MockAdminOptions) SetAsyncWriteWorkerPool(value sync.PooledWorkerPool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAsyncWriteWorkerPool", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetAsyncWriteWorkerPool indicates an expected call of SetAsyncWriteWorkerPool
+func (mr *MockAdminOptionsMockRecorder) SetAsyncWriteWorkerPool(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAsyncWriteWorkerPool", reflect.TypeOf((*MockAdminOptions)(nil).SetAsyncWriteWorkerPool), value)
+}
+
+// AsyncWriteWorkerPool mocks base method
+func (m *MockAdminOptions) AsyncWriteWorkerPool() sync.PooledWorkerPool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AsyncWriteWorkerPool")
+	ret0, _ := ret[0].(sync.PooledWorkerPool)
+	return ret0
+}
+
+// AsyncWriteWorkerPool indicates an expected call of AsyncWriteWorkerPool
+func (mr *MockAdminOptionsMockRecorder) AsyncWriteWorkerPool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AsyncWriteWorkerPool", reflect.TypeOf((*MockAdminOptions)(nil).AsyncWriteWorkerPool))
+}
+
+// SetAsyncWriteMaxConcurrency mocks base method
+func (m *MockAdminOptions) SetAsyncWriteMaxConcurrency(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAsyncWriteMaxConcurrency", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetAsyncWriteMaxConcurrency indicates an expected call of SetAsyncWriteMaxConcurrency
+func (mr *MockAdminOptionsMockRecorder) SetAsyncWriteMaxConcurrency(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAsyncWriteMaxConcurrency", reflect.TypeOf((*MockAdminOptions)(nil).SetAsyncWriteMaxConcurrency), value)
 }
 
-// TagEncoderOptions mocks base method
-func (m *MockAdminOptions) TagEncoderOptions() serialize.TagEncoderOptions {
+// AsyncWriteMaxConcurrency mocks base method
+func (m *MockAdminOptions) AsyncWriteMaxConcurrency() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TagEncoderOptions")
-	ret0, _ := ret[0].(serialize.TagEncoderOptions)
+	ret := m.ctrl.Call(m, "AsyncWriteMaxConcurrency")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// TagEncoderOptions indicates an expected call of TagEncoderOptions
-func (mr *MockAdminOptionsMockRecorder) TagEncoderOptions() *gomock.Call {
+// AsyncWriteMaxConcurrency indicates an expected call of AsyncWriteMaxConcurrency
+func (mr *MockAdminOptionsMockRecorder) AsyncWriteMaxConcurrency() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagEncoderOptions", reflect.TypeOf((*MockAdminOptions)(nil).TagEncoderOptions))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AsyncWriteMaxConcurrency", reflect.TypeOf((*MockAdminOptions)(nil).AsyncWriteMaxConcurrency))
 }
 
-// SetTagEncoderPoolSize mocks base method
-func (m *MockAdminOptions) SetTagEncoderPoolSize(value int) Options {
+// SetUseV2BatchAPIs mocks base method
+func (m *MockAdminOptions) SetUseV2BatchAPIs(value bool) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetTagEncoderPoolSize", value)
+	ret := m.ctrl.Call(m, "SetUseV2BatchAPIs", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetTagEncoderPoolSize indicates an expected call of SetTagEncoderPoolSize
-func (mr *MockAdminOptionsMockRecorder) SetTagEncoderPoolSize(value interface{}) *gomock.Call {
+// SetUseV2BatchAPIs indicates an expected call of SetUseV2BatchAPIs
+func (mr *MockAdminOptionsMockRecorder) SetUseV2BatchAPIs(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagEncoderPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetTagEncoderPoolSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUseV2BatchAPIs", reflect.TypeOf((*MockAdminOptions)(nil).SetUseV2BatchAPIs), value)
 }
 
-// TagEncoderPoolSize mocks base method
-func (m *MockAdminOptions) TagEncoderPoolSize() int {
+// UseV2BatchAPIs mocks base method
+func (m *MockAdminOptions) UseV2BatchAPIs() bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TagEncoderPoolSize")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "UseV2BatchAPIs")
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// TagEncoderPoolSize indicates an expected call of TagEncoderPoolSize
-func (mr *MockAdminOptionsMockRecorder) TagEncoderPoolSize() *gomock.Call {
+// UseV2BatchAPIs indicates an expected call of UseV2BatchAPIs
+func (mr *MockAdminOptionsMockRecorder) UseV2BatchAPIs() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagEncoderPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).TagEncoderPoolSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseV2BatchAPIs", reflect.TypeOf((*MockAdminOptions)(nil).UseV2BatchAPIs))
 }
 
-// SetTagDecoderOptions mocks base method
-func (m *MockAdminOptions) SetTagDecoderOptions(value serialize.TagDecoderOptions) Options {
+// SetIterationOptions mocks base method
+func (m *MockAdminOptions) SetIterationOptions(arg0 index.IterationOptions) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetTagDecoderOptions", value)
+	ret := m.ctrl.Call(m, "SetIterationOptions", arg0)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetTagDecoderOptions indicates an expected call of SetTagDecoderOptions
-func (mr *MockAdminOptionsMockRecorder) SetTagDecoderOptions(value interface{}) *gomock.Call {
+// SetIterationOptions indicates an expected call of SetIterationOptions
+func (mr *MockAdminOptionsMockRecorder) SetIterationOptions(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagDecoderOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetTagDecoderOptions), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIterationOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetIterationOptions), arg0)
 }
 
-// TagDecoderOptions mocks base method
-func (m *MockAdminOptions) TagDecoderOptions() serialize.TagDecoderOptions {
+// IterationOptions mocks base method
+func (m *MockAdminOptions) IterationOptions() index.IterationOptions {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TagDecoderOptions")
-	ret0, _ := ret[0].(serialize.TagDecoderOptions)
+	ret := m.ctrl.Call(m, "IterationOptions")
+	ret0, _ := ret[0].(index.IterationOptions)
 	return ret0
 }
 
-// TagDecoderOptions indicates an expected call of TagDecoderOptions
-func (mr *MockAdminOptionsMockRecorder) TagDecoderOptions() *gomock.Call {
+// IterationOptions indicates an expected call of IterationOptions
+func (mr *MockAdminOptionsMockRecorder) IterationOptions() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagDecoderOptions", reflect.TypeOf((*MockAdminOptions)(nil).TagDecoderOptions))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterationOptions", reflect.TypeOf((*MockAdminOptions)(nil).IterationOptions))
 }
 
-// SetTagDecoderPoolSize mocks base method
-func (m *MockAdminOptions) SetTagDecoderPoolSize(value int) Options {
+// SetWriteTimestampOffset mocks base method
+func (m *MockAdminOptions) SetWriteTimestampOffset(value time.Duration) AdminOptions {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetTagDecoderPoolSize", value)
-	ret0, _ := ret[0].(Options)
+	ret := m.ctrl.Call(m, "SetWriteTimestampOffset", value)
+	ret0, _ := ret[0].(AdminOptions)
 	return ret0
 }
 
-// SetTagDecoderPoolSize indicates an expected call of SetTagDecoderPoolSize
-func (mr *MockAdminOptionsMockRecorder) SetTagDecoderPoolSize(value interface{}) *gomock.Call {
+// SetWriteTimestampOffset indicates an expected call of SetWriteTimestampOffset
+func (mr *MockAdminOptionsMockRecorder) SetWriteTimestampOffset(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagDecoderPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetTagDecoderPoolSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteTimestampOffset", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteTimestampOffset), value)
 }
 
-// TagDecoderPoolSize mocks base method
-func (m *MockAdminOptions) TagDecoderPoolSize() int {
+// WriteTimestampOffset mocks base method
+func (m *MockAdminOptions) WriteTimestampOffset() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TagDecoderPoolSize")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "WriteTimestampOffset")
+	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// TagDecoderPoolSize indicates an expected call of TagDecoderPoolSize
-func (mr *MockAdminOptionsMockRecorder) TagDecoderPoolSize() *gomock.Call {
+// WriteTimestampOffset indicates an expected call of WriteTimestampOffset
+func (mr *MockAdminOptionsMockRecorder) WriteTimestampOffset() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagDecoderPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).TagDecoderPoolSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTimestampOffset", reflect.TypeOf((*MockAdminOptions)(nil).WriteTimestampOffset))
 }
 
-// SetWriteBatchSize mocks base method
-func (m *MockAdminOptions) SetWriteBatchSize(value int) Options {
+// SetReadRepairEnabled mocks base method
+func (m *MockAdminOptions) SetReadRepairEnabled(value bool) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetWriteBatchSize", value)
+	ret := m.ctrl.Call(m, "SetReadRepairEnabled", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetWriteBatchSize indicates an expected call of SetWriteBatchSize
-func (mr *MockAdminOptionsMockRecorder) SetWriteBatchSize(value interface{}) *gomock.Call {
+// SetReadRepairEnabled indicates an expected call of SetReadRepairEnabled
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairEnabled(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteBatchSize", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteBatchSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairEnabled", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairEnabled), value)
 }
 
-// WriteBatchSize mocks base method
-func (m *MockAdminOptions) WriteBatchSize() int {
+// ReadRepairEnabled mocks base method
+func (m *MockAdminOptions) ReadRepairEnabled() bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteBatchSize")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "ReadRepairEnabled")
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// WriteBatchSize indicates an expected call of WriteBatchSize
-func (mr *MockAdminOptionsMockRecorder) WriteBatchSize() *gomock.Call {
+// ReadRepairEnabled indicates an expected call of ReadRepairEnabled
+func (mr *MockAdminOptionsMockRecorder) ReadRepairEnabled() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteBatchSize", reflect.TypeOf((*MockAdminOptions)(nil).WriteBatchSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairEnabled", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairEnabled))
 }
 
-// SetFetchBatchSize mocks base method
-func (m *MockAdminOptions) SetFetchBatchSize(value int) Options {
+// SetReadRepairMaxDatapointsPerSecond mocks base method
+func (m *MockAdminOptions) SetReadRepairMaxDatapointsPerSecond(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetFetchBatchSize", value)
+	ret := m.ctrl.Call(m, "SetReadRepairMaxDatapointsPerSecond", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetFetchBatchSize indicates an expected call of SetFetchBatchSize
-func (mr *MockAdminOptionsMockRecorder) SetFetchBatchSize(value interface{}) *gomock.Call {
+// SetReadRepairMaxDatapointsPerSecond indicates an expected call of SetReadRepairMaxDatapointsPerSecond
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairMaxDatapointsPerSecond(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchBatchSize", reflect.TypeOf((*MockAdminOptions)(nil).SetFetchBatchSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairMaxDatapointsPerSecond", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairMaxDatapointsPerSecond), value)
 }
 
-// FetchBatchSize mocks base method
-func (m *MockAdminOptions) FetchBatchSize() int {
+// ReadRepairMaxDatapointsPerSecond mocks base method
+func (m *MockAdminOptions) ReadRepairMaxDatapointsPerSecond() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchBatchSize")
+	ret := m.ctrl.Call(m, "ReadRepairMaxDatapointsPerSecond")
 	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// FetchBatchSize indicates an expected call of FetchBatchSize
-func (mr *MockAdminOptionsMockRecorder) FetchBatchSize() *gomock.Call {
+// ReadRepairMaxDatapointsPerSecond indicates an expected call of ReadRepairMaxDatapointsPerSecond
+func (mr *MockAdminOptionsMockRecorder) ReadRepairMaxDatapointsPerSecond() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBatchSize", reflect.TypeOf((*MockAdminOptions)(nil).FetchBatchSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairMaxDatapointsPerSecond", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairMaxDatapointsPerSecond))
 }
 
-// SetWriteOpPoolSize mocks base method
-func (m *MockAdminOptions) SetWriteOpPoolSize(value int) Options {
+// SetReadRepairQueueSize mocks base method
+func (m *MockAdminOptions) SetReadRepairQueueSize(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetWriteOpPoolSize", value)
+	ret := m.ctrl.Call(m, "SetReadRepairQueueSize", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetWriteOpPoolSize indicates an expected call of SetWriteOpPoolSize
-func (mr *MockAdminOptionsMockRecorder) SetWriteOpPoolSize(value interface{}) *gomock.Call {
+// SetReadRepairQueueSize indicates an expected call of SetReadRepairQueueSize
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairQueueSize(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteOpPoolSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairQueueSize", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairQueueSize), value)
 }
 
-// WriteOpPoolSize mocks base method
-func (m *MockAdminOptions) WriteOpPoolSize() int {
+// ReadRepairQueueSize mocks base method
+func (m *MockAdminOptions) ReadRepairQueueSize() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteOpPoolSize")
+	ret := m.ctrl.Call(m, "ReadRepairQueueSize")
 	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// WriteOpPoolSize indicates an expected call of WriteOpPoolSize
-func (mr *MockAdminOptionsMockRecorder) WriteOpPoolSize() *gomock.Call {
+// ReadRepairQueueSize indicates an expected call of ReadRepairQueueSize
+func (mr *MockAdminOptionsMockRecorder) ReadRepairQueueSize() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).WriteOpPoolSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairQueueSize", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairQueueSize))
 }
 
-// SetWriteTaggedOpPoolSize mocks base method
-func (m *MockAdminOptions) SetWriteTaggedOpPoolSize(value int) Options {
+// SetReadRepairNamespaceInitializer mocks base method
+func (m *MockAdminOptions) SetReadRepairNamespaceInitializer(value namespace.Initializer) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetWriteTaggedOpPoolSize", value)
+	ret := m.ctrl.Call(m, "SetReadRepairNamespaceInitializer", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetWriteTaggedOpPoolSize indicates an expected call of SetWriteTaggedOpPoolSize
-func (mr *MockAdminOptionsMockRecorder) SetWriteTaggedOpPoolSize(value interface{}) *gomock.Call {
+// SetReadRepairNamespaceInitializer indicates an expected call of SetReadRepairNamespaceInitializer
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairNamespaceInitializer(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteTaggedOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteTaggedOpPoolSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairNamespaceInitializer", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairNamespaceInitializer), value)
 }
 
-// WriteTaggedOpPoolSize mocks base method
-func (m *MockAdminOptions) WriteTaggedOpPoolSize() int {
+// ReadRepairNamespaceInitializer mocks base method
+func (m *MockAdminOptions) ReadRepairNamespaceInitializer() namespace.Initializer {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteTaggedOpPoolSize")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "ReadRepairNamespaceInitializer")
+	ret0, _ := ret[0].(namespace.Initializer)
 	return ret0
 }
 
-// WriteTaggedOpPoolSize indicates an expected call of WriteTaggedOpPoolSize
-func (mr *MockAdminOptionsMockRecorder) WriteTaggedOpPoolSize() *gomock.Call {
+// ReadRepairNamespaceInitializer indicates an expected call of ReadRepairNamespaceInitializer
+func (mr *MockAdminOptionsMockRecorder) ReadRepairNamespaceInitializer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTaggedOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).WriteTaggedOpPoolSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairNamespaceInitializer", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairNamespaceInitializer))
 }
 
-// SetFetchBatchOpPoolSize mocks base method
-func (m *MockAdminOptions) SetFetchBatchOpPoolSize(value int) Options {
+// SetHintedHandoffEnabled mocks base method
+func (m *MockAdminOptions) SetHintedHandoffEnabled(value bool) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetFetchBatchOpPoolSize", value)
+	ret := m.ctrl.Call(m, "SetHintedHandoffEnabled", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetFetchBatchOpPoolSize indicates an expected call of SetFetchBatchOpPoolSize
-func (mr *MockAdminOptionsMockRecorder) SetFetchBatchOpPoolSize(value interface{}) *gomock.Call {
+// SetHintedHandoffEnabled indicates an expected call of SetHintedHandoffEnabled
+func (mr *MockAdminOptionsMockRecorder) SetHintedHandoffEnabled(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchBatchOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetFetchBatchOpPoolSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffEnabled", reflect.TypeOf((*MockAdminOptions)(nil).SetHintedHandoffEnabled), value)
 }
 
-// FetchBatchOpPoolSize mocks base method
-func (m *MockAdminOptions) FetchBatchOpPoolSize() int {
+// HintedHandoffEnabled mocks base method
+func (m *MockAdminOptions) HintedHandoffEnabled() bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchBatchOpPoolSize")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "HintedHandoffEnabled")
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// FetchBatchOpPoolSize indicates an expected call of FetchBatchOpPoolSize
-func (mr *MockAdminOptionsMockRecorder) FetchBatchOpPoolSize() *gomock.Call {
+// HintedHandoffEnabled indicates an expected call of HintedHandoffEnabled
+func (mr *MockAdminOptionsMockRecorder) HintedHandoffEnabled() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBatchOpPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).FetchBatchOpPoolSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffEnabled", reflect.TypeOf((*MockAdminOptions)(nil).HintedHandoffEnabled))
 }
 
-// SetCheckedBytesWrapperPoolSize mocks base method
-func (m *MockAdminOptions) SetCheckedBytesWrapperPoolSize(value int) Options {
+// SetHintedHandoffMaxHintsPerHost mocks base method
+func (m *MockAdminOptions) SetHintedHandoffMaxHintsPerHost(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetCheckedBytesWrapperPoolSize", value)
+	ret := m.ctrl.Call(m, "SetHintedHandoffMaxHintsPerHost", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetCheckedBytesWrapperPoolSize indicates an expected call of SetCheckedBytesWrapperPoolSize
-func (mr *MockAdminOptionsMockRecorder) SetCheckedBytesWrapperPoolSize(value interface{}) *gomock.Call {
+// SetHintedHandoffMaxHintsPerHost indicates an expected call of SetHintedHandoffMaxHintsPerHost
+func (mr *MockAdminOptionsMockRecorder) SetHintedHandoffMaxHintsPerHost(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCheckedBytesWrapperPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetCheckedBytesWrapperPoolSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffMaxHintsPerHost", reflect.TypeOf((*MockAdminOptions)(nil).SetHintedHandoffMaxHintsPerHost), value)
 }
 
-// CheckedBytesWrapperPoolSize mocks base method
-func (m *MockAdminOptions) CheckedBytesWrapperPoolSize() int {
+// HintedHandoffMaxHintsPerHost mocks base method
+func (m *MockAdminOptions) HintedHandoffMaxHintsPerHost() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckedBytesWrapperPoolSize")
+	ret := m.ctrl.Call(m, "HintedHandoffMaxHintsPerHost")
 	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// CheckedBytesWrapperPoolSize indicates an expected call of CheckedBytesWrapperPoolSize
-func (mr *MockAdminOptionsMockRecorder) CheckedBytesWrapperPoolSize() *gomock.Call {
+// HintedHandoffMaxHintsPerHost indicates an expected call of HintedHandoffMaxHintsPerHost
+func (mr *MockAdminOptionsMockRecorder) HintedHandoffMaxHintsPerHost() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckedBytesWrapperPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).CheckedBytesWrapperPoolSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffMaxHintsPerHost", reflect.TypeOf((*MockAdminOptions)(nil).HintedHandoffMaxHintsPerHost))
 }
 
-// SetHostQueueOpsFlushSize mocks base method
-func (m *MockAdminOptions) SetHostQueueOpsFlushSize(value int) Options {
+// SetHintedHandoffMaxHintAge mocks base method
+func (m *MockAdminOptions) SetHintedHandoffMaxHintAge(value time.Duration) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetHostQueueOpsFlushSize", value)
+	ret := m.ctrl.Call(m, "SetHintedHandoffMaxHintAge", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetHostQueueOpsFlushSize indicates an expected call of SetHostQueueOpsFlushSize
-func (mr *MockAdminOptionsMockRecorder) SetHostQueueOpsFlushSize(value interface{}) *gomock.Call {
+// SetHintedHandoffMaxHintAge indicates an expected call of SetHintedHandoffMaxHintAge
+func (mr *MockAdminOptionsMockRecorder) SetHintedHandoffMaxHintAge(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostQueueOpsFlushSize", reflect.TypeOf((*MockAdminOptions)(nil).SetHostQueueOpsFlushSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffMaxHintAge", reflect.TypeOf((*MockAdminOptions)(nil).SetHintedHandoffMaxHintAge), value)
 }
 
-// HostQueueOpsFlushSize mocks base method
-func (m *MockAdminOptions) HostQueueOpsFlushSize() int {
+// HintedHandoffMaxHintAge mocks base method
+func (m *MockAdminOptions) HintedHandoffMaxHintAge() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HostQueueOpsFlushSize")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "HintedHandoffMaxHintAge")
+	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// HostQueueOpsFlushSize indicates an expected call of HostQueueOpsFlushSize
-func (mr *MockAdminOptionsMockRecorder) HostQueueOpsFlushSize() *gomock.Call {
+// HintedHandoffMaxHintAge indicates an expected call of HintedHandoffMaxHintAge
+func (mr *MockAdminOptionsMockRecorder) HintedHandoffMaxHintAge() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueOpsFlushSize", reflect.TypeOf((*MockAdminOptions)(nil).HostQueueOpsFlushSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffMaxHintAge", reflect.TypeOf((*MockAdminOptions)(nil).HintedHandoffMaxHintAge))
 }
 
-// SetHostQueueOpsFlushInterval mocks base method
-func (m *MockAdminOptions) SetHostQueueOpsFlushInterval(value time.Duration) Options {
+// SetHintedHandoffReplayInterval mocks base method
+func (m *MockAdminOptions) SetHintedHandoffReplayInterval(value time.Duration) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetHostQueueOpsFlushInterval", value)
+	ret := m.ctrl.Call(m, "SetHintedHandoffReplayInterval", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetHostQueueOpsFlushInterval indicates an expected call of SetHostQueueOpsFlushInterval
-func (mr *MockAdminOptionsMockRecorder) SetHostQueueOpsFlushInterval(value interface{}) *gomock.Call {
+// SetHintedHandoffReplayInterval indicates an expected call of SetHintedHandoffReplayInterval
+func (mr *MockAdminOptionsMockRecorder) SetHintedHandoffReplayInterval(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostQueueOpsFlushInterval", reflect.TypeOf((*MockAdminOptions)(nil).SetHostQueueOpsFlushInterval), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffReplayInterval", reflect.TypeOf((*MockAdminOptions)(nil).SetHintedHandoffReplayInterval), value)
 }
 
-// HostQueueOpsFlushInterval mocks base method
-func (m *MockAdminOptions) HostQueueOpsFlushInterval() time.Duration {
+// HintedHandoffReplayInterval mocks base method
+func (m *MockAdminOptions) HintedHandoffReplayInterval() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HostQueueOpsFlushInterval")
+	ret := m.ctrl.Call(m, "HintedHandoffReplayInterval")
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// HostQueueOpsFlushInterval indicates an expected call of HostQueueOpsFlushInterval
-func (mr *MockAdminOptionsMockRecorder) HostQueueOpsFlushInterval() *gomock.Call {
+// HintedHandoffReplayInterval indicates an expected call of HintedHandoffReplayInterval
+func (mr *MockAdminOptionsMockRecorder) HintedHandoffReplayInterval() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueOpsFlushInterval", reflect.TypeOf((*MockAdminOptions)(nil).HostQueueOpsFlushInterval))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffReplayInterval", reflect.TypeOf((*MockAdminOptions)(nil).HintedHandoffReplayInterval))
 }
 
-// SetContextPool mocks base method
-func (m *MockAdminOptions) SetContextPool(value context.Pool) Options {
+// SetTransportType mocks base method
+func (m *MockAdminOptions) SetTransportType(value TransportType) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetContextPool", value)
+	ret := m.ctrl.Call(m, "SetTransportType", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetContextPool indicates an expected call of SetContextPool
-func (mr *MockAdminOptionsMockRecorder) SetContextPool(value interface{}) *gomock.Call {
+// SetTransportType indicates an expected call of SetTransportType
+func (mr *MockAdminOptionsMockRecorder) SetTransportType(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContextPool", reflect.TypeOf((*MockAdminOptions)(nil).SetContextPool), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransportType", reflect.TypeOf((*MockAdminOptions)(nil).SetTransportType), value)
 }
 
-// ContextPool mocks base method
-func (m *MockAdminOptions) ContextPool() context.Pool {
+// TransportType mocks base method
+func (m *MockAdminOptions) TransportType() TransportType {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ContextPool")
-	ret0, _ := ret[0].(context.Pool)
+	ret := m.ctrl.Call(m, "TransportType")
+	ret0, _ := ret[0].(TransportType)
 	return ret0
 }
 
-// ContextPool indicates an expected call of ContextPool
-func (mr *MockAdminOptionsMockRecorder) ContextPool() *gomock.Call {
+// TransportType indicates an expected call of TransportType
+func (mr *MockAdminOptionsMockRecorder) TransportType() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContextPool", reflect.TypeOf((*MockAdminOptions)(nil).ContextPool))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransportType", reflect.TypeOf((*MockAdminOptions)(nil).TransportType))
 }
 
-// SetIdentifierPool mocks base method
-func (m *MockAdminOptions) SetIdentifierPool(value ident.Pool) Options {
+// SetGRPCPort mocks base method
+func (m *MockAdminOptions) SetGRPCPort(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetIdentifierPool", value)
+	ret := m.ctrl.Call(m, "SetGRPCPort", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetIdentifierPool indicates an expected call of SetIdentifierPool
-func (mr *MockAdminOptionsMockRecorder) SetIdentifierPool(value interface{}) *gomock.Call {
+// SetGRPCPort indicates an expected call of SetGRPCPort
+func (mr *MockAdminOptionsMockRecorder) SetGRPCPort(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIdentifierPool", reflect.TypeOf((*MockAdminOptions)(nil).SetIdentifierPool), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCPort", reflect.TypeOf((*MockAdminOptions)(nil).SetGRPCPort), value)
 }
 
-// IdentifierPool mocks base method
-func (m *MockAdminOptions) IdentifierPool() ident.Pool {
+// GRPCPort mocks base method
+func (m *MockAdminOptions) GRPCPort() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IdentifierPool")
-	ret0, _ := ret[0].(ident.Pool)
+	ret := m.ctrl.Call(m, "GRPCPort")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// IdentifierPool indicates an expected call of IdentifierPool
-func (mr *MockAdminOptionsMockRecorder) IdentifierPool() *gomock.Call {
+// GRPCPort indicates an expected call of GRPCPort
+func (mr *MockAdminOptionsMockRecorder) GRPCPort() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdentifierPool", reflect.TypeOf((*MockAdminOptions)(nil).IdentifierPool))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCPort", reflect.TypeOf((*MockAdminOptions)(nil).GRPCPort))
 }
 
-// SetHostQueueOpsArrayPoolSize mocks base method
-func (m *MockAdminOptions) SetHostQueueOpsArrayPoolSize(value int) Options {
+// SetGRPCMaxMsgSize mocks base method
+func (m *MockAdminOptions) SetGRPCMaxMsgSize(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetHostQueueOpsArrayPoolSize", value)
+	ret := m.ctrl.Call(m, "SetGRPCMaxMsgSize", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetHostQueueOpsArrayPoolSize indicates an expected call of SetHostQueueOpsArrayPoolSize
-func (mr *MockAdminOptionsMockRecorder) SetHostQueueOpsArrayPoolSize(value interface{}) *gomock.Call {
+// SetGRPCMaxMsgSize indicates an expected call of SetGRPCMaxMsgSize
+func (mr *MockAdminOptionsMockRecorder) SetGRPCMaxMsgSize(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostQueueOpsArrayPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetHostQueueOpsArrayPoolSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCMaxMsgSize", reflect.TypeOf((*MockAdminOptions)(nil).SetGRPCMaxMsgSize), value)
 }
 
-// HostQueueOpsArrayPoolSize mocks base method
-func (m *MockAdminOptions) HostQueueOpsArrayPoolSize() int {
+// GRPCMaxMsgSize mocks base method
+func (m *MockAdminOptions) GRPCMaxMsgSize() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HostQueueOpsArrayPoolSize")
+	ret := m.ctrl.Call(m, "GRPCMaxMsgSize")
 	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// HostQueueOpsArrayPoolSize indicates an expected call of HostQueueOpsArrayPoolSize
-func (mr *MockAdminOptionsMockRecorder) HostQueueOpsArrayPoolSize() *gomock.Call {
+// GRPCMaxMsgSize indicates an expected call of GRPCMaxMsgSize
+func (mr *MockAdminOptionsMockRecorder) GRPCMaxMsgSize() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueOpsArrayPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).HostQueueOpsArrayPoolSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCMaxMsgSize", reflect.TypeOf((*MockAdminOptions)(nil).GRPCMaxMsgSize))
 }
 
-// SetSeriesIteratorPoolSize mocks base method
-func (m *MockAdminOptions) SetSeriesIteratorPoolSize(value int) Options {
+// SetGRPCTransportCredentials mocks base method
+func (m *MockAdminOptions) SetGRPCTransportCredentials(value credentials.TransportCredentials) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetSeriesIteratorPoolSize", value)
+	ret := m.ctrl.Call(m, "SetGRPCTransportCredentials", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetSeriesIteratorPoolSize indicates an expected call of SetSeriesIteratorPoolSize
-func (mr *MockAdminOptionsMockRecorder) SetSeriesIteratorPoolSize(value interface{}) *gomock.Call {
+// SetGRPCTransportCredentials indicates an expected call of SetGRPCTransportCredentials
+func (mr *MockAdminOptionsMockRecorder) SetGRPCTransportCredentials(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeriesIteratorPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SetSeriesIteratorPoolSize), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCTransportCredentials", reflect.TypeOf((*MockAdminOptions)(nil).SetGRPCTransportCredentials), value)
 }
 
-// SeriesIteratorPoolSize mocks base method
-func (m *MockAdminOptions) SeriesIteratorPoolSize() int {
+// GRPCTransportCredentials mocks base method
+func (m *MockAdminOptions) GRPCTransportCredentials() credentials.TransportCredentials {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesIteratorPoolSize")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "GRPCTransportCredentials")
+	ret0, _ := ret[0].(credentials.TransportCredentials)
 	return ret0
 }
 
-// SeriesIteratorPoolSize indicates an expected call of SeriesIteratorPoolSize
-func (mr *MockAdminOptionsMockRecorder) SeriesIteratorPoolSize() *gomock.Call {
+// GRPCTransportCredentials indicates an expected call of GRPCTransportCredentials
+func (mr *MockAdminOptionsMockRecorder) GRPCTransportCredentials() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesIteratorPoolSize", reflect.TypeOf((*MockAdminOptions)(nil).SeriesIteratorPoolSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCTransportCredentials", reflect.TypeOf((*MockAdminOptions)(nil).GRPCTransportCredentials))
 }
 
-// SetSeriesIteratorArrayPoolBuckets mocks base method
-func (m *MockAdminOptions) SetSeriesIteratorArrayPoolBuckets(value []pool.Bucket) Options {
+// SetAdaptiveWriteBatchEnabled mocks base method
+func (m *MockAdminOptions) SetAdaptiveWriteBatchEnabled(value bool) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetSeriesIteratorArrayPoolBuckets", value)
+	ret := m.ctrl.Call(m, "SetAdaptiveWriteBatchEnabled", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
-
-// SetSeriesIteratorArrayPoolBuckets indicates an expected call of SetSeriesIteratorArrayPoolBuckets
-func (mr *MockAdminOptionsMockRecorder) SetSeriesIteratorArrayPoolBuckets(value interface{}) *gomock.Call {
+
+// SetAdaptiveWriteBatchEnabled indicates an expected call of SetAdaptiveWriteBatchEnabled
+func (mr *MockAdminOptionsMockRecorder) SetAdaptiveWriteBatchEnabled(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeriesIteratorArrayPoolBuckets", reflect.TypeOf((*MockAdminOptions)(nil).SetSeriesIteratorArrayPoolBuckets), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAdaptiveWriteBatchEnabled", reflect.TypeOf((*MockAdminOptions)(nil).SetAdaptiveWriteBatchEnabled), value)
 }
 
-// SeriesIteratorArrayPoolBuckets mocks base method
-func (m *MockAdminOptions) SeriesIteratorArrayPoolBuckets() []pool.Bucket {
+// AdaptiveWriteBatchEnabled mocks base method
+func (m *MockAdminOptions) AdaptiveWriteBatchEnabled() bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesIteratorArrayPoolBuckets")
-	ret0, _ := ret[0].([]pool.Bucket)
+	ret := m.ctrl.Call(m, "AdaptiveWriteBatchEnabled")
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// SeriesIteratorArrayPoolBuckets indicates an expected call of SeriesIteratorArrayPoolBuckets
-func (mr *MockAdminOptionsMockRecorder) SeriesIteratorArrayPoolBuckets() *gomock.Call {
+// AdaptiveWriteBatchEnabled indicates an expected call of AdaptiveWriteBatchEnabled
+func (mr *MockAdminOptionsMockRecorder) AdaptiveWriteBatchEnabled() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesIteratorArrayPoolBuckets", reflect.TypeOf((*MockAdminOptions)(nil).SeriesIteratorArrayPoolBuckets))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdaptiveWriteBatchEnabled", reflect.TypeOf((*MockAdminOptions)(nil).AdaptiveWriteBatchEnabled))
 }
 
-// SetReaderIteratorAllocate mocks base method
-func (m *MockAdminOptions) SetReaderIteratorAllocate(value encoding.ReaderIteratorAllocate) Options {
+// SetAdaptiveWriteBatchTargetLatency mocks base method
+func (m *MockAdminOptions) SetAdaptiveWriteBatchTargetLatency(value time.Duration) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetReaderIteratorAllocate", value)
+	ret := m.ctrl.Call(m, "SetAdaptiveWriteBatchTargetLatency", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetReaderIteratorAllocate indicates an expected call of SetReaderIteratorAllocate
-func (mr *MockAdminOptionsMockRecorder) SetReaderIteratorAllocate(value interface{}) *gomock.Call {
+// SetAdaptiveWriteBatchTargetLatency indicates an expected call of SetAdaptiveWriteBatchTargetLatency
+func (mr *MockAdminOptionsMockRecorder) SetAdaptiveWriteBatchTargetLatency(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReaderIteratorAllocate", reflect.TypeOf((*MockAdminOptions)(nil).SetReaderIteratorAllocate), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAdaptiveWriteBatchTargetLatency", reflect.TypeOf((*MockAdminOptions)(nil).SetAdaptiveWriteBatchTargetLatency), value)
 }
 
-// ReaderIteratorAllocate mocks base method
-func (m *MockAdminOptions) ReaderIteratorAllocate() encoding.ReaderIteratorAllocate {
+// AdaptiveWriteBatchTargetLatency mocks base method
+func (m *MockAdminOptions) AdaptiveWriteBatchTargetLatency() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReaderIteratorAllocate")
-	ret0, _ := ret[0].(encoding.ReaderIteratorAllocate)
+	ret := m.ctrl.Call(m, "AdaptiveWriteBatchTargetLatency")
+	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// ReaderIteratorAllocate indicates an expected call of ReaderIteratorAllocate
-func (mr *MockAdminOptionsMockRecorder) ReaderIteratorAllocate() *gomock.Call {
+// AdaptiveWriteBatchTargetLatency indicates an expected call of AdaptiveWriteBatchTargetLatency
+func (mr *MockAdminOptionsMockRecorder) AdaptiveWriteBatchTargetLatency() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReaderIteratorAllocate", reflect.TypeOf((*MockAdminOptions)(nil).ReaderIteratorAllocate))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdaptiveWriteBatchTargetLatency", reflect.TypeOf((*MockAdminOptions)(nil).AdaptiveWriteBatchTargetLatency))
 }
 
-// SetSchemaRegistry mocks base method
-func (m *MockAdminOptions) SetSchemaRegistry(registry namespace.SchemaRegistry) AdminOptions {
+// SetAdaptiveWriteBatchMinSize mocks base method
+func (m *MockAdminOptions) SetAdaptiveWriteBatchMinSize(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetSchemaRegistry", registry)
-	ret0, _ := ret[0].(AdminOptions)
+	ret := m.ctrl.Call(m, "SetAdaptiveWriteBatchMinSize", value)
+	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetSchemaRegistry indicates an expected call of SetSchemaRegistry
-func (mr *MockAdminOptionsMockRecorder) SetSchemaRegistry(registry interface{}) *gomock.Call {
+// SetAdaptiveWriteBatchMinSize indicates an expected call of SetAdaptiveWriteBatchMinSize
+func (mr *MockAdminOptionsMockRecorder) SetAdaptiveWriteBatchMinSize(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchemaRegistry", reflect.TypeOf((*MockAdminOptions)(nil).SetSchemaRegistry), registry)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAdaptiveWriteBatchMinSize", reflect.TypeOf((*MockAdminOptions)(nil).SetAdaptiveWriteBatchMinSize), value)
 }
 
-// SchemaRegistry mocks base method
-func (m *MockAdminOptions) SchemaRegistry() namespace.SchemaRegistry {
+// AdaptiveWriteBatchMinSize mocks base method
+func (m *MockAdminOptions) AdaptiveWriteBatchMinSize() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SchemaRegistry")
-	ret0, _ := ret[0].(namespace.SchemaRegistry)
+	ret := m.ctrl.Call(m, "AdaptiveWriteBatchMinSize")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// SchemaRegistry indicates an expected call of SchemaRegistry
-func (mr *MockAdminOptionsMockRecorder) SchemaRegistry() *gomock.Call {
+// AdaptiveWriteBatchMinSize indicates an expected call of AdaptiveWriteBatchMinSize
+func (mr *MockAdminOptionsMockRecorder) AdaptiveWriteBatchMinSize() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaRegistry", reflect.TypeOf((*MockAdminOptions)(nil).SchemaRegistry))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdaptiveWriteBatchMinSize", reflect.TypeOf((*MockAdminOptions)(nil).AdaptiveWriteBatchMinSize))
 }
 
-// SetAsyncTopologyInitializers mocks base method
-func (m *MockAdminOptions) SetAsyncTopologyInitializers(value []topology.Initializer) Options {
+// SetHostQueueSaturationThreshold mocks base method
+func (m *MockAdminOptions) SetHostQueueSaturationThreshold(value int) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetAsyncTopologyInitializers", value)
+	ret := m.ctrl.Call(m, "SetHostQueueSaturationThreshold", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetAsyncTopologyInitializers indicates an expected call of SetAsyncTopologyInitializers
-func (mr *MockAdminOptionsMockRecorder) SetAsyncTopologyInitializers(value interface{}) *gomock.Call {
+// SetHostQueueSaturationThreshold indicates an expected call of SetHostQueueSaturationThreshold
+func (mr *MockAdminOptionsMockRecorder) SetHostQueueSaturationThreshold(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAsyncTopologyInitializers", reflect.TypeOf((*MockAdminOptions)(nil).SetAsyncTopologyInitializers), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostQueueSaturationThreshold", reflect.TypeOf((*MockAdminOptions)(nil).SetHostQueueSaturationThreshold), value)
 }
 
-// AsyncTopologyInitializers mocks base method
-func (m *MockAdminOptions) AsyncTopologyInitializers() []topology.Initializer {
+// HostQueueSaturationThreshold mocks base method
+func (m *MockAdminOptions) HostQueueSaturationThreshold() int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AsyncTopologyInitializers")
-	ret0, _ := ret[0].([]topology.Initializer)
+	ret := m.ctrl.Call(m, "HostQueueSaturationThreshold")
+	ret0, _ := ret[0].(int)
 	return ret0
 }
 
-// AsyncTopologyInitializers indicates an expected call of AsyncTopologyInitializers
-func (mr *MockAdminOptionsMockRecorder) AsyncTopologyInitializers() *gomock.Call {
+// HostQueueSaturationThreshold indicates an expected call of HostQueueSaturationThreshold
+func (mr *MockAdminOptionsMockRecorder) HostQueueSaturationThreshold() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AsyncTopologyInitializers", reflect.TypeOf((*MockAdminOptions)(nil).AsyncTopologyInitializers))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueSaturationThreshold", reflect.TypeOf((*MockAdminOptions)(nil).HostQueueSaturationThreshold))
 }
 
-// SetAsyncWriteWorkerPool mocks base method
-func (m *MockAdminOptions) SetAsyncWriteWorkerPool(value sync.PooledWorkerPool) Options {
+// SetHostQueueSaturationFn mocks base method
+func (m *MockAdminOptions) SetHostQueueSaturationFn(value HostQueueSaturationFn) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetAsyncWriteWorkerPool", value)
+	ret := m.ctrl.Call(m, "SetHostQueueSaturationFn", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetAsyncWriteWorkerPool indicates an expected call of SetAsyncWriteWorkerPool
-func (mr *MockAdminOptionsMockRecorder) SetAsyncWriteWorkerPool(value interface{}) *gomock.Call {
+// SetHostQueueSaturationFn indicates an expected call of SetHostQueueSaturationFn
+func (mr *MockAdminOptionsMockRecorder) SetHostQueueSaturationFn(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAsyncWriteWorkerPool", reflect.TypeOf((*MockAdminOptions)(nil).SetAsyncWriteWorkerPool), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostQueueSaturationFn", reflect.TypeOf((*MockAdminOptions)(nil).SetHostQueueSaturationFn), value)
 }
 
-// AsyncWriteWorkerPool mocks base method
-func (m *MockAdminOptions) AsyncWriteWorkerPool() sync.PooledWorkerPool {
+// HostQueueSaturationFn mocks base method
+func (m *MockAdminOptions) HostQueueSaturationFn() HostQueueSaturationFn {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AsyncWriteWorkerPool")
-	ret0, _ := ret[0].(sync.PooledWorkerPool)
+	ret := m.ctrl.Call(m, "HostQueueSaturationFn")
+	ret0, _ := ret[0].(HostQueueSaturationFn)
 	return ret0
 }
 
-// AsyncWriteWorkerPool indicates an expected call of AsyncWriteWorkerPool
-func (mr *MockAdminOptionsMockRecorder) AsyncWriteWorkerPool() *gomock.Call {
+// HostQueueSaturationFn indicates an expected call of HostQueueSaturationFn
+func (mr *MockAdminOptionsMockRecorder) HostQueueSaturationFn() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AsyncWriteWorkerPool", reflect.TypeOf((*MockAdminOptions)(nil).AsyncWriteWorkerPool))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueSaturationFn", reflect.TypeOf((*MockAdminOptions)(nil).HostQueueSaturationFn))
 }
 
-// SetAsyncWriteMaxConcurrency mocks base method
-func (m *MockAdminOptions) SetAsyncWriteMaxConcurrency(value int) Options {
+// SetHedgedReadsEnabled mocks base method
+func (m *MockAdminOptions) SetHedgedReadsEnabled(value bool) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetAsyncWriteMaxConcurrency", value)
+	ret := m.ctrl.Call(m, "SetHedgedReadsEnabled", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetAsyncWriteMaxConcurrency indicates an expected call of SetAsyncWriteMaxConcurrency
-func (mr *MockAdminOptionsMockRecorder) SetAsyncWriteMaxConcurrency(value interface{}) *gomock.Call {
+// SetHedgedReadsEnabled indicates an expected call of SetHedgedReadsEnabled
+func (mr *MockAdminOptionsMockRecorder) SetHedgedReadsEnabled(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAsyncWriteMaxConcurrency", reflect.TypeOf((*MockAdminOptions)(nil).SetAsyncWriteMaxConcurrency), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHedgedReadsEnabled", reflect.TypeOf((*MockAdminOptions)(nil).SetHedgedReadsEnabled), value)
 }
 
-// AsyncWriteMaxConcurrency mocks base method
-func (m *MockAdminOptions) AsyncWriteMaxConcurrency() int {
+// HedgedReadsEnabled mocks base method
+func (m *MockAdminOptions) HedgedReadsEnabled() bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AsyncWriteMaxConcurrency")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "HedgedReadsEnabled")
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// AsyncWriteMaxConcurrency indicates an expected call of AsyncWriteMaxConcurrency
-func (mr *MockAdminOptionsMockRecorder) AsyncWriteMaxConcurrency() *gomock.Call {
+// HedgedReadsEnabled indicates an expected call of HedgedReadsEnabled
+func (mr *MockAdminOptionsMockRecorder) HedgedReadsEnabled() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AsyncWriteMaxConcurrency", reflect.TypeOf((*MockAdminOptions)(nil).AsyncWriteMaxConcurrency))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HedgedReadsEnabled", reflect.TypeOf((*MockAdminOptions)(nil).HedgedReadsEnabled))
 }
 
-// SetUseV2BatchAPIs mocks base method
-func (m *MockAdminOptions) SetUseV2BatchAPIs(value bool) Options {
+// SetHedgedReadsDelayPercentile mocks base method
+func (m *MockAdminOptions) SetHedgedReadsDelayPercentile(value float64) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetUseV2BatchAPIs", value)
+	ret := m.ctrl.Call(m, "SetHedgedReadsDelayPercentile", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetUseV2BatchAPIs indicates an expected call of SetUseV2BatchAPIs
-func (mr *MockAdminOptionsMockRecorder) SetUseV2BatchAPIs(value interface{}) *gomock.Call {
+// SetHedgedReadsDelayPercentile indicates an expected call of SetHedgedReadsDelayPercentile
+func (mr *MockAdminOptionsMockRecorder) SetHedgedReadsDelayPercentile(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUseV2BatchAPIs", reflect.TypeOf((*MockAdminOptions)(nil).SetUseV2BatchAPIs), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHedgedReadsDelayPercentile", reflect.TypeOf((*MockAdminOptions)(nil).SetHedgedReadsDelayPercentile), value)
 }
 
-// UseV2BatchAPIs mocks base method
-func (m *MockAdminOptions) UseV2BatchAPIs() bool {
+// HedgedReadsDelayPercentile mocks base method
+func (m *MockAdminOptions) HedgedReadsDelayPercentile() float64 {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UseV2BatchAPIs")
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "HedgedReadsDelayPercentile")
+	ret0, _ := ret[0].(float64)
 	return ret0
 }
 
-// UseV2BatchAPIs indicates an expected call of UseV2BatchAPIs
-func (mr *MockAdminOptionsMockRecorder) UseV2BatchAPIs() *gomock.Call {
+// HedgedReadsDelayPercentile indicates an expected call of HedgedReadsDelayPercentile
+func (mr *MockAdminOptionsMockRecorder) HedgedReadsDelayPercentile() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseV2BatchAPIs", reflect.TypeOf((*MockAdminOptions)(nil).UseV2BatchAPIs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HedgedReadsDelayPercentile", reflect.TypeOf((*MockAdminOptions)(nil).HedgedReadsDelayPercentile))
 }
 
-// SetIterationOptions mocks base method
-func (m *MockAdminOptions) SetIterationOptions(arg0 index.IterationOptions) Options {
+// SetHedgedReadsMinDelay mocks base method
+func (m *MockAdminOptions) SetHedgedReadsMinDelay(value time.Duration) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetIterationOptions", arg0)
+	ret := m.ctrl.Call(m, "SetHedgedReadsMinDelay", value)
 	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetIterationOptions indicates an expected call of SetIterationOptions
-func (mr *MockAdminOptionsMockRecorder) SetIterationOptions(arg0 interface{}) *gomock.Call {
+// SetHedgedReadsMinDelay indicates an expected call of SetHedgedReadsMinDelay
+func (mr *MockAdminOptionsMockRecorder) SetHedgedReadsMinDelay(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIterationOptions", reflect.TypeOf((*MockAdminOptions)(nil).SetIterationOptions), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHedgedReadsMinDelay", reflect.TypeOf((*MockAdminOptions)(nil).SetHedgedReadsMinDelay), value)
 }
 
-// IterationOptions mocks base method
-func (m *MockAdminOptions) IterationOptions() index.IterationOptions {
+// HedgedReadsMinDelay mocks base method
+func (m *MockAdminOptions) HedgedReadsMinDelay() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IterationOptions")
-	ret0, _ := ret[0].(index.IterationOptions)
+	ret := m.ctrl.Call(m, "HedgedReadsMinDelay")
+	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// IterationOptions indicates an expected call of IterationOptions
-func (mr *MockAdminOptionsMockRecorder) IterationOptions() *gomock.Call {
+// HedgedReadsMinDelay indicates an expected call of HedgedReadsMinDelay
+func (mr *MockAdminOptionsMockRecorder) HedgedReadsMinDelay() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterationOptions", reflect.TypeOf((*MockAdminOptions)(nil).IterationOptions))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HedgedReadsMinDelay", reflect.TypeOf((*MockAdminOptions)(nil).HedgedReadsMinDelay))
 }
 
-// SetWriteTimestampOffset mocks base method
-func (m *MockAdminOptions) SetWriteTimestampOffset(value time.Duration) AdminOptions {
+// SetPreferredReadIsolationGroup mocks base method
+func (m *MockAdminOptions) SetPreferredReadIsolationGroup(value string) Options {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetWriteTimestampOffset", value)
-	ret0, _ := ret[0].(AdminOptions)
+	ret := m.ctrl.Call(m, "SetPreferredReadIsolationGroup", value)
+	ret0, _ := ret[0].(Options)
 	return ret0
 }
 
-// SetWriteTimestampOffset indicates an expected call of SetWriteTimestampOffset
-func (mr *MockAdminOptionsMockRecorder) SetWriteTimestampOffset(value interface{}) *gomock.Call {
+// SetPreferredReadIsolationGroup indicates an expected call of SetPreferredReadIsolationGroup
+func (mr *MockAdminOptionsMockRecorder) SetPreferredReadIsolationGroup(value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteTimestampOffset", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteTimestampOffset), value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreferredReadIsolationGroup", reflect.TypeOf((*MockAdminOptions)(nil).SetPreferredReadIsolationGroup), value)
 }
 
-// WriteTimestampOffset mocks base method
-func (m *MockAdminOptions) WriteTimestampOffset() time.Duration {
+// PreferredReadIsolationGroup mocks base method
+func (m *MockAdminOptions) PreferredReadIsolationGroup() string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteTimestampOffset")
-	ret0, _ := ret[0].(time.Duration)
+	ret := m.ctrl.Call(m, "PreferredReadIsolationGroup")
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// WriteTimestampOffset indicates an expected call of WriteTimestampOffset
-func (mr *MockAdminOptionsMockRecorder) WriteTimestampOffset() *gomock.Call {
+// PreferredReadIsolationGroup indicates an expected call of PreferredReadIsolationGroup
+func (mr *MockAdminOptionsMockRecorder) PreferredReadIsolationGroup() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTimestampOffset", reflect.TypeOf((*MockAdminOptions)(nil).WriteTimestampOffset))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreferredReadIsolationGroup", reflect.TypeOf((*MockAdminOptions)(nil).PreferredReadIsolationGroup))
 }
 
 // SetOrigin mocks base method
@@ -4188,6 +5832,20 @@ func (mr *MockclientSessionMockRecorder) Write(namespace, id, t, value, unit, an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockclientSession)(nil).Write), namespace, id, t, value, unit, annotation)
 }
 
+// WriteContext mocks base method
+func (m *MockclientSession) WriteContext(ctx context0.Context, namespace, id ident.ID, t time.Time, value float64, unit time0.Unit, annotation []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteContext", ctx, namespace, id, t, value, unit, annotation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteContext indicates an expected call of WriteContext
+func (mr *MockclientSessionMockRecorder) WriteContext(ctx, namespace, id, t, value, unit, annotation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteContext", reflect.TypeOf((*MockclientSession)(nil).WriteContext), ctx, namespace, id, t, value, unit, annotation)
+}
+
 // WriteTagged mocks base method
 func (m *MockclientSession) WriteTagged(namespace, id ident.ID, tags ident.TagIterator, t time.Time, value float64, unit time0.Unit, annotation []byte) error {
 	m.ctrl.T.Helper()
@@ -4202,6 +5860,34 @@ func (mr *MockclientSessionMockRecorder) WriteTagged(namespace, id, tags, t, val
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTagged", reflect.TypeOf((*MockclientSession)(nil).WriteTagged), namespace, id, tags, t, value, unit, annotation)
 }
 
+// WriteDocument mocks base method
+func (m *MockclientSession) WriteDocument(namespace ident.ID, d doc.Document, t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDocument", namespace, d, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteDocument indicates an expected call of WriteDocument
+func (mr *MockclientSessionMockRecorder) WriteDocument(namespace, d, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDocument", reflect.TypeOf((*MockclientSession)(nil).WriteDocument), namespace, d, t)
+}
+
+// WriteTaggedContext mocks base method
+func (m *MockclientSession) WriteTaggedContext(ctx context0.Context, namespace, id ident.ID, tags ident.TagIterator, t time.Time, value float64, unit time0.Unit, annotation []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteTaggedContext", ctx, namespace, id, tags, t, value, unit, annotation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteTaggedContext indicates an expected call of WriteTaggedContext
+func (mr *MockclientSessionMockRecorder) WriteTaggedContext(ctx, namespace, id, tags, t, value, unit, annotation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTaggedContext", reflect.TypeOf((*MockclientSession)(nil).WriteTaggedContext), ctx, namespace, id, tags, t, value, unit, annotation)
+}
+
 // Fetch mocks base method
 func (m *MockclientSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error) {
 	m.ctrl.T.Helper()
@@ -4217,6 +5903,21 @@ func (mr *MockclientSessionMockRecorder) Fetch(namespace, id, startInclusive, en
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockclientSession)(nil).Fetch), namespace, id, startInclusive, endExclusive)
 }
 
+// FetchContext mocks base method
+func (m *MockclientSession) FetchContext(ctx context0.Context, namespace, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchContext", ctx, namespace, id, startInclusive, endExclusive)
+	ret0, _ := ret[0].(encoding.SeriesIterator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchContext indicates an expected call of FetchContext
+func (mr *MockclientSessionMockRecorder) FetchContext(ctx, namespace, id, startInclusive, endExclusive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchContext", reflect.TypeOf((*MockclientSession)(nil).FetchContext), ctx, namespace, id, startInclusive, endExclusive)
+}
+
 // FetchIDs mocks base method
 func (m *MockclientSession) FetchIDs(namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error) {
 	m.ctrl.T.Helper()
@@ -4232,6 +5933,21 @@ func (mr *MockclientSessionMockRecorder) FetchIDs(namespace, ids, startInclusive
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchIDs", reflect.TypeOf((*MockclientSession)(nil).FetchIDs), namespace, ids, startInclusive, endExclusive)
 }
 
+// FetchIDsContext mocks base method
+func (m *MockclientSession) FetchIDsContext(ctx context0.Context, namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchIDsContext", ctx, namespace, ids, startInclusive, endExclusive)
+	ret0, _ := ret[0].(encoding.SeriesIterators)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchIDsContext indicates an expected call of FetchIDsContext
+func (mr *MockclientSessionMockRecorder) FetchIDsContext(ctx, namespace, ids, startInclusive, endExclusive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchIDsContext", reflect.TypeOf((*MockclientSession)(nil).FetchIDsContext), ctx, namespace, ids, startInclusive, endExclusive)
+}
+
 // FetchTagged mocks base method
 func (m *MockclientSession) FetchTagged(namespace ident.ID, q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, FetchResponseMetadata, error) {
 	m.ctrl.T.Helper()
@@ -4248,6 +5964,22 @@ func (mr *MockclientSessionMockRecorder) FetchTagged(namespace, q, opts interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTagged", reflect.TypeOf((*MockclientSession)(nil).FetchTagged), namespace, q, opts)
 }
 
+// FetchTaggedContext mocks base method
+func (m *MockclientSession) FetchTaggedContext(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedContext", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(encoding.SeriesIterators)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedContext indicates an expected call of FetchTaggedContext
+func (mr *MockclientSessionMockRecorder) FetchTaggedContext(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedContext", reflect.TypeOf((*MockclientSession)(nil).FetchTaggedContext), ctx, namespace, q, opts)
+}
+
 // FetchTaggedIDs mocks base method
 func (m *MockclientSession) FetchTaggedIDs(namespace ident.ID, q index.Query, opts index.QueryOptions) (TaggedIDsIterator, FetchResponseMetadata, error) {
 	m.ctrl.T.Helper()
@@ -4264,6 +5996,54 @@ func (mr *MockclientSessionMockRecorder) FetchTaggedIDs(namespace, q, opts inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDs", reflect.TypeOf((*MockclientSession)(nil).FetchTaggedIDs), namespace, q, opts)
 }
 
+// FetchTaggedIDsContext mocks base method
+func (m *MockclientSession) FetchTaggedIDsContext(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedIDsContext", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(TaggedIDsIterator)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedIDsContext indicates an expected call of FetchTaggedIDsContext
+func (mr *MockclientSessionMockRecorder) FetchTaggedIDsContext(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDsContext", reflect.TypeOf((*MockclientSession)(nil).FetchTaggedIDsContext), ctx, namespace, q, opts)
+}
+
+// FetchTaggedPage mocks base method
+func (m *MockclientSession) FetchTaggedPage(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedPage", ctx, namespace, q, opts, pageToken)
+	ret0, _ := ret[0].(encoding.SeriesIterators)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedPage indicates an expected call of FetchTaggedPage
+func (mr *MockclientSessionMockRecorder) FetchTaggedPage(ctx, namespace, q, opts, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedPage", reflect.TypeOf((*MockclientSession)(nil).FetchTaggedPage), ctx, namespace, q, opts, pageToken)
+}
+
+// FetchTaggedIDsPage mocks base method
+func (m *MockclientSession) FetchTaggedIDsPage(ctx context0.Context, namespace ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedIDsPage", ctx, namespace, q, opts, pageToken)
+	ret0, _ := ret[0].(TaggedIDsIterator)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchTaggedIDsPage indicates an expected call of FetchTaggedIDsPage
+func (mr *MockclientSessionMockRecorder) FetchTaggedIDsPage(ctx, namespace, q, opts, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDsPage", reflect.TypeOf((*MockclientSession)(nil).FetchTaggedIDsPage), ctx, namespace, q, opts, pageToken)
+}
+
 // Aggregate mocks base method
 func (m *MockclientSession) Aggregate(namespace ident.ID, q index.Query, opts index.AggregationOptions) (AggregatedTagsIterator, FetchResponseMetadata, error) {
 	m.ctrl.T.Helper()
@@ -4280,6 +6060,52 @@ func (mr *MockclientSessionMockRecorder) Aggregate(namespace, q, opts interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockclientSession)(nil).Aggregate), namespace, q, opts)
 }
 
+// AggregateContext mocks base method
+func (m *MockclientSession) AggregateContext(ctx context0.Context, namespace ident.ID, q index.Query, opts index.AggregationOptions) (AggregatedTagsIterator, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateContext", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(AggregatedTagsIterator)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AggregateContext indicates an expected call of AggregateContext
+func (mr *MockclientSessionMockRecorder) AggregateContext(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateContext", reflect.TypeOf((*MockclientSession)(nil).AggregateContext), ctx, namespace, q, opts)
+}
+
+// Cardinality mocks base method
+func (m *MockclientSession) Cardinality(namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockclientSessionMockRecorder) Cardinality(namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockclientSession)(nil).Cardinality), namespace, opts)
+}
+
+// CardinalityContext mocks base method
+func (m *MockclientSession) CardinalityContext(ctx context0.Context, namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityContext", ctx, namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardinalityContext indicates an expected call of CardinalityContext
+func (mr *MockclientSessionMockRecorder) CardinalityContext(ctx, namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityContext", reflect.TypeOf((*MockclientSession)(nil).CardinalityContext), ctx, namespace, opts)
+}
+
 // ShardID mocks base method
 func (m *MockclientSession) ShardID(id ident.ID) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -4310,6 +6136,21 @@ func (mr *MockclientSessionMockRecorder) IteratorPools() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IteratorPools", reflect.TypeOf((*MockclientSession)(nil).IteratorPools))
 }
 
+// HostQueueSaturation mocks base method
+func (m *MockclientSession) HostQueueSaturation() ([]HostQueueSaturation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostQueueSaturation")
+	ret0, _ := ret[0].([]HostQueueSaturation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HostQueueSaturation indicates an expected call of HostQueueSaturation
+func (mr *MockclientSessionMockRecorder) HostQueueSaturation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostQueueSaturation", reflect.TypeOf((*MockclientSession)(nil).HostQueueSaturation))
+}
+
 // Close mocks base method
 func (m *MockclientSession) Close() error {
 	m.ctrl.T.Helper()
@@ -4382,6 +6223,21 @@ func (mr *MockclientSessionMockRecorder) Truncate(namespace interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*MockclientSession)(nil).Truncate), namespace)
 }
 
+// DeleteTagged mocks base method
+func (m *MockclientSession) DeleteTagged(namespace ident.ID, q index.Query, start, end time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", namespace, q, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged
+func (mr *MockclientSessionMockRecorder) DeleteTagged(namespace, q, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*MockclientSession)(nil).DeleteTagged), namespace, q, start, end)
+}
+
 // FetchBootstrapBlocksFromPeers mocks base method
 func (m *MockclientSession) FetchBootstrapBlocksFromPeers(namespace namespace.Metadata, shard uint32, start, end time.Time, opts result.Options) (result.ShardResult, error) {
 	m.ctrl.T.Helper()
@@ -4575,6 +6431,20 @@ func (mr *MockhostQueueMockRecorder) BorrowConnection(fn interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BorrowConnection", reflect.TypeOf((*MockhostQueue)(nil).BorrowConnection), fn)
 }
 
+// Saturation mocks base method
+func (m *MockhostQueue) Saturation() HostQueueSaturation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Saturation")
+	ret0, _ := ret[0].(HostQueueSaturation)
+	return ret0
+}
+
+// Saturation indicates an expected call of Saturation
+func (mr *MockhostQueueMockRecorder) Saturation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Saturation", reflect.TypeOf((*MockhostQueue)(nil).Saturation))
+}
+
 // Close mocks base method
 func (m *MockhostQueue) Close() {
 	m.ctrl.T.Helper()
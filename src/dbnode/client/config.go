@@ -21,12 +21,17 @@
 package client
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/environment"
 	"github.com/m3db/m3/src/dbnode/namespace"
@@ -37,6 +42,8 @@ import (
 	"github.com/m3db/m3/src/x/retry"
 	"github.com/m3db/m3/src/x/sampler"
 	xsync "github.com/m3db/m3/src/x/sync"
+
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -106,6 +113,175 @@ type Configuration struct {
 
 	// WriteTimestampOffset offsets all writes by specified duration into the past.
 	WriteTimestampOffset *time.Duration `yaml:"writeTimestampOffset"`
+
+	// ReadRepair configures read repair of diverging replicas on fetches.
+	ReadRepair *ReadRepairConfiguration `yaml:"readRepair"`
+
+	// HintedHandoff configures spooling and replaying of writes that failed
+	// against a replica.
+	HintedHandoff *HintedHandoffConfiguration `yaml:"hintedHandoff"`
+
+	// Transport configures the transport used to communicate with nodes.
+	Transport *TransportConfiguration `yaml:"transport"`
+
+	// AdaptiveWriteBatch configures sizing of write batches from the observed
+	// latency of write requests to each host.
+	AdaptiveWriteBatch *AdaptiveWriteBatchConfiguration `yaml:"adaptiveWriteBatch"`
+
+	// HostQueueSaturationThreshold is the number of writes pending to a host
+	// at which its queue is considered saturated.
+	HostQueueSaturationThreshold *int `yaml:"hostQueueSaturationThreshold"`
+
+	// HedgedReads configures hedging of fetch tagged requests to additional
+	// replicas when slow to respond.
+	HedgedReads *HedgedReadsConfiguration `yaml:"hedgedReads"`
+
+	// ReadPreference configures which replicas fetches prefer to read from.
+	ReadPreference *ReadPreferenceConfiguration `yaml:"readPreference"`
+}
+
+// ReadRepairConfiguration is the configuration for read repair of diverging
+// replicas on fetches.
+type ReadRepairConfiguration struct {
+	// Enabled specifies whether read repair is enabled, only series of
+	// namespaces with cold writes enabled are repaired.
+	Enabled bool `yaml:"enabled"`
+
+	// MaxDatapointsPerSecond is the maximum number of datapoints written back
+	// to replicas per second.
+	MaxDatapointsPerSecond *int `yaml:"maxDatapointsPerSecond"`
+
+	// QueueSize is the maximum number of series pending read repair.
+	QueueSize *int `yaml:"queueSize"`
+}
+
+// HintedHandoffConfiguration is the configuration for spooling and replaying
+// of writes that failed against a replica.
+type HintedHandoffConfiguration struct {
+	// Enabled specifies whether hinted handoff is enabled.
+	Enabled bool `yaml:"enabled"`
+
+	// MaxHintsPerHost is the maximum number of writes spooled for a replica.
+	MaxHintsPerHost *int `yaml:"maxHintsPerHost"`
+
+	// MaxHintAge is the maximum age of a spooled write before it is dropped.
+	MaxHintAge *time.Duration `yaml:"maxHintAge"`
+
+	// ReplayInterval is the interval at which replicas with spooled writes
+	// are checked for being reachable again.
+	ReplayInterval *time.Duration `yaml:"replayInterval"`
+}
+
+// TransportConfiguration is the configuration for the transport used to
+// communicate with nodes.
+type TransportConfiguration struct {
+	// Type is the transport type, either tchannel (default) or grpc.
+	Type TransportType `yaml:"type"`
+
+	// GRPCPort is the port nodes serve gRPC on when using the grpc transport
+	// for nodes that do not advertise a grpc port in the placement, the host
+	// is taken from the topology address of each node.
+	GRPCPort *int `yaml:"grpcPort"`
+
+	// MaxMsgSize is the maximum size of messages sent to and received from
+	// nodes when using the grpc transport.
+	MaxMsgSize *int `yaml:"maxMsgSize"`
+
+	// TLS is the TLS configuration used to connect to nodes when using the
+	// grpc transport, connections are insecure when unset.
+	TLS *TransportTLSConfiguration `yaml:"tls"`
+}
+
+// TransportTLSConfiguration is the TLS configuration used to connect to
+// nodes when using the grpc transport.
+type TransportTLSConfiguration struct {
+	// ServerName is the name used to verify the certificate of nodes.
+	ServerName string `yaml:"serverName"`
+
+	// CACrtPath is the path to the CA certificate used to verify nodes.
+	CACrtPath string `yaml:"caCrt" validate:"nonzero"`
+
+	// ClientCrtPath is the path to the client certificate.
+	ClientCrtPath string `yaml:"clientCrt" validate:"nonzero"`
+
+	// ClientKeyPath is the path to the client key.
+	ClientKeyPath string `yaml:"clientKey" validate:"nonzero"`
+}
+
+// Credentials returns the transport credentials for the TLS configuration.
+func (c TransportTLSConfiguration) Credentials() (credentials.TransportCredentials, error) {
+	caCrt, err := ioutil.ReadFile(c.CACrtPath)
+	if err != nil {
+		return nil, err
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCrt) {
+		return nil, fmt.Errorf("unable to append CA certificate: %s", c.CACrtPath)
+	}
+
+	certificate, err := tls.LoadX509KeyPair(c.ClientCrtPath, c.ClientKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		ServerName:   c.ServerName,
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+	}), nil
+}
+
+// AdaptiveWriteBatchConfiguration is the configuration for sizing of write
+// batches from the observed latency of write requests to each host.
+type AdaptiveWriteBatchConfiguration struct {
+	// Enabled specifies whether adaptive write batches are enabled, the
+	// write batch size is used as the maximum batch size when enabled.
+	Enabled bool `yaml:"enabled"`
+
+	// TargetLatency is the write request latency batches are sized to stay within.
+	TargetLatency *time.Duration `yaml:"targetLatency"`
+
+	// MinSize is the minimum size of write batches.
+	MinSize *int `yaml:"minSize"`
+}
+
+// HedgedReadsConfiguration is the configuration for hedging of fetch tagged
+// requests to additional replicas when slow to respond.
+type HedgedReadsConfiguration struct {
+	// Enabled specifies whether hedged reads are enabled, only fetches at read
+	// consistency level one or unstrict majority are hedged.
+	Enabled bool `yaml:"enabled"`
+
+	// DelayPercentile is the percentile of recent response latencies after
+	// which a fetch is hedged.
+	DelayPercentile *float64 `yaml:"delayPercentile"`
+
+	// MinDelay is the minimum delay after which a fetch is hedged.
+	MinDelay *time.Duration `yaml:"minDelay"`
+}
+
+// ReadPreferenceConfiguration is the configuration for which replicas
+// fetches prefer to read from.
+type ReadPreferenceConfiguration struct {
+	// IsolationGroup is the isolation group, such as the zone or rack of the
+	// client, whose replicas fetches at read consistency level one or unstrict
+	// majority prefer to read from.
+	IsolationGroup string `yaml:"isolationGroup"`
+
+	// IsolationGroupEnvVarName is the environment variable the isolation
+	// group is read from, taking precedence over IsolationGroup when set.
+	IsolationGroupEnvVarName *string `yaml:"isolationGroupEnvVarName"`
+}
+
+// ResolveIsolationGroup returns the isolation group fetches prefer to read from.
+func (c ReadPreferenceConfiguration) ResolveIsolationGroup() string {
+	if c.IsolationGroupEnvVarName != nil {
+		if v := os.Getenv(*c.IsolationGroupEnvVarName); v != "" {
+			return v
+		}
+	}
+	return c.IsolationGroup
 }
 
 // ProtoConfiguration is the configuration for running with ProtoDataMode enabled.
@@ -193,6 +369,61 @@ func (c *Configuration) Validate() error {
 			*c.AsyncWriteMaxConcurrency)
 	}
 
+	if c.ReadRepair != nil {
+		if v := c.ReadRepair.MaxDatapointsPerSecond; v != nil && *v <= 0 {
+			return fmt.Errorf("m3db client read repair max datapoints per second was: %d but must be >0", *v)
+		}
+		if v := c.ReadRepair.QueueSize; v != nil && *v <= 0 {
+			return fmt.Errorf("m3db client read repair queue size was: %d but must be >0", *v)
+		}
+	}
+
+	if c.HintedHandoff != nil {
+		if v := c.HintedHandoff.MaxHintsPerHost; v != nil && *v <= 0 {
+			return fmt.Errorf("m3db client hinted handoff max hints per host was: %d but must be >0", *v)
+		}
+		if v := c.HintedHandoff.MaxHintAge; v != nil && *v <= 0 {
+			return fmt.Errorf("m3db client hinted handoff max hint age was: %v but must be >0", *v)
+		}
+		if v := c.HintedHandoff.ReplayInterval; v != nil && *v <= 0 {
+			return fmt.Errorf("m3db client hinted handoff replay interval was: %v but must be >0", *v)
+		}
+	}
+
+	if c.Transport != nil {
+		if err := ValidateTransportType(c.Transport.Type); err != nil {
+			return fmt.Errorf("m3db client transport invalid: %v", err)
+		}
+		if v := c.Transport.GRPCPort; v != nil && (*v <= 0 || *v > 65535) {
+			return fmt.Errorf("m3db client transport grpc port was: %d but must be >0 and <=65535", *v)
+		}
+		if v := c.Transport.MaxMsgSize; v != nil && *v <= 0 {
+			return fmt.Errorf("m3db client transport max message size was: %d but must be >0", *v)
+		}
+	}
+
+	if c.AdaptiveWriteBatch != nil {
+		if v := c.AdaptiveWriteBatch.TargetLatency; v != nil && *v <= 0 {
+			return fmt.Errorf("m3db client adaptive write batch target latency was: %v but must be >0", *v)
+		}
+		if v := c.AdaptiveWriteBatch.MinSize; v != nil && *v <= 0 {
+			return fmt.Errorf("m3db client adaptive write batch min size was: %d but must be >0", *v)
+		}
+	}
+
+	if v := c.HostQueueSaturationThreshold; v != nil && *v <= 0 {
+		return fmt.Errorf("m3db client host queue saturation threshold was: %d but must be >0", *v)
+	}
+
+	if c.HedgedReads != nil {
+		if v := c.HedgedReads.DelayPercentile; v != nil && (*v <= 0 || *v > 1) {
+			return fmt.Errorf("m3db client hedged reads delay percentile was: %v but must be >0 and <=1", *v)
+		}
+		if v := c.HedgedReads.MinDelay; v != nil && *v < 0 {
+			return fmt.Errorf("m3db client hedged reads min delay was: %v but must be >=0", *v)
+		}
+	}
+
 	if err := c.Proto.Validate(); err != nil {
 		return fmt.Errorf("error validating M3DB client proto configuration: %v", err)
 	}
@@ -218,6 +449,11 @@ type ConfigurationParameters struct {
 	// constructing a client from configuration.
 	TopologyInitializer topology.Initializer
 
+	// NamespaceInitializer is an optional argument when
+	// constructing a client from configuration, it is used by
+	// read repair when set along with the topology initializer.
+	NamespaceInitializer namespace.Initializer
+
 	// EncodingOptions is an optional argument when
 	// constructing a client from configuration.
 	EncodingOptions encoding.Options
@@ -279,6 +515,7 @@ func (c Configuration) NewAdminClient(
 
 	var (
 		syncTopoInit         = params.TopologyInitializer
+		syncNsInit           = params.NamespaceInitializer
 		syncClientOverrides  environment.ClientOverrides
 		asyncTopoInits       = []topology.Initializer{}
 		asyncClientOverrides = []environment.ClientOverrides{}
@@ -299,6 +536,7 @@ func (c Configuration) NewAdminClient(
 				buildAsyncPool = true
 			} else {
 				syncTopoInit = envCfg.TopologyInitializer
+				syncNsInit = envCfg.NamespaceInitializer
 				syncClientOverrides = envCfg.ClientOverrides
 			}
 		}
@@ -353,6 +591,68 @@ func (c Configuration) NewAdminClient(
 	if c.BackgroundHealthCheckFailThrottleFactor != nil {
 		v = v.SetBackgroundHealthCheckFailThrottleFactor(*c.BackgroundHealthCheckFailThrottleFactor)
 	}
+	if c.ReadRepair != nil {
+		v = v.SetReadRepairEnabled(c.ReadRepair.Enabled).
+			SetReadRepairNamespaceInitializer(syncNsInit)
+		if c.ReadRepair.MaxDatapointsPerSecond != nil {
+			v = v.SetReadRepairMaxDatapointsPerSecond(*c.ReadRepair.MaxDatapointsPerSecond)
+		}
+		if c.ReadRepair.QueueSize != nil {
+			v = v.SetReadRepairQueueSize(*c.ReadRepair.QueueSize)
+		}
+	}
+	if c.HintedHandoff != nil {
+		v = v.SetHintedHandoffEnabled(c.HintedHandoff.Enabled)
+		if c.HintedHandoff.MaxHintsPerHost != nil {
+			v = v.SetHintedHandoffMaxHintsPerHost(*c.HintedHandoff.MaxHintsPerHost)
+		}
+		if c.HintedHandoff.MaxHintAge != nil {
+			v = v.SetHintedHandoffMaxHintAge(*c.HintedHandoff.MaxHintAge)
+		}
+		if c.HintedHandoff.ReplayInterval != nil {
+			v = v.SetHintedHandoffReplayInterval(*c.HintedHandoff.ReplayInterval)
+		}
+	}
+	if c.Transport != nil {
+		v = v.SetTransportType(c.Transport.Type)
+		if c.Transport.GRPCPort != nil {
+			v = v.SetGRPCPort(*c.Transport.GRPCPort)
+		}
+		if c.Transport.MaxMsgSize != nil {
+			v = v.SetGRPCMaxMsgSize(*c.Transport.MaxMsgSize)
+		}
+		if c.Transport.TLS != nil {
+			creds, err := c.Transport.TLS.Credentials()
+			if err != nil {
+				return nil, fmt.Errorf("unable to load m3db client transport tls credentials: %v", err)
+			}
+			v = v.SetGRPCTransportCredentials(creds)
+		}
+	}
+	if c.AdaptiveWriteBatch != nil {
+		v = v.SetAdaptiveWriteBatchEnabled(c.AdaptiveWriteBatch.Enabled)
+		if c.AdaptiveWriteBatch.TargetLatency != nil {
+			v = v.SetAdaptiveWriteBatchTargetLatency(*c.AdaptiveWriteBatch.TargetLatency)
+		}
+		if c.AdaptiveWriteBatch.MinSize != nil {
+			v = v.SetAdaptiveWriteBatchMinSize(*c.AdaptiveWriteBatch.MinSize)
+		}
+	}
+	if c.HostQueueSaturationThreshold != nil {
+		v = v.SetHostQueueSaturationThreshold(*c.HostQueueSaturationThreshold)
+	}
+	if c.HedgedReads != nil {
+		v = v.SetHedgedReadsEnabled(c.HedgedReads.Enabled)
+		if c.HedgedReads.DelayPercentile != nil {
+			v = v.SetHedgedReadsDelayPercentile(*c.HedgedReads.DelayPercentile)
+		}
+		if c.HedgedReads.MinDelay != nil {
+			v = v.SetHedgedReadsMinDelay(*c.HedgedReads.MinDelay)
+		}
+	}
+	if c.ReadPreference != nil {
+		v = v.SetPreferredReadIsolationGroup(c.ReadPreference.ResolveIsolationGroup())
+	}
 	if c.WriteTimeout != nil {
 		v = v.SetWriteRequestTimeout(*c.WriteTimeout)
 	}
@@ -382,7 +682,8 @@ func (c Configuration) NewAdminClient(
 
 	v = v.SetReaderIteratorAllocate(func(r io.Reader, _ namespace.SchemaDescr) encoding.ReaderIterator {
 		intOptimized := m3tsz.DefaultIntOptimizationEnabled
-		return m3tsz.NewReaderIterator(r, intOptimized, encodingOpts)
+		return histogram.NewReaderIteratorWithFallback(r,
+			m3tsz.NewReaderIterator(nil, intOptimized, encodingOpts), encodingOpts)
 	})
 
 	if c.Proto != nil && c.Proto.Enabled {
@@ -65,6 +65,15 @@ proto:
     ns2:
       schemaDeployID: "deployID-345"
       messageName: "ns2_msg_name"
+transport:
+  type: grpc
+  grpcPort: 9006
+  maxMsgSize: 67108864
+  tls:
+    serverName: m3db
+    caCrt: /path/to/ca.crt
+    clientCrt: /path/to/client.crt
+    clientKey: /path/to/client.key
 `
 
 	fd, err := ioutil.TempFile("", "config.yaml")
@@ -91,6 +100,8 @@ proto:
 		num4                 = 4
 		numHalf              = 0.5
 		boolTrue             = true
+		port9006             = 9006
+		size64MiB            = 64 << 20
 	)
 
 	expected := Configuration{
@@ -124,7 +135,31 @@ proto:
 				"ns2":    {SchemaDeployID: "deployID-345", MessageName: "ns2_msg_name"},
 			},
 		},
+		Transport: &TransportConfiguration{
+			Type:       GRPCTransportType,
+			GRPCPort:   &port9006,
+			MaxMsgSize: &size64MiB,
+			TLS: &TransportTLSConfiguration{
+				ServerName:    "m3db",
+				CACrtPath:     "/path/to/ca.crt",
+				ClientCrtPath: "/path/to/client.crt",
+				ClientKeyPath: "/path/to/client.key",
+			},
+		},
 	}
 
 	assert.Equal(t, expected, cfg)
 }
+
+func TestReadPreferenceConfigurationResolveIsolationGroup(t *testing.T) {
+	envVarName := "TEST_M3DB_CLIENT_ISOLATION_GROUP"
+	cfg := ReadPreferenceConfiguration{
+		IsolationGroup:           "zone-a",
+		IsolationGroupEnvVarName: &envVarName,
+	}
+	require.Equal(t, "zone-a", cfg.ResolveIsolationGroup())
+
+	require.NoError(t, os.Setenv(envVarName, "zone-b"))
+	defer os.Unsetenv(envVarName)
+	require.Equal(t, "zone-b", cfg.ResolveIsolationGroup())
+}
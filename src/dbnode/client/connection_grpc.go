@@ -0,0 +1,486 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/grpc/convert"
+	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3/src/dbnode/topology"
+	xclose "github.com/m3db/m3/src/x/close"
+
+	"github.com/uber/tchannel-go/thrift"
+	"google.golang.org/grpc"
+)
+
+// grpcAddress returns the address a host serves gRPC on, which is the host
+// of its topology address with the gRPC port advertised in the placement
+// or the configured gRPC port for hosts that do not advertise one.
+func grpcAddress(host topology.Host, opts Options) string {
+	address := host.Address()
+	h, _, err := net.SplitHostPort(address)
+	if err != nil {
+		// Leave invalid addresses to fail when dialed.
+		return address
+	}
+	port := host.GRPCPort()
+	if port == 0 {
+		port = opts.GRPCPort()
+	}
+	return net.JoinHostPort(h, strconv.Itoa(port))
+}
+
+func newGRPCConn(address string, opts Options) (xclose.SimpleCloser, rpc.TChanNode, error) {
+	cc, err := grpc.Dial(address, grpcDialOptions(opts)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	closer := xclose.SimpleCloserFn(func() {
+		cc.Close()
+	})
+	return closer, newGRPCNode(nodepb.NewNodeClient(cc)), nil
+}
+
+func grpcDialOptions(opts Options) []grpc.DialOption {
+	transportOpt := grpc.WithInsecure()
+	if creds := opts.GRPCTransportCredentials(); creds != nil {
+		transportOpt = grpc.WithTransportCredentials(creds)
+	}
+	// Fetch tagged and fetch blocks responses regularly exceed the default
+	// maximum message size of gRPC.
+	return []grpc.DialOption{
+		transportOpt,
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(opts.GRPCMaxMsgSize()),
+			grpc.MaxCallSendMsgSize(opts.GRPCMaxMsgSize()),
+		),
+	}
+}
+
+// grpcNode implements the TChannel Thrift node interface with the gRPC
+// node service so that it can be used by the session interchangeably.
+type grpcNode struct {
+	client nodepb.NodeClient
+}
+
+func newGRPCNode(client nodepb.NodeClient) rpc.TChanNode {
+	return &grpcNode{client: client}
+}
+
+func (n *grpcNode) Health(ctx thrift.Context) (*rpc.NodeHealthResult_, error) {
+	resp, err := n.client.Health(ctx, &nodepb.HealthRequest{})
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCHealthResult(resp), nil
+}
+
+func (n *grpcNode) WriteTaggedBatchRaw(
+	ctx thrift.Context,
+	req *rpc.WriteTaggedBatchRawRequest,
+) error {
+	protoReq, err := convert.ToProtoWriteTaggedBatchRawRequest(req)
+	if err != nil {
+		return tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.WriteTaggedBatchRaw(ctx, protoReq)
+	if err != nil {
+		return convert.FromStatusError(err)
+	}
+	return convert.FromProtoWriteBatchRawResponse(resp)
+}
+
+func (n *grpcNode) FetchTagged(
+	ctx thrift.Context,
+	req *rpc.FetchTaggedRequest,
+) (*rpc.FetchTaggedResult_, error) {
+	protoReq, err := convert.ToProtoFetchTaggedRequest(req)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.FetchTagged(ctx, protoReq)
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCFetchTaggedResult(resp), nil
+}
+
+func (n *grpcNode) AggregateRaw(
+	ctx thrift.Context,
+	req *rpc.AggregateQueryRawRequest,
+) (*rpc.AggregateQueryRawResult_, error) {
+	protoReq, err := convert.ToProtoAggregateRawRequest(req)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.AggregateRaw(ctx, protoReq)
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCAggregateRawResult(resp), nil
+}
+
+func (n *grpcNode) FetchBlocksRaw(
+	ctx thrift.Context,
+	req *rpc.FetchBlocksRawRequest,
+) (*rpc.FetchBlocksRawResult_, error) {
+	resp, err := n.client.FetchBlocksRaw(ctx, convert.ToProtoFetchBlocksRawRequest(req))
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCFetchBlocksRawResult(resp), nil
+}
+
+func (n *grpcNode) Query(ctx thrift.Context, req *rpc.QueryRequest) (*rpc.QueryResult_, error) {
+	protoReq, err := convert.ToProtoQueryRequest(req)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.Query(ctx, protoReq)
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	result, err := convert.ToRPCQueryResult(resp, req.ResultTimeType)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	return result, nil
+}
+
+func (n *grpcNode) Aggregate(
+	ctx thrift.Context,
+	req *rpc.AggregateQueryRequest,
+) (*rpc.AggregateQueryResult_, error) {
+	protoReq, err := convert.ToProtoAggregateRequest(req)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.Aggregate(ctx, protoReq)
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCAggregateResult(resp), nil
+}
+
+func (n *grpcNode) Fetch(ctx thrift.Context, req *rpc.FetchRequest) (*rpc.FetchResult_, error) {
+	protoReq, err := convert.ToProtoFetchRequest(req)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.Fetch(ctx, protoReq)
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	result, err := convert.ToRPCFetchResult(resp, req.ResultTimeType)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	return result, nil
+}
+
+func (n *grpcNode) Write(ctx thrift.Context, req *rpc.WriteRequest) error {
+	protoReq, err := convert.ToProtoWriteRequest(req)
+	if err != nil {
+		return tterrors.NewBadRequestError(err)
+	}
+	if _, err := n.client.Write(ctx, protoReq); err != nil {
+		return convert.FromStatusError(err)
+	}
+	return nil
+}
+
+func (n *grpcNode) WriteTagged(ctx thrift.Context, req *rpc.WriteTaggedRequest) error {
+	protoReq, err := convert.ToProtoWriteTaggedRequest(req)
+	if err != nil {
+		return tterrors.NewBadRequestError(err)
+	}
+	if _, err := n.client.WriteTagged(ctx, protoReq); err != nil {
+		return convert.FromStatusError(err)
+	}
+	return nil
+}
+
+func (n *grpcNode) FetchBatchRaw(
+	ctx thrift.Context,
+	req *rpc.FetchBatchRawRequest,
+) (*rpc.FetchBatchRawResult_, error) {
+	protoReq, err := convert.ToProtoFetchBatchRawRequest(req)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.FetchBatchRaw(ctx, protoReq)
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCFetchBatchRawResult(resp), nil
+}
+
+func (n *grpcNode) FetchBatchRawV2(
+	ctx thrift.Context,
+	req *rpc.FetchBatchRawV2Request,
+) (*rpc.FetchBatchRawResult_, error) {
+	protoReq, err := convert.ToProtoFetchBatchRawV2Request(req)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.FetchBatchRawV2(ctx, protoReq)
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCFetchBatchRawResult(resp), nil
+}
+
+func (n *grpcNode) FetchBlocksMetadataRawV2(
+	ctx thrift.Context,
+	req *rpc.FetchBlocksMetadataRawV2Request,
+) (*rpc.FetchBlocksMetadataRawV2Result_, error) {
+	resp, err := n.client.FetchBlocksMetadataRawV2(ctx,
+		convert.ToProtoFetchBlocksMetadataRawV2Request(req))
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCFetchBlocksMetadataRawV2Result(resp), nil
+}
+
+func (n *grpcNode) WriteBatchRaw(ctx thrift.Context, req *rpc.WriteBatchRawRequest) error {
+	protoReq, err := convert.ToProtoWriteBatchRawRequest(req)
+	if err != nil {
+		return tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.WriteBatchRaw(ctx, protoReq)
+	if err != nil {
+		return convert.FromStatusError(err)
+	}
+	return convert.FromProtoWriteBatchRawResponse(resp)
+}
+
+func (n *grpcNode) WriteBatchRawV2(ctx thrift.Context, req *rpc.WriteBatchRawV2Request) error {
+	protoReq, err := convert.ToProtoWriteBatchRawV2Request(req)
+	if err != nil {
+		return tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.WriteBatchRawV2(ctx, protoReq)
+	if err != nil {
+		return convert.FromStatusError(err)
+	}
+	return convert.FromProtoWriteBatchRawResponse(resp)
+}
+
+func (n *grpcNode) WriteTaggedBatchRawV2(
+	ctx thrift.Context,
+	req *rpc.WriteTaggedBatchRawV2Request,
+) error {
+	protoReq, err := convert.ToProtoWriteTaggedBatchRawV2Request(req)
+	if err != nil {
+		return tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.WriteTaggedBatchRawV2(ctx, protoReq)
+	if err != nil {
+		return convert.FromStatusError(err)
+	}
+	return convert.FromProtoWriteBatchRawResponse(resp)
+}
+
+func (n *grpcNode) Repair(ctx thrift.Context) error {
+	if _, err := n.client.Repair(ctx, &nodepb.RepairRequest{}); err != nil {
+		return convert.FromStatusError(err)
+	}
+	return nil
+}
+
+func (n *grpcNode) Truncate(ctx thrift.Context, req *rpc.TruncateRequest) (*rpc.TruncateResult_, error) {
+	resp, err := n.client.Truncate(ctx, &nodepb.TruncateRequest{NameSpace: req.NameSpace})
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	result := rpc.NewTruncateResult_()
+	result.NumSeries = resp.NumSeries
+	return result, nil
+}
+
+func (n *grpcNode) DeleteTagged(
+	ctx thrift.Context,
+	req *rpc.DeleteTaggedRequest,
+) (*rpc.DeleteTaggedResult_, error) {
+	protoReq, err := convert.ToProtoDeleteTaggedRequest(req)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.DeleteTagged(ctx, protoReq)
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCDeleteTaggedResult(resp), nil
+}
+
+func (n *grpcNode) Cardinality(
+	ctx thrift.Context,
+	req *rpc.CardinalityRequest,
+) (*rpc.CardinalityResult_, error) {
+	protoReq, err := convert.ToProtoCardinalityRequest(req)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.Cardinality(ctx, protoReq)
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCCardinalityResult(resp), nil
+}
+
+func (n *grpcNode) WriteDocument(ctx thrift.Context, req *rpc.WriteDocumentRequest) error {
+	protoReq, err := convert.ToProtoWriteDocumentRequest(req)
+	if err != nil {
+		return tterrors.NewBadRequestError(err)
+	}
+	if _, err := n.client.WriteDocument(ctx, protoReq); err != nil {
+		return convert.FromStatusError(err)
+	}
+	return nil
+}
+
+func (n *grpcNode) Bootstrapped(ctx thrift.Context) (*rpc.NodeBootstrappedResult_, error) {
+	if _, err := n.client.Bootstrapped(ctx, &nodepb.BootstrappedRequest{}); err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return rpc.NewNodeBootstrappedResult_(), nil
+}
+
+func (n *grpcNode) BootstrappedInPlacementOrNoPlacement(
+	ctx thrift.Context,
+) (*rpc.NodeBootstrappedInPlacementOrNoPlacementResult_, error) {
+	_, err := n.client.BootstrappedInPlacementOrNoPlacement(ctx, &nodepb.BootstrappedRequest{})
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return rpc.NewNodeBootstrappedInPlacementOrNoPlacementResult_(), nil
+}
+
+func (n *grpcNode) GetPersistRateLimit(ctx thrift.Context) (*rpc.NodePersistRateLimitResult_, error) {
+	resp, err := n.client.GetPersistRateLimit(ctx, &nodepb.GetPersistRateLimitRequest{})
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCPersistRateLimitResult(resp), nil
+}
+
+func (n *grpcNode) SetPersistRateLimit(
+	ctx thrift.Context,
+	req *rpc.NodeSetPersistRateLimitRequest,
+) (*rpc.NodePersistRateLimitResult_, error) {
+	resp, err := n.client.SetPersistRateLimit(ctx,
+		convert.ToProtoSetPersistRateLimitRequest(req))
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCPersistRateLimitResult(resp), nil
+}
+
+func (n *grpcNode) GetWriteNewSeriesAsync(
+	ctx thrift.Context,
+) (*rpc.NodeWriteNewSeriesAsyncResult_, error) {
+	resp, err := n.client.GetWriteNewSeriesAsync(ctx, &nodepb.GetWriteNewSeriesAsyncRequest{})
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCWriteNewSeriesAsyncResult(resp), nil
+}
+
+func (n *grpcNode) SetWriteNewSeriesAsync(
+	ctx thrift.Context,
+	req *rpc.NodeSetWriteNewSeriesAsyncRequest,
+) (*rpc.NodeWriteNewSeriesAsyncResult_, error) {
+	resp, err := n.client.SetWriteNewSeriesAsync(ctx, &nodepb.SetWriteNewSeriesAsyncRequest{
+		WriteNewSeriesAsync: req.WriteNewSeriesAsync,
+	})
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCWriteNewSeriesAsyncResult(resp), nil
+}
+
+func (n *grpcNode) GetWriteNewSeriesBackoffDuration(
+	ctx thrift.Context,
+) (*rpc.NodeWriteNewSeriesBackoffDurationResult_, error) {
+	resp, err := n.client.GetWriteNewSeriesBackoffDuration(ctx,
+		&nodepb.GetWriteNewSeriesBackoffDurationRequest{})
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCWriteNewSeriesBackoffDurationResult(resp), nil
+}
+
+func (n *grpcNode) SetWriteNewSeriesBackoffDuration(
+	ctx thrift.Context,
+	req *rpc.NodeSetWriteNewSeriesBackoffDurationRequest,
+) (*rpc.NodeWriteNewSeriesBackoffDurationResult_, error) {
+	protoReq, err := convert.ToProtoSetWriteNewSeriesBackoffDurationRequest(req)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	resp, err := n.client.SetWriteNewSeriesBackoffDuration(ctx, protoReq)
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCWriteNewSeriesBackoffDurationResult(resp), nil
+}
+
+func (n *grpcNode) GetWriteNewSeriesLimitPerShardPerSecond(
+	ctx thrift.Context,
+) (*rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_, error) {
+	resp, err := n.client.GetWriteNewSeriesLimitPerShardPerSecond(ctx,
+		&nodepb.GetWriteNewSeriesLimitPerShardPerSecondRequest{})
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCWriteNewSeriesLimitPerShardPerSecondResult(resp), nil
+}
+
+func (n *grpcNode) SetWriteNewSeriesLimitPerShardPerSecond(
+	ctx thrift.Context,
+	req *rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest,
+) (*rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_, error) {
+	resp, err := n.client.SetWriteNewSeriesLimitPerShardPerSecond(ctx,
+		&nodepb.SetWriteNewSeriesLimitPerShardPerSecondRequest{
+			WriteNewSeriesLimitPerShardPerSecond: req.WriteNewSeriesLimitPerShardPerSecond,
+		})
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return convert.ToRPCWriteNewSeriesLimitPerShardPerSecondResult(resp), nil
+}
+
+func (n *grpcNode) DebugIndexMemorySegments(
+	ctx thrift.Context,
+	req *rpc.DebugIndexMemorySegmentsRequest,
+) (*rpc.DebugIndexMemorySegmentsResult_, error) {
+	_, err := n.client.DebugIndexMemorySegments(ctx, &nodepb.DebugIndexMemorySegmentsRequest{
+		Directory: req.Directory,
+	})
+	if err != nil {
+		return nil, convert.FromStatusError(err)
+	}
+	return rpc.NewDebugIndexMemorySegmentsResult_(), nil
+}
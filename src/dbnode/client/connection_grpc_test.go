@@ -0,0 +1,232 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	stdctx "context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/dbnode/topology"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go/thrift"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+type testGRPCNodeClient struct {
+	nodepb.NodeClient
+
+	writeReq          *nodepb.WriteTaggedBatchRawRequest
+	writeResp         *nodepb.WriteBatchRawResponse
+	fetchReq          *nodepb.FetchTaggedRequest
+	fetchResp         *nodepb.FetchTaggedResponse
+	untaggedWriteReq  *nodepb.WriteRequest
+	untaggedFetchReq  *nodepb.FetchRequest
+	untaggedFetchResp *nodepb.FetchResponse
+	err               error
+}
+
+func (c *testGRPCNodeClient) WriteTaggedBatchRaw(
+	ctx stdctx.Context,
+	in *nodepb.WriteTaggedBatchRawRequest,
+	opts ...grpc.CallOption,
+) (*nodepb.WriteBatchRawResponse, error) {
+	c.writeReq = in
+	return c.writeResp, c.err
+}
+
+func (c *testGRPCNodeClient) FetchTagged(
+	ctx stdctx.Context,
+	in *nodepb.FetchTaggedRequest,
+	opts ...grpc.CallOption,
+) (*nodepb.FetchTaggedResponse, error) {
+	c.fetchReq = in
+	return c.fetchResp, c.err
+}
+
+func (c *testGRPCNodeClient) Write(
+	ctx stdctx.Context,
+	in *nodepb.WriteRequest,
+	opts ...grpc.CallOption,
+) (*nodepb.WriteResponse, error) {
+	c.untaggedWriteReq = in
+	return &nodepb.WriteResponse{}, c.err
+}
+
+func (c *testGRPCNodeClient) Fetch(
+	ctx stdctx.Context,
+	in *nodepb.FetchRequest,
+	opts ...grpc.CallOption,
+) (*nodepb.FetchResponse, error) {
+	c.untaggedFetchReq = in
+	return c.untaggedFetchResp, c.err
+}
+
+func newTestGRPCContext() thrift.Context {
+	ctx, _ := thrift.NewContext(time.Minute)
+	return ctx
+}
+
+func TestGRPCNodeWriteTaggedBatchRaw(t *testing.T) {
+	client := &testGRPCNodeClient{
+		writeResp: &nodepb.WriteBatchRawResponse{
+			Errors: []*nodepb.WriteBatchRawError{
+				{
+					Index: 1,
+					Err: &nodepb.Error{
+						Type:    nodepb.ErrorType_BAD_REQUEST,
+						Message: "bad",
+					},
+				},
+			},
+		},
+	}
+	node := newGRPCNode(client)
+
+	req := rpc.NewWriteTaggedBatchRawRequest()
+	req.NameSpace = []byte("ns")
+	for _, id := range []string{"foo", "bar"} {
+		elem := rpc.NewWriteTaggedBatchRawRequestElement()
+		elem.ID = []byte(id)
+		elem.Datapoint = rpc.NewDatapoint()
+		elem.Datapoint.Timestamp = 10
+		elem.Datapoint.TimestampTimeType = rpc.TimeType_UNIX_SECONDS
+		elem.Datapoint.Value = 42
+		req.Elements = append(req.Elements, elem)
+	}
+
+	err := node.WriteTaggedBatchRaw(newTestGRPCContext(), req)
+	require.Error(t, err)
+
+	batchErrs, ok := err.(*rpc.WriteBatchRawErrors)
+	require.True(t, ok)
+	require.Len(t, batchErrs.Errors, 1)
+	assert.Equal(t, int64(1), batchErrs.Errors[0].Index)
+	assert.Equal(t, rpc.ErrorType_BAD_REQUEST, batchErrs.Errors[0].Err.Type)
+
+	require.NotNil(t, client.writeReq)
+	require.Len(t, client.writeReq.Elements, 2)
+	assert.Equal(t, int64(10*time.Second), client.writeReq.Elements[0].Datapoint.Timestamp)
+	assert.Equal(t, float64(42), client.writeReq.Elements[0].Datapoint.Value)
+}
+
+func TestGRPCNodeFetchTaggedStatusError(t *testing.T) {
+	client := &testGRPCNodeClient{
+		err: grpcstatus.Error(codes.ResourceExhausted, "exhausted"),
+	}
+	node := newGRPCNode(client)
+
+	req := rpc.NewFetchTaggedRequest()
+	req.NameSpace = []byte("ns")
+	req.RangeTimeType = rpc.TimeType_UNIX_NANOSECONDS
+
+	_, err := node.FetchTagged(newTestGRPCContext(), req)
+	require.Error(t, err)
+	assert.True(t, IsResourceExhaustedError(err))
+	require.NotNil(t, client.fetchReq)
+	assert.Equal(t, []byte("ns"), client.fetchReq.NameSpace)
+}
+
+func TestGRPCNodeWrite(t *testing.T) {
+	client := &testGRPCNodeClient{}
+	node := newGRPCNode(client)
+
+	req := rpc.NewWriteRequest()
+	req.NameSpace = "ns"
+	req.ID = "foo"
+	req.Datapoint = rpc.NewDatapoint()
+	req.Datapoint.Timestamp = 10
+	req.Datapoint.TimestampTimeType = rpc.TimeType_UNIX_SECONDS
+	req.Datapoint.Value = 42
+
+	require.NoError(t, node.Write(newTestGRPCContext(), req))
+	require.NotNil(t, client.untaggedWriteReq)
+	assert.Equal(t, "ns", client.untaggedWriteReq.NameSpace)
+	assert.Equal(t, "foo", client.untaggedWriteReq.Id)
+	assert.Equal(t, int64(10*time.Second), client.untaggedWriteReq.Datapoint.Timestamp)
+}
+
+func TestGRPCNodeFetchConvertsResultTimeType(t *testing.T) {
+	client := &testGRPCNodeClient{
+		untaggedFetchResp: &nodepb.FetchResponse{
+			Datapoints: []*nodepb.Datapoint{
+				{Timestamp: int64(30 * time.Second), Value: 42},
+			},
+		},
+	}
+	node := newGRPCNode(client)
+
+	req := rpc.NewFetchRequest()
+	req.NameSpace = "ns"
+	req.ID = "foo"
+	req.RangeStart = 10
+	req.RangeEnd = 20
+	req.RangeType = rpc.TimeType_UNIX_SECONDS
+	req.ResultTimeType = rpc.TimeType_UNIX_SECONDS
+
+	result, err := node.Fetch(newTestGRPCContext(), req)
+	require.NoError(t, err)
+	require.Len(t, result.Datapoints, 1)
+	assert.Equal(t, int64(30), result.Datapoints[0].Timestamp)
+	assert.Equal(t, float64(42), result.Datapoints[0].Value)
+
+	require.NotNil(t, client.untaggedFetchReq)
+	assert.Equal(t, int64(10*time.Second), client.untaggedFetchReq.RangeStart)
+	assert.Equal(t, int64(20*time.Second), client.untaggedFetchReq.RangeEnd)
+}
+
+func TestGRPCNodeTransportError(t *testing.T) {
+	transportErr := errors.New("connection refused")
+	node := newGRPCNode(&testGRPCNodeClient{err: transportErr})
+
+	req := rpc.NewFetchTaggedRequest()
+	req.RangeTimeType = rpc.TimeType_UNIX_NANOSECONDS
+
+	_, err := node.FetchTagged(newTestGRPCContext(), req)
+	assert.Equal(t, transportErr, err)
+}
+
+func TestGRPCAddress(t *testing.T) {
+	opts := NewOptions().SetGRPCPort(9006)
+
+	host := topology.NewHost("h1", "10.0.0.1:9000")
+	assert.Equal(t, "10.0.0.1:9006", grpcAddress(host, opts))
+
+	si := services.NewServiceInstance().
+		SetInstanceID("h2").
+		SetEndpoint("10.0.0.2:9000").
+		SetGRPCPort(9010).
+		SetShards(shard.NewShards([]shard.Shard{shard.NewShard(0)}))
+	hostShardSet, err := topology.NewHostShardSetFromServiceInstance(si,
+		sharding.DefaultHashFn(1))
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.2:9010", grpcAddress(hostShardSet.Host(), opts))
+}
@@ -173,6 +173,9 @@ func (p *connPool) connectEvery(interval time.Duration, stutter time.Duration) {
 		}
 
 		address := p.host.Address()
+		if p.opts.TransportType() == GRPCTransportType {
+			address = grpcAddress(p.host, p.opts)
+		}
 
 		var wg sync.WaitGroup
 		for i := 0; i < target-poolLen; i++ {
@@ -297,6 +300,9 @@ func (p *connPool) healthCheckEvery(interval time.Duration, stutter time.Duratio
 }
 
 func newConn(channelName string, address string, opts Options) (xclose.SimpleCloser, rpc.TChanNode, error) {
+	if opts.TransportType() == GRPCTransportType {
+		return newGRPCConn(address, opts)
+	}
 	channel, err := tchannel.NewChannel(channelName, opts.ChannelOptions())
 	if err != nil {
 		return nil, nil, err
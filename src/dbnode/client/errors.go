@@ -54,6 +54,18 @@ func IsBadRequestError(err error) bool {
 	return false
 }
 
+// IsResourceExhaustedError determines if the error is a resource exhausted
+// error, such as returned when a write or query exceeds a namespace quota.
+func IsResourceExhaustedError(err error) bool {
+	for err != nil {
+		if e, ok := err.(*rpc.Error); ok && tterrors.IsResourceExhaustedError(e) {
+			return true
+		}
+		err = xerrors.InnerError(err)
+	}
+	return false
+}
+
 // IsConsistencyResultError determines if the error is a consistency result error.
 func IsConsistencyResultError(err error) bool {
 	_, ok := err.(consistencyResultErr)
@@ -137,8 +149,22 @@ func newConsistencyResultError(
 	enqueued, responded int,
 	errs []error,
 ) consistencyResultError {
+	return consistencyResultErr{
+		level:       level,
+		success:     enqueued - len(errs),
+		enqueued:    enqueued,
+		responded:   responded,
+		topLevelErr: topLevelError(errs),
+		errs:        append([]error(nil), errs...),
+	}
+}
+
+// topLevelError returns the error that classifies a set of errors.
+func topLevelError(errs []error) error {
 	// NB(r): if any errors are bad request errors, encapsulate that error
-	// to ensure the error itself is wholly classified as a bad request error
+	// to ensure the error itself is wholly classified as a bad request error,
+	// failing that prefer resource exhausted errors so that callers can
+	// surface quota rejections.
 	var topLevelErr error
 	for i := 0; i < len(errs); i++ {
 		if topLevelErr == nil {
@@ -149,15 +175,11 @@ func newConsistencyResultError(
 			topLevelErr = errs[i]
 			break
 		}
+		if IsResourceExhaustedError(errs[i]) && !IsResourceExhaustedError(topLevelErr) {
+			topLevelErr = errs[i]
+		}
 	}
-	return consistencyResultErr{
-		level:       level,
-		success:     enqueued - len(errs),
-		enqueued:    enqueued,
-		responded:   responded,
-		topLevelErr: topLevelErr,
-		errs:        append([]error(nil), errs...),
-	}
+	return topLevelErr
 }
 
 func (e consistencyResultErr) InnerError() error {
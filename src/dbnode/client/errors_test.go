@@ -52,3 +52,19 @@ func TestConsistencyResultError(t *testing.T) {
 	assert.Equal(t, 1, NumSuccess(err))
 	assert.Equal(t, 2, NumError(err))
 }
+
+func TestConsistencyResultErrorResourceExhausted(t *testing.T) {
+	exhaustedErr := &rpc.Error{
+		Type: rpc.ErrorType_RESOURCE_EXHAUSTED,
+	}
+
+	level := topology.ConsistencyLevelMajority
+	errs := []error{fmt.Errorf("another error"), exhaustedErr}
+
+	err := error(newConsistencyResultError(level, 3, 3, errs))
+
+	assert.Equal(t, exhaustedErr, xerrors.InnerError(err))
+	assert.True(t, IsResourceExhaustedError(err))
+	assert.False(t, IsBadRequestError(err))
+	assert.False(t, IsInternalServerError(err))
+}
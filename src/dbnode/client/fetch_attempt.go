@@ -21,6 +21,7 @@
 package client
 
 import (
+	stdctx "context"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/encoding"
@@ -39,10 +40,12 @@ type fetchAttempt struct {
 
 	session *session
 
-	attemptFn xretry.Fn
+	attemptFn  xretry.Fn
+	continueFn xretry.ContinueFn
 }
 
 type fetchAttemptArgs struct {
+	ctx       stdctx.Context
 	namespace ident.ID
 	ids       ident.Iterator
 	start     time.Time
@@ -54,13 +57,17 @@ func (f *fetchAttempt) reset() {
 	f.result = nil
 }
 
+func (f *fetchAttempt) shouldContinue(attempt int) bool {
+	return f.args.ctx.Err() == nil
+}
+
 func (f *fetchAttempt) perform() error {
-	result, err := f.session.fetchIDsAttempt(f.args.namespace,
+	result, err := f.session.fetchIDsAttempt(f.args.ctx, f.args.namespace,
 		f.args.ids, f.args.start, f.args.end)
 	f.result = result
 
-	if IsBadRequestError(err) {
-		// Do not retry bad request errors
+	if IsBadRequestError(err) || IsResourceExhaustedError(err) {
+		// Do not retry bad request or resource exhausted errors
 		err = xerrors.NewNonRetryableError(err)
 	}
 
@@ -86,6 +93,7 @@ func (p *fetchAttemptPool) Init() {
 		// NB(r): Bind attemptFn once to avoid creating receiver
 		// and function method pointer over and over again
 		w.attemptFn = w.perform
+		w.continueFn = w.shouldContinue
 		w.reset()
 		return w
 	})
@@ -28,6 +28,8 @@ import (
 
 type fetchBatchOp struct {
 	checked.RefCount
+	opContext
+
 	request           rpc.FetchBatchRawRequest
 	requestV2Elements []rpc.FetchBatchRawV2RequestElement
 	completionFns     []completionFn
@@ -56,6 +58,7 @@ func (f *fetchBatchOp) reset() {
 		f.requestV2Elements[i].RangeTimeType = 0
 	}
 	f.requestV2Elements = f.requestV2Elements[:0]
+	f.setContext(nil)
 
 	f.DecWrites()
 }
@@ -21,6 +21,7 @@
 package client
 
 import (
+	stdctx "context"
 	"errors"
 	"fmt"
 	"sync"
@@ -31,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/serialize"
 )
@@ -70,6 +72,21 @@ type fetchState struct {
 	// is used for - fetchTagged or Aggregate.
 	stateType fetchStateType
 
+	// hedgeQueues is set when the op is initially sent to a subset of hosts,
+	// the op is hedged to them on the first error or, if hedgedReads is set,
+	// once slow to respond.
+	hedgedReads  *hedgedReads
+	hedgeQueues  []hostQueue
+	hedgeHostIDs map[string]struct{}
+	hedgeTimer   *time.Timer
+	hedged       bool
+	startTime    time.Time
+	nowFn        func() time.Time
+
+	// watchStopCh is closed once the fetch is done to stop watching the
+	// context of the caller.
+	watchStopCh chan struct{}
+
 	done bool
 }
 
@@ -98,6 +115,14 @@ func (f *fetchState) close() {
 	}
 	f.err = nil
 	f.done = false
+	f.hedgedReads = nil
+	f.hedgeQueues = nil
+	f.hedgeHostIDs = nil
+	f.hedgeTimer = nil
+	f.hedged = false
+	f.startTime = time.Time{}
+	f.nowFn = nil
+	f.watchStopCh = nil
 	f.tagResultAccumulator.Clear()
 
 	if f.pool == nil {
@@ -117,6 +142,118 @@ func (f *fetchState) ResetFetchTagged(
 	f.fetchTaggedOp = op
 	f.stateType = fetchTaggedFetchState
 	f.tagResultAccumulator.Reset(startTime, endTime, topoMap, majority, consistencyLevel)
+	if op.paginated() {
+		f.tagResultAccumulator.ResetPagination()
+	}
+	if op.readRepair {
+		f.tagResultAccumulator.ResetReadRepair()
+	}
+	if op.explain != nil {
+		f.tagResultAccumulator.ResetExplain(op.explain)
+	}
+}
+
+// ResetHedge sets the op to be initially sent to only the given hosts and to
+// be hedged to the hedge queues, it must be called before the op is enqueued.
+// The op is only hedged on the first error if hedgedReads is nil.
+func (f *fetchState) ResetHedge(
+	hedgedReads *hedgedReads,
+	initial []hostQueue,
+	hedge []hostQueue,
+	nowFn func() time.Time,
+) {
+	hosts := make([]topology.Host, 0, len(initial))
+	for _, hq := range initial {
+		hosts = append(hosts, hq.Host())
+	}
+	f.tagResultAccumulator.ResetEnqueuedHosts(hosts)
+	f.hedgedReads = hedgedReads
+	f.hedgeQueues = hedge
+	f.nowFn = nowFn
+	f.startTime = nowFn()
+}
+
+// StartHedgeTimerWithLock hedges the op once the hedge delay elapses if it
+// is not done by then, it must be called after the op is enqueued.
+func (f *fetchState) StartHedgeTimerWithLock() {
+	if f.hedgedReads == nil || len(f.hedgeQueues) == 0 {
+		return
+	}
+	f.incRef() // released once the timer fires or is stopped
+	f.hedgeTimer = time.AfterFunc(f.hedgedReads.Delay(), f.hedgeAfterDelay)
+}
+
+func (f *fetchState) hedgeAfterDelay() {
+	f.Lock()
+	if !f.done {
+		f.hedgeWithLock()
+	}
+	f.Unlock()
+	f.decRef() // release ref held onto by the timer
+}
+
+func (f *fetchState) hedgeWithLock() {
+	if f.hedged {
+		return
+	}
+	f.hedged = true
+	f.hedgeHostIDs = make(map[string]struct{}, len(f.hedgeQueues))
+	for _, hq := range f.hedgeQueues {
+		// inc to indicate the hostQueue has a reference to the op which has a ref to the fetchState
+		f.incRef()
+		if err := hq.Enqueue(f.fetchTaggedOp); err != nil {
+			// NB: the queue was closed by a topology change, the hosts
+			// already enqueued can still satisfy the request.
+			f.decRef()
+			continue
+		}
+		f.tagResultAccumulator.AddEnqueuedHost(hq.Host())
+		f.hedgeHostIDs[hq.Host().ID()] = struct{}{}
+		if f.hedgedReads != nil {
+			f.hedgedReads.metrics.hedgesSent.Inc(1)
+		}
+	}
+}
+
+func (f *fetchState) stopHedgeTimerWithLock() {
+	if f.hedgeTimer != nil && f.hedgeTimer.Stop() {
+		// NB: the completing host queue still holds a ref so this can not
+		// release the fetch state.
+		f.decRef()
+	}
+	f.hedgeTimer = nil
+}
+
+// WatchContextWithLock marks the fetch done with the context error once the
+// context is done, so that the caller stops waiting on hosts that have yet to
+// respond, it must be called after the op is enqueued.
+func (f *fetchState) WatchContextWithLock(ctx stdctx.Context) {
+	if ctx == nil || ctx.Done() == nil {
+		return
+	}
+	f.incRef() // released once the watch returns
+	f.watchStopCh = make(chan struct{})
+	go f.watchContext(ctx, f.watchStopCh)
+}
+
+func (f *fetchState) watchContext(ctx stdctx.Context, stopCh chan struct{}) {
+	select {
+	case <-ctx.Done():
+		f.Lock()
+		if !f.done {
+			f.markDoneWithLock(xerrors.NewNonRetryableError(ctx.Err()))
+		}
+		f.Unlock()
+	case <-stopCh:
+	}
+	f.decRef() // release ref held onto by the watch
+}
+
+func (f *fetchState) stopContextWatchWithLock() {
+	if f.watchStopCh != nil {
+		close(f.watchStopCh)
+		f.watchStopCh = nil
+	}
 }
 
 func (f *fetchState) ResetAggregate(
@@ -142,12 +279,31 @@ func (f *fetchState) completionFn(
 		f.decRef() // release ref held onto by the hostQueue (via op.completionFn)
 	}()
 
+	var hedgeWon bool
+	if f.hedgedReads != nil {
+		r, _ := result.(fetchTaggedResultAccumulatorOpts)
+		if r.host != nil {
+			_, hedgeWon = f.hedgeHostIDs[r.host.ID()]
+		}
+		if !hedgeWon {
+			// NB: record the latency of responses after the fetch is done
+			// too, otherwise the slowest responses would never be recorded.
+			f.hedgedReads.RecordLatency(f.nowFn().Sub(f.startTime))
+		}
+	}
+
 	if f.done {
 		// i.e. we've already failed, no need to continue processing any additional
 		// responses we receive
 		return
 	}
 
+	if len(f.hedgeQueues) > 0 && resultErr != nil {
+		// Hedge before accounting for the error so that the shards of the
+		// failed host are not marked as unsatisfiable.
+		f.hedgeWithLock()
+	}
+
 	var (
 		done bool
 		err  error
@@ -167,11 +323,16 @@ func (f *fetchState) completionFn(
 	}
 
 	if done {
+		if hedgeWon && err == nil {
+			f.hedgedReads.metrics.hedgesWon.Inc(1)
+		}
 		f.markDoneWithLock(err)
 	}
 }
 
 func (f *fetchState) markDoneWithLock(err error) {
+	f.stopHedgeTimerWithLock()
+	f.stopContextWatchWithLock()
 	f.done = true
 	f.err = err
 	f.Signal()
@@ -227,6 +388,14 @@ func (f *fetchState) asEncodingSeriesIterators(
 	return f.tagResultAccumulator.AsEncodingSeriesIterators(limit, pools, descr, opts)
 }
 
+// readRepairSeries returns the series with diverging replicas found when the
+// results were returned as series iterators.
+func (f *fetchState) readRepairSeries() []readRepairSeries {
+	f.Lock()
+	defer f.Unlock()
+	return f.tagResultAccumulator.ReadRepairSeries()
+}
+
 func (f *fetchState) asAggregatedTagsIterator(pools fetchTaggedPools) (AggregatedTagsIterator, FetchResponseMetadata, error) {
 	f.Lock()
 	defer f.Unlock()
@@ -21,8 +21,11 @@
 package client
 
 import (
+	stdctx "context"
+
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
 	xretry "github.com/m3db/m3/src/x/retry"
@@ -38,6 +41,7 @@ type fetchTaggedAttempt struct {
 
 	idsAttemptFn       xretry.Fn
 	dataAttemptFn      xretry.Fn
+	continueFn         xretry.ContinueFn
 	idsResultIter      TaggedIDsIterator
 	dataResultIters    encoding.SeriesIterators
 	idsResultMetadata  FetchResponseMetadata
@@ -45,6 +49,7 @@ type fetchTaggedAttempt struct {
 }
 
 type fetchTaggedAttemptArgs struct {
+	ctx   stdctx.Context
 	ns    ident.ID
 	query index.Query
 	opts  index.QueryOptions
@@ -59,17 +64,33 @@ func (f *fetchTaggedAttempt) reset() {
 	f.dataResultMetadata = FetchResponseMetadata{}
 }
 
+func (f *fetchTaggedAttempt) shouldContinue(attempt int) bool {
+	return f.args.ctx.Err() == nil
+}
+
 func (f *fetchTaggedAttempt) performIDsAttempt() error {
 	var err error
 	f.idsResultIter, f.idsResultMetadata, err = f.session.fetchTaggedIDsAttempt(
-		f.args.ns, f.args.query, f.args.opts)
+		f.args.ctx, f.args.ns, f.args.query, f.args.opts)
+
+	if IsBadRequestError(err) || IsResourceExhaustedError(err) {
+		// Do not retry bad request or resource exhausted errors
+		err = xerrors.NewNonRetryableError(err)
+	}
+
 	return err
 }
 
 func (f *fetchTaggedAttempt) performDataAttempt() error {
 	var err error
 	f.dataResultIters, f.dataResultMetadata, err = f.session.fetchTaggedAttempt(
-		f.args.ns, f.args.query, f.args.opts)
+		f.args.ctx, f.args.ns, f.args.query, f.args.opts)
+
+	if IsBadRequestError(err) || IsResourceExhaustedError(err) {
+		// Do not retry bad request or resource exhausted errors
+		err = xerrors.NewNonRetryableError(err)
+	}
+
 	return err
 }
 
@@ -99,6 +120,7 @@ func (p *fetchTaggedAttemptPoolImpl) Init() {
 		// and function method pointer over and over again
 		f.idsAttemptFn = f.performIDsAttempt
 		f.dataAttemptFn = f.performDataAttempt
+		f.continueFn = f.shouldContinue
 		f.reset()
 		return f
 	})
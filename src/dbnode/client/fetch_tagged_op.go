@@ -22,6 +22,7 @@ package client
 
 import (
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/x/pool"
 )
 
@@ -31,9 +32,18 @@ var (
 
 type fetchTaggedOp struct {
 	refCounter
+	opContext
+
 	request      rpc.FetchTaggedRequest
 	completionFn completionFn
 
+	// readRepair is whether series with diverging replicas are repaired.
+	readRepair bool
+
+	// explain is merged with the explanation returned by each host when
+	// the query is explained, it is nil otherwise.
+	explain *index.QueryExplanation
+
 	pool fetchTaggedOpPool
 }
 
@@ -52,9 +62,16 @@ func (f *fetchTaggedOp) requestLimit(defaultValue int) int {
 	return int(*f.request.Limit)
 }
 
+func (f *fetchTaggedOp) paginated() bool {
+	return f.request.IsSetPageToken()
+}
+
 func (f *fetchTaggedOp) close() {
 	f.completionFn = nil
 	f.request = fetchTaggedOpRequestZeroed
+	f.readRepair = false
+	f.explain = nil
+	f.setContext(nil)
 	// return to pool
 	if f.pool == nil {
 		return
@@ -22,6 +22,7 @@ package client
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"time"
@@ -68,6 +69,23 @@ type fetchTaggedResultAccumulator struct {
 	aggResponses   aggregateResults
 	exhaustive     bool
 
+	// paginated is whether the request is paginated, pageBound is then the
+	// smallest ID a host stopped its page at. Series after the bound are left
+	// out of the page since hosts that stopped at it have not returned them.
+	paginated bool
+	pageBound []byte
+
+	// readRepair tracks the host each series was returned from to find
+	// series whose replicas diverge when enabled.
+	readRepair        bool
+	elemHostIDs       map[*rpc.FetchTaggedIDResult_]string
+	exhaustiveHostIDs map[string]struct{}
+	readRepairSeries  []readRepairSeries
+
+	// explain is merged with the explanation returned by each host when
+	// the query is explained, it is nil otherwise.
+	explain *index.QueryExplanation
+
 	startTime        time.Time
 	endTime          time.Time
 	majority         int
@@ -98,11 +116,25 @@ func (accum *fetchTaggedResultAccumulator) AddFetchTaggedResponse(
 	opts fetchTaggedResultAccumulatorOpts,
 	resultErr error,
 ) (bool, error) {
+	if opts.response != nil && resultErr == nil && accum.paginated {
+		resultErr = accum.addPageBound(opts.response.NextPageToken)
+	}
 	if opts.response != nil && resultErr == nil {
 		accum.exhaustive = accum.exhaustive && opts.response.Exhaustive
 		for _, elem := range opts.response.Elements {
 			accum.fetchResponses = append(accum.fetchResponses, elem)
 		}
+		if accum.readRepair && opts.host != nil {
+			for _, elem := range opts.response.Elements {
+				accum.elemHostIDs[elem] = opts.host.ID()
+			}
+			if opts.response.Exhaustive {
+				accum.exhaustiveHostIDs[opts.host.ID()] = struct{}{}
+			}
+		}
+		if accum.explain != nil && opts.host != nil && opts.response.Explain != nil {
+			accum.addExplanation(opts.host, opts.response.Explain)
+		}
 	}
 
 	// NB(r): Write the response to calculate transport to work out length.
@@ -203,9 +235,15 @@ func (accum *fetchTaggedResultAccumulator) accumulatedResult(
 	// all shards, so we need to fail
 	if accum.numHostsPending == 0 && accum.numShardsPending != 0 {
 		doneAccumulating := true
-		return doneAccumulating, fmt.Errorf(
+		// NB: keep the classification of the host errors so that bad request
+		// and resource exhausted errors are not retried.
+		err := fmt.Errorf(
 			"unable to satisfy consistency requirements for %d shards [ err = %s ]",
 			accum.numShardsPending, accum.errors.Error())
+		if topLevelErr := topLevelError(accum.errors); topLevelErr != nil {
+			err = xerrors.NewRenamedError(topLevelErr, err)
+		}
+		return doneAccumulating, err
 	}
 
 	doneAccumulating := false
@@ -231,6 +269,9 @@ func (accum *fetchTaggedResultAccumulator) Clear() {
 	accum.startTime, accum.endTime = time.Time{}, time.Time{}
 	accum.topoMap = nil
 	accum.exhaustive = true
+	accum.paginated, accum.pageBound = false, nil
+	accum.explain = nil
+	accum.resetReadRepair(false)
 	accum.calcTransport.Reset()
 }
 
@@ -262,9 +303,173 @@ func (accum *fetchTaggedResultAccumulator) Reset(
 		}
 	}
 
+	accum.paginated, accum.pageBound = false, nil
+	accum.explain = nil
+	accum.resetReadRepair(false)
 	accum.calcTransport.Reset()
 }
 
+// ResetEnqueuedHosts resets the hosts pending and the replicas enqueued for
+// each shard to only those of the given hosts, for when a request is
+// initially sent to a subset of the hosts in the topology.
+func (accum *fetchTaggedResultAccumulator) ResetEnqueuedHosts(hosts []topology.Host) {
+	accum.numHostsPending = 0
+	for i := range accum.shardConsistencyResults {
+		accum.shardConsistencyResults[i].enqueued = 0
+	}
+	for _, host := range hosts {
+		accum.AddEnqueuedHost(host)
+	}
+}
+
+// AddEnqueuedHost adds a host the request was additionally sent to.
+func (accum *fetchTaggedResultAccumulator) AddEnqueuedHost(host topology.Host) {
+	hostShardSet, ok := accum.topoMap.LookupHostShardSet(host.ID())
+	if !ok {
+		return
+	}
+	accum.numHostsPending++
+	for _, hs := range hostShardSet.ShardSet().All() {
+		accum.shardConsistencyResults[int(hs.ID())].enqueued++
+	}
+}
+
+// ResetReadRepair starts tracking the host each series is returned from to
+// find series whose replicas diverge.
+func (accum *fetchTaggedResultAccumulator) ResetReadRepair() {
+	accum.resetReadRepair(true)
+}
+
+func (accum *fetchTaggedResultAccumulator) resetReadRepair(enabled bool) {
+	accum.readRepair = enabled
+	accum.elemHostIDs = nil
+	accum.exhaustiveHostIDs = nil
+	for i := range accum.readRepairSeries {
+		accum.readRepairSeries[i] = readRepairSeries{}
+	}
+	accum.readRepairSeries = accum.readRepairSeries[:0]
+	if enabled {
+		accum.elemHostIDs = make(map[*rpc.FetchTaggedIDResult_]string)
+		accum.exhaustiveHostIDs = make(map[string]struct{})
+	}
+}
+
+// ResetExplain starts merging the explanation returned by each host into
+// the given explanation.
+func (accum *fetchTaggedResultAccumulator) ResetExplain(
+	explain *index.QueryExplanation,
+) {
+	accum.explain = explain
+}
+
+// addExplanation merges the explanation returned by a host, explanations are
+// best effort so an explanation that cannot be decoded is skipped rather than
+// failing the fetch.
+func (accum *fetchTaggedResultAccumulator) addExplanation(
+	host topology.Host,
+	data []byte,
+) {
+	var hostExplain index.QueryExplanation
+	if err := json.Unmarshal(data, &hostExplain); err != nil {
+		return
+	}
+	if accum.explain.Query == "" {
+		accum.explain.Query = hostExplain.Query
+	}
+	for _, block := range hostExplain.Blocks {
+		block.Host = host.ID()
+		accum.explain.AddBlock(block)
+	}
+}
+
+// ReadRepairSeries returns the series found to have diverging replicas when
+// the series were last returned as series iterators.
+func (accum *fetchTaggedResultAccumulator) ReadRepairSeries() []readRepairSeries {
+	return accum.readRepairSeries
+}
+
+func (accum *fetchTaggedResultAccumulator) addReadRepairSeries(
+	elems fetchTaggedIDResults,
+	descr namespace.SchemaDescr,
+) {
+	hostIDs := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		hostID, ok := accum.elemHostIDs[elem]
+		if !ok {
+			// Should never happen, all responses are tracked.
+			return
+		}
+		hostIDs = append(hostIDs, hostID)
+	}
+
+	var ownerHostIDs []string
+	shard := accum.topoMap.ShardSet().Lookup(ident.BytesID(elems[0].ID))
+	if err := accum.topoMap.RouteShardForEach(shard, func(_ int, host topology.Host) {
+		if _, ok := accum.exhaustiveHostIDs[host.ID()]; ok {
+			ownerHostIDs = append(ownerHostIDs, host.ID())
+		}
+	}); err != nil {
+		return
+	}
+
+	series, ok := newReadRepairSeries(elems, hostIDs, ownerHostIDs)
+	if !ok {
+		return
+	}
+	series.schema = descr
+	series.startInclusive = accum.startTime
+	series.endExclusive = accum.endTime
+	accum.readRepairSeries = append(accum.readRepairSeries, series)
+}
+
+// ResetPagination starts tracking the page bound of a paginated request.
+func (accum *fetchTaggedResultAccumulator) ResetPagination() {
+	accum.paginated = true
+	accum.pageBound = nil
+}
+
+// addPageBound lowers the page bound to the position the page of a host
+// stopped at, hosts that returned their last page do not bound the page.
+func (accum *fetchTaggedResultAccumulator) addPageBound(nextPageToken []byte) error {
+	if nextPageToken == nil {
+		return nil
+	}
+	var cursor index.QueryCursor
+	if err := cursor.UnmarshalBinary(nextPageToken); err != nil {
+		return err
+	}
+	if accum.pageBound == nil || bytes.Compare(cursor.After, accum.pageBound) < 0 {
+		accum.pageBound = cursor.After
+	}
+	return nil
+}
+
+// pageIncludes returns whether the series with the given ID is returned by
+// the page, every host has returned the series up to the page bound.
+func (accum *fetchTaggedResultAccumulator) pageIncludes(id []byte) bool {
+	return accum.pageBound == nil || bytes.Compare(id, accum.pageBound) <= 0
+}
+
+// nextPageToken returns the token to resume a paginated request from, the
+// page resumes after the last series returned if the page was cut short by
+// the limit and after the page bound otherwise.
+func (accum *fetchTaggedResultAccumulator) nextPageToken(
+	lastID []byte,
+	limitReached bool,
+) ([]byte, error) {
+	if !accum.paginated {
+		return nil, nil
+	}
+	after := accum.pageBound
+	if limitReached {
+		after = lastID
+	}
+	if after == nil {
+		return nil, nil
+	}
+	return index.QueryCursor{After: after}.MarshalBinary()
+}
+
 func (accum *fetchTaggedResultAccumulator) sliceResponsesAsSeriesIter(
 	pools fetchTaggedPools,
 	elems fetchTaggedIDResults,
@@ -315,28 +520,48 @@ func (accum *fetchTaggedResultAccumulator) AsEncodingSeriesIterators(
 	accum.fetchResponses = fetchTaggedIDResults(results)
 
 	numElements := 0
-	accum.fetchResponses.forEachID(func(_ fetchTaggedIDResults, _ bool) bool {
+	accum.fetchResponses.forEachID(func(elems fetchTaggedIDResults, _ bool) bool {
+		if !accum.pageIncludes(elems[0].ID) {
+			return false
+		}
 		numElements++
 		return numElements < limit
 	})
 
 	result := pools.MutableSeriesIterators().Get(numElements)
 	result.Reset(numElements)
-	count := 0
-	moreElems := false
+	var (
+		count     = 0
+		moreElems = false
+		lastID    []byte
+	)
 	accum.fetchResponses.forEachID(func(elems fetchTaggedIDResults, hasMore bool) bool {
+		if !accum.pageIncludes(elems[0].ID) {
+			return false
+		}
 		seriesIter := accum.sliceResponsesAsSeriesIter(pools, elems, descr, opts)
 		result.SetAt(count, seriesIter)
+		if accum.readRepair {
+			accum.addReadRepairSeries(elems, descr)
+		}
 		count++
 		moreElems = hasMore
+		lastID = elems[0].ID
 		return count < limit
 	})
 
-	exhaustive := accum.exhaustive && count <= limit && !moreElems
+	nextPageToken, err := accum.nextPageToken(lastID, moreElems && count >= limit)
+	if err != nil {
+		result.Close()
+		return nil, FetchResponseMetadata{}, err
+	}
+	exhaustive := accum.exhaustive && count <= limit && !moreElems &&
+		nextPageToken == nil
 	return result, FetchResponseMetadata{
 		Exhaustive:         exhaustive,
 		Responses:          len(accum.fetchResponses),
 		EstimateTotalBytes: accum.calcTransport.GetSize(),
+		NextPageToken:      nextPageToken,
 	}, nil
 }
 
@@ -348,22 +573,34 @@ func (accum *fetchTaggedResultAccumulator) AsTaggedIDsIterator(
 		iter      = newTaggedIDsIterator(pools)
 		count     = 0
 		moreElems = false
+		lastID    []byte
 	)
 	results := fetchTaggedIDResultsSortedByID(accum.fetchResponses)
 	sort.Sort(results)
 	accum.fetchResponses = fetchTaggedIDResults(results)
 	accum.fetchResponses.forEachID(func(elems fetchTaggedIDResults, hasMore bool) bool {
+		if !accum.pageIncludes(elems[0].ID) {
+			return false
+		}
 		iter.addBacking(elems[0].NameSpace, elems[0].ID, elems[0].EncodedTags)
 		count++
 		moreElems = hasMore
+		lastID = elems[0].ID
 		return count < limit
 	})
 
-	exhaustive := accum.exhaustive && count <= limit && !moreElems
+	nextPageToken, err := accum.nextPageToken(lastID, moreElems && count >= limit)
+	if err != nil {
+		iter.Finalize()
+		return nil, FetchResponseMetadata{}, err
+	}
+	exhaustive := accum.exhaustive && count <= limit && !moreElems &&
+		nextPageToken == nil
 	return iter, FetchResponseMetadata{
 		Exhaustive:         exhaustive,
 		Responses:          len(accum.aggResponses),
 		EstimateTotalBytes: accum.calcTransport.GetSize(),
+		NextPageToken:      nextPageToken,
 	}, nil
 }
 
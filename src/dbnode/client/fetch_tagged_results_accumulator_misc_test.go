@@ -21,6 +21,7 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
@@ -29,15 +30,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/m3db/m3/src/cluster/shard"
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/topology"
+	tu "github.com/m3db/m3/src/dbnode/topology/testutil"
+	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
 	"github.com/m3db/m3/src/x/serialize"
+	xtime "github.com/m3db/m3/src/x/time"
 
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
@@ -63,6 +69,221 @@ func TestFetchTaggedResultsAccumulatorClearResetsState(t *testing.T) {
 	require.NoError(t, resultsIter.Err())
 }
 
+func TestFetchTaggedResultsAccumulatorPagination(t *testing.T) {
+	// rf=3, 30 shards total; three identical hosts
+	topoMap := tu.MustNewTopologyMap(3, map[string][]shard.Shard{
+		"testhost0": tu.ShardsRange(0, 29, shard.Available),
+		"testhost1": tu.ShardsRange(0, 29, shard.Available),
+		"testhost2": tu.ShardsRange(0, 29, shard.Available),
+	})
+	pageToken := func(after string) []byte {
+		token, err := index.QueryCursor{After: []byte(after)}.MarshalBinary()
+		require.NoError(t, err)
+		return token
+	}
+	response := func(next []byte, ids ...string) *rpc.FetchTaggedResult_ {
+		result := &rpc.FetchTaggedResult_{Exhaustive: next == nil, NextPageToken: next}
+		for _, id := range ids {
+			result.Elements = append(result.Elements, &rpc.FetchTaggedIDResult_{
+				NameSpace: []byte("ns"),
+				ID:        []byte(id),
+			})
+		}
+		return result
+	}
+	pageIDs := func(iter TaggedIDsIterator) []string {
+		var ids []string
+		for iter.Next() {
+			_, id, _ := iter.Current()
+			ids = append(ids, id.String())
+		}
+		require.NoError(t, iter.Err())
+		return ids
+	}
+
+	tests := []struct {
+		name     string
+		limit    int
+		expected []string
+		next     []byte
+	}{
+		{
+			// testhost1 stopped its page at "b" so the series after it are
+			// returned by the next page even though other hosts returned them.
+			name:     "bounded by hosts",
+			limit:    maxInt,
+			expected: []string{"a", "b"},
+			next:     pageToken("b"),
+		},
+		{
+			name:     "bounded by limit",
+			limit:    1,
+			expected: []string{"a"},
+			next:     pageToken("a"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			accum := newFetchTaggedResultAccumulator()
+			accum.Reset(time.Time{}, time.Time{}, topoMap, topoMap.MajorityReplicas(),
+				topology.ReadConsistencyLevelOne)
+			accum.ResetPagination()
+
+			for _, r := range []struct {
+				host     string
+				response *rpc.FetchTaggedResult_
+			}{
+				{host: "testhost0", response: response(pageToken("c"), "a", "b", "c")},
+				{host: "testhost1", response: response(pageToken("b"), "a", "b")},
+				{host: "testhost2", response: response(nil, "a", "b", "c", "d")},
+			} {
+				_, err := accum.AddFetchTaggedResponse(fetchTaggedResultAccumulatorOpts{
+					host:     host(t, topoMap, r.host),
+					response: r.response,
+				}, nil)
+				require.NoError(t, err)
+			}
+
+			iter, meta, err := accum.AsTaggedIDsIterator(test.limit, newTestFetchTaggedPools())
+			require.NoError(t, err)
+			require.False(t, meta.Exhaustive)
+			require.Equal(t, test.expected, pageIDs(iter))
+			require.Equal(t, test.next, meta.NextPageToken)
+		})
+	}
+
+	// The last page of every host ends the pagination.
+	accum := newFetchTaggedResultAccumulator()
+	accum.Reset(time.Time{}, time.Time{}, topoMap, topoMap.MajorityReplicas(),
+		topology.ReadConsistencyLevelOne)
+	accum.ResetPagination()
+	for _, hostID := range []string{"testhost0", "testhost1", "testhost2"} {
+		_, err := accum.AddFetchTaggedResponse(fetchTaggedResultAccumulatorOpts{
+			host:     host(t, topoMap, hostID),
+			response: response(nil, "c", "d"),
+		}, nil)
+		require.NoError(t, err)
+	}
+	iter, meta, err := accum.AsTaggedIDsIterator(maxInt, newTestFetchTaggedPools())
+	require.NoError(t, err)
+	require.True(t, meta.Exhaustive)
+	require.Equal(t, []string{"c", "d"}, pageIDs(iter))
+	require.Nil(t, meta.NextPageToken)
+}
+
+func TestFetchTaggedResultsAccumulatorReadRepair(t *testing.T) {
+	// rf=3, 30 shards total; three identical hosts
+	topoMap := tu.MustNewTopologyMap(3, map[string][]shard.Shard{
+		"testhost0": tu.ShardsRange(0, 29, shard.Available),
+		"testhost1": tu.ShardsRange(0, 29, shard.Available),
+		"testhost2": tu.ShardsRange(0, 29, shard.Available),
+	})
+
+	var (
+		blockSize = 2 * time.Hour
+		start     = time.Now().Truncate(blockSize)
+		end       = start.Add(blockSize)
+		elem      = func() *rpc.FetchTaggedIDResult_ {
+			return &rpc.FetchTaggedIDResult_{
+				NameSpace: []byte("testns"),
+				ID:        []byte("foo"),
+				Segments: []*rpc.Segments{testReadRepairSegments(t, start, blockSize,
+					[]ts.Datapoint{{Timestamp: start, Value: 1}})},
+			}
+		}
+	)
+
+	accum := newFetchTaggedResultAccumulator()
+	accum.Reset(start, end, topoMap, topoMap.MajorityReplicas(),
+		topology.ReadConsistencyLevelAll)
+	accum.ResetReadRepair()
+
+	// testhost2 returned all of its results without the series.
+	for _, response := range []struct {
+		host     string
+		elements []*rpc.FetchTaggedIDResult_
+	}{
+		{host: "testhost0", elements: []*rpc.FetchTaggedIDResult_{elem()}},
+		{host: "testhost1", elements: []*rpc.FetchTaggedIDResult_{elem()}},
+		{host: "testhost2"},
+	} {
+		_, err := accum.AddFetchTaggedResponse(fetchTaggedResultAccumulatorOpts{
+			host: host(t, topoMap, response.host),
+			response: &rpc.FetchTaggedResult_{
+				Elements:   response.elements,
+				Exhaustive: true,
+			},
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	iters, _, err := accum.AsEncodingSeriesIterators(maxInt,
+		newTestFetchTaggedPools(), nil, index.IterationOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, iters.Len())
+
+	series := accum.ReadRepairSeries()
+	require.Equal(t, 1, len(series))
+	require.Equal(t, []byte("foo"), series[0].id)
+	require.Equal(t, []readRepairBlock{{
+		start:   xtime.ToUnixNano(start),
+		end:     xtime.ToUnixNano(end),
+		hostIDs: []string{"testhost2"},
+	}}, series[0].blocks)
+
+	accum.Clear()
+	require.Equal(t, 0, len(accum.ReadRepairSeries()))
+}
+
+func TestFetchTaggedResultsAccumulatorExplain(t *testing.T) {
+	// rf=3, 30 shards total; three identical hosts
+	topoMap := tu.MustNewTopologyMap(3, map[string][]shard.Shard{
+		"testhost0": tu.ShardsRange(0, 29, shard.Available),
+		"testhost1": tu.ShardsRange(0, 29, shard.Available),
+		"testhost2": tu.ShardsRange(0, 29, shard.Available),
+	})
+
+	blockStart := time.Unix(1600000000, 0).UTC()
+	hostExplain := func(t *testing.T) []byte {
+		explain := &index.QueryExplanation{Query: "term(foo, bar)"}
+		explain.AddBlock(index.BlockExplanation{BlockStart: blockStart})
+		data, err := json.Marshal(explain)
+		require.NoError(t, err)
+		return data
+	}
+
+	explain := &index.QueryExplanation{}
+	accum := newFetchTaggedResultAccumulator()
+	accum.Reset(time.Time{}, time.Time{}, topoMap, topoMap.MajorityReplicas(),
+		topology.ReadConsistencyLevelAll)
+	accum.ResetExplain(explain)
+
+	// testhost2 returned an explanation that cannot be decoded.
+	for _, response := range []struct {
+		host    string
+		explain []byte
+	}{
+		{host: "testhost1", explain: hostExplain(t)},
+		{host: "testhost0", explain: hostExplain(t)},
+		{host: "testhost2", explain: []byte("invalid")},
+	} {
+		_, err := accum.AddFetchTaggedResponse(fetchTaggedResultAccumulatorOpts{
+			host: host(t, topoMap, response.host),
+			response: &rpc.FetchTaggedResult_{
+				Exhaustive: true,
+				Explain:    response.explain,
+			},
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, "term(foo, bar)", explain.Query)
+	require.Equal(t, []index.BlockExplanation{
+		{Host: "testhost0", BlockStart: blockStart},
+		{Host: "testhost1", BlockStart: blockStart},
+	}, explain.Blocks)
+}
+
 func TestFetchTaggedShardConsistencyResultsInitializeLength(t *testing.T) {
 	var results fetchTaggedShardConsistencyResults
 	require.Len(t, results, 0)
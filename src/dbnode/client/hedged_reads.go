@@ -0,0 +1,121 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	// hedgedReadsLatencySamples is the number of recent fetch tagged response
+	// latencies the hedge delay percentile is calculated from.
+	hedgedReadsLatencySamples = 1024
+
+	// hedgedReadsDelayUpdateEvery is the number of latencies recorded between
+	// recalculating the hedge delay.
+	hedgedReadsDelayUpdateEvery = 64
+)
+
+type hedgedReadsMetrics struct {
+	hedgesSent tally.Counter
+	hedgesWon  tally.Counter
+}
+
+func newHedgedReadsMetrics(scope tally.Scope) hedgedReadsMetrics {
+	return hedgedReadsMetrics{
+		hedgesSent: scope.Counter("hedges-sent"),
+		hedgesWon:  scope.Counter("hedges-won"),
+	}
+}
+
+// hedgedReads decides after what delay a fetch tagged is hedged to the hosts
+// it was not initially sent to. The delay is the configured percentile of the
+// latency of recent responses so that only the slowest requests are hedged,
+// bounded below by the configured min delay.
+type hedgedReads struct {
+	sync.Mutex
+
+	percentile float64
+	minDelay   time.Duration
+	metrics    hedgedReadsMetrics
+
+	samples     []time.Duration
+	next        int
+	sinceUpdate int
+
+	// delay is accessed atomically.
+	delay int64
+}
+
+func newHedgedReads(opts Options, scope tally.Scope) *hedgedReads {
+	return &hedgedReads{
+		percentile: opts.HedgedReadsDelayPercentile(),
+		minDelay:   opts.HedgedReadsMinDelay(),
+		metrics:    newHedgedReadsMetrics(scope),
+		samples:    make([]time.Duration, 0, hedgedReadsLatencySamples),
+		delay:      int64(opts.HedgedReadsMinDelay()),
+	}
+}
+
+// Delay returns the delay after which a fetch is hedged.
+func (h *hedgedReads) Delay() time.Duration {
+	return time.Duration(atomic.LoadInt64(&h.delay))
+}
+
+// RecordLatency records the latency of a response to a fetch.
+func (h *hedgedReads) RecordLatency(latency time.Duration) {
+	h.Lock()
+	defer h.Unlock()
+
+	if len(h.samples) < cap(h.samples) {
+		h.samples = append(h.samples, latency)
+	} else {
+		h.samples[h.next] = latency
+		h.next = (h.next + 1) % len(h.samples)
+	}
+
+	h.sinceUpdate++
+	if h.sinceUpdate < hedgedReadsDelayUpdateEvery {
+		return
+	}
+	h.sinceUpdate = 0
+
+	sorted := make([]time.Duration, len(h.samples))
+	copy(sorted, h.samples)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	idx := int(math.Ceil(h.percentile*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	delay := sorted[idx]
+	if delay < h.minDelay {
+		delay = h.minDelay
+	}
+	atomic.StoreInt64(&h.delay, int64(delay))
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/topology"
+	tu "github.com/m3db/m3/src/dbnode/topology/testutil"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func newTestHedgedReads(opts Options) (*hedgedReads, tally.TestScope) {
+	scope := tally.NewTestScope("", nil)
+	return newHedgedReads(opts, scope), scope
+}
+
+func TestHedgedReadsDelay(t *testing.T) {
+	opts := NewOptions().
+		SetHedgedReadsDelayPercentile(0.9).
+		SetHedgedReadsMinDelay(5 * time.Millisecond)
+	h, _ := newTestHedgedReads(opts)
+	require.Equal(t, 5*time.Millisecond, h.Delay())
+
+	// The delay is only recalculated every update interval.
+	for i := 1; i < hedgedReadsDelayUpdateEvery; i++ {
+		h.RecordLatency(time.Duration(i) * time.Millisecond)
+	}
+	require.Equal(t, 5*time.Millisecond, h.Delay())
+
+	h.RecordLatency(hedgedReadsDelayUpdateEvery * time.Millisecond)
+	require.Equal(t, 58*time.Millisecond, h.Delay())
+
+	// Latencies below the min delay do not lower the delay past it.
+	for i := 0; i < hedgedReadsLatencySamples; i++ {
+		h.RecordLatency(time.Millisecond)
+	}
+	require.Equal(t, 5*time.Millisecond, h.Delay())
+}
+
+func TestFetchStateHedgesOnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	topoMap := tu.MustNewTopologyMap(3, map[string][]shard.Shard{
+		"testhost0": tu.ShardsRange(0, 29, shard.Available),
+		"testhost1": tu.ShardsRange(0, 29, shard.Available),
+		"testhost2": tu.ShardsRange(0, 29, shard.Available),
+	})
+	queues := newTestReadHostQueues(ctrl, topoMap, nil)
+
+	// NB: the min delay is long enough that only the error hedges the fetch.
+	h, scope := newTestHedgedReads(NewOptions().SetHedgedReadsMinDelay(time.Hour))
+	initial, hedge := readHosts(queues, topoMap, 1, "")
+	require.Len(t, initial, 1)
+
+	op := newFetchTaggedOp(nil)
+	f := newFetchState(nil)
+	op.incRef()
+	op.update(rpc.FetchTaggedRequest{}, f.completionFn)
+	f.incRef()
+	f.ResetFetchTagged(time.Time{}, time.Time{}, op, topoMap,
+		topoMap.MajorityReplicas(), topology.ReadConsistencyLevelOne)
+	f.ResetHedge(h, initial, hedge, time.Now)
+	op.decRef()
+
+	initial[0].(*MockhostQueue).EXPECT().Enqueue(op).Return(nil)
+	f.Lock()
+	f.incRef()
+	require.NoError(t, initial[0].Enqueue(op))
+	f.StartHedgeTimerWithLock()
+	f.Unlock()
+
+	// The error from the only host enqueued hedges to the remaining hosts
+	// rather than failing the fetch.
+	for _, hq := range hedge {
+		hq.(*MockhostQueue).EXPECT().Enqueue(op).Return(nil)
+	}
+	f.completionFn(fetchTaggedResultAccumulatorOpts{
+		host: initial[0].Host(),
+	}, errTestFetchTagged)
+
+	f.Lock()
+	require.False(t, f.done)
+	f.Unlock()
+
+	f.completionFn(fetchTaggedResultAccumulatorOpts{
+		host:     hedge[0].Host(),
+		response: &rpc.FetchTaggedResult_{Exhaustive: true},
+	}, nil)
+
+	f.Lock()
+	require.True(t, f.done)
+	require.NoError(t, f.err)
+	require.Nil(t, f.hedgeTimer)
+	f.Unlock()
+
+	f.completionFn(fetchTaggedResultAccumulatorOpts{
+		host:     hedge[1].Host(),
+		response: &rpc.FetchTaggedResult_{Exhaustive: true},
+	}, nil)
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(2), counters["hedges-sent+"].Value())
+	require.Equal(t, int64(1), counters["hedges-won+"].Value())
+
+	f.decRef()
+	require.Nil(t, f.fetchTaggedOp)
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	xerrors "github.com/m3db/m3/src/x/errors"
+
+	"github.com/uber-go/tally"
+)
+
+var errHintedHandoffClosed = errors.New("hinted handoff is closed")
+
+// hint is a write that failed against a replica, spooled so that it can be
+// replayed against the replica once it is reachable again.
+type hint struct {
+	namespace   []byte
+	id          []byte
+	encodedTags []byte
+	tagged      bool
+	datapoint   rpc.Datapoint
+	created     time.Time
+}
+
+// newHint copies the request of a write operation so that it outlives the
+// pooled operation, returns false for operations that cannot be replayed.
+func newHint(op writeOp, created time.Time) (hint, bool) {
+	var h hint
+	switch o := op.(type) {
+	case *writeOperation:
+		h.namespace = append([]byte(nil), o.namespace.Bytes()...)
+		h.id = append([]byte(nil), o.request.ID...)
+		h.datapoint = o.datapoint
+	case *writeTaggedOperation:
+		h.namespace = append([]byte(nil), o.namespace.Bytes()...)
+		h.id = append([]byte(nil), o.request.ID...)
+		h.encodedTags = append([]byte(nil), o.request.EncodedTags...)
+		h.tagged = true
+		h.datapoint = o.datapoint
+	default:
+		return hint{}, false
+	}
+	if h.datapoint.Annotation != nil {
+		h.datapoint.Annotation = append([]byte(nil), h.datapoint.Annotation...)
+	}
+	h.created = created
+	return h, true
+}
+
+// hintKey identifies the write of a hint so that retries of a write that
+// failed against the same replica are only spooled once.
+type hintKey struct {
+	namespace string
+	id        string
+	timestamp int64
+	timeType  rpc.TimeType
+	value     uint64
+}
+
+func (h hint) key() hintKey {
+	return hintKey{
+		namespace: string(h.namespace),
+		id:        string(h.id),
+		timestamp: h.datapoint.Timestamp,
+		timeType:  h.datapoint.TimestampTimeType,
+		value:     math.Float64bits(h.datapoint.Value),
+	}
+}
+
+// isHintableError returns whether a write that failed with an error should
+// be replayed, writes that were rejected by the replica (i.e. invalid writes
+// or writes over its limits) would be rejected again.
+func isHintableError(err error) bool {
+	return !IsBadRequestError(err) &&
+		!IsResourceExhaustedError(err) &&
+		!xerrors.IsNonRetryableError(err)
+}
+
+// hintWriteFn enqueues the write of a spooled hint to a single replica.
+type hintWriteFn func(hostID string, h hint, completionFn completionFn) error
+
+// hintHostConnectedFn returns whether a replica is reachable.
+type hintHostConnectedFn func(hostID string) bool
+
+type hintedHandoffMetrics struct {
+	hintsSpooled  tally.Counter
+	hintsDropped  tally.Counter
+	hintsDeduped  tally.Counter
+	hintsExpired  tally.Counter
+	replaySuccess tally.Counter
+	replayErrors  tally.Counter
+	hintsPending  tally.Gauge
+}
+
+func newHintedHandoffMetrics(scope tally.Scope) hintedHandoffMetrics {
+	return hintedHandoffMetrics{
+		hintsSpooled:  scope.Counter("hints-spooled"),
+		hintsDropped:  scope.Counter("hints-dropped"),
+		hintsDeduped:  scope.Counter("hints-deduped"),
+		hintsExpired:  scope.Counter("hints-expired"),
+		replaySuccess: scope.Counter("replay-success"),
+		replayErrors:  scope.Counter("replay-errors"),
+		hintsPending:  scope.Gauge("hints-pending"),
+	}
+}
+
+// hintedHandoff spools writes that failed against a replica in a bounded
+// queue per replica and replays them once the replica's host queue has open
+// connections again, so that replicas converge after short outages such as
+// rolling restarts without waiting for repair. Hints are kept in memory only
+// and are dropped once too old or when the queue for a replica is full, and
+// retries of a write are spooled once per replica.
+type hintedHandoff struct {
+	sync.Mutex
+
+	closed      bool
+	closeCh     chan struct{}
+	hints       map[string][]hint
+	keys        map[string]map[hintKey]struct{}
+	writeFn     hintWriteFn
+	connectedFn hintHostConnectedFn
+	metrics     hintedHandoffMetrics
+
+	nowFn           clock.NowFn
+	maxHintsPerHost int
+	maxHintAge      time.Duration
+	replayInterval  time.Duration
+}
+
+func newHintedHandoff(
+	opts Options,
+	writeFn hintWriteFn,
+	connectedFn hintHostConnectedFn,
+	scope tally.Scope,
+) *hintedHandoff {
+	return &hintedHandoff{
+		closeCh:         make(chan struct{}),
+		hints:           make(map[string][]hint),
+		keys:            make(map[string]map[hintKey]struct{}),
+		writeFn:         writeFn,
+		connectedFn:     connectedFn,
+		metrics:         newHintedHandoffMetrics(scope),
+		nowFn:           opts.ClockOptions().NowFn(),
+		maxHintsPerHost: opts.HintedHandoffMaxHintsPerHost(),
+		maxHintAge:      opts.HintedHandoffMaxHintAge(),
+		replayInterval:  opts.HintedHandoffReplayInterval(),
+	}
+}
+
+// Hint spools a write operation that failed against a replica.
+func (h *hintedHandoff) Hint(hostID string, op writeOp) {
+	hint, ok := newHint(op, h.nowFn())
+	if !ok {
+		return
+	}
+	h.add(hostID, hint)
+}
+
+func (h *hintedHandoff) add(hostID string, hint hint) {
+	h.Lock()
+	defer h.Unlock()
+	if h.closed {
+		return
+	}
+	var (
+		key  = hint.key()
+		keys = h.keys[hostID]
+	)
+	if _, ok := keys[key]; ok {
+		h.metrics.hintsDeduped.Inc(1)
+		return
+	}
+	hints := h.hints[hostID]
+	if len(hints) >= h.maxHintsPerHost {
+		h.metrics.hintsDropped.Inc(1)
+		return
+	}
+	if keys == nil {
+		keys = make(map[hintKey]struct{})
+		h.keys[hostID] = keys
+	}
+	keys[key] = struct{}{}
+	h.hints[hostID] = append(hints, hint)
+	h.metrics.hintsSpooled.Inc(1)
+}
+
+// Run replays spooled writes at the replay interval until closed.
+func (h *hintedHandoff) Run() {
+	ticker := time.NewTicker(h.replayInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.closeCh:
+			return
+		case <-ticker.C:
+			h.replay()
+		}
+	}
+}
+
+// Close stops the hinted handoff, writes still spooled are dropped.
+func (h *hintedHandoff) Close() error {
+	h.Lock()
+	defer h.Unlock()
+	if h.closed {
+		return errHintedHandoffClosed
+	}
+	h.closed = true
+	h.hints = nil
+	h.keys = nil
+	close(h.closeCh)
+	return nil
+}
+
+func (h *hintedHandoff) replay() {
+	h.Lock()
+	hostIDs := make([]string, 0, len(h.hints))
+	for hostID := range h.hints {
+		hostIDs = append(hostIDs, hostID)
+	}
+	h.Unlock()
+
+	// NB: Check connectivity outside of the lock since it requires the
+	// session's state lock, which is held while writes are enqueued.
+	connected := make(map[string]bool, len(hostIDs))
+	for _, hostID := range hostIDs {
+		connected[hostID] = h.connectedFn(hostID)
+	}
+
+	var (
+		now     = h.nowFn()
+		replays = make(map[string][]hint)
+		expired int
+		pending int
+	)
+	h.Lock()
+	for hostID, hints := range h.hints {
+		// NB: Hints that failed to replay are spooled again behind newer
+		// hints so all of them need to be checked for expiry.
+		var (
+			keys      = h.keys[hostID]
+			unexpired = hints[:0]
+		)
+		for _, hint := range hints {
+			if now.Sub(hint.created) > h.maxHintAge {
+				delete(keys, hint.key())
+				expired++
+				continue
+			}
+			unexpired = append(unexpired, hint)
+		}
+		hints = unexpired
+
+		if len(hints) == 0 {
+			delete(h.hints, hostID)
+			delete(h.keys, hostID)
+			continue
+		}
+		if !connected[hostID] {
+			h.hints[hostID] = hints
+			pending += len(hints)
+			continue
+		}
+		replays[hostID] = hints
+		delete(h.hints, hostID)
+		delete(h.keys, hostID)
+	}
+	h.Unlock()
+
+	h.metrics.hintsExpired.Inc(int64(expired))
+	h.metrics.hintsPending.Update(float64(pending))
+
+	for hostID, hints := range replays {
+		for _, hint := range hints {
+			if err := h.writeFn(hostID, hint, h.replayCompletionFn(hostID, hint)); err != nil {
+				h.metrics.replayErrors.Inc(1)
+				h.add(hostID, hint)
+			}
+		}
+	}
+}
+
+func (h *hintedHandoff) replayCompletionFn(hostID string, hint hint) completionFn {
+	return func(_ interface{}, err error) {
+		if err == nil {
+			h.metrics.replaySuccess.Inc(1)
+			return
+		}
+		h.metrics.replayErrors.Inc(1)
+		if isHintableError(err) {
+			// Spool the write again to be replayed once the replica is
+			// reachable again, it's dropped once too old.
+			h.add(hostID, hint)
+		}
+	}
+}
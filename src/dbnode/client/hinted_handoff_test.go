@@ -0,0 +1,242 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type testHintedHandoffWrite struct {
+	hostID string
+	hint   hint
+	fn     completionFn
+}
+
+func newTestHintedHandoff(
+	opts Options,
+	connected map[string]bool,
+) (*hintedHandoff, *[]testHintedHandoffWrite, tally.TestScope, *time.Time) {
+	var (
+		scope  = tally.NewTestScope("", nil)
+		now    = time.Now()
+		writes []testHintedHandoffWrite
+	)
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return now
+	}))
+	h := newHintedHandoff(opts, func(hostID string, h hint, fn completionFn) error {
+		writes = append(writes, testHintedHandoffWrite{hostID: hostID, hint: h, fn: fn})
+		return nil
+	}, func(hostID string) bool {
+		return connected[hostID]
+	}, scope)
+	return h, &writes, scope, &now
+}
+
+func newTestHintWriteTaggedOp(id string, value float64) *writeTaggedOperation {
+	op := &writeTaggedOperation{}
+	op.reset()
+	op.namespace = ident.StringID("testNs")
+	op.request.ID = []byte(id)
+	op.request.EncodedTags = []byte("encodedTags")
+	op.datapoint = rpc.Datapoint{
+		Timestamp:  1,
+		Value:      value,
+		Annotation: []byte("annotation"),
+	}
+	return op
+}
+
+func TestNewHint(t *testing.T) {
+	now := time.Now()
+
+	taggedOp := newTestHintWriteTaggedOp("foo", 42)
+	h, ok := newHint(taggedOp, now)
+	require.True(t, ok)
+	require.True(t, h.tagged)
+	require.Equal(t, []byte("testNs"), h.namespace)
+	require.Equal(t, []byte("foo"), h.id)
+	require.Equal(t, []byte("encodedTags"), h.encodedTags)
+	require.Equal(t, 42.0, h.datapoint.Value)
+	require.Equal(t, now, h.created)
+
+	// Ensure the hint does not share any bytes with the pooled operation.
+	taggedOp.request.ID[0] = 'b'
+	taggedOp.datapoint.Annotation[0] = 'b'
+	require.Equal(t, []byte("foo"), h.id)
+	require.Equal(t, []byte("annotation"), h.datapoint.Annotation)
+
+	op := &writeOperation{}
+	op.reset()
+	op.namespace = ident.StringID("testNs")
+	op.request.ID = []byte("bar")
+	op.datapoint.Value = 7
+	h, ok = newHint(op, now)
+	require.True(t, ok)
+	require.False(t, h.tagged)
+	require.Equal(t, []byte("bar"), h.id)
+	require.Nil(t, h.encodedTags)
+	require.Equal(t, 7.0, h.datapoint.Value)
+}
+
+func TestHintedHandoffReplaysOnceConnected(t *testing.T) {
+	connected := map[string]bool{}
+	h, writes, scope, _ := newTestHintedHandoff(NewOptions(), connected)
+
+	h.Hint("host0", newTestHintWriteTaggedOp("foo", 1))
+	h.Hint("host0", newTestHintWriteTaggedOp("bar", 2))
+	h.Hint("host1", newTestHintWriteTaggedOp("baz", 3))
+
+	// Nothing is replayed while the hosts are not reachable.
+	h.replay()
+	require.Len(t, *writes, 0)
+
+	connected["host0"] = true
+	h.replay()
+	require.Len(t, *writes, 2)
+	for i, id := range []string{"foo", "bar"} {
+		require.Equal(t, "host0", (*writes)[i].hostID)
+		require.Equal(t, []byte(id), (*writes)[i].hint.id)
+		(*writes)[i].fn(nil, nil)
+	}
+
+	// Hints are only replayed once.
+	h.replay()
+	require.Len(t, *writes, 2)
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(3), counters["hints-spooled+"].Value())
+	require.Equal(t, int64(2), counters["replay-success+"].Value())
+	require.Equal(t, 1.0, scope.Snapshot().Gauges()["hints-pending+"].Value())
+}
+
+func TestHintedHandoffRespoolsFailedReplays(t *testing.T) {
+	connected := map[string]bool{"host0": true}
+	h, writes, scope, _ := newTestHintedHandoff(NewOptions(), connected)
+
+	h.Hint("host0", newTestHintWriteTaggedOp("foo", 1))
+	h.Hint("host0", newTestHintWriteTaggedOp("bar", 2))
+	h.replay()
+	require.Len(t, *writes, 2)
+
+	h.Hint("host0", newTestHintWriteTaggedOp("baz", 3))
+	h.Hint("host0", newTestHintWriteTaggedOp("qux", 4))
+	h.replay()
+	require.Len(t, *writes, 4)
+
+	// Failed replays are spooled again unless the write was rejected.
+	(*writes)[0].fn(nil, errors.New("host unreachable"))
+	(*writes)[1].fn(nil, xerrors.NewInvalidParamsError(errors.New("bad write")))
+	(*writes)[2].fn(nil, tterrors.NewResourceExhaustedError(errors.New("over limit")))
+	(*writes)[3].fn(nil, xerrors.NewNonRetryableError(errors.New("non retryable")))
+
+	h.replay()
+	require.Len(t, *writes, 5)
+	require.Equal(t, []byte("foo"), (*writes)[4].hint.id)
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(4), counters["replay-errors+"].Value())
+}
+
+func TestHintedHandoffDedupesHints(t *testing.T) {
+	connected := map[string]bool{}
+	h, writes, scope, _ := newTestHintedHandoff(NewOptions(), connected)
+
+	// Retries of a write spool it once per replica.
+	h.Hint("host0", newTestHintWriteTaggedOp("foo", 1))
+	h.Hint("host0", newTestHintWriteTaggedOp("foo", 1))
+	h.Hint("host1", newTestHintWriteTaggedOp("foo", 1))
+	h.Hint("host0", newTestHintWriteTaggedOp("foo", 2))
+
+	connected["host0"] = true
+	h.replay()
+	require.Len(t, *writes, 2)
+	require.Equal(t, 1.0, (*writes)[0].hint.datapoint.Value)
+	require.Equal(t, 2.0, (*writes)[1].hint.datapoint.Value)
+
+	// Hints can be spooled again once replayed.
+	(*writes)[0].fn(nil, errors.New("host unreachable"))
+	h.Hint("host0", newTestHintWriteTaggedOp("foo", 1))
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(4), counters["hints-spooled+"].Value())
+	require.Equal(t, int64(2), counters["hints-deduped+"].Value())
+}
+
+func TestHintedHandoffMaxHintsPerHost(t *testing.T) {
+	h, writes, scope, _ := newTestHintedHandoff(
+		NewOptions().SetHintedHandoffMaxHintsPerHost(1),
+		map[string]bool{"host0": true})
+
+	h.Hint("host0", newTestHintWriteTaggedOp("foo", 1))
+	h.Hint("host0", newTestHintWriteTaggedOp("bar", 2))
+
+	h.replay()
+	require.Len(t, *writes, 1)
+	require.Equal(t, []byte("foo"), (*writes)[0].hint.id)
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(1), counters["hints-spooled+"].Value())
+	require.Equal(t, int64(1), counters["hints-dropped+"].Value())
+}
+
+func TestHintedHandoffExpiresHints(t *testing.T) {
+	connected := map[string]bool{}
+	h, writes, scope, now := newTestHintedHandoff(
+		NewOptions().SetHintedHandoffMaxHintAge(time.Minute), connected)
+
+	h.Hint("host0", newTestHintWriteTaggedOp("foo", 1))
+	*now = now.Add(30 * time.Second)
+	h.Hint("host0", newTestHintWriteTaggedOp("bar", 2))
+	*now = now.Add(45 * time.Second)
+
+	connected["host0"] = true
+	h.replay()
+	require.Len(t, *writes, 1)
+	require.Equal(t, []byte("bar"), (*writes)[0].hint.id)
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(1), counters["hints-expired+"].Value())
+}
+
+func TestHintedHandoffClose(t *testing.T) {
+	h, writes, _, _ := newTestHintedHandoff(NewOptions(),
+		map[string]bool{"host0": true})
+
+	h.Hint("host0", newTestHintWriteTaggedOp("foo", 1))
+	require.NoError(t, h.Close())
+	require.Equal(t, errHintedHandoffClosed, h.Close())
+
+	// Spooled hints are dropped and no more are accepted once closed.
+	h.Hint("host0", newTestHintWriteTaggedOp("bar", 2))
+	h.replay()
+	require.Len(t, *writes, 0)
+}
@@ -22,19 +22,24 @@ package client
 
 import (
 	"bytes"
+	stdctx "context"
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/clock"
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
 	xsync "github.com/m3db/m3/src/x/sync"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber-go/tally"
+	"github.com/uber/tchannel-go"
 	"github.com/uber/tchannel-go/thrift"
 )
 
@@ -67,8 +72,19 @@ type queue struct {
 	drainIn                                      chan []op
 	writeOpBatchSize                             tally.Histogram
 	fetchOpBatchSize                             tally.Histogram
+	cancelledOps                                 tally.Counter
 	status                                       status
 	serverSupportsV2APIs                         bool
+	writeBatchSizer                              *writeBatchSizer
+	saturationThreshold                          int64
+	saturationFn                                 HostQueueSaturationFn
+	saturationNotify                             chan struct{}
+	saturationNotifyDone                         chan struct{}
+	saturatedCount                               tally.Counter
+
+	// pendingWrites and saturated are accessed atomically.
+	pendingWrites int64
+	saturated     int32
 }
 
 func newHostQueue(
@@ -145,8 +161,15 @@ func newHostQueue(
 		opsArrayPool:                                 opArrayPool,
 		writeOpBatchSize:                             scopeWithoutHostID.Histogram("write-op-batch-size", writeOpBatchSizeBuckets),
 		fetchOpBatchSize:                             scopeWithoutHostID.Histogram("fetch-op-batch-size", fetchOpBatchSizeBuckets),
+		cancelledOps:                                 scopeWithoutHostID.Counter("cancelled-ops"),
 		drainIn:                                      make(chan []op, opsArraysLen),
 		serverSupportsV2APIs:                         opts.UseV2BatchAPIs(),
+		writeBatchSizer:                              newWriteBatchSizer(opts),
+		saturationThreshold:                          int64(opts.HostQueueSaturationThreshold()),
+		saturationFn:                                 opts.HostQueueSaturationFn(),
+		saturationNotify:                             make(chan struct{}, 1),
+		saturationNotifyDone:                         make(chan struct{}),
+		saturatedCount:                               scopeWithoutHostID.Counter("saturated"),
 	}, nil
 }
 
@@ -171,6 +194,11 @@ func (q *queue) Open() {
 		// Continually flush the queue at given interval if set
 		go q.flushEvery(flushInterval)
 	}
+
+	if q.saturationFn != nil {
+		// Call the saturation function asynchronously until closed
+		go q.notifySaturationChanges()
+	}
 }
 
 func (q *queue) flushEvery(interval time.Duration) {
@@ -249,6 +277,15 @@ func (q *queue) drain() {
 	for ops := range q.drainIn {
 		opsLen := len(ops)
 		for i := 0; i < opsLen; i++ {
+			if cOp, ok := ops[i].(contextOp); ok {
+				if err := cOp.contextErr(); err != nil {
+					// The caller is no longer waiting on the op so avoid
+					// issuing the request to the host altogether.
+					q.dropCancelledOp(ops[i], err)
+					continue
+				}
+			}
+
 			switch v := ops[i].(type) {
 			case *writeOperation:
 				if q.serverSupportsV2APIs {
@@ -274,6 +311,12 @@ func (q *queue) drain() {
 				q.asyncAggregate(v)
 			case *truncateOp:
 				q.asyncTruncate(v)
+			case *deleteTaggedOp:
+				q.asyncDeleteTagged(v)
+			case *writeDocumentOp:
+				q.asyncWriteDocument(v)
+			case *cardinalityOp:
+				q.asyncCardinality(v)
 			default:
 				completionFn := ops[i].CompletionFn()
 				completionFn(nil, errQueueUnknownOperation(q.host.ID()))
@@ -331,6 +374,108 @@ func (q *queue) drain() {
 	q.connPool.Close()
 }
 
+// dropCancelledOp completes an op whose context was cancelled before it was
+// issued to the host, releasing the references the queue holds on it.
+func (q *queue) dropCancelledOp(o op, err error) {
+	q.cancelledOps.Inc(1)
+	switch v := o.(type) {
+	case *fetchBatchOp:
+		v.completeAll(nil, err)
+		v.DecRef()
+		v.Finalize()
+	case *fetchTaggedOp:
+		v.CompletionFn()(fetchTaggedResultAccumulatorOpts{host: q.host}, err)
+		v.decRef()
+	case *aggregateOp:
+		v.CompletionFn()(aggregateResultAccumulatorOpts{host: q.host}, err)
+		v.decRef()
+	case *cardinalityOp:
+		v.completionFn(nil, err)
+	default:
+		// NB: host is passed to the write state to determine the state of
+		// the shard on the node the write was destined for.
+		o.CompletionFn()(q.host, err)
+		if isWriteOp(o) {
+			q.writesDone(1)
+		}
+	}
+}
+
+func isWriteOp(o op) bool {
+	switch o.(type) {
+	case *writeOperation, *writeTaggedOperation:
+		return true
+	}
+	return false
+}
+
+// writesDone marks writes as no longer pending to the host, notifying that
+// the queue is no longer saturated once pending writes drop below half of
+// the saturation threshold.
+func (q *queue) writesDone(n int) {
+	pending := atomic.AddInt64(&q.pendingWrites, -int64(n))
+	if pending < q.saturationThreshold/2 &&
+		atomic.CompareAndSwapInt32(&q.saturated, 1, 0) {
+		q.notifySaturation()
+	}
+}
+
+// writeEnqueued marks a write as pending to the host, notifying that the
+// queue is saturated once pending writes reach the saturation threshold.
+func (q *queue) writeEnqueued() {
+	pending := atomic.AddInt64(&q.pendingWrites, 1)
+	if pending >= q.saturationThreshold &&
+		atomic.CompareAndSwapInt32(&q.saturated, 0, 1) {
+		q.saturatedCount.Inc(1)
+		q.notifySaturation()
+	}
+}
+
+// notifySaturation wakes the goroutine calling the saturation function, the
+// function is not called inline since writes are enqueued while holding the
+// session lock and completed by the goroutines draining the queue.
+func (q *queue) notifySaturation() {
+	select {
+	case q.saturationNotify <- struct{}{}:
+	default:
+		// Already woken, the latest saturation is read once it runs.
+	}
+}
+
+// notifySaturationChanges calls the saturation function each time the queue
+// becomes saturated or is no longer saturated until the queue is closed.
+// Changes that are reversed before the function is called may be skipped,
+// but the last call always reflects whether the queue is saturated.
+func (q *queue) notifySaturationChanges() {
+	var saturated bool
+	for {
+		select {
+		case <-q.saturationNotify:
+		case <-q.saturationNotifyDone:
+			return
+		}
+
+		saturation := q.Saturation()
+		if saturation.Saturated == saturated {
+			continue
+		}
+		saturated = saturation.Saturated
+		q.saturationFn(saturation)
+	}
+}
+
+func (q *queue) Saturation() HostQueueSaturation {
+	pending := atomic.LoadInt64(&q.pendingWrites)
+	return HostQueueSaturation{
+		Host:           q.host,
+		PendingWrites:  int(pending),
+		Saturation:     float64(pending) / float64(q.saturationThreshold),
+		Saturated:      atomic.LoadInt32(&q.saturated) == 1,
+		WriteBatchSize: q.writeBatchSizer.BatchSize(),
+		WriteLatency:   q.writeBatchSizer.Latency(),
+	}
+}
+
 func (q *queue) drainWriteOpV1(
 	v *writeOperation,
 	currWriteOpsByNamespace namespaceWriteBatchOpsSlice,
@@ -350,7 +495,7 @@ func (q *queue) drainWriteOpV1(
 
 	currWriteOpsByNamespace.appendAt(idx, op, &v.request)
 
-	if currWriteOpsByNamespace.lenAt(idx) == q.opts.WriteBatchSize() {
+	if currWriteOpsByNamespace.lenAt(idx) >= q.writeBatchSizer.BatchSize() {
 		// Reached write batch limit, write async and reset.
 		q.asyncWrite(namespace, currWriteOpsByNamespace[idx].ops,
 			currWriteOpsByNamespace[idx].elems)
@@ -379,7 +524,7 @@ func (q *queue) drainTaggedWriteOpV1(
 
 	currTaggedWriteOpsByNamespace.appendAt(idx, op, &v.request)
 
-	if currTaggedWriteOpsByNamespace.lenAt(idx) == q.opts.WriteBatchSize() {
+	if currTaggedWriteOpsByNamespace.lenAt(idx) >= q.writeBatchSizer.BatchSize() {
 		// Reached write batch limit, write async and reset
 		q.asyncTaggedWrite(namespace, currTaggedWriteOpsByNamespace[idx].ops,
 			currTaggedWriteOpsByNamespace[idx].elems)
@@ -420,7 +565,7 @@ func (q *queue) drainWriteOpV2(
 	requestCopy.NameSpace = int64(nsIdx)
 	currV2WriteReq.Elements = append(currV2WriteReq.Elements, &requestCopy)
 	currV2WriteOps = append(currV2WriteOps, op)
-	if len(currV2WriteReq.Elements) == q.opts.WriteBatchSize() {
+	if len(currV2WriteReq.Elements) >= q.writeBatchSizer.BatchSize() {
 		// Reached write batch limit, write async and reset.
 		q.asyncWriteV2(currV2WriteOps, currV2WriteReq)
 		currV2WriteReq = nil
@@ -461,7 +606,7 @@ func (q *queue) drainTaggedWriteOpV2(
 	requestCopy.NameSpace = int64(nsIdx)
 	currV2WriteTaggedReq.Elements = append(currV2WriteTaggedReq.Elements, &requestCopy)
 	currV2WriteTaggedOps = append(currV2WriteTaggedOps, op)
-	if len(currV2WriteTaggedReq.Elements) == q.opts.WriteBatchSize() {
+	if len(currV2WriteTaggedReq.Elements) >= q.writeBatchSizer.BatchSize() {
 		// Reached write batch limit, write async and reset.
 		q.asyncTaggedWriteV2(currV2WriteTaggedOps, currV2WriteTaggedReq)
 		currV2WriteTaggedReq = nil
@@ -529,6 +674,7 @@ func (q *queue) asyncTaggedWrite(
 			q.writeTaggedBatchRawRequestElementArrayPool.Put(elems)
 			q.writeTaggedBatchRawRequestPool.Put(req)
 			q.opsArrayPool.Put(ops)
+			q.writesDone(len(ops))
 			q.Done()
 		}
 
@@ -543,8 +689,12 @@ func (q *queue) asyncTaggedWrite(
 			return
 		}
 
-		ctx, _ := thrift.NewContext(q.opts.WriteRequestTimeout())
+		sp, ctx, cancel := q.newRequestContext(batchContext(ops),
+			tracepoint.ClientQueueWriteBatch, q.opts.WriteRequestTimeout())
+		start := q.nowFn()
 		err = client.WriteTaggedBatchRaw(ctx, req)
+		q.writeBatchSizer.RecordLatency(q.nowFn().Sub(start))
+		finishRequestSpan(sp, cancel, err)
 		if err == nil {
 			// All succeeded
 			callAllCompletionFns(ops, q.host, nil)
@@ -590,6 +740,7 @@ func (q *queue) asyncTaggedWriteV2(
 			q.writeTaggedBatchRawV2RequestElementArrayPool.Put(req.Elements)
 			q.writeTaggedBatchRawV2RequestPool.Put(req)
 			q.opsArrayPool.Put(ops)
+			q.writesDone(len(ops))
 			q.Done()
 		}
 
@@ -603,8 +754,12 @@ func (q *queue) asyncTaggedWriteV2(
 			return
 		}
 
-		ctx, _ := thrift.NewContext(q.opts.WriteRequestTimeout())
+		sp, ctx, cancel := q.newRequestContext(batchContext(ops),
+			tracepoint.ClientQueueWriteBatch, q.opts.WriteRequestTimeout())
+		start := q.nowFn()
 		err = client.WriteTaggedBatchRawV2(ctx, req)
+		q.writeBatchSizer.RecordLatency(q.nowFn().Sub(start))
+		finishRequestSpan(sp, cancel, err)
 		if err == nil {
 			// All succeeded
 			callAllCompletionFns(ops, q.host, nil)
@@ -654,6 +809,7 @@ func (q *queue) asyncWrite(
 			q.writeBatchRawRequestElementArrayPool.Put(elems)
 			q.writeBatchRawRequestPool.Put(req)
 			q.opsArrayPool.Put(ops)
+			q.writesDone(len(ops))
 			q.Done()
 		}
 
@@ -668,8 +824,12 @@ func (q *queue) asyncWrite(
 			return
 		}
 
-		ctx, _ := thrift.NewContext(q.opts.WriteRequestTimeout())
+		sp, ctx, cancel := q.newRequestContext(batchContext(ops),
+			tracepoint.ClientQueueWriteBatch, q.opts.WriteRequestTimeout())
+		start := q.nowFn()
 		err = client.WriteBatchRaw(ctx, req)
+		q.writeBatchSizer.RecordLatency(q.nowFn().Sub(start))
+		finishRequestSpan(sp, cancel, err)
 		if err == nil {
 			// All succeeded
 			callAllCompletionFns(ops, q.host, nil)
@@ -714,6 +874,7 @@ func (q *queue) asyncWriteV2(
 			q.writeBatchRawV2RequestElementArrayPool.Put(req.Elements)
 			q.writeBatchRawV2RequestPool.Put(req)
 			q.opsArrayPool.Put(ops)
+			q.writesDone(len(ops))
 			q.Done()
 		}
 
@@ -727,8 +888,12 @@ func (q *queue) asyncWriteV2(
 			return
 		}
 
-		ctx, _ := thrift.NewContext(q.opts.WriteRequestTimeout())
+		sp, ctx, cancel := q.newRequestContext(batchContext(ops),
+			tracepoint.ClientQueueWriteBatch, q.opts.WriteRequestTimeout())
+		start := q.nowFn()
 		err = client.WriteBatchRawV2(ctx, req)
+		q.writeBatchSizer.RecordLatency(q.nowFn().Sub(start))
+		finishRequestSpan(sp, cancel, err)
 		if err == nil {
 			// All succeeded.
 			callAllCompletionFns(ops, q.host, nil)
@@ -780,8 +945,10 @@ func (q *queue) asyncFetch(op *fetchBatchOp) {
 			return
 		}
 
-		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		sp, ctx, cancel := q.newRequestContext(op.context(),
+			tracepoint.ClientQueueFetchBatch, q.opts.FetchRequestTimeout())
 		result, err := client.FetchBatchRaw(ctx, &op.request)
+		finishRequestSpan(sp, cancel, err)
 		if err != nil {
 			op.completeAll(nil, err)
 			cleanup()
@@ -833,8 +1000,10 @@ func (q *queue) asyncFetchV2(
 			return
 		}
 
-		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		sp, ctx, cancel := q.newRequestContext(batchContext(ops),
+			tracepoint.ClientQueueFetchBatch, q.opts.FetchRequestTimeout())
 		result, err := client.FetchBatchRawV2(ctx, currV2FetchBatchRawReq)
+		finishRequestSpan(sp, cancel, err)
 		if err != nil {
 			callAllCompletionFns(ops, nil, err)
 			cleanup()
@@ -880,8 +1049,10 @@ func (q *queue) asyncFetchTagged(op *fetchTaggedOp) {
 			return
 		}
 
-		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		sp, ctx, cancel := q.newRequestContext(op.context(),
+			tracepoint.ClientQueueFetchTagged, q.opts.FetchRequestTimeout())
 		result, err := client.FetchTagged(ctx, &op.request)
+		finishRequestSpan(sp, cancel, err)
 		if err != nil {
 			op.CompletionFn()(fetchTaggedResultAccumulatorOpts{host: q.host}, err)
 			cleanup()
@@ -913,8 +1084,10 @@ func (q *queue) asyncAggregate(op *aggregateOp) {
 			return
 		}
 
-		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		sp, ctx, cancel := q.newRequestContext(op.context(),
+			tracepoint.ClientQueueAggregate, q.opts.FetchRequestTimeout())
 		result, err := client.AggregateRaw(ctx, &op.request)
+		finishRequestSpan(sp, cancel, err)
 		if err != nil {
 			op.CompletionFn()(aggregateResultAccumulatorOpts{host: q.host}, err)
 			cleanup()
@@ -954,6 +1127,141 @@ func (q *queue) asyncTruncate(op *truncateOp) {
 	})
 }
 
+func (q *queue) asyncDeleteTagged(op *deleteTaggedOp) {
+	q.Add(1)
+
+	q.workerPool.Go(func() {
+		cleanup := q.Done
+
+		client, err := q.connPool.NextClient()
+		if err != nil {
+			// No client available
+			op.completionFn(nil, err)
+			cleanup()
+			return
+		}
+
+		ctx, _ := thrift.NewContext(q.opts.DeleteTaggedRequestTimeout())
+		if res, err := client.DeleteTagged(ctx, &op.request); err != nil {
+			op.completionFn(nil, err)
+		} else {
+			op.completionFn(res, nil)
+		}
+
+		cleanup()
+	})
+}
+
+func (q *queue) asyncWriteDocument(op *writeDocumentOp) {
+	q.Add(1)
+
+	q.workerPool.Go(func() {
+		cleanup := q.Done
+
+		client, err := q.connPool.NextClient()
+		if err != nil {
+			// No client available
+			op.completionFn(nil, err)
+			cleanup()
+			return
+		}
+
+		ctx, _ := thrift.NewContext(q.opts.WriteRequestTimeout())
+		if err := client.WriteDocument(ctx, &op.request); err != nil {
+			op.completionFn(nil, err)
+		} else {
+			op.completionFn(nil, nil)
+		}
+
+		cleanup()
+	})
+}
+
+func (q *queue) asyncCardinality(op *cardinalityOp) {
+	q.Add(1)
+
+	q.workerPool.Go(func() {
+		cleanup := q.Done
+
+		client, err := q.connPool.NextClient()
+		if err != nil {
+			// No client available
+			op.completionFn(nil, err)
+			cleanup()
+			return
+		}
+
+		sp, ctx, cancel := q.newRequestContext(op.context(),
+			tracepoint.ClientQueueCardinality, q.opts.FetchRequestTimeout())
+		res, err := client.Cardinality(ctx, &op.request)
+		finishRequestSpan(sp, cancel, err)
+		if err != nil {
+			op.completionFn(nil, err)
+		} else {
+			op.completionFn(res, nil)
+		}
+
+		cleanup()
+	})
+}
+
+// newRequestContext returns the context for a request issued on behalf of
+// ops. When the ops carry a context the request inherits its deadline and
+// cancellation, bounded by the given timeout, and is traced with a child
+// span of the ops' span if they have one.
+func (q *queue) newRequestContext(
+	parent stdctx.Context,
+	operation string,
+	timeout time.Duration,
+) (opentracing.Span, thrift.Context, stdctx.CancelFunc) {
+	if parent == nil {
+		ctx, cancel := thrift.NewContext(timeout)
+		return nil, ctx, cancel
+	}
+
+	sp, parent := startSpan(parent, operation)
+	if sp != nil {
+		sp.SetTag("host", q.host.ID())
+	}
+
+	ctx, cancel := tchannel.NewContextBuilder(timeout).
+		SetParentContext(parent).
+		Build()
+	return sp, ctx, cancel
+}
+
+// batchContext returns the context shared by every op of a batch so that the
+// batch request inherits its deadline and cancellation. Batches of ops from
+// callers that are cancelled independently have no context and are only
+// bounded by the request timeout, ops cancelled before the batch was issued
+// are dropped from it when the queue is drained.
+func batchContext(ops []op) stdctx.Context {
+	var (
+		ctx  stdctx.Context
+		done <-chan struct{}
+	)
+	for i, o := range ops {
+		cOp, ok := o.(contextOp)
+		if !ok || cOp.context() == nil {
+			return nil
+		}
+		if i == 0 {
+			ctx = cOp.context()
+			done = ctx.Done()
+			continue
+		}
+		if cOp.context().Done() != done {
+			return nil
+		}
+	}
+	return ctx
+}
+
+func finishRequestSpan(sp opentracing.Span, cancel stdctx.CancelFunc, err error) {
+	cancel()
+	finishSpan(sp, err)
+}
+
 func (q *queue) Len() int {
 	q.RLock()
 	v := q.opsSumSize
@@ -992,6 +1300,12 @@ func (q *queue) Enqueue(o op) error {
 		q.drainIn <- needsDrain
 	}
 	q.Unlock()
+
+	// NB: track pending writes outside of the lock since the saturation
+	// function is called on the calling goroutine.
+	if isWriteOp(o) {
+		q.writeEnqueued()
+	}
 	return nil
 }
 
@@ -1045,6 +1359,7 @@ func (q *queue) Close() {
 	// Closed drainIn channel in lock to ensure writers know
 	// consistently if channel is open or not by checking state
 	close(q.drainIn)
+	close(q.saturationNotifyDone)
 	q.Unlock()
 }
 
@@ -21,6 +21,7 @@
 package client
 
 import (
+	stdctx "context"
 	"fmt"
 	"sync"
 	"testing"
@@ -157,9 +158,59 @@ func TestHostQueueFetchTaggedErrorOnFetchTaggedError(t *testing.T) {
 	})
 }
 
+func TestHostQueueFetchTaggedDropsCancelledOp(t *testing.T) {
+	namespace := "testNs"
+	ctx, cancel := stdctx.WithCancel(stdctx.Background())
+	cancel()
+
+	expectedResults := []hostQueueResult{
+		hostQueueResult{
+			result: fetchTaggedResultAccumulatorOpts{host: h},
+			err:    stdctx.Canceled,
+		},
+	}
+	opts := &testHostQueueFetchTaggedOptions{
+		ctx: ctx,
+	}
+	// NB: no client is requested since the cancelled op is never issued.
+	testHostQueueFetchTagged(t, namespace, nil, expectedResults, opts, func(results []hostQueueResult) {
+		assert.Equal(t, expectedResults, results)
+	})
+}
+
+func TestHostQueueFetchTaggedPropagatesContextDeadline(t *testing.T) {
+	namespace := "testNs"
+	res := &rpc.FetchTaggedResult_{Exhaustive: true}
+	deadline := time.Now().Add(time.Second)
+	ctx, cancel := stdctx.WithDeadline(stdctx.Background(), deadline)
+	defer cancel()
+
+	expectedResults := []hostQueueResult{
+		hostQueueResult{
+			result: fetchTaggedResultAccumulatorOpts{
+				response: res,
+				host:     h,
+			},
+		},
+	}
+	opts := &testHostQueueFetchTaggedOptions{
+		ctx: ctx,
+		assertRequestCtx: func(reqCtx thrift.Context) {
+			reqDeadline, ok := reqCtx.Deadline()
+			require.True(t, ok)
+			assert.False(t, reqDeadline.After(deadline))
+		},
+	}
+	testHostQueueFetchTagged(t, namespace, res, expectedResults, opts, func(results []hostQueueResult) {
+		assert.Equal(t, expectedResults, results)
+	})
+}
+
 type testHostQueueFetchTaggedOptions struct {
-	nextClientErr  error
-	fetchTaggedErr error
+	nextClientErr    error
+	fetchTaggedErr   error
+	ctx              stdctx.Context
+	assertRequestCtx func(ctx thrift.Context)
 }
 
 func testHostQueueFetchTagged(
@@ -198,10 +249,17 @@ func testHostQueueFetchTagged(
 	// Prepare fetch batch op
 	fetchTagged := testFetchTaggedOp("testNs", callback)
 	wg.Add(1)
+	if testOpts != nil && testOpts.ctx != nil {
+		fetchTagged.setContext(testOpts.ctx)
+	}
 
 	// Prepare mocks for flush
 	mockClient := rpc.NewMockTChanNode(ctrl)
-	if testOpts != nil && testOpts.nextClientErr != nil {
+	cancelled := testOpts != nil && testOpts.ctx != nil && testOpts.ctx.Err() != nil
+	if cancelled {
+		// Cancelled ops are dropped without borrowing a client.
+		mockConnPool.EXPECT().NextClient().Times(0)
+	} else if testOpts != nil && testOpts.nextClientErr != nil {
 		mockConnPool.EXPECT().NextClient().Return(nil, testOpts.nextClientErr)
 	} else if testOpts != nil && testOpts.fetchTaggedErr != nil {
 		fetchTaggedExec := func(ctx thrift.Context, req *rpc.FetchTaggedRequest) {
@@ -218,6 +276,9 @@ func testHostQueueFetchTagged(
 		fetchTaggedExec := func(ctx thrift.Context, req *rpc.FetchTaggedRequest) {
 			require.NotNil(t, req)
 			assert.Equal(t, fetchTagged.request, *req)
+			if testOpts != nil && testOpts.assertRequestCtx != nil {
+				testOpts.assertRequestCtx(ctx)
+			}
 		}
 		mockClient.EXPECT().
 			FetchTagged(gomock.Any(), gomock.Any()).
@@ -21,6 +21,7 @@
 package client
 
 import (
+	stdctx "context"
 	"fmt"
 	"sync"
 	"testing"
@@ -31,6 +32,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/uber/tchannel-go/thrift"
 )
 
@@ -40,6 +42,28 @@ func TestHostQueueWriteErrorBeforeOpen(t *testing.T) {
 	assert.Error(t, queue.Enqueue(&writeOperation{}))
 }
 
+func TestBatchContext(t *testing.T) {
+	newOp := func(ctx stdctx.Context) op {
+		w := &writeOperation{}
+		w.reset()
+		w.setContext(ctx)
+		return w
+	}
+
+	ctx, cancel := stdctx.WithCancel(stdctx.Background())
+	defer cancel()
+	other, cancelOther := stdctx.WithCancel(stdctx.Background())
+	defer cancelOther()
+
+	// Batches inherit the context shared by all of their ops.
+	assert.Equal(t, ctx, batchContext([]op{newOp(ctx), newOp(ctx)}))
+
+	// Batches of ops that are cancelled independently have no context.
+	assert.Nil(t, batchContext([]op{newOp(ctx), newOp(other)}))
+	assert.Nil(t, batchContext([]op{newOp(ctx), newOp(nil)}))
+	assert.Nil(t, batchContext(nil))
+}
+
 func TestHostQueueWriteErrorAfterClose(t *testing.T) {
 	opts := newHostQueueTestOptions()
 	queue := newTestHostQueue(opts)
@@ -517,6 +541,182 @@ func TestHostQueueDrainOnClose(t *testing.T) {
 	}
 }
 
+func TestHostQueueWriteBatchesSaturation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockConnPool := NewMockconnectionPool(ctrl)
+
+	saturationCh := make(chan HostQueueSaturation, 2)
+	opts := newHostQueueTestOptions().
+		SetHostQueueSaturationThreshold(2).
+		SetHostQueueSaturationFn(func(s HostQueueSaturation) {
+			saturationCh <- s
+		})
+	queue := newTestHostQueue(opts)
+	queue.connPool = mockConnPool
+
+	// Open
+	mockConnPool.EXPECT().Open()
+	queue.Open()
+	assert.Equal(t, statusOpen, queue.status)
+
+	var wg sync.WaitGroup
+	callback := func(r interface{}, err error) {
+		assert.NoError(t, err)
+		wg.Done()
+	}
+
+	writes := []*writeOperation{
+		testWriteOp("testNs", "foo", 1.0, 1000, rpc.TimeType_UNIX_SECONDS, callback),
+		testWriteOp("testNs", "bar", 2.0, 2000, rpc.TimeType_UNIX_SECONDS, callback),
+		testWriteOp("testNs", "baz", 3.0, 3000, rpc.TimeType_UNIX_SECONDS, callback),
+		testWriteOp("testNs", "qux", 4.0, 4000, rpc.TimeType_UNIX_SECONDS, callback),
+	}
+	wg.Add(len(writes))
+
+	// Reaching the threshold saturates the queue.
+	for _, write := range writes[:2] {
+		assert.NoError(t, queue.Enqueue(write))
+	}
+	saturation := <-saturationCh
+	assert.True(t, saturation.Saturated)
+	assert.Equal(t, 2, saturation.PendingWrites)
+	assert.Equal(t, 1.0, saturation.Saturation)
+	assert.Equal(t, 4, saturation.WriteBatchSize)
+
+	mockClient := rpc.NewMockTChanNode(ctrl)
+	mockClient.EXPECT().WriteBatchRaw(gomock.Any(), gomock.Any()).Return(nil)
+	mockConnPool.EXPECT().NextClient().Return(mockClient, nil)
+
+	// Flushing the writes relieves the saturation.
+	for _, write := range writes[2:] {
+		assert.NoError(t, queue.Enqueue(write))
+	}
+	wg.Wait()
+
+	select {
+	case saturation := <-saturationCh:
+		assert.False(t, saturation.Saturated)
+		assert.Equal(t, 0, saturation.PendingWrites)
+	case <-time.After(time.Minute):
+		assert.Fail(t, "saturation not relieved")
+	}
+
+	// Close
+	mockConnPool.EXPECT().Close().AnyTimes()
+	queue.Close()
+}
+
+func TestHostQueueWriteBatchesSaturationFnDoesNotBlockWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockConnPool := NewMockconnectionPool(ctrl)
+
+	var (
+		called     = make(chan struct{})
+		calledOnce sync.Once
+		release    = make(chan struct{})
+	)
+	opts := newHostQueueTestOptions().
+		SetHostQueueSaturationThreshold(2).
+		SetHostQueueSaturationFn(func(s HostQueueSaturation) {
+			calledOnce.Do(func() { close(called) })
+			<-release
+		})
+	queue := newTestHostQueue(opts)
+	queue.connPool = mockConnPool
+
+	// Open
+	mockConnPool.EXPECT().Open()
+	queue.Open()
+	assert.Equal(t, statusOpen, queue.status)
+
+	var wg sync.WaitGroup
+	callback := func(r interface{}, err error) {
+		wg.Done()
+	}
+
+	// Saturating the queue does not wait for the saturation function.
+	writes := []*writeOperation{
+		testWriteOp("testNs", "foo", 1.0, 1000, rpc.TimeType_UNIX_SECONDS, callback),
+		testWriteOp("testNs", "bar", 2.0, 2000, rpc.TimeType_UNIX_SECONDS, callback),
+		testWriteOp("testNs", "baz", 3.0, 3000, rpc.TimeType_UNIX_SECONDS, callback),
+	}
+	wg.Add(len(writes))
+	for _, write := range writes {
+		assert.NoError(t, queue.Enqueue(write))
+	}
+	<-called
+	close(release)
+
+	// Close
+	mockClient := rpc.NewMockTChanNode(ctrl)
+	mockClient.EXPECT().WriteBatchRaw(gomock.Any(), gomock.Any()).Return(nil)
+	mockConnPool.EXPECT().NextClient().Return(mockClient, nil)
+	mockConnPool.EXPECT().Close().AnyTimes()
+	queue.Close()
+	wg.Wait()
+}
+func TestHostQueueWriteBatchesAdaptiveBatchSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockConnPool := NewMockconnectionPool(ctrl)
+
+	opts := newHostQueueTestOptions().
+		SetAdaptiveWriteBatchEnabled(true).
+		SetAdaptiveWriteBatchTargetLatency(time.Millisecond).
+		SetAdaptiveWriteBatchMinSize(2)
+	queue := newTestHostQueue(opts)
+	queue.connPool = mockConnPool
+
+	// Slow write requests shrink the write batches to the min size.
+	for i := 0; i < 10; i++ {
+		queue.writeBatchSizer.RecordLatency(time.Second)
+	}
+	require.Equal(t, 2, queue.writeBatchSizer.BatchSize())
+
+	// Open
+	mockConnPool.EXPECT().Open()
+	queue.Open()
+	assert.Equal(t, statusOpen, queue.status)
+
+	var wg sync.WaitGroup
+	callback := func(r interface{}, err error) {
+		assert.NoError(t, err)
+		wg.Done()
+	}
+
+	writes := []*writeOperation{
+		testWriteOp("testNs", "foo", 1.0, 1000, rpc.TimeType_UNIX_SECONDS, callback),
+		testWriteOp("testNs", "bar", 2.0, 2000, rpc.TimeType_UNIX_SECONDS, callback),
+		testWriteOp("testNs", "baz", 3.0, 3000, rpc.TimeType_UNIX_SECONDS, callback),
+		testWriteOp("testNs", "qux", 4.0, 4000, rpc.TimeType_UNIX_SECONDS, callback),
+	}
+	wg.Add(len(writes))
+
+	mockClient := rpc.NewMockTChanNode(ctrl)
+	mockClient.EXPECT().
+		WriteBatchRaw(gomock.Any(), gomock.Any()).
+		Do(func(ctx thrift.Context, req *rpc.WriteBatchRawRequest) {
+			assert.Equal(t, 2, len(req.Elements))
+		}).
+		Return(nil).
+		Times(2)
+	mockConnPool.EXPECT().NextClient().Return(mockClient, nil).Times(2)
+
+	for _, write := range writes {
+		assert.NoError(t, queue.Enqueue(write))
+	}
+	wg.Wait()
+
+	// Close
+	mockConnPool.EXPECT().Close().AnyTimes()
+	queue.Close()
+}
+
 func testWriteOp(
 	namespace string,
 	id string,
@@ -20,6 +20,15 @@
 
 package client
 
+import (
+	stdctx "context"
+
+	xopentracing "github.com/m3db/m3/src/x/opentracing"
+
+	"github.com/opentracing/opentracing-go"
+	opentracinglog "github.com/opentracing/opentracing-go/log"
+)
+
 type completionFn func(result interface{}, err error)
 
 func callAllCompletionFns(ops []op, result interface{}, err error) {
@@ -27,3 +36,56 @@ func callAllCompletionFns(ops []op, result interface{}, err error) {
 		ops[i].CompletionFn()(result, err)
 	}
 }
+
+// opContext holds the context of the session call an op was created for,
+// allowing host queues to drop ops the caller is no longer waiting on and
+// to propagate deadlines and trace spans into the requests they issue.
+type opContext struct {
+	ctx stdctx.Context
+}
+
+func (c *opContext) setContext(ctx stdctx.Context) {
+	c.ctx = ctx
+}
+
+// context returns the context of the op, which is nil if the op was
+// created without one.
+func (c *opContext) context() stdctx.Context {
+	return c.ctx
+}
+
+// contextErr returns the error of the op context if it has been cancelled
+// or its deadline has been exceeded.
+func (c *opContext) contextErr() error {
+	if c.ctx == nil {
+		return nil
+	}
+	return c.ctx.Err()
+}
+
+type contextOp interface {
+	context() stdctx.Context
+	contextErr() error
+}
+
+// startSpan starts a child span for the operation if the context carries a
+// trace span, the client never starts traces of its own.
+func startSpan(
+	ctx stdctx.Context,
+	operation string,
+) (opentracing.Span, stdctx.Context) {
+	if ctx == nil || opentracing.SpanFromContext(ctx) == nil {
+		return nil, ctx
+	}
+	return xopentracing.StartSpanFromContext(ctx, operation)
+}
+
+func finishSpan(sp opentracing.Span, err error) {
+	if sp == nil {
+		return
+	}
+	if err != nil {
+		sp.LogFields(opentracinglog.Error(err))
+	}
+	sp.Finish()
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/clock"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/encoding/proto"
 	"github.com/m3db/m3/src/dbnode/environment"
@@ -46,6 +47,7 @@ import (
 	xsync "github.com/m3db/m3/src/x/sync"
 
 	tchannel "github.com/uber/tchannel-go"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -85,6 +87,9 @@ const (
 	// defaultTruncateRequestTimeout is the default truncate request timeout
 	defaultTruncateRequestTimeout = 60 * time.Second
 
+	// defaultDeleteTaggedRequestTimeout is the default delete tagged request timeout
+	defaultDeleteTaggedRequestTimeout = 60 * time.Second
+
 	// defaultIdentifierPoolSize is the default identifier pool size
 	defaultIdentifierPoolSize = 8192
 
@@ -162,6 +167,74 @@ const (
 	// defaultUseV2BatchAPIs is the default setting for whether the v2 version of the batch APIs should
 	// be used.
 	defaultUseV2BatchAPIs = false
+
+	// defaultReadRepairEnabled is the default setting for whether read repair
+	// of diverging replicas is performed on fetches.
+	defaultReadRepairEnabled = false
+
+	// defaultReadRepairMaxDatapointsPerSecond is the default maximum number of
+	// datapoints written back to replicas by read repair per second.
+	defaultReadRepairMaxDatapointsPerSecond = 10000
+
+	// defaultReadRepairQueueSize is the default maximum number of series
+	// pending read repair, series beyond this are not repaired.
+	defaultReadRepairQueueSize = 1024
+
+	// defaultHintedHandoffEnabled is the default setting for whether writes
+	// that failed against a replica are spooled and replayed against it.
+	defaultHintedHandoffEnabled = false
+
+	// defaultHintedHandoffMaxHintsPerHost is the default maximum number of
+	// writes spooled for a single replica, writes beyond this are dropped.
+	defaultHintedHandoffMaxHintsPerHost = 100000
+
+	// defaultHintedHandoffMaxHintAge is the default maximum age of a spooled
+	// write, older writes are dropped rather than replayed.
+	defaultHintedHandoffMaxHintAge = 10 * time.Minute
+
+	// defaultHintedHandoffReplayInterval is the default interval at which
+	// replicas with spooled writes are checked for being reachable again.
+	defaultHintedHandoffReplayInterval = time.Second
+
+	// defaultTransportType is the default transport used to communicate
+	// with nodes.
+	defaultTransportType = TChannelTransportType
+
+	// defaultGRPCPort is the default port nodes serve gRPC on when using
+	// the gRPC transport.
+	defaultGRPCPort = 9005
+
+	// defaultGRPCMaxMsgSize is the default maximum size of messages sent to
+	// and received from nodes when using the gRPC transport.
+	defaultGRPCMaxMsgSize = 256 << 20
+
+	// defaultAdaptiveWriteBatchEnabled is the default setting for whether
+	// write batches are sized from the observed write request latency.
+	defaultAdaptiveWriteBatchEnabled = false
+
+	// defaultAdaptiveWriteBatchTargetLatency is the default write request
+	// latency adaptive write batches are sized to stay within.
+	defaultAdaptiveWriteBatchTargetLatency = 50 * time.Millisecond
+
+	// defaultAdaptiveWriteBatchMinSize is the default minimum size of
+	// adaptive write batches.
+	defaultAdaptiveWriteBatchMinSize = 8
+
+	// defaultHostQueueSaturationThreshold is the default number of writes
+	// pending to a host at which its queue is considered saturated.
+	defaultHostQueueSaturationThreshold = 65536
+
+	// defaultHedgedReadsEnabled is the default setting for whether fetch
+	// tagged requests are hedged.
+	defaultHedgedReadsEnabled = false
+
+	// defaultHedgedReadsDelayPercentile is the default percentile of recent
+	// fetch tagged response latencies after which a fetch is hedged.
+	defaultHedgedReadsDelayPercentile = 0.95
+
+	// defaultHedgedReadsMinDelay is the default minimum delay after which a
+	// fetch is hedged.
+	defaultHedgedReadsMinDelay = 10 * time.Millisecond
 )
 
 var (
@@ -209,6 +282,14 @@ var (
 
 	errNoTopologyInitializerSet    = errors.New("no topology initializer set")
 	errNoReaderIteratorAllocateSet = errors.New("no reader iterator allocator set, encoding not set")
+	errInvalidReadRepairOptions    = errors.New("read repair max datapoints per second and queue size must be positive")
+	errNoReadRepairNamespaceInit   = errors.New("read repair namespace initializer must be set")
+	errInvalidHintedHandoffOptions = errors.New("hinted handoff max hints per host, max hint age and replay interval must be positive")
+	errInvalidGRPCPort             = errors.New("grpc port must be between 1 and 65535")
+	errInvalidGRPCMaxMsgSize       = errors.New("grpc max message size must be positive")
+	errInvalidAdaptiveWriteBatch   = errors.New("adaptive write batch target latency and min size must be positive")
+	errInvalidSaturationThreshold  = errors.New("host queue saturation threshold must be positive")
+	errInvalidHedgedReadsOptions   = errors.New("hedged reads delay percentile must be in (0, 1] and min delay must be non-negative")
 )
 
 type options struct {
@@ -229,6 +310,7 @@ type options struct {
 	writeRequestTimeout                     time.Duration
 	fetchRequestTimeout                     time.Duration
 	truncateRequestTimeout                  time.Duration
+	deleteTaggedRequestTimeout              time.Duration
 	backgroundConnectInterval               time.Duration
 	backgroundConnectStutter                time.Duration
 	backgroundHealthCheckInterval           time.Duration
@@ -270,6 +352,27 @@ type options struct {
 	useV2BatchAPIs                          bool
 	iterationOptions                        index.IterationOptions
 	writeTimestampOffset                    time.Duration
+	readRepairEnabled                       bool
+	readRepairMaxDatapointsPerSecond        int
+	readRepairQueueSize                     int
+	readRepairNamespaceInitializer          namespace.Initializer
+	hintedHandoffEnabled                    bool
+	hintedHandoffMaxHintsPerHost            int
+	hintedHandoffMaxHintAge                 time.Duration
+	hintedHandoffReplayInterval             time.Duration
+	transportType                           TransportType
+	grpcPort                                int
+	grpcMaxMsgSize                          int
+	grpcTransportCredentials                credentials.TransportCredentials
+	adaptiveWriteBatchEnabled               bool
+	adaptiveWriteBatchTargetLatency         time.Duration
+	adaptiveWriteBatchMinSize               int
+	hostQueueSaturationThreshold            int
+	hostQueueSaturationFn                   HostQueueSaturationFn
+	hedgedReadsEnabled                      bool
+	hedgedReadsDelayPercentile              float64
+	hedgedReadsMinDelay                     time.Duration
+	preferredReadIsolationGroup             string
 }
 
 // NewOptions creates a new set of client options with defaults
@@ -335,6 +438,7 @@ func newOptions() *options {
 		writeRequestTimeout:                     defaultWriteRequestTimeout,
 		fetchRequestTimeout:                     defaultFetchRequestTimeout,
 		truncateRequestTimeout:                  defaultTruncateRequestTimeout,
+		deleteTaggedRequestTimeout:              defaultDeleteTaggedRequestTimeout,
 		backgroundConnectInterval:               defaultBackgroundConnectInterval,
 		backgroundConnectStutter:                defaultBackgroundConnectStutter,
 		backgroundHealthCheckInterval:           defaultBackgroundHealthCheckInterval,
@@ -370,6 +474,23 @@ func newOptions() *options {
 		asyncTopologyInitializers:               []topology.Initializer{},
 		asyncWriteMaxConcurrency:                defaultAsyncWriteMaxConcurrency,
 		useV2BatchAPIs:                          defaultUseV2BatchAPIs,
+		readRepairEnabled:                       defaultReadRepairEnabled,
+		readRepairMaxDatapointsPerSecond:        defaultReadRepairMaxDatapointsPerSecond,
+		readRepairQueueSize:                     defaultReadRepairQueueSize,
+		hintedHandoffEnabled:                    defaultHintedHandoffEnabled,
+		hintedHandoffMaxHintsPerHost:            defaultHintedHandoffMaxHintsPerHost,
+		hintedHandoffMaxHintAge:                 defaultHintedHandoffMaxHintAge,
+		hintedHandoffReplayInterval:             defaultHintedHandoffReplayInterval,
+		transportType:                           defaultTransportType,
+		grpcPort:                                defaultGRPCPort,
+		grpcMaxMsgSize:                          defaultGRPCMaxMsgSize,
+		adaptiveWriteBatchEnabled:               defaultAdaptiveWriteBatchEnabled,
+		adaptiveWriteBatchTargetLatency:         defaultAdaptiveWriteBatchTargetLatency,
+		adaptiveWriteBatchMinSize:               defaultAdaptiveWriteBatchMinSize,
+		hostQueueSaturationThreshold:            defaultHostQueueSaturationThreshold,
+		hedgedReadsEnabled:                      defaultHedgedReadsEnabled,
+		hedgedReadsDelayPercentile:              defaultHedgedReadsDelayPercentile,
+		hedgedReadsMinDelay:                     defaultHedgedReadsMinDelay,
 	}
 	return opts.SetEncodingM3TSZ().(*options)
 }
@@ -401,6 +522,41 @@ func validate(opts *options) error {
 	); err != nil {
 		return err
 	}
+	if opts.readRepairEnabled &&
+		(opts.readRepairMaxDatapointsPerSecond <= 0 || opts.readRepairQueueSize <= 0) {
+		return errInvalidReadRepairOptions
+	}
+	if opts.readRepairEnabled && opts.readRepairNamespaceInitializer == nil {
+		return errNoReadRepairNamespaceInit
+	}
+	if opts.hintedHandoffEnabled &&
+		(opts.hintedHandoffMaxHintsPerHost <= 0 || opts.hintedHandoffMaxHintAge <= 0 ||
+			opts.hintedHandoffReplayInterval <= 0) {
+		return errInvalidHintedHandoffOptions
+	}
+	if err := ValidateTransportType(opts.transportType); err != nil {
+		return err
+	}
+	if opts.transportType == GRPCTransportType {
+		if opts.grpcPort <= 0 || opts.grpcPort > 65535 {
+			return errInvalidGRPCPort
+		}
+		if opts.grpcMaxMsgSize <= 0 {
+			return errInvalidGRPCMaxMsgSize
+		}
+	}
+	if opts.adaptiveWriteBatchEnabled &&
+		(opts.adaptiveWriteBatchTargetLatency <= 0 || opts.adaptiveWriteBatchMinSize <= 0) {
+		return errInvalidAdaptiveWriteBatch
+	}
+	if opts.hostQueueSaturationThreshold <= 0 {
+		return errInvalidSaturationThreshold
+	}
+	if opts.hedgedReadsEnabled &&
+		(opts.hedgedReadsDelayPercentile <= 0 || opts.hedgedReadsDelayPercentile > 1 ||
+			opts.hedgedReadsMinDelay < 0) {
+		return errInvalidHedgedReadsOptions
+	}
 	return opts.logErrorSampleRate.Validate()
 }
 
@@ -411,7 +567,10 @@ func (o *options) Validate() error {
 func (o *options) SetEncodingM3TSZ() Options {
 	opts := *o
 	opts.readerIteratorAllocate = func(r io.Reader, _ namespace.SchemaDescr) encoding.ReaderIterator {
-		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encoding.NewOptions())
+		encodingOpts := encoding.NewOptions()
+		return histogram.NewReaderIteratorWithFallback(r,
+			m3tsz.NewReaderIterator(nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts),
+			encodingOpts)
 	}
 	opts.isProtoEnabled = false
 	return &opts
@@ -600,6 +759,16 @@ func (o *options) TruncateRequestTimeout() time.Duration {
 	return o.truncateRequestTimeout
 }
 
+func (o *options) SetDeleteTaggedRequestTimeout(value time.Duration) Options {
+	opts := *o
+	opts.deleteTaggedRequestTimeout = value
+	return &opts
+}
+
+func (o *options) DeleteTaggedRequestTimeout() time.Duration {
+	return o.deleteTaggedRequestTimeout
+}
+
 func (o *options) SetBackgroundConnectInterval(value time.Duration) Options {
 	opts := *o
 	opts.backgroundConnectInterval = value
@@ -999,3 +1168,213 @@ func (o *options) SetWriteTimestampOffset(value time.Duration) AdminOptions {
 func (o *options) WriteTimestampOffset() time.Duration {
 	return o.writeTimestampOffset
 }
+
+func (o *options) SetReadRepairEnabled(value bool) Options {
+	opts := *o
+	opts.readRepairEnabled = value
+	return &opts
+}
+
+func (o *options) ReadRepairEnabled() bool {
+	return o.readRepairEnabled
+}
+
+func (o *options) SetReadRepairMaxDatapointsPerSecond(value int) Options {
+	opts := *o
+	opts.readRepairMaxDatapointsPerSecond = value
+	return &opts
+}
+
+func (o *options) ReadRepairMaxDatapointsPerSecond() int {
+	return o.readRepairMaxDatapointsPerSecond
+}
+
+func (o *options) SetReadRepairQueueSize(value int) Options {
+	opts := *o
+	opts.readRepairQueueSize = value
+	return &opts
+}
+
+func (o *options) ReadRepairQueueSize() int {
+	return o.readRepairQueueSize
+}
+
+func (o *options) SetReadRepairNamespaceInitializer(value namespace.Initializer) Options {
+	opts := *o
+	opts.readRepairNamespaceInitializer = value
+	return &opts
+}
+
+func (o *options) ReadRepairNamespaceInitializer() namespace.Initializer {
+	return o.readRepairNamespaceInitializer
+}
+
+func (o *options) SetHintedHandoffEnabled(value bool) Options {
+	opts := *o
+	opts.hintedHandoffEnabled = value
+	return &opts
+}
+
+func (o *options) HintedHandoffEnabled() bool {
+	return o.hintedHandoffEnabled
+}
+
+func (o *options) SetHintedHandoffMaxHintsPerHost(value int) Options {
+	opts := *o
+	opts.hintedHandoffMaxHintsPerHost = value
+	return &opts
+}
+
+func (o *options) HintedHandoffMaxHintsPerHost() int {
+	return o.hintedHandoffMaxHintsPerHost
+}
+
+func (o *options) SetHintedHandoffMaxHintAge(value time.Duration) Options {
+	opts := *o
+	opts.hintedHandoffMaxHintAge = value
+	return &opts
+}
+
+func (o *options) HintedHandoffMaxHintAge() time.Duration {
+	return o.hintedHandoffMaxHintAge
+}
+
+func (o *options) SetHintedHandoffReplayInterval(value time.Duration) Options {
+	opts := *o
+	opts.hintedHandoffReplayInterval = value
+	return &opts
+}
+
+func (o *options) HintedHandoffReplayInterval() time.Duration {
+	return o.hintedHandoffReplayInterval
+}
+
+func (o *options) SetTransportType(value TransportType) Options {
+	opts := *o
+	opts.transportType = value
+	return &opts
+}
+
+func (o *options) TransportType() TransportType {
+	return o.transportType
+}
+
+func (o *options) SetGRPCPort(value int) Options {
+	opts := *o
+	opts.grpcPort = value
+	return &opts
+}
+
+func (o *options) GRPCPort() int {
+	return o.grpcPort
+}
+
+func (o *options) SetGRPCMaxMsgSize(value int) Options {
+	opts := *o
+	opts.grpcMaxMsgSize = value
+	return &opts
+}
+
+func (o *options) GRPCMaxMsgSize() int {
+	return o.grpcMaxMsgSize
+}
+
+func (o *options) SetGRPCTransportCredentials(value credentials.TransportCredentials) Options {
+	opts := *o
+	opts.grpcTransportCredentials = value
+	return &opts
+}
+
+func (o *options) GRPCTransportCredentials() credentials.TransportCredentials {
+	return o.grpcTransportCredentials
+}
+
+func (o *options) SetAdaptiveWriteBatchEnabled(value bool) Options {
+	opts := *o
+	opts.adaptiveWriteBatchEnabled = value
+	return &opts
+}
+
+func (o *options) AdaptiveWriteBatchEnabled() bool {
+	return o.adaptiveWriteBatchEnabled
+}
+
+func (o *options) SetAdaptiveWriteBatchTargetLatency(value time.Duration) Options {
+	opts := *o
+	opts.adaptiveWriteBatchTargetLatency = value
+	return &opts
+}
+
+func (o *options) AdaptiveWriteBatchTargetLatency() time.Duration {
+	return o.adaptiveWriteBatchTargetLatency
+}
+
+func (o *options) SetAdaptiveWriteBatchMinSize(value int) Options {
+	opts := *o
+	opts.adaptiveWriteBatchMinSize = value
+	return &opts
+}
+
+func (o *options) AdaptiveWriteBatchMinSize() int {
+	return o.adaptiveWriteBatchMinSize
+}
+
+func (o *options) SetHostQueueSaturationThreshold(value int) Options {
+	opts := *o
+	opts.hostQueueSaturationThreshold = value
+	return &opts
+}
+
+func (o *options) HostQueueSaturationThreshold() int {
+	return o.hostQueueSaturationThreshold
+}
+
+func (o *options) SetHostQueueSaturationFn(value HostQueueSaturationFn) Options {
+	opts := *o
+	opts.hostQueueSaturationFn = value
+	return &opts
+}
+
+func (o *options) HostQueueSaturationFn() HostQueueSaturationFn {
+	return o.hostQueueSaturationFn
+}
+
+func (o *options) SetHedgedReadsEnabled(value bool) Options {
+	opts := *o
+	opts.hedgedReadsEnabled = value
+	return &opts
+}
+
+func (o *options) HedgedReadsEnabled() bool {
+	return o.hedgedReadsEnabled
+}
+
+func (o *options) SetHedgedReadsDelayPercentile(value float64) Options {
+	opts := *o
+	opts.hedgedReadsDelayPercentile = value
+	return &opts
+}
+
+func (o *options) HedgedReadsDelayPercentile() float64 {
+	return o.hedgedReadsDelayPercentile
+}
+
+func (o *options) SetHedgedReadsMinDelay(value time.Duration) Options {
+	opts := *o
+	opts.hedgedReadsMinDelay = value
+	return &opts
+}
+
+func (o *options) HedgedReadsMinDelay() time.Duration {
+	return o.hedgedReadsMinDelay
+}
+
+func (o *options) SetPreferredReadIsolationGroup(value string) Options {
+	opts := *o
+	opts.preferredReadIsolationGroup = value
+	return &opts
+}
+
+func (o *options) PreferredReadIsolationGroup() string {
+	return o.preferredReadIsolationGroup
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package client
+
+import (
+	"math/rand"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/topology"
+)
+
+// readHosts splits the host queues into those a fetch is initially sent to,
+// being enough replicas of every shard to satisfy the read consistency level,
+// and those the fetch is only sent to when needed. Hosts in the preferred
+// isolation group, if any, are picked first to reduce cross zone traffic.
+func readHosts(
+	queues []hostQueue,
+	topoMap topology.Map,
+	numDesired int,
+	isolationGroup string,
+) ([]hostQueue, []hostQueue) {
+	var (
+		ordered  = make([]hostQueue, 0, len(queues))
+		initial  = make([]hostQueue, 0, len(queues))
+		fallback = make([]hostQueue, 0, len(queues))
+		counts   = make(map[uint32]int)
+	)
+	if len(queues) == 0 {
+		return initial, fallback
+	}
+
+	// Start from a random host so that load is spread across replicas.
+	offset := rand.Intn(len(queues))
+	for i := range queues {
+		if hq := queues[(i+offset)%len(queues)]; isolationGroup != "" &&
+			hq.Host().IsolationGroup() == isolationGroup {
+			ordered = append(ordered, hq)
+		}
+	}
+	for i := range queues {
+		if hq := queues[(i+offset)%len(queues)]; isolationGroup == "" ||
+			hq.Host().IsolationGroup() != isolationGroup {
+			ordered = append(ordered, hq)
+		}
+	}
+
+	for _, hq := range ordered {
+		hostShardSet, ok := topoMap.LookupHostShardSet(hq.Host().ID())
+		if !ok {
+			fallback = append(fallback, hq)
+			continue
+		}
+
+		needed := false
+		for _, s := range hostShardSet.ShardSet().All() {
+			if s.State() == shard.Available && counts[s.ID()] < numDesired {
+				needed = true
+				break
+			}
+		}
+		if !needed {
+			fallback = append(fallback, hq)
+			continue
+		}
+
+		initial = append(initial, hq)
+		for _, s := range hostShardSet.ShardSet().All() {
+			if s.State() == shard.Available {
+				counts[s.ID()]++
+			}
+		}
+	}
+	return initial, fallback
+}
+
+// readHostsConsistencyLevel returns whether fetches at the given read
+// consistency level can be sent to a subset of the replicas rather than to
+// every replica.
+func readHostsConsistencyLevel(level topology.ReadConsistencyLevel) bool {
+	switch level {
+	case topology.ReadConsistencyLevelOne, topology.ReadConsistencyLevelUnstrictMajority:
+		return true
+	}
+	return false
+}
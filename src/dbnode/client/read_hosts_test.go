@@ -0,0 +1,121 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/topology"
+	tu "github.com/m3db/m3/src/dbnode/topology/testutil"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReadHostQueues(
+	ctrl *gomock.Controller,
+	topoMap topology.Map,
+	isolationGroups map[string]string,
+) []hostQueue {
+	var queues []hostQueue
+	for _, hss := range topoMap.HostShardSets() {
+		id := hss.Host().ID()
+		host := topology.NewHostWithIsolationGroup(id, fmt.Sprintf("%s:9000", id),
+			isolationGroups[id])
+		hq := NewMockhostQueue(ctrl)
+		hq.EXPECT().Host().Return(host).AnyTimes()
+		queues = append(queues, hq)
+	}
+	return queues
+}
+
+func readHostIDs(queues []hostQueue) []string {
+	ids := make([]string, 0, len(queues))
+	for _, hq := range queues {
+		ids = append(ids, hq.Host().ID())
+	}
+	return ids
+}
+
+func TestReadHosts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// rf=3, 30 shards total; three identical hosts and a host whose shards
+	// are all initializing.
+	topoMap := tu.MustNewTopologyMap(3, map[string][]shard.Shard{
+		"testhost0": tu.ShardsRange(0, 29, shard.Available),
+		"testhost1": tu.ShardsRange(0, 29, shard.Available),
+		"testhost2": tu.ShardsRange(0, 29, shard.Available),
+		"testhost3": tu.ShardsRange(0, 29, shard.Initializing),
+	})
+	queues := newTestReadHostQueues(ctrl, topoMap, nil)
+
+	for _, numDesired := range []int{1, 2, 3} {
+		initial, fallback := readHosts(queues, topoMap, numDesired, "")
+		require.Len(t, initial, numDesired)
+		require.Len(t, fallback, len(queues)-numDesired)
+		require.NotContains(t, readHostIDs(initial), "testhost3")
+	}
+}
+
+func TestReadHostsPreferredIsolationGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// rf=3, 30 shards total; testhost0 and testhost1 split the shards
+	// between them in zone a while testhost2 and testhost3 each own every
+	// shard in zones b and c.
+	topoMap := tu.MustNewTopologyMap(3, map[string][]shard.Shard{
+		"testhost0": tu.ShardsRange(0, 14, shard.Available),
+		"testhost1": tu.ShardsRange(15, 29, shard.Available),
+		"testhost2": tu.ShardsRange(0, 29, shard.Available),
+		"testhost3": tu.ShardsRange(0, 29, shard.Available),
+	})
+	queues := newTestReadHostQueues(ctrl, topoMap, map[string]string{
+		"testhost0": "a",
+		"testhost1": "a",
+		"testhost2": "b",
+		"testhost3": "c",
+	})
+
+	// Every local replica is read from before any remote replica.
+	for i := 0; i < 10; i++ {
+		initial, fallback := readHosts(queues, topoMap, 1, "a")
+		require.ElementsMatch(t, []string{"testhost0", "testhost1"}, readHostIDs(initial))
+		require.ElementsMatch(t, []string{"testhost2", "testhost3"}, readHostIDs(fallback))
+	}
+
+	// Remote replicas are only read from when there are not enough local
+	// replicas to satisfy the consistency level.
+	for i := 0; i < 10; i++ {
+		initial, fallback := readHosts(queues, topoMap, 2, "a")
+		require.Len(t, initial, 3)
+		require.Subset(t, readHostIDs(initial), []string{"testhost0", "testhost1"})
+		require.Len(t, fallback, 1)
+	}
+
+	// An isolation group with no replicas falls back to every host.
+	initial, fallback := readHosts(queues, topoMap, 1, "d")
+	require.NotEmpty(t, initial)
+	require.Len(t, fallback, len(queues)-len(initial))
+}
@@ -0,0 +1,460 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+)
+
+var errReadRepairerClosed = errors.New("read repairer is closed")
+
+// readRepairSeries is a series whose replicas returned diverging blocks
+// for a fetch, along with the replicas lagging in each of the blocks.
+type readRepairSeries struct {
+	namespace      []byte
+	id             []byte
+	encodedTags    []byte
+	schema         namespace.SchemaDescr
+	startInclusive time.Time
+	endExclusive   time.Time
+	replicas       fetchTaggedIDResults
+	blocks         []readRepairBlock
+}
+
+// readRepairBlock is a block of a series and the replicas lagging in it.
+type readRepairBlock struct {
+	start   xtime.UnixNano
+	end     xtime.UnixNano
+	hostIDs []string
+}
+
+type readRepairReplicaBlock struct {
+	end        xtime.UnixNano
+	checksum   int64
+	comparable bool
+}
+
+// readRepairConsistencyLevel returns whether fetches at the given read
+// consistency level read from enough replicas to perform read repair.
+func readRepairConsistencyLevel(level topology.ReadConsistencyLevel) bool {
+	switch level {
+	case topology.ReadConsistencyLevelUnstrictMajority,
+		topology.ReadConsistencyLevelMajority,
+		topology.ReadConsistencyLevelAll:
+		return true
+	}
+	return false
+}
+
+// newReadRepairSeries compares the blocks each replica returned for a series
+// and returns the blocks that diverge. The hostIDs are the hosts each of the
+// replicas were returned from, the ownerHostIDs are hosts that own the series
+// and returned all of their results, owners that did not return the series at
+// all are lagging in every block of it.
+//
+// A replica is lagging in a block if it did not return the block or if its
+// checksum for the block does not match the checksum returned by a strict
+// majority of the replicas that returned the block. Only merged blocks have
+// a checksum, blocks that any replica returned unmerged are not compared.
+func newReadRepairSeries(
+	replicas fetchTaggedIDResults,
+	hostIDs []string,
+	ownerHostIDs []string,
+) (readRepairSeries, bool) {
+	var (
+		replicaBlocks = make([]map[xtime.UnixNano]readRepairReplicaBlock, 0, len(replicas))
+		missingHosts  []string
+		starts        []xtime.UnixNano
+	)
+	for _, replica := range replicas {
+		blocks := make(map[xtime.UnixNano]readRepairReplicaBlock, len(replica.Segments))
+		for _, segments := range replica.Segments {
+			seg, comparable := segments.Merged, true
+			if seg == nil {
+				if len(segments.Unmerged) == 0 {
+					continue
+				}
+				seg, comparable = segments.Unmerged[0], false
+			}
+			if seg.StartTime == nil || seg.BlockSize == nil {
+				// Cannot tell which block the segment belongs to.
+				return readRepairSeries{}, false
+			}
+			start := xtime.UnixNano(*seg.StartTime)
+			if _, ok := blocks[start]; ok {
+				comparable = false
+			} else {
+				starts = append(starts, start)
+			}
+			block := readRepairReplicaBlock{
+				end:        start + xtime.UnixNano(*seg.BlockSize),
+				comparable: comparable && seg.Checksum != nil,
+			}
+			if block.comparable {
+				block.checksum = *seg.Checksum
+			}
+			blocks[start] = block
+		}
+		replicaBlocks = append(replicaBlocks, blocks)
+	}
+
+	for _, hostID := range ownerHostIDs {
+		found := false
+		for _, replicaHostID := range hostIDs {
+			if hostID == replicaHostID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missingHosts = append(missingHosts, hostID)
+		}
+	}
+
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i] < starts[j]
+	})
+
+	var (
+		result = readRepairSeries{
+			namespace:   replicas[0].NameSpace,
+			id:          replicas[0].ID,
+			encodedTags: replicas[0].EncodedTags,
+			replicas:    replicas,
+		}
+		checksums = make(map[int64]int, len(replicas))
+	)
+	for i, start := range starts {
+		if i > 0 && starts[i-1] == start {
+			continue
+		}
+
+		var (
+			lagging    = append([]string(nil), missingHosts...)
+			end        xtime.UnixNano
+			returned   int
+			comparable = true
+		)
+		for k := range checksums {
+			delete(checksums, k)
+		}
+		for j, blocks := range replicaBlocks {
+			block, ok := blocks[start]
+			if !ok {
+				lagging = append(lagging, hostIDs[j])
+				continue
+			}
+			if !block.comparable {
+				comparable = false
+				break
+			}
+			end = block.end
+			returned++
+			checksums[block.checksum]++
+		}
+		if !comparable || (len(lagging) == 0 && len(checksums) == 1) {
+			continue
+		}
+
+		if len(checksums) > 1 {
+			majorityChecksum, hasMajority := int64(0), false
+			for checksum, count := range checksums {
+				if 2*count > returned {
+					majorityChecksum, hasMajority = checksum, true
+				}
+			}
+			for j, blocks := range replicaBlocks {
+				block, ok := blocks[start]
+				if ok && (!hasMajority || block.checksum != majorityChecksum) {
+					lagging = append(lagging, hostIDs[j])
+				}
+			}
+		}
+
+		result.blocks = append(result.blocks, readRepairBlock{
+			start:   start,
+			end:     end,
+			hostIDs: lagging,
+		})
+	}
+
+	return result, len(result.blocks) > 0
+}
+
+type readRepairWriteFn func(
+	hostID string,
+	series readRepairSeries,
+	dp ts.Datapoint,
+	unit xtime.Unit,
+	annotation ts.Annotation,
+	completionFn completionFn,
+) error
+
+type readRepairMetrics struct {
+	seriesEnqueued tally.Counter
+	seriesDropped  tally.Counter
+	seriesErrors   tally.Counter
+	writeSuccess   tally.Counter
+	writeErrors    tally.Counter
+	throttled      tally.Counter
+	seriesSkipped  tally.Counter
+}
+
+func newReadRepairMetrics(scope tally.Scope) readRepairMetrics {
+	return readRepairMetrics{
+		seriesEnqueued: scope.Counter("series-enqueued"),
+		seriesDropped:  scope.Counter("series-dropped"),
+		seriesErrors:   scope.Counter("series-errors"),
+		writeSuccess:   scope.Counter("write-success"),
+		writeErrors:    scope.Counter("write-errors"),
+		throttled:      scope.Counter("throttled"),
+		seriesSkipped:  scope.Counter("series-skipped"),
+	}
+}
+
+// readRepairer writes the merged datapoints of series whose replicas
+// returned diverging blocks back to the lagging replicas in the background.
+// Series are dropped rather than blocking fetches if the queue of series
+// pending repair is full.
+//
+// The datapoints written back are historical and so are rejected outside of
+// the buffer window by namespaces without cold writes enabled, series of such
+// namespaces are skipped rather than repaired.
+type readRepairer struct {
+	sync.RWMutex
+
+	closed  bool
+	queue   chan readRepairSeries
+	writeFn readRepairWriteFn
+	metrics readRepairMetrics
+
+	nsInit     namespace.Initializer
+	nsRegistry namespace.Registry
+	nsWatch    namespace.Watch
+
+	nowFn                  clock.NowFn
+	sleepFn                func(time.Duration)
+	iteratorAlloc          encoding.ReaderIteratorAllocate
+	maxDatapointsPerSecond int
+
+	// Only accessed by the repair loop.
+	windowNanos      int64
+	windowDatapoints int
+}
+
+func newReadRepairer(
+	opts Options,
+	writeFn readRepairWriteFn,
+	scope tally.Scope,
+) *readRepairer {
+	return &readRepairer{
+		queue:                  make(chan readRepairSeries, opts.ReadRepairQueueSize()),
+		writeFn:                writeFn,
+		metrics:                newReadRepairMetrics(scope),
+		nsInit:                 opts.ReadRepairNamespaceInitializer(),
+		nowFn:                  opts.ClockOptions().NowFn(),
+		sleepFn:                time.Sleep,
+		iteratorAlloc:          opts.ReaderIteratorAllocate(),
+		maxDatapointsPerSecond: opts.ReadRepairMaxDatapointsPerSecond(),
+	}
+}
+
+// Open watches the namespace registry to find the namespaces which accept
+// the datapoints written back by read repair.
+func (r *readRepairer) Open() error {
+	registry, err := r.nsInit.Init()
+	if err != nil {
+		return err
+	}
+
+	watch, err := registry.Watch()
+	if err != nil {
+		registry.Close()
+		return err
+	}
+
+	r.Lock()
+	r.nsRegistry = registry
+	r.nsWatch = watch
+	r.Unlock()
+	return nil
+}
+
+// Enqueue enqueues a series to be repaired without blocking.
+func (r *readRepairer) Enqueue(series readRepairSeries) error {
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return errReadRepairerClosed
+	}
+	if !r.coldWritesEnabledWithRLock(series.namespace) {
+		r.metrics.seriesSkipped.Inc(1)
+		return nil
+	}
+	select {
+	case r.queue <- series:
+		r.metrics.seriesEnqueued.Inc(1)
+	default:
+		r.metrics.seriesDropped.Inc(1)
+	}
+	return nil
+}
+
+// Run repairs enqueued series until the read repairer is closed.
+func (r *readRepairer) Run() {
+	for series := range r.queue {
+		if r.isClosed() {
+			// Drain the series left in the queue once closed.
+			r.metrics.seriesDropped.Inc(1)
+			continue
+		}
+		if err := r.repair(series); err != nil {
+			r.metrics.seriesErrors.Inc(1)
+		}
+	}
+}
+
+// Close stops the read repairer, series pending repair are dropped.
+func (r *readRepairer) Close() error {
+	r.Lock()
+	defer r.Unlock()
+	if r.closed {
+		return errReadRepairerClosed
+	}
+	r.closed = true
+	close(r.queue)
+	if r.nsWatch != nil {
+		r.nsWatch.Close()
+		r.nsRegistry.Close()
+	}
+	return nil
+}
+
+// coldWritesEnabledWithRLock returns whether the namespace has cold writes
+// enabled, namespaces are unknown until the read repairer has been opened.
+func (r *readRepairer) coldWritesEnabledWithRLock(ns []byte) bool {
+	if r.nsWatch == nil {
+		return false
+	}
+	md, err := r.nsWatch.Get().Get(ident.BytesID(ns))
+	return err == nil && md.Options().ColdWritesEnabled()
+}
+
+func (r *readRepairer) isClosed() bool {
+	r.RLock()
+	closed := r.closed
+	r.RUnlock()
+	return closed
+}
+
+func (r *readRepairer) repair(series readRepairSeries) error {
+	replicas := make([]encoding.MultiReaderIterator, 0, len(series.replicas))
+	for _, replica := range series.replicas {
+		slicesIter := newReaderSliceOfSlicesIterator(replica.Segments, nil)
+		multiIter := encoding.NewMultiReaderIterator(r.iteratorAlloc, nil)
+		multiIter.ResetSliceOfSlices(slicesIter, series.schema)
+		replicas = append(replicas, multiIter)
+	}
+
+	iter := encoding.NewSeriesIterator(encoding.SeriesIteratorOptions{
+		ID:             ident.BytesID(series.id),
+		Namespace:      ident.BytesID(series.namespace),
+		StartInclusive: xtime.ToUnixNano(series.startInclusive),
+		EndExclusive:   xtime.ToUnixNano(series.endExclusive),
+		Replicas:       replicas,
+	}, nil)
+	defer func() {
+		iter.Close()
+		for _, replica := range replicas {
+			replica.Close()
+		}
+	}()
+
+	blockIdx := 0
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		t := xtime.ToUnixNano(dp.Timestamp)
+		for blockIdx < len(series.blocks) && t >= series.blocks[blockIdx].end {
+			blockIdx++
+		}
+		if blockIdx == len(series.blocks) {
+			break
+		}
+		block := series.blocks[blockIdx]
+		if t < block.start {
+			continue
+		}
+
+		// NB: The annotation is only valid until the iterator is advanced
+		// while the write is performed asynchronously.
+		annotation = append(ts.Annotation(nil), annotation...)
+		for _, hostID := range block.hostIDs {
+			if r.isClosed() {
+				return errReadRepairerClosed
+			}
+			r.waitForCapacity()
+			if err := r.writeFn(hostID, series, dp, unit, annotation, r.writeCompletionFn); err != nil {
+				return err
+			}
+		}
+	}
+	return iter.Err()
+}
+
+func (r *readRepairer) writeCompletionFn(_ interface{}, err error) {
+	if err != nil {
+		r.metrics.writeErrors.Inc(1)
+		return
+	}
+	r.metrics.writeSuccess.Inc(1)
+}
+
+// waitForCapacity blocks until a datapoint can be written without exceeding
+// the maximum datapoints written per second.
+func (r *readRepairer) waitForCapacity() {
+	for {
+		now := r.nowFn()
+		window := now.Truncate(time.Second)
+		if windowNanos := window.UnixNano(); r.windowNanos != windowNanos {
+			r.windowNanos = windowNanos
+			r.windowDatapoints = 0
+		}
+		if r.windowDatapoints < r.maxDatapointsPerSecond {
+			r.windowDatapoints++
+			return
+		}
+		r.metrics.throttled.Inc(1)
+		r.sleepFn(window.Add(time.Second).Sub(now))
+	}
+}
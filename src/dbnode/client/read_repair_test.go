@@ -0,0 +1,257 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestNewReadRepairSeries(t *testing.T) {
+	var (
+		blockSize = int64(2 * time.Hour)
+		b0        = int64(0)
+		b1        = b0 + blockSize
+	)
+	merged := func(start, checksum int64) *rpc.Segments {
+		return &rpc.Segments{Merged: &rpc.Segment{
+			StartTime: &start,
+			BlockSize: &blockSize,
+			Checksum:  &checksum,
+		}}
+	}
+	unmerged := func(start int64) *rpc.Segments {
+		return &rpc.Segments{Unmerged: []*rpc.Segment{
+			{StartTime: &start, BlockSize: &blockSize},
+			{StartTime: &start, BlockSize: &blockSize},
+		}}
+	}
+	replica := func(segments ...*rpc.Segments) *rpc.FetchTaggedIDResult_ {
+		return &rpc.FetchTaggedIDResult_{
+			NameSpace: []byte("testns"),
+			ID:        []byte("foo"),
+			Segments:  segments,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		replicas fetchTaggedIDResults
+		hostIDs  []string
+		owners   []string
+		expected []readRepairBlock
+	}{
+		{
+			name:     "matching replicas",
+			replicas: fetchTaggedIDResults{replica(merged(b0, 1)), replica(merged(b0, 1))},
+			hostIDs:  []string{"a", "b"},
+			owners:   []string{"a", "b"},
+		},
+		{
+			name: "checksum differs from majority",
+			replicas: fetchTaggedIDResults{
+				replica(merged(b0, 1)), replica(merged(b0, 1)), replica(merged(b0, 2)),
+			},
+			hostIDs: []string{"a", "b", "c"},
+			owners:  []string{"a", "b", "c"},
+			expected: []readRepairBlock{
+				{start: xtime.UnixNano(b0), end: xtime.UnixNano(b1), hostIDs: []string{"c"}},
+			},
+		},
+		{
+			name:     "checksums without majority",
+			replicas: fetchTaggedIDResults{replica(merged(b0, 1)), replica(merged(b0, 2))},
+			hostIDs:  []string{"a", "b"},
+			owners:   []string{"a", "b"},
+			expected: []readRepairBlock{
+				{start: xtime.UnixNano(b0), end: xtime.UnixNano(b1), hostIDs: []string{"a", "b"}},
+			},
+		},
+		{
+			name: "missing block",
+			replicas: fetchTaggedIDResults{
+				replica(merged(b0, 1), merged(b1, 3)), replica(merged(b0, 1)),
+			},
+			hostIDs: []string{"a", "b"},
+			owners:  []string{"a", "b"},
+			expected: []readRepairBlock{
+				{start: xtime.UnixNano(b1), end: xtime.UnixNano(b1 + blockSize), hostIDs: []string{"b"}},
+			},
+		},
+		{
+			name:     "missing series",
+			replicas: fetchTaggedIDResults{replica(merged(b0, 1)), replica(merged(b0, 1))},
+			hostIDs:  []string{"a", "b"},
+			owners:   []string{"a", "b", "c"},
+			expected: []readRepairBlock{
+				{start: xtime.UnixNano(b0), end: xtime.UnixNano(b1), hostIDs: []string{"c"}},
+			},
+		},
+		{
+			name:     "unmerged block not compared",
+			replicas: fetchTaggedIDResults{replica(unmerged(b0)), replica(merged(b0, 2))},
+			hostIDs:  []string{"a", "b"},
+			owners:   []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			series, ok := newReadRepairSeries(tt.replicas, tt.hostIDs, tt.owners)
+			require.Equal(t, len(tt.expected) > 0, ok)
+			require.Equal(t, tt.expected, series.blocks)
+		})
+	}
+}
+
+func TestReadRepairerRepair(t *testing.T) {
+	var (
+		blockSize = 2 * time.Hour
+		start     = time.Now().Truncate(blockSize)
+		dps       = []ts.Datapoint{
+			{Timestamp: start, Value: 1},
+			{Timestamp: start.Add(time.Minute), Value: 2},
+			{Timestamp: start.Add(2 * time.Minute), Value: 3},
+		}
+		replica = func(dps []ts.Datapoint) *rpc.FetchTaggedIDResult_ {
+			return &rpc.FetchTaggedIDResult_{
+				NameSpace: []byte("testns"),
+				ID:        []byte("foo"),
+				Segments: []*rpc.Segments{
+					testReadRepairSegments(t, start, blockSize, dps),
+				},
+			}
+		}
+		replicas = fetchTaggedIDResults{replica(dps), replica(dps), replica(dps[:1])}
+	)
+
+	series, ok := newReadRepairSeries(replicas, []string{"a", "b", "c"}, nil)
+	require.True(t, ok)
+	series.startInclusive = start
+	series.endExclusive = start.Add(blockSize)
+
+	var (
+		now    = start
+		sleeps []time.Duration
+		writes []ts.Datapoint
+	)
+	opts := NewOptions().
+		SetReadRepairMaxDatapointsPerSecond(2).
+		SetClockOptions(NewOptions().ClockOptions().SetNowFn(func() time.Time {
+			return now
+		}))
+	scope := tally.NewTestScope("", nil)
+	r := newReadRepairer(opts, func(
+		hostID string,
+		_ readRepairSeries,
+		dp ts.Datapoint,
+		_ xtime.Unit,
+		_ ts.Annotation,
+		completionFn completionFn,
+	) error {
+		require.Equal(t, "c", hostID)
+		writes = append(writes, dp)
+		completionFn(nil, nil)
+		return nil
+	}, scope)
+	r.sleepFn = func(d time.Duration) {
+		sleeps = append(sleeps, d)
+		now = now.Add(d)
+	}
+
+	require.NoError(t, r.repair(series))
+	require.Equal(t, len(dps), len(writes))
+	for i, dp := range dps {
+		require.True(t, dp.Timestamp.Equal(writes[i].Timestamp))
+		require.Equal(t, dp.Value, writes[i].Value)
+	}
+	require.Equal(t, []time.Duration{time.Second}, sleeps)
+	require.Equal(t, int64(len(dps)),
+		scope.Snapshot().Counters()["write-success+"].Value())
+}
+
+func TestReadRepairerEnqueue(t *testing.T) {
+	coldMd, err := namespace.NewMetadata(ident.StringID("cold"),
+		namespace.NewOptions().SetColdWritesEnabled(true))
+	require.NoError(t, err)
+	warmMd, err := namespace.NewMetadata(ident.StringID("warm"),
+		namespace.NewOptions())
+	require.NoError(t, err)
+
+	scope := tally.NewTestScope("", nil)
+	opts := NewOptions().
+		SetReadRepairQueueSize(1).
+		SetReadRepairNamespaceInitializer(namespace.NewStaticInitializer(
+			[]namespace.Metadata{coldMd, warmMd}))
+	r := newReadRepairer(opts, nil, scope)
+	require.NoError(t, r.Open())
+
+	// Series of namespaces that reject historical writes are not repaired.
+	cold := readRepairSeries{namespace: []byte("cold")}
+	require.NoError(t, r.Enqueue(readRepairSeries{namespace: []byte("warm")}))
+	require.NoError(t, r.Enqueue(readRepairSeries{namespace: []byte("unknown")}))
+	require.NoError(t, r.Enqueue(cold))
+	require.NoError(t, r.Enqueue(cold))
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(2), counters["series-skipped+"].Value())
+	require.Equal(t, int64(1), counters["series-enqueued+"].Value())
+	require.Equal(t, int64(1), counters["series-dropped+"].Value())
+
+	require.NoError(t, r.Close())
+	require.Equal(t, errReadRepairerClosed, r.Enqueue(cold))
+}
+
+func testReadRepairSegments(
+	t *testing.T,
+	start time.Time,
+	blockSize time.Duration,
+	dps []ts.Datapoint,
+) *rpc.Segments {
+	enc := m3tsz.NewEncoder(start, nil, m3tsz.DefaultIntOptimizationEnabled,
+		encoding.NewOptions())
+	for _, dp := range dps {
+		require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+	}
+	reader, ok := enc.Stream(context.NewContext())
+	require.True(t, ok)
+	res, err := convert.ToSegments([]xio.BlockReader{{
+		SegmentReader: reader,
+		Start:         start,
+		BlockSize:     blockSize,
+	}})
+	require.NoError(t, err)
+	return res.Segments
+}
@@ -21,6 +21,7 @@
 package client
 
 import (
+	stdctx "context"
 	"fmt"
 	"time"
 
@@ -30,6 +31,8 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
 	m3sync "github.com/m3db/m3/src/x/sync"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -143,6 +146,7 @@ func (s *replicatedSession) setAsyncSessions(opts []Options) error {
 }
 
 type replicatedParams struct {
+	ctx        stdctx.Context
 	namespace  ident.ID
 	id         ident.ID
 	t          time.Time
@@ -184,6 +188,14 @@ func (s replicatedSession) replicate(params replicatedParams) error {
 		}
 	}
 
+	// NB: the context only applies to the write to the primary cluster since
+	// the caller does not wait on the replicated writes.
+	if params.ctx != nil {
+		if params.useTags {
+			return s.session.WriteTaggedContext(params.ctx, params.namespace, params.id, params.tags, params.t, params.value, params.unit, params.annotation)
+		}
+		return s.session.WriteContext(params.ctx, params.namespace, params.id, params.t, params.value, params.unit, params.annotation)
+	}
 	if params.useTags {
 		return s.session.WriteTagged(params.namespace, params.id, params.tags, params.t, params.value, params.unit, params.annotation)
 	}
@@ -202,6 +214,19 @@ func (s replicatedSession) Write(namespace, id ident.ID, t time.Time, value floa
 	})
 }
 
+// WriteContext writes value to the database for an ID with the given context.
+func (s replicatedSession) WriteContext(ctx stdctx.Context, namespace, id ident.ID, t time.Time, value float64, unit xtime.Unit, annotation []byte) error {
+	return s.replicate(replicatedParams{
+		ctx:        ctx,
+		namespace:  namespace,
+		id:         id,
+		t:          t.Add(-s.writeTimestampOffset),
+		value:      value,
+		unit:       unit,
+		annotation: annotation,
+	})
+}
+
 // WriteTagged value to the database for an ID and given tags.
 func (s replicatedSession) WriteTagged(namespace, id ident.ID, tags ident.TagIterator, t time.Time, value float64, unit xtime.Unit, annotation []byte) error {
 	return s.replicate(replicatedParams{
@@ -216,16 +241,71 @@ func (s replicatedSession) WriteTagged(namespace, id ident.ID, tags ident.TagIte
 	})
 }
 
+// WriteDocument writes a document to an index only namespace, it is
+// replicated to the async clusters in the same way as writes.
+func (s replicatedSession) WriteDocument(namespace ident.ID, d doc.Document, t time.Time) error {
+	t = t.Add(-s.writeTimestampOffset)
+	for _, asyncSession := range s.asyncSessions {
+		asyncSession := asyncSession // capture var
+		select {
+		case s.replicationSemaphore <- struct{}{}:
+			s.workerPool.Go(func() {
+				err := asyncSession.WriteDocument(namespace, d, t)
+				if err != nil {
+					s.metrics.replicateError.Inc(1)
+					s.log.Error("could not replicate document", zap.Error(err))
+				} else {
+					s.metrics.replicateSuccess.Inc(1)
+				}
+				if s.outCh != nil {
+					s.outCh <- err
+				}
+				<-s.replicationSemaphore
+			})
+			s.metrics.replicateExecuted.Inc(1)
+		default:
+			s.metrics.replicateNotExecuted.Inc(1)
+		}
+	}
+
+	return s.session.WriteDocument(namespace, d, t)
+}
+
+// WriteTaggedContext writes value to the database for an ID and given tags with the given context.
+func (s replicatedSession) WriteTaggedContext(ctx stdctx.Context, namespace, id ident.ID, tags ident.TagIterator, t time.Time, value float64, unit xtime.Unit, annotation []byte) error {
+	return s.replicate(replicatedParams{
+		ctx:        ctx,
+		namespace:  namespace,
+		id:         id,
+		t:          t.Add(-s.writeTimestampOffset),
+		value:      value,
+		unit:       unit,
+		annotation: annotation,
+		tags:       tags,
+		useTags:    true,
+	})
+}
+
 // Fetch values from the database for an ID.
 func (s replicatedSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error) {
 	return s.session.Fetch(namespace, id, startInclusive, endExclusive)
 }
 
+// FetchContext fetches values from the database for an ID with the given context.
+func (s replicatedSession) FetchContext(ctx stdctx.Context, namespace, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error) {
+	return s.session.FetchContext(ctx, namespace, id, startInclusive, endExclusive)
+}
+
 // FetchIDs values from the database for a set of IDs.
 func (s replicatedSession) FetchIDs(namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error) {
 	return s.session.FetchIDs(namespace, ids, startInclusive, endExclusive)
 }
 
+// FetchIDsContext fetches values from the database for a set of IDs with the given context.
+func (s replicatedSession) FetchIDsContext(ctx stdctx.Context, namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error) {
+	return s.session.FetchIDsContext(ctx, namespace, ids, startInclusive, endExclusive)
+}
+
 // Aggregate aggregates values from the database for the given set of constraints.
 func (s replicatedSession) Aggregate(
 	ns ident.ID, q index.Query, opts index.AggregationOptions,
@@ -233,16 +313,43 @@ func (s replicatedSession) Aggregate(
 	return s.session.Aggregate(ns, q, opts)
 }
 
+// AggregateContext aggregates values from the database for the given set of constraints with the given context.
+func (s replicatedSession) AggregateContext(
+	ctx stdctx.Context, ns ident.ID, q index.Query, opts index.AggregationOptions,
+) (AggregatedTagsIterator, FetchResponseMetadata, error) {
+	return s.session.AggregateContext(ctx, ns, q, opts)
+}
+
 // FetchTagged resolves the provided query to known IDs, and fetches the data for them.
 func (s replicatedSession) FetchTagged(namespace ident.ID, q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, FetchResponseMetadata, error) {
 	return s.session.FetchTagged(namespace, q, opts)
 }
 
+// FetchTaggedContext resolves the provided query to known IDs, and fetches the data for them with the given context.
+func (s replicatedSession) FetchTaggedContext(ctx stdctx.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	return s.session.FetchTaggedContext(ctx, namespace, q, opts)
+}
+
 // FetchTaggedIDs resolves the provided query to known IDs.
 func (s replicatedSession) FetchTaggedIDs(namespace ident.ID, q index.Query, opts index.QueryOptions) (TaggedIDsIterator, FetchResponseMetadata, error) {
 	return s.session.FetchTaggedIDs(namespace, q, opts)
 }
 
+// FetchTaggedIDsContext resolves the provided query to known IDs with the given context.
+func (s replicatedSession) FetchTaggedIDsContext(ctx stdctx.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	return s.session.FetchTaggedIDsContext(ctx, namespace, q, opts)
+}
+
+// FetchTaggedPage resolves the provided query to known IDs, and fetches the data for a single page of them.
+func (s replicatedSession) FetchTaggedPage(ctx stdctx.Context, namespace ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	return s.session.FetchTaggedPage(ctx, namespace, q, opts, pageToken)
+}
+
+// FetchTaggedIDsPage resolves the provided query to known IDs for a single page of them.
+func (s replicatedSession) FetchTaggedIDsPage(ctx stdctx.Context, namespace ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	return s.session.FetchTaggedIDsPage(ctx, namespace, q, opts, pageToken)
+}
+
 // ShardID returns the given shard for an ID for callers
 // to easily discern what shard is failing when operations
 // for given IDs begin failing.
@@ -255,6 +362,12 @@ func (s replicatedSession) IteratorPools() (encoding.IteratorPools, error) {
 	return s.session.IteratorPools()
 }
 
+// HostQueueSaturation returns the saturation of the queue of writes pending
+// to each host of the primary cluster.
+func (s replicatedSession) HostQueueSaturation() ([]HostQueueSaturation, error) {
+	return s.session.HostQueueSaturation()
+}
+
 // Close the session.
 func (s replicatedSession) Close() error {
 	err := s.session.Close()
@@ -289,6 +402,49 @@ func (s replicatedSession) Truncate(namespace ident.ID) (int64, error) {
 	return s.session.Truncate(namespace)
 }
 
+// Cardinality returns the approximate series cardinality per tag name and
+// tag value of the namespace, merged across all hosts.
+func (s replicatedSession) Cardinality(
+	namespace ident.ID,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	return s.session.Cardinality(namespace, opts)
+}
+
+// CardinalityContext returns the approximate series cardinality of the
+// namespace with the given context.
+func (s replicatedSession) CardinalityContext(
+	ctx stdctx.Context,
+	namespace ident.ID,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	return s.session.CardinalityContext(ctx, namespace, opts)
+}
+
+// DeleteTagged will delete the data within [start, end) of all series
+// matching the query from the namespace on every host. Unlike writes the
+// delete is never dropped for the async clusters, it is applied to each of
+// them once applied to the primary cluster and any failure is returned.
+func (s replicatedSession) DeleteTagged(
+	namespace ident.ID,
+	q index.Query,
+	start, end time.Time,
+) (int64, error) {
+	deleted, err := s.session.DeleteTagged(namespace, q, start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, asyncSession := range s.asyncSessions {
+		if _, err := asyncSession.DeleteTagged(namespace, q, start, end); err != nil {
+			s.log.Error("could not replicate delete", zap.Error(err))
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return deleted, multiErr.FinalError()
+}
+
 // FetchBootstrapBlocksFromPeers will fetch the most fulfilled block
 // for each series using the runtime configurable bootstrap level consistency.
 func (s replicatedSession) FetchBootstrapBlocksFromPeers(
@@ -22,6 +22,7 @@ package client
 
 import (
 	"bytes"
+	stdctx "context"
 	"errors"
 	"fmt"
 	"math"
@@ -44,15 +45,18 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	idxconvert "github.com/m3db/m3/src/dbnode/storage/index/convert"
 	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/x/xio"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/x/checked"
 	xclose "github.com/m3db/m3/src/x/close"
 	"github.com/m3db/m3/src/x/context"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
+	xopentracing "github.com/m3db/m3/src/x/opentracing"
 	"github.com/m3db/m3/src/x/pool"
 	xretry "github.com/m3db/m3/src/x/retry"
 	"github.com/m3db/m3/src/x/sampler"
@@ -61,6 +65,8 @@ import (
 	xtime "github.com/m3db/m3/src/x/time"
 
 	apachethrift "github.com/apache/thrift/lib/go/thrift"
+	"github.com/opentracing/opentracing-go"
+	opentracinglog "github.com/opentracing/opentracing-go/log"
 	"github.com/uber-go/tally"
 	"github.com/uber/tchannel-go/thrift"
 	"go.uber.org/zap"
@@ -159,6 +165,9 @@ type session struct {
 	streamBlocksBatchSize            int
 	streamBlocksMetadataBatchTimeout time.Duration
 	streamBlocksBatchTimeout         time.Duration
+	readRepairer                     *readRepairer
+	hintedHandoff                    *hintedHandoff
+	hedgedReads                      *hedgedReads
 	metrics                          sessionMetrics
 }
 
@@ -172,12 +181,14 @@ type sessionMetrics struct {
 	writeSuccess                         tally.Counter
 	writeErrorsBadRequest                tally.Counter
 	writeErrorsInternalError             tally.Counter
+	writeErrorsResourceExhausted         tally.Counter
 	writeLatencyHistogram                tally.Histogram
 	writeNodesRespondingErrors           []tally.Counter
 	writeNodesRespondingBadRequestErrors []tally.Counter
 	fetchSuccess                         tally.Counter
 	fetchErrorsBadRequest                tally.Counter
 	fetchErrorsInternalError             tally.Counter
+	fetchErrorsResourceExhausted         tally.Counter
 	fetchLatencyHistogram                tally.Histogram
 	fetchNodesRespondingErrors           []tally.Counter
 	fetchNodesRespondingBadRequestErrors []tally.Counter
@@ -195,6 +206,9 @@ func newSessionMetrics(scope tally.Scope) sessionMetrics {
 		writeErrorsInternalError: scope.Tagged(map[string]string{
 			"error_type": "internal_error",
 		}).Counter("write.errors"),
+		writeErrorsResourceExhausted: scope.Tagged(map[string]string{
+			"error_type": "resource_exhausted",
+		}).Counter("write.errors"),
 		writeLatencyHistogram: histogramWithDurationBuckets(scope, "write.latency"),
 		fetchSuccess:          scope.Counter("fetch.success"),
 		fetchErrorsBadRequest: scope.Tagged(map[string]string{
@@ -203,6 +217,9 @@ func newSessionMetrics(scope tally.Scope) sessionMetrics {
 		fetchErrorsInternalError: scope.Tagged(map[string]string{
 			"error_type": "internal_error",
 		}).Counter("fetch.errors"),
+		fetchErrorsResourceExhausted: scope.Tagged(map[string]string{
+			"error_type": "resource_exhausted",
+		}).Counter("fetch.errors"),
 		fetchLatencyHistogram:  histogramWithDurationBuckets(scope, "fetch.latency"),
 		topologyUpdatedSuccess: scope.Counter("topology.updated-success"),
 		topologyUpdatedError:   scope.Counter("topology.updated-error"),
@@ -292,6 +309,17 @@ func newSession(opts Options) (clientSession, error) {
 	}
 	s.reattemptStreamBlocksFromPeersFn = s.streamBlocksReattemptFromPeers
 	s.pickBestPeerFn = s.streamBlocksPickBestPeer
+	if opts.ReadRepairEnabled() {
+		s.readRepairer = newReadRepairer(opts, s.readRepairWrite,
+			scope.SubScope("read-repair"))
+	}
+	if opts.HintedHandoffEnabled() {
+		s.hintedHandoff = newHintedHandoff(opts, s.hintedHandoffWrite,
+			s.hostConnected, scope.SubScope("hinted-handoff"))
+	}
+	if opts.HedgedReadsEnabled() {
+		s.hedgedReads = newHedgedReads(opts, scope.SubScope("hedged-reads"))
+	}
 	writeAttemptPoolOpts := pool.NewObjectPoolOptions().
 		SetSize(opts.WriteOpPoolSize()).
 		SetInstrumentOptions(opts.InstrumentOptions().SetMetricsScope(
@@ -454,6 +482,8 @@ func (s *session) recordWriteMetrics(consistencyResultErr error, respErrs int32,
 		s.metrics.writeSuccess.Inc(1)
 	} else if IsBadRequestError(consistencyResultErr) {
 		s.metrics.writeErrorsBadRequest.Inc(1)
+	} else if IsResourceExhaustedError(consistencyResultErr) {
+		s.metrics.writeErrorsResourceExhausted.Inc(1)
 	} else {
 		s.metrics.writeErrorsInternalError.Inc(1)
 	}
@@ -478,6 +508,8 @@ func (s *session) recordFetchMetrics(consistencyResultErr error, respErrs int32,
 		s.metrics.fetchSuccess.Inc(1)
 	} else if IsBadRequestError(consistencyResultErr) {
 		s.metrics.fetchErrorsBadRequest.Inc(1)
+	} else if IsResourceExhaustedError(consistencyResultErr) {
+		s.metrics.fetchErrorsResourceExhausted.Inc(1)
 	} else {
 		s.metrics.fetchErrorsInternalError.Inc(1)
 	}
@@ -509,6 +541,13 @@ func (s *session) Open() error {
 		return errSessionStatusNotInitial
 	}
 
+	if s.readRepairer != nil {
+		if err := s.readRepairer.Open(); err != nil {
+			s.state.Unlock()
+			return err
+		}
+	}
+
 	watch, err := s.state.topo.Watch()
 	if err != nil {
 		s.state.Unlock()
@@ -602,6 +641,13 @@ func (s *session) Open() error {
 	s.state.status = statusOpen
 	s.state.Unlock()
 
+	if s.readRepairer != nil {
+		go s.readRepairer.Run()
+	}
+	if s.hintedHandoff != nil {
+		go s.hintedHandoff.Run()
+	}
+
 	go func() {
 		for range watch.C() {
 			s.log.Info("received update for topology")
@@ -981,14 +1027,29 @@ func (s *session) Write(
 	unit xtime.Unit,
 	annotation []byte,
 ) error {
+	return s.WriteContext(stdctx.Background(), nsID, id, t, value, unit, annotation)
+}
+
+func (s *session) WriteContext(
+	ctx stdctx.Context,
+	nsID, id ident.ID,
+	t time.Time,
+	value float64,
+	unit xtime.Unit,
+	annotation []byte,
+) error {
+	sp, ctx := startSpan(ctx, tracepoint.ClientSessionWrite)
 	w := s.pools.writeAttempt.Get()
+	w.args.ctx = ctx
 	w.args.attemptType = untaggedWriteAttemptType
 	w.args.namespace, w.args.id = nsID, id
 	w.args.tags = ident.EmptyTagIterator
 	w.args.t, w.args.value, w.args.unit, w.args.annotation =
 		t, value, unit, annotation
-	err := s.writeRetrier.Attempt(w.attemptFn)
+	err := contextAttemptErr(ctx,
+		s.writeRetrier.AttemptWhile(w.continueFn, w.attemptFn))
 	s.pools.writeAttempt.Put(w)
+	finishSpan(sp, err)
 	return err
 }
 
@@ -1000,17 +1061,48 @@ func (s *session) WriteTagged(
 	unit xtime.Unit,
 	annotation []byte,
 ) error {
+	return s.WriteTaggedContext(stdctx.Background(), nsID, id, tags, t,
+		value, unit, annotation)
+}
+
+func (s *session) WriteTaggedContext(
+	ctx stdctx.Context,
+	nsID, id ident.ID,
+	tags ident.TagIterator,
+	t time.Time,
+	value float64,
+	unit xtime.Unit,
+	annotation []byte,
+) error {
+	sp, ctx := startSpan(ctx, tracepoint.ClientSessionWriteTagged)
 	w := s.pools.writeAttempt.Get()
+	w.args.ctx = ctx
 	w.args.attemptType = taggedWriteAttemptType
 	w.args.namespace, w.args.id, w.args.tags = nsID, id, tags
 	w.args.t, w.args.value, w.args.unit, w.args.annotation =
 		t, value, unit, annotation
-	err := s.writeRetrier.Attempt(w.attemptFn)
+	err := contextAttemptErr(ctx,
+		s.writeRetrier.AttemptWhile(w.continueFn, w.attemptFn))
 	s.pools.writeAttempt.Put(w)
+	finishSpan(sp, err)
+	return err
+}
+
+// contextAttemptErr returns the context error in place of the error returned
+// by a retrier that stopped attempting since the context was done.
+func contextAttemptErr(ctx stdctx.Context, err error) error {
+	if err == xretry.ErrWhileConditionFalse {
+		return ctx.Err()
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil &&
+		xerrors.GetInnerNonRetryableError(err) == ctxErr {
+		return ctxErr
+	}
 	return err
 }
 
 func (s *session) writeAttempt(
+	ctx stdctx.Context,
 	wType writeAttemptType,
 	nsID, id ident.ID,
 	inputTags ident.TagIterator,
@@ -1038,7 +1130,7 @@ func (s *session) writeAttempt(
 	}
 
 	state, majority, enqueued, err := s.writeAttemptWithRLock(
-		wType, nsID, id, inputTags, timestamp, value, timeType, annotation)
+		ctx, wType, nsID, id, inputTags, timestamp, value, timeType, annotation)
 	s.state.RUnlock()
 
 	if err != nil {
@@ -1048,9 +1140,14 @@ func (s *session) writeAttempt(
 	// it's safe to Wait() here, as we still hold the lock on state, after it's
 	// returned from writeAttemptWithRLock.
 	state.Wait()
+	state.stopContextWatchWithLock()
 
 	err = s.writeConsistencyResult(state.consistencyLevel, majority, enqueued,
 		enqueued-state.pending, int32(len(state.errors)), state.errors)
+	if err != nil && state.ctxErr != nil {
+		// The caller stopped waiting on the hosts that have yet to respond.
+		err = xerrors.NewNonRetryableError(state.ctxErr)
+	}
 
 	s.recordWriteMetrics(err, int32(len(state.errors)), startWriteAttempt)
 
@@ -1066,6 +1163,7 @@ func (s *session) writeAttempt(
 // is transferred to the calling function, and is expected to manage the lifecycle of
 // of the object (including releasing the lock/decRef'ing it).
 func (s *session) writeAttemptWithRLock(
+	ctx stdctx.Context,
 	wType writeAttemptType,
 	namespace, id ident.ID,
 	inputTags ident.TagIterator,
@@ -1099,6 +1197,7 @@ func (s *session) writeAttemptWithRLock(
 	switch wType {
 	case untaggedWriteAttemptType:
 		wop := s.pools.writeOperation.Get()
+		wop.setContext(ctx)
 		wop.namespace = nsID
 		wop.shardID = s.state.topoMap.ShardSet().Lookup(tsID)
 		wop.request.ID = tsID.Bytes()
@@ -1111,6 +1210,7 @@ func (s *session) writeAttemptWithRLock(
 		op = wop
 	case taggedWriteAttemptType:
 		wop := s.pools.writeTaggedOperation.Get()
+		wop.setContext(ctx)
 		wop.namespace = nsID
 		wop.shardID = s.state.topoMap.ShardSet().Lookup(tsID)
 		wop.request.ID = tsID.Bytes()
@@ -1140,6 +1240,7 @@ func (s *session) writeAttemptWithRLock(
 	// todo@bl: Can we combine the writeOpPool and the writeStatePool?
 	state.op, state.majority = op, majority
 	state.nsID, state.tsID, state.tagEncoder = nsID, tsID, tagEncoder
+	state.hintedHandoff = s.hintedHandoff
 	op.SetCompletionFn(state.completionFn)
 
 	if err := s.state.topoMap.RouteForEach(tsID, func(idx int, host topology.Host) {
@@ -1167,18 +1268,157 @@ func (s *session) writeAttemptWithRLock(
 		enqueued++
 	}
 
+	state.watchContextWithLock(ctx)
+
 	// NB(prateek): the current go-routine still holds a lock on the
 	// returned writeState object.
 	return state, majority, enqueued, nil
 }
 
+// readRepairWrite enqueues a write of a datapoint of a series with diverging
+// replicas to a single lagging replica.
+func (s *session) readRepairWrite(
+	hostID string,
+	series readRepairSeries,
+	dp ts.Datapoint,
+	unit xtime.Unit,
+	annotation ts.Annotation,
+	completionFn completionFn,
+) error {
+	timeType, err := convert.ToTimeType(unit)
+	if err != nil {
+		return err
+	}
+
+	timestamp, err := convert.ToValue(dp.Timestamp, timeType)
+	if err != nil {
+		return err
+	}
+
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	if s.state.status != statusOpen {
+		return errSessionStatusNotOpen
+	}
+
+	queue, ok := s.state.queuesByHostID[hostID]
+	if !ok {
+		return errSessionHasNoHostQueueForHost
+	}
+
+	tsID := ident.BytesID(series.id)
+	wop := s.pools.writeTaggedOperation.Get()
+	wop.namespace = ident.BytesID(series.namespace)
+	wop.shardID = s.state.topoMap.ShardSet().Lookup(tsID)
+	wop.request.ID = series.id
+	wop.request.EncodedTags = series.encodedTags
+	wop.request.Datapoint.Value = dp.Value
+	wop.request.Datapoint.Timestamp = timestamp
+	wop.request.Datapoint.TimestampTimeType = timeType
+	wop.request.Datapoint.Annotation = annotation
+	wop.requestV2.ID = wop.request.ID
+	wop.requestV2.EncodedTags = wop.request.EncodedTags
+	wop.requestV2.Datapoint = wop.request.Datapoint
+	wop.SetCompletionFn(func(result interface{}, err error) {
+		completionFn(result, err)
+		wop.Close()
+	})
+
+	if err := queue.Enqueue(wop); err != nil {
+		wop.Close()
+		return err
+	}
+	return nil
+}
+
+// hintedHandoffWrite enqueues the replay of a write that previously failed
+// against a replica to that replica.
+func (s *session) hintedHandoffWrite(
+	hostID string,
+	h hint,
+	completionFn completionFn,
+) error {
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	if s.state.status != statusOpen {
+		return errSessionStatusNotOpen
+	}
+
+	queue, ok := s.state.queuesByHostID[hostID]
+	if !ok {
+		return errSessionHasNoHostQueueForHost
+	}
+
+	var (
+		tsID    = ident.BytesID(h.id)
+		shardID = s.state.topoMap.ShardSet().Lookup(tsID)
+		op      writeOp
+	)
+	if h.tagged {
+		wop := s.pools.writeTaggedOperation.Get()
+		wop.namespace = ident.BytesID(h.namespace)
+		wop.shardID = shardID
+		wop.datapoint = h.datapoint
+		wop.request.ID = h.id
+		wop.request.EncodedTags = h.encodedTags
+		wop.requestV2.ID = wop.request.ID
+		wop.requestV2.EncodedTags = wop.request.EncodedTags
+		op = wop
+	} else {
+		wop := s.pools.writeOperation.Get()
+		wop.namespace = ident.BytesID(h.namespace)
+		wop.shardID = shardID
+		wop.datapoint = h.datapoint
+		wop.request.ID = h.id
+		wop.requestV2.ID = wop.request.ID
+		op = wop
+	}
+	op.SetCompletionFn(func(result interface{}, err error) {
+		completionFn(result, err)
+		op.Close()
+	})
+
+	if err := queue.Enqueue(op); err != nil {
+		op.Close()
+		return err
+	}
+	return nil
+}
+
+// hostConnected returns whether the host queue of a host has any open
+// connections.
+func (s *session) hostConnected(hostID string) bool {
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	if s.state.status != statusOpen {
+		return false
+	}
+
+	queue, ok := s.state.queuesByHostID[hostID]
+	return ok && queue.ConnectionCount() > 0
+}
+
 func (s *session) Fetch(
 	nsID ident.ID,
 	id ident.ID,
 	startInclusive, endExclusive time.Time,
+) (encoding.SeriesIterator, error) {
+	return s.FetchContext(stdctx.Background(), nsID, id,
+		startInclusive, endExclusive)
+}
+
+func (s *session) FetchContext(
+	ctx stdctx.Context,
+	nsID ident.ID,
+	id ident.ID,
+	startInclusive, endExclusive time.Time,
 ) (encoding.SeriesIterator, error) {
 	tsIDs := ident.NewIDsIterator(id)
-	results, err := s.FetchIDs(nsID, tsIDs, startInclusive, endExclusive)
+	results, err := s.FetchIDsContext(ctx, nsID, tsIDs,
+		startInclusive, endExclusive)
 	if err != nil {
 		return nil, err
 	}
@@ -1196,30 +1436,65 @@ func (s *session) FetchIDs(
 	ids ident.Iterator,
 	startInclusive, endExclusive time.Time,
 ) (encoding.SeriesIterators, error) {
+	return s.FetchIDsContext(stdctx.Background(), nsID, ids,
+		startInclusive, endExclusive)
+}
+
+func (s *session) FetchIDsContext(
+	ctx stdctx.Context,
+	nsID ident.ID,
+	ids ident.Iterator,
+	startInclusive, endExclusive time.Time,
+) (encoding.SeriesIterators, error) {
+	sp, ctx := startSpan(ctx, tracepoint.ClientSessionFetchIDs)
+	if sp != nil {
+		sp.LogFields(
+			opentracinglog.String("namespace", nsID.String()),
+			opentracinglog.Int("ids", ids.Remaining()),
+			xopentracing.Time("start", startInclusive),
+			xopentracing.Time("end", endExclusive),
+		)
+	}
 	f := s.pools.fetchAttempt.Get()
+	f.args.ctx = ctx
 	f.args.namespace, f.args.ids = nsID, ids
 	f.args.start, f.args.end = startInclusive, endExclusive
-	err := s.fetchRetrier.Attempt(f.attemptFn)
+	err := contextAttemptErr(ctx,
+		s.fetchRetrier.AttemptWhile(f.continueFn, f.attemptFn))
 	result := f.result
 	s.pools.fetchAttempt.Put(f)
+	finishSpan(sp, err)
 	return result, err
 }
 
 func (s *session) Aggregate(
 	ns ident.ID, q index.Query, opts index.AggregationOptions,
 ) (AggregatedTagsIterator, FetchResponseMetadata, error) {
+	return s.AggregateContext(stdctx.Background(), ns, q, opts)
+}
+
+func (s *session) AggregateContext(
+	ctx stdctx.Context, ns ident.ID, q index.Query, opts index.AggregationOptions,
+) (AggregatedTagsIterator, FetchResponseMetadata, error) {
+	sp, ctx := startSpan(ctx, tracepoint.ClientSessionAggregate)
+	if sp != nil {
+		logQuerySpanFields(sp, ns, q, opts.StartInclusive, opts.EndExclusive)
+	}
 	f := s.pools.aggregateAttempt.Get()
+	f.args.ctx = ctx
 	f.args.ns = ns
 	f.args.query = q
 	f.args.opts = opts
-	err := s.fetchRetrier.Attempt(f.attemptFn)
+	err := contextAttemptErr(ctx,
+		s.fetchRetrier.AttemptWhile(f.continueFn, f.attemptFn))
 	iter, metadata := f.resultIter, f.resultMetadata
 	s.pools.aggregateAttempt.Put(f)
+	finishSpan(sp, err)
 	return iter, metadata, err
 }
 
 func (s *session) aggregateAttempt(
-	ns ident.ID, q index.Query, opts index.AggregationOptions,
+	ctx stdctx.Context, ns ident.ID, q index.Query, opts index.AggregationOptions,
 ) (AggregatedTagsIterator, FetchResponseMetadata, error) {
 	s.state.RLock()
 	if s.state.status != statusOpen {
@@ -1239,6 +1514,7 @@ func (s *session) aggregateAttempt(
 	}
 
 	fetchState, err := s.newFetchStateWithRLock(nsClone, newFetchStateOpts{
+		ctx:              ctx,
 		stateType:        aggregateFetchState,
 		aggregateRequest: req,
 		startInclusive:   opts.StartInclusive,
@@ -1269,31 +1545,131 @@ func (s *session) aggregateAttempt(
 func (s *session) FetchTagged(
 	ns ident.ID, q index.Query, opts index.QueryOptions,
 ) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	return s.FetchTaggedContext(stdctx.Background(), ns, q, opts)
+}
+
+func (s *session) FetchTaggedContext(
+	ctx stdctx.Context, ns ident.ID, q index.Query, opts index.QueryOptions,
+) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	return s.fetchTagged(ctx, tracepoint.ClientSessionFetchTagged, ns, q, opts)
+}
+
+func (s *session) FetchTaggedPage(
+	ctx stdctx.Context, ns ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte,
+) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	opts, err := paginatedFetchTaggedOpts(opts, pageToken)
+	if err != nil {
+		return nil, FetchResponseMetadata{}, err
+	}
+	return s.fetchTagged(ctx, tracepoint.ClientSessionFetchTaggedPage, ns, q, opts)
+}
+
+func (s *session) fetchTagged(
+	ctx stdctx.Context,
+	operation string,
+	ns ident.ID,
+	q index.Query,
+	opts index.QueryOptions,
+) (encoding.SeriesIterators, FetchResponseMetadata, error) {
+	sp, ctx := startSpan(ctx, operation)
+	if sp != nil {
+		logQuerySpanFields(sp, ns, q, opts.StartInclusive, opts.EndExclusive)
+	}
 	f := s.pools.fetchTaggedAttempt.Get()
+	f.args.ctx = ctx
 	f.args.ns = ns
 	f.args.query = q
 	f.args.opts = opts
-	err := s.fetchRetrier.Attempt(f.dataAttemptFn)
+	err := contextAttemptErr(ctx,
+		s.fetchRetrier.AttemptWhile(f.continueFn, f.dataAttemptFn))
 	iters, metadata := f.dataResultIters, f.dataResultMetadata
 	s.pools.fetchTaggedAttempt.Put(f)
+	finishSpan(sp, err)
 	return iters, metadata, err
 }
 
 func (s *session) FetchTaggedIDs(
 	ns ident.ID, q index.Query, opts index.QueryOptions,
 ) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	return s.FetchTaggedIDsContext(stdctx.Background(), ns, q, opts)
+}
+
+func (s *session) FetchTaggedIDsContext(
+	ctx stdctx.Context, ns ident.ID, q index.Query, opts index.QueryOptions,
+) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	return s.fetchTaggedIDs(ctx, tracepoint.ClientSessionFetchTaggedIDs, ns, q, opts)
+}
+
+func (s *session) FetchTaggedIDsPage(
+	ctx stdctx.Context, ns ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte,
+) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	opts, err := paginatedFetchTaggedOpts(opts, pageToken)
+	if err != nil {
+		return nil, FetchResponseMetadata{}, err
+	}
+	return s.fetchTaggedIDs(ctx, tracepoint.ClientSessionFetchTaggedIDsPage, ns, q, opts)
+}
+
+func (s *session) fetchTaggedIDs(
+	ctx stdctx.Context,
+	operation string,
+	ns ident.ID,
+	q index.Query,
+	opts index.QueryOptions,
+) (TaggedIDsIterator, FetchResponseMetadata, error) {
+	sp, ctx := startSpan(ctx, operation)
+	if sp != nil {
+		logQuerySpanFields(sp, ns, q, opts.StartInclusive, opts.EndExclusive)
+	}
 	f := s.pools.fetchTaggedAttempt.Get()
+	f.args.ctx = ctx
 	f.args.ns = ns
 	f.args.query = q
 	f.args.opts = opts
-	err := s.fetchRetrier.Attempt(f.idsAttemptFn)
+	err := contextAttemptErr(ctx,
+		s.fetchRetrier.AttemptWhile(f.continueFn, f.idsAttemptFn))
 	iter, metadata := f.idsResultIter, f.idsResultMetadata
 	s.pools.fetchTaggedAttempt.Put(f)
+	finishSpan(sp, err)
 	return iter, metadata, err
 }
 
+// paginatedFetchTaggedOpts sets the query options cursor to the position of
+// the page token of a paginated fetch, a nil page token requests the first
+// page. Every host is requested with the same cursor.
+func paginatedFetchTaggedOpts(
+	opts index.QueryOptions,
+	pageToken []byte,
+) (index.QueryOptions, error) {
+	var cursor index.QueryCursor
+	if pageToken != nil {
+		if err := cursor.UnmarshalBinary(pageToken); err != nil {
+			return opts, xerrors.NewInvalidParamsError(err)
+		}
+	}
+	opts.Cursor = &cursor
+	return opts, nil
+}
+
+func logQuerySpanFields(
+	sp opentracing.Span,
+	ns ident.ID,
+	q index.Query,
+	start, end time.Time,
+) {
+	sp.LogFields(
+		opentracinglog.String("query", q.String()),
+		opentracinglog.String("namespace", ns.String()),
+		xopentracing.Time("start", start),
+		xopentracing.Time("end", end),
+	)
+}
+
 func (s *session) fetchTaggedAttempt(
-	ns ident.ID, q index.Query, opts index.QueryOptions,
+	ctx stdctx.Context,
+	ns ident.ID,
+	q index.Query,
+	opts index.QueryOptions,
 ) (encoding.SeriesIterators, FetchResponseMetadata, error) {
 	nsCtx, err := s.nsCtxFor(ns)
 	if err != nil {
@@ -1321,11 +1697,19 @@ func (s *session) fetchTaggedAttempt(
 		return nil, FetchResponseMetadata{}, xerrors.NewNonRetryableError(err)
 	}
 
+	// NB: Series are only repaired when read from a majority of replicas and
+	// every host was asked for all of its results, otherwise a replica not
+	// returning a series does not mean it is lagging.
+	readRepair := s.readRepairer != nil && opts.Cursor == nil &&
+		readRepairConsistencyLevel(s.state.readLevel)
 	fetchState, err := s.newFetchStateWithRLock(nsClone, newFetchStateOpts{
-		stateType:          fetchTaggedFetchState,
-		fetchTaggedRequest: req,
-		startInclusive:     opts.StartInclusive,
-		endExclusive:       opts.EndExclusive,
+		ctx:                   ctx,
+		stateType:             fetchTaggedFetchState,
+		fetchTaggedRequest:    req,
+		fetchTaggedReadRepair: readRepair,
+		fetchTaggedExplain:    opts.Explain,
+		startInclusive:        opts.StartInclusive,
+		endExclusive:          opts.EndExclusive,
 	})
 	s.state.RUnlock()
 
@@ -1342,6 +1726,11 @@ func (s *session) fetchTaggedAttempt(
 	fetchState.Unlock()
 	iters, metadata, err := fetchState.asEncodingSeriesIterators(
 		s.pools, nsCtx.Schema, s.opts.IterationOptions())
+	if err == nil && readRepair {
+		for _, series := range fetchState.readRepairSeries() {
+			s.readRepairer.Enqueue(series)
+		}
+	}
 
 	// must Unlock() before decRef'ing, as the latter releases the fetchState back into a
 	// pool if ref count == 0.
@@ -1351,7 +1740,10 @@ func (s *session) fetchTaggedAttempt(
 }
 
 func (s *session) fetchTaggedIDsAttempt(
-	ns ident.ID, q index.Query, opts index.QueryOptions,
+	ctx stdctx.Context,
+	ns ident.ID,
+	q index.Query,
+	opts index.QueryOptions,
 ) (TaggedIDsIterator, FetchResponseMetadata, error) {
 	s.state.RLock()
 	if s.state.status != statusOpen {
@@ -1376,8 +1768,10 @@ func (s *session) fetchTaggedIDsAttempt(
 	}
 
 	fetchState, err := s.newFetchStateWithRLock(nsClone, newFetchStateOpts{
+		ctx:                ctx,
 		stateType:          fetchTaggedFetchState,
 		fetchTaggedRequest: req,
+		fetchTaggedExplain: opts.Explain,
 		startInclusive:     opts.StartInclusive,
 		endExclusive:       opts.EndExclusive,
 	})
@@ -1404,12 +1798,15 @@ func (s *session) fetchTaggedIDsAttempt(
 }
 
 type newFetchStateOpts struct {
+	ctx            stdctx.Context
 	stateType      fetchStateType
 	startInclusive time.Time
 	endExclusive   time.Time
 
 	// only valid if stateType == fetchTaggedFetchState
-	fetchTaggedRequest rpc.FetchTaggedRequest
+	fetchTaggedRequest    rpc.FetchTaggedRequest
+	fetchTaggedReadRepair bool
+	fetchTaggedExplain    *index.QueryExplanation
 
 	// only valid if stateType == aggregateFetchState
 	aggregateRequest rpc.AggregateQueryRawRequest
@@ -1434,6 +1831,7 @@ func (s *session) newFetchStateWithRLock(
 	var (
 		op     op
 		closer func()
+		queues = s.state.queues
 	)
 	switch opts.stateType {
 	case fetchTaggedFetchState:
@@ -1441,8 +1839,22 @@ func (s *session) newFetchStateWithRLock(
 		fetchOp.incRef()        // indicate current go-routine has a reference to the op
 		closer = fetchOp.decRef // release the ref for the current go-routine
 		fetchOp.update(opts.fetchTaggedRequest, fetchState.completionFn)
+		fetchOp.readRepair = opts.fetchTaggedReadRepair
+		fetchOp.explain = opts.fetchTaggedExplain
+		fetchOp.setContext(opts.ctx)
 		fetchState.ResetFetchTagged(opts.startInclusive, opts.endExclusive,
 			fetchOp, topoMap, s.state.majority, s.state.readLevel)
+		// NB: Read repaired fetches need every replica's response so are
+		// always sent to every replica.
+		isolationGroup := s.opts.PreferredReadIsolationGroup()
+		if (s.hedgedReads != nil || isolationGroup != "") && !fetchOp.readRepair &&
+			readHostsConsistencyLevel(s.state.readLevel) {
+			numDesired := topology.NumDesiredForReadConsistency(s.state.readLevel,
+				s.state.replicas, s.state.majority)
+			initial, hedge := readHosts(queues, topoMap, numDesired, isolationGroup)
+			fetchState.ResetHedge(s.hedgedReads, initial, hedge, s.nowFn)
+			queues = initial
+		}
 		op = fetchOp
 
 	case aggregateFetchState:
@@ -1450,6 +1862,7 @@ func (s *session) newFetchStateWithRLock(
 		aggOp.incRef()        // indicate current go-routine has a reference to the op
 		closer = aggOp.decRef // release the ref for the current go-routine
 		aggOp.update(opts.aggregateRequest, fetchState.completionFn)
+		aggOp.setContext(opts.ctx)
 		fetchState.ResetAggregate(opts.startInclusive, opts.endExclusive,
 			aggOp, topoMap, s.state.majority, s.state.readLevel)
 		op = aggOp
@@ -1462,7 +1875,7 @@ func (s *session) newFetchStateWithRLock(
 	}
 
 	fetchState.Lock()
-	for _, hq := range s.state.queues {
+	for _, hq := range queues {
 		// inc to indicate the hostQueue has a reference to `op` which has a ref to the fetchState
 		fetchState.incRef()
 		if err := hq.Enqueue(op); err != nil {
@@ -1483,12 +1896,16 @@ func (s *session) newFetchStateWithRLock(
 
 	closer() // release the ref for the current go-routine
 
+	fetchState.StartHedgeTimerWithLock()
+	fetchState.WatchContextWithLock(opts.ctx)
+
 	// NB(prateek): the calling go-routine still holds the lock and a ref
 	// on the returned fetchState object.
 	return fetchState, nil
 }
 
 func (s *session) fetchIDsAttempt(
+	ctx stdctx.Context,
 	inputNamespace ident.ID,
 	inputIDs ident.Iterator,
 	startInclusive, endExclusive time.Time,
@@ -1723,6 +2140,7 @@ func (s *session) fetchIDsAttempt(
 				// they know when their use is complete.
 				f = s.pools.fetchBatchOp.Get()
 				f.IncRef()
+				f.setContext(ctx)
 				fetchBatchOpsByHostIdx[hostIdx] = append(fetchBatchOpsByHostIdx[hostIdx], f)
 				f.request.RangeStart = rangeStart
 				f.request.RangeEnd = rangeEnd
@@ -1815,6 +2233,19 @@ func (s *session) IteratorPools() (encoding.IteratorPools, error) {
 	return s.pools, nil
 }
 
+func (s *session) HostQueueSaturation() ([]HostQueueSaturation, error) {
+	s.state.RLock()
+	defer s.state.RUnlock()
+	if s.state.status != statusOpen {
+		return nil, errSessionStatusNotOpen
+	}
+	result := make([]HostQueueSaturation, 0, len(s.state.queues))
+	for _, queue := range s.state.queues {
+		result = append(result, queue.Saturation())
+	}
+	return result, nil
+}
+
 func (s *session) Close() error {
 	s.state.Lock()
 	if s.state.status != statusOpen {
@@ -1827,6 +2258,13 @@ func (s *session) Close() error {
 	topo := s.state.topo
 	s.state.Unlock()
 
+	if s.readRepairer != nil {
+		s.readRepairer.Close()
+	}
+	if s.hintedHandoff != nil {
+		s.hintedHandoff.Close()
+	}
+
 	for _, q := range queues {
 		q.Close()
 	}
@@ -1914,6 +2352,237 @@ func (s *session) Truncate(namespace ident.ID) (int64, error) {
 	return truncated, resultErr.FinalError()
 }
 
+func (s *session) DeleteTagged(
+	namespace ident.ID,
+	q index.Query,
+	start, end time.Time,
+) (int64, error) {
+	req, err := convert.ToRPCDeleteTaggedRequest(namespace, q, start, end)
+	if err != nil {
+		return 0, xerrors.NewInvalidParamsError(err)
+	}
+
+	var (
+		wg         sync.WaitGroup
+		enqueueErr xerrors.MultiError
+		resultLock sync.Mutex
+		resultErr  xerrors.MultiError
+		// NB: Each replica of a shard deletes the same series so the number
+		// of series deleted is the most deleted by any replica of each shard,
+		// hosts that do not report per shard counts can only bound the total.
+		deletedByShard = make(map[int32]int64)
+		maxDeleted     int64
+	)
+
+	d := &deleteTaggedOp{request: req}
+	d.completionFn = func(result interface{}, err error) {
+		resultLock.Lock()
+		if err != nil {
+			resultErr = resultErr.Add(err)
+		} else {
+			res := result.(*rpc.DeleteTaggedResult_)
+			for shard, numSeries := range res.NumSeriesByShard {
+				if numSeries > deletedByShard[shard] {
+					deletedByShard[shard] = numSeries
+				}
+			}
+			if res.NumSeries > maxDeleted {
+				maxDeleted = res.NumSeries
+			}
+		}
+		resultLock.Unlock()
+		wg.Done()
+	}
+
+	s.state.RLock()
+	for idx := range s.state.queues {
+		wg.Add(1)
+		if err := s.state.queues[idx].Enqueue(d); err != nil {
+			wg.Done()
+			enqueueErr = enqueueErr.Add(err)
+		}
+	}
+	s.state.RUnlock()
+
+	if err := enqueueErr.FinalError(); err != nil {
+		s.log.Error("failed to enqueue request", zap.Error(err))
+		return 0, err
+	}
+
+	// Wait for the series to be deleted on all replicas
+	wg.Wait()
+
+	var deleted int64
+	for _, numSeries := range deletedByShard {
+		deleted += numSeries
+	}
+	if maxDeleted > deleted {
+		deleted = maxDeleted
+	}
+	return deleted, resultErr.FinalError()
+}
+
+func (s *session) WriteDocument(
+	namespace ident.ID,
+	d doc.Document,
+	timestamp time.Time,
+) error {
+	startWriteAttempt := s.nowFn()
+
+	tagEncoder := s.pools.tagEncoder.Get()
+	req, err := convert.ToRPCWriteDocumentRequest(namespace, d, timestamp, tagEncoder)
+	tagEncoder.Finalize()
+	if err != nil {
+		return xerrors.NewInvalidParamsError(err)
+	}
+
+	var (
+		wg         sync.WaitGroup
+		resultLock sync.Mutex
+		resultErrs []error
+		enqueued   int32
+	)
+
+	w := &writeDocumentOp{request: req}
+	w.completionFn = func(_ interface{}, err error) {
+		if err != nil {
+			resultLock.Lock()
+			resultErrs = append(resultErrs, err)
+			resultLock.Unlock()
+		}
+		wg.Done()
+	}
+
+	s.state.RLock()
+	if s.state.status != statusOpen {
+		s.state.RUnlock()
+		return errSessionStatusNotOpen
+	}
+	var (
+		level    = s.state.writeLevel
+		majority = int32(s.state.majority)
+	)
+	// NB: Documents are sharded by ID like series so the write is only sent
+	// to the replicas owning the shard of the document.
+	routeErr := s.state.topoMap.RouteForEach(ident.BytesID(d.ID), func(idx int, _ topology.Host) {
+		wg.Add(1)
+		if err := s.state.queues[idx].Enqueue(w); err != nil {
+			// Enqueue errors count against the write consistency.
+			resultLock.Lock()
+			resultErrs = append(resultErrs, err)
+			resultLock.Unlock()
+			wg.Done()
+		}
+		enqueued++
+	})
+	s.state.RUnlock()
+
+	// Wait for the document to be written on all replicas it was sent to
+	wg.Wait()
+
+	if routeErr != nil {
+		return routeErr
+	}
+
+	err = s.writeConsistencyResult(level, majority, enqueued,
+		enqueued, int32(len(resultErrs)), resultErrs)
+	s.recordWriteMetrics(err, int32(len(resultErrs)), startWriteAttempt)
+	return err
+}
+
+func (s *session) Cardinality(
+	namespace ident.ID,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	return s.CardinalityContext(stdctx.Background(), namespace, opts)
+}
+
+func (s *session) CardinalityContext(
+	ctx stdctx.Context,
+	namespace ident.ID,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	sp, ctx := startSpan(ctx, tracepoint.ClientSessionCardinality)
+	if sp != nil {
+		sp.LogFields(opentracinglog.String("namespace", namespace.String()))
+	}
+	result, err := s.cardinality(ctx, namespace, opts)
+	finishSpan(sp, err)
+	return result, err
+}
+
+func (s *session) cardinality(
+	ctx stdctx.Context,
+	namespace ident.ID,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	if err := ctx.Err(); err != nil {
+		return index.CardinalityResult{}, err
+	}
+
+	// Hosts over fetch so that the limits are only applied to the merged
+	// result.
+	req, err := convert.ToRPCCardinalityRequest(namespace, opts.HostOptions())
+	if err != nil {
+		return index.CardinalityResult{}, xerrors.NewInvalidParamsError(err)
+	}
+
+	var (
+		wg            sync.WaitGroup
+		enqueueErr    xerrors.MultiError
+		resultLock    sync.Mutex
+		resultErr     xerrors.MultiError
+		resultsByHost []index.CardinalityResult
+	)
+
+	c := &cardinalityOp{request: req}
+	c.setContext(ctx)
+	c.completionFn = func(result interface{}, err error) {
+		if err == nil {
+			var res index.CardinalityResult
+			res, err = convert.FromRPCCardinalityResult(result.(*rpc.CardinalityResult_))
+			if err == nil {
+				resultLock.Lock()
+				resultsByHost = append(resultsByHost, res)
+				resultLock.Unlock()
+			}
+		}
+		if err != nil {
+			resultLock.Lock()
+			resultErr = resultErr.Add(err)
+			resultLock.Unlock()
+		}
+		wg.Done()
+	}
+
+	s.state.RLock()
+	for idx := range s.state.queues {
+		wg.Add(1)
+		if err := s.state.queues[idx].Enqueue(c); err != nil {
+			wg.Done()
+			enqueueErr = enqueueErr.Add(err)
+		}
+	}
+	s.state.RUnlock()
+
+	if err := enqueueErr.FinalError(); err != nil {
+		s.log.Error("failed to enqueue request", zap.Error(err))
+		return index.CardinalityResult{}, err
+	}
+
+	// Wait for all hosts to respond, since each host only holds a subset of
+	// the shards every host is required to build the complete report.
+	wg.Wait()
+
+	if err := resultErr.FinalError(); err != nil {
+		return index.CardinalityResult{}, err
+	}
+	// NB: Fields and values ranked below the over fetched limits of every
+	// host are not returned, so the merged result remains approximate when
+	// the limits are set.
+	return index.MergeCardinalityResults(resultsByHost, opts)
+}
+
 // NB(r): Excluding maligned struct check here as we can
 // live with a few extra bytes since this struct is only
 // ever passed by stack, its much more readable not optimized
@@ -0,0 +1,99 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardinality(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	var (
+		end   = time.Now().Truncate(time.Second)
+		start = end.Add(-time.Hour)
+	)
+
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			cardinality, ok := op.(*cardinalityOp)
+			assert.True(t, ok)
+			assert.Equal(t, []byte("metrics"), cardinality.request.NameSpace)
+			assert.Equal(t, start.UnixNano(), cardinality.request.RangeStart)
+			assert.Equal(t, end.UnixNano(), cardinality.request.RangeEnd)
+			// Hosts over fetch and the limit is applied to the merged result.
+			assert.Equal(t, int64(index.CardinalityOptions{FieldsLimit: 1}.HostOptions().FieldsLimit),
+				cardinality.request.GetFieldsLimit())
+			assert.True(t, cardinality.request.GetFieldsLimit() > 1)
+
+			// Each host holds an overlapping subset of the series.
+			tracker := index.NewCardinalityTracker()
+			for i := idx * 10; i < idx*10+20; i++ {
+				tracker.Add(doc.Document{
+					ID: []byte(fmt.Sprintf("series-%d", i)),
+					Fields: []doc.Field{
+						{Name: []byte("city"), Value: []byte(fmt.Sprintf("city-%d", i%2))},
+						{Name: []byte("host"), Value: []byte(fmt.Sprintf("host-%d", idx))},
+					},
+				})
+			}
+			result, err := convert.ToRPCCardinalityResult(tracker.Result(index.CardinalityOptions{}))
+			require.NoError(t, err)
+			cardinality.completionFn(result, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	result, err := s.Cardinality(ident.StringID("metrics"), index.CardinalityOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+		FieldsLimit:    1,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Fields, 1)
+
+	expectedSeries := uint64(sessionTestReplicas*10 + 10)
+	field := result.Fields[0]
+	assert.Equal(t, "city", string(field.Field))
+	assert.Equal(t, expectedSeries, field.Series.Estimate())
+	assert.Equal(t, uint64(2), field.Values.Estimate())
+	require.Len(t, field.TopValues, 2)
+
+	assert.NoError(t, session.Close())
+}
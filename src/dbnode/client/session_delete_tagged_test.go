@@ -0,0 +1,87 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteTagged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	var (
+		end   = time.Now().Truncate(time.Second)
+		start = end.Add(-time.Hour)
+		query = index.Query{Query: idx.NewTermQuery([]byte("foo"), []byte("bar"))}
+	)
+	queryBytes, err := idx.Marshal(query.Query)
+	require.NoError(t, err)
+
+	// Every replica deletes the same series from its shards so the series
+	// deleted are counted once per shard rather than once per replica.
+	var maxShard0 int64
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			deleteTagged, ok := op.(*deleteTaggedOp)
+			assert.True(t, ok)
+			assert.Equal(t, []byte("metrics"), deleteTagged.request.NameSpace)
+			assert.Equal(t, queryBytes, deleteTagged.request.Query)
+			assert.Equal(t, start.UnixNano(), deleteTagged.request.RangeStart)
+			assert.Equal(t, end.UnixNano(), deleteTagged.request.RangeEnd)
+			assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, deleteTagged.request.RangeTimeType)
+
+			shard0 := rand.Int63n(128)
+			if shard0 > maxShard0 {
+				maxShard0 = shard0
+			}
+			result := &rpc.DeleteTaggedResult_{
+				NumSeries:        shard0 + 5,
+				NumSeriesByShard: map[int32]int64{0: shard0, 1: 5},
+			}
+			deleteTagged.completionFn(result, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	n, err := s.DeleteTagged(ident.StringID("metrics"), query, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, maxShard0+5, n)
+
+	assert.NoError(t, session.Close())
+}
@@ -21,6 +21,7 @@
 package client
 
 import (
+	stdctx "context"
 	"fmt"
 	"strings"
 	"sync"
@@ -106,6 +107,136 @@ func TestSessionFetchTaggedNotOpenError(t *testing.T) {
 	assert.Equal(t, errSessionStatusNotOpen, err)
 }
 
+func TestSessionFetchTaggedContextCancelled(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{t})
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	// NB: no enqueue is expected since the context is already cancelled.
+	mockHostQueues(ctrl, session, sessionTestReplicas, nil)
+	assert.NoError(t, session.Open())
+
+	ctx, cancel := stdctx.WithCancel(stdctx.Background())
+	cancel()
+
+	t0 := time.Now()
+	leakPool := injectLeakcheckFetchTaggedAttempPool(session)
+	_, _, err = session.FetchTaggedContext(ctx, ident.StringID("namespace"),
+		testSessionFetchTaggedQuery, testSessionFetchTaggedQueryOpts(t0, t0))
+	assert.Equal(t, stdctx.Canceled, err)
+	leakPool.Check(t)
+
+	_, _, err = session.FetchTaggedIDsContext(ctx, ident.StringID("namespace"),
+		testSessionFetchTaggedQuery, testSessionFetchTaggedQueryOpts(t0, t0))
+	assert.Equal(t, stdctx.Canceled, err)
+	leakPool.Check(t)
+
+	assert.NoError(t, session.Close())
+}
+
+func TestSessionFetchTaggedContextCancelledStopsRetries(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{t})
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions().
+		SetFetchRetrier(xretry.NewRetrier(xretry.NewOptions().SetMaxRetries(1)))
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	start := time.Now().Truncate(time.Hour)
+	end := start.Add(2 * time.Hour)
+
+	topoInit := opts.TopologyInitializer()
+	topoWatch, err := topoInit.Init()
+	require.NoError(t, err)
+	topoMap := topoWatch.Get()
+	require.True(t, topoMap.HostsLen() > 0)
+
+	ctx, cancel := stdctx.WithCancel(stdctx.Background())
+	defer cancel()
+
+	// NB: each host queue only expects a single enqueue, a retry after
+	// the context is cancelled would fail the test.
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			fetchOp, ok := op.(*fetchTaggedOp)
+			require.True(t, ok)
+			assert.Equal(t, ctx, fetchOp.context())
+
+			cancel()
+			err := fetchOp.contextErr()
+			go func() {
+				host := topoMap.Hosts()[idx]
+				op.CompletionFn()(fetchTaggedResultAccumulatorOpts{host: host}, err)
+			}()
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	_, _, err = session.FetchTaggedContext(ctx, ident.StringID("namespace"),
+		testSessionFetchTaggedQuery, testSessionFetchTaggedQueryOpts(start, end))
+	assert.Equal(t, stdctx.Canceled, err)
+	assert.NoError(t, session.Close())
+}
+
+func TestSessionFetchTaggedContextCancelledWhileWaiting(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{t})
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	start := time.Now().Truncate(time.Hour)
+	end := start.Add(2 * time.Hour)
+
+	topoInit := opts.TopologyInitializer()
+	topoWatch, err := topoInit.Init()
+	require.NoError(t, err)
+	topoMap := topoWatch.Get()
+
+	ctx, cancel := stdctx.WithCancel(stdctx.Background())
+	defer cancel()
+
+	// NB: the hosts only respond once the caller stopped waiting on them.
+	var (
+		respondCh = make(chan struct{})
+		respondWg sync.WaitGroup
+	)
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			cancel()
+			respondWg.Add(1)
+			go func() {
+				defer respondWg.Done()
+				<-respondCh
+				host := topoMap.Hosts()[idx]
+				op.CompletionFn()(fetchTaggedResultAccumulatorOpts{
+					host:     host,
+					response: &rpc.FetchTaggedResult_{Exhaustive: true},
+				}, nil)
+			}()
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	_, _, err = session.FetchTaggedContext(ctx, ident.StringID("namespace"),
+		testSessionFetchTaggedQuery, testSessionFetchTaggedQueryOpts(start, end))
+	assert.Equal(t, stdctx.Canceled, err)
+
+	close(respondCh)
+	respondWg.Wait()
+	assert.NoError(t, session.Close())
+}
+
 func TestSessionFetchTaggedIDsGuardAgainstInvalidCall(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -232,6 +363,7 @@ func TestSessionFetchTaggedIDsBadRequestErrorIsNonRetryable(t *testing.T) {
 	_, _, err = session.FetchTaggedIDs(ident.StringID("namespace"),
 		testSessionFetchTaggedQuery, testSessionFetchTaggedQueryOpts(start, end))
 	assert.Error(t, err)
+	assert.True(t, xerrors.IsNonRetryableError(err))
 	assert.NoError(t, session.Close())
 
 	numStateAllocs := 0
@@ -249,6 +381,46 @@ func TestSessionFetchTaggedIDsBadRequestErrorIsNonRetryable(t *testing.T) {
 	require.Equal(t, 1, numOpAllocs)
 }
 
+func TestSessionFetchTaggedResourceExhaustedErrorIsNonRetryable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	start := time.Now().Truncate(time.Hour)
+	end := start.Add(2 * time.Hour)
+
+	topoInit := opts.TopologyInitializer()
+	topoWatch, err := topoInit.Init()
+	require.NoError(t, err)
+	topoMap := topoWatch.Get()
+	require.True(t, topoMap.HostsLen() > 0)
+
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			go func() {
+				host := topoMap.Hosts()[idx]
+				op.CompletionFn()(fetchTaggedResultAccumulatorOpts{host: host}, &rpc.Error{
+					Type:    rpc.ErrorType_RESOURCE_EXHAUSTED,
+					Message: "expected resource exhausted error",
+				})
+			}()
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	_, _, err = session.FetchTagged(ident.StringID("namespace"),
+		testSessionFetchTaggedQuery, testSessionFetchTaggedQueryOpts(start, end))
+	assert.Error(t, err)
+	assert.True(t, xerrors.IsNonRetryableError(err))
+	assert.True(t, IsResourceExhaustedError(err))
+	assert.NoError(t, session.Close())
+}
+
 func TestSessionFetchTaggedIDsEnqueueErr(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
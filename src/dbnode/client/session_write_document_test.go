@@ -0,0 +1,78 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteDocument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	var (
+		timestamp = time.Now().Truncate(time.Second)
+		d         = doc.Document{
+			ID:     []byte("foo"),
+			Fields: []doc.Field{{Name: []byte("bar"), Value: []byte("baz")}},
+		}
+	)
+
+	// A single replica failing still meets the majority write consistency.
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			writeDocument, ok := op.(*writeDocumentOp)
+			assert.True(t, ok)
+			assert.Equal(t, []byte("metrics"), writeDocument.request.NameSpace)
+			assert.Equal(t, d.ID, writeDocument.request.ID)
+			assert.NotEmpty(t, writeDocument.request.EncodedTags)
+			assert.Equal(t, timestamp.UnixNano(), writeDocument.request.Timestamp)
+			assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, writeDocument.request.TimestampTimeType)
+
+			if idx == 0 {
+				writeDocument.completionFn(nil, errors.New("an error"))
+				return
+			}
+			writeDocument.completionFn(nil, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	require.NoError(t, s.WriteDocument(ident.StringID("metrics"), d, timestamp))
+
+	assert.NoError(t, session.Close())
+}
@@ -21,6 +21,7 @@
 package client
 
 import (
+	stdctx "context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -116,6 +117,36 @@ func testSessionWrite(t *testing.T, testOpts testOptions) {
 	assert.NoError(t, session.Close())
 }
 
+func TestSessionWriteContextCancelledWhileWaiting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := newDefaultTestSession(t).(*session)
+	w := newWriteStub()
+
+	ctx, cancel := stdctx.WithCancel(stdctx.Background())
+	defer cancel()
+
+	// NB: the hosts only respond once the caller stopped waiting on them.
+	var completionFns []completionFn
+	enqueueWg := mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{func(idx int, op op) {
+		completionFns = append(completionFns, op.CompletionFn())
+		cancel()
+	}})
+
+	assert.NoError(t, session.Open())
+
+	err := session.WriteContext(ctx, w.ns, w.id, w.t, w.value, w.unit, w.annotation)
+	assert.Equal(t, stdctx.Canceled, err)
+
+	enqueueWg.Wait()
+	for _, fn := range completionFns {
+		fn(session.state.topoMap.Hosts()[0], nil)
+	}
+
+	assert.NoError(t, session.Close())
+}
+
 func TestSessionWriteDoesNotCloneNoFinalize(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
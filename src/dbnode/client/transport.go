@@ -0,0 +1,89 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TransportType is the transport used to communicate with M3DB nodes.
+type TransportType int
+
+const (
+	// TChannelTransportType communicates with nodes using TChannel Thrift.
+	TChannelTransportType TransportType = iota
+
+	// GRPCTransportType communicates with nodes using gRPC, which serves the
+	// same node service as TChannel Thrift.
+	GRPCTransportType
+)
+
+var (
+	validTransportTypes = []TransportType{
+		TChannelTransportType,
+		GRPCTransportType,
+	}
+
+	errTransportTypeUnspecified = errors.New("transport type not specified")
+)
+
+func (t TransportType) String() string {
+	switch t {
+	case TChannelTransportType:
+		return "tchannel"
+	case GRPCTransportType:
+		return "grpc"
+	}
+	return "unknown"
+}
+
+// ValidateTransportType validates a transport type.
+func ValidateTransportType(v TransportType) error {
+	for _, valid := range validTransportTypes {
+		if valid == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid transport type: %d", v)
+}
+
+// UnmarshalYAML unmarshals a TransportType into a valid type from string.
+func (t *TransportType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == "" {
+		return errTransportTypeUnspecified
+	}
+	strs := make([]string, 0, len(validTransportTypes))
+	for _, valid := range validTransportTypes {
+		if str == valid.String() {
+			*t = valid
+			return nil
+		}
+		strs = append(strs, "'"+valid.String()+"'")
+	}
+	return fmt.Errorf("invalid TransportType '%s' valid types are: %s",
+		str, strings.Join(strs, ", "))
+}
@@ -21,6 +21,7 @@
 package client
 
 import (
+	stdctx "context"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/clock"
@@ -32,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
@@ -43,6 +45,7 @@ import (
 	xtime "github.com/m3db/m3/src/x/time"
 
 	tchannel "github.com/uber/tchannel-go"
+	"google.golang.org/grpc/credentials"
 )
 
 // Client can create sessions to write and read to a cluster.
@@ -61,28 +64,79 @@ type Client interface {
 }
 
 // Session can write and read to a cluster.
+//
+// Each operation has a variant suffixed with Context which accepts a context,
+// once the context is done the operation is no longer retried and requests
+// not yet issued to hosts are dropped. The deadline of the context is
+// propagated to single requests issued on behalf of the operation, batched
+// requests are bounded by the configured request timeouts. When the context
+// carries a trace span the operation is traced with a child span.
 type Session interface {
 	// Write value to the database for an ID.
 	Write(namespace, id ident.ID, t time.Time, value float64, unit xtime.Unit, annotation []byte) error
 
+	// WriteContext writes value to the database for an ID with the given context.
+	WriteContext(ctx stdctx.Context, namespace, id ident.ID, t time.Time, value float64, unit xtime.Unit, annotation []byte) error
+
 	// WriteTagged value to the database for an ID and given tags.
 	WriteTagged(namespace, id ident.ID, tags ident.TagIterator, t time.Time, value float64, unit xtime.Unit, annotation []byte) error
 
+	// WriteTaggedContext writes value to the database for an ID and given tags with the given context.
+	WriteTaggedContext(ctx stdctx.Context, namespace, id ident.ID, tags ident.TagIterator, t time.Time, value float64, unit xtime.Unit, annotation []byte) error
+
+	// WriteDocument writes a document to an index only namespace, it is
+	// sent to the replicas owning the shard of the document ID.
+	WriteDocument(namespace ident.ID, d doc.Document, t time.Time) error
+
 	// Fetch values from the database for an ID.
 	Fetch(namespace, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error)
 
+	// FetchContext fetches values from the database for an ID with the given context.
+	FetchContext(ctx stdctx.Context, namespace, id ident.ID, startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error)
+
 	// FetchIDs values from the database for a set of IDs.
 	FetchIDs(namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error)
 
+	// FetchIDsContext fetches values from the database for a set of IDs with the given context.
+	FetchIDsContext(ctx stdctx.Context, namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error)
+
 	// FetchTagged resolves the provided query to known IDs, and fetches the data for them.
 	FetchTagged(namespace ident.ID, q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, FetchResponseMetadata, error)
 
+	// FetchTaggedContext resolves the provided query to known IDs, and fetches the data for them with the given context.
+	FetchTaggedContext(ctx stdctx.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (encoding.SeriesIterators, FetchResponseMetadata, error)
+
 	// FetchTaggedIDs resolves the provided query to known IDs.
 	FetchTaggedIDs(namespace ident.ID, q index.Query, opts index.QueryOptions) (TaggedIDsIterator, FetchResponseMetadata, error)
 
+	// FetchTaggedIDsContext resolves the provided query to known IDs with the given context.
+	FetchTaggedIDsContext(ctx stdctx.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (TaggedIDsIterator, FetchResponseMetadata, error)
+
+	// FetchTaggedPage resolves the provided query to known IDs, and fetches the data
+	// for a single page of them. The page resumes from the page token returned by the
+	// previous page, or from the start if the token is nil, and the token for the next
+	// page is returned in the response metadata. Pages return series in ID order so every
+	// replica returns the same page, the query limit bounds the number of series in a page
+	// and each series is returned by exactly one page.
+	FetchTaggedPage(ctx stdctx.Context, namespace ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte) (encoding.SeriesIterators, FetchResponseMetadata, error)
+
+	// FetchTaggedIDsPage resolves the provided query to known IDs for a single page of
+	// them, paginated in the same way as FetchTaggedPage.
+	FetchTaggedIDsPage(ctx stdctx.Context, namespace ident.ID, q index.Query, opts index.QueryOptions, pageToken []byte) (TaggedIDsIterator, FetchResponseMetadata, error)
+
 	// Aggregate aggregates values from the database for the given set of constraints.
 	Aggregate(namespace ident.ID, q index.Query, opts index.AggregationOptions) (AggregatedTagsIterator, FetchResponseMetadata, error)
 
+	// AggregateContext aggregates values from the database for the given set of constraints with the given context.
+	AggregateContext(ctx stdctx.Context, namespace ident.ID, q index.Query, opts index.AggregationOptions) (AggregatedTagsIterator, FetchResponseMetadata, error)
+
+	// Cardinality returns the approximate series cardinality per tag name and
+	// tag value of the namespace, merged across all hosts.
+	Cardinality(namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error)
+
+	// CardinalityContext returns the approximate series cardinality of the namespace with the given context.
+	CardinalityContext(ctx stdctx.Context, namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error)
+
 	// ShardID returns the given shard for an ID for callers
 	// to easily discern what shard is failing when operations
 	// for given IDs begin failing.
@@ -91,10 +145,38 @@ type Session interface {
 	// IteratorPools exposes the internal iterator pools used by the session to clients.
 	IteratorPools() (encoding.IteratorPools, error)
 
+	// HostQueueSaturation returns the saturation of the queue of writes
+	// pending to each host so that callers can shed load before writes fail.
+	HostQueueSaturation() ([]HostQueueSaturation, error)
+
 	// Close the session
 	Close() error
 }
 
+// HostQueueSaturation is the saturation of the queue of writes pending to a
+// single host.
+type HostQueueSaturation struct {
+	// Host is the host writes are pending to.
+	Host topology.Host
+	// PendingWrites is the number of writes enqueued or in flight to the host.
+	PendingWrites int
+	// Saturation is the ratio of pending writes to the saturation threshold.
+	Saturation float64
+	// Saturated indicates whether the pending writes reached the saturation
+	// threshold, it is reset once they drop below half of the threshold.
+	Saturated bool
+	// WriteBatchSize is the current size of write batches issued to the host.
+	WriteBatchSize int
+	// WriteLatency is the moving average latency of write requests to the host.
+	WriteLatency time.Duration
+}
+
+// HostQueueSaturationFn is called asynchronously when a host queue becomes
+// saturated or is no longer saturated. Calls for a host queue are serialized
+// and changes reversed before the function is called may be skipped, the
+// last call always reflects whether the host queue is saturated.
+type HostQueueSaturationFn func(saturation HostQueueSaturation)
+
 // FetchResponseMetadata is metadata about a fetch response.
 type FetchResponseMetadata struct {
 	// Exhaustive indicates whether the underlying data set presents a full
@@ -104,6 +186,9 @@ type FetchResponseMetadata struct {
 	Responses int
 	// EstimateTotalBytes is an approximation of the total byte size of the response.
 	EstimateTotalBytes int
+	// NextPageToken is the token to request the next page of a paginated
+	// fetch with, it is nil once all pages have been returned.
+	NextPageToken []byte
 }
 
 // AggregatedTagsIterator iterates over a collection of tag names with optionally
@@ -202,6 +287,15 @@ type AdminSession interface {
 	// Truncate will truncate the namespace for a given shard.
 	Truncate(namespace ident.ID) (int64, error)
 
+	// DeleteTagged will delete the data within [start, end) of all series
+	// matching the query from the namespace on every host, returning the
+	// number of series deleted summed across hosts.
+	DeleteTagged(
+		namespace ident.ID,
+		q index.Query,
+		start, end time.Time,
+	) (int64, error)
+
 	// FetchBootstrapBlocksFromPeers will fetch the most fulfilled block
 	// for each series using the runtime configurable bootstrap level consistency.
 	FetchBootstrapBlocksFromPeers(
@@ -351,6 +445,12 @@ type Options interface {
 	// TruncateRequestTimeout returns the truncateRequestTimeout.
 	TruncateRequestTimeout() time.Duration
 
+	// SetDeleteTaggedRequestTimeout sets the deleteTaggedRequestTimeout.
+	SetDeleteTaggedRequestTimeout(value time.Duration) Options
+
+	// DeleteTaggedRequestTimeout returns the deleteTaggedRequestTimeout.
+	DeleteTaggedRequestTimeout() time.Duration
+
 	// SetBackgroundConnectInterval sets the backgroundConnectInterval.
 	SetBackgroundConnectInterval(value time.Duration) Options
 
@@ -566,6 +666,185 @@ type Options interface {
 
 	// WriteTimestampOffset returns the write timestamp offset.
 	WriteTimestampOffset() time.Duration
+
+	// SetReadRepairEnabled sets whether fetches that read from a majority
+	// of replicas asynchronously write the merged datapoints back to
+	// replicas that returned diverging blocks.
+	SetReadRepairEnabled(value bool) Options
+
+	// ReadRepairEnabled returns whether fetches that read from a majority
+	// of replicas asynchronously write the merged datapoints back to
+	// replicas that returned diverging blocks.
+	ReadRepairEnabled() bool
+
+	// SetReadRepairMaxDatapointsPerSecond sets the maximum number of
+	// datapoints written back to replicas by read repair per second.
+	SetReadRepairMaxDatapointsPerSecond(value int) Options
+
+	// ReadRepairMaxDatapointsPerSecond returns the maximum number of
+	// datapoints written back to replicas by read repair per second.
+	ReadRepairMaxDatapointsPerSecond() int
+
+	// SetReadRepairQueueSize sets the maximum number of series pending
+	// read repair, series found diverging beyond this are not repaired.
+	SetReadRepairQueueSize(value int) Options
+
+	// ReadRepairQueueSize returns the maximum number of series pending
+	// read repair, series found diverging beyond this are not repaired.
+	ReadRepairQueueSize() int
+
+	// SetReadRepairNamespaceInitializer sets the initializer of the namespace
+	// registry used by read repair, only series of namespaces with cold writes
+	// enabled are repaired since the datapoints written back are historical.
+	SetReadRepairNamespaceInitializer(value namespace.Initializer) Options
+
+	// ReadRepairNamespaceInitializer returns the initializer of the namespace
+	// registry used by read repair, only series of namespaces with cold writes
+	// enabled are repaired since the datapoints written back are historical.
+	ReadRepairNamespaceInitializer() namespace.Initializer
+
+	// SetHintedHandoffEnabled sets whether writes that fail against a replica
+	// are spooled and replayed against it once it is reachable again.
+	SetHintedHandoffEnabled(value bool) Options
+
+	// HintedHandoffEnabled returns whether writes that fail against a replica
+	// are spooled and replayed against it once it is reachable again.
+	HintedHandoffEnabled() bool
+
+	// SetHintedHandoffMaxHintsPerHost sets the maximum number of writes
+	// spooled for a single replica, writes beyond this are dropped.
+	SetHintedHandoffMaxHintsPerHost(value int) Options
+
+	// HintedHandoffMaxHintsPerHost returns the maximum number of writes
+	// spooled for a single replica, writes beyond this are dropped.
+	HintedHandoffMaxHintsPerHost() int
+
+	// SetHintedHandoffMaxHintAge sets the maximum age of a spooled write,
+	// older writes are dropped rather than replayed.
+	SetHintedHandoffMaxHintAge(value time.Duration) Options
+
+	// HintedHandoffMaxHintAge returns the maximum age of a spooled write,
+	// older writes are dropped rather than replayed.
+	HintedHandoffMaxHintAge() time.Duration
+
+	// SetHintedHandoffReplayInterval sets the interval at which replicas with
+	// spooled writes are checked for being reachable again.
+	SetHintedHandoffReplayInterval(value time.Duration) Options
+
+	// HintedHandoffReplayInterval returns the interval at which replicas with
+	// spooled writes are checked for being reachable again.
+	HintedHandoffReplayInterval() time.Duration
+
+	// SetTransportType sets the transport used to communicate with nodes.
+	SetTransportType(value TransportType) Options
+
+	// TransportType returns the transport used to communicate with nodes.
+	TransportType() TransportType
+
+	// SetGRPCPort sets the port nodes serve gRPC on when using the gRPC
+	// transport for nodes that do not advertise a gRPC port in the placement,
+	// the host is taken from the topology address of each node.
+	SetGRPCPort(value int) Options
+
+	// GRPCPort returns the port nodes serve gRPC on when using the gRPC
+	// transport for nodes that do not advertise a gRPC port in the placement,
+	// the host is taken from the topology address of each node.
+	GRPCPort() int
+
+	// SetGRPCMaxMsgSize sets the maximum size of messages sent to and
+	// received from nodes when using the gRPC transport.
+	SetGRPCMaxMsgSize(value int) Options
+
+	// GRPCMaxMsgSize returns the maximum size of messages sent to and
+	// received from nodes when using the gRPC transport.
+	GRPCMaxMsgSize() int
+
+	// SetGRPCTransportCredentials sets the transport credentials used to
+	// connect to nodes when using the gRPC transport, connections are
+	// insecure when nil.
+	SetGRPCTransportCredentials(value credentials.TransportCredentials) Options
+
+	// GRPCTransportCredentials returns the transport credentials used to
+	// connect to nodes when using the gRPC transport, connections are
+	// insecure when nil.
+	GRPCTransportCredentials() credentials.TransportCredentials
+
+	// SetAdaptiveWriteBatchEnabled sets whether write batches are sized from
+	// the observed latency of write requests to each host, the write batch
+	// size is used as the maximum batch size when enabled.
+	SetAdaptiveWriteBatchEnabled(value bool) Options
+
+	// AdaptiveWriteBatchEnabled returns whether write batches are sized from
+	// the observed latency of write requests to each host.
+	AdaptiveWriteBatchEnabled() bool
+
+	// SetAdaptiveWriteBatchTargetLatency sets the write request latency that
+	// adaptive write batches are sized to stay within.
+	SetAdaptiveWriteBatchTargetLatency(value time.Duration) Options
+
+	// AdaptiveWriteBatchTargetLatency returns the write request latency that
+	// adaptive write batches are sized to stay within.
+	AdaptiveWriteBatchTargetLatency() time.Duration
+
+	// SetAdaptiveWriteBatchMinSize sets the minimum size of adaptive write batches.
+	SetAdaptiveWriteBatchMinSize(value int) Options
+
+	// AdaptiveWriteBatchMinSize returns the minimum size of adaptive write batches.
+	AdaptiveWriteBatchMinSize() int
+
+	// SetHostQueueSaturationThreshold sets the number of writes pending to a
+	// host at which its queue is considered saturated.
+	SetHostQueueSaturationThreshold(value int) Options
+
+	// HostQueueSaturationThreshold returns the number of writes pending to a
+	// host at which its queue is considered saturated.
+	HostQueueSaturationThreshold() int
+
+	// SetHostQueueSaturationFn sets the function called when a host queue
+	// becomes saturated or is no longer saturated.
+	SetHostQueueSaturationFn(value HostQueueSaturationFn) Options
+
+	// HostQueueSaturationFn returns the function called when a host queue
+	// becomes saturated or is no longer saturated.
+	HostQueueSaturationFn() HostQueueSaturationFn
+
+	// SetHedgedReadsEnabled sets whether fetch tagged requests at read
+	// consistency level one or unstrict majority are first sent to only
+	// enough replicas to satisfy the consistency level and hedged to the
+	// remaining replicas if slow to respond.
+	SetHedgedReadsEnabled(value bool) Options
+
+	// HedgedReadsEnabled returns whether fetch tagged requests at read
+	// consistency level one or unstrict majority are first sent to only
+	// enough replicas to satisfy the consistency level and hedged to the
+	// remaining replicas if slow to respond.
+	HedgedReadsEnabled() bool
+
+	// SetHedgedReadsDelayPercentile sets the percentile of recent fetch
+	// tagged response latencies after which a fetch is hedged.
+	SetHedgedReadsDelayPercentile(value float64) Options
+
+	// HedgedReadsDelayPercentile returns the percentile of recent fetch
+	// tagged response latencies after which a fetch is hedged.
+	HedgedReadsDelayPercentile() float64
+
+	// SetHedgedReadsMinDelay sets the minimum delay after which a fetch is hedged.
+	SetHedgedReadsMinDelay(value time.Duration) Options
+
+	// HedgedReadsMinDelay returns the minimum delay after which a fetch is hedged.
+	HedgedReadsMinDelay() time.Duration
+
+	// SetPreferredReadIsolationGroup sets the isolation group, such as the
+	// zone or rack of the client, whose replicas fetch tagged requests at read
+	// consistency level one or unstrict majority are first sent to, falling
+	// back to replicas in other isolation groups only when needed.
+	SetPreferredReadIsolationGroup(value string) Options
+
+	// PreferredReadIsolationGroup returns the isolation group, such as the
+	// zone or rack of the client, whose replicas fetch tagged requests at read
+	// consistency level one or unstrict majority are first sent to, falling
+	// back to replicas in other isolation groups only when needed.
+	PreferredReadIsolationGroup() string
 }
 
 // AdminOptions is a set of administration client options.
@@ -655,6 +934,9 @@ type hostQueue interface {
 	// BorrowConnection will borrow a connection and execute a user function.
 	BorrowConnection(fn withConnectionFn) error
 
+	// Saturation returns the saturation of the writes pending to the host.
+	Saturation() HostQueueSaturation
+
 	// Close the host queue, will flush any operations still pending.
 	Close()
 }
@@ -21,6 +21,7 @@
 package client
 
 import (
+	stdctx "context"
 	"time"
 
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -46,10 +47,12 @@ type writeAttempt struct {
 
 	session *session
 
-	attemptFn xretry.Fn
+	attemptFn  xretry.Fn
+	continueFn xretry.ContinueFn
 }
 
 type writeAttemptArgs struct {
+	ctx         stdctx.Context
 	namespace   ident.ID
 	id          ident.ID
 	tags        ident.TagIterator
@@ -64,13 +67,17 @@ func (w *writeAttempt) reset() {
 	w.args = writeAttemptArgsZeroed
 }
 
+func (w *writeAttempt) shouldContinue(attempt int) bool {
+	return w.args.ctx.Err() == nil
+}
+
 func (w *writeAttempt) perform() error {
-	err := w.session.writeAttempt(w.args.attemptType,
+	err := w.session.writeAttempt(w.args.ctx, w.args.attemptType,
 		w.args.namespace, w.args.id, w.args.tags, w.args.t,
 		w.args.value, w.args.unit, w.args.annotation)
 
-	if IsBadRequestError(err) {
-		// Do not retry bad request errors
+	if IsBadRequestError(err) || IsResourceExhaustedError(err) {
+		// Do not retry bad request or resource exhausted errors
 		err = xerrors.NewNonRetryableError(err)
 	}
 
@@ -96,6 +103,7 @@ func (p *writeAttemptPool) Init() {
 		// NB(r): Bind attemptFn once to avoid creating receiver
 		// and function method pointer over and over again
 		w.attemptFn = w.perform
+		w.continueFn = w.shouldContinue
 		w.reset()
 		return w
 	})
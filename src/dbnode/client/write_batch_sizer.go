@@ -0,0 +1,124 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// writeBatchLatencyAlpha is the weight given to the latest observation
+	// in the moving average of write request latency.
+	writeBatchLatencyAlpha = 0.2
+
+	// writeBatchDecreaseFactor is the factor write batches are shrunk by
+	// when write requests are slower than the target latency.
+	writeBatchDecreaseFactor = 0.75
+
+	// writeBatchIncreaseDivisor determines the increment write batches are
+	// grown by as a fraction of the maximum write batch size.
+	writeBatchIncreaseDivisor = 16
+)
+
+// writeBatchSizer sizes the write batches issued to a single host from the
+// observed latency of write requests to it. When adaptive, batches are grown
+// additively while the moving average latency is within the target latency
+// and shrunk multiplicatively once it exceeds it so that a host under load
+// receives smaller requests. The moving average latency is tracked regardless
+// to report host queue saturation.
+type writeBatchSizer struct {
+	sync.Mutex
+
+	adaptive      bool
+	minSize       int
+	maxSize       int
+	increment     int
+	targetLatency time.Duration
+
+	size    int64
+	latency int64
+}
+
+func newWriteBatchSizer(opts Options) *writeBatchSizer {
+	maxSize := opts.WriteBatchSize()
+	minSize := opts.AdaptiveWriteBatchMinSize()
+	if minSize > maxSize {
+		minSize = maxSize
+	}
+	increment := maxSize / writeBatchIncreaseDivisor
+	if increment < 1 {
+		increment = 1
+	}
+	return &writeBatchSizer{
+		adaptive:      opts.AdaptiveWriteBatchEnabled(),
+		minSize:       minSize,
+		maxSize:       maxSize,
+		increment:     increment,
+		targetLatency: opts.AdaptiveWriteBatchTargetLatency(),
+		size:          int64(maxSize),
+	}
+}
+
+// BatchSize returns the number of writes at which a batch should be issued.
+func (s *writeBatchSizer) BatchSize() int {
+	return int(atomic.LoadInt64(&s.size))
+}
+
+// Latency returns the moving average latency of write requests.
+func (s *writeBatchSizer) Latency() time.Duration {
+	return time.Duration(atomic.LoadInt64(&s.latency))
+}
+
+// RecordLatency records the latency of a write request and resizes batches
+// if adaptive.
+func (s *writeBatchSizer) RecordLatency(latency time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+
+	avg := time.Duration(atomic.LoadInt64(&s.latency))
+	if avg == 0 {
+		avg = latency
+	} else {
+		avg = time.Duration(writeBatchLatencyAlpha*float64(latency) +
+			(1-writeBatchLatencyAlpha)*float64(avg))
+	}
+	atomic.StoreInt64(&s.latency, int64(avg))
+
+	if !s.adaptive {
+		return
+	}
+
+	size := int(atomic.LoadInt64(&s.size))
+	if avg > s.targetLatency {
+		size = int(float64(size) * writeBatchDecreaseFactor)
+	} else {
+		size += s.increment
+	}
+	if size < s.minSize {
+		size = s.minSize
+	}
+	if size > s.maxSize {
+		size = s.maxSize
+	}
+	atomic.StoreInt64(&s.size, int64(size))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteBatchSizerAdaptive(t *testing.T) {
+	opts := newSessionTestOptions().
+		SetWriteBatchSize(64).
+		SetAdaptiveWriteBatchEnabled(true).
+		SetAdaptiveWriteBatchTargetLatency(10 * time.Millisecond).
+		SetAdaptiveWriteBatchMinSize(8)
+	sizer := newWriteBatchSizer(opts)
+	assert.Equal(t, 64, sizer.BatchSize())
+
+	// Slow requests shrink batches down to the minimum size.
+	for i := 0; i < 20; i++ {
+		sizer.RecordLatency(100 * time.Millisecond)
+	}
+	assert.Equal(t, 8, sizer.BatchSize())
+	assert.True(t, sizer.Latency() > 10*time.Millisecond)
+
+	// Fast requests grow batches back up to the maximum size.
+	for i := 0; i < 100; i++ {
+		sizer.RecordLatency(time.Millisecond)
+	}
+	assert.Equal(t, 64, sizer.BatchSize())
+	assert.True(t, sizer.Latency() < 10*time.Millisecond)
+}
+
+func TestWriteBatchSizerNotAdaptive(t *testing.T) {
+	opts := newSessionTestOptions().
+		SetWriteBatchSize(64).
+		SetAdaptiveWriteBatchTargetLatency(10 * time.Millisecond)
+	sizer := newWriteBatchSizer(opts)
+
+	for i := 0; i < 20; i++ {
+		sizer.RecordLatency(100 * time.Millisecond)
+	}
+	assert.Equal(t, 64, sizer.BatchSize())
+	assert.Equal(t, 100*time.Millisecond, sizer.Latency())
+}
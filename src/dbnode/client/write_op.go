@@ -34,6 +34,8 @@ var (
 )
 
 type writeOperation struct {
+	opContext
+
 	namespace    ident.ID
 	shardID      uint32
 	request      rpc.WriteBatchRawRequestElement
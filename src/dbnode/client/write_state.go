@@ -21,6 +21,7 @@
 package client
 
 import (
+	stdctx "context"
 	"fmt"
 	"sync"
 
@@ -58,7 +59,14 @@ type writeState struct {
 	success           int32
 	errors            []error
 
+	// ctxErr is set if the context of the caller is done before the write
+	// satisfied its consistency level, watchStopCh is closed once the caller
+	// stops waiting on the write.
+	ctxErr      error
+	watchStopCh chan struct{}
+
 	queues         []hostQueue
+	hintedHandoff  *hintedHandoff
 	tagEncoderPool serialize.TagEncoderPool
 	pool           *writeStatePool
 }
@@ -88,6 +96,8 @@ func (w *writeState) close() {
 
 	w.op, w.majority, w.pending, w.success = nil, 0, 0, 0
 	w.nsID, w.tsID, w.tagEncoder = nil, nil, nil
+	w.hintedHandoff = nil
+	w.ctxErr, w.watchStopCh = nil, nil
 
 	for i := range w.errors {
 		w.errors[i] = nil
@@ -105,6 +115,39 @@ func (w *writeState) close() {
 	w.pool.Put(w)
 }
 
+// watchContextWithLock wakes the caller waiting on the write once the context
+// is done, so that it stops waiting on hosts that have yet to respond.
+func (w *writeState) watchContextWithLock(ctx stdctx.Context) {
+	if ctx == nil || ctx.Done() == nil {
+		return
+	}
+	w.incRef() // released once the watch returns
+	w.watchStopCh = make(chan struct{})
+	go w.watchContext(ctx, w.watchStopCh)
+}
+
+func (w *writeState) watchContext(ctx stdctx.Context, stopCh chan struct{}) {
+	select {
+	case <-ctx.Done():
+		w.Lock()
+		if w.watchStopCh == stopCh {
+			// Still being waited on.
+			w.ctxErr = ctx.Err()
+			w.Signal()
+		}
+		w.Unlock()
+	case <-stopCh:
+	}
+	w.decRef() // release ref held onto by the watch
+}
+
+func (w *writeState) stopContextWatchWithLock() {
+	if w.watchStopCh != nil {
+		close(w.watchStopCh)
+		w.watchStopCh = nil
+	}
+}
+
 func (w *writeState) completionFn(result interface{}, err error) {
 	hostID := result.(topology.Host).ID()
 	// NB(bl) panic on invalid result, it indicates a bug in the code
@@ -116,6 +159,10 @@ func (w *writeState) completionFn(result interface{}, err error) {
 
 	if err != nil {
 		wErr = xerrors.NewRenamedError(err, fmt.Errorf("error writing to host %s: %v", hostID, err))
+		if w.hintedHandoff != nil && isHintableError(err) {
+			// Spool the write to be replayed once the host is reachable again.
+			w.hintedHandoff.Hint(hostID, w.op)
+		}
 	} else if hostShardSet, ok := w.topoMap.LookupHostShardSet(hostID); !ok {
 		errStr := "missing host shard in writeState completionFn: %s"
 		wErr = xerrors.NewRetryableError(fmt.Errorf(errStr, hostID))
@@ -152,9 +152,11 @@ func setShardStates(t *testing.T, s *session, host topology.Host, state shard.St
 
 type fakeHost struct{ id string }
 
-func (f fakeHost) ID() string      { return f.id }
-func (f fakeHost) Address() string { return "" }
-func (f fakeHost) String() string  { return "" }
+func (f fakeHost) ID() string             { return f.id }
+func (f fakeHost) Address() string        { return "" }
+func (f fakeHost) IsolationGroup() string { return "" }
+func (f fakeHost) GRPCPort() int          { return 0 }
+func (f fakeHost) String() string         { return "" }
 
 func writeTestSetup(t *testing.T, writeWg *sync.WaitGroup) (*writeState, *session, topology.Host) {
 	ctrl := gomock.NewController(t)
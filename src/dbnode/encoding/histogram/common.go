@@ -0,0 +1,79 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"math"
+
+	"github.com/m3db/m3/src/x/checked"
+)
+
+const (
+	// streamMagic is the first byte of every histogram encoded stream. M3TSZ
+	// streams begin with the block start as a non-negative 64 bit integer
+	// so their first byte never has the high bit set, which allows readers
+	// to tell histogram streams apart from M3TSZ streams.
+	streamMagic byte = 0xff
+
+	currentEncodingSchemeVersion = 1
+
+	// maxNumBuckets is the maximum number of buckets a single histogram
+	// can have, it exists so that in the case of data / memory corruption
+	// the iterator returns an error instead of allocating a massive slice.
+	maxNumBuckets = 1 << 16
+)
+
+const (
+	// Single bit op codes that get encoded into the compressed stream and
+	// inform the iterator how it should interpret subsequent bits.
+	opCodeNoMoreDataOrTimeUnitChange = 0
+	opCodeMoreData                   = 1
+
+	opCodeNoMoreData     = 0
+	opCodeTimeUnitChange = 1
+
+	opCodeBucketsUnchanged = 0
+	opCodeBucketsChanged   = 1
+)
+
+// tails is a list of all possible tails based on the
+// byte value of the last byte. For the histogram encoder
+// they are all the same.
+var tails [256]checked.Bytes
+
+func init() {
+	for i := 0; i < 256; i++ {
+		tails[i] = checked.NewBytes([]byte{byte(i)}, nil)
+	}
+}
+
+func bucketBoundsEqual(bounds []float64, buckets []Bucket) bool {
+	if len(bounds) != len(buckets) {
+		return false
+	}
+	for i, b := range buckets {
+		// Compare bits rather than values so that the stream round trips exactly.
+		if math.Float64bits(bounds[i]) != math.Float64bits(b.UpperBound) {
+			return false
+		}
+	}
+	return true
+}
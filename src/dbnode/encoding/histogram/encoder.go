@@ -0,0 +1,309 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// Make sure encoder implements encoding.Encoder.
+var _ encoding.Encoder = &encoder{}
+
+var (
+	encErrPrefix           = "histogram encoder:"
+	errEncoderClosed       = fmt.Errorf("%s encoder is closed", encErrPrefix)
+	errNoEncodedDatapoints = fmt.Errorf("%s encoder has no encoded datapoints", encErrPrefix)
+)
+
+type encoder struct {
+	opts encoding.Options
+
+	stream           encoding.OStream
+	timestampEncoder m3tsz.TimestampEncoder
+	sumEncoder       m3tsz.FloatEncoderAndIterator
+
+	numEncoded     int
+	lastEncodedDP  ts.Datapoint
+	prevAnnotation ts.Annotation
+
+	prevCount        uint64
+	prevBucketBounds []float64
+	prevBucketCounts []uint64
+
+	// Fields that are reused between function calls to
+	// avoid allocations.
+	varIntBuf [binary.MaxVarintLen64]byte
+	curr      Histogram
+
+	closed bool
+}
+
+// NewEncoder creates a new histogram encoder.
+func NewEncoder(start time.Time, opts encoding.Options) encoding.Encoder {
+	initAllocIfEmpty := opts.EncoderPool() == nil
+	stream := encoding.NewOStream(nil, initAllocIfEmpty, opts.BytesPool())
+	return &encoder{
+		opts:   opts,
+		stream: stream,
+		timestampEncoder: m3tsz.NewTimestampEncoder(
+			start, opts.DefaultTimeUnit(), opts),
+	}
+}
+
+// SetSchema is a no-op since the histogram encoder is not schema aware.
+func (enc *encoder) SetSchema(descr namespace.SchemaDescr) {}
+
+// Encode encodes a timestamp and a histogram. The function signature is strange
+// in order to implement the encoding.Encoder interface. It accepts a ts.Datapoint,
+// but only the Timestamp field will be used, the Value field will be ignored and
+// subsequent iteration will return the histogram count as the value. The provided
+// annotation is expected to be a histogram marshalled with MarshalAppend.
+func (enc *encoder) Encode(dp ts.Datapoint, timeUnit xtime.Unit, annotation ts.Annotation) error {
+	if enc.closed {
+		return errEncoderClosed
+	}
+
+	// Unmarshal and validate before any data is written so that errors can't
+	// be encountered mid-write leaving the stream in a corrupted state.
+	if err := Unmarshal(annotation, &enc.curr); err != nil {
+		return fmt.Errorf("%s error unmarshalling histogram: %v", encErrPrefix, err)
+	}
+	if err := enc.curr.Validate(); err != nil {
+		return fmt.Errorf("%s invalid histogram: %v", encErrPrefix, err)
+	}
+	if len(enc.curr.Buckets) > maxNumBuckets {
+		return fmt.Errorf("%s histogram has %d buckets but maximum allowed is %d",
+			encErrPrefix, len(enc.curr.Buckets), maxNumBuckets)
+	}
+
+	if enc.numEncoded == 0 {
+		enc.stream.WriteByte(streamMagic)
+		enc.encodeVarInt(currentEncodingSchemeVersion)
+	}
+
+	if timeUnit != enc.timestampEncoder.TimeUnit {
+		// First bit means either there is no more data OR the time unit has changed,
+		// the next bit means there is more data but the time unit has changed.
+		enc.stream.WriteBit(opCodeNoMoreDataOrTimeUnitChange)
+		enc.stream.WriteBit(opCodeTimeUnitChange)
+		// Similar to the proto encoder, the time unit change is written manually
+		// instead of relying on the M3TSZ marker encoding scheme since the
+		// histogram values could legitimately match the markers.
+		enc.timestampEncoder.WriteTimeUnit(enc.stream, timeUnit)
+	} else {
+		enc.stream.WriteBit(opCodeMoreData)
+	}
+
+	err := enc.timestampEncoder.WriteTime(enc.stream, dp.Timestamp, nil, timeUnit)
+	if err != nil {
+		return fmt.Errorf("%s error encoding timestamp: %v", encErrPrefix, err)
+	}
+
+	enc.encodeHistogram(enc.curr)
+
+	dp.Value = float64(enc.curr.Count)
+	enc.numEncoded++
+	enc.lastEncodedDP = dp
+	enc.prevAnnotation = annotation
+	return nil
+}
+
+func (enc *encoder) encodeHistogram(h Histogram) {
+	// Counts are mostly monotonically increasing so deltas are encoded.
+	enc.encodeSignedVarInt(int64(h.Count - enc.prevCount))
+	enc.prevCount = h.Count
+
+	enc.sumEncoder.WriteFloat(enc.stream, h.Sum)
+
+	if bucketBoundsEqual(enc.prevBucketBounds, h.Buckets) {
+		enc.stream.WriteBit(opCodeBucketsUnchanged)
+	} else {
+		enc.stream.WriteBit(opCodeBucketsChanged)
+		enc.encodeVarInt(uint64(len(h.Buckets)))
+
+		enc.prevBucketBounds = enc.prevBucketBounds[:0]
+		enc.prevBucketCounts = enc.prevBucketCounts[:0]
+		for _, b := range h.Buckets {
+			enc.stream.WriteBits(math.Float64bits(b.UpperBound), 64)
+			enc.prevBucketBounds = append(enc.prevBucketBounds, b.UpperBound)
+			enc.prevBucketCounts = append(enc.prevBucketCounts, 0)
+		}
+	}
+
+	for i, b := range h.Buckets {
+		enc.encodeSignedVarInt(int64(b.Count - enc.prevBucketCounts[i]))
+		enc.prevBucketCounts[i] = b.Count
+	}
+}
+
+func (enc *encoder) Stream(ctx context.Context) (xio.SegmentReader, bool) {
+	seg := enc.segmentZeroCopy(ctx)
+	if seg.Len() == 0 {
+		return nil, false
+	}
+
+	if readerPool := enc.opts.SegmentReaderPool(); readerPool != nil {
+		reader := readerPool.Get()
+		reader.Reset(seg)
+		return reader, true
+	}
+	return xio.NewSegmentReader(seg), true
+}
+
+func (enc *encoder) segmentZeroCopy(ctx context.Context) ts.Segment {
+	length := enc.stream.Len()
+	if length == 0 {
+		return ts.Segment{}
+	}
+
+	// We need a tail to capture an immutable snapshot of the encoder data
+	// as the last byte can change after this method returns.
+	rawBuffer, _ := enc.stream.Rawbytes()
+	lastByte := rawBuffer[length-1]
+
+	// Take ref up to last byte.
+	headBytes := rawBuffer[:length-1]
+
+	// Zero copy from the output stream.
+	var head checked.Bytes
+	if pool := enc.opts.CheckedBytesWrapperPool(); pool != nil {
+		head = pool.Get(headBytes)
+	} else {
+		head = checked.NewBytes(headBytes, nil)
+	}
+
+	// Make sure the ostream bytes ref is delayed from finalizing
+	// until this operation is complete (since this is zero copy).
+	buffer, _ := enc.stream.CheckedBytes()
+	ctx.RegisterCloser(buffer.DelayFinalizer())
+
+	// Take a shared ref to a known good tail.
+	tail := tails[lastByte]
+
+	// Only discard the head since tails are shared for process life time.
+	return ts.NewSegment(head, tail, 0, ts.FinalizeHead)
+}
+
+func (enc *encoder) segmentTakeOwnership() ts.Segment {
+	if enc.stream.Len() == 0 {
+		return ts.Segment{}
+	}
+
+	// Take ref from the ostream.
+	head := enc.stream.Discard()
+
+	return ts.NewSegment(head, nil, 0, ts.FinalizeHead)
+}
+
+func (enc *encoder) NumEncoded() int {
+	return enc.numEncoded
+}
+
+func (enc *encoder) LastEncoded() (ts.Datapoint, error) {
+	if enc.closed {
+		return ts.Datapoint{}, errEncoderClosed
+	}
+	if enc.numEncoded == 0 {
+		return ts.Datapoint{}, errNoEncodedDatapoints
+	}
+	return enc.lastEncodedDP, nil
+}
+
+func (enc *encoder) LastAnnotation() (ts.Annotation, error) {
+	if enc.numEncoded == 0 {
+		return nil, errNoEncodedDatapoints
+	}
+	return enc.prevAnnotation, nil
+}
+
+func (enc *encoder) Len() int {
+	return enc.stream.Len()
+}
+
+func (enc *encoder) Reset(start time.Time, capacity int, schema namespace.SchemaDescr) {
+	enc.stream.Reset(enc.newBuffer(capacity))
+	enc.timestampEncoder = m3tsz.NewTimestampEncoder(
+		start, enc.opts.DefaultTimeUnit(), enc.opts)
+	enc.sumEncoder = m3tsz.FloatEncoderAndIterator{}
+	enc.lastEncodedDP = ts.Datapoint{}
+	enc.prevAnnotation = nil
+	enc.prevCount = 0
+	enc.prevBucketBounds = enc.prevBucketBounds[:0]
+	enc.prevBucketCounts = enc.prevBucketCounts[:0]
+	enc.numEncoded = 0
+	enc.closed = false
+}
+
+func (enc *encoder) Close() {
+	if enc.closed {
+		return
+	}
+
+	enc.Reset(time.Time{}, 0, nil)
+	enc.stream.Reset(nil)
+	enc.closed = true
+
+	if pool := enc.opts.EncoderPool(); pool != nil {
+		pool.Put(enc)
+	}
+}
+
+func (enc *encoder) Discard() ts.Segment {
+	segment := enc.segmentTakeOwnership()
+	// Close the encoder since its no longer needed.
+	enc.Close()
+	return segment
+}
+
+func (enc *encoder) DiscardReset(start time.Time, capacity int, schema namespace.SchemaDescr) ts.Segment {
+	segment := enc.segmentTakeOwnership()
+	enc.Reset(start, capacity, schema)
+	return segment
+}
+
+func (enc *encoder) encodeVarInt(x uint64) {
+	n := binary.PutUvarint(enc.varIntBuf[:], x)
+	enc.stream.WriteBytes(enc.varIntBuf[:n])
+}
+
+func (enc *encoder) encodeSignedVarInt(x int64) {
+	n := binary.PutVarint(enc.varIntBuf[:], x)
+	enc.stream.WriteBytes(enc.varIntBuf[:n])
+}
+
+func (enc *encoder) newBuffer(capacity int) checked.Bytes {
+	if bytesPool := enc.opts.BytesPool(); bytesPool != nil {
+		return bytesPool.Get(capacity)
+	}
+	return checked.NewBytes(make([]byte, 0, capacity), nil)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"io"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// Make sure fallbackReaderIterator implements encoding.ReaderIterator.
+var _ encoding.ReaderIterator = &fallbackReaderIterator{}
+
+type fallbackReaderIterator struct {
+	opts      encoding.Options
+	reader    peekReader
+	histogram encoding.ReaderIterator
+	fallback  encoding.ReaderIterator
+	curr      encoding.ReaderIterator
+	err       error
+	closed    bool
+}
+
+// NewReaderIteratorWithFallback returns an iterator that reads histogram
+// encoded streams with the histogram iterator and any other stream with
+// the fallback iterator, which allows a single iterator pool to read series
+// of namespaces with and without native histograms enabled. The iterator
+// takes ownership of the fallback iterator, which must not be pooled.
+func NewReaderIteratorWithFallback(
+	reader io.Reader,
+	fallback encoding.ReaderIterator,
+	opts encoding.Options,
+) encoding.ReaderIterator {
+	it := &fallbackReaderIterator{
+		opts:      opts,
+		histogram: NewReaderIterator(nil, opts.SetReaderIteratorPool(nil)),
+		fallback:  fallback,
+	}
+	it.Reset(reader, nil)
+	return it
+}
+
+func (it *fallbackReaderIterator) Next() bool {
+	if it.err != nil || it.closed {
+		return false
+	}
+	return it.curr.Next()
+}
+
+func (it *fallbackReaderIterator) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	return it.curr.Current()
+}
+
+func (it *fallbackReaderIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.curr.Err()
+}
+
+func (it *fallbackReaderIterator) Reset(reader io.Reader, schema namespace.SchemaDescr) {
+	it.err = nil
+	it.closed = false
+	it.curr = it.fallback
+	if reader == nil {
+		it.reader.Reset(nil)
+		it.histogram.Reset(nil, schema)
+		it.fallback.Reset(nil, schema)
+		return
+	}
+
+	first, err := it.reader.Reset(reader)
+	if err != nil && err != io.EOF {
+		it.err = err
+		return
+	}
+	if err == nil && first == streamMagic {
+		it.curr = it.histogram
+	}
+	it.curr.Reset(&it.reader, schema)
+}
+
+func (it *fallbackReaderIterator) Close() {
+	if it.closed {
+		return
+	}
+
+	it.Reset(nil, nil)
+	it.closed = true
+
+	if pool := it.opts.ReaderIteratorPool(); pool != nil {
+		pool.Put(it)
+	}
+}
+
+// peekReader is a reader that reads the first byte of the underlying reader
+// ahead so that it can be inspected before the stream is read.
+type peekReader struct {
+	reader io.Reader
+	first  [1]byte
+	peeked bool
+}
+
+// Reset resets the reader to read from a new reader and returns the first
+// byte of the new reader.
+func (r *peekReader) Reset(reader io.Reader) (byte, error) {
+	r.reader = reader
+	r.peeked = false
+	if reader == nil {
+		return 0, nil
+	}
+
+	if _, err := io.ReadFull(reader, r.first[:]); err != nil {
+		return 0, err
+	}
+	r.peeked = true
+	return r.first[0], nil
+}
+
+func (r *peekReader) Read(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+	if r.peeked {
+		b[0] = r.first[0]
+		r.peeked = false
+		return 1, nil
+	}
+	if r.reader == nil {
+		return 0, io.EOF
+	}
+	return r.reader.Read(b)
+}
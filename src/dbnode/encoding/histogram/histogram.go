@@ -0,0 +1,183 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package histogram implements a histogram aware encoding scheme that stores
+// the sparse bucket counts, sum and count of each histogram datapoint.
+package histogram
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	annotationVersion = 1
+)
+
+var (
+	// annotationMagic is the prefix of every marshalled histogram so that
+	// histogram annotations can be told apart from arbitrary annotations.
+	annotationMagic = []byte("M3HG")
+
+	annotationHeaderLen = len(annotationMagic) + 1
+
+	errAnnotationTooShort  = errors.New("histogram annotation too short")
+	errAnnotationNoMagic   = errors.New("histogram annotation missing magic prefix")
+	errAnnotationTruncated = errors.New("histogram annotation truncated")
+)
+
+// Bucket is a single bucket of a sparse histogram.
+type Bucket struct {
+	// UpperBound is the inclusive upper bound of the bucket.
+	UpperBound float64
+	// Count is the number of observations that fell into the bucket, it is
+	// not cumulative with the counts of the buckets preceding it.
+	Count uint64
+}
+
+// Histogram is a single histogram datapoint. Buckets are sparse, that is
+// buckets without any observations can be omitted, and observations above the
+// largest bucket upper bound are only accounted for in the total count.
+type Histogram struct {
+	// Count is the total number of observations.
+	Count uint64
+	// Sum is the sum of all observations.
+	Sum float64
+	// Buckets are the non-empty buckets ordered by increasing upper bound.
+	Buckets []Bucket
+}
+
+// Validate validates the histogram.
+func (h Histogram) Validate() error {
+	var bucketsCount uint64
+	for i, b := range h.Buckets {
+		if math.IsNaN(b.UpperBound) {
+			return fmt.Errorf("histogram bucket %d has NaN upper bound", i)
+		}
+		if i > 0 && b.UpperBound <= h.Buckets[i-1].UpperBound {
+			return fmt.Errorf(
+				"histogram bucket %d upper bound %v not greater than previous upper bound %v",
+				i, b.UpperBound, h.Buckets[i-1].UpperBound)
+		}
+		bucketsCount += b.Count
+	}
+	if bucketsCount > h.Count {
+		return fmt.Errorf(
+			"histogram bucket counts total %d exceeds histogram count %d",
+			bucketsCount, h.Count)
+	}
+	return nil
+}
+
+// MarshalAppend appends the marshalled histogram to the given buffer, the
+// result can be used as the annotation of a datapoint written to a histogram
+// encoded series.
+func (h Histogram) MarshalAppend(buf []byte) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+
+	buf = append(buf, annotationMagic...)
+	buf = append(buf, annotationVersion)
+
+	n := binary.PutUvarint(scratch[:], h.Count)
+	buf = append(buf, scratch[:n]...)
+
+	binary.LittleEndian.PutUint64(scratch[:8], math.Float64bits(h.Sum))
+	buf = append(buf, scratch[:8]...)
+
+	n = binary.PutUvarint(scratch[:], uint64(len(h.Buckets)))
+	buf = append(buf, scratch[:n]...)
+	for _, b := range h.Buckets {
+		binary.LittleEndian.PutUint64(scratch[:8], math.Float64bits(b.UpperBound))
+		buf = append(buf, scratch[:8]...)
+
+		n = binary.PutUvarint(scratch[:], b.Count)
+		buf = append(buf, scratch[:n]...)
+	}
+	return buf
+}
+
+// IsHistogramAnnotation returns whether the annotation is a marshalled histogram.
+func IsHistogramAnnotation(b []byte) bool {
+	return len(b) >= annotationHeaderLen && bytes.HasPrefix(b, annotationMagic)
+}
+
+// Unmarshal unmarshals a histogram from the given bytes into the provided
+// histogram, reusing the capacity of its buckets.
+func Unmarshal(b []byte, h *Histogram) error {
+	if len(b) < annotationHeaderLen {
+		return errAnnotationTooShort
+	}
+	if !bytes.HasPrefix(b, annotationMagic) {
+		return errAnnotationNoMagic
+	}
+	if v := b[len(annotationMagic)]; v != annotationVersion {
+		return fmt.Errorf("histogram annotation version %d unsupported", v)
+	}
+	b = b[annotationHeaderLen:]
+
+	count, n := binary.Uvarint(b)
+	if n <= 0 {
+		return errAnnotationTruncated
+	}
+	b = b[n:]
+
+	if len(b) < 8 {
+		return errAnnotationTruncated
+	}
+	sum := math.Float64frombits(binary.LittleEndian.Uint64(b))
+	b = b[8:]
+
+	numBuckets, n := binary.Uvarint(b)
+	if n <= 0 {
+		return errAnnotationTruncated
+	}
+	b = b[n:]
+
+	// Each bucket requires at least nine bytes, check upfront so that a
+	// corrupt annotation can't cause a massive allocation.
+	if numBuckets > uint64(len(b)/9) {
+		return errAnnotationTruncated
+	}
+
+	buckets := h.Buckets[:0]
+	for i := uint64(0); i < numBuckets; i++ {
+		if len(b) < 8 {
+			return errAnnotationTruncated
+		}
+		upperBound := math.Float64frombits(binary.LittleEndian.Uint64(b))
+		b = b[8:]
+
+		bucketCount, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errAnnotationTruncated
+		}
+		b = b[n:]
+
+		buckets = append(buckets, Bucket{UpperBound: upperBound, Count: bucketCount})
+	}
+
+	h.Count = count
+	h.Sum = sum
+	h.Buckets = buckets
+	return nil
+}
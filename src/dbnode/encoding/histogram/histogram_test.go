@@ -0,0 +1,93 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramMarshalRoundTrip(t *testing.T) {
+	h := Histogram{
+		Count: 12,
+		Sum:   42.5,
+		Buckets: []Bucket{
+			{UpperBound: 0.1, Count: 3},
+			{UpperBound: 1, Count: 5},
+			{UpperBound: 10, Count: 2},
+		},
+	}
+
+	b := h.MarshalAppend(nil)
+	require.True(t, IsHistogramAnnotation(b))
+
+	var decoded Histogram
+	require.NoError(t, Unmarshal(b, &decoded))
+	require.Equal(t, h, decoded)
+
+	// Unmarshalling an empty histogram should reuse and truncate the buckets.
+	require.NoError(t, Unmarshal(Histogram{}.MarshalAppend(nil), &decoded))
+	require.Equal(t, uint64(0), decoded.Count)
+	require.Equal(t, 0, len(decoded.Buckets))
+}
+
+func TestHistogramUnmarshalInvalid(t *testing.T) {
+	var h Histogram
+	require.Error(t, Unmarshal(nil, &h))
+	require.Error(t, Unmarshal([]byte("not a histogram"), &h))
+	require.False(t, IsHistogramAnnotation([]byte("not a histogram")))
+
+	b := Histogram{
+		Count:   1,
+		Buckets: []Bucket{{UpperBound: 1, Count: 1}},
+	}.MarshalAppend(nil)
+	for i := len(annotationMagic); i < len(b); i++ {
+		require.Error(t, Unmarshal(b[:i], &h), "truncated at %d", i)
+	}
+}
+
+func TestHistogramValidate(t *testing.T) {
+	valid := Histogram{
+		Count:   4,
+		Buckets: []Bucket{{UpperBound: 1, Count: 1}, {UpperBound: 2, Count: 2}},
+	}
+	require.NoError(t, valid.Validate())
+
+	unordered := Histogram{
+		Count:   4,
+		Buckets: []Bucket{{UpperBound: 2, Count: 1}, {UpperBound: 1, Count: 2}},
+	}
+	require.Error(t, unordered.Validate())
+
+	nan := Histogram{
+		Count:   4,
+		Buckets: []Bucket{{UpperBound: math.NaN(), Count: 1}},
+	}
+	require.Error(t, nan.Validate())
+
+	overflow := Histogram{
+		Count:   2,
+		Buckets: []Bucket{{UpperBound: 1, Count: 3}},
+	}
+	require.Error(t, overflow.Validate())
+}
@@ -0,0 +1,343 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var itErrPrefix = "histogram iterator:"
+
+type readerIterator struct {
+	opts   encoding.Options
+	stream encoding.IStream
+	err    error
+
+	tsIterator  m3tsz.TimestampIterator
+	sumIterator m3tsz.FloatEncoderAndIterator
+
+	curr       Histogram
+	annotation []byte
+
+	// Fields that are reused between function calls to
+	// avoid allocations.
+	varIntBuf [binary.MaxVarintLen64]byte
+
+	consumedFirstHistogram bool
+	done                   bool
+	closed                 bool
+}
+
+// NewReaderIterator returns a new iterator for a histogram encoded stream.
+// The value of each datapoint is the histogram count and the annotation is
+// the histogram marshalled with MarshalAppend, use Unmarshal or wrap the
+// iterator with NewIterator to access the histogram itself.
+func NewReaderIterator(reader io.Reader, opts encoding.Options) encoding.ReaderIterator {
+	return &readerIterator{
+		opts:       opts,
+		stream:     encoding.NewIStream(reader, opts.IStreamReaderSizeM3TSZ()),
+		tsIterator: m3tsz.NewTimestampIterator(opts, true),
+	}
+}
+
+func (it *readerIterator) Next() bool {
+	if !it.hasNext() {
+		return false
+	}
+
+	if !it.consumedFirstHistogram {
+		magic, err := it.stream.ReadByte()
+		if err == io.EOF {
+			it.done = true
+			return false
+		}
+		if err != nil {
+			it.err = fmt.Errorf("%s error reading stream header: %v", itErrPrefix, err)
+			return false
+		}
+		if magic != streamMagic {
+			it.err = fmt.Errorf("%s stream is not histogram encoded", itErrPrefix)
+			return false
+		}
+		// Can ignore the version number for now because we only have one.
+		if _, err := it.readVarInt(); err != nil {
+			it.err = fmt.Errorf("%s error reading stream header: %v", itErrPrefix, err)
+			return false
+		}
+	}
+
+	moreDataControlBit, err := it.stream.ReadBit()
+	if err == io.EOF {
+		it.done = true
+		return false
+	}
+	if err != nil {
+		it.err = fmt.Errorf(
+			"%s error reading more data control bit: %v", itErrPrefix, err)
+		return false
+	}
+
+	if moreDataControlBit == opCodeNoMoreDataOrTimeUnitChange {
+		// The next bit will tell us whether we've reached the end of the
+		// stream or that the time unit has changed.
+		noMoreDataControlBit, err := it.stream.ReadBit()
+		if err == io.EOF {
+			it.done = true
+			return false
+		}
+		if err != nil {
+			it.err = fmt.Errorf(
+				"%s error reading no more data control bit: %v", itErrPrefix, err)
+			return false
+		}
+
+		if noMoreDataControlBit == opCodeNoMoreData {
+			it.done = true
+			return false
+		}
+
+		if err := it.tsIterator.ReadTimeUnit(it.stream); err != nil {
+			it.err = fmt.Errorf("%s error reading new time unit: %v", itErrPrefix, err)
+			return false
+		}
+	}
+
+	_, done, err := it.tsIterator.ReadTimestamp(it.stream)
+	if err != nil {
+		it.err = fmt.Errorf("%s error reading timestamp: %v", itErrPrefix, err)
+		return false
+	}
+	if done {
+		// This should never happen since we never encode the EndOfStream marker.
+		it.err = fmt.Errorf("%s unexpected end of timestamp stream", itErrPrefix)
+		return false
+	}
+
+	if err := it.readHistogram(); err != nil {
+		it.err = fmt.Errorf("%s error reading histogram: %v", itErrPrefix, err)
+		return false
+	}
+
+	it.annotation = it.curr.MarshalAppend(it.annotation[:0])
+	it.consumedFirstHistogram = true
+	return it.hasNext()
+}
+
+func (it *readerIterator) readHistogram() error {
+	countDelta, err := it.readSignedVarInt()
+	if err != nil {
+		return err
+	}
+	it.curr.Count += uint64(countDelta)
+
+	if err := it.sumIterator.ReadFloat(it.stream); err != nil {
+		return err
+	}
+	it.curr.Sum = math.Float64frombits(it.sumIterator.PrevFloatBits)
+
+	bucketsChangedControlBit, err := it.stream.ReadBit()
+	if err != nil {
+		return err
+	}
+	if bucketsChangedControlBit == opCodeBucketsChanged {
+		numBuckets, err := it.readVarInt()
+		if err != nil {
+			return err
+		}
+		if numBuckets > maxNumBuckets {
+			return fmt.Errorf(
+				"num buckets is %d but maximum allowed is %d",
+				numBuckets, maxNumBuckets)
+		}
+
+		buckets := it.curr.Buckets[:0]
+		for i := uint64(0); i < numBuckets; i++ {
+			upperBound, err := it.stream.ReadBits(64)
+			if err != nil {
+				return err
+			}
+			buckets = append(buckets, Bucket{
+				UpperBound: math.Float64frombits(upperBound),
+			})
+		}
+		it.curr.Buckets = buckets
+	}
+
+	for i := range it.curr.Buckets {
+		delta, err := it.readSignedVarInt()
+		if err != nil {
+			return err
+		}
+		it.curr.Buckets[i].Count += uint64(delta)
+	}
+	return nil
+}
+
+func (it *readerIterator) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	var (
+		dp = ts.Datapoint{
+			Timestamp:      it.tsIterator.PrevTime.ToTime(),
+			TimestampNanos: it.tsIterator.PrevTime,
+			Value:          float64(it.curr.Count),
+		}
+		unit = it.tsIterator.TimeUnit
+	)
+	return dp, unit, it.annotation
+}
+
+func (it *readerIterator) Err() error {
+	return it.err
+}
+
+func (it *readerIterator) Reset(reader io.Reader, schema namespace.SchemaDescr) {
+	it.stream.Reset(reader)
+	it.tsIterator = m3tsz.NewTimestampIterator(it.opts, true)
+	it.sumIterator = m3tsz.FloatEncoderAndIterator{}
+	it.curr.Count = 0
+	it.curr.Sum = 0
+	it.curr.Buckets = it.curr.Buckets[:0]
+	it.annotation = it.annotation[:0]
+	it.err = nil
+	it.consumedFirstHistogram = false
+	it.done = false
+	it.closed = false
+}
+
+func (it *readerIterator) Close() {
+	if it.closed {
+		return
+	}
+
+	it.Reset(nil, nil)
+	it.closed = true
+
+	if pool := it.opts.ReaderIteratorPool(); pool != nil {
+		pool.Put(it)
+	}
+}
+
+func (it *readerIterator) readVarInt() (uint64, error) {
+	buf, err := it.readVarIntBytes()
+	if err != nil {
+		return 0, err
+	}
+	v, _ := binary.Uvarint(buf)
+	return v, nil
+}
+
+func (it *readerIterator) readSignedVarInt() (int64, error) {
+	buf, err := it.readVarIntBytes()
+	if err != nil {
+		return 0, err
+	}
+	v, _ := binary.Varint(buf)
+	return v, nil
+}
+
+func (it *readerIterator) readVarIntBytes() ([]byte, error) {
+	buf := it.varIntBuf[:0]
+	for {
+		b, err := it.stream.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("error reading var int: %v", err)
+		}
+
+		buf = append(buf, b)
+		if b>>7 == 0 {
+			return buf, nil
+		}
+		if len(buf) == len(it.varIntBuf) {
+			return nil, fmt.Errorf("var int exceeds %d bytes", len(it.varIntBuf))
+		}
+	}
+}
+
+func (it *readerIterator) hasNext() bool {
+	return it.err == nil && !it.done && !it.closed
+}
+
+// Iterator iterates over histogram datapoints.
+type Iterator interface {
+	// Next moves to the next item.
+	Next() bool
+
+	// Current returns the current datapoint and histogram. Users should not
+	// hold on to the returned histogram as its buckets may get invalidated
+	// when the iterator calls Next().
+	Current() (ts.Datapoint, xtime.Unit, Histogram)
+
+	// Err returns the error encountered.
+	Err() error
+
+	// Close closes the iterator and the underlying iterator.
+	Close()
+}
+
+type iterator struct {
+	iter encoding.Iterator
+	curr Histogram
+	err  error
+}
+
+// NewIterator returns a new histogram iterator that decodes the histograms
+// from an iterator over a histogram encoded series, such as an
+// encoding.SeriesIterator that was built from histogram reader iterators.
+func NewIterator(iter encoding.Iterator) Iterator {
+	return &iterator{iter: iter}
+}
+
+func (it *iterator) Next() bool {
+	if it.err != nil || !it.iter.Next() {
+		return false
+	}
+
+	_, _, annotation := it.iter.Current()
+	if err := Unmarshal(annotation, &it.curr); err != nil {
+		it.err = fmt.Errorf("%s error unmarshalling histogram: %v", itErrPrefix, err)
+		return false
+	}
+	return true
+}
+
+func (it *iterator) Current() (ts.Datapoint, xtime.Unit, Histogram) {
+	dp, unit, _ := it.iter.Current()
+	return dp, unit, it.curr
+}
+
+func (it *iterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.iter.Err()
+}
+
+func (it *iterator) Close() {
+	it.iter.Close()
+}
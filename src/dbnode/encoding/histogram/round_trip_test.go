@@ -0,0 +1,279 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testEncodingOptions = encoding.NewOptions().
+	SetDefaultTimeUnit(xtime.Second)
+
+type testHistogram struct {
+	timestamp time.Time
+	unit      xtime.Unit
+	histogram Histogram
+}
+
+func newTestHistograms(start time.Time) []testHistogram {
+	return []testHistogram{
+		{
+			timestamp: start,
+			unit:      xtime.Second,
+			histogram: Histogram{},
+		},
+		{
+			timestamp: start.Add(10 * time.Second),
+			unit:      xtime.Second,
+			histogram: Histogram{
+				Count: 3,
+				Sum:   1.5,
+				Buckets: []Bucket{
+					{UpperBound: 0.5, Count: 1},
+					{UpperBound: 1, Count: 2},
+				},
+			},
+		},
+		{
+			// Same bucket layout with increased counts.
+			timestamp: start.Add(20 * time.Second),
+			unit:      xtime.Second,
+			histogram: Histogram{
+				Count: 10,
+				Sum:   9.25,
+				Buckets: []Bucket{
+					{UpperBound: 0.5, Count: 4},
+					{UpperBound: 1, Count: 5},
+				},
+			},
+		},
+		{
+			// Time unit change and a new sparse bucket layout.
+			timestamp: start.Add(20*time.Second + 500*time.Millisecond),
+			unit:      xtime.Millisecond,
+			histogram: Histogram{
+				Count: 12,
+				Sum:   30,
+				Buckets: []Bucket{
+					{UpperBound: 1, Count: 5},
+					{UpperBound: 10, Count: 6},
+				},
+			},
+		},
+		{
+			// Counts decreasing, for instance after a process restart.
+			timestamp: start.Add(30 * time.Second),
+			unit:      xtime.Millisecond,
+			histogram: Histogram{
+				Count: 1,
+				Sum:   0.25,
+				Buckets: []Bucket{
+					{UpperBound: 1, Count: 1},
+					{UpperBound: 10, Count: 0},
+				},
+			},
+		},
+	}
+}
+
+func encodeTestHistograms(t *testing.T, start time.Time, input []testHistogram) encoding.Encoder {
+	enc := NewEncoder(start, testEncodingOptions)
+	enc.Reset(start, 0, nil)
+	for _, h := range input {
+		// Encoder should ignore value so we set it to make sure it gets ignored.
+		dp := ts.Datapoint{Timestamp: h.timestamp, Value: -1}
+		require.NoError(t, enc.Encode(dp, h.unit, h.histogram.MarshalAppend(nil)))
+
+		last, err := enc.LastEncoded()
+		require.NoError(t, err)
+		require.True(t, h.timestamp.Equal(last.Timestamp))
+		require.Equal(t, float64(h.histogram.Count), last.Value)
+	}
+	require.Equal(t, len(input), enc.NumEncoded())
+	return enc
+}
+
+func TestRoundTrip(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	input := newTestHistograms(start)
+	enc := encodeTestHistograms(t, start, input)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	stream, ok := enc.Stream(ctx)
+	require.True(t, ok)
+
+	iter := NewReaderIterator(stream, testEncodingOptions)
+	defer iter.Close()
+
+	i := 0
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		require.True(t, input[i].timestamp.Equal(dp.Timestamp),
+			"expected %v, got %v", input[i].timestamp, dp.Timestamp)
+		require.Equal(t, input[i].unit, unit)
+		require.Equal(t, float64(input[i].histogram.Count), dp.Value)
+
+		var h Histogram
+		require.NoError(t, Unmarshal(annotation, &h))
+		requireHistogramsEqual(t, input[i].histogram, h)
+		i++
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, len(input), i)
+}
+
+func TestEncoderRejectsInvalidHistograms(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	enc := NewEncoder(start, testEncodingOptions)
+	enc.Reset(start, 0, nil)
+
+	err := enc.Encode(ts.Datapoint{Timestamp: start}, xtime.Second, ts.Annotation("foo"))
+	require.Error(t, err)
+
+	invalid := Histogram{
+		Count:   1,
+		Buckets: []Bucket{{UpperBound: 2, Count: 1}, {UpperBound: 1}},
+	}
+	err = enc.Encode(ts.Datapoint{Timestamp: start}, xtime.Second, invalid.MarshalAppend(nil))
+	require.Error(t, err)
+
+	// Nothing should have been written to the stream.
+	require.Equal(t, 0, enc.NumEncoded())
+	require.Equal(t, 0, enc.Len())
+}
+
+func TestIteratorOverSeriesIterator(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	input := newTestHistograms(start)
+	enc := encodeTestHistograms(t, start, input)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	stream, ok := enc.Stream(ctx)
+	require.True(t, ok)
+
+	multiIter := encoding.NewMultiReaderIterator(
+		func(r io.Reader, _ namespace.SchemaDescr) encoding.ReaderIterator {
+			return NewReaderIterator(r, testEncodingOptions)
+		}, nil)
+	multiIter.Reset([]xio.SegmentReader{stream}, start, time.Hour, nil)
+
+	seriesIter := encoding.NewSeriesIterator(encoding.SeriesIteratorOptions{
+		ID:             ident.StringID("foo"),
+		Namespace:      ident.StringID("bar"),
+		Replicas:       []encoding.MultiReaderIterator{multiIter},
+		StartInclusive: xtime.ToUnixNano(start),
+		EndExclusive:   xtime.ToUnixNano(start.Add(time.Hour)),
+	}, nil)
+
+	iter := NewIterator(seriesIter)
+	defer iter.Close()
+
+	i := 0
+	for iter.Next() {
+		dp, _, h := iter.Current()
+		require.True(t, input[i].timestamp.Equal(dp.Timestamp))
+		requireHistogramsEqual(t, input[i].histogram, h)
+		i++
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, len(input), i)
+}
+
+func TestReaderIteratorWithFallback(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	input := newTestHistograms(start)
+	enc := encodeTestHistograms(t, start, input)
+
+	m3tszEnc := m3tsz.NewEncoder(start, nil, true, testEncodingOptions)
+	for i := 0; i < 3; i++ {
+		dp := ts.Datapoint{Timestamp: start.Add(time.Duration(i) * time.Second), Value: float64(i)}
+		require.NoError(t, m3tszEnc.Encode(dp, xtime.Second, nil))
+	}
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	iter := NewReaderIteratorWithFallback(nil,
+		m3tsz.NewReaderIterator(nil, true, testEncodingOptions), testEncodingOptions)
+	defer iter.Close()
+
+	// Histogram encoded streams are read as histograms.
+	stream, ok := enc.Stream(ctx)
+	require.True(t, ok)
+	iter.Reset(stream, nil)
+	i := 0
+	for iter.Next() {
+		dp, _, annotation := iter.Current()
+		require.True(t, input[i].timestamp.Equal(dp.Timestamp))
+
+		var h Histogram
+		require.NoError(t, Unmarshal(annotation, &h))
+		requireHistogramsEqual(t, input[i].histogram, h)
+		i++
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, len(input), i)
+
+	// Any other stream is read by the fallback iterator.
+	stream, ok = m3tszEnc.Stream(ctx)
+	require.True(t, ok)
+	iter.Reset(stream, nil)
+	i = 0
+	for iter.Next() {
+		dp, _, annotation := iter.Current()
+		require.True(t, start.Add(time.Duration(i)*time.Second).Equal(dp.Timestamp))
+		require.Equal(t, float64(i), dp.Value)
+		require.Nil(t, annotation)
+		i++
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, 3, i)
+
+	// Empty streams are read by the fallback iterator too.
+	iter.Reset(xio.NewSegmentReader(ts.Segment{}), nil)
+	require.False(t, iter.Next())
+}
+
+func requireHistogramsEqual(t *testing.T, expected, actual Histogram) {
+	require.Equal(t, expected.Count, actual.Count)
+	require.Equal(t, expected.Sum, actual.Sum)
+	require.Equal(t, len(expected.Buckets), len(actual.Buckets))
+	for i := range expected.Buckets {
+		require.Equal(t, expected.Buckets[i], actual.Buckets[i])
+	}
+}
@@ -33,6 +33,8 @@
 		IndexOptions
 		NamespaceOptions
 		Registry
+		ResolutionTier
+		RepairThrottle
 		SchemaOptions
 		SchemaHistory
 		FileDescriptorSet
@@ -145,16 +147,21 @@ func (m *IndexOptions) GetBlockSizeNanos() int64 {
 }
 
 type NamespaceOptions struct {
-	BootstrapEnabled  bool              `protobuf:"varint,1,opt,name=bootstrapEnabled,proto3" json:"bootstrapEnabled,omitempty"`
-	FlushEnabled      bool              `protobuf:"varint,2,opt,name=flushEnabled,proto3" json:"flushEnabled,omitempty"`
-	WritesToCommitLog bool              `protobuf:"varint,3,opt,name=writesToCommitLog,proto3" json:"writesToCommitLog,omitempty"`
-	CleanupEnabled    bool              `protobuf:"varint,4,opt,name=cleanupEnabled,proto3" json:"cleanupEnabled,omitempty"`
-	RepairEnabled     bool              `protobuf:"varint,5,opt,name=repairEnabled,proto3" json:"repairEnabled,omitempty"`
-	RetentionOptions  *RetentionOptions `protobuf:"bytes,6,opt,name=retentionOptions" json:"retentionOptions,omitempty"`
-	SnapshotEnabled   bool              `protobuf:"varint,7,opt,name=snapshotEnabled,proto3" json:"snapshotEnabled,omitempty"`
-	IndexOptions      *IndexOptions     `protobuf:"bytes,8,opt,name=indexOptions" json:"indexOptions,omitempty"`
-	SchemaOptions     *SchemaOptions    `protobuf:"bytes,9,opt,name=schemaOptions" json:"schemaOptions,omitempty"`
-	ColdWritesEnabled bool              `protobuf:"varint,10,opt,name=coldWritesEnabled,proto3" json:"coldWritesEnabled,omitempty"`
+	BootstrapEnabled    bool              `protobuf:"varint,1,opt,name=bootstrapEnabled,proto3" json:"bootstrapEnabled,omitempty"`
+	FlushEnabled        bool              `protobuf:"varint,2,opt,name=flushEnabled,proto3" json:"flushEnabled,omitempty"`
+	WritesToCommitLog   bool              `protobuf:"varint,3,opt,name=writesToCommitLog,proto3" json:"writesToCommitLog,omitempty"`
+	CleanupEnabled      bool              `protobuf:"varint,4,opt,name=cleanupEnabled,proto3" json:"cleanupEnabled,omitempty"`
+	RepairEnabled       bool              `protobuf:"varint,5,opt,name=repairEnabled,proto3" json:"repairEnabled,omitempty"`
+	RetentionOptions    *RetentionOptions `protobuf:"bytes,6,opt,name=retentionOptions" json:"retentionOptions,omitempty"`
+	SnapshotEnabled     bool              `protobuf:"varint,7,opt,name=snapshotEnabled,proto3" json:"snapshotEnabled,omitempty"`
+	IndexOptions        *IndexOptions     `protobuf:"bytes,8,opt,name=indexOptions" json:"indexOptions,omitempty"`
+	SchemaOptions       *SchemaOptions    `protobuf:"bytes,9,opt,name=schemaOptions" json:"schemaOptions,omitempty"`
+	ColdWritesEnabled   bool              `protobuf:"varint,10,opt,name=coldWritesEnabled,proto3" json:"coldWritesEnabled,omitempty"`
+	ReplicationClusters []string          `protobuf:"bytes,11,rep,name=replicationClusters" json:"replicationClusters,omitempty"`
+	IndexOnly           bool              `protobuf:"varint,12,opt,name=indexOnly,proto3" json:"indexOnly,omitempty"`
+	ResolutionTiers     []*ResolutionTier `protobuf:"bytes,13,rep,name=resolutionTiers" json:"resolutionTiers,omitempty"`
+	RepairThrottle      *RepairThrottle   `protobuf:"bytes,14,opt,name=repairThrottle" json:"repairThrottle,omitempty"`
+	HistogramsEnabled   bool              `protobuf:"varint,15,opt,name=histogramsEnabled,proto3" json:"histogramsEnabled,omitempty"`
 }
 
 func (m *NamespaceOptions) Reset()                    { *m = NamespaceOptions{} }
@@ -232,6 +239,41 @@ func (m *NamespaceOptions) GetColdWritesEnabled() bool {
 	return false
 }
 
+func (m *NamespaceOptions) GetReplicationClusters() []string {
+	if m != nil {
+		return m.ReplicationClusters
+	}
+	return nil
+}
+
+func (m *NamespaceOptions) GetIndexOnly() bool {
+	if m != nil {
+		return m.IndexOnly
+	}
+	return false
+}
+
+func (m *NamespaceOptions) GetResolutionTiers() []*ResolutionTier {
+	if m != nil {
+		return m.ResolutionTiers
+	}
+	return nil
+}
+
+func (m *NamespaceOptions) GetRepairThrottle() *RepairThrottle {
+	if m != nil {
+		return m.RepairThrottle
+	}
+	return nil
+}
+
+func (m *NamespaceOptions) GetHistogramsEnabled() bool {
+	if m != nil {
+		return m.HistogramsEnabled
+	}
+	return false
+}
+
 type Registry struct {
 	Namespaces map[string]*NamespaceOptions `protobuf:"bytes,1,rep,name=namespaces" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -248,11 +290,53 @@ func (m *Registry) GetNamespaces() map[string]*NamespaceOptions {
 	return nil
 }
 
+type ResolutionTier struct {
+	ResolutionNanos int64 `protobuf:"varint,1,opt,name=resolutionNanos,proto3" json:"resolutionNanos,omitempty"`
+	AgeNanos        int64 `protobuf:"varint,2,opt,name=ageNanos,proto3" json:"ageNanos,omitempty"`
+}
+
+func (m *ResolutionTier) Reset()                    { *m = ResolutionTier{} }
+func (m *ResolutionTier) String() string            { return proto.CompactTextString(m) }
+func (*ResolutionTier) ProtoMessage()               {}
+func (*ResolutionTier) Descriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{4} }
+
+func (m *ResolutionTier) GetResolutionNanos() int64 {
+	if m != nil {
+		return m.ResolutionNanos
+	}
+	return 0
+}
+
+func (m *ResolutionTier) GetAgeNanos() int64 {
+	if m != nil {
+		return m.AgeNanos
+	}
+	return 0
+}
+
+type RepairThrottle struct {
+	ThrottleNanos int64 `protobuf:"varint,1,opt,name=throttleNanos,proto3" json:"throttleNanos,omitempty"`
+}
+
+func (m *RepairThrottle) Reset()                    { *m = RepairThrottle{} }
+func (m *RepairThrottle) String() string            { return proto.CompactTextString(m) }
+func (*RepairThrottle) ProtoMessage()               {}
+func (*RepairThrottle) Descriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{5} }
+
+func (m *RepairThrottle) GetThrottleNanos() int64 {
+	if m != nil {
+		return m.ThrottleNanos
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*RetentionOptions)(nil), "namespace.RetentionOptions")
 	proto.RegisterType((*IndexOptions)(nil), "namespace.IndexOptions")
 	proto.RegisterType((*NamespaceOptions)(nil), "namespace.NamespaceOptions")
 	proto.RegisterType((*Registry)(nil), "namespace.Registry")
+	proto.RegisterType((*ResolutionTier)(nil), "namespace.ResolutionTier")
+	proto.RegisterType((*RepairThrottle)(nil), "namespace.RepairThrottle")
 }
 func (m *RetentionOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -460,6 +544,63 @@ func (m *NamespaceOptions) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.ReplicationClusters) > 0 {
+		for _, s := range m.ReplicationClusters {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.IndexOnly {
+		dAtA[i] = 0x60
+		i++
+		if m.IndexOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ResolutionTiers) > 0 {
+		for _, msg := range m.ResolutionTiers {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintNamespace(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.RepairThrottle != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.RepairThrottle.Size()))
+		n4, err := m.RepairThrottle.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.HistogramsEnabled {
+		dAtA[i] = 0x78
+		i++
+		if m.HistogramsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -498,17 +639,68 @@ func (m *Registry) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintNamespace(dAtA, i, uint64(v.Size()))
-				n4, err := v.MarshalTo(dAtA[i:])
+				n5, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n4
+				i += n5
 			}
 		}
 	}
 	return i, nil
 }
 
+func (m *ResolutionTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolutionTier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ResolutionNanos != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.ResolutionNanos))
+	}
+	if m.AgeNanos != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.AgeNanos))
+	}
+	return i, nil
+}
+
+func (m *RepairThrottle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepairThrottle) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ThrottleNanos != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.ThrottleNanos))
+	}
+	return i, nil
+}
+
 func encodeVarintNamespace(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -593,6 +785,28 @@ func (m *NamespaceOptions) Size() (n int) {
 	if m.ColdWritesEnabled {
 		n += 2
 	}
+	if len(m.ReplicationClusters) > 0 {
+		for _, s := range m.ReplicationClusters {
+			l = len(s)
+			n += 1 + l + sovNamespace(uint64(l))
+		}
+	}
+	if m.IndexOnly {
+		n += 2
+	}
+	if len(m.ResolutionTiers) > 0 {
+		for _, e := range m.ResolutionTiers {
+			l = e.Size()
+			n += 1 + l + sovNamespace(uint64(l))
+		}
+	}
+	if m.RepairThrottle != nil {
+		l = m.RepairThrottle.Size()
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	if m.HistogramsEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -615,6 +829,27 @@ func (m *Registry) Size() (n int) {
 	return n
 }
 
+func (m *ResolutionTier) Size() (n int) {
+	var l int
+	_ = l
+	if m.ResolutionNanos != 0 {
+		n += 1 + sovNamespace(uint64(m.ResolutionNanos))
+	}
+	if m.AgeNanos != 0 {
+		n += 1 + sovNamespace(uint64(m.AgeNanos))
+	}
+	return n
+}
+
+func (m *RepairThrottle) Size() (n int) {
+	var l int
+	_ = l
+	if m.ThrottleNanos != 0 {
+		n += 1 + sovNamespace(uint64(m.ThrottleNanos))
+	}
+	return n
+}
+
 func sovNamespace(x uint64) (n int) {
 	for {
 		n++
@@ -1169,6 +1404,139 @@ func (m *NamespaceOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ColdWritesEnabled = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationClusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationClusters = append(m.ReplicationClusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexOnly = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolutionTiers = append(m.ResolutionTiers, &ResolutionTier{})
+			if err := m.ResolutionTiers[len(m.ResolutionTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairThrottle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RepairThrottle == nil {
+				m.RepairThrottle = &RepairThrottle{}
+			}
+			if err := m.RepairThrottle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HistogramsEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespace(dAtA[iNdEx:])
@@ -1363,6 +1731,163 @@ func (m *Registry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResolutionTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolutionTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolutionTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionNanos", wireType)
+			}
+			m.ResolutionNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeNanos", wireType)
+			}
+			m.AgeNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgeNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepairThrottle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepairThrottle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepairThrottle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottleNanos", wireType)
+			}
+			m.ThrottleNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThrottleNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNamespace(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1473,41 +1998,49 @@ func init() {
 }
 
 var fileDescriptorNamespace = []byte{
-	// 575 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x51, 0x6b, 0xd3, 0x50,
-	0x14, 0xc7, 0x4d, 0xb3, 0xad, 0xed, 0x59, 0xe7, 0xe2, 0x45, 0xb0, 0x54, 0x28, 0xa3, 0x8a, 0x14,
-	0x91, 0x06, 0xdb, 0x17, 0x51, 0x18, 0xcc, 0xad, 0x0e, 0x41, 0x6a, 0xb9, 0x13, 0x84, 0xbd, 0xdd,
-	0x24, 0xa7, 0x6d, 0x58, 0x92, 0x1b, 0xee, 0xbd, 0xd1, 0xd5, 0xcf, 0xe0, 0x83, 0xdf, 0xc3, 0x2f,
-	0xe2, 0xa3, 0x1f, 0x41, 0xea, 0xd7, 0xf0, 0x41, 0x72, 0x63, 0xba, 0x24, 0x1d, 0x32, 0xf6, 0x52,
-	0x6e, 0xff, 0xe7, 0x77, 0xce, 0xb9, 0x3d, 0xff, 0x73, 0x0b, 0xa7, 0x73, 0x5f, 0x2d, 0x12, 0x67,
-	0xe0, 0xf2, 0xd0, 0x0e, 0x47, 0x9e, 0x63, 0x87, 0x23, 0x5b, 0x0a, 0xd7, 0xf6, 0x9c, 0x88, 0x7b,
-	0x68, 0xcf, 0x31, 0x42, 0xc1, 0x14, 0x7a, 0x76, 0x2c, 0xb8, 0xe2, 0x76, 0xc4, 0x42, 0x94, 0x31,
-	0x73, 0xf1, 0xea, 0x34, 0xd0, 0x11, 0xd2, 0x5c, 0x0b, 0x9d, 0x93, 0xdb, 0xd6, 0x94, 0xee, 0x02,
-	0x43, 0x96, 0x15, 0xec, 0x7d, 0x35, 0xc1, 0xa2, 0xa8, 0x30, 0x52, 0x3e, 0x8f, 0xde, 0xc7, 0xe9,
-	0xa7, 0x24, 0x43, 0xb8, 0x2f, 0x72, 0x6d, 0x8a, 0xc2, 0xe7, 0xde, 0x84, 0x45, 0x5c, 0xb6, 0x8d,
-	0x03, 0xa3, 0x6f, 0xd2, 0x6b, 0x63, 0xe4, 0x09, 0xdc, 0x75, 0x02, 0xee, 0x5e, 0x9c, 0xf9, 0x5f,
-	0x30, 0xa3, 0x6b, 0x9a, 0xae, 0xa8, 0xe4, 0x19, 0xdc, 0x73, 0x92, 0xd9, 0x0c, 0xc5, 0x9b, 0x44,
-	0x25, 0xe2, 0x1f, 0x6a, 0x6a, 0x74, 0x33, 0x40, 0xfa, 0xb0, 0x9f, 0x89, 0x53, 0x26, 0x55, 0xc6,
-	0x6e, 0x69, 0xb6, 0x2a, 0x6b, 0x32, 0xed, 0x74, 0xc2, 0x14, 0x1b, 0x5f, 0xc6, 0xbe, 0x58, 0xb6,
-	0xb7, 0x0f, 0x8c, 0x7e, 0x83, 0x56, 0x65, 0x72, 0x0e, 0xfd, 0x8a, 0x74, 0x34, 0x53, 0x28, 0x26,
-	0x5c, 0x1d, 0xb9, 0x2e, 0x4a, 0x59, 0xfc, 0xc5, 0x3b, 0xba, 0xd9, 0x8d, 0x79, 0x72, 0x08, 0x9d,
-	0x99, 0xbe, 0x3e, 0xbd, 0x6e, 0x7e, 0x75, 0x5d, 0xed, 0x3f, 0x44, 0x6f, 0x0a, 0xad, 0xb7, 0x91,
-	0x87, 0x97, 0xb9, 0x13, 0x6d, 0xa8, 0x63, 0xc4, 0x9c, 0x00, 0x3d, 0x3d, 0xfc, 0x06, 0xcd, 0xbf,
-	0xde, 0x74, 0xde, 0xbd, 0x3f, 0x26, 0x58, 0x93, 0xdc, 0xfb, 0xbc, 0xec, 0x53, 0xb0, 0x1c, 0xce,
-	0x95, 0x54, 0x82, 0xc5, 0xe3, 0x52, 0xfd, 0x0d, 0x9d, 0xf4, 0xa0, 0x35, 0x0b, 0x12, 0xb9, 0xc8,
-	0xb9, 0x9a, 0xe6, 0x4a, 0x5a, 0x6a, 0xea, 0x67, 0xe1, 0x2b, 0x94, 0x1f, 0xf8, 0x31, 0x0f, 0x43,
-	0x5f, 0xbd, 0xe3, 0x73, 0x6d, 0x6a, 0x83, 0x6e, 0x06, 0xd2, 0xab, 0xbb, 0x01, 0xb2, 0x28, 0x59,
-	0xf7, 0xde, 0xd2, 0x68, 0x45, 0x25, 0x8f, 0x61, 0x4f, 0x60, 0xcc, 0x7c, 0x91, 0x63, 0x99, 0xa1,
-	0x65, 0x91, 0x9c, 0x82, 0x25, 0x2a, 0x0b, 0xac, 0x6d, 0xdb, 0x1d, 0x3e, 0x1c, 0x5c, 0x3d, 0x9f,
-	0xea, 0x8e, 0xd3, 0x8d, 0xa4, 0x74, 0x83, 0x64, 0xc4, 0x62, 0xb9, 0xe0, 0x2a, 0x6f, 0x58, 0xcf,
-	0x36, 0xa8, 0x22, 0x93, 0x57, 0xd0, 0xf2, 0x0b, 0x2e, 0xb5, 0x1b, 0xba, 0xdd, 0x83, 0x42, 0xbb,
-	0xa2, 0x89, 0xb4, 0x04, 0x93, 0x43, 0xd8, 0xcb, 0x5e, 0x60, 0x9e, 0xdd, 0xd4, 0xd9, 0xed, 0x42,
-	0xf6, 0x59, 0x31, 0x4e, 0xcb, 0x78, 0x3a, 0x6b, 0x97, 0x07, 0xde, 0x47, 0x3d, 0xd6, 0xfc, 0xa2,
-	0x90, 0xcd, 0x7a, 0x23, 0xd0, 0xfb, 0x6e, 0x40, 0x83, 0xe2, 0xdc, 0x97, 0x4a, 0x2c, 0xc9, 0x31,
-	0xc0, 0xba, 0x49, 0xfa, 0x9a, 0xcd, 0xfe, 0xee, 0xf0, 0x51, 0x69, 0x48, 0x19, 0x38, 0x58, 0x2f,
-	0x8c, 0x1c, 0x47, 0x4a, 0x2c, 0x69, 0x21, 0xad, 0x73, 0x0e, 0xfb, 0x95, 0x30, 0xb1, 0xc0, 0xbc,
-	0xc0, 0xa5, 0xde, 0xa0, 0x26, 0x4d, 0x8f, 0xe4, 0x39, 0x6c, 0x7f, 0x62, 0x41, 0x82, 0x7a, 0x5b,
-	0xca, 0x4e, 0x54, 0x97, 0x91, 0x66, 0xe4, 0xcb, 0xda, 0x0b, 0xe3, 0xb5, 0xf5, 0x63, 0xd5, 0x35,
-	0x7e, 0xae, 0xba, 0xc6, 0xaf, 0x55, 0xd7, 0xf8, 0xf6, 0xbb, 0x7b, 0xc7, 0xd9, 0xd1, 0x7f, 0x53,
-	0xa3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0xfa, 0xeb, 0x0c, 0x42, 0x05, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x55, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0xa6, 0xeb, 0x7e, 0xda, 0xb3, 0xae, 0x2d, 0x06, 0x89, 0x50, 0xd0, 0x34, 0x05, 0x84, 0x2a,
+	0x84, 0x5a, 0xd8, 0x24, 0x84, 0x40, 0x42, 0x1a, 0xdb, 0x98, 0x90, 0xd0, 0x98, 0xbc, 0x09, 0xa4,
+	0xdd, 0x39, 0x89, 0xdb, 0x46, 0x4b, 0xe2, 0xc8, 0x76, 0x60, 0xe3, 0x92, 0x6b, 0x2e, 0x78, 0x0f,
+	0x5e, 0x84, 0x4b, 0x1e, 0x01, 0xc1, 0x8b, 0x60, 0x3b, 0x4b, 0x9b, 0xb8, 0x13, 0x9a, 0xb8, 0x48,
+	0x64, 0x7f, 0xe7, 0x3b, 0xe7, 0x38, 0xdf, 0x39, 0xc7, 0x81, 0xfd, 0x71, 0x28, 0x27, 0x99, 0x37,
+	0xf0, 0x59, 0x3c, 0x8c, 0xb7, 0x02, 0x4f, 0xbd, 0x86, 0x82, 0xfb, 0xc3, 0xc0, 0x4b, 0x58, 0x40,
+	0x87, 0x63, 0x9a, 0x50, 0x4e, 0x24, 0x0d, 0x86, 0x29, 0x67, 0x92, 0x0d, 0x13, 0x12, 0x53, 0x91,
+	0x12, 0x9f, 0xce, 0x56, 0x03, 0x63, 0x41, 0xcd, 0x29, 0xd0, 0xdb, 0xfd, 0xdf, 0x98, 0xc2, 0x9f,
+	0xd0, 0x98, 0xe4, 0x01, 0xdd, 0xaf, 0x75, 0xe8, 0x62, 0x2a, 0x69, 0x22, 0x43, 0x96, 0xbc, 0x4b,
+	0xf5, 0x5b, 0xa0, 0x4d, 0xb8, 0xc9, 0x0b, 0xec, 0x90, 0xf2, 0x90, 0x05, 0x07, 0x24, 0x61, 0xc2,
+	0xa9, 0x6d, 0xd4, 0xfa, 0x75, 0x7c, 0xa9, 0x0d, 0x3d, 0x80, 0xb6, 0x17, 0x31, 0xff, 0xf4, 0x28,
+	0xfc, 0x4c, 0x73, 0xf6, 0x82, 0x61, 0x5b, 0x28, 0x7a, 0x04, 0xd7, 0xbd, 0x6c, 0x34, 0xa2, 0xfc,
+	0x75, 0x26, 0x33, 0x7e, 0x41, 0xad, 0x1b, 0xea, 0xbc, 0x01, 0xf5, 0xa1, 0x93, 0x83, 0x87, 0x44,
+	0xc8, 0x9c, 0xbb, 0x68, 0xb8, 0x36, 0x6c, 0x98, 0x3a, 0xd3, 0x2e, 0x91, 0x64, 0xef, 0x2c, 0x0d,
+	0xf9, 0xb9, 0xb3, 0xa4, 0x98, 0x0d, 0x6c, 0xc3, 0xe8, 0x04, 0xfa, 0x16, 0xb4, 0x3d, 0x92, 0x94,
+	0x1f, 0x30, 0xb9, 0xed, 0xfb, 0x54, 0x88, 0xf2, 0x17, 0x2f, 0x9b, 0x64, 0x57, 0xe6, 0xa3, 0x97,
+	0xd0, 0x1b, 0x99, 0xe3, 0xe3, 0xcb, 0xf4, 0x5b, 0x31, 0xd1, 0xfe, 0xc1, 0x70, 0x0f, 0xa1, 0xf5,
+	0x26, 0x09, 0xe8, 0x59, 0x51, 0x09, 0x07, 0x56, 0x68, 0x42, 0xbc, 0x88, 0x06, 0x46, 0xfc, 0x06,
+	0x2e, 0xb6, 0x57, 0xd5, 0xdb, 0xfd, 0xb2, 0x0c, 0xdd, 0x83, 0xa2, 0xf6, 0x45, 0xd8, 0x87, 0xd0,
+	0xf5, 0x18, 0x93, 0x42, 0x72, 0x92, 0xee, 0x55, 0xe2, 0xcf, 0xe1, 0xc8, 0x85, 0xd6, 0x28, 0xca,
+	0xc4, 0xa4, 0xe0, 0x2d, 0x18, 0x5e, 0x05, 0xd3, 0x45, 0xfd, 0xc4, 0x43, 0x49, 0xc5, 0x31, 0xdb,
+	0x61, 0x71, 0x1c, 0xca, 0xb7, 0x6c, 0x6c, 0x8a, 0xda, 0xc0, 0xf3, 0x06, 0x7d, 0x74, 0x3f, 0xa2,
+	0x24, 0xc9, 0xa6, 0xb9, 0x17, 0x0d, 0xd5, 0x42, 0xd1, 0x7d, 0x58, 0xe3, 0x34, 0x25, 0x21, 0x2f,
+	0x68, 0x79, 0x41, 0xab, 0x20, 0xda, 0x87, 0x2e, 0xb7, 0x1a, 0xd8, 0x94, 0x6d, 0x75, 0xf3, 0xce,
+	0x60, 0x36, 0x3e, 0x76, 0x8f, 0xe3, 0x39, 0x27, 0xdd, 0x41, 0x22, 0x21, 0xa9, 0x98, 0x30, 0x59,
+	0x24, 0x5c, 0xc9, 0x3b, 0xc8, 0x82, 0xd1, 0x0b, 0x68, 0x85, 0xa5, 0x2a, 0x39, 0x0d, 0x93, 0xee,
+	0x56, 0x29, 0x5d, 0xb9, 0x88, 0xb8, 0x42, 0x56, 0x2d, 0xb2, 0x96, 0x4f, 0x60, 0xe1, 0xdd, 0x34,
+	0xde, 0x4e, 0xc9, 0xfb, 0xa8, 0x6c, 0xc7, 0x55, 0xba, 0xd6, 0xda, 0x67, 0x51, 0xf0, 0xc1, 0xc8,
+	0x5a, 0x1c, 0x14, 0x72, 0xad, 0xe7, 0x0c, 0xe8, 0x31, 0xdc, 0x50, 0x72, 0x45, 0xa1, 0x4f, 0xb4,
+	0xf7, 0x8e, 0x2a, 0x9a, 0x6a, 0x5d, 0xe1, 0xac, 0x6e, 0xd4, 0xfb, 0x4d, 0x7c, 0x99, 0x09, 0xdd,
+	0x85, 0x66, 0x7e, 0xde, 0x24, 0x3a, 0x77, 0x5a, 0x26, 0xee, 0x0c, 0x40, 0x3b, 0xd0, 0xe1, 0x54,
+	0xb0, 0x28, 0xd3, 0x3e, 0xc7, 0xa1, 0x8e, 0xb5, 0xa6, 0x62, 0xad, 0x6e, 0xde, 0xae, 0x88, 0x5d,
+	0x66, 0x60, 0xdb, 0x03, 0x6d, 0x43, 0x3b, 0xaf, 0xe1, 0xf1, 0x44, 0x5d, 0x42, 0x32, 0xa2, 0x4e,
+	0xdb, 0x68, 0x50, 0x8d, 0x51, 0x26, 0x60, 0xcb, 0x41, 0xab, 0x30, 0x09, 0x85, 0x64, 0x63, 0x4e,
+	0xe2, 0xa9, 0x0a, 0x9d, 0x5c, 0x85, 0x39, 0x83, 0xfb, 0xbd, 0x06, 0x0d, 0x4c, 0xc7, 0x0a, 0xe7,
+	0xfa, 0x13, 0x60, 0x9a, 0x46, 0xdf, 0x69, 0xfa, 0xf4, 0xf7, 0x2a, 0x99, 0x73, 0xe2, 0x60, 0x3a,
+	0x36, 0x2a, 0x8e, 0xda, 0xe3, 0x92, 0x5b, 0xef, 0x04, 0x3a, 0x96, 0x19, 0x75, 0xa1, 0x7e, 0x4a,
+	0xcf, 0xcd, 0x1c, 0x35, 0xb1, 0x5e, 0xa2, 0x27, 0xb0, 0xf4, 0x91, 0x44, 0x19, 0x35, 0x33, 0x53,
+	0xed, 0x47, 0x7b, 0x24, 0x71, 0xce, 0x7c, 0xbe, 0xf0, 0xac, 0xe6, 0xbe, 0x87, 0x76, 0x55, 0x41,
+	0xdd, 0x9a, 0x33, 0x0d, 0xcb, 0x77, 0xb1, 0x0d, 0xa3, 0x1e, 0x34, 0xc8, 0xb8, 0x72, 0x21, 0x4c,
+	0xf7, 0xee, 0x53, 0x1d, 0xb7, 0xa2, 0xa2, 0x9a, 0x30, 0x79, 0xb1, 0x2e, 0x47, 0xad, 0x82, 0xaf,
+	0xba, 0x3f, 0x7e, 0xaf, 0xd7, 0x7e, 0xaa, 0xe7, 0x97, 0x7a, 0xbe, 0xfd, 0x59, 0xbf, 0xe6, 0x2d,
+	0x9b, 0x9f, 0xc7, 0xd6, 0x5f, 0xb3, 0xd8, 0x17, 0x76, 0xd8, 0x06, 0x00, 0x00,
 }
@@ -30,6 +30,7 @@
 	It has these top-level messages:
 		Metadata
 		CommitLogID
+		HighWaterMark
 */
 package snapshot
 
@@ -51,9 +52,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type Metadata struct {
-	SnapshotIndex int64        `protobuf:"varint,1,opt,name=snapshotIndex,proto3" json:"snapshotIndex,omitempty"`
-	SnapshotUUID  []byte       `protobuf:"bytes,2,opt,name=snapshotUUID,proto3" json:"snapshotUUID,omitempty"`
-	CommitlogID   *CommitLogID `protobuf:"bytes,3,opt,name=commitlogID" json:"commitlogID,omitempty"`
+	SnapshotIndex  int64            `protobuf:"varint,1,opt,name=snapshotIndex,proto3" json:"snapshotIndex,omitempty"`
+	SnapshotUUID   []byte           `protobuf:"bytes,2,opt,name=snapshotUUID,proto3" json:"snapshotUUID,omitempty"`
+	CommitlogID    *CommitLogID     `protobuf:"bytes,3,opt,name=commitlogID" json:"commitlogID,omitempty"`
+	HighWaterMarks []*HighWaterMark `protobuf:"bytes,4,rep,name=highWaterMarks" json:"highWaterMarks,omitempty"`
 }
 
 func (m *Metadata) Reset()                    { *m = Metadata{} }
@@ -82,6 +84,13 @@ func (m *Metadata) GetCommitlogID() *CommitLogID {
 	return nil
 }
 
+func (m *Metadata) GetHighWaterMarks() []*HighWaterMark {
+	if m != nil {
+		return m.HighWaterMarks
+	}
+	return nil
+}
+
 type CommitLogID struct {
 	FilePath string `protobuf:"bytes,1,opt,name=filePath,proto3" json:"filePath,omitempty"`
 	Index    int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -106,9 +115,50 @@ func (m *CommitLogID) GetIndex() int64 {
 	return 0
 }
 
+type HighWaterMark struct {
+	Namespace      []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Shard          uint32 `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	CommitlogIndex int64  `protobuf:"varint,3,opt,name=commitlogIndex,proto3" json:"commitlogIndex,omitempty"`
+	NumEntries     int64  `protobuf:"varint,4,opt,name=numEntries,proto3" json:"numEntries,omitempty"`
+}
+
+func (m *HighWaterMark) Reset()                    { *m = HighWaterMark{} }
+func (m *HighWaterMark) String() string            { return proto.CompactTextString(m) }
+func (*HighWaterMark) ProtoMessage()               {}
+func (*HighWaterMark) Descriptor() ([]byte, []int) { return fileDescriptorSnapshotMetadata, []int{2} }
+
+func (m *HighWaterMark) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *HighWaterMark) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *HighWaterMark) GetCommitlogIndex() int64 {
+	if m != nil {
+		return m.CommitlogIndex
+	}
+	return 0
+}
+
+func (m *HighWaterMark) GetNumEntries() int64 {
+	if m != nil {
+		return m.NumEntries
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Metadata)(nil), "snapshot.Metadata")
 	proto.RegisterType((*CommitLogID)(nil), "snapshot.CommitLogID")
+	proto.RegisterType((*HighWaterMark)(nil), "snapshot.HighWaterMark")
 }
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -146,6 +196,18 @@ func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.HighWaterMarks) > 0 {
+		for _, msg := range m.HighWaterMarks {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintSnapshotMetadata(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -178,6 +240,45 @@ func (m *CommitLogID) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *HighWaterMark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighWaterMark) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSnapshotMetadata(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if m.Shard != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSnapshotMetadata(dAtA, i, uint64(m.Shard))
+	}
+	if m.CommitlogIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSnapshotMetadata(dAtA, i, uint64(m.CommitlogIndex))
+	}
+	if m.NumEntries != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSnapshotMetadata(dAtA, i, uint64(m.NumEntries))
+	}
+	return i, nil
+}
+
 func encodeVarintSnapshotMetadata(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -201,6 +302,12 @@ func (m *Metadata) Size() (n int) {
 		l = m.CommitlogID.Size()
 		n += 1 + l + sovSnapshotMetadata(uint64(l))
 	}
+	if len(m.HighWaterMarks) > 0 {
+		for _, e := range m.HighWaterMarks {
+			l = e.Size()
+			n += 1 + l + sovSnapshotMetadata(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -217,6 +324,25 @@ func (m *CommitLogID) Size() (n int) {
 	return n
 }
 
+func (m *HighWaterMark) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSnapshotMetadata(uint64(l))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovSnapshotMetadata(uint64(m.Shard))
+	}
+	if m.CommitlogIndex != 0 {
+		n += 1 + sovSnapshotMetadata(uint64(m.CommitlogIndex))
+	}
+	if m.NumEntries != 0 {
+		n += 1 + sovSnapshotMetadata(uint64(m.NumEntries))
+	}
+	return n
+}
+
 func sovSnapshotMetadata(x uint64) (n int) {
 	for {
 		n++
@@ -342,6 +468,37 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWaterMarks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshotMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighWaterMarks = append(m.HighWaterMarks, &HighWaterMark{})
+			if err := m.HighWaterMarks[len(m.HighWaterMarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshotMetadata(dAtA[iNdEx:])
@@ -461,6 +618,144 @@ func (m *CommitLogID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HighWaterMark) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshotMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighWaterMark: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighWaterMark: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshotMetadata
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitlogIndex", wireType)
+			}
+			m.CommitlogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitlogIndex |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEntries", wireType)
+			}
+			m.NumEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEntries |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshotMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSnapshotMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSnapshotMetadata(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -571,21 +866,26 @@ func init() {
 }
 
 var fileDescriptorSnapshotMetadata = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xf2, 0x4b, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x35, 0x4e, 0x49, 0xd2, 0xcf, 0x35, 0xd6, 0x2f,
-	0x2e, 0x4a, 0xd6, 0x4f, 0x49, 0xca, 0xcb, 0x4f, 0x49, 0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0x4a,
-	0x2c, 0x49, 0x4d, 0xd1, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0xd7, 0x2f, 0xce, 0x4b, 0x2c, 0x28, 0xce,
-	0xc8, 0x2f, 0x81, 0x33, 0xe2, 0x73, 0x53, 0x4b, 0x12, 0x53, 0x12, 0x4b, 0x12, 0xf5, 0xc0, 0x0a,
-	0x84, 0x38, 0x60, 0x12, 0x4a, 0xbd, 0x8c, 0x5c, 0x1c, 0xbe, 0x50, 0x49, 0x21, 0x15, 0x2e, 0x5e,
-	0x98, 0x84, 0x67, 0x5e, 0x4a, 0x6a, 0x85, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x73, 0x10, 0xaa, 0xa0,
-	0x90, 0x12, 0x17, 0x0f, 0x4c, 0x20, 0x34, 0xd4, 0xd3, 0x45, 0x82, 0x49, 0x81, 0x51, 0x83, 0x27,
-	0x08, 0x45, 0x4c, 0xc8, 0x9c, 0x8b, 0x3b, 0x39, 0x3f, 0x37, 0x37, 0xb3, 0x24, 0x27, 0x3f, 0xdd,
-	0xd3, 0x45, 0x82, 0x59, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x54, 0x0f, 0xa6, 0x46, 0xcf, 0x19, 0x2c,
-	0xe9, 0x03, 0x92, 0x0c, 0x42, 0x56, 0xa9, 0x64, 0xcf, 0xc5, 0x8d, 0x24, 0x27, 0x24, 0xc5, 0xc5,
-	0x91, 0x96, 0x99, 0x93, 0x1a, 0x90, 0x58, 0x92, 0x01, 0x76, 0x0c, 0x67, 0x10, 0x9c, 0x2f, 0x24,
-	0xc2, 0xc5, 0x9a, 0x09, 0x76, 0x25, 0x13, 0xd8, 0x95, 0x10, 0x8e, 0x93, 0xc0, 0x89, 0x47, 0x72,
-	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0x43, 0x12, 0x1b,
-	0xd8, 0xcf, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x66, 0xf1, 0x77, 0x45, 0x01, 0x00,
-	0x00,
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x18, 0x85, 0xef, 0x34, 0xf7, 0x5e, 0xda, 0x3f, 0x6d, 0x91, 0x41, 0x31, 0x88, 0x84, 0x10, 0x44,
+	0xb2, 0x4a, 0xa0, 0x5d, 0xb8, 0x2c, 0x68, 0x05, 0x0b, 0x56, 0x24, 0x50, 0x5c, 0xca, 0x24, 0x33,
+	0x26, 0xc1, 0xce, 0x4c, 0x99, 0x4c, 0xc1, 0x77, 0x70, 0xe3, 0x63, 0xb9, 0x12, 0x1f, 0x41, 0xea,
+	0x8b, 0x48, 0xa6, 0xa6, 0x4d, 0xbb, 0xcb, 0x7f, 0xbe, 0x93, 0x73, 0x38, 0x0c, 0xdc, 0x65, 0x85,
+	0xce, 0x97, 0x49, 0x98, 0x4a, 0x1e, 0xf1, 0x21, 0x4d, 0x22, 0x3e, 0x8c, 0x4a, 0x95, 0x46, 0x34,
+	0x11, 0x92, 0xb2, 0x28, 0x63, 0x82, 0x29, 0xa2, 0x19, 0x8d, 0x16, 0x4a, 0x6a, 0x19, 0x95, 0x82,
+	0x2c, 0xca, 0x5c, 0xea, 0xcd, 0xc7, 0x23, 0x67, 0x9a, 0x50, 0xa2, 0x49, 0x68, 0x0c, 0xb8, 0x5d,
+	0x03, 0xff, 0x03, 0x41, 0x7b, 0xfa, 0x0b, 0xf1, 0x19, 0xf4, 0x6a, 0x30, 0x11, 0x94, 0xbd, 0x38,
+	0xc8, 0x43, 0x81, 0x15, 0xef, 0x8a, 0xd8, 0x87, 0x6e, 0x2d, 0xcc, 0x66, 0x93, 0xb1, 0xd3, 0xf2,
+	0x50, 0xd0, 0x8d, 0x77, 0x34, 0x7c, 0x01, 0x76, 0x2a, 0x39, 0x2f, 0xf4, 0x5c, 0x66, 0x93, 0xb1,
+	0x63, 0x79, 0x28, 0xb0, 0x07, 0x47, 0x61, 0xed, 0x09, 0xaf, 0x0c, 0xbc, 0xad, 0x60, 0xdc, 0x74,
+	0xe2, 0x11, 0xf4, 0xf3, 0x22, 0xcb, 0x1f, 0x88, 0x66, 0x6a, 0x4a, 0xd4, 0x73, 0xe9, 0xfc, 0xf5,
+	0xac, 0xc0, 0x1e, 0x1c, 0x6f, 0xff, 0xbd, 0x69, 0xf2, 0x78, 0xcf, 0xee, 0x8f, 0xc0, 0x6e, 0x84,
+	0xe3, 0x13, 0x68, 0x3f, 0x15, 0x73, 0x76, 0x4f, 0x74, 0x6e, 0xd6, 0x74, 0xe2, 0xcd, 0x8d, 0x0f,
+	0xe1, 0x5f, 0x61, 0x66, 0xb6, 0xcc, 0xcc, 0xf5, 0xe1, 0xbf, 0x22, 0xe8, 0xed, 0x54, 0xe0, 0x53,
+	0xe8, 0x08, 0xc2, 0x59, 0xb9, 0x20, 0x29, 0x33, 0x21, 0xdd, 0x78, 0x2b, 0x54, 0x29, 0x65, 0x4e,
+	0x14, 0x35, 0x29, 0xbd, 0x78, 0x7d, 0xe0, 0x73, 0xe8, 0x6f, 0x67, 0x99, 0x12, 0xcb, 0x94, 0xec,
+	0xa9, 0xd8, 0x05, 0x10, 0x4b, 0x7e, 0x2d, 0xb4, 0x2a, 0x58, 0xb5, 0xb5, 0xf2, 0x34, 0x94, 0xcb,
+	0x83, 0xf7, 0x95, 0x8b, 0x3e, 0x57, 0x2e, 0xfa, 0x5a, 0xb9, 0xe8, 0xed, 0xdb, 0xfd, 0x93, 0xfc,
+	0x37, 0x4f, 0x38, 0xfc, 0x19, 0x00, 0xb2, 0xc7, 0x64, 0x3e, 0x14, 0x02, 0x00, 0x00,
 }
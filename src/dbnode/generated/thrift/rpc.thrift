@@ -29,7 +29,8 @@ enum TimeType {
 
 enum ErrorType {
 	INTERNAL_ERROR,
-	BAD_REQUEST
+	BAD_REQUEST,
+	RESOURCE_EXHAUSTED
 }
 
 exception Error {
@@ -63,6 +64,9 @@ service Node {
 	void writeTaggedBatchRawV2(1: WriteTaggedBatchRawV2Request req) throws (1: WriteBatchRawErrors err)
 	void repair() throws (1: Error err)
 	TruncateResult truncate(1: TruncateRequest req) throws (1: Error err)
+	DeleteTaggedResult deleteTagged(1: DeleteTaggedRequest req) throws (1: Error err)
+	CardinalityResult cardinality(1: CardinalityRequest req) throws (1: Error err)
+	void writeDocument(1: WriteDocumentRequest req) throws (1: Error err)
 
 	// Management endpoints
 	NodeHealthResult health() throws (1: Error err)
@@ -168,11 +172,15 @@ struct FetchTaggedRequest {
 	5: required bool fetchData
 	6: optional i64 limit
 	7: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+	8: optional binary pageToken
+	9: optional bool explain
 }
 
 struct FetchTaggedResult {
 	1: required list<FetchTaggedIDResult> elements
 	2: required bool exhaustive
+	3: optional binary nextPageToken
+	4: optional binary explain
 }
 
 struct FetchTaggedIDResult {
@@ -300,6 +308,55 @@ struct TruncateResult {
 	1: required i64 numSeries
 }
 
+struct DeleteTaggedRequest {
+	1: required binary nameSpace
+	2: required binary query
+	3: required i64 rangeStart
+	4: required i64 rangeEnd
+	5: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+}
+
+struct DeleteTaggedResult {
+	1: required i64 numSeries
+	2: optional map<i32, i64> numSeriesByShard
+}
+
+struct CardinalityRequest {
+	1: required binary nameSpace
+	2: required i64 rangeStart
+	3: required i64 rangeEnd
+	4: optional i64 fieldsLimit
+	5: optional i64 valuesLimit
+	6: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+}
+
+struct CardinalityValue {
+	1: required binary value
+	2: required i64 seriesEstimate
+	3: required binary seriesSketch
+}
+
+struct CardinalityField {
+	1: required binary name
+	2: required i64 seriesEstimate
+	3: required binary seriesSketch
+	4: required i64 valuesEstimate
+	5: required binary valuesSketch
+	6: required list<CardinalityValue> topValues
+}
+
+struct CardinalityResult {
+	1: required list<CardinalityField> fields
+}
+
+struct WriteDocumentRequest {
+	1: required binary nameSpace
+	2: required binary id
+	3: required binary encodedTags
+	4: required i64 timestamp
+	5: optional TimeType timestampTimeType = TimeType.UNIX_SECONDS
+}
+
 struct NodeHealthResult {
 	1: required bool ok
 	2: required string status
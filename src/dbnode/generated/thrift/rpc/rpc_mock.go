@@ -524,6 +524,36 @@ func (mr *MockTChanNodeMockRecorder) Truncate(ctx, req interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*MockTChanNode)(nil).Truncate), ctx, req)
 }
 
+// DeleteTagged mocks base method
+func (m *MockTChanNode) DeleteTagged(ctx thrift.Context, req *DeleteTaggedRequest) (*DeleteTaggedResult_, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", ctx, req)
+	ret0, _ := ret[0].(*DeleteTaggedResult_)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged
+func (mr *MockTChanNodeMockRecorder) DeleteTagged(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*MockTChanNode)(nil).DeleteTagged), ctx, req)
+}
+
+// Cardinality mocks base method
+func (m *MockTChanNode) Cardinality(ctx thrift.Context, req *CardinalityRequest) (*CardinalityResult_, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", ctx, req)
+	ret0, _ := ret[0].(*CardinalityResult_)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockTChanNodeMockRecorder) Cardinality(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockTChanNode)(nil).Cardinality), ctx, req)
+}
+
 // Write mocks base method
 func (m *MockTChanNode) Write(ctx thrift.Context, req *WriteRequest) error {
 	m.ctrl.T.Helper()
@@ -566,6 +596,20 @@ func (mr *MockTChanNodeMockRecorder) WriteBatchRawV2(ctx, req interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteBatchRawV2", reflect.TypeOf((*MockTChanNode)(nil).WriteBatchRawV2), ctx, req)
 }
 
+// WriteDocument mocks base method
+func (m *MockTChanNode) WriteDocument(ctx thrift.Context, req *WriteDocumentRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDocument", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteDocument indicates an expected call of WriteDocument
+func (mr *MockTChanNodeMockRecorder) WriteDocument(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDocument", reflect.TypeOf((*MockTChanNode)(nil).WriteDocument), ctx, req)
+}
+
 // WriteTagged mocks base method
 func (m *MockTChanNode) WriteTagged(ctx thrift.Context, req *WriteTaggedRequest) error {
 	m.ctrl.T.Helper()
@@ -33,6 +33,11 @@ const (
 	// configuration specifying a hard limit for a cluster new series insertions.
 	ClusterNewSeriesInsertLimitKey = "m3db.node.cluster-new-series-insert-limit"
 
+	// NamespaceQuotasKey is the KV config key for the runtime configuration
+	// specifying the per namespace quotas on datapoints written, new series
+	// inserted and index documents matched per second enforced by each node.
+	NamespaceQuotasKey = "m3db.node.namespace-quotas"
+
 	// ClientBootstrapConsistencyLevel is the KV config key for the runtime
 	// configuration specifying the client bootstrap consistency level
 	ClientBootstrapConsistencyLevel = "m3db.client.bootstrap-consistency-level"
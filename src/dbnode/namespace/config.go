@@ -48,15 +48,20 @@ func (m *MapConfiguration) Map() (Map, error) {
 
 // MetadataConfiguration is the configuration for a single namespace
 type MetadataConfiguration struct {
-	ID                string                  `yaml:"id" validate:"nonzero"`
-	BootstrapEnabled  *bool                   `yaml:"bootstrapEnabled"`
-	FlushEnabled      *bool                   `yaml:"flushEnabled"`
-	WritesToCommitLog *bool                   `yaml:"writesToCommitLog"`
-	CleanupEnabled    *bool                   `yaml:"cleanupEnabled"`
-	RepairEnabled     *bool                   `yaml:"repairEnabled"`
-	ColdWritesEnabled *bool                   `yaml:"coldWritesEnabled"`
-	Retention         retention.Configuration `yaml:"retention" validate:"nonzero"`
-	Index             IndexConfiguration      `yaml:"index"`
+	ID                string                        `yaml:"id" validate:"nonzero"`
+	BootstrapEnabled  *bool                         `yaml:"bootstrapEnabled"`
+	FlushEnabled      *bool                         `yaml:"flushEnabled"`
+	WritesToCommitLog *bool                         `yaml:"writesToCommitLog"`
+	CleanupEnabled    *bool                         `yaml:"cleanupEnabled"`
+	RepairEnabled     *bool                         `yaml:"repairEnabled"`
+	RepairThrottle    *time.Duration                `yaml:"repairThrottle"`
+	ColdWritesEnabled *bool                         `yaml:"coldWritesEnabled"`
+	IndexOnly         *bool                         `yaml:"indexOnly"`
+	HistogramsEnabled *bool                         `yaml:"histogramsEnabled"`
+	Retention         retention.Configuration       `yaml:"retention" validate:"nonzero"`
+	Index             IndexConfiguration            `yaml:"index"`
+	ResolutionTiers   []ResolutionTierConfiguration `yaml:"resolutionTiers"`
+	ReplicateTo       []string                      `yaml:"replicateTo"`
 }
 
 // Metadata returns a Metadata corresponding to the receiver struct
@@ -81,9 +86,28 @@ func (mc *MetadataConfiguration) Metadata() (Metadata, error) {
 	if v := mc.RepairEnabled; v != nil {
 		opts = opts.SetRepairEnabled(*v)
 	}
+	if v := mc.RepairThrottle; v != nil {
+		opts = opts.SetRepairThrottle(*v)
+	}
 	if v := mc.ColdWritesEnabled; v != nil {
 		opts = opts.SetColdWritesEnabled(*v)
 	}
+	if v := mc.IndexOnly; v != nil {
+		opts = opts.SetIndexOnly(*v)
+	}
+	if v := mc.HistogramsEnabled; v != nil {
+		opts = opts.SetHistogramsEnabled(*v)
+	}
+	if len(mc.ResolutionTiers) > 0 {
+		tiers := make([]ResolutionTier, 0, len(mc.ResolutionTiers))
+		for _, tc := range mc.ResolutionTiers {
+			tiers = append(tiers, tc.ResolutionTier())
+		}
+		opts = opts.SetResolutionTiers(tiers)
+	}
+	if len(mc.ReplicateTo) > 0 {
+		opts = opts.SetReplicationClusters(mc.ReplicateTo)
+	}
 	return NewMetadata(ident.StringID(mc.ID), opts)
 }
 
@@ -99,3 +123,18 @@ func (ic *IndexConfiguration) Options() IndexOptions {
 		SetEnabled(ic.Enabled).
 		SetBlockSize(ic.BlockSize)
 }
+
+// ResolutionTierConfiguration is the configuration for a single resolution
+// tier that aged blocks are downsampled to.
+type ResolutionTierConfiguration struct {
+	Resolution time.Duration `yaml:"resolution" validate:"nonzero"`
+	Age        time.Duration `yaml:"age" validate:"nonzero"`
+}
+
+// ResolutionTier returns the ResolutionTier corresponding to the receiver struct.
+func (tc *ResolutionTierConfiguration) ResolutionTier() ResolutionTier {
+	return ResolutionTier{
+		Resolution: tc.Resolution,
+		Age:        tc.Age,
+	}
+}
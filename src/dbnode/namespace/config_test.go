@@ -232,3 +232,84 @@ metadatas:
 	require.True(t, testRetentionOpts.Equal(opts.RetentionOptions()))
 
 }
+
+func TestMetadataConfigResolutionTiers(t *testing.T) {
+	config := &MetadataConfiguration{
+		ID: "downsampled",
+		Retention: retention.Configuration{
+			BlockSize:       2 * time.Hour,
+			RetentionPeriod: 365 * 24 * time.Hour,
+			BufferFuture:    time.Minute,
+			BufferPast:      time.Minute,
+		},
+		ResolutionTiers: []ResolutionTierConfiguration{
+			{Resolution: time.Minute, Age: 2 * 24 * time.Hour},
+			{Resolution: time.Hour, Age: 30 * 24 * time.Hour},
+		},
+	}
+
+	metadata, err := config.Metadata()
+	require.NoError(t, err)
+	require.Equal(t, []ResolutionTier{
+		{Resolution: time.Minute, Age: 2 * 24 * time.Hour},
+		{Resolution: time.Hour, Age: 30 * 24 * time.Hour},
+	}, metadata.Options().ResolutionTiers())
+}
+
+func TestMetadataConfigRepairThrottle(t *testing.T) {
+	var (
+		enabled  = true
+		throttle = 2 * time.Minute
+	)
+	config := &MetadataConfiguration{
+		ID:             "repaired",
+		RepairEnabled:  &enabled,
+		RepairThrottle: &throttle,
+		Retention: retention.Configuration{
+			BlockSize:       2 * time.Hour,
+			RetentionPeriod: 48 * time.Hour,
+		},
+	}
+
+	metadata, err := config.Metadata()
+	require.NoError(t, err)
+	require.True(t, metadata.Options().RepairEnabled())
+	repairThrottle, ok := metadata.Options().RepairThrottle()
+	require.True(t, ok)
+	require.Equal(t, throttle, repairThrottle)
+}
+
+func TestMetadataConfigIndexOnly(t *testing.T) {
+	indexOnly := true
+	config := &MetadataConfiguration{
+		ID:        "events",
+		IndexOnly: &indexOnly,
+		Retention: retention.Configuration{
+			BlockSize:       2 * time.Hour,
+			RetentionPeriod: 48 * time.Hour,
+		},
+		Index: IndexConfiguration{
+			Enabled:   true,
+			BlockSize: 2 * time.Hour,
+		},
+	}
+
+	metadata, err := config.Metadata()
+	require.NoError(t, err)
+	require.True(t, metadata.Options().IndexOnly())
+}
+
+func TestMetadataConfigReplicateTo(t *testing.T) {
+	config := &MetadataConfiguration{
+		ID:          "metrics",
+		ReplicateTo: []string{"us-east"},
+		Retention: retention.Configuration{
+			BlockSize:       2 * time.Hour,
+			RetentionPeriod: 48 * time.Hour,
+		},
+	}
+
+	metadata, err := config.Metadata()
+	require.NoError(t, err)
+	require.Equal(t, []string{"us-east"}, metadata.Options().ReplicationClusters())
+}
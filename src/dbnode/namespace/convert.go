@@ -79,6 +79,25 @@ func ToIndexOptions(
 	return iopts, nil
 }
 
+// ToResolutionTiers converts nsproto.ResolutionTier to ResolutionTier
+func ToResolutionTiers(
+	tiers []*nsproto.ResolutionTier,
+) []ResolutionTier {
+	if len(tiers) == 0 {
+		return nil
+	}
+
+	result := make([]ResolutionTier, 0, len(tiers))
+	for _, t := range tiers {
+		result = append(result, ResolutionTier{
+			Resolution: fromNanos(t.ResolutionNanos),
+			Age:        fromNanos(t.AgeNanos),
+		})
+	}
+
+	return result
+}
+
 // ToMetadata converts nsproto.Options to Metadata
 func ToMetadata(
 	id string,
@@ -113,7 +132,14 @@ func ToMetadata(
 		SetSchemaHistory(sr).
 		SetRetentionOptions(ropts).
 		SetIndexOptions(iopts).
-		SetColdWritesEnabled(opts.ColdWritesEnabled)
+		SetColdWritesEnabled(opts.ColdWritesEnabled).
+		SetReplicationClusters(opts.ReplicationClusters).
+		SetIndexOnly(opts.IndexOnly).
+		SetHistogramsEnabled(opts.HistogramsEnabled).
+		SetResolutionTiers(ToResolutionTiers(opts.ResolutionTiers))
+	if t := opts.RepairThrottle; t != nil {
+		mopts = mopts.SetRepairThrottle(fromNanos(t.ThrottleNanos))
+	}
 
 	return NewMetadata(ident.StringID(id), mopts)
 }
@@ -170,6 +196,36 @@ func OptionsToProto(opts Options) *nsproto.NamespaceOptions {
 			Enabled:        iopts.Enabled(),
 			BlockSizeNanos: iopts.BlockSize().Nanoseconds(),
 		},
-		ColdWritesEnabled: opts.ColdWritesEnabled(),
+		ColdWritesEnabled:   opts.ColdWritesEnabled(),
+		ReplicationClusters: opts.ReplicationClusters(),
+		IndexOnly:           opts.IndexOnly(),
+		ResolutionTiers:     toResolutionTiersProto(opts.ResolutionTiers()),
+		RepairThrottle:      toRepairThrottleProto(opts),
+		HistogramsEnabled:   opts.HistogramsEnabled(),
 	}
 }
+
+func toRepairThrottleProto(opts Options) *nsproto.RepairThrottle {
+	throttle, ok := opts.RepairThrottle()
+	if !ok {
+		return nil
+	}
+
+	return &nsproto.RepairThrottle{ThrottleNanos: throttle.Nanoseconds()}
+}
+
+func toResolutionTiersProto(tiers []ResolutionTier) []*nsproto.ResolutionTier {
+	if len(tiers) == 0 {
+		return nil
+	}
+
+	result := make([]*nsproto.ResolutionTier, 0, len(tiers))
+	for _, t := range tiers {
+		result = append(result, &nsproto.ResolutionTier{
+			ResolutionNanos: t.Resolution.Nanoseconds(),
+			AgeNanos:        t.Age.Nanoseconds(),
+		})
+	}
+
+	return result
+}
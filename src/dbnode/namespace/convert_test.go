@@ -271,6 +271,144 @@ func TestFromProtoSnapshotEnabled(t *testing.T) {
 	require.Equal(t, !namespace.NewOptions().SnapshotEnabled(), md.Options().SnapshotEnabled())
 }
 
+func TestReplicationClustersRoundTrip(t *testing.T) {
+	md, err := namespace.NewMetadata(
+		ident.StringID("ns1"),
+		namespace.NewOptions().
+			SetReplicationClusters([]string{"us-east", "eu-west"}),
+	)
+	require.NoError(t, err)
+	nsMap, err := namespace.NewMap([]namespace.Metadata{md})
+	require.NoError(t, err)
+
+	data, err := namespace.ToProto(nsMap).Marshal()
+	require.NoError(t, err)
+
+	var reg nsproto.Registry
+	require.NoError(t, reg.Unmarshal(data))
+	require.Equal(t, []string{"us-east", "eu-west"},
+		reg.Namespaces["ns1"].ReplicationClusters)
+
+	nsMap, err = namespace.FromProto(reg)
+	require.NoError(t, err)
+	observed, err := nsMap.Get(ident.StringID("ns1"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"us-east", "eu-west"},
+		observed.Options().ReplicationClusters())
+}
+
+func TestIndexOnlyRoundTrip(t *testing.T) {
+	md, err := namespace.NewMetadata(
+		ident.StringID("ns1"),
+		namespace.NewOptions().
+			SetIndexOnly(true).
+			SetIndexOptions(namespace.NewIndexOptions().SetEnabled(true)),
+	)
+	require.NoError(t, err)
+	nsMap, err := namespace.NewMap([]namespace.Metadata{md})
+	require.NoError(t, err)
+
+	data, err := namespace.ToProto(nsMap).Marshal()
+	require.NoError(t, err)
+
+	var reg nsproto.Registry
+	require.NoError(t, reg.Unmarshal(data))
+	require.True(t, reg.Namespaces["ns1"].IndexOnly)
+
+	nsMap, err = namespace.FromProto(reg)
+	require.NoError(t, err)
+	observed, err := nsMap.Get(ident.StringID("ns1"))
+	require.NoError(t, err)
+	require.True(t, observed.Options().IndexOnly())
+}
+
+func TestHistogramsEnabledRoundTrip(t *testing.T) {
+	md, err := namespace.NewMetadata(ident.StringID("ns1"),
+		namespace.NewOptions().SetHistogramsEnabled(true))
+	require.NoError(t, err)
+	nsMap, err := namespace.NewMap([]namespace.Metadata{md})
+	require.NoError(t, err)
+
+	data, err := namespace.ToProto(nsMap).Marshal()
+	require.NoError(t, err)
+
+	var reg nsproto.Registry
+	require.NoError(t, reg.Unmarshal(data))
+	require.True(t, reg.Namespaces["ns1"].HistogramsEnabled)
+
+	nsMap, err = namespace.FromProto(reg)
+	require.NoError(t, err)
+	observed, err := nsMap.Get(ident.StringID("ns1"))
+	require.NoError(t, err)
+	require.True(t, observed.Options().HistogramsEnabled())
+}
+
+func TestResolutionTiersRoundTrip(t *testing.T) {
+	tiers := []namespace.ResolutionTier{
+		{Resolution: time.Minute, Age: 6 * time.Hour},
+		{Resolution: 5 * time.Minute, Age: 24 * time.Hour},
+	}
+	md, err := namespace.NewMetadata(
+		ident.StringID("ns1"),
+		namespace.NewOptions().SetResolutionTiers(tiers),
+	)
+	require.NoError(t, err)
+	nsMap, err := namespace.NewMap([]namespace.Metadata{md})
+	require.NoError(t, err)
+
+	data, err := namespace.ToProto(nsMap).Marshal()
+	require.NoError(t, err)
+
+	var reg nsproto.Registry
+	require.NoError(t, reg.Unmarshal(data))
+	require.Equal(t, []*nsproto.ResolutionTier{
+		{ResolutionNanos: time.Minute.Nanoseconds(), AgeNanos: (6 * time.Hour).Nanoseconds()},
+		{ResolutionNanos: (5 * time.Minute).Nanoseconds(), AgeNanos: (24 * time.Hour).Nanoseconds()},
+	}, reg.Namespaces["ns1"].ResolutionTiers)
+
+	nsMap, err = namespace.FromProto(reg)
+	require.NoError(t, err)
+	observed, err := nsMap.Get(ident.StringID("ns1"))
+	require.NoError(t, err)
+	require.Equal(t, tiers, observed.Options().ResolutionTiers())
+}
+
+func TestRepairThrottleRoundTrip(t *testing.T) {
+	for _, throttle := range []time.Duration{0, time.Minute} {
+		md, err := namespace.NewMetadata(
+			ident.StringID("ns1"),
+			namespace.NewOptions().SetRepairThrottle(throttle),
+		)
+		require.NoError(t, err)
+		nsMap, err := namespace.NewMap([]namespace.Metadata{md})
+		require.NoError(t, err)
+
+		data, err := namespace.ToProto(nsMap).Marshal()
+		require.NoError(t, err)
+
+		var reg nsproto.Registry
+		require.NoError(t, reg.Unmarshal(data))
+		require.Equal(t, &nsproto.RepairThrottle{ThrottleNanos: throttle.Nanoseconds()},
+			reg.Namespaces["ns1"].RepairThrottle)
+
+		nsMap, err = namespace.FromProto(reg)
+		require.NoError(t, err)
+		observed, err := nsMap.Get(ident.StringID("ns1"))
+		require.NoError(t, err)
+		observedThrottle, ok := observed.Options().RepairThrottle()
+		require.True(t, ok)
+		require.Equal(t, throttle, observedThrottle)
+	}
+
+	// Namespaces without a throttle use the repairer's throttle.
+	opts := namespace.OptionsToProto(namespace.NewOptions())
+	require.Nil(t, opts.RepairThrottle)
+	md, err := namespace.ToMetadata("ns1", opts)
+	require.NoError(t, err)
+	_, ok := md.Options().RepairThrottle()
+	require.False(t, ok)
+}
+
 func assertEqualMetadata(t *testing.T, name string, expected nsproto.NamespaceOptions, observed namespace.Metadata) {
 	require.Equal(t, name, observed.ID().String())
 	opts := observed.Options()
@@ -280,6 +418,8 @@ func assertEqualMetadata(t *testing.T, name string, expected nsproto.NamespaceOp
 	require.Equal(t, expected.WritesToCommitLog, opts.WritesToCommitLog())
 	require.Equal(t, expected.CleanupEnabled, opts.CleanupEnabled())
 	require.Equal(t, expected.RepairEnabled, opts.RepairEnabled())
+	require.Equal(t, expected.IndexOnly, opts.IndexOnly())
+	require.Equal(t, expected.HistogramsEnabled, opts.HistogramsEnabled())
 	expectedSchemaReg, err := namespace.LoadSchemaHistory(expected.SchemaOptions)
 	require.NoError(t, err)
 	require.NotNil(t, expectedSchemaReg)
@@ -256,6 +256,35 @@ func (mr *MockOptionsMockRecorder) RepairEnabled() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairEnabled", reflect.TypeOf((*MockOptions)(nil).RepairEnabled))
 }
 
+// SetRepairThrottle mocks base method
+func (m *MockOptions) SetRepairThrottle(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRepairThrottle", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetRepairThrottle indicates an expected call of SetRepairThrottle
+func (mr *MockOptionsMockRecorder) SetRepairThrottle(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRepairThrottle", reflect.TypeOf((*MockOptions)(nil).SetRepairThrottle), value)
+}
+
+// RepairThrottle mocks base method
+func (m *MockOptions) RepairThrottle() (time.Duration, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepairThrottle")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// RepairThrottle indicates an expected call of RepairThrottle
+func (mr *MockOptionsMockRecorder) RepairThrottle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairThrottle", reflect.TypeOf((*MockOptions)(nil).RepairThrottle))
+}
+
 // SetColdWritesEnabled mocks base method
 func (m *MockOptions) SetColdWritesEnabled(value bool) Options {
 	m.ctrl.T.Helper()
@@ -284,6 +313,90 @@ func (mr *MockOptionsMockRecorder) ColdWritesEnabled() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdWritesEnabled", reflect.TypeOf((*MockOptions)(nil).ColdWritesEnabled))
 }
 
+// SetIndexOnly mocks base method
+func (m *MockOptions) SetIndexOnly(value bool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIndexOnly", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetIndexOnly indicates an expected call of SetIndexOnly
+func (mr *MockOptionsMockRecorder) SetIndexOnly(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIndexOnly", reflect.TypeOf((*MockOptions)(nil).SetIndexOnly), value)
+}
+
+// IndexOnly mocks base method
+func (m *MockOptions) IndexOnly() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexOnly")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IndexOnly indicates an expected call of IndexOnly
+func (mr *MockOptionsMockRecorder) IndexOnly() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexOnly", reflect.TypeOf((*MockOptions)(nil).IndexOnly))
+}
+
+// SetHistogramsEnabled mocks base method
+func (m *MockOptions) SetHistogramsEnabled(value bool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHistogramsEnabled", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHistogramsEnabled indicates an expected call of SetHistogramsEnabled
+func (mr *MockOptionsMockRecorder) SetHistogramsEnabled(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHistogramsEnabled", reflect.TypeOf((*MockOptions)(nil).SetHistogramsEnabled), value)
+}
+
+// HistogramsEnabled mocks base method
+func (m *MockOptions) HistogramsEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HistogramsEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HistogramsEnabled indicates an expected call of HistogramsEnabled
+func (mr *MockOptionsMockRecorder) HistogramsEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HistogramsEnabled", reflect.TypeOf((*MockOptions)(nil).HistogramsEnabled))
+}
+
+// SetReplicationClusters mocks base method
+func (m *MockOptions) SetReplicationClusters(value []string) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReplicationClusters", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReplicationClusters indicates an expected call of SetReplicationClusters
+func (mr *MockOptionsMockRecorder) SetReplicationClusters(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReplicationClusters", reflect.TypeOf((*MockOptions)(nil).SetReplicationClusters), value)
+}
+
+// ReplicationClusters mocks base method
+func (m *MockOptions) ReplicationClusters() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicationClusters")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ReplicationClusters indicates an expected call of ReplicationClusters
+func (mr *MockOptionsMockRecorder) ReplicationClusters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicationClusters", reflect.TypeOf((*MockOptions)(nil).ReplicationClusters))
+}
+
 // SetRetentionOptions mocks base method
 func (m *MockOptions) SetRetentionOptions(value retention.Options) Options {
 	m.ctrl.T.Helper()
@@ -368,6 +481,34 @@ func (mr *MockOptionsMockRecorder) SchemaHistory() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaHistory", reflect.TypeOf((*MockOptions)(nil).SchemaHistory))
 }
 
+// SetResolutionTiers mocks base method
+func (m *MockOptions) SetResolutionTiers(value []ResolutionTier) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetResolutionTiers", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetResolutionTiers indicates an expected call of SetResolutionTiers
+func (mr *MockOptionsMockRecorder) SetResolutionTiers(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetResolutionTiers", reflect.TypeOf((*MockOptions)(nil).SetResolutionTiers), value)
+}
+
+// ResolutionTiers mocks base method
+func (m *MockOptions) ResolutionTiers() []ResolutionTier {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolutionTiers")
+	ret0, _ := ret[0].([]ResolutionTier)
+	return ret0
+}
+
+// ResolutionTiers indicates an expected call of ResolutionTiers
+func (mr *MockOptionsMockRecorder) ResolutionTiers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolutionTiers", reflect.TypeOf((*MockOptions)(nil).ResolutionTiers))
+}
+
 // MockIndexOptions is a mock of IndexOptions interface
 type MockIndexOptions struct {
 	ctrl     *gomock.Controller
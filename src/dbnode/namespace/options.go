@@ -22,6 +22,8 @@ package namespace
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/m3db/m3/src/dbnode/retention"
 )
@@ -53,19 +55,32 @@ var (
 	errIndexBlockSizePositive                       = errors.New("index block size must positive")
 	errIndexBlockSizeTooLarge                       = errors.New("index block size needs to be <= namespace retention period")
 	errIndexBlockSizeMustBeAMultipleOfDataBlockSize = errors.New("index block size must be a multiple of data block size")
+	errResolutionTierResolutionPositive             = errors.New("resolution tier resolution must be positive")
+	errResolutionTierAgeTooSmall                    = errors.New("resolution tier age must be >= data block size")
+	errResolutionTierAgeTooLarge                    = errors.New("resolution tier age must be < namespace retention period")
+	errResolutionTierBlockSizeNotMultiple           = errors.New("data block size must be a multiple of resolution tier resolution")
+	errRepairThrottleNegative                       = errors.New("repair throttle must not be negative")
+	errIndexOnlyIndexDisabled                       = errors.New("index only namespaces must have indexing enabled")
+	errHistogramsWithSchema                         = errors.New("histogram namespaces cannot have a schema")
 )
 
 type options struct {
-	bootstrapEnabled  bool
-	flushEnabled      bool
-	snapshotEnabled   bool
-	writesToCommitLog bool
-	cleanupEnabled    bool
-	repairEnabled     bool
-	coldWritesEnabled bool
-	retentionOpts     retention.Options
-	indexOpts         IndexOptions
-	schemaHis         SchemaHistory
+	bootstrapEnabled    bool
+	flushEnabled        bool
+	snapshotEnabled     bool
+	writesToCommitLog   bool
+	cleanupEnabled      bool
+	repairEnabled       bool
+	coldWritesEnabled   bool
+	indexOnly           bool
+	histogramsEnabled   bool
+	repairThrottle      time.Duration
+	repairThrottleSet   bool
+	retentionOpts       retention.Options
+	indexOpts           IndexOptions
+	schemaHis           SchemaHistory
+	resolutionTiers     []ResolutionTier
+	replicationClusters []string
 }
 
 // NewSchemaHistory returns an empty schema history.
@@ -93,7 +108,21 @@ func (o *options) Validate() error {
 	if err := o.retentionOpts.Validate(); err != nil {
 		return err
 	}
+	if err := o.validateResolutionTiers(); err != nil {
+		return err
+	}
+	if o.repairThrottle < 0 {
+		return errRepairThrottleNegative
+	}
+	if o.histogramsEnabled && o.schemaHis != nil {
+		if _, ok := o.schemaHis.GetLatest(); ok {
+			return errHistogramsWithSchema
+		}
+	}
 	if !o.indexOpts.Enabled() {
+		if o.indexOnly {
+			return errIndexOnlyIndexDisabled
+		}
 		return nil
 	}
 	var (
@@ -114,6 +143,39 @@ func (o *options) Validate() error {
 	return nil
 }
 
+func (o *options) validateResolutionTiers() error {
+	if len(o.resolutionTiers) == 0 {
+		return nil
+	}
+	var (
+		retention     = o.retentionOpts.RetentionPeriod()
+		dataBlockSize = o.retentionOpts.BlockSize()
+		prev          ResolutionTier
+	)
+	for i, tier := range o.resolutionTiers {
+		if tier.Resolution <= 0 {
+			return errResolutionTierResolutionPositive
+		}
+		if tier.Age < dataBlockSize {
+			return errResolutionTierAgeTooSmall
+		}
+		if tier.Age >= retention {
+			return errResolutionTierAgeTooLarge
+		}
+		if dataBlockSize%tier.Resolution != 0 {
+			return errResolutionTierBlockSizeNotMultiple
+		}
+		if i > 0 && (tier.Age <= prev.Age || tier.Resolution <= prev.Resolution) {
+			return fmt.Errorf(
+				"resolution tiers must be in order of increasing age and resolution: "+
+					"tier %d (resolution=%v, age=%v) follows (resolution=%v, age=%v)",
+				i, tier.Resolution, tier.Age, prev.Resolution, prev.Age)
+		}
+		prev = tier
+	}
+	return nil
+}
+
 func (o *options) Equal(value Options) bool {
 	return o.bootstrapEnabled == value.BootstrapEnabled() &&
 		o.flushEnabled == value.FlushEnabled() &&
@@ -121,10 +183,44 @@ func (o *options) Equal(value Options) bool {
 		o.snapshotEnabled == value.SnapshotEnabled() &&
 		o.cleanupEnabled == value.CleanupEnabled() &&
 		o.repairEnabled == value.RepairEnabled() &&
+		o.equalRepairThrottle(value) &&
 		o.coldWritesEnabled == value.ColdWritesEnabled() &&
+		o.indexOnly == value.IndexOnly() &&
+		o.histogramsEnabled == value.HistogramsEnabled() &&
 		o.retentionOpts.Equal(value.RetentionOptions()) &&
 		o.indexOpts.Equal(value.IndexOptions()) &&
-		o.schemaHis.Equal(value.SchemaHistory())
+		o.schemaHis.Equal(value.SchemaHistory()) &&
+		resolutionTiersEqual(o.resolutionTiers, value.ResolutionTiers()) &&
+		stringsEqual(o.replicationClusters, value.ReplicationClusters())
+}
+
+func (o *options) equalRepairThrottle(value Options) bool {
+	throttle, ok := value.RepairThrottle()
+	return o.repairThrottle == throttle && o.repairThrottleSet == ok
+}
+
+func resolutionTiersEqual(a, b []ResolutionTier) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 func (o *options) SetBootstrapEnabled(value bool) Options {
@@ -187,6 +283,17 @@ func (o *options) RepairEnabled() bool {
 	return o.repairEnabled
 }
 
+func (o *options) SetRepairThrottle(value time.Duration) Options {
+	opts := *o
+	opts.repairThrottle = value
+	opts.repairThrottleSet = true
+	return &opts
+}
+
+func (o *options) RepairThrottle() (time.Duration, bool) {
+	return o.repairThrottle, o.repairThrottleSet
+}
+
 func (o *options) SetColdWritesEnabled(value bool) Options {
 	opts := *o
 	opts.coldWritesEnabled = value
@@ -197,6 +304,36 @@ func (o *options) ColdWritesEnabled() bool {
 	return o.coldWritesEnabled
 }
 
+func (o *options) SetIndexOnly(value bool) Options {
+	opts := *o
+	opts.indexOnly = value
+	return &opts
+}
+
+func (o *options) IndexOnly() bool {
+	return o.indexOnly
+}
+
+func (o *options) SetHistogramsEnabled(value bool) Options {
+	opts := *o
+	opts.histogramsEnabled = value
+	return &opts
+}
+
+func (o *options) HistogramsEnabled() bool {
+	return o.histogramsEnabled
+}
+
+func (o *options) SetReplicationClusters(value []string) Options {
+	opts := *o
+	opts.replicationClusters = value
+	return &opts
+}
+
+func (o *options) ReplicationClusters() []string {
+	return o.replicationClusters
+}
+
 func (o *options) SetRetentionOptions(value retention.Options) Options {
 	opts := *o
 	opts.retentionOpts = value
@@ -226,3 +363,30 @@ func (o *options) SetSchemaHistory(value SchemaHistory) Options {
 func (o *options) SchemaHistory() SchemaHistory {
 	return o.schemaHis
 }
+
+func (o *options) SetResolutionTiers(value []ResolutionTier) Options {
+	opts := *o
+	opts.resolutionTiers = value
+	return &opts
+}
+
+func (o *options) ResolutionTiers() []ResolutionTier {
+	return o.resolutionTiers
+}
+
+// ResolutionForAge returns the resolution that a block of the given age
+// (measured from the end of the block) should be downsampled to, if any.
+func ResolutionForAge(tiers []ResolutionTier, age time.Duration) (time.Duration, bool) {
+	var (
+		resolution time.Duration
+		found      bool
+	)
+	for _, tier := range tiers {
+		if age < tier.Age {
+			break
+		}
+		resolution = tier.Resolution
+		found = true
+	}
+	return resolution, found
+}
@@ -180,3 +180,152 @@ func TestOptionsValidateNoIndexing(t *testing.T) {
 	rOpts.EXPECT().Validate().Return(nil)
 	require.NoError(t, o1.Validate())
 }
+
+func TestOptionsValidateResolutionTiers(t *testing.T) {
+	rOpts := retention.NewOptions().
+		SetBlockSize(2 * time.Hour).
+		SetRetentionPeriod(365 * 24 * time.Hour)
+	opts := NewOptions().SetRetentionOptions(rOpts)
+
+	valid := opts.SetResolutionTiers([]ResolutionTier{
+		{Resolution: time.Minute, Age: 2 * 24 * time.Hour},
+		{Resolution: time.Hour, Age: 30 * 24 * time.Hour},
+	})
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name  string
+		tiers []ResolutionTier
+	}{
+		{
+			name:  "non-positive resolution",
+			tiers: []ResolutionTier{{Resolution: 0, Age: 24 * time.Hour}},
+		},
+		{
+			name:  "age smaller than block size",
+			tiers: []ResolutionTier{{Resolution: time.Minute, Age: time.Hour}},
+		},
+		{
+			name:  "age larger than retention",
+			tiers: []ResolutionTier{{Resolution: time.Minute, Age: 366 * 24 * time.Hour}},
+		},
+		{
+			name:  "block size not a multiple of resolution",
+			tiers: []ResolutionTier{{Resolution: 7 * time.Minute, Age: 24 * time.Hour}},
+		},
+		{
+			name: "out of order ages",
+			tiers: []ResolutionTier{
+				{Resolution: time.Minute, Age: 30 * 24 * time.Hour},
+				{Resolution: time.Hour, Age: 2 * 24 * time.Hour},
+			},
+		},
+		{
+			name: "out of order resolutions",
+			tiers: []ResolutionTier{
+				{Resolution: time.Hour, Age: 2 * 24 * time.Hour},
+				{Resolution: time.Minute, Age: 30 * 24 * time.Hour},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Error(t, opts.SetResolutionTiers(test.tiers).Validate())
+		})
+	}
+}
+
+func TestOptionsEqualsResolutionTiers(t *testing.T) {
+	o1 := NewOptions()
+	o2 := o1.SetResolutionTiers([]ResolutionTier{
+		{Resolution: time.Minute, Age: 48 * time.Hour},
+	})
+	require.True(t, o2.Equal(o2))
+	require.False(t, o1.Equal(o2))
+	require.False(t, o2.Equal(o1))
+}
+
+func TestOptionsValidateIndexOnly(t *testing.T) {
+	opts := NewOptions().SetIndexOnly(true)
+	require.Equal(t, errIndexOnlyIndexDisabled, opts.Validate())
+
+	opts = opts.SetIndexOptions(NewIndexOptions().
+		SetEnabled(true).
+		SetBlockSize(opts.RetentionOptions().BlockSize()))
+	require.NoError(t, opts.Validate())
+}
+
+func TestOptionsEqualsIndexOnly(t *testing.T) {
+	o1 := NewOptions()
+	o2 := o1.SetIndexOnly(true)
+	require.True(t, o2.Equal(o2))
+	require.False(t, o1.Equal(o2))
+	require.False(t, o2.Equal(o1))
+}
+
+func TestOptionsValidateHistograms(t *testing.T) {
+	opts := NewOptions().SetHistogramsEnabled(true)
+	require.NoError(t, opts.Validate())
+
+	schema, err := LoadSchemaHistory(testSchemaOptions)
+	require.NoError(t, err)
+	opts = opts.SetSchemaHistory(schema)
+	require.Equal(t, errHistogramsWithSchema, opts.Validate())
+}
+
+func TestOptionsEqualsHistograms(t *testing.T) {
+	o1 := NewOptions()
+	o2 := o1.SetHistogramsEnabled(true)
+	require.True(t, o2.Equal(o2))
+	require.False(t, o1.Equal(o2))
+	require.False(t, o2.Equal(o1))
+}
+
+func TestOptionsValidateRepairThrottle(t *testing.T) {
+	opts := NewOptions()
+	require.NoError(t, opts.SetRepairThrottle(time.Minute).Validate())
+	require.Error(t, opts.SetRepairThrottle(-time.Minute).Validate())
+}
+
+func TestOptionsEqualsRepairThrottle(t *testing.T) {
+	o1 := NewOptions()
+	o2 := o1.SetRepairThrottle(time.Minute)
+	require.True(t, o2.Equal(o2))
+	require.False(t, o1.Equal(o2))
+	require.False(t, o2.Equal(o1))
+
+	// A zero throttle disables throttling rather than using the default.
+	o3 := o1.SetRepairThrottle(0)
+	require.False(t, o1.Equal(o3))
+	require.False(t, o3.Equal(o1))
+}
+
+func TestOptionsRepairThrottle(t *testing.T) {
+	_, ok := NewOptions().RepairThrottle()
+	require.False(t, ok)
+
+	throttle, ok := NewOptions().SetRepairThrottle(0).RepairThrottle()
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), throttle)
+}
+
+func TestResolutionForAge(t *testing.T) {
+	tiers := []ResolutionTier{
+		{Resolution: time.Minute, Age: 2 * 24 * time.Hour},
+		{Resolution: time.Hour, Age: 30 * 24 * time.Hour},
+	}
+
+	_, ok := ResolutionForAge(tiers, 24*time.Hour)
+	require.False(t, ok)
+
+	res, ok := ResolutionForAge(tiers, 2*24*time.Hour)
+	require.True(t, ok)
+	require.Equal(t, time.Minute, res)
+
+	res, ok = ResolutionForAge(tiers, 60*24*time.Hour)
+	require.True(t, ok)
+	require.Equal(t, time.Hour, res)
+
+	_, ok = ResolutionForAge(nil, 60*24*time.Hour)
+	require.False(t, ok)
+}
@@ -74,12 +74,46 @@ type Options interface {
 	// RepairEnabled returns whether the data for this namespace needs to be repaired
 	RepairEnabled() bool
 
+	// SetRepairThrottle sets how long repair of this namespace pauses for
+	// across its shards, overriding the repairer's throttle. A zero value
+	// disables throttling for this namespace.
+	SetRepairThrottle(value time.Duration) Options
+
+	// RepairThrottle returns how long repair of this namespace pauses for
+	// across its shards and whether it overrides the repairer's throttle.
+	RepairThrottle() (time.Duration, bool)
+
 	// SetColdWritesEnabled sets whether cold writes are enabled for this namespace.
 	SetColdWritesEnabled(value bool) Options
 
 	// ColdWritesEnabled returns whether cold writes are enabled for this namespace.
 	ColdWritesEnabled() bool
 
+	// SetIndexOnly sets whether this namespace only stores documents in its
+	// index, without any time series data or data filesets.
+	SetIndexOnly(value bool) Options
+
+	// IndexOnly returns whether this namespace only stores documents in its
+	// index, without any time series data or data filesets.
+	IndexOnly() bool
+
+	// SetHistogramsEnabled sets whether this namespace stores datapoints as
+	// native histograms, which expects the annotation of every datapoint
+	// written to be a histogram.
+	SetHistogramsEnabled(value bool) Options
+
+	// HistogramsEnabled returns whether this namespace stores datapoints as
+	// native histograms.
+	HistogramsEnabled() bool
+
+	// SetReplicationClusters sets the names of the clusters this namespace
+	// is asynchronously replicated to.
+	SetReplicationClusters(value []string) Options
+
+	// ReplicationClusters returns the names of the clusters this namespace
+	// is asynchronously replicated to.
+	ReplicationClusters() []string
+
 	// SetRetentionOptions sets the retention options for this namespace
 	SetRetentionOptions(value retention.Options) Options
 
@@ -97,6 +131,26 @@ type Options interface {
 
 	// SchemaHistory returns the schema registry for this namespace.
 	SchemaHistory() SchemaHistory
+
+	// SetResolutionTiers sets the resolution tiers that flushed blocks are
+	// downsampled to as they age.
+	SetResolutionTiers(value []ResolutionTier) Options
+
+	// ResolutionTiers returns the resolution tiers that flushed blocks are
+	// downsampled to as they age.
+	ResolutionTiers() []ResolutionTier
+}
+
+// ResolutionTier describes a resolution that flushed blocks are downsampled
+// to once they are older than a certain age. Data younger than the first
+// tier's age is kept at raw resolution.
+type ResolutionTier struct {
+	// Resolution is the resolution datapoints are downsampled to.
+	Resolution time.Duration
+
+	// Age is how old a block must be (measured from its end) before it is
+	// downsampled to the tier's resolution.
+	Age time.Duration
 }
 
 // IndexOptions controls the indexing options for a namespace.
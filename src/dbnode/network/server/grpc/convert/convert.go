@@ -0,0 +1,1664 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package convert converts between the gRPC node protocol buffer types and
+// the TChannel Thrift node types, allowing the gRPC node service to be served
+// and consumed by the same implementations as the TChannel Thrift service.
+package convert
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	tchannelthriftconvert "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// All times sent over gRPC are unix nanoseconds.
+	timeType = rpc.TimeType_UNIX_NANOSECONDS
+)
+
+var (
+	errUnknownBatchError = errors.New("unknown write batch error")
+)
+
+// ToStatusError converts an error returned by a Thrift node service to a
+// gRPC status error, errors that are not Thrift errors are returned as is.
+func ToStatusError(err error) error {
+	rpcErr, ok := err.(*rpc.Error)
+	if !ok {
+		return err
+	}
+	switch rpcErr.Type {
+	case rpc.ErrorType_BAD_REQUEST:
+		return status.Error(codes.InvalidArgument, rpcErr.Message)
+	case rpc.ErrorType_RESOURCE_EXHAUSTED:
+		return status.Error(codes.ResourceExhausted, rpcErr.Message)
+	default:
+		return status.Error(codes.Internal, rpcErr.Message)
+	}
+}
+
+// FromStatusError converts a gRPC status error returned by a node to a
+// Thrift error, errors that did not originate from the node service (i.e.
+// transport errors) are returned as is.
+func FromStatusError(err error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	var errType rpc.ErrorType
+	switch s.Code() {
+	case codes.InvalidArgument:
+		errType = rpc.ErrorType_BAD_REQUEST
+	case codes.ResourceExhausted:
+		errType = rpc.ErrorType_RESOURCE_EXHAUSTED
+	case codes.Internal, codes.Unknown:
+		errType = rpc.ErrorType_INTERNAL_ERROR
+	default:
+		return err
+	}
+	rpcErr := rpc.NewError()
+	rpcErr.Type = errType
+	rpcErr.Message = s.Message()
+	return rpcErr
+}
+
+// ToProtoError converts a Thrift error to a protobuf error.
+func ToProtoError(err *rpc.Error) *nodepb.Error {
+	if err == nil {
+		return nil
+	}
+	return &nodepb.Error{
+		Type:    nodepb.ErrorType(err.Type),
+		Message: err.Message,
+	}
+}
+
+// ToRPCError converts a protobuf error to a Thrift error.
+func ToRPCError(err *nodepb.Error) *rpc.Error {
+	if err == nil {
+		return nil
+	}
+	rpcErr := rpc.NewError()
+	rpcErr.Type = rpc.ErrorType(err.Type)
+	rpcErr.Message = err.Message
+	return rpcErr
+}
+
+// ToProtoHealthResult converts a Thrift health result to a protobuf response.
+func ToProtoHealthResult(r *rpc.NodeHealthResult_) *nodepb.HealthResponse {
+	return &nodepb.HealthResponse{
+		Ok:           r.Ok,
+		Status:       r.Status,
+		Bootstrapped: r.Bootstrapped,
+	}
+}
+
+// ToRPCHealthResult converts a protobuf health response to a Thrift result.
+func ToRPCHealthResult(r *nodepb.HealthResponse) *rpc.NodeHealthResult_ {
+	result := rpc.NewNodeHealthResult_()
+	result.Ok = r.Ok
+	result.Status = r.Status
+	result.Bootstrapped = r.Bootstrapped
+	return result
+}
+
+// ToRPCWriteTaggedBatchRawRequest converts a protobuf write tagged batch
+// request to a Thrift request.
+func ToRPCWriteTaggedBatchRawRequest(
+	r *nodepb.WriteTaggedBatchRawRequest,
+) *rpc.WriteTaggedBatchRawRequest {
+	req := rpc.NewWriteTaggedBatchRawRequest()
+	req.NameSpace = r.NameSpace
+	req.Elements = make([]*rpc.WriteTaggedBatchRawRequestElement, 0, len(r.Elements))
+	for _, elem := range r.Elements {
+		rpcElem := rpc.NewWriteTaggedBatchRawRequestElement()
+		rpcElem.ID = elem.Id
+		rpcElem.EncodedTags = elem.EncodedTags
+		rpcElem.Datapoint = toRPCDatapoint(elem.Datapoint)
+		req.Elements = append(req.Elements, rpcElem)
+	}
+	return req
+}
+
+// ToProtoWriteTaggedBatchRawRequest converts a Thrift write tagged batch
+// request to a protobuf request.
+func ToProtoWriteTaggedBatchRawRequest(
+	r *rpc.WriteTaggedBatchRawRequest,
+) (*nodepb.WriteTaggedBatchRawRequest, error) {
+	req := &nodepb.WriteTaggedBatchRawRequest{
+		NameSpace: r.NameSpace,
+		Elements:  make([]*nodepb.WriteTaggedBatchRawRequestElement, 0, len(r.Elements)),
+	}
+	for _, elem := range r.Elements {
+		datapoint, err := toProtoDatapoint(elem.Datapoint)
+		if err != nil {
+			return nil, err
+		}
+		req.Elements = append(req.Elements, &nodepb.WriteTaggedBatchRawRequestElement{
+			Id:          elem.ID,
+			EncodedTags: elem.EncodedTags,
+			Datapoint:   datapoint,
+		})
+	}
+	return req, nil
+}
+
+// ToProtoWriteBatchRawResponse converts the error returned by a Thrift write
+// batch to a protobuf response, returning any error that is not a batch error.
+func ToProtoWriteBatchRawResponse(err error) (*nodepb.WriteBatchRawResponse, error) {
+	if err == nil {
+		return &nodepb.WriteBatchRawResponse{}, nil
+	}
+	batchErrs, ok := err.(*rpc.WriteBatchRawErrors)
+	if !ok {
+		return nil, err
+	}
+	resp := &nodepb.WriteBatchRawResponse{
+		Errors: make([]*nodepb.WriteBatchRawError, 0, len(batchErrs.Errors)),
+	}
+	for _, batchErr := range batchErrs.Errors {
+		resp.Errors = append(resp.Errors, &nodepb.WriteBatchRawError{
+			Index: batchErr.Index,
+			Err:   ToProtoError(batchErr.Err),
+		})
+	}
+	return resp, nil
+}
+
+// FromProtoWriteBatchRawResponse returns the Thrift write batch error
+// equivalent to a protobuf response, or nil if all writes succeeded.
+func FromProtoWriteBatchRawResponse(r *nodepb.WriteBatchRawResponse) error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	batchErrs := rpc.NewWriteBatchRawErrors()
+	batchErrs.Errors = make([]*rpc.WriteBatchRawError, 0, len(r.Errors))
+	for _, protoErr := range r.Errors {
+		batchErr := rpc.NewWriteBatchRawError()
+		batchErr.Index = protoErr.Index
+		batchErr.Err = ToRPCError(protoErr.Err)
+		if batchErr.Err == nil {
+			batchErr.Err = tterrors.NewInternalError(errUnknownBatchError)
+		}
+		batchErrs.Errors = append(batchErrs.Errors, batchErr)
+	}
+	return batchErrs
+}
+
+// ToRPCFetchTaggedRequest converts a protobuf fetch tagged request to a
+// Thrift request.
+func ToRPCFetchTaggedRequest(r *nodepb.FetchTaggedRequest) *rpc.FetchTaggedRequest {
+	req := rpc.NewFetchTaggedRequest()
+	req.NameSpace = r.NameSpace
+	req.Query = r.Query
+	req.RangeStart = r.RangeStart
+	req.RangeEnd = r.RangeEnd
+	req.RangeTimeType = timeType
+	req.FetchData = r.FetchData
+	req.Limit = optionalInt64(r.Limit)
+	req.PageToken = r.PageToken
+	return req
+}
+
+// ToProtoFetchTaggedRequest converts a Thrift fetch tagged request to a
+// protobuf request.
+func ToProtoFetchTaggedRequest(r *rpc.FetchTaggedRequest) (*nodepb.FetchTaggedRequest, error) {
+	rangeStart, err := toNanos(r.RangeStart, r.RangeTimeType)
+	if err != nil {
+		return nil, err
+	}
+	rangeEnd, err := toNanos(r.RangeEnd, r.RangeTimeType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.FetchTaggedRequest{
+		NameSpace:  r.NameSpace,
+		Query:      r.Query,
+		RangeStart: rangeStart,
+		RangeEnd:   rangeEnd,
+		FetchData:  r.FetchData,
+		Limit:      int64Value(r.Limit),
+		PageToken:  r.PageToken,
+	}, nil
+}
+
+// ToProtoFetchTaggedResult converts a Thrift fetch tagged result to a
+// protobuf response.
+func ToProtoFetchTaggedResult(r *rpc.FetchTaggedResult_) *nodepb.FetchTaggedResponse {
+	resp := &nodepb.FetchTaggedResponse{
+		Elements:      make([]*nodepb.FetchTaggedIDResult, 0, len(r.Elements)),
+		Exhaustive:    r.Exhaustive,
+		NextPageToken: r.NextPageToken,
+	}
+	for _, elem := range r.Elements {
+		protoElem := &nodepb.FetchTaggedIDResult{
+			Id:          elem.ID,
+			NameSpace:   elem.NameSpace,
+			EncodedTags: elem.EncodedTags,
+			Err:         ToProtoError(elem.Err),
+		}
+		if len(elem.Segments) > 0 {
+			protoElem.Segments = make([]*nodepb.Segments, 0, len(elem.Segments))
+			for _, segs := range elem.Segments {
+				protoElem.Segments = append(protoElem.Segments, toProtoSegments(segs))
+			}
+		}
+		resp.Elements = append(resp.Elements, protoElem)
+	}
+	return resp
+}
+
+// ToRPCFetchTaggedResult converts a protobuf fetch tagged response to a
+// Thrift result.
+func ToRPCFetchTaggedResult(r *nodepb.FetchTaggedResponse) *rpc.FetchTaggedResult_ {
+	result := rpc.NewFetchTaggedResult_()
+	result.Elements = make([]*rpc.FetchTaggedIDResult_, 0, len(r.Elements))
+	result.Exhaustive = r.Exhaustive
+	result.NextPageToken = r.NextPageToken
+	for _, elem := range r.Elements {
+		rpcElem := rpc.NewFetchTaggedIDResult_()
+		rpcElem.ID = elem.Id
+		rpcElem.NameSpace = elem.NameSpace
+		rpcElem.EncodedTags = elem.EncodedTags
+		rpcElem.Err = ToRPCError(elem.Err)
+		if len(elem.Segments) > 0 {
+			rpcElem.Segments = make([]*rpc.Segments, 0, len(elem.Segments))
+			for _, segs := range elem.Segments {
+				rpcElem.Segments = append(rpcElem.Segments, toRPCSegments(segs))
+			}
+		}
+		result.Elements = append(result.Elements, rpcElem)
+	}
+	return result
+}
+
+// ToRPCAggregateRawRequest converts a protobuf aggregate request to a
+// Thrift request.
+func ToRPCAggregateRawRequest(r *nodepb.AggregateRawRequest) *rpc.AggregateQueryRawRequest {
+	req := rpc.NewAggregateQueryRawRequest()
+	req.Query = r.Query
+	req.RangeStart = r.RangeStart
+	req.RangeEnd = r.RangeEnd
+	req.RangeType = timeType
+	req.NameSpace = r.NameSpace
+	req.Limit = optionalInt64(r.Limit)
+	req.TagNameFilter = r.TagNameFilter
+	req.AggregateQueryType = toRPCAggregateQueryType(r.AggregateQueryType)
+	return req
+}
+
+// ToProtoAggregateRawRequest converts a Thrift aggregate request to a
+// protobuf request.
+func ToProtoAggregateRawRequest(
+	r *rpc.AggregateQueryRawRequest,
+) (*nodepb.AggregateRawRequest, error) {
+	rangeStart, err := toNanos(r.RangeStart, r.RangeType)
+	if err != nil {
+		return nil, err
+	}
+	rangeEnd, err := toNanos(r.RangeEnd, r.RangeType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.AggregateRawRequest{
+		Query:              r.Query,
+		RangeStart:         rangeStart,
+		RangeEnd:           rangeEnd,
+		NameSpace:          r.NameSpace,
+		Limit:              int64Value(r.Limit),
+		TagNameFilter:      r.TagNameFilter,
+		AggregateQueryType: toProtoAggregateQueryType(r.AggregateQueryType),
+	}, nil
+}
+
+// ToProtoAggregateRawResult converts a Thrift aggregate result to a
+// protobuf response.
+func ToProtoAggregateRawResult(r *rpc.AggregateQueryRawResult_) *nodepb.AggregateRawResponse {
+	resp := &nodepb.AggregateRawResponse{
+		Results:    make([]*nodepb.AggregateRawResult, 0, len(r.Results)),
+		Exhaustive: r.Exhaustive,
+	}
+	for _, elem := range r.Results {
+		protoElem := &nodepb.AggregateRawResult{TagName: elem.TagName}
+		if len(elem.TagValues) > 0 {
+			protoElem.TagValues = make([][]byte, 0, len(elem.TagValues))
+			for _, value := range elem.TagValues {
+				protoElem.TagValues = append(protoElem.TagValues, value.TagValue)
+			}
+		}
+		resp.Results = append(resp.Results, protoElem)
+	}
+	return resp
+}
+
+// ToRPCAggregateRawResult converts a protobuf aggregate response to a
+// Thrift result.
+func ToRPCAggregateRawResult(r *nodepb.AggregateRawResponse) *rpc.AggregateQueryRawResult_ {
+	result := rpc.NewAggregateQueryRawResult_()
+	result.Results = make([]*rpc.AggregateQueryRawResultTagNameElement, 0, len(r.Results))
+	result.Exhaustive = r.Exhaustive
+	for _, elem := range r.Results {
+		rpcElem := rpc.NewAggregateQueryRawResultTagNameElement()
+		rpcElem.TagName = elem.TagName
+		if len(elem.TagValues) > 0 {
+			rpcElem.TagValues = make([]*rpc.AggregateQueryRawResultTagValueElement, 0, len(elem.TagValues))
+			for _, value := range elem.TagValues {
+				rpcValue := rpc.NewAggregateQueryRawResultTagValueElement()
+				rpcValue.TagValue = value
+				rpcElem.TagValues = append(rpcElem.TagValues, rpcValue)
+			}
+		}
+		result.Results = append(result.Results, rpcElem)
+	}
+	return result
+}
+
+// ToRPCFetchBlocksRawRequest converts a protobuf fetch blocks request to a
+// Thrift request.
+func ToRPCFetchBlocksRawRequest(r *nodepb.FetchBlocksRawRequest) *rpc.FetchBlocksRawRequest {
+	req := rpc.NewFetchBlocksRawRequest()
+	req.NameSpace = r.NameSpace
+	req.Shard = r.Shard
+	req.Elements = make([]*rpc.FetchBlocksRawRequestElement, 0, len(r.Elements))
+	for _, elem := range r.Elements {
+		rpcElem := rpc.NewFetchBlocksRawRequestElement()
+		rpcElem.ID = elem.Id
+		rpcElem.Starts = elem.Starts
+		req.Elements = append(req.Elements, rpcElem)
+	}
+	return req
+}
+
+// ToProtoFetchBlocksRawRequest converts a Thrift fetch blocks request to a
+// protobuf request.
+func ToProtoFetchBlocksRawRequest(r *rpc.FetchBlocksRawRequest) *nodepb.FetchBlocksRawRequest {
+	req := &nodepb.FetchBlocksRawRequest{
+		NameSpace: r.NameSpace,
+		Shard:     r.Shard,
+		Elements:  make([]*nodepb.FetchBlocksRawRequestElement, 0, len(r.Elements)),
+	}
+	for _, elem := range r.Elements {
+		req.Elements = append(req.Elements, &nodepb.FetchBlocksRawRequestElement{
+			Id:     elem.ID,
+			Starts: elem.Starts,
+		})
+	}
+	return req
+}
+
+// ToProtoFetchBlocksRawResult converts a Thrift fetch blocks result to a
+// protobuf response.
+func ToProtoFetchBlocksRawResult(r *rpc.FetchBlocksRawResult_) *nodepb.FetchBlocksRawResponse {
+	resp := &nodepb.FetchBlocksRawResponse{
+		Elements: make([]*nodepb.Blocks, 0, len(r.Elements)),
+	}
+	for _, elem := range r.Elements {
+		protoElem := &nodepb.Blocks{
+			Id:     elem.ID,
+			Blocks: make([]*nodepb.Block, 0, len(elem.Blocks)),
+		}
+		for _, block := range elem.Blocks {
+			protoElem.Blocks = append(protoElem.Blocks, &nodepb.Block{
+				Start:    block.Start,
+				Segments: toProtoSegments(block.Segments),
+				Err:      ToProtoError(block.Err),
+				Checksum: int64Value(block.Checksum),
+			})
+		}
+		resp.Elements = append(resp.Elements, protoElem)
+	}
+	return resp
+}
+
+// ToRPCFetchBlocksRawResult converts a protobuf fetch blocks response to a
+// Thrift result.
+func ToRPCFetchBlocksRawResult(r *nodepb.FetchBlocksRawResponse) *rpc.FetchBlocksRawResult_ {
+	result := rpc.NewFetchBlocksRawResult_()
+	result.Elements = make([]*rpc.Blocks, 0, len(r.Elements))
+	for _, elem := range r.Elements {
+		rpcElem := rpc.NewBlocks()
+		rpcElem.ID = elem.Id
+		rpcElem.Blocks = make([]*rpc.Block, 0, len(elem.Blocks))
+		for _, block := range elem.Blocks {
+			rpcBlock := rpc.NewBlock()
+			rpcBlock.Start = block.Start
+			rpcBlock.Segments = toRPCSegments(block.Segments)
+			rpcBlock.Err = ToRPCError(block.Err)
+			rpcBlock.Checksum = optionalInt64(block.Checksum)
+			rpcElem.Blocks = append(rpcElem.Blocks, rpcBlock)
+		}
+		result.Elements = append(result.Elements, rpcElem)
+	}
+	return result
+}
+
+// ToRPCQueryRequest converts a protobuf query request to a Thrift request.
+func ToRPCQueryRequest(r *nodepb.QueryRequest) *rpc.QueryRequest {
+	req := rpc.NewQueryRequest()
+	req.Query = toRPCQuery(r.Query)
+	req.RangeStart = r.RangeStart
+	req.RangeEnd = r.RangeEnd
+	req.RangeType = timeType
+	req.ResultTimeType = timeType
+	req.NameSpace = r.NameSpace
+	req.Limit = optionalInt64(r.Limit)
+	req.NoData = optionalBool(r.NoData)
+	return req
+}
+
+// ToProtoQueryRequest converts a Thrift query request to a protobuf request.
+func ToProtoQueryRequest(r *rpc.QueryRequest) (*nodepb.QueryRequest, error) {
+	rangeStart, err := toNanos(r.RangeStart, r.RangeType)
+	if err != nil {
+		return nil, err
+	}
+	rangeEnd, err := toNanos(r.RangeEnd, r.RangeType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.QueryRequest{
+		Query:      toProtoQuery(r.Query),
+		RangeStart: rangeStart,
+		RangeEnd:   rangeEnd,
+		NameSpace:  r.NameSpace,
+		Limit:      int64Value(r.Limit),
+		NoData:     boolValue(r.NoData),
+	}, nil
+}
+
+// ToProtoQueryResult converts a Thrift query result to a protobuf response.
+func ToProtoQueryResult(r *rpc.QueryResult_) *nodepb.QueryResponse {
+	resp := &nodepb.QueryResponse{
+		Results:    make([]*nodepb.QueryResultElement, 0, len(r.Results)),
+		Exhaustive: r.Exhaustive,
+	}
+	for _, elem := range r.Results {
+		resp.Results = append(resp.Results, &nodepb.QueryResultElement{
+			Id:         elem.ID,
+			Tags:       toProtoTags(elem.Tags),
+			Datapoints: toProtoResultDatapoints(elem.Datapoints),
+		})
+	}
+	return resp
+}
+
+// ToRPCQueryResult converts a protobuf query response to a Thrift result
+// with datapoint timestamps in the given time type.
+func ToRPCQueryResult(
+	r *nodepb.QueryResponse,
+	resultTimeType rpc.TimeType,
+) (*rpc.QueryResult_, error) {
+	result := rpc.NewQueryResult_()
+	result.Results = make([]*rpc.QueryResultElement, 0, len(r.Results))
+	result.Exhaustive = r.Exhaustive
+	for _, elem := range r.Results {
+		datapoints, err := toRPCResultDatapoints(elem.Datapoints, resultTimeType)
+		if err != nil {
+			return nil, err
+		}
+		rpcElem := rpc.NewQueryResultElement()
+		rpcElem.ID = elem.Id
+		rpcElem.Tags = toRPCTags(elem.Tags)
+		rpcElem.Datapoints = datapoints
+		result.Results = append(result.Results, rpcElem)
+	}
+	return result, nil
+}
+
+// ToRPCAggregateRequest converts a protobuf aggregate request to a Thrift
+// request.
+func ToRPCAggregateRequest(r *nodepb.AggregateRequest) *rpc.AggregateQueryRequest {
+	req := rpc.NewAggregateQueryRequest()
+	req.Query = toRPCQuery(r.Query)
+	req.RangeStart = r.RangeStart
+	req.RangeEnd = r.RangeEnd
+	req.RangeType = timeType
+	req.NameSpace = r.NameSpace
+	req.Limit = optionalInt64(r.Limit)
+	req.TagNameFilter = r.TagNameFilter
+	req.AggregateQueryType = toRPCAggregateQueryType(r.AggregateQueryType)
+	return req
+}
+
+// ToProtoAggregateRequest converts a Thrift aggregate request to a protobuf
+// request.
+func ToProtoAggregateRequest(r *rpc.AggregateQueryRequest) (*nodepb.AggregateRequest, error) {
+	rangeStart, err := toNanos(r.RangeStart, r.RangeType)
+	if err != nil {
+		return nil, err
+	}
+	rangeEnd, err := toNanos(r.RangeEnd, r.RangeType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.AggregateRequest{
+		Query:              toProtoQuery(r.Query),
+		RangeStart:         rangeStart,
+		RangeEnd:           rangeEnd,
+		NameSpace:          r.NameSpace,
+		Limit:              int64Value(r.Limit),
+		TagNameFilter:      r.TagNameFilter,
+		AggregateQueryType: toProtoAggregateQueryType(r.AggregateQueryType),
+	}, nil
+}
+
+// ToProtoAggregateResult converts a Thrift aggregate result to a protobuf
+// response.
+func ToProtoAggregateResult(r *rpc.AggregateQueryResult_) *nodepb.AggregateResponse {
+	resp := &nodepb.AggregateResponse{
+		Results:    make([]*nodepb.AggregateResult, 0, len(r.Results)),
+		Exhaustive: r.Exhaustive,
+	}
+	for _, elem := range r.Results {
+		protoElem := &nodepb.AggregateResult{TagName: elem.TagName}
+		if len(elem.TagValues) > 0 {
+			protoElem.TagValues = make([]string, 0, len(elem.TagValues))
+			for _, value := range elem.TagValues {
+				protoElem.TagValues = append(protoElem.TagValues, value.TagValue)
+			}
+		}
+		resp.Results = append(resp.Results, protoElem)
+	}
+	return resp
+}
+
+// ToRPCAggregateResult converts a protobuf aggregate response to a Thrift
+// result.
+func ToRPCAggregateResult(r *nodepb.AggregateResponse) *rpc.AggregateQueryResult_ {
+	result := rpc.NewAggregateQueryResult_()
+	result.Results = make([]*rpc.AggregateQueryResultTagNameElement, 0, len(r.Results))
+	result.Exhaustive = r.Exhaustive
+	for _, elem := range r.Results {
+		rpcElem := rpc.NewAggregateQueryResultTagNameElement()
+		rpcElem.TagName = elem.TagName
+		if len(elem.TagValues) > 0 {
+			rpcElem.TagValues = make([]*rpc.AggregateQueryResultTagValueElement, 0, len(elem.TagValues))
+			for _, value := range elem.TagValues {
+				rpcValue := rpc.NewAggregateQueryResultTagValueElement()
+				rpcValue.TagValue = value
+				rpcElem.TagValues = append(rpcElem.TagValues, rpcValue)
+			}
+		}
+		result.Results = append(result.Results, rpcElem)
+	}
+	return result
+}
+
+// ToRPCFetchRequest converts a protobuf fetch request to a Thrift request.
+func ToRPCFetchRequest(r *nodepb.FetchRequest) *rpc.FetchRequest {
+	req := rpc.NewFetchRequest()
+	req.NameSpace = r.NameSpace
+	req.ID = r.Id
+	req.RangeStart = r.RangeStart
+	req.RangeEnd = r.RangeEnd
+	req.RangeType = timeType
+	req.ResultTimeType = timeType
+	return req
+}
+
+// ToProtoFetchRequest converts a Thrift fetch request to a protobuf request.
+func ToProtoFetchRequest(r *rpc.FetchRequest) (*nodepb.FetchRequest, error) {
+	rangeStart, err := toNanos(r.RangeStart, r.RangeType)
+	if err != nil {
+		return nil, err
+	}
+	rangeEnd, err := toNanos(r.RangeEnd, r.RangeType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.FetchRequest{
+		NameSpace:  r.NameSpace,
+		Id:         r.ID,
+		RangeStart: rangeStart,
+		RangeEnd:   rangeEnd,
+	}, nil
+}
+
+// ToProtoFetchResult converts a Thrift fetch result to a protobuf response.
+func ToProtoFetchResult(r *rpc.FetchResult_) *nodepb.FetchResponse {
+	return &nodepb.FetchResponse{
+		Datapoints: toProtoResultDatapoints(r.Datapoints),
+	}
+}
+
+// ToRPCFetchResult converts a protobuf fetch response to a Thrift result
+// with datapoint timestamps in the given time type.
+func ToRPCFetchResult(
+	r *nodepb.FetchResponse,
+	resultTimeType rpc.TimeType,
+) (*rpc.FetchResult_, error) {
+	datapoints, err := toRPCResultDatapoints(r.Datapoints, resultTimeType)
+	if err != nil {
+		return nil, err
+	}
+	result := rpc.NewFetchResult_()
+	result.Datapoints = datapoints
+	return result, nil
+}
+
+// ToRPCWriteRequest converts a protobuf write request to a Thrift request.
+func ToRPCWriteRequest(r *nodepb.WriteRequest) *rpc.WriteRequest {
+	req := rpc.NewWriteRequest()
+	req.NameSpace = r.NameSpace
+	req.ID = r.Id
+	req.Datapoint = toRPCDatapoint(r.Datapoint)
+	return req
+}
+
+// ToProtoWriteRequest converts a Thrift write request to a protobuf request.
+func ToProtoWriteRequest(r *rpc.WriteRequest) (*nodepb.WriteRequest, error) {
+	datapoint, err := toProtoDatapoint(r.Datapoint)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.WriteRequest{
+		NameSpace: r.NameSpace,
+		Id:        r.ID,
+		Datapoint: datapoint,
+	}, nil
+}
+
+// ToRPCWriteTaggedRequest converts a protobuf write tagged request to a
+// Thrift request.
+func ToRPCWriteTaggedRequest(r *nodepb.WriteTaggedRequest) *rpc.WriteTaggedRequest {
+	req := rpc.NewWriteTaggedRequest()
+	req.NameSpace = r.NameSpace
+	req.ID = r.Id
+	req.Tags = toRPCTags(r.Tags)
+	req.Datapoint = toRPCDatapoint(r.Datapoint)
+	return req
+}
+
+// ToProtoWriteTaggedRequest converts a Thrift write tagged request to a
+// protobuf request.
+func ToProtoWriteTaggedRequest(r *rpc.WriteTaggedRequest) (*nodepb.WriteTaggedRequest, error) {
+	datapoint, err := toProtoDatapoint(r.Datapoint)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.WriteTaggedRequest{
+		NameSpace: r.NameSpace,
+		Id:        r.ID,
+		Tags:      toProtoTags(r.Tags),
+		Datapoint: datapoint,
+	}, nil
+}
+
+// ToRPCFetchBatchRawRequest converts a protobuf fetch batch request to a
+// Thrift request.
+func ToRPCFetchBatchRawRequest(r *nodepb.FetchBatchRawRequest) *rpc.FetchBatchRawRequest {
+	req := rpc.NewFetchBatchRawRequest()
+	req.NameSpace = r.NameSpace
+	req.Ids = r.Ids
+	req.RangeStart = r.RangeStart
+	req.RangeEnd = r.RangeEnd
+	req.RangeTimeType = timeType
+	return req
+}
+
+// ToProtoFetchBatchRawRequest converts a Thrift fetch batch request to a
+// protobuf request.
+func ToProtoFetchBatchRawRequest(r *rpc.FetchBatchRawRequest) (*nodepb.FetchBatchRawRequest, error) {
+	rangeStart, err := toNanos(r.RangeStart, r.RangeTimeType)
+	if err != nil {
+		return nil, err
+	}
+	rangeEnd, err := toNanos(r.RangeEnd, r.RangeTimeType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.FetchBatchRawRequest{
+		NameSpace:  r.NameSpace,
+		Ids:        r.Ids,
+		RangeStart: rangeStart,
+		RangeEnd:   rangeEnd,
+	}, nil
+}
+
+// ToRPCFetchBatchRawV2Request converts a protobuf fetch batch V2 request to
+// a Thrift request.
+func ToRPCFetchBatchRawV2Request(r *nodepb.FetchBatchRawV2Request) *rpc.FetchBatchRawV2Request {
+	req := rpc.NewFetchBatchRawV2Request()
+	req.NameSpaces = r.NameSpaces
+	req.Elements = make([]*rpc.FetchBatchRawV2RequestElement, 0, len(r.Elements))
+	for _, elem := range r.Elements {
+		rpcElem := rpc.NewFetchBatchRawV2RequestElement()
+		rpcElem.NameSpace = elem.NameSpace
+		rpcElem.ID = elem.Id
+		rpcElem.RangeStart = elem.RangeStart
+		rpcElem.RangeEnd = elem.RangeEnd
+		rpcElem.RangeTimeType = timeType
+		req.Elements = append(req.Elements, rpcElem)
+	}
+	return req
+}
+
+// ToProtoFetchBatchRawV2Request converts a Thrift fetch batch V2 request to
+// a protobuf request.
+func ToProtoFetchBatchRawV2Request(
+	r *rpc.FetchBatchRawV2Request,
+) (*nodepb.FetchBatchRawV2Request, error) {
+	req := &nodepb.FetchBatchRawV2Request{
+		NameSpaces: r.NameSpaces,
+		Elements:   make([]*nodepb.FetchBatchRawV2RequestElement, 0, len(r.Elements)),
+	}
+	for _, elem := range r.Elements {
+		rangeStart, err := toNanos(elem.RangeStart, elem.RangeTimeType)
+		if err != nil {
+			return nil, err
+		}
+		rangeEnd, err := toNanos(elem.RangeEnd, elem.RangeTimeType)
+		if err != nil {
+			return nil, err
+		}
+		req.Elements = append(req.Elements, &nodepb.FetchBatchRawV2RequestElement{
+			NameSpace:  elem.NameSpace,
+			Id:         elem.ID,
+			RangeStart: rangeStart,
+			RangeEnd:   rangeEnd,
+		})
+	}
+	return req, nil
+}
+
+// ToProtoFetchBatchRawResult converts a Thrift fetch batch result to a
+// protobuf response.
+func ToProtoFetchBatchRawResult(r *rpc.FetchBatchRawResult_) *nodepb.FetchBatchRawResponse {
+	resp := &nodepb.FetchBatchRawResponse{
+		Elements: make([]*nodepb.FetchRawResult, 0, len(r.Elements)),
+	}
+	for _, elem := range r.Elements {
+		protoElem := &nodepb.FetchRawResult{Err: ToProtoError(elem.Err)}
+		if len(elem.Segments) > 0 {
+			protoElem.Segments = make([]*nodepb.Segments, 0, len(elem.Segments))
+			for _, segs := range elem.Segments {
+				protoElem.Segments = append(protoElem.Segments, toProtoSegments(segs))
+			}
+		}
+		resp.Elements = append(resp.Elements, protoElem)
+	}
+	return resp
+}
+
+// ToRPCFetchBatchRawResult converts a protobuf fetch batch response to a
+// Thrift result.
+func ToRPCFetchBatchRawResult(r *nodepb.FetchBatchRawResponse) *rpc.FetchBatchRawResult_ {
+	result := rpc.NewFetchBatchRawResult_()
+	result.Elements = make([]*rpc.FetchRawResult_, 0, len(r.Elements))
+	for _, elem := range r.Elements {
+		rpcElem := rpc.NewFetchRawResult_()
+		rpcElem.Err = ToRPCError(elem.Err)
+		rpcElem.Segments = make([]*rpc.Segments, 0, len(elem.Segments))
+		for _, segs := range elem.Segments {
+			rpcElem.Segments = append(rpcElem.Segments, toRPCSegments(segs))
+		}
+		result.Elements = append(result.Elements, rpcElem)
+	}
+	return result
+}
+
+// ToRPCFetchBlocksMetadataRawV2Request converts a protobuf fetch blocks
+// metadata request to a Thrift request.
+func ToRPCFetchBlocksMetadataRawV2Request(
+	r *nodepb.FetchBlocksMetadataRawV2Request,
+) *rpc.FetchBlocksMetadataRawV2Request {
+	req := rpc.NewFetchBlocksMetadataRawV2Request()
+	req.NameSpace = r.NameSpace
+	req.Shard = r.Shard
+	req.RangeStart = r.RangeStart
+	req.RangeEnd = r.RangeEnd
+	req.Limit = r.Limit
+	req.PageToken = r.PageToken
+	req.IncludeSizes = optionalBool(r.IncludeSizes)
+	req.IncludeChecksums = optionalBool(r.IncludeChecksums)
+	req.IncludeLastRead = optionalBool(r.IncludeLastRead)
+	return req
+}
+
+// ToProtoFetchBlocksMetadataRawV2Request converts a Thrift fetch blocks
+// metadata request to a protobuf request.
+func ToProtoFetchBlocksMetadataRawV2Request(
+	r *rpc.FetchBlocksMetadataRawV2Request,
+) *nodepb.FetchBlocksMetadataRawV2Request {
+	return &nodepb.FetchBlocksMetadataRawV2Request{
+		NameSpace:        r.NameSpace,
+		Shard:            r.Shard,
+		RangeStart:       r.RangeStart,
+		RangeEnd:         r.RangeEnd,
+		Limit:            r.Limit,
+		PageToken:        r.PageToken,
+		IncludeSizes:     boolValue(r.IncludeSizes),
+		IncludeChecksums: boolValue(r.IncludeChecksums),
+		IncludeLastRead:  boolValue(r.IncludeLastRead),
+	}
+}
+
+// ToProtoFetchBlocksMetadataRawV2Result converts a Thrift fetch blocks
+// metadata result to a protobuf response.
+func ToProtoFetchBlocksMetadataRawV2Result(
+	r *rpc.FetchBlocksMetadataRawV2Result_,
+) (*nodepb.FetchBlocksMetadataRawV2Response, error) {
+	if r == nil {
+		// The node returns no result for requests without a limit.
+		return &nodepb.FetchBlocksMetadataRawV2Response{}, nil
+	}
+	resp := &nodepb.FetchBlocksMetadataRawV2Response{
+		Elements:      make([]*nodepb.BlockMetadataV2, 0, len(r.Elements)),
+		NextPageToken: r.NextPageToken,
+	}
+	for _, elem := range r.Elements {
+		var lastRead int64
+		if elem.LastRead != nil {
+			var err error
+			lastRead, err = toNanos(*elem.LastRead, elem.LastReadTimeType)
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp.Elements = append(resp.Elements, &nodepb.BlockMetadataV2{
+			Id:          elem.ID,
+			Start:       elem.Start,
+			Err:         ToProtoError(elem.Err),
+			Size_:       int64Value(elem.Size),
+			Checksum:    int64Value(elem.Checksum),
+			LastRead:    lastRead,
+			EncodedTags: elem.EncodedTags,
+		})
+	}
+	return resp, nil
+}
+
+// ToRPCFetchBlocksMetadataRawV2Result converts a protobuf fetch blocks
+// metadata response to a Thrift result.
+func ToRPCFetchBlocksMetadataRawV2Result(
+	r *nodepb.FetchBlocksMetadataRawV2Response,
+) *rpc.FetchBlocksMetadataRawV2Result_ {
+	result := rpc.NewFetchBlocksMetadataRawV2Result_()
+	result.Elements = make([]*rpc.BlockMetadataV2, 0, len(r.Elements))
+	result.NextPageToken = r.NextPageToken
+	for _, elem := range r.Elements {
+		rpcElem := rpc.NewBlockMetadataV2()
+		rpcElem.ID = elem.Id
+		rpcElem.Start = elem.Start
+		rpcElem.Err = ToRPCError(elem.Err)
+		rpcElem.Size = optionalInt64(elem.Size_)
+		rpcElem.Checksum = optionalInt64(elem.Checksum)
+		rpcElem.LastRead = optionalInt64(elem.LastRead)
+		if rpcElem.LastRead != nil {
+			rpcElem.LastReadTimeType = timeType
+		}
+		rpcElem.EncodedTags = elem.EncodedTags
+		result.Elements = append(result.Elements, rpcElem)
+	}
+	return result
+}
+
+// ToRPCWriteBatchRawRequest converts a protobuf write batch request to a
+// Thrift request.
+func ToRPCWriteBatchRawRequest(r *nodepb.WriteBatchRawRequest) *rpc.WriteBatchRawRequest {
+	req := rpc.NewWriteBatchRawRequest()
+	req.NameSpace = r.NameSpace
+	req.Elements = make([]*rpc.WriteBatchRawRequestElement, 0, len(r.Elements))
+	for _, elem := range r.Elements {
+		rpcElem := rpc.NewWriteBatchRawRequestElement()
+		rpcElem.ID = elem.Id
+		rpcElem.Datapoint = toRPCDatapoint(elem.Datapoint)
+		req.Elements = append(req.Elements, rpcElem)
+	}
+	return req
+}
+
+// ToProtoWriteBatchRawRequest converts a Thrift write batch request to a
+// protobuf request.
+func ToProtoWriteBatchRawRequest(r *rpc.WriteBatchRawRequest) (*nodepb.WriteBatchRawRequest, error) {
+	req := &nodepb.WriteBatchRawRequest{
+		NameSpace: r.NameSpace,
+		Elements:  make([]*nodepb.WriteBatchRawRequestElement, 0, len(r.Elements)),
+	}
+	for _, elem := range r.Elements {
+		datapoint, err := toProtoDatapoint(elem.Datapoint)
+		if err != nil {
+			return nil, err
+		}
+		req.Elements = append(req.Elements, &nodepb.WriteBatchRawRequestElement{
+			Id:        elem.ID,
+			Datapoint: datapoint,
+		})
+	}
+	return req, nil
+}
+
+// ToRPCWriteBatchRawV2Request converts a protobuf write batch V2 request to
+// a Thrift request.
+func ToRPCWriteBatchRawV2Request(r *nodepb.WriteBatchRawV2Request) *rpc.WriteBatchRawV2Request {
+	req := rpc.NewWriteBatchRawV2Request()
+	req.NameSpaces = r.NameSpaces
+	req.Elements = make([]*rpc.WriteBatchRawV2RequestElement, 0, len(r.Elements))
+	for _, elem := range r.Elements {
+		rpcElem := rpc.NewWriteBatchRawV2RequestElement()
+		rpcElem.NameSpace = elem.NameSpace
+		rpcElem.ID = elem.Id
+		rpcElem.Datapoint = toRPCDatapoint(elem.Datapoint)
+		req.Elements = append(req.Elements, rpcElem)
+	}
+	return req
+}
+
+// ToProtoWriteBatchRawV2Request converts a Thrift write batch V2 request to
+// a protobuf request.
+func ToProtoWriteBatchRawV2Request(
+	r *rpc.WriteBatchRawV2Request,
+) (*nodepb.WriteBatchRawV2Request, error) {
+	req := &nodepb.WriteBatchRawV2Request{
+		NameSpaces: r.NameSpaces,
+		Elements:   make([]*nodepb.WriteBatchRawV2RequestElement, 0, len(r.Elements)),
+	}
+	for _, elem := range r.Elements {
+		datapoint, err := toProtoDatapoint(elem.Datapoint)
+		if err != nil {
+			return nil, err
+		}
+		req.Elements = append(req.Elements, &nodepb.WriteBatchRawV2RequestElement{
+			NameSpace: elem.NameSpace,
+			Id:        elem.ID,
+			Datapoint: datapoint,
+		})
+	}
+	return req, nil
+}
+
+// ToRPCWriteTaggedBatchRawV2Request converts a protobuf write tagged batch
+// V2 request to a Thrift request.
+func ToRPCWriteTaggedBatchRawV2Request(
+	r *nodepb.WriteTaggedBatchRawV2Request,
+) *rpc.WriteTaggedBatchRawV2Request {
+	req := rpc.NewWriteTaggedBatchRawV2Request()
+	req.NameSpaces = r.NameSpaces
+	req.Elements = make([]*rpc.WriteTaggedBatchRawV2RequestElement, 0, len(r.Elements))
+	for _, elem := range r.Elements {
+		rpcElem := rpc.NewWriteTaggedBatchRawV2RequestElement()
+		rpcElem.NameSpace = elem.NameSpace
+		rpcElem.ID = elem.Id
+		rpcElem.EncodedTags = elem.EncodedTags
+		rpcElem.Datapoint = toRPCDatapoint(elem.Datapoint)
+		req.Elements = append(req.Elements, rpcElem)
+	}
+	return req
+}
+
+// ToProtoWriteTaggedBatchRawV2Request converts a Thrift write tagged batch
+// V2 request to a protobuf request.
+func ToProtoWriteTaggedBatchRawV2Request(
+	r *rpc.WriteTaggedBatchRawV2Request,
+) (*nodepb.WriteTaggedBatchRawV2Request, error) {
+	req := &nodepb.WriteTaggedBatchRawV2Request{
+		NameSpaces: r.NameSpaces,
+		Elements:   make([]*nodepb.WriteTaggedBatchRawV2RequestElement, 0, len(r.Elements)),
+	}
+	for _, elem := range r.Elements {
+		datapoint, err := toProtoDatapoint(elem.Datapoint)
+		if err != nil {
+			return nil, err
+		}
+		req.Elements = append(req.Elements, &nodepb.WriteTaggedBatchRawV2RequestElement{
+			NameSpace:   elem.NameSpace,
+			Id:          elem.ID,
+			EncodedTags: elem.EncodedTags,
+			Datapoint:   datapoint,
+		})
+	}
+	return req, nil
+}
+
+// ToRPCTruncateRequest converts a protobuf truncate request to a Thrift
+// request.
+func ToRPCTruncateRequest(r *nodepb.TruncateRequest) *rpc.TruncateRequest {
+	req := rpc.NewTruncateRequest()
+	req.NameSpace = r.NameSpace
+	return req
+}
+
+// ToRPCDeleteTaggedRequest converts a protobuf delete tagged request to a
+// Thrift request.
+func ToRPCDeleteTaggedRequest(r *nodepb.DeleteTaggedRequest) *rpc.DeleteTaggedRequest {
+	req := rpc.NewDeleteTaggedRequest()
+	req.NameSpace = r.NameSpace
+	req.Query = r.Query
+	req.RangeStart = r.RangeStart
+	req.RangeEnd = r.RangeEnd
+	req.RangeTimeType = timeType
+	return req
+}
+
+// ToProtoDeleteTaggedRequest converts a Thrift delete tagged request to a
+// protobuf request.
+func ToProtoDeleteTaggedRequest(r *rpc.DeleteTaggedRequest) (*nodepb.DeleteTaggedRequest, error) {
+	rangeStart, err := toNanos(r.RangeStart, r.RangeTimeType)
+	if err != nil {
+		return nil, err
+	}
+	rangeEnd, err := toNanos(r.RangeEnd, r.RangeTimeType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.DeleteTaggedRequest{
+		NameSpace:  r.NameSpace,
+		Query:      r.Query,
+		RangeStart: rangeStart,
+		RangeEnd:   rangeEnd,
+	}, nil
+}
+
+// ToProtoDeleteTaggedResult converts a Thrift delete tagged result to a
+// protobuf response, ordering the per shard counts by shard.
+func ToProtoDeleteTaggedResult(r *rpc.DeleteTaggedResult_) *nodepb.DeleteTaggedResponse {
+	resp := &nodepb.DeleteTaggedResponse{NumSeries: r.NumSeries}
+	if len(r.NumSeriesByShard) > 0 {
+		resp.NumSeriesByShard = make([]*nodepb.ShardNumSeries, 0, len(r.NumSeriesByShard))
+		for shard, numSeries := range r.NumSeriesByShard {
+			resp.NumSeriesByShard = append(resp.NumSeriesByShard, &nodepb.ShardNumSeries{
+				Shard:     shard,
+				NumSeries: numSeries,
+			})
+		}
+		sort.Slice(resp.NumSeriesByShard, func(i, j int) bool {
+			return resp.NumSeriesByShard[i].Shard < resp.NumSeriesByShard[j].Shard
+		})
+	}
+	return resp
+}
+
+// ToRPCDeleteTaggedResult converts a protobuf delete tagged response to a
+// Thrift result.
+func ToRPCDeleteTaggedResult(r *nodepb.DeleteTaggedResponse) *rpc.DeleteTaggedResult_ {
+	result := rpc.NewDeleteTaggedResult_()
+	result.NumSeries = r.NumSeries
+	if len(r.NumSeriesByShard) > 0 {
+		result.NumSeriesByShard = make(map[int32]int64, len(r.NumSeriesByShard))
+		for _, elem := range r.NumSeriesByShard {
+			result.NumSeriesByShard[elem.Shard] = elem.NumSeries
+		}
+	}
+	return result
+}
+
+// ToRPCCardinalityRequest converts a protobuf cardinality request to a
+// Thrift request.
+func ToRPCCardinalityRequest(r *nodepb.CardinalityRequest) *rpc.CardinalityRequest {
+	req := rpc.NewCardinalityRequest()
+	req.NameSpace = r.NameSpace
+	req.RangeStart = r.RangeStart
+	req.RangeEnd = r.RangeEnd
+	req.RangeTimeType = timeType
+	req.FieldsLimit = optionalInt64(r.FieldsLimit)
+	req.ValuesLimit = optionalInt64(r.ValuesLimit)
+	return req
+}
+
+// ToProtoCardinalityRequest converts a Thrift cardinality request to a
+// protobuf request.
+func ToProtoCardinalityRequest(r *rpc.CardinalityRequest) (*nodepb.CardinalityRequest, error) {
+	rangeStart, err := toNanos(r.RangeStart, r.RangeTimeType)
+	if err != nil {
+		return nil, err
+	}
+	rangeEnd, err := toNanos(r.RangeEnd, r.RangeTimeType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.CardinalityRequest{
+		NameSpace:   r.NameSpace,
+		RangeStart:  rangeStart,
+		RangeEnd:    rangeEnd,
+		FieldsLimit: int64Value(r.FieldsLimit),
+		ValuesLimit: int64Value(r.ValuesLimit),
+	}, nil
+}
+
+// ToProtoCardinalityResult converts a Thrift cardinality result to a
+// protobuf response.
+func ToProtoCardinalityResult(r *rpc.CardinalityResult_) *nodepb.CardinalityResponse {
+	resp := &nodepb.CardinalityResponse{
+		Fields: make([]*nodepb.CardinalityField, 0, len(r.Fields)),
+	}
+	for _, field := range r.Fields {
+		protoField := &nodepb.CardinalityField{
+			Name:           field.Name,
+			SeriesEstimate: field.SeriesEstimate,
+			SeriesSketch:   field.SeriesSketch,
+			ValuesEstimate: field.ValuesEstimate,
+			ValuesSketch:   field.ValuesSketch,
+		}
+		if len(field.TopValues) > 0 {
+			protoField.TopValues = make([]*nodepb.CardinalityValue, 0, len(field.TopValues))
+			for _, value := range field.TopValues {
+				protoField.TopValues = append(protoField.TopValues, &nodepb.CardinalityValue{
+					Value:          value.Value,
+					SeriesEstimate: value.SeriesEstimate,
+					SeriesSketch:   value.SeriesSketch,
+				})
+			}
+		}
+		resp.Fields = append(resp.Fields, protoField)
+	}
+	return resp
+}
+
+// ToRPCCardinalityResult converts a protobuf cardinality response to a
+// Thrift result.
+func ToRPCCardinalityResult(r *nodepb.CardinalityResponse) *rpc.CardinalityResult_ {
+	result := rpc.NewCardinalityResult_()
+	result.Fields = make([]*rpc.CardinalityField, 0, len(r.Fields))
+	for _, field := range r.Fields {
+		rpcField := rpc.NewCardinalityField()
+		rpcField.Name = field.Name
+		rpcField.SeriesEstimate = field.SeriesEstimate
+		rpcField.SeriesSketch = field.SeriesSketch
+		rpcField.ValuesEstimate = field.ValuesEstimate
+		rpcField.ValuesSketch = field.ValuesSketch
+		rpcField.TopValues = make([]*rpc.CardinalityValue, 0, len(field.TopValues))
+		for _, value := range field.TopValues {
+			rpcValue := rpc.NewCardinalityValue()
+			rpcValue.Value = value.Value
+			rpcValue.SeriesEstimate = value.SeriesEstimate
+			rpcValue.SeriesSketch = value.SeriesSketch
+			rpcField.TopValues = append(rpcField.TopValues, rpcValue)
+		}
+		result.Fields = append(result.Fields, rpcField)
+	}
+	return result
+}
+
+// ToRPCWriteDocumentRequest converts a protobuf write document request to a
+// Thrift request.
+func ToRPCWriteDocumentRequest(r *nodepb.WriteDocumentRequest) *rpc.WriteDocumentRequest {
+	req := rpc.NewWriteDocumentRequest()
+	req.NameSpace = r.NameSpace
+	req.ID = r.Id
+	req.EncodedTags = r.EncodedTags
+	req.Timestamp = r.Timestamp
+	req.TimestampTimeType = timeType
+	return req
+}
+
+// ToProtoWriteDocumentRequest converts a Thrift write document request to a
+// protobuf request.
+func ToProtoWriteDocumentRequest(r *rpc.WriteDocumentRequest) (*nodepb.WriteDocumentRequest, error) {
+	timestamp, err := toNanos(r.Timestamp, r.TimestampTimeType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.WriteDocumentRequest{
+		NameSpace:   r.NameSpace,
+		Id:          r.ID,
+		EncodedTags: r.EncodedTags,
+		Timestamp:   timestamp,
+	}, nil
+}
+
+// ToRPCSetPersistRateLimitRequest converts a protobuf set persist rate limit
+// request to a Thrift request.
+func ToRPCSetPersistRateLimitRequest(
+	r *nodepb.SetPersistRateLimitRequest,
+) *rpc.NodeSetPersistRateLimitRequest {
+	req := rpc.NewNodeSetPersistRateLimitRequest()
+	if r.LimitEnabled != nil {
+		req.LimitEnabled = &r.LimitEnabled.Value
+	}
+	if r.LimitMbps != nil {
+		req.LimitMbps = &r.LimitMbps.Value
+	}
+	if r.LimitCheckEvery != nil {
+		req.LimitCheckEvery = &r.LimitCheckEvery.Value
+	}
+	return req
+}
+
+// ToProtoSetPersistRateLimitRequest converts a Thrift set persist rate limit
+// request to a protobuf request.
+func ToProtoSetPersistRateLimitRequest(
+	r *rpc.NodeSetPersistRateLimitRequest,
+) *nodepb.SetPersistRateLimitRequest {
+	req := &nodepb.SetPersistRateLimitRequest{}
+	if r.LimitEnabled != nil {
+		req.LimitEnabled = &nodepb.BoolValue{Value: *r.LimitEnabled}
+	}
+	if r.LimitMbps != nil {
+		req.LimitMbps = &nodepb.DoubleValue{Value: *r.LimitMbps}
+	}
+	if r.LimitCheckEvery != nil {
+		req.LimitCheckEvery = &nodepb.Int64Value{Value: *r.LimitCheckEvery}
+	}
+	return req
+}
+
+// ToProtoPersistRateLimitResult converts a Thrift persist rate limit result
+// to a protobuf response.
+func ToProtoPersistRateLimitResult(
+	r *rpc.NodePersistRateLimitResult_,
+) *nodepb.PersistRateLimitResponse {
+	return &nodepb.PersistRateLimitResponse{
+		LimitEnabled:    r.LimitEnabled,
+		LimitMbps:       r.LimitMbps,
+		LimitCheckEvery: r.LimitCheckEvery,
+	}
+}
+
+// ToRPCPersistRateLimitResult converts a protobuf persist rate limit
+// response to a Thrift result.
+func ToRPCPersistRateLimitResult(
+	r *nodepb.PersistRateLimitResponse,
+) *rpc.NodePersistRateLimitResult_ {
+	result := rpc.NewNodePersistRateLimitResult_()
+	result.LimitEnabled = r.LimitEnabled
+	result.LimitMbps = r.LimitMbps
+	result.LimitCheckEvery = r.LimitCheckEvery
+	return result
+}
+
+// ToProtoWriteNewSeriesAsyncResult converts a Thrift write new series async
+// result to a protobuf response.
+func ToProtoWriteNewSeriesAsyncResult(
+	r *rpc.NodeWriteNewSeriesAsyncResult_,
+) *nodepb.WriteNewSeriesAsyncResponse {
+	return &nodepb.WriteNewSeriesAsyncResponse{
+		WriteNewSeriesAsync: r.WriteNewSeriesAsync,
+	}
+}
+
+// ToRPCWriteNewSeriesAsyncResult converts a protobuf write new series async
+// response to a Thrift result.
+func ToRPCWriteNewSeriesAsyncResult(
+	r *nodepb.WriteNewSeriesAsyncResponse,
+) *rpc.NodeWriteNewSeriesAsyncResult_ {
+	result := rpc.NewNodeWriteNewSeriesAsyncResult_()
+	result.WriteNewSeriesAsync = r.WriteNewSeriesAsync
+	return result
+}
+
+// ToRPCSetWriteNewSeriesBackoffDurationRequest converts a protobuf set write
+// new series backoff duration request to a Thrift request.
+func ToRPCSetWriteNewSeriesBackoffDurationRequest(
+	r *nodepb.SetWriteNewSeriesBackoffDurationRequest,
+) *rpc.NodeSetWriteNewSeriesBackoffDurationRequest {
+	req := rpc.NewNodeSetWriteNewSeriesBackoffDurationRequest()
+	req.WriteNewSeriesBackoffDuration = r.WriteNewSeriesBackoffDuration
+	req.DurationType = timeType
+	return req
+}
+
+// ToProtoSetWriteNewSeriesBackoffDurationRequest converts a Thrift set write
+// new series backoff duration request to a protobuf request.
+func ToProtoSetWriteNewSeriesBackoffDurationRequest(
+	r *rpc.NodeSetWriteNewSeriesBackoffDurationRequest,
+) (*nodepb.SetWriteNewSeriesBackoffDurationRequest, error) {
+	duration, err := toDurationNanos(r.WriteNewSeriesBackoffDuration, r.DurationType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.SetWriteNewSeriesBackoffDurationRequest{
+		WriteNewSeriesBackoffDuration: duration,
+	}, nil
+}
+
+// ToProtoWriteNewSeriesBackoffDurationResult converts a Thrift write new
+// series backoff duration result to a protobuf response.
+func ToProtoWriteNewSeriesBackoffDurationResult(
+	r *rpc.NodeWriteNewSeriesBackoffDurationResult_,
+) (*nodepb.WriteNewSeriesBackoffDurationResponse, error) {
+	duration, err := toDurationNanos(r.WriteNewSeriesBackoffDuration, r.DurationType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.WriteNewSeriesBackoffDurationResponse{
+		WriteNewSeriesBackoffDuration: duration,
+	}, nil
+}
+
+// ToRPCWriteNewSeriesBackoffDurationResult converts a protobuf write new
+// series backoff duration response to a Thrift result.
+func ToRPCWriteNewSeriesBackoffDurationResult(
+	r *nodepb.WriteNewSeriesBackoffDurationResponse,
+) *rpc.NodeWriteNewSeriesBackoffDurationResult_ {
+	result := rpc.NewNodeWriteNewSeriesBackoffDurationResult_()
+	result.WriteNewSeriesBackoffDuration = r.WriteNewSeriesBackoffDuration
+	result.DurationType = timeType
+	return result
+}
+
+// ToProtoWriteNewSeriesLimitPerShardPerSecondResult converts a Thrift write
+// new series limit result to a protobuf response.
+func ToProtoWriteNewSeriesLimitPerShardPerSecondResult(
+	r *rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_,
+) *nodepb.WriteNewSeriesLimitPerShardPerSecondResponse {
+	return &nodepb.WriteNewSeriesLimitPerShardPerSecondResponse{
+		WriteNewSeriesLimitPerShardPerSecond: r.WriteNewSeriesLimitPerShardPerSecond,
+	}
+}
+
+// ToRPCWriteNewSeriesLimitPerShardPerSecondResult converts a protobuf write
+// new series limit response to a Thrift result.
+func ToRPCWriteNewSeriesLimitPerShardPerSecondResult(
+	r *nodepb.WriteNewSeriesLimitPerShardPerSecondResponse,
+) *rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_ {
+	result := rpc.NewNodeWriteNewSeriesLimitPerShardPerSecondResult_()
+	result.WriteNewSeriesLimitPerShardPerSecond = r.WriteNewSeriesLimitPerShardPerSecond
+	return result
+}
+
+func toRPCQuery(q *nodepb.Query) *rpc.Query {
+	if q == nil {
+		return nil
+	}
+	query := rpc.NewQuery()
+	if q.Term != nil {
+		query.Term = rpc.NewTermQuery()
+		query.Term.Field = q.Term.Field
+		query.Term.Term = q.Term.Term
+	}
+	if q.Regexp != nil {
+		query.Regexp = rpc.NewRegexpQuery()
+		query.Regexp.Field = q.Regexp.Field
+		query.Regexp.Regexp = q.Regexp.Regexp
+	}
+	if q.Negation != nil {
+		query.Negation = rpc.NewNegationQuery()
+		query.Negation.Query = toRPCQuery(q.Negation.Query)
+	}
+	if q.Conjunction != nil {
+		query.Conjunction = rpc.NewConjunctionQuery()
+		query.Conjunction.Queries = toRPCQueries(q.Conjunction.Queries)
+	}
+	if q.Disjunction != nil {
+		query.Disjunction = rpc.NewDisjunctionQuery()
+		query.Disjunction.Queries = toRPCQueries(q.Disjunction.Queries)
+	}
+	if q.All != nil {
+		query.All = rpc.NewAllQuery()
+	}
+	if q.Field != nil {
+		query.Field = rpc.NewFieldQuery()
+		query.Field.Field = q.Field.Field
+	}
+	return query
+}
+
+func toRPCQueries(queries []*nodepb.Query) []*rpc.Query {
+	rpcQueries := make([]*rpc.Query, 0, len(queries))
+	for _, q := range queries {
+		rpcQueries = append(rpcQueries, toRPCQuery(q))
+	}
+	return rpcQueries
+}
+
+func toProtoQuery(q *rpc.Query) *nodepb.Query {
+	if q == nil {
+		return nil
+	}
+	query := &nodepb.Query{}
+	if q.Term != nil {
+		query.Term = &nodepb.TermQuery{Field: q.Term.Field, Term: q.Term.Term}
+	}
+	if q.Regexp != nil {
+		query.Regexp = &nodepb.RegexpQuery{Field: q.Regexp.Field, Regexp: q.Regexp.Regexp}
+	}
+	if q.Negation != nil {
+		query.Negation = &nodepb.NegationQuery{Query: toProtoQuery(q.Negation.Query)}
+	}
+	if q.Conjunction != nil {
+		query.Conjunction = &nodepb.ConjunctionQuery{Queries: toProtoQueries(q.Conjunction.Queries)}
+	}
+	if q.Disjunction != nil {
+		query.Disjunction = &nodepb.DisjunctionQuery{Queries: toProtoQueries(q.Disjunction.Queries)}
+	}
+	if q.All != nil {
+		query.All = &nodepb.AllQuery{}
+	}
+	if q.Field != nil {
+		query.Field = &nodepb.FieldQuery{Field: q.Field.Field}
+	}
+	return query
+}
+
+func toProtoQueries(queries []*rpc.Query) []*nodepb.Query {
+	protoQueries := make([]*nodepb.Query, 0, len(queries))
+	for _, q := range queries {
+		protoQueries = append(protoQueries, toProtoQuery(q))
+	}
+	return protoQueries
+}
+
+func toRPCAggregateQueryType(t nodepb.AggregateQueryType) rpc.AggregateQueryType {
+	if t == nodepb.AggregateQueryType_AGGREGATE_BY_TAG_NAME {
+		return rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME
+	}
+	return rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME_VALUE
+}
+
+func toProtoAggregateQueryType(t rpc.AggregateQueryType) nodepb.AggregateQueryType {
+	if t == rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME {
+		return nodepb.AggregateQueryType_AGGREGATE_BY_TAG_NAME
+	}
+	return nodepb.AggregateQueryType_AGGREGATE_BY_TAG_NAME_VALUE
+}
+
+func toRPCTags(tags []*nodepb.Tag) []*rpc.Tag {
+	rpcTags := make([]*rpc.Tag, 0, len(tags))
+	for _, tag := range tags {
+		rpcTag := rpc.NewTag()
+		rpcTag.Name = tag.Name
+		rpcTag.Value = tag.Value
+		rpcTags = append(rpcTags, rpcTag)
+	}
+	return rpcTags
+}
+
+func toProtoTags(tags []*rpc.Tag) []*nodepb.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	protoTags := make([]*nodepb.Tag, 0, len(tags))
+	for _, tag := range tags {
+		protoTags = append(protoTags, &nodepb.Tag{Name: tag.Name, Value: tag.Value})
+	}
+	return protoTags
+}
+
+// toRPCDatapoint always returns a datapoint since datapoints are required by
+// the Thrift requests that carry them.
+func toRPCDatapoint(dp *nodepb.Datapoint) *rpc.Datapoint {
+	datapoint := rpc.NewDatapoint()
+	if dp != nil {
+		datapoint.Timestamp = dp.Timestamp
+		datapoint.TimestampTimeType = timeType
+		datapoint.Value = dp.Value
+		datapoint.Annotation = dp.Annotation
+	}
+	return datapoint
+}
+
+func toProtoDatapoint(dp *rpc.Datapoint) (*nodepb.Datapoint, error) {
+	if dp == nil {
+		return nil, nil
+	}
+	timestamp, err := toNanos(dp.Timestamp, dp.TimestampTimeType)
+	if err != nil {
+		return nil, err
+	}
+	return &nodepb.Datapoint{
+		Timestamp:  timestamp,
+		Value:      dp.Value,
+		Annotation: dp.Annotation,
+	}, nil
+}
+
+// toProtoResultDatapoints converts datapoints read with a result time type
+// of unix nanoseconds.
+func toProtoResultDatapoints(dps []*rpc.Datapoint) []*nodepb.Datapoint {
+	if len(dps) == 0 {
+		return nil
+	}
+	protoDps := make([]*nodepb.Datapoint, 0, len(dps))
+	for _, dp := range dps {
+		protoDps = append(protoDps, &nodepb.Datapoint{
+			Timestamp:  dp.Timestamp,
+			Value:      dp.Value,
+			Annotation: dp.Annotation,
+		})
+	}
+	return protoDps
+}
+
+// toRPCResultDatapoints converts datapoints to the result time type that was
+// requested by the caller.
+func toRPCResultDatapoints(
+	dps []*nodepb.Datapoint,
+	resultTimeType rpc.TimeType,
+) ([]*rpc.Datapoint, error) {
+	rpcDps := make([]*rpc.Datapoint, 0, len(dps))
+	for _, dp := range dps {
+		timestamp, err := fromNanos(dp.Timestamp, resultTimeType)
+		if err != nil {
+			return nil, err
+		}
+		rpcDp := rpc.NewDatapoint()
+		rpcDp.Timestamp = timestamp
+		rpcDp.Value = dp.Value
+		rpcDp.Annotation = dp.Annotation
+		rpcDps = append(rpcDps, rpcDp)
+	}
+	return rpcDps, nil
+}
+
+func toProtoSegments(segs *rpc.Segments) *nodepb.Segments {
+	if segs == nil {
+		return nil
+	}
+	protoSegs := &nodepb.Segments{Merged: toProtoSegment(segs.Merged)}
+	if len(segs.Unmerged) > 0 {
+		protoSegs.Unmerged = make([]*nodepb.Segment, 0, len(segs.Unmerged))
+		for _, seg := range segs.Unmerged {
+			protoSegs.Unmerged = append(protoSegs.Unmerged, toProtoSegment(seg))
+		}
+	}
+	return protoSegs
+}
+
+func toProtoSegment(seg *rpc.Segment) *nodepb.Segment {
+	if seg == nil {
+		return nil
+	}
+	return &nodepb.Segment{
+		Head:      seg.Head,
+		Tail:      seg.Tail,
+		StartTime: int64Value(seg.StartTime),
+		BlockSize: int64Value(seg.BlockSize),
+		Checksum:  int64Value(seg.Checksum),
+	}
+}
+
+func toRPCSegments(segs *nodepb.Segments) *rpc.Segments {
+	if segs == nil {
+		return nil
+	}
+	rpcSegs := rpc.NewSegments()
+	rpcSegs.Merged = toRPCSegment(segs.Merged)
+	if len(segs.Unmerged) > 0 {
+		rpcSegs.Unmerged = make([]*rpc.Segment, 0, len(segs.Unmerged))
+		for _, seg := range segs.Unmerged {
+			rpcSegs.Unmerged = append(rpcSegs.Unmerged, toRPCSegment(seg))
+		}
+	}
+	return rpcSegs
+}
+
+func toRPCSegment(seg *nodepb.Segment) *rpc.Segment {
+	if seg == nil {
+		return nil
+	}
+	rpcSeg := rpc.NewSegment()
+	rpcSeg.Head = seg.Head
+	rpcSeg.Tail = seg.Tail
+	rpcSeg.StartTime = optionalInt64(seg.StartTime)
+	rpcSeg.BlockSize = optionalInt64(seg.BlockSize)
+	rpcSeg.Checksum = optionalInt64(seg.Checksum)
+	return rpcSeg
+}
+
+func toNanos(value int64, valueTimeType rpc.TimeType) (int64, error) {
+	if value == 0 || valueTimeType == timeType {
+		// Zero is unset regardless of the unit.
+		return value, nil
+	}
+	t, err := tchannelthriftconvert.ToTime(value, valueTimeType)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano(), nil
+}
+
+func fromNanos(value int64, valueTimeType rpc.TimeType) (int64, error) {
+	if value == 0 || valueTimeType == timeType {
+		return value, nil
+	}
+	return tchannelthriftconvert.ToValue(time.Unix(0, value), valueTimeType)
+}
+
+func toDurationNanos(value int64, durationType rpc.TimeType) (int64, error) {
+	unit, err := tchannelthriftconvert.ToDuration(durationType)
+	if err != nil {
+		return 0, err
+	}
+	return int64(time.Duration(value) * unit), nil
+}
+
+func optionalInt64(v int64) *int64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func optionalBool(v bool) *bool {
+	if !v {
+		return nil
+	}
+	return &v
+}
+
+func boolValue(v *bool) bool {
+	return v != nil && *v
+}
@@ -0,0 +1,658 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package convert
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusErrorRoundTrip(t *testing.T) {
+	for _, rpcErr := range []*rpc.Error{
+		tterrors.NewBadRequestError(errors.New("bad")),
+		tterrors.NewInternalError(errors.New("internal")),
+		tterrors.NewResourceExhaustedError(errors.New("exhausted")),
+	} {
+		statusErr := ToStatusError(rpcErr)
+		_, ok := status.FromError(statusErr)
+		require.True(t, ok)
+		assert.Equal(t, rpcErr, FromStatusError(statusErr))
+	}
+
+	// Errors that are not Thrift errors are passed through.
+	err := errors.New("some error")
+	assert.Equal(t, err, ToStatusError(err))
+
+	// Transport errors are passed through.
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	assert.Equal(t, unavailable, FromStatusError(unavailable))
+
+	// Plain errors returned by the service are surfaced as internal errors.
+	unknown := FromStatusError(status.Error(codes.Unknown, "unknown"))
+	assert.True(t, tterrors.IsInternalError(unknown.(*rpc.Error)))
+}
+
+func TestWriteTaggedBatchRawRequestRoundTrip(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	req := rpc.NewWriteTaggedBatchRawRequest()
+	req.NameSpace = []byte("ns")
+	req.Elements = []*rpc.WriteTaggedBatchRawRequestElement{
+		{
+			ID:          []byte("foo"),
+			EncodedTags: []byte("tags"),
+			Datapoint: &rpc.Datapoint{
+				Timestamp:         now.Unix(),
+				TimestampTimeType: rpc.TimeType_UNIX_SECONDS,
+				Value:             42,
+				Annotation:        []byte("annotation"),
+			},
+		},
+	}
+
+	protoReq, err := ToProtoWriteTaggedBatchRawRequest(req)
+	require.NoError(t, err)
+	require.Len(t, protoReq.Elements, 1)
+	assert.Equal(t, now.UnixNano(), protoReq.Elements[0].Datapoint.Timestamp)
+
+	result := ToRPCWriteTaggedBatchRawRequest(protoReq)
+	require.Len(t, result.Elements, 1)
+	assert.Equal(t, req.NameSpace, result.NameSpace)
+	assert.Equal(t, req.Elements[0].ID, result.Elements[0].ID)
+	assert.Equal(t, req.Elements[0].EncodedTags, result.Elements[0].EncodedTags)
+	assert.Equal(t, &rpc.Datapoint{
+		Timestamp:         now.UnixNano(),
+		TimestampTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+		Value:             42,
+		Annotation:        []byte("annotation"),
+	}, result.Elements[0].Datapoint)
+}
+
+func TestWriteBatchRawResponseRoundTrip(t *testing.T) {
+	resp, err := ToProtoWriteBatchRawResponse(nil)
+	require.NoError(t, err)
+	assert.NoError(t, FromProtoWriteBatchRawResponse(resp))
+
+	batchErrs := rpc.NewWriteBatchRawErrors()
+	batchErrs.Errors = []*rpc.WriteBatchRawError{
+		tterrors.NewBadRequestWriteBatchRawError(1, errors.New("bad")),
+		tterrors.NewWriteBatchRawError(3, errors.New("internal")),
+	}
+	resp, err = ToProtoWriteBatchRawResponse(batchErrs)
+	require.NoError(t, err)
+	assert.Equal(t, batchErrs, FromProtoWriteBatchRawResponse(resp))
+
+	// Errors other than batch errors fail the whole request.
+	_, err = ToProtoWriteBatchRawResponse(tterrors.NewInternalError(errors.New("err")))
+	assert.Error(t, err)
+}
+
+func TestFetchTaggedRoundTrip(t *testing.T) {
+	var (
+		limit    = int64(10)
+		start    = int64(1)
+		size     = int64(2)
+		checksum = int64(3)
+	)
+	req := rpc.NewFetchTaggedRequest()
+	req.NameSpace = []byte("ns")
+	req.Query = []byte("query")
+	req.RangeStart = 1
+	req.RangeEnd = 2
+	req.RangeTimeType = rpc.TimeType_UNIX_MILLISECONDS
+	req.FetchData = true
+	req.Limit = &limit
+	req.PageToken = []byte("token")
+
+	protoReq, err := ToProtoFetchTaggedRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(time.Millisecond), protoReq.RangeStart)
+	assert.Equal(t, int64(2*time.Millisecond), protoReq.RangeEnd)
+
+	rpcReq := ToRPCFetchTaggedRequest(protoReq)
+	assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, rpcReq.RangeTimeType)
+	assert.Equal(t, protoReq.RangeStart, rpcReq.RangeStart)
+	assert.Equal(t, protoReq.RangeEnd, rpcReq.RangeEnd)
+	assert.Equal(t, req.Limit, rpcReq.Limit)
+	assert.Equal(t, req.PageToken, rpcReq.PageToken)
+	assert.True(t, rpcReq.FetchData)
+
+	result := rpc.NewFetchTaggedResult_()
+	result.Exhaustive = true
+	result.NextPageToken = []byte("next")
+	result.Elements = []*rpc.FetchTaggedIDResult_{
+		{
+			ID:          []byte("foo"),
+			NameSpace:   []byte("ns"),
+			EncodedTags: []byte("tags"),
+			Segments: []*rpc.Segments{
+				{
+					Merged: &rpc.Segment{
+						Head:      []byte("head"),
+						Tail:      []byte("tail"),
+						StartTime: &start,
+						BlockSize: &size,
+						Checksum:  &checksum,
+					},
+				},
+				{
+					Unmerged: []*rpc.Segment{
+						{Head: []byte("a")},
+						{Head: []byte("b")},
+					},
+				},
+			},
+		},
+		{
+			ID:  []byte("bar"),
+			Err: tterrors.NewInternalError(errors.New("err")),
+		},
+	}
+
+	assert.Equal(t, result, ToRPCFetchTaggedResult(ToProtoFetchTaggedResult(result)))
+}
+
+func TestAggregateRawRoundTrip(t *testing.T) {
+	limit := int64(10)
+	req := rpc.NewAggregateQueryRawRequest()
+	req.Query = []byte("query")
+	req.RangeStart = 1
+	req.RangeEnd = 2
+	req.RangeType = rpc.TimeType_UNIX_NANOSECONDS
+	req.NameSpace = []byte("ns")
+	req.Limit = &limit
+	req.TagNameFilter = [][]byte{[]byte("foo")}
+	req.AggregateQueryType = rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME_VALUE
+
+	protoReq, err := ToProtoAggregateRawRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, nodepb.AggregateQueryType_AGGREGATE_BY_TAG_NAME_VALUE, protoReq.AggregateQueryType)
+	assert.Equal(t, req, ToRPCAggregateRawRequest(protoReq))
+
+	req.AggregateQueryType = rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME
+	protoReq, err = ToProtoAggregateRawRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, nodepb.AggregateQueryType_AGGREGATE_BY_TAG_NAME, protoReq.AggregateQueryType)
+	assert.Equal(t, req, ToRPCAggregateRawRequest(protoReq))
+
+	result := rpc.NewAggregateQueryRawResult_()
+	result.Exhaustive = true
+	result.Results = []*rpc.AggregateQueryRawResultTagNameElement{
+		{
+			TagName: []byte("foo"),
+			TagValues: []*rpc.AggregateQueryRawResultTagValueElement{
+				{TagValue: []byte("bar")},
+				{TagValue: []byte("baz")},
+			},
+		},
+		{TagName: []byte("qux")},
+	}
+
+	assert.Equal(t, result, ToRPCAggregateRawResult(ToProtoAggregateRawResult(result)))
+}
+
+func TestFetchBlocksRawRoundTrip(t *testing.T) {
+	req := rpc.NewFetchBlocksRawRequest()
+	req.NameSpace = []byte("ns")
+	req.Shard = 3
+	req.Elements = []*rpc.FetchBlocksRawRequestElement{
+		{ID: []byte("foo"), Starts: []int64{1, 2}},
+	}
+
+	assert.Equal(t, req, ToRPCFetchBlocksRawRequest(ToProtoFetchBlocksRawRequest(req)))
+
+	checksum := int64(4)
+	result := rpc.NewFetchBlocksRawResult_()
+	result.Elements = []*rpc.Blocks{
+		{
+			ID: []byte("foo"),
+			Blocks: []*rpc.Block{
+				{
+					Start:    1,
+					Segments: &rpc.Segments{Merged: &rpc.Segment{Head: []byte("head")}},
+					Checksum: &checksum,
+				},
+				{
+					Start: 2,
+					Err:   tterrors.NewBadRequestError(errors.New("err")),
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, result, ToRPCFetchBlocksRawResult(ToProtoFetchBlocksRawResult(result)))
+}
+
+func TestHealthResultRoundTrip(t *testing.T) {
+	result := rpc.NewNodeHealthResult_()
+	result.Ok = true
+	result.Status = "up"
+	result.Bootstrapped = true
+
+	resp := ToProtoHealthResult(result)
+	assert.Equal(t, &nodepb.HealthResponse{Ok: true, Status: "up", Bootstrapped: true}, resp)
+	assert.Equal(t, result, ToRPCHealthResult(resp))
+}
+
+func TestQueryRoundTrip(t *testing.T) {
+	limit := int64(10)
+	noData := true
+	req := rpc.NewQueryRequest()
+	req.Query = &rpc.Query{
+		Conjunction: &rpc.ConjunctionQuery{
+			Queries: []*rpc.Query{
+				{Term: &rpc.TermQuery{Field: "foo", Term: "bar"}},
+				{Negation: &rpc.NegationQuery{
+					Query: &rpc.Query{Regexp: &rpc.RegexpQuery{Field: "baz", Regexp: "qu.*"}},
+				}},
+				{Disjunction: &rpc.DisjunctionQuery{
+					Queries: []*rpc.Query{
+						{All: &rpc.AllQuery{}},
+						{Field: &rpc.FieldQuery{Field: "qux"}},
+					},
+				}},
+			},
+		},
+	}
+	req.RangeStart = 1
+	req.RangeEnd = 2
+	req.RangeType = rpc.TimeType_UNIX_SECONDS
+	req.ResultTimeType = rpc.TimeType_UNIX_SECONDS
+	req.NameSpace = "ns"
+	req.Limit = &limit
+	req.NoData = &noData
+
+	protoReq, err := ToProtoQueryRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(time.Second), protoReq.RangeStart)
+	assert.Equal(t, int64(2*time.Second), protoReq.RangeEnd)
+
+	rpcReq := ToRPCQueryRequest(protoReq)
+	assert.Equal(t, req.Query, rpcReq.Query)
+	assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, rpcReq.RangeType)
+	assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, rpcReq.ResultTimeType)
+	assert.Equal(t, req.Limit, rpcReq.Limit)
+	assert.Equal(t, req.NoData, rpcReq.NoData)
+
+	// The node returns nanoseconds which are converted back to the result
+	// time type of the caller.
+	result := rpc.NewQueryResult_()
+	result.Exhaustive = true
+	result.Results = []*rpc.QueryResultElement{
+		{
+			ID:   "foo",
+			Tags: []*rpc.Tag{{Name: "bar", Value: "baz"}},
+			Datapoints: []*rpc.Datapoint{
+				{Timestamp: int64(3 * time.Second), Value: 42, Annotation: []byte("a")},
+			},
+		},
+	}
+	rpcResult, err := ToRPCQueryResult(ToProtoQueryResult(result), rpc.TimeType_UNIX_SECONDS)
+	require.NoError(t, err)
+	result.Results[0].Datapoints[0].Timestamp = 3
+	assert.Equal(t, result, rpcResult)
+}
+
+func TestAggregateRoundTrip(t *testing.T) {
+	limit := int64(10)
+	req := rpc.NewAggregateQueryRequest()
+	req.Query = &rpc.Query{All: &rpc.AllQuery{}}
+	req.RangeStart = 1
+	req.RangeEnd = 2
+	req.RangeType = rpc.TimeType_UNIX_NANOSECONDS
+	req.NameSpace = "ns"
+	req.Limit = &limit
+	req.TagNameFilter = []string{"foo"}
+	req.AggregateQueryType = rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME
+
+	protoReq, err := ToProtoAggregateRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, req, ToRPCAggregateRequest(protoReq))
+
+	result := rpc.NewAggregateQueryResult_()
+	result.Exhaustive = true
+	result.Results = []*rpc.AggregateQueryResultTagNameElement{
+		{
+			TagName: "foo",
+			TagValues: []*rpc.AggregateQueryResultTagValueElement{
+				{TagValue: "bar"},
+			},
+		},
+		{TagName: "baz"},
+	}
+
+	assert.Equal(t, result, ToRPCAggregateResult(ToProtoAggregateResult(result)))
+}
+
+func TestFetchRoundTrip(t *testing.T) {
+	req := rpc.NewFetchRequest()
+	req.NameSpace = "ns"
+	req.ID = "foo"
+	req.RangeStart = 1
+	req.RangeEnd = 2
+	req.RangeType = rpc.TimeType_UNIX_MILLISECONDS
+	req.ResultTimeType = rpc.TimeType_UNIX_MILLISECONDS
+
+	protoReq, err := ToProtoFetchRequest(req)
+	require.NoError(t, err)
+	rpcReq := ToRPCFetchRequest(protoReq)
+	assert.Equal(t, int64(time.Millisecond), rpcReq.RangeStart)
+	assert.Equal(t, int64(2*time.Millisecond), rpcReq.RangeEnd)
+	assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, rpcReq.RangeType)
+	assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, rpcReq.ResultTimeType)
+
+	result := rpc.NewFetchResult_()
+	result.Datapoints = []*rpc.Datapoint{
+		{Timestamp: int64(3 * time.Millisecond), Value: 42},
+	}
+	rpcResult, err := ToRPCFetchResult(ToProtoFetchResult(result), rpc.TimeType_UNIX_MILLISECONDS)
+	require.NoError(t, err)
+	require.Len(t, rpcResult.Datapoints, 1)
+	assert.Equal(t, int64(3), rpcResult.Datapoints[0].Timestamp)
+	assert.Equal(t, float64(42), rpcResult.Datapoints[0].Value)
+
+	// Results without datapoints are returned as empty rather than nil.
+	rpcResult, err = ToRPCFetchResult(ToProtoFetchResult(rpc.NewFetchResult_()), rpc.TimeType_UNIX_SECONDS)
+	require.NoError(t, err)
+	assert.Equal(t, []*rpc.Datapoint{}, rpcResult.Datapoints)
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	req := rpc.NewWriteRequest()
+	req.NameSpace = "ns"
+	req.ID = "foo"
+	req.Datapoint = &rpc.Datapoint{
+		Timestamp:         1,
+		TimestampTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+		Value:             42,
+		Annotation:        []byte("annotation"),
+	}
+
+	protoReq, err := ToProtoWriteRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, req, ToRPCWriteRequest(protoReq))
+
+	taggedReq := rpc.NewWriteTaggedRequest()
+	taggedReq.NameSpace = "ns"
+	taggedReq.ID = "foo"
+	taggedReq.Tags = []*rpc.Tag{{Name: "bar", Value: "baz"}}
+	taggedReq.Datapoint = req.Datapoint
+
+	protoTaggedReq, err := ToProtoWriteTaggedRequest(taggedReq)
+	require.NoError(t, err)
+	assert.Equal(t, taggedReq, ToRPCWriteTaggedRequest(protoTaggedReq))
+}
+
+func TestFetchBatchRawRoundTrip(t *testing.T) {
+	req := rpc.NewFetchBatchRawRequest()
+	req.NameSpace = []byte("ns")
+	req.Ids = [][]byte{[]byte("foo"), []byte("bar")}
+	req.RangeStart = 1
+	req.RangeEnd = 2
+	req.RangeTimeType = rpc.TimeType_UNIX_NANOSECONDS
+
+	protoReq, err := ToProtoFetchBatchRawRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, req, ToRPCFetchBatchRawRequest(protoReq))
+
+	reqV2 := rpc.NewFetchBatchRawV2Request()
+	reqV2.NameSpaces = [][]byte{[]byte("ns")}
+	reqV2.Elements = []*rpc.FetchBatchRawV2RequestElement{
+		{
+			NameSpace:     0,
+			ID:            []byte("foo"),
+			RangeStart:    1,
+			RangeEnd:      2,
+			RangeTimeType: rpc.TimeType_UNIX_SECONDS,
+		},
+	}
+
+	protoReqV2, err := ToProtoFetchBatchRawV2Request(reqV2)
+	require.NoError(t, err)
+	rpcReqV2 := ToRPCFetchBatchRawV2Request(protoReqV2)
+	require.Len(t, rpcReqV2.Elements, 1)
+	assert.Equal(t, int64(time.Second), rpcReqV2.Elements[0].RangeStart)
+	assert.Equal(t, int64(2*time.Second), rpcReqV2.Elements[0].RangeEnd)
+	assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, rpcReqV2.Elements[0].RangeTimeType)
+
+	result := rpc.NewFetchBatchRawResult_()
+	result.Elements = []*rpc.FetchRawResult_{
+		{
+			Segments: []*rpc.Segments{
+				{Merged: &rpc.Segment{Head: []byte("head"), Tail: []byte("tail")}},
+			},
+		},
+		{
+			Segments: []*rpc.Segments{},
+			Err:      tterrors.NewInternalError(errors.New("err")),
+		},
+	}
+
+	assert.Equal(t, result, ToRPCFetchBatchRawResult(ToProtoFetchBatchRawResult(result)))
+}
+
+func TestFetchBlocksMetadataRawV2RoundTrip(t *testing.T) {
+	includeSizes := true
+	req := rpc.NewFetchBlocksMetadataRawV2Request()
+	req.NameSpace = []byte("ns")
+	req.Shard = 3
+	req.RangeStart = 1
+	req.RangeEnd = 2
+	req.Limit = 10
+	req.PageToken = []byte("token")
+	req.IncludeSizes = &includeSizes
+
+	assert.Equal(t, req,
+		ToRPCFetchBlocksMetadataRawV2Request(ToProtoFetchBlocksMetadataRawV2Request(req)))
+
+	var (
+		size     = int64(4)
+		checksum = int64(5)
+		lastRead = int64(6)
+	)
+	result := rpc.NewFetchBlocksMetadataRawV2Result_()
+	result.NextPageToken = []byte("next")
+	result.Elements = []*rpc.BlockMetadataV2{
+		{
+			ID:               []byte("foo"),
+			Start:            1,
+			Size:             &size,
+			Checksum:         &checksum,
+			LastRead:         &lastRead,
+			LastReadTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+			EncodedTags:      []byte("tags"),
+		},
+		{
+			ID:    []byte("bar"),
+			Start: 2,
+			Err:   tterrors.NewInternalError(errors.New("err")),
+		},
+	}
+
+	resp, err := ToProtoFetchBlocksMetadataRawV2Result(result)
+	require.NoError(t, err)
+	assert.Equal(t, result, ToRPCFetchBlocksMetadataRawV2Result(resp))
+
+	// The node returns no result for requests without a limit.
+	resp, err = ToProtoFetchBlocksMetadataRawV2Result(nil)
+	require.NoError(t, err)
+	assert.Equal(t, &nodepb.FetchBlocksMetadataRawV2Response{}, resp)
+}
+
+func TestWriteBatchRawRequestRoundTrip(t *testing.T) {
+	datapoint := &rpc.Datapoint{
+		Timestamp:         1,
+		TimestampTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+		Value:             42,
+	}
+
+	req := rpc.NewWriteBatchRawRequest()
+	req.NameSpace = []byte("ns")
+	req.Elements = []*rpc.WriteBatchRawRequestElement{
+		{ID: []byte("foo"), Datapoint: datapoint},
+	}
+	protoReq, err := ToProtoWriteBatchRawRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, req, ToRPCWriteBatchRawRequest(protoReq))
+
+	reqV2 := rpc.NewWriteBatchRawV2Request()
+	reqV2.NameSpaces = [][]byte{[]byte("ns"), []byte("other")}
+	reqV2.Elements = []*rpc.WriteBatchRawV2RequestElement{
+		{ID: []byte("foo"), Datapoint: datapoint, NameSpace: 1},
+	}
+	protoReqV2, err := ToProtoWriteBatchRawV2Request(reqV2)
+	require.NoError(t, err)
+	assert.Equal(t, reqV2, ToRPCWriteBatchRawV2Request(protoReqV2))
+
+	taggedReqV2 := rpc.NewWriteTaggedBatchRawV2Request()
+	taggedReqV2.NameSpaces = [][]byte{[]byte("ns")}
+	taggedReqV2.Elements = []*rpc.WriteTaggedBatchRawV2RequestElement{
+		{ID: []byte("foo"), EncodedTags: []byte("tags"), Datapoint: datapoint},
+	}
+	protoTaggedReqV2, err := ToProtoWriteTaggedBatchRawV2Request(taggedReqV2)
+	require.NoError(t, err)
+	assert.Equal(t, taggedReqV2, ToRPCWriteTaggedBatchRawV2Request(protoTaggedReqV2))
+}
+
+func TestDeleteTaggedRoundTrip(t *testing.T) {
+	req := rpc.NewDeleteTaggedRequest()
+	req.NameSpace = []byte("ns")
+	req.Query = []byte("query")
+	req.RangeStart = 1
+	req.RangeEnd = 2
+	req.RangeTimeType = rpc.TimeType_UNIX_NANOSECONDS
+
+	protoReq, err := ToProtoDeleteTaggedRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, req, ToRPCDeleteTaggedRequest(protoReq))
+
+	result := rpc.NewDeleteTaggedResult_()
+	result.NumSeries = 5
+	result.NumSeriesByShard = map[int32]int64{3: 2, 1: 3}
+
+	resp := ToProtoDeleteTaggedResult(result)
+	assert.Equal(t, []*nodepb.ShardNumSeries{
+		{Shard: 1, NumSeries: 3},
+		{Shard: 3, NumSeries: 2},
+	}, resp.NumSeriesByShard)
+	assert.Equal(t, result, ToRPCDeleteTaggedResult(resp))
+}
+
+func TestCardinalityRoundTrip(t *testing.T) {
+	fieldsLimit := int64(10)
+	req := rpc.NewCardinalityRequest()
+	req.NameSpace = []byte("ns")
+	req.RangeStart = 1
+	req.RangeEnd = 2
+	req.RangeTimeType = rpc.TimeType_UNIX_NANOSECONDS
+	req.FieldsLimit = &fieldsLimit
+
+	protoReq, err := ToProtoCardinalityRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, req, ToRPCCardinalityRequest(protoReq))
+
+	result := rpc.NewCardinalityResult_()
+	result.Fields = []*rpc.CardinalityField{
+		{
+			Name:           []byte("foo"),
+			SeriesEstimate: 1,
+			SeriesSketch:   []byte("series"),
+			ValuesEstimate: 2,
+			ValuesSketch:   []byte("values"),
+			TopValues: []*rpc.CardinalityValue{
+				{Value: []byte("bar"), SeriesEstimate: 3, SeriesSketch: []byte("sketch")},
+			},
+		},
+	}
+
+	assert.Equal(t, result, ToRPCCardinalityResult(ToProtoCardinalityResult(result)))
+}
+
+func TestWriteDocumentRequestRoundTrip(t *testing.T) {
+	req := rpc.NewWriteDocumentRequest()
+	req.NameSpace = []byte("ns")
+	req.ID = []byte("foo")
+	req.EncodedTags = []byte("tags")
+	req.Timestamp = 1
+	req.TimestampTimeType = rpc.TimeType_UNIX_SECONDS
+
+	protoReq, err := ToProtoWriteDocumentRequest(req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(time.Second), protoReq.Timestamp)
+
+	rpcReq := ToRPCWriteDocumentRequest(protoReq)
+	assert.Equal(t, int64(time.Second), rpcReq.Timestamp)
+	assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, rpcReq.TimestampTimeType)
+}
+
+func TestRuntimeOptionsRoundTrip(t *testing.T) {
+	limitMbps := float64(2)
+	setPersistReq := rpc.NewNodeSetPersistRateLimitRequest()
+	setPersistReq.LimitMbps = &limitMbps
+	assert.Equal(t, setPersistReq,
+		ToRPCSetPersistRateLimitRequest(ToProtoSetPersistRateLimitRequest(setPersistReq)))
+
+	persistResult := rpc.NewNodePersistRateLimitResult_()
+	persistResult.LimitEnabled = true
+	persistResult.LimitMbps = 2
+	persistResult.LimitCheckEvery = 3
+	assert.Equal(t, persistResult,
+		ToRPCPersistRateLimitResult(ToProtoPersistRateLimitResult(persistResult)))
+
+	asyncResult := rpc.NewNodeWriteNewSeriesAsyncResult_()
+	asyncResult.WriteNewSeriesAsync = true
+	assert.Equal(t, asyncResult,
+		ToRPCWriteNewSeriesAsyncResult(ToProtoWriteNewSeriesAsyncResult(asyncResult)))
+
+	// Durations are sent as nanoseconds.
+	setBackoffReq := rpc.NewNodeSetWriteNewSeriesBackoffDurationRequest()
+	setBackoffReq.WriteNewSeriesBackoffDuration = 5
+	setBackoffReq.DurationType = rpc.TimeType_UNIX_MILLISECONDS
+	protoSetBackoffReq, err := ToProtoSetWriteNewSeriesBackoffDurationRequest(setBackoffReq)
+	require.NoError(t, err)
+	rpcSetBackoffReq := ToRPCSetWriteNewSeriesBackoffDurationRequest(protoSetBackoffReq)
+	assert.Equal(t, int64(5*time.Millisecond), rpcSetBackoffReq.WriteNewSeriesBackoffDuration)
+	assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, rpcSetBackoffReq.DurationType)
+
+	backoffResult := rpc.NewNodeWriteNewSeriesBackoffDurationResult_()
+	backoffResult.WriteNewSeriesBackoffDuration = 5
+	backoffResult.DurationType = rpc.TimeType_UNIX_MILLISECONDS
+	protoBackoffResult, err := ToProtoWriteNewSeriesBackoffDurationResult(backoffResult)
+	require.NoError(t, err)
+	rpcBackoffResult := ToRPCWriteNewSeriesBackoffDurationResult(protoBackoffResult)
+	assert.Equal(t, int64(5*time.Millisecond), rpcBackoffResult.WriteNewSeriesBackoffDuration)
+	assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, rpcBackoffResult.DurationType)
+
+	limitResult := rpc.NewNodeWriteNewSeriesLimitPerShardPerSecondResult_()
+	limitResult.WriteNewSeriesLimitPerShardPerSecond = 100
+	assert.Equal(t, limitResult,
+		ToRPCWriteNewSeriesLimitPerShardPerSecondResult(
+			ToProtoWriteNewSeriesLimitPerShardPerSecondResult(limitResult)))
+}
@@ -0,0 +1,478 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	stdctx "context"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/grpc/convert"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
+	"github.com/m3db/m3/src/x/context"
+
+	"github.com/uber/tchannel-go/thrift"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+)
+
+type contextCloserKey struct{}
+
+// contextCloser holds the M3DB context of a request so that it can be closed
+// once the response has been sent.
+type contextCloser struct {
+	ctx context.Context
+}
+
+type service struct {
+	node        rpc.TChanNode
+	contextPool context.Pool
+}
+
+func newService(node rpc.TChanNode, contextPool context.Pool) nodepb.NodeServer {
+	return &service{
+		node:        node,
+		contextPool: contextPool,
+	}
+}
+
+func (s *service) Health(
+	ctx stdctx.Context,
+	req *nodepb.HealthRequest,
+) (*nodepb.HealthResponse, error) {
+	result, err := s.node.Health(s.thriftContext(ctx))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoHealthResult(result), nil
+}
+
+func (s *service) WriteTaggedBatchRaw(
+	ctx stdctx.Context,
+	req *nodepb.WriteTaggedBatchRawRequest,
+) (*nodepb.WriteBatchRawResponse, error) {
+	err := s.node.WriteTaggedBatchRaw(s.thriftContext(ctx),
+		convert.ToRPCWriteTaggedBatchRawRequest(req))
+	resp, err := convert.ToProtoWriteBatchRawResponse(err)
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+func (s *service) FetchTagged(
+	ctx stdctx.Context,
+	req *nodepb.FetchTaggedRequest,
+) (*nodepb.FetchTaggedResponse, error) {
+	result, err := s.node.FetchTagged(s.thriftContext(ctx),
+		convert.ToRPCFetchTaggedRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoFetchTaggedResult(result), nil
+}
+
+func (s *service) AggregateRaw(
+	ctx stdctx.Context,
+	req *nodepb.AggregateRawRequest,
+) (*nodepb.AggregateRawResponse, error) {
+	result, err := s.node.AggregateRaw(s.thriftContext(ctx),
+		convert.ToRPCAggregateRawRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoAggregateRawResult(result), nil
+}
+
+func (s *service) FetchBlocksRaw(
+	ctx stdctx.Context,
+	req *nodepb.FetchBlocksRawRequest,
+) (*nodepb.FetchBlocksRawResponse, error) {
+	result, err := s.node.FetchBlocksRaw(s.thriftContext(ctx),
+		convert.ToRPCFetchBlocksRawRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoFetchBlocksRawResult(result), nil
+}
+
+func (s *service) Query(
+	ctx stdctx.Context,
+	req *nodepb.QueryRequest,
+) (*nodepb.QueryResponse, error) {
+	result, err := s.node.Query(s.thriftContext(ctx), convert.ToRPCQueryRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoQueryResult(result), nil
+}
+
+func (s *service) Aggregate(
+	ctx stdctx.Context,
+	req *nodepb.AggregateRequest,
+) (*nodepb.AggregateResponse, error) {
+	result, err := s.node.Aggregate(s.thriftContext(ctx),
+		convert.ToRPCAggregateRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoAggregateResult(result), nil
+}
+
+func (s *service) Fetch(
+	ctx stdctx.Context,
+	req *nodepb.FetchRequest,
+) (*nodepb.FetchResponse, error) {
+	result, err := s.node.Fetch(s.thriftContext(ctx), convert.ToRPCFetchRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoFetchResult(result), nil
+}
+
+func (s *service) Write(
+	ctx stdctx.Context,
+	req *nodepb.WriteRequest,
+) (*nodepb.WriteResponse, error) {
+	err := s.node.Write(s.thriftContext(ctx), convert.ToRPCWriteRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return &nodepb.WriteResponse{}, nil
+}
+
+func (s *service) WriteTagged(
+	ctx stdctx.Context,
+	req *nodepb.WriteTaggedRequest,
+) (*nodepb.WriteResponse, error) {
+	err := s.node.WriteTagged(s.thriftContext(ctx), convert.ToRPCWriteTaggedRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return &nodepb.WriteResponse{}, nil
+}
+
+func (s *service) FetchBatchRaw(
+	ctx stdctx.Context,
+	req *nodepb.FetchBatchRawRequest,
+) (*nodepb.FetchBatchRawResponse, error) {
+	result, err := s.node.FetchBatchRaw(s.thriftContext(ctx),
+		convert.ToRPCFetchBatchRawRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoFetchBatchRawResult(result), nil
+}
+
+func (s *service) FetchBatchRawV2(
+	ctx stdctx.Context,
+	req *nodepb.FetchBatchRawV2Request,
+) (*nodepb.FetchBatchRawResponse, error) {
+	result, err := s.node.FetchBatchRawV2(s.thriftContext(ctx),
+		convert.ToRPCFetchBatchRawV2Request(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoFetchBatchRawResult(result), nil
+}
+
+func (s *service) FetchBlocksMetadataRawV2(
+	ctx stdctx.Context,
+	req *nodepb.FetchBlocksMetadataRawV2Request,
+) (*nodepb.FetchBlocksMetadataRawV2Response, error) {
+	result, err := s.node.FetchBlocksMetadataRawV2(s.thriftContext(ctx),
+		convert.ToRPCFetchBlocksMetadataRawV2Request(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	resp, err := convert.ToProtoFetchBlocksMetadataRawV2Result(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *service) WriteBatchRaw(
+	ctx stdctx.Context,
+	req *nodepb.WriteBatchRawRequest,
+) (*nodepb.WriteBatchRawResponse, error) {
+	err := s.node.WriteBatchRaw(s.thriftContext(ctx),
+		convert.ToRPCWriteBatchRawRequest(req))
+	resp, err := convert.ToProtoWriteBatchRawResponse(err)
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+func (s *service) WriteBatchRawV2(
+	ctx stdctx.Context,
+	req *nodepb.WriteBatchRawV2Request,
+) (*nodepb.WriteBatchRawResponse, error) {
+	err := s.node.WriteBatchRawV2(s.thriftContext(ctx),
+		convert.ToRPCWriteBatchRawV2Request(req))
+	resp, err := convert.ToProtoWriteBatchRawResponse(err)
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+func (s *service) WriteTaggedBatchRawV2(
+	ctx stdctx.Context,
+	req *nodepb.WriteTaggedBatchRawV2Request,
+) (*nodepb.WriteBatchRawResponse, error) {
+	err := s.node.WriteTaggedBatchRawV2(s.thriftContext(ctx),
+		convert.ToRPCWriteTaggedBatchRawV2Request(req))
+	resp, err := convert.ToProtoWriteBatchRawResponse(err)
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+func (s *service) Repair(
+	ctx stdctx.Context,
+	req *nodepb.RepairRequest,
+) (*nodepb.RepairResponse, error) {
+	if err := s.node.Repair(s.thriftContext(ctx)); err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return &nodepb.RepairResponse{}, nil
+}
+
+func (s *service) Truncate(
+	ctx stdctx.Context,
+	req *nodepb.TruncateRequest,
+) (*nodepb.TruncateResponse, error) {
+	result, err := s.node.Truncate(s.thriftContext(ctx), convert.ToRPCTruncateRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return &nodepb.TruncateResponse{NumSeries: result.NumSeries}, nil
+}
+
+func (s *service) DeleteTagged(
+	ctx stdctx.Context,
+	req *nodepb.DeleteTaggedRequest,
+) (*nodepb.DeleteTaggedResponse, error) {
+	result, err := s.node.DeleteTagged(s.thriftContext(ctx),
+		convert.ToRPCDeleteTaggedRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoDeleteTaggedResult(result), nil
+}
+
+func (s *service) Cardinality(
+	ctx stdctx.Context,
+	req *nodepb.CardinalityRequest,
+) (*nodepb.CardinalityResponse, error) {
+	result, err := s.node.Cardinality(s.thriftContext(ctx),
+		convert.ToRPCCardinalityRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoCardinalityResult(result), nil
+}
+
+func (s *service) WriteDocument(
+	ctx stdctx.Context,
+	req *nodepb.WriteDocumentRequest,
+) (*nodepb.WriteResponse, error) {
+	err := s.node.WriteDocument(s.thriftContext(ctx), convert.ToRPCWriteDocumentRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return &nodepb.WriteResponse{}, nil
+}
+
+func (s *service) Bootstrapped(
+	ctx stdctx.Context,
+	req *nodepb.BootstrappedRequest,
+) (*nodepb.BootstrappedResponse, error) {
+	if _, err := s.node.Bootstrapped(s.thriftContext(ctx)); err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return &nodepb.BootstrappedResponse{}, nil
+}
+
+func (s *service) BootstrappedInPlacementOrNoPlacement(
+	ctx stdctx.Context,
+	req *nodepb.BootstrappedRequest,
+) (*nodepb.BootstrappedResponse, error) {
+	if _, err := s.node.BootstrappedInPlacementOrNoPlacement(s.thriftContext(ctx)); err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return &nodepb.BootstrappedResponse{}, nil
+}
+
+func (s *service) GetPersistRateLimit(
+	ctx stdctx.Context,
+	req *nodepb.GetPersistRateLimitRequest,
+) (*nodepb.PersistRateLimitResponse, error) {
+	result, err := s.node.GetPersistRateLimit(s.thriftContext(ctx))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoPersistRateLimitResult(result), nil
+}
+
+func (s *service) SetPersistRateLimit(
+	ctx stdctx.Context,
+	req *nodepb.SetPersistRateLimitRequest,
+) (*nodepb.PersistRateLimitResponse, error) {
+	result, err := s.node.SetPersistRateLimit(s.thriftContext(ctx),
+		convert.ToRPCSetPersistRateLimitRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoPersistRateLimitResult(result), nil
+}
+
+func (s *service) GetWriteNewSeriesAsync(
+	ctx stdctx.Context,
+	req *nodepb.GetWriteNewSeriesAsyncRequest,
+) (*nodepb.WriteNewSeriesAsyncResponse, error) {
+	result, err := s.node.GetWriteNewSeriesAsync(s.thriftContext(ctx))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoWriteNewSeriesAsyncResult(result), nil
+}
+
+func (s *service) SetWriteNewSeriesAsync(
+	ctx stdctx.Context,
+	req *nodepb.SetWriteNewSeriesAsyncRequest,
+) (*nodepb.WriteNewSeriesAsyncResponse, error) {
+	rpcReq := rpc.NewNodeSetWriteNewSeriesAsyncRequest()
+	rpcReq.WriteNewSeriesAsync = req.WriteNewSeriesAsync
+	result, err := s.node.SetWriteNewSeriesAsync(s.thriftContext(ctx), rpcReq)
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoWriteNewSeriesAsyncResult(result), nil
+}
+
+func (s *service) GetWriteNewSeriesBackoffDuration(
+	ctx stdctx.Context,
+	req *nodepb.GetWriteNewSeriesBackoffDurationRequest,
+) (*nodepb.WriteNewSeriesBackoffDurationResponse, error) {
+	result, err := s.node.GetWriteNewSeriesBackoffDuration(s.thriftContext(ctx))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	resp, err := convert.ToProtoWriteNewSeriesBackoffDurationResult(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *service) SetWriteNewSeriesBackoffDuration(
+	ctx stdctx.Context,
+	req *nodepb.SetWriteNewSeriesBackoffDurationRequest,
+) (*nodepb.WriteNewSeriesBackoffDurationResponse, error) {
+	result, err := s.node.SetWriteNewSeriesBackoffDuration(s.thriftContext(ctx),
+		convert.ToRPCSetWriteNewSeriesBackoffDurationRequest(req))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	resp, err := convert.ToProtoWriteNewSeriesBackoffDurationResult(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *service) GetWriteNewSeriesLimitPerShardPerSecond(
+	ctx stdctx.Context,
+	req *nodepb.GetWriteNewSeriesLimitPerShardPerSecondRequest,
+) (*nodepb.WriteNewSeriesLimitPerShardPerSecondResponse, error) {
+	result, err := s.node.GetWriteNewSeriesLimitPerShardPerSecond(s.thriftContext(ctx))
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoWriteNewSeriesLimitPerShardPerSecondResult(result), nil
+}
+
+func (s *service) SetWriteNewSeriesLimitPerShardPerSecond(
+	ctx stdctx.Context,
+	req *nodepb.SetWriteNewSeriesLimitPerShardPerSecondRequest,
+) (*nodepb.WriteNewSeriesLimitPerShardPerSecondResponse, error) {
+	rpcReq := rpc.NewNodeSetWriteNewSeriesLimitPerShardPerSecondRequest()
+	rpcReq.WriteNewSeriesLimitPerShardPerSecond = req.WriteNewSeriesLimitPerShardPerSecond
+	result, err := s.node.SetWriteNewSeriesLimitPerShardPerSecond(s.thriftContext(ctx), rpcReq)
+	if err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return convert.ToProtoWriteNewSeriesLimitPerShardPerSecondResult(result), nil
+}
+
+func (s *service) DebugIndexMemorySegments(
+	ctx stdctx.Context,
+	req *nodepb.DebugIndexMemorySegmentsRequest,
+) (*nodepb.DebugIndexMemorySegmentsResponse, error) {
+	rpcReq := rpc.NewDebugIndexMemorySegmentsRequest()
+	rpcReq.Directory = req.Directory
+	if _, err := s.node.DebugIndexMemorySegments(s.thriftContext(ctx), rpcReq); err != nil {
+		return nil, convert.ToStatusError(err)
+	}
+	return &nodepb.DebugIndexMemorySegmentsResponse{}, nil
+}
+
+// thriftContext returns a Thrift context carrying a new M3DB context that is
+// closed by the stats handler when the request completes.
+func (s *service) thriftContext(ctx stdctx.Context) thrift.Context {
+	xCtx := s.contextPool.Get()
+	xCtx.SetGoContext(ctx)
+	if closer, ok := ctx.Value(contextCloserKey{}).(*contextCloser); ok {
+		closer.ctx = xCtx
+	}
+	return tchannelthrift.NewContextWithXContext(ctx, xCtx)
+}
+
+// contextStatsHandler closes the M3DB context of each request after the
+// response has been sent.
+type contextStatsHandler struct{}
+
+func (contextStatsHandler) TagRPC(ctx stdctx.Context, _ *stats.RPCTagInfo) stdctx.Context {
+	return stdctx.WithValue(ctx, contextCloserKey{}, &contextCloser{})
+}
+
+func (contextStatsHandler) HandleRPC(ctx stdctx.Context, s stats.RPCStats) {
+	if _, ok := s.(*stats.End); !ok {
+		return
+	}
+	closer, ok := ctx.Value(contextCloserKey{}).(*contextCloser)
+	if !ok || closer.ctx == nil {
+		return
+	}
+	closer.ctx.Close()
+	closer.ctx = nil
+}
+
+func (contextStatsHandler) TagConn(ctx stdctx.Context, _ *stats.ConnTagInfo) stdctx.Context {
+	return ctx
+}
+
+func (contextStatsHandler) HandleConn(stdctx.Context, stats.ConnStats) {}
@@ -0,0 +1,217 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	stdctx "context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
+	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/resource"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go/thrift"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestClient(t *testing.T, node rpc.TChanNode) (nodepb.NodeClient, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(grpc.StatsHandler(contextStatsHandler{}))
+	nodepb.RegisterNodeServer(server,
+		newService(node, context.NewPool(context.NewOptions())))
+	go func() {
+		server.Serve(listener)
+	}()
+
+	cc, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+
+	return nodepb.NewNodeClient(cc), func() {
+		cc.Close()
+		server.Stop()
+	}
+}
+
+func TestServiceFetchTaggedClosesContextAfterResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node := rpc.NewMockTChanNode(ctrl)
+	client, closeFn := newTestClient(t, node)
+	defer closeFn()
+
+	closed := make(chan struct{})
+	result := rpc.NewFetchTaggedResult_()
+	result.Exhaustive = true
+	result.Elements = []*rpc.FetchTaggedIDResult_{
+		{ID: []byte("foo"), NameSpace: []byte("ns"), EncodedTags: []byte("tags")},
+	}
+	node.EXPECT().
+		FetchTagged(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx thrift.Context, req *rpc.FetchTaggedRequest) (*rpc.FetchTaggedResult_, error) {
+			assert.Equal(t, []byte("ns"), req.NameSpace)
+			assert.Equal(t, int64(1), req.RangeStart)
+			assert.Equal(t, int64(2), req.RangeEnd)
+			assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, req.RangeTimeType)
+			tchannelthrift.Context(ctx).RegisterFinalizer(resource.FinalizerFn(func() {
+				close(closed)
+			}))
+			return result, nil
+		})
+
+	resp, err := client.FetchTagged(stdctx.Background(), &nodepb.FetchTaggedRequest{
+		NameSpace:  []byte("ns"),
+		RangeStart: 1,
+		RangeEnd:   2,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Exhaustive)
+	require.Len(t, resp.Elements, 1)
+	assert.Equal(t, []byte("foo"), resp.Elements[0].Id)
+
+	select {
+	case <-closed:
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "context not closed")
+	}
+}
+
+func TestServiceWriteTaggedBatchRawReturnsBatchErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node := rpc.NewMockTChanNode(ctrl)
+	client, closeFn := newTestClient(t, node)
+	defer closeFn()
+
+	batchErrs := rpc.NewWriteBatchRawErrors()
+	batchErrs.Errors = []*rpc.WriteBatchRawError{
+		tterrors.NewBadRequestWriteBatchRawError(1, errors.New("bad")),
+	}
+	node.EXPECT().
+		WriteTaggedBatchRaw(gomock.Any(), gomock.Any()).
+		Return(batchErrs)
+
+	resp, err := client.WriteTaggedBatchRaw(stdctx.Background(), &nodepb.WriteTaggedBatchRawRequest{
+		NameSpace: []byte("ns"),
+		Elements: []*nodepb.WriteTaggedBatchRawRequestElement{
+			{Id: []byte("foo"), Datapoint: &nodepb.Datapoint{Timestamp: 1, Value: 2}},
+			{Id: []byte("bar"), Datapoint: &nodepb.Datapoint{Timestamp: 1, Value: 2}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, int64(1), resp.Errors[0].Index)
+	assert.Equal(t, nodepb.ErrorType_BAD_REQUEST, resp.Errors[0].Err.Type)
+}
+
+func TestServiceHealthReturnsStatusError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node := rpc.NewMockTChanNode(ctrl)
+	client, closeFn := newTestClient(t, node)
+	defer closeFn()
+
+	node.EXPECT().
+		Health(gomock.Any()).
+		Return(nil, tterrors.NewResourceExhaustedError(errors.New("exhausted")))
+
+	_, err := client.Health(stdctx.Background(), &nodepb.HealthRequest{})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestServiceWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node := rpc.NewMockTChanNode(ctrl)
+	client, closeFn := newTestClient(t, node)
+	defer closeFn()
+
+	node.EXPECT().
+		Write(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx thrift.Context, req *rpc.WriteRequest) error {
+			assert.Equal(t, "ns", req.NameSpace)
+			assert.Equal(t, "foo", req.ID)
+			assert.Equal(t, int64(1), req.Datapoint.Timestamp)
+			assert.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, req.Datapoint.TimestampTimeType)
+			assert.Equal(t, float64(2), req.Datapoint.Value)
+			return nil
+		})
+
+	_, err := client.Write(stdctx.Background(), &nodepb.WriteRequest{
+		NameSpace: "ns",
+		Id:        "foo",
+		Datapoint: &nodepb.Datapoint{Timestamp: 1, Value: 2},
+	})
+	require.NoError(t, err)
+}
+
+func TestServiceFetchBlocksMetadataRawV2WithoutLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node := rpc.NewMockTChanNode(ctrl)
+	client, closeFn := newTestClient(t, node)
+	defer closeFn()
+
+	// The node returns no result for requests without a limit.
+	node.EXPECT().
+		FetchBlocksMetadataRawV2(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+
+	resp, err := client.FetchBlocksMetadataRawV2(stdctx.Background(),
+		&nodepb.FetchBlocksMetadataRawV2Request{NameSpace: []byte("ns")})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Elements)
+}
+
+func TestServiceBootstrappedReturnsStatusError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	node := rpc.NewMockTChanNode(ctrl)
+	client, closeFn := newTestClient(t, node)
+	defer closeFn()
+
+	node.EXPECT().
+		Bootstrapped(gomock.Any()).
+		Return(nil, tterrors.NewInternalError(errors.New("not bootstrapped")))
+
+	_, err := client.Bootstrapped(stdctx.Background(), &nodepb.BootstrappedRequest{})
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
@@ -54,6 +54,13 @@ func NewContext(timeout time.Duration) (thrift.Context, xnetcontext.CancelFunc)
 	return thrift.WithHeaders(ctxWithValue, nil), cancel
 }
 
+// NewContextWithXContext returns a thrift context derived from the given
+// context with the given M3DB context embedded
+func NewContextWithXContext(ctx xnetcontext.Context, xCtx context.Context) thrift.Context {
+	ctxWithValue := xnetcontext.WithValue(ctx, contextKey, xCtx)
+	return thrift.WithHeaders(ctxWithValue, nil)
+}
+
 // Context returns an M3DB context from the thrift context
 func Context(ctx thrift.Context) context.Context {
 	return ctx.Value(contextKey).(context.Context)
@@ -27,14 +27,19 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3/src/dbnode/ratelimit"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	idxconvert "github.com/m3db/m3/src/dbnode/storage/index/convert"
 	"github.com/m3db/m3/src/dbnode/x/xio"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/generated/proto/querypb"
 	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/x/checked"
 	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/hll"
 	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/serialize"
 	xtime "github.com/m3db/m3/src/x/time"
 )
 
@@ -43,6 +48,8 @@ var (
 	errUnknownUnit      = errors.New("unknown unit")
 	errNilTaggedRequest = errors.New("nil write tagged request")
 
+	errUnableToEncodeDocument = errors.New("unable to encode document fields")
+
 	timeZero time.Time
 )
 
@@ -189,6 +196,9 @@ func ToRPCError(err error) *rpc.Error {
 	if err == nil {
 		return nil
 	}
+	if ratelimit.IsQuotaExceededError(err) {
+		return tterrors.NewResourceExhaustedError(err)
+	}
 	if xerrors.IsInvalidParams(err) {
 		return tterrors.NewBadRequestError(err)
 	}
@@ -225,6 +235,16 @@ func FromRPCFetchTaggedRequest(
 	if l := req.Limit; l != nil {
 		opts.Limit = int(*l)
 	}
+	if req.IsSetPageToken() {
+		var cursor index.QueryCursor
+		if err := cursor.UnmarshalBinary(req.PageToken); err != nil {
+			return nil, index.Query{}, index.QueryOptions{}, false, err
+		}
+		opts.Cursor = &cursor
+	}
+	if req.GetExplain() {
+		opts.Explain = &index.QueryExplanation{}
+	}
 
 	q, err := idx.Unmarshal(req.Query)
 	if err != nil {
@@ -276,9 +296,279 @@ func ToRPCFetchTaggedRequest(
 		request.Limit = &l
 	}
 
+	if opts.Cursor != nil {
+		pageToken, err := opts.Cursor.MarshalBinary()
+		if err != nil {
+			return rpc.FetchTaggedRequest{}, err
+		}
+		request.PageToken = pageToken
+	}
+
+	if opts.Explain != nil {
+		explain := true
+		request.Explain = &explain
+	}
+
 	return request, nil
 }
 
+// FromRPCDeleteTaggedRequest converts the rpc request type for DeleteTaggedRequest into corresponding Go API types.
+func FromRPCDeleteTaggedRequest(
+	req *rpc.DeleteTaggedRequest, pools FetchTaggedConversionPools,
+) (ident.ID, index.Query, time.Time, time.Time, error) {
+	start, rangeStartErr := ToTime(req.RangeStart, req.RangeTimeType)
+	if rangeStartErr != nil {
+		return nil, index.Query{}, time.Time{}, time.Time{}, rangeStartErr
+	}
+
+	end, rangeEndErr := ToTime(req.RangeEnd, req.RangeTimeType)
+	if rangeEndErr != nil {
+		return nil, index.Query{}, time.Time{}, time.Time{}, rangeEndErr
+	}
+
+	q, err := idx.Unmarshal(req.Query)
+	if err != nil {
+		return nil, index.Query{}, time.Time{}, time.Time{}, err
+	}
+
+	var ns ident.ID
+	if pools != nil {
+		nsBytes := pools.CheckedBytesWrapper().Get(req.NameSpace)
+		ns = pools.ID().BinaryID(nsBytes)
+	} else {
+		ns = ident.StringID(string(req.NameSpace))
+	}
+	return ns, index.Query{Query: q}, start, end, nil
+}
+
+// ToRPCDeleteTaggedRequest converts the Go `client/` types into rpc request type for DeleteTaggedRequest.
+func ToRPCDeleteTaggedRequest(
+	ns ident.ID,
+	q index.Query,
+	start, end time.Time,
+) (rpc.DeleteTaggedRequest, error) {
+	rangeStart, tsErr := ToValue(start, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.DeleteTaggedRequest{}, tsErr
+	}
+
+	rangeEnd, tsErr := ToValue(end, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.DeleteTaggedRequest{}, tsErr
+	}
+
+	query, queryErr := idx.Marshal(q.Query)
+	if queryErr != nil {
+		return rpc.DeleteTaggedRequest{}, queryErr
+	}
+
+	return rpc.DeleteTaggedRequest{
+		NameSpace:     ns.Bytes(),
+		Query:         query,
+		RangeStart:    rangeStart,
+		RangeEnd:      rangeEnd,
+		RangeTimeType: fetchTaggedTimeType,
+	}, nil
+}
+
+// FromRPCWriteDocumentRequest converts the rpc request type for WriteDocumentRequest into corresponding Go API types.
+func FromRPCWriteDocumentRequest(
+	req *rpc.WriteDocumentRequest,
+	decoder serialize.TagDecoder,
+) (ident.ID, doc.Document, time.Time, error) {
+	timestamp, err := ToTime(req.Timestamp, req.TimestampTimeType)
+	if err != nil {
+		return nil, doc.Document{}, time.Time{}, err
+	}
+
+	decoder.Reset(checked.NewBytes(req.EncodedTags, nil))
+	if err := decoder.Err(); err != nil {
+		return nil, doc.Document{}, time.Time{}, err
+	}
+
+	d, err := idxconvert.FromMetricIter(ident.BytesID(req.ID), decoder)
+	if err != nil {
+		return nil, doc.Document{}, time.Time{}, err
+	}
+
+	return ident.BytesID(req.NameSpace), d, timestamp, nil
+}
+
+// ToRPCWriteDocumentRequest converts the Go `client/` types into rpc request type for WriteDocumentRequest.
+func ToRPCWriteDocumentRequest(
+	ns ident.ID,
+	d doc.Document,
+	timestamp time.Time,
+	encoder serialize.TagEncoder,
+) (rpc.WriteDocumentRequest, error) {
+	value, err := ToValue(timestamp, fetchTaggedTimeType)
+	if err != nil {
+		return rpc.WriteDocumentRequest{}, err
+	}
+
+	tags := make([]ident.Tag, 0, len(d.Fields))
+	for _, f := range d.Fields {
+		tags = append(tags, ident.Tag{
+			Name:  ident.BytesID(f.Name),
+			Value: ident.BytesID(f.Value),
+		})
+	}
+	if err := encoder.Encode(ident.NewTagsIterator(ident.NewTags(tags...))); err != nil {
+		return rpc.WriteDocumentRequest{}, err
+	}
+	encoded, ok := encoder.Data()
+	if !ok {
+		return rpc.WriteDocumentRequest{}, errUnableToEncodeDocument
+	}
+
+	return rpc.WriteDocumentRequest{
+		NameSpace:         ns.Bytes(),
+		ID:                d.ID,
+		EncodedTags:       append([]byte(nil), encoded.Bytes()...),
+		Timestamp:         value,
+		TimestampTimeType: fetchTaggedTimeType,
+	}, nil
+}
+
+// FromRPCCardinalityRequest converts the rpc request type for CardinalityRequest into corresponding Go API types.
+func FromRPCCardinalityRequest(
+	req *rpc.CardinalityRequest,
+) (ident.ID, index.CardinalityOptions, error) {
+	start, rangeStartErr := ToTime(req.RangeStart, req.RangeTimeType)
+	if rangeStartErr != nil {
+		return nil, index.CardinalityOptions{}, rangeStartErr
+	}
+
+	end, rangeEndErr := ToTime(req.RangeEnd, req.RangeTimeType)
+	if rangeEndErr != nil {
+		return nil, index.CardinalityOptions{}, rangeEndErr
+	}
+
+	opts := index.CardinalityOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+	}
+	if l := req.FieldsLimit; l != nil {
+		opts.FieldsLimit = int(*l)
+	}
+	if l := req.ValuesLimit; l != nil {
+		opts.ValuesLimit = int(*l)
+	}
+
+	return ident.StringID(string(req.NameSpace)), opts, nil
+}
+
+// ToRPCCardinalityRequest converts the Go `client/` types into rpc request type for CardinalityRequest.
+func ToRPCCardinalityRequest(
+	ns ident.ID,
+	opts index.CardinalityOptions,
+) (rpc.CardinalityRequest, error) {
+	rangeStart, tsErr := ToValue(opts.StartInclusive, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.CardinalityRequest{}, tsErr
+	}
+
+	rangeEnd, tsErr := ToValue(opts.EndExclusive, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.CardinalityRequest{}, tsErr
+	}
+
+	req := rpc.CardinalityRequest{
+		NameSpace:     ns.Bytes(),
+		RangeStart:    rangeStart,
+		RangeEnd:      rangeEnd,
+		RangeTimeType: fetchTaggedTimeType,
+	}
+	if opts.FieldsLimit > 0 {
+		l := int64(opts.FieldsLimit)
+		req.FieldsLimit = &l
+	}
+	if opts.ValuesLimit > 0 {
+		l := int64(opts.ValuesLimit)
+		req.ValuesLimit = &l
+	}
+	return req, nil
+}
+
+// ToRPCCardinalityResult converts the Go cardinality result into the rpc type.
+func ToRPCCardinalityResult(
+	result index.CardinalityResult,
+) (*rpc.CardinalityResult_, error) {
+	res := rpc.NewCardinalityResult_()
+	res.Fields = make([]*rpc.CardinalityField, 0, len(result.Fields))
+	for _, field := range result.Fields {
+		seriesSketch, err := field.Series.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		valuesSketch, err := field.Values.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]*rpc.CardinalityValue, 0, len(field.TopValues))
+		for _, value := range field.TopValues {
+			sketch, err := value.Series.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, &rpc.CardinalityValue{
+				Value:          value.Value,
+				SeriesEstimate: int64(value.Series.Estimate()),
+				SeriesSketch:   sketch,
+			})
+		}
+
+		res.Fields = append(res.Fields, &rpc.CardinalityField{
+			Name:           field.Field,
+			SeriesEstimate: int64(field.Series.Estimate()),
+			SeriesSketch:   seriesSketch,
+			ValuesEstimate: int64(field.Values.Estimate()),
+			ValuesSketch:   valuesSketch,
+			TopValues:      values,
+		})
+	}
+	return res, nil
+}
+
+// FromRPCCardinalityResult converts the rpc cardinality result into the Go type.
+func FromRPCCardinalityResult(
+	res *rpc.CardinalityResult_,
+) (index.CardinalityResult, error) {
+	result := index.CardinalityResult{
+		Fields: make([]index.FieldCardinality, 0, len(res.Fields)),
+	}
+	for _, field := range res.Fields {
+		var series, values hll.Sketch
+		if err := series.UnmarshalBinary(field.SeriesSketch); err != nil {
+			return index.CardinalityResult{}, err
+		}
+		if err := values.UnmarshalBinary(field.ValuesSketch); err != nil {
+			return index.CardinalityResult{}, err
+		}
+
+		topValues := make([]index.ValueCardinality, 0, len(field.TopValues))
+		for _, value := range field.TopValues {
+			var sketch hll.Sketch
+			if err := sketch.UnmarshalBinary(value.SeriesSketch); err != nil {
+				return index.CardinalityResult{}, err
+			}
+			topValues = append(topValues, index.ValueCardinality{
+				Value:  value.Value,
+				Series: &sketch,
+			})
+		}
+
+		result.Fields = append(result.Fields, index.FieldCardinality{
+			Field:     field.Name,
+			Series:    &series,
+			Values:    &values,
+			TopValues: topValues,
+		})
+	}
+	return result, nil
+}
+
 // FromRPCAggregateQueryRequest converts the rpc request type for AggregateRawQueryRequest into corresponding Go API types.
 func FromRPCAggregateQueryRequest(
 	req *rpc.AggregateQueryRequest,
@@ -29,9 +29,11 @@ import (
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
+	"github.com/m3db/m3/src/x/serialize"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
@@ -165,6 +167,186 @@ func TestConvertFetchTaggedRequest(t *testing.T) {
 	}
 }
 
+func TestConvertFetchTaggedRequestPageToken(t *testing.T) {
+	ns := ident.StringID("abc")
+	q, _ := conjunctionQueryATestCase(t)
+	opts := index.QueryOptions{
+		StartInclusive: time.Now().Add(-900 * time.Hour),
+		EndExclusive:   time.Now(),
+		Limit:          10,
+		Cursor:         &index.QueryCursor{After: []byte("foo")},
+	}
+
+	req, err := convert.ToRPCFetchTaggedRequest(ns, index.Query{Query: q}, opts, true)
+	require.NoError(t, err)
+	require.True(t, req.IsSetPageToken())
+
+	_, _, observedOpts, _, err := convert.FromRPCFetchTaggedRequest(&req, nil)
+	require.NoError(t, err)
+	require.NotNil(t, observedOpts.Cursor)
+	require.Equal(t, opts.Cursor, observedOpts.Cursor)
+
+	req.PageToken = []byte("invalid")
+	_, _, _, _, err = convert.FromRPCFetchTaggedRequest(&req, nil)
+	require.Error(t, err)
+}
+
+func TestConvertFetchTaggedRequestExplain(t *testing.T) {
+	ns := ident.StringID("abc")
+	q, _ := conjunctionQueryATestCase(t)
+	opts := index.QueryOptions{
+		StartInclusive: time.Now().Add(-900 * time.Hour),
+		EndExclusive:   time.Now(),
+		Explain:        &index.QueryExplanation{},
+	}
+
+	req, err := convert.ToRPCFetchTaggedRequest(ns, index.Query{Query: q}, opts, true)
+	require.NoError(t, err)
+	require.True(t, req.GetExplain())
+
+	_, _, observedOpts, _, err := convert.FromRPCFetchTaggedRequest(&req, nil)
+	require.NoError(t, err)
+	require.NotNil(t, observedOpts.Explain)
+
+	opts.Explain = nil
+	req, err = convert.ToRPCFetchTaggedRequest(ns, index.Query{Query: q}, opts, true)
+	require.NoError(t, err)
+	require.False(t, req.IsSetExplain())
+}
+
+func TestConvertDeleteTaggedRequest(t *testing.T) {
+	var (
+		ns    = ident.StringID("abc")
+		start = time.Now().Add(-900 * time.Hour)
+		end   = time.Now()
+	)
+	q, rpcQ := conjunctionQueryATestCase(t)
+
+	for _, pools := range []struct {
+		name string
+		pool convert.FetchTaggedConversionPools
+	}{
+		{"nil pools", nil},
+		{"valid pools", newTestPools()},
+	} {
+		t.Run(pools.name, func(t *testing.T) {
+			req, err := convert.ToRPCDeleteTaggedRequest(ns, index.Query{Query: q}, start, end)
+			require.NoError(t, err)
+			require.Equal(t, rpc.DeleteTaggedRequest{
+				NameSpace:     ns.Bytes(),
+				Query:         rpcQ,
+				RangeStart:    mustToRpcTime(t, start),
+				RangeEnd:      mustToRpcTime(t, end),
+				RangeTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+			}, req)
+
+			id, observedQuery, observedStart, observedEnd, err := convert.FromRPCDeleteTaggedRequest(&req, pools.pool)
+			require.NoError(t, err)
+			require.Equal(t, ns.String(), id.String())
+			require.True(t, index.NewQueryMatcher(index.Query{Query: q}).Matches(observedQuery))
+			require.True(t, start.Equal(observedStart))
+			require.True(t, end.Equal(observedEnd))
+		})
+	}
+}
+
+func TestConvertWriteDocumentRequest(t *testing.T) {
+	var (
+		ns        = ident.StringID("abc")
+		timestamp = time.Now()
+		d         = doc.Document{
+			ID: []byte("foo"),
+			Fields: []doc.Field{
+				{Name: []byte("bar"), Value: []byte("baz")},
+				{Name: []byte("qux"), Value: []byte("quz")},
+			},
+		}
+		encoder = serialize.NewTagEncoderPool(serialize.NewTagEncoderOptions(),
+			pool.NewObjectPoolOptions().SetSize(1))
+		decoder = serialize.NewTagDecoderPool(serialize.NewTagDecoderOptions(serialize.TagDecoderOptionsConfig{}),
+			pool.NewObjectPoolOptions().SetSize(1))
+	)
+	encoder.Init()
+	decoder.Init()
+
+	req, err := convert.ToRPCWriteDocumentRequest(ns, d, timestamp, encoder.Get())
+	require.NoError(t, err)
+	require.Equal(t, ns.Bytes(), req.NameSpace)
+	require.Equal(t, d.ID, req.ID)
+	require.Equal(t, mustToRpcTime(t, timestamp), req.Timestamp)
+	require.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, req.TimestampTimeType)
+
+	id, observedDoc, observedTimestamp, err := convert.FromRPCWriteDocumentRequest(&req, decoder.Get())
+	require.NoError(t, err)
+	require.Equal(t, ns.String(), id.String())
+	require.Equal(t, d, observedDoc)
+	require.True(t, timestamp.Equal(observedTimestamp))
+}
+
+func TestConvertCardinalityRequest(t *testing.T) {
+	var (
+		ns    = ident.StringID("abc")
+		start = time.Now().Add(-900 * time.Hour)
+		end   = time.Now()
+		opts  = index.CardinalityOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+			FieldsLimit:    10,
+			ValuesLimit:    5,
+		}
+	)
+
+	req, err := convert.ToRPCCardinalityRequest(ns, opts)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), req.GetFieldsLimit())
+	require.Equal(t, int64(5), req.GetValuesLimit())
+
+	id, observed, err := convert.FromRPCCardinalityRequest(&req)
+	require.NoError(t, err)
+	require.Equal(t, ns.String(), id.String())
+	require.True(t, start.Equal(observed.StartInclusive))
+	require.True(t, end.Equal(observed.EndExclusive))
+	require.Equal(t, opts.FieldsLimit, observed.FieldsLimit)
+	require.Equal(t, opts.ValuesLimit, observed.ValuesLimit)
+}
+
+func TestConvertCardinalityResult(t *testing.T) {
+	tracker := index.NewCardinalityTracker()
+	for i := 0; i < 10; i++ {
+		tracker.Add(doc.Document{
+			ID: []byte(fmt.Sprintf("series-%d", i)),
+			Fields: []doc.Field{
+				{Name: []byte("city"), Value: []byte(fmt.Sprintf("city-%d", i%3))},
+			},
+		})
+	}
+	result := tracker.Result(index.CardinalityOptions{})
+
+	res, err := convert.ToRPCCardinalityResult(result)
+	require.NoError(t, err)
+	require.Len(t, res.Fields, 1)
+	require.Equal(t, "city", string(res.Fields[0].Name))
+	require.Equal(t, int64(10), res.Fields[0].SeriesEstimate)
+	require.Equal(t, int64(3), res.Fields[0].ValuesEstimate)
+	require.Len(t, res.Fields[0].TopValues, 3)
+	require.Equal(t, "city-0", string(res.Fields[0].TopValues[0].Value))
+	require.Equal(t, int64(4), res.Fields[0].TopValues[0].SeriesEstimate)
+
+	observed, err := convert.FromRPCCardinalityResult(res)
+	require.NoError(t, err)
+	require.Len(t, observed.Fields, 1)
+	field := observed.Fields[0]
+	require.Equal(t, "city", string(field.Field))
+	require.Equal(t, uint64(10), field.Series.Estimate())
+	require.Equal(t, uint64(3), field.Values.Estimate())
+	require.Len(t, field.TopValues, 3)
+	for i, value := range field.TopValues {
+		expected := result.Fields[0].TopValues[i]
+		require.Equal(t, expected.Value, value.Value)
+		require.Equal(t, expected.Series.Estimate(), value.Series.Estimate())
+	}
+}
+
 func TestConvertAggregateRawQueryRequest(t *testing.T) {
 	ns := ident.StringID("abc")
 	opts := index.AggregationOptions{
@@ -21,6 +21,7 @@
 package node
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -33,6 +34,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
 	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3/src/dbnode/ratelimit"
 	"github.com/m3db/m3/src/dbnode/storage"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/index"
@@ -105,10 +107,13 @@ type serviceMetrics struct {
 	aggregate               instrument.MethodMetrics
 	write                   instrument.MethodMetrics
 	writeTagged             instrument.MethodMetrics
+	writeDocument           instrument.MethodMetrics
 	fetchBlocks             instrument.MethodMetrics
 	fetchBlocksMetadata     instrument.MethodMetrics
 	repair                  instrument.MethodMetrics
 	truncate                instrument.MethodMetrics
+	deleteTagged            instrument.MethodMetrics
+	cardinality             instrument.MethodMetrics
 	fetchBatchRawRPCS       tally.Counter
 	fetchBatchRaw           instrument.BatchMethodMetrics
 	writeBatchRawRPCs       tally.Counter
@@ -125,10 +130,13 @@ func newServiceMetrics(scope tally.Scope, opts instrument.TimerOptions) serviceM
 		aggregate:               instrument.NewMethodMetrics(scope, "aggregate", opts),
 		write:                   instrument.NewMethodMetrics(scope, "write", opts),
 		writeTagged:             instrument.NewMethodMetrics(scope, "writeTagged", opts),
+		writeDocument:           instrument.NewMethodMetrics(scope, "writeDocument", opts),
 		fetchBlocks:             instrument.NewMethodMetrics(scope, "fetchBlocks", opts),
 		fetchBlocksMetadata:     instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", opts),
 		repair:                  instrument.NewMethodMetrics(scope, "repair", opts),
 		truncate:                instrument.NewMethodMetrics(scope, "truncate", opts),
+		deleteTagged:            instrument.NewMethodMetrics(scope, "deleteTagged", opts),
+		cardinality:             instrument.NewMethodMetrics(scope, "cardinality", opts),
 		fetchBatchRawRPCS:       scope.Counter("fetchBatchRaw-rpcs"),
 		fetchBatchRaw:           instrument.NewBatchMethodMetrics(scope, "fetchBatchRaw", opts),
 		writeBatchRawRPCs:       scope.Counter("writeBatchRaw-rpcs"),
@@ -629,6 +637,20 @@ func (s *service) fetchTagged(ctx context.Context, db storage.Database, req *rpc
 		Exhaustive: queryResult.Exhaustive,
 		Elements:   make([]*rpc.FetchTaggedIDResult_, 0, results.Size()),
 	}
+	if next := queryResult.NextCursor; next != nil {
+		response.NextPageToken, err = next.MarshalBinary()
+		if err != nil { // This is an invariant, should never happen
+			s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+			return nil, tterrors.NewInternalError(err)
+		}
+	}
+	if opts.Explain != nil {
+		response.Explain, err = json.Marshal(opts.Explain)
+		if err != nil { // This is an invariant, should never happen
+			s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+			return nil, tterrors.NewInternalError(err)
+		}
+	}
 	nsID := results.Namespace()
 	nsIDBytes := nsID.Bytes()
 
@@ -1318,6 +1340,35 @@ func (s *service) WriteTagged(tctx thrift.Context, req *rpc.WriteTaggedRequest)
 	return nil
 }
 
+func (s *service) WriteDocument(tctx thrift.Context, req *rpc.WriteDocumentRequest) error {
+	db, err := s.startWriteRPCWithDB()
+	if err != nil {
+		return err
+	}
+	defer s.writeRPCCompleted()
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	decoder := s.pools.tagDecoder.Get()
+	ctx.RegisterCloser(decoder)
+
+	ns, d, timestamp, err := convert.FromRPCWriteDocumentRequest(req, decoder)
+	if err != nil {
+		s.metrics.writeDocument.ReportError(s.nowFn().Sub(callStart))
+		return tterrors.NewBadRequestError(err)
+	}
+
+	if err = db.WriteDocument(ctx, ns, d, timestamp); err != nil {
+		s.metrics.writeDocument.ReportError(s.nowFn().Sub(callStart))
+		return convert.ToRPCError(err)
+	}
+
+	s.metrics.writeDocument.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return nil
+}
+
 func (s *service) WriteBatchRaw(tctx thrift.Context, req *rpc.WriteBatchRawRequest) error {
 	s.metrics.writeBatchRawRPCs.Inc(1)
 	db, err := s.startWriteRPCWithDB()
@@ -1779,6 +1830,76 @@ func (s *service) Truncate(tctx thrift.Context, req *rpc.TruncateRequest) (r *rp
 	return res, nil
 }
 
+func (s *service) DeleteTagged(tctx thrift.Context, req *rpc.DeleteTaggedRequest) (*rpc.DeleteTaggedResult_, error) {
+	db, err := s.startRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	ns, query, start, end, err := convert.FromRPCDeleteTaggedRequest(req, s.pools)
+	if err != nil {
+		s.metrics.deleteTagged.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	deleted, err := db.DeleteTagged(ctx, ns, query, start, end)
+	if err != nil {
+		s.metrics.deleteTagged.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	res := rpc.NewDeleteTaggedResult_()
+	res.NumSeries = deleted.NumSeries
+	res.NumSeriesByShard = make(map[int32]int64, len(deleted.NumSeriesByShard))
+	for shard, numSeries := range deleted.NumSeriesByShard {
+		res.NumSeriesByShard[int32(shard)] = numSeries
+	}
+
+	s.metrics.deleteTagged.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return res, nil
+}
+
+func (s *service) Cardinality(tctx thrift.Context, req *rpc.CardinalityRequest) (*rpc.CardinalityResult_, error) {
+	db, err := s.startReadRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+	defer s.readRPCCompleted()
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	ns, opts, err := convert.FromRPCCardinalityRequest(req)
+	if err != nil {
+		s.metrics.cardinality.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	result, err := db.Cardinality(ctx, ns, opts)
+	if err == index.ErrCardinalityTrackingDisabled {
+		s.metrics.cardinality.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+	if err != nil {
+		s.metrics.cardinality.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	res, err := convert.ToRPCCardinalityResult(result)
+	if err != nil {
+		s.metrics.cardinality.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	s.metrics.cardinality.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return res, nil
+}
+
 func (s *service) GetPersistRateLimit(
 	ctx thrift.Context,
 ) (*rpc.NodePersistRateLimitResult_, error) {
@@ -2315,6 +2436,14 @@ func (r *writeBatchPooledReq) HandleError(index int, err error) {
 		return
 	}
 
+	if ratelimit.IsQuotaExceededError(err) {
+		r.nonRetryableErrors++
+		r.errs = append(
+			r.errs,
+			tterrors.NewResourceExhaustedWriteBatchRawError(index, err))
+		return
+	}
+
 	if xerrors.IsInvalidParams(err) {
 		r.nonRetryableErrors++
 		r.errs = append(
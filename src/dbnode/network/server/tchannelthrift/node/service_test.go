@@ -23,6 +23,7 @@ package node
 import (
 	"bytes"
 	gocontext "context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -34,6 +35,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
 	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3/src/dbnode/ratelimit"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/storage"
 	"github.com/m3db/m3/src/dbnode/storage/block"
@@ -42,9 +44,12 @@ import (
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/pool"
 	"github.com/m3db/m3/src/x/serialize"
 	xtest "github.com/m3db/m3/src/x/test"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -1788,6 +1793,153 @@ func TestServiceFetchTaggedNoData(t *testing.T) {
 	}
 }
 
+func TestServiceFetchTaggedPaginated(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	start := time.Now().Add(-2 * time.Hour)
+	end := start.Add(2 * time.Hour)
+
+	start, end = start.Truncate(time.Second), end.Truncate(time.Second)
+	nsID := "metrics"
+
+	req, err := idx.NewRegexpQuery([]byte("foo"), []byte("b.*"))
+	require.NoError(t, err)
+	qry := index.Query{Query: req}
+
+	cursor := index.QueryCursor{After: []byte("bar")}
+	pageToken, err := cursor.MarshalBinary()
+	require.NoError(t, err)
+
+	resMap := index.NewQueryResults(ident.StringID(nsID),
+		index.QueryResultsOptions{}, testIndexOptions)
+	resMap.Map().Set(ident.StringID("foo"), ident.NewTagsIterator(ident.Tags{}))
+	nextCursor := &index.QueryCursor{After: []byte("foo")}
+	mockDB.EXPECT().QueryIDs(
+		ctx,
+		ident.NewIDMatcher(nsID),
+		index.NewQueryMatcher(qry),
+		index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+			Limit:          1,
+			Cursor:         &cursor,
+		}).Return(index.QueryResult{
+		Results:    resMap,
+		Exhaustive: false,
+		NextCursor: nextCursor,
+	}, nil)
+
+	startNanos, err := convert.ToValue(start, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	endNanos, err := convert.ToValue(end, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	var limit int64 = 1
+	data, err := idx.Marshal(req)
+	require.NoError(t, err)
+	r, err := service.FetchTagged(tctx, &rpc.FetchTaggedRequest{
+		NameSpace:  []byte(nsID),
+		Query:      data,
+		RangeStart: startNanos,
+		RangeEnd:   endNanos,
+		FetchData:  false,
+		Limit:      &limit,
+		PageToken:  pageToken,
+	})
+	require.NoError(t, err)
+
+	require.False(t, r.Exhaustive)
+	require.Equal(t, 1, len(r.Elements))
+	require.Equal(t, []byte("foo"), r.Elements[0].ID)
+
+	var observed index.QueryCursor
+	require.NoError(t, observed.UnmarshalBinary(r.NextPageToken))
+	require.Equal(t, *nextCursor, observed)
+}
+
+func TestServiceFetchTaggedExplain(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	start := time.Now().Add(-2 * time.Hour)
+	end := start.Add(2 * time.Hour)
+
+	start, end = start.Truncate(time.Second), end.Truncate(time.Second)
+	blockStart := time.Unix(0, start.Truncate(time.Hour).UnixNano()).UTC()
+	nsID := "metrics"
+
+	req, err := idx.NewRegexpQuery([]byte("foo"), []byte("b.*"))
+	require.NoError(t, err)
+	qry := index.Query{Query: req}
+
+	resMap := index.NewQueryResults(ident.StringID(nsID),
+		index.QueryResultsOptions{}, testIndexOptions)
+	mockDB.EXPECT().QueryIDs(
+		ctx,
+		ident.NewIDMatcher(nsID),
+		index.NewQueryMatcher(qry),
+		gomock.Any(),
+	).DoAndReturn(func(
+		_ context.Context,
+		_ ident.ID,
+		q index.Query,
+		opts index.QueryOptions,
+	) (index.QueryResult, error) {
+		require.NotNil(t, opts.Explain)
+		opts.Explain.Query = q.String()
+		opts.Explain.AddBlock(index.BlockExplanation{
+			BlockStart: blockStart,
+			Duration:   time.Millisecond,
+		})
+		return index.QueryResult{Results: resMap, Exhaustive: true}, nil
+	})
+
+	startNanos, err := convert.ToValue(start, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	endNanos, err := convert.ToValue(end, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	data, err := idx.Marshal(req)
+	require.NoError(t, err)
+	explain := true
+	r, err := service.FetchTagged(tctx, &rpc.FetchTaggedRequest{
+		NameSpace:  []byte(nsID),
+		Query:      data,
+		RangeStart: startNanos,
+		RangeEnd:   endNanos,
+		FetchData:  false,
+		Explain:    &explain,
+	})
+	require.NoError(t, err)
+	require.True(t, r.Exhaustive)
+
+	var explanation index.QueryExplanation
+	require.NoError(t, json.Unmarshal(r.Explain, &explanation))
+	require.Equal(t, qry.String(), explanation.Query)
+	require.Equal(t, []index.BlockExplanation{
+		{BlockStart: blockStart, Duration: time.Millisecond},
+	}, explanation.Blocks)
+}
+
 func TestServiceFetchTaggedErrs(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -2064,6 +2216,44 @@ func TestServiceWriteOverloaded(t *testing.T) {
 	require.Equal(t, tterrors.NewInternalError(errServerIsOverloaded), err)
 }
 
+func TestServiceWriteQuotaExceeded(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	at := time.Now().Truncate(time.Second)
+	quotaErr := ratelimit.QuotaExceededError{
+		Namespace: "metrics",
+		Resource:  ratelimit.DatapointsQuotaResource,
+		Limit:     100,
+	}
+	mockDB.EXPECT().
+		Write(ctx, ident.NewIDMatcher("metrics"), ident.NewIDMatcher("foo"), at, 42.42,
+			xtime.Second, nil).
+		Return(quotaErr)
+
+	mockDB.EXPECT().IsOverloaded().Return(false)
+	err := service.Write(tctx, &rpc.WriteRequest{
+		NameSpace: "metrics",
+		ID:        "foo",
+		Datapoint: &rpc.Datapoint{
+			Timestamp:         at.Unix(),
+			TimestampTimeType: rpc.TimeType_UNIX_SECONDS,
+			Value:             42.42,
+		},
+	})
+	require.Equal(t, tterrors.NewResourceExhaustedError(quotaErr), err)
+	require.True(t, tterrors.IsResourceExhaustedError(err.(*rpc.Error)))
+}
+
 func TestServiceWriteDatabaseNotSet(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -2845,6 +3035,146 @@ func TestServiceTruncate(t *testing.T) {
 	assert.Equal(t, truncated, r.NumSeries)
 }
 
+func TestServiceDeleteTagged(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	var (
+		nsID    = "metrics"
+		end     = time.Now().Truncate(time.Second)
+		start   = end.Add(-2 * time.Hour)
+		deleted = storage.DeleteTaggedResult{
+			NumSeries:        42,
+			NumSeriesByShard: map[uint32]int64{1: 40, 3: 2},
+		}
+	)
+
+	req, err := idx.NewRegexpQuery([]byte("foo"), []byte("b.*"))
+	require.NoError(t, err)
+	data, err := idx.Marshal(req)
+	require.NoError(t, err)
+	qry := index.Query{Query: req}
+
+	mockDB.EXPECT().DeleteTagged(gomock.Any(), ident.NewIDMatcher(nsID),
+		index.NewQueryMatcher(qry), start, end).Return(deleted, nil)
+
+	r, err := service.DeleteTagged(tctx, &rpc.DeleteTaggedRequest{
+		NameSpace:     []byte(nsID),
+		Query:         data,
+		RangeStart:    start.UnixNano(),
+		RangeEnd:      end.UnixNano(),
+		RangeTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, deleted.NumSeries, r.NumSeries)
+	assert.Equal(t, map[int32]int64{1: 40, 3: 2}, r.NumSeriesByShard)
+}
+
+func TestServiceWriteDocument(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	var (
+		nsID = "metrics"
+		at   = time.Now().Truncate(time.Second)
+		d    = doc.Document{
+			ID:     []byte("foo"),
+			Fields: []doc.Field{{Name: []byte("bar"), Value: []byte("baz")}},
+		}
+		encoderPool = serialize.NewTagEncoderPool(serialize.NewTagEncoderOptions(),
+			pool.NewObjectPoolOptions().SetSize(1))
+	)
+	encoderPool.Init()
+
+	req, err := convert.ToRPCWriteDocumentRequest(ident.StringID(nsID), d, at, encoderPool.Get())
+	require.NoError(t, err)
+
+	mockDB.EXPECT().WriteDocument(ctx, ident.NewIDMatcher(nsID), d, at).Return(nil)
+
+	require.NoError(t, service.WriteDocument(tctx, &req))
+}
+
+func TestServiceCardinality(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false).AnyTimes()
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	var (
+		nsID  = "metrics"
+		end   = time.Now().Truncate(time.Second)
+		start = end.Add(-2 * time.Hour)
+	)
+
+	tracker := index.NewCardinalityTracker()
+	tracker.Add(doc.Document{
+		ID:     []byte("foo"),
+		Fields: []doc.Field{{Name: []byte("city"), Value: []byte("nyc")}},
+	})
+
+	mockDB.EXPECT().Cardinality(gomock.Any(), ident.NewIDMatcher(nsID),
+		index.CardinalityOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+			ValuesLimit:    10,
+		}).Return(tracker.Result(index.CardinalityOptions{}), nil)
+
+	valuesLimit := int64(10)
+	r, err := service.Cardinality(tctx, &rpc.CardinalityRequest{
+		NameSpace:     []byte(nsID),
+		RangeStart:    start.UnixNano(),
+		RangeEnd:      end.UnixNano(),
+		ValuesLimit:   &valuesLimit,
+		RangeTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+	})
+	require.NoError(t, err)
+	require.Len(t, r.Fields, 1)
+	assert.Equal(t, "city", string(r.Fields[0].Name))
+	assert.Equal(t, int64(1), r.Fields[0].SeriesEstimate)
+	require.Len(t, r.Fields[0].TopValues, 1)
+	assert.Equal(t, "nyc", string(r.Fields[0].TopValues[0].Value))
+
+	mockDB.EXPECT().Cardinality(gomock.Any(), ident.NewIDMatcher(nsID), gomock.Any()).
+		Return(index.CardinalityResult{}, index.ErrCardinalityTrackingDisabled)
+
+	_, err = service.Cardinality(tctx, &rpc.CardinalityRequest{
+		NameSpace:     []byte(nsID),
+		RangeStart:    start.UnixNano(),
+		RangeEnd:      end.UnixNano(),
+		RangeTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+	})
+	require.Error(t, err)
+	assert.True(t, tterrors.IsBadRequestError(err.(*rpc.Error)))
+}
+
 func TestServiceSetPersistRateLimit(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
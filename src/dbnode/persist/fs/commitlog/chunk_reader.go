@@ -40,25 +40,64 @@ const (
 type chunkReader struct {
 	fd                 *os.File
 	buffer             *bufio.Reader
+	compression        CompressionType
 	chunkData          []byte
+	decompressedData   []byte
+	chunk              []byte
 	chunkDataRemaining int
 	charBuff           []byte
 }
 
 func newChunkReader(bufferLen int) *chunkReader {
 	return &chunkReader{
-		buffer:    bufio.NewReaderSize(nil, bufferLen),
-		chunkData: make([]byte, bufferLen),
-		charBuff:  make([]byte, 1),
+		buffer:           bufio.NewReaderSize(nil, bufferLen),
+		chunkData:        make([]byte, bufferLen),
+		decompressedData: make([]byte, 0, bufferLen),
+		charBuff:         make([]byte, 1),
 	}
 }
 
 func (r *chunkReader) reset(fd *os.File) {
 	r.fd = fd
 	r.buffer.Reset(fd)
+	r.compression = CompressionNone
+	r.chunk = nil
 	r.chunkDataRemaining = 0
 }
 
+// readFileHeader reads the file header if present, files without a file
+// header are uncompressed.
+func (r *chunkReader) readFileHeader() error {
+	magic, err := r.buffer.Peek(fileHeaderMagicLen)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if !hasFileHeader(magic) {
+		return nil
+	}
+
+	header, err := r.buffer.Peek(fileHeaderLen)
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+
+	compression, err := decodeFileHeader(header)
+	if err != nil {
+		return err
+	}
+
+	// Discard the peeked header
+	if _, err := r.buffer.Discard(fileHeaderLen); err != nil {
+		return err
+	}
+
+	r.compression = compression
+	return nil
+}
+
 func (r *chunkReader) readHeader() error {
 	header, err := r.buffer.Peek(chunkHeaderLen)
 	if err != nil {
@@ -111,8 +150,17 @@ func (r *chunkReader) readHeader() error {
 		return errCommitLogReaderChunkSizeChecksumMismatch
 	}
 
+	r.chunk, err = decompress(r.compression, r.decompressedData, r.chunkData)
+	if err != nil {
+		return err
+	}
+	if r.compression != CompressionNone {
+		// Hold onto the decompressed buffer in case it was grown.
+		r.decompressedData = r.chunk
+	}
+
 	// Set remaining data to be consumed
-	r.chunkDataRemaining = int(size)
+	r.chunkDataRemaining = len(r.chunk)
 
 	return nil
 }
@@ -124,8 +172,8 @@ func (r *chunkReader) Read(p []byte) (int, error) {
 	if r.chunkDataRemaining < size {
 		// Copy any remaining
 		if r.chunkDataRemaining > 0 {
-			chunkDataOffset := len(r.chunkData) - r.chunkDataRemaining
-			n := copy(p, r.chunkData[chunkDataOffset:])
+			chunkDataOffset := len(r.chunk) - r.chunkDataRemaining
+			n := copy(p, r.chunk[chunkDataOffset:])
 			r.chunkDataRemaining -= n
 			read += n
 		}
@@ -144,8 +192,8 @@ func (r *chunkReader) Read(p []byte) (int, error) {
 		return read, err
 	}
 
-	chunkDataOffset := len(r.chunkData) - r.chunkDataRemaining
-	n := copy(p, r.chunkData[chunkDataOffset:][:len(p)])
+	chunkDataOffset := len(r.chunk) - r.chunkDataRemaining
+	n := copy(p, r.chunk[chunkDataOffset:][:len(p)])
 	r.chunkDataRemaining -= n
 	read += n
 	return read, nil
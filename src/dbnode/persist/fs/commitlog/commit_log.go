@@ -137,6 +137,9 @@ type writerState struct {
 	// only be used when the order of operations does not matter.
 	writers     []commitLogWriter
 	activeFiles persist.CommitLogFiles
+	// The number of entries written to the primary writer since it was opened,
+	// which along with the index of the primary file is the write position.
+	primaryNumEntries int64
 }
 
 type asyncResettableWriter struct {
@@ -191,15 +194,17 @@ const (
 	flushEventType
 	activeLogsEventType
 	rotateLogsEventType
+	writePositionEventType
 )
 
 type callbackFn func(callbackResult)
 
 type callbackResult struct {
-	eventType  eventType
-	err        error
-	activeLogs activeLogsCallbackResult
-	rotateLogs rotateLogsResult
+	eventType     eventType
+	err           error
+	activeLogs    activeLogsCallbackResult
+	rotateLogs    rotateLogsResult
+	writePosition writePositionResult
 }
 
 type activeLogsCallbackResult struct {
@@ -210,6 +215,10 @@ type rotateLogsResult struct {
 	file persist.CommitLogFile
 }
 
+type writePositionResult struct {
+	position persist.CommitLogPosition
+}
+
 func (r callbackResult) activeLogsCallbackResult() (activeLogsCallbackResult, error) {
 	if r.eventType != activeLogsEventType {
 		return activeLogsCallbackResult{}, fmt.Errorf(
@@ -238,6 +247,20 @@ func (r callbackResult) rotateLogsResult() (rotateLogsResult, error) {
 	return r.rotateLogs, nil
 }
 
+func (r callbackResult) writePositionResult() (writePositionResult, error) {
+	if r.eventType != writePositionEventType {
+		return writePositionResult{}, fmt.Errorf(
+			"wrong event type: expected %d but got %d",
+			writePositionEventType, r.eventType)
+	}
+
+	if r.err != nil {
+		return writePositionResult{}, r.err
+	}
+
+	return r.writePosition, nil
+}
+
 type commitLogWrite struct {
 	eventType  eventType
 	write      writeOrWriteBatch
@@ -400,6 +423,42 @@ func (l *commitLog) RotateLogs() (persist.CommitLogFile, error) {
 	return file, nil
 }
 
+func (l *commitLog) WritePosition() (persist.CommitLogPosition, error) {
+	l.closedState.RLock()
+	defer l.closedState.RUnlock()
+
+	if l.closedState.closed {
+		return persist.CommitLogPosition{}, errCommitLogClosed
+	}
+
+	var (
+		err      error
+		position persist.CommitLogPosition
+		wg       sync.WaitGroup
+	)
+	wg.Add(1)
+
+	// NB: The position is determined by the single-threaded writer so that
+	// every write enqueued before this call is accounted for.
+	l.writes <- commitLogWrite{
+		eventType: writePositionEventType,
+		callbackFn: func(r callbackResult) {
+			defer wg.Done()
+
+			result, e := r.writePositionResult()
+			position, err = result.position, e
+		},
+	}
+
+	wg.Wait()
+
+	if err != nil {
+		return persist.CommitLogPosition{}, err
+	}
+
+	return position, nil
+}
+
 func (l *commitLog) QueueLength() int64 {
 	return atomic.LoadInt64(&l.numWritesInQueue)
 }
@@ -469,6 +528,20 @@ func (l *commitLog) write() {
 			continue
 		}
 
+		if write.eventType == writePositionEventType {
+			write.callbackFn(callbackResult{
+				eventType: write.eventType,
+				err:       nil,
+				writePosition: writePositionResult{
+					position: persist.CommitLogPosition{
+						Index:      l.writerState.activeFiles[0].Index,
+						NumEntries: l.writerState.primaryNumEntries,
+					},
+				},
+			})
+			continue
+		}
+
 		// For writes requiring acks add to pending acks
 		if write.eventType == writeEventType && write.callbackFn != nil {
 			l.writerState.primary.pendingFlushFns = append(
@@ -486,6 +559,8 @@ func (l *commitLog) write() {
 				if l.commitLogFailFn != nil {
 					l.commitLogFailFn(err)
 				}
+			} else {
+				l.writerState.primaryNumEntries = 0
 			}
 
 			write.callbackFn(callbackResult{
@@ -536,6 +611,7 @@ func (l *commitLog) write() {
 				l.handleWriteErr(err)
 				continue
 			}
+			l.writerState.primaryNumEntries++
 			numWritesSuccess++
 		}
 
@@ -150,6 +150,21 @@ func (mr *MockCommitLogMockRecorder) RotateLogs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateLogs", reflect.TypeOf((*MockCommitLog)(nil).RotateLogs))
 }
 
+// WritePosition mocks base method
+func (m *MockCommitLog) WritePosition() (persist.CommitLogPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePosition")
+	ret0, _ := ret[0].(persist.CommitLogPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WritePosition indicates an expected call of WritePosition
+func (mr *MockCommitLogMockRecorder) WritePosition() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePosition", reflect.TypeOf((*MockCommitLog)(nil).WritePosition))
+}
+
 // QueueLength mocks base method
 func (m *MockCommitLog) QueueLength() int64 {
 	m.ctrl.T.Helper()
@@ -613,3 +628,31 @@ func (mr *MockOptionsMockRecorder) IdentifierPool() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdentifierPool", reflect.TypeOf((*MockOptions)(nil).IdentifierPool))
 }
+
+// SetCompression mocks base method
+func (m *MockOptions) SetCompression(value CompressionType) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompression", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetCompression indicates an expected call of SetCompression
+func (mr *MockOptionsMockRecorder) SetCompression(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompression", reflect.TypeOf((*MockOptions)(nil).SetCompression), value)
+}
+
+// Compression mocks base method
+func (m *MockOptions) Compression() CompressionType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compression")
+	ret0, _ := ret[0].(CompressionType)
+	return ret0
+}
+
+// Compression indicates an expected call of Compression
+func (mr *MockOptionsMockRecorder) Compression() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compression", reflect.TypeOf((*MockOptions)(nil).Compression))
+}
@@ -1022,6 +1022,90 @@ func TestCommitLogRotateLogs(t *testing.T) {
 	assertCommitLogWritesByIterating(t, commitLog, writes)
 }
 
+func TestCommitLogWritePositionResultError(t *testing.T) {
+	expectedErr := errors.New("write position error")
+	_, err := callbackResult{
+		eventType: writePositionEventType,
+		err:       expectedErr,
+	}.writePositionResult()
+	require.Equal(t, expectedErr, err)
+
+	_, err = callbackResult{eventType: writeEventType}.writePositionResult()
+	require.Error(t, err)
+}
+
+func TestCommitLogWritePosition(t *testing.T) {
+	var (
+		start       = time.Now()
+		clock       = &mockTime{t: start}
+		opts, scope = newTestOptions(t, overrides{
+			nowFn:    clock.Now,
+			strategy: StrategyWriteWait,
+		})
+	)
+	defer cleanup(t, opts)
+
+	commitLog := newTestCommitLog(t, opts)
+
+	position, err := commitLog.WritePosition()
+	require.NoError(t, err)
+	require.Equal(t, persist.CommitLogPosition{Index: 0, NumEntries: 0}, position)
+
+	writes := []testWrite{
+		{testSeries(0, "foo.bar", testTags1, 127), start, 123.456, xtime.Millisecond, nil, nil},
+		{testSeries(1, "foo.baz", testTags2, 150), start.Add(1 * time.Second), 456.789, xtime.Millisecond, nil, nil},
+		{testSeries(2, "foo.qux", testTags3, 291), start.Add(2 * time.Second), 789.123, xtime.Millisecond, nil, nil},
+	}
+	writeCommitLogs(t, scope, commitLog, writes[:2])
+
+	position, err = commitLog.WritePosition()
+	require.NoError(t, err)
+	require.Equal(t, persist.CommitLogPosition{Index: 0, NumEntries: 2}, position)
+
+	file, err := commitLog.RotateLogs()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), file.Index)
+
+	position, err = commitLog.WritePosition()
+	require.NoError(t, err)
+	require.Equal(t, persist.CommitLogPosition{Index: 1, NumEntries: 0}, position)
+
+	writeCommitLogs(t, scope, commitLog, writes[2:])
+
+	position, err = commitLog.WritePosition()
+	require.NoError(t, err)
+	require.Equal(t, persist.CommitLogPosition{Index: 1, NumEntries: 1}, position)
+
+	// Close and consequently flush.
+	require.NoError(t, commitLog.Close())
+	_, err = commitLog.WritePosition()
+	require.Error(t, err)
+
+	// Ensure the positions of the entries read back match the write positions.
+	iter, corruptFiles, err := NewIterator(IteratorOpts{
+		CommitLogOptions:    opts,
+		FileFilterPredicate: ReadAllPredicate(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(corruptFiles))
+	defer iter.Close()
+
+	var positions []persist.CommitLogPosition
+	for iter.Next() {
+		metadata := iter.Current().Metadata
+		positions = append(positions, persist.CommitLogPosition{
+			Index:      metadata.FileIndex,
+			NumEntries: metadata.EntryOffset,
+		})
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, []persist.CommitLogPosition{
+		{Index: 0, NumEntries: 0},
+		{Index: 0, NumEntries: 1},
+		{Index: 1, NumEntries: 0},
+	}, positions)
+}
+
 var (
 	testTag1 = ident.StringTag("name1", "val1")
 	testTag2 = ident.StringTag("name2", "val2")
@@ -0,0 +1,181 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package commitlog
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+
+	"github.com/golang/snappy"
+)
+
+// CompressionType describes the compression applied to commit log chunks.
+type CompressionType int
+
+const (
+	// CompressionNone writes commit log chunks uncompressed and without a file
+	// header so that the files remain readable by older versions.
+	CompressionNone CompressionType = iota
+
+	// CompressionSnappy compresses each commit log chunk with snappy.
+	CompressionSnappy
+)
+
+var validCompressionTypes = []CompressionType{
+	CompressionNone,
+	CompressionSnappy,
+}
+
+// String returns the string representation of the compression type.
+func (t CompressionType) String() string {
+	switch t {
+	case CompressionNone:
+		return "none"
+	case CompressionSnappy:
+		return "snappy"
+	}
+	return "unknown"
+}
+
+// Validate validates the compression type.
+func (t CompressionType) Validate() error {
+	for _, valid := range validCompressionTypes {
+		if t == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid commit log compression type: %d, valid types are: %v",
+		int(t), validCompressionTypes)
+}
+
+// MarshalYAML marshals a compression type as a string.
+func (t CompressionType) MarshalYAML() (interface{}, error) {
+	return t.String(), nil
+}
+
+// UnmarshalYAML unmarshals a compression type from a string.
+func (t *CompressionType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == "" {
+		*t = CompressionNone
+		return nil
+	}
+	for _, valid := range validCompressionTypes {
+		if str == valid.String() {
+			*t = valid
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid commit log compression type: %s, valid types are: %v",
+		str, validCompressionTypes)
+}
+
+// The file header is only written to commit log files that use compression,
+// files without it are read as uncompressed version 0 files. The header is
+// laid out as:
+// - magic [4]byte
+// - version uint8
+// - compression uint8
+// - reserved [2]byte
+// - checksum uint32 (of all preceding header bytes)
+const (
+	fileHeaderMagic          = "M3CL"
+	fileHeaderVersion        = 1
+	fileHeaderMagicLen       = len(fileHeaderMagic)
+	fileHeaderVersionIdx     = fileHeaderMagicLen
+	fileHeaderCompressionIdx = fileHeaderVersionIdx + 1
+	fileHeaderChecksumStart  = fileHeaderCompressionIdx + 3
+	fileHeaderLen            = fileHeaderChecksumStart + 4
+)
+
+var (
+	errCommitLogReaderFileHeaderChecksumMismatch = errors.New("commit log reader encountered file header checksum mismatch")
+	errCommitLogReaderUnsupportedFileVersion     = errors.New("commit log reader encountered unsupported file version")
+)
+
+func encodeFileHeader(compression CompressionType) []byte {
+	header := make([]byte, fileHeaderLen)
+	copy(header, fileHeaderMagic)
+	header[fileHeaderVersionIdx] = fileHeaderVersion
+	header[fileHeaderCompressionIdx] = byte(compression)
+	digest.
+		Buffer(header[fileHeaderChecksumStart:]).
+		WriteDigest(digest.Checksum(header[:fileHeaderChecksumStart]))
+	return header
+}
+
+// hasFileHeader returns whether the bytes at the start of a commit log file
+// begin with a file header.
+func hasFileHeader(b []byte) bool {
+	return len(b) >= fileHeaderMagicLen &&
+		string(b[:fileHeaderMagicLen]) == fileHeaderMagic
+}
+
+func decodeFileHeader(b []byte) (CompressionType, error) {
+	if len(b) < fileHeaderLen {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	checksum := digest.Buffer(b[fileHeaderChecksumStart:fileHeaderLen]).ReadDigest()
+	if digest.Checksum(b[:fileHeaderChecksumStart]) != checksum {
+		return 0, errCommitLogReaderFileHeaderChecksumMismatch
+	}
+
+	if version := b[fileHeaderVersionIdx]; version > fileHeaderVersion {
+		return 0, fmt.Errorf("%v: %d", errCommitLogReaderUnsupportedFileVersion, version)
+	}
+
+	compression := CompressionType(b[fileHeaderCompressionIdx])
+	if err := compression.Validate(); err != nil {
+		return 0, err
+	}
+	return compression, nil
+}
+
+// compress compresses src into dst, growing dst if required.
+func compress(compression CompressionType, dst, src []byte) []byte {
+	switch compression {
+	case CompressionSnappy:
+		dst = resizeBufferOrGrowIfNeeded(dst, snappy.MaxEncodedLen(len(src)))
+		return snappy.Encode(dst, src)
+	}
+	return src
+}
+
+// decompress decompresses src into dst, growing dst if required.
+func decompress(compression CompressionType, dst, src []byte) ([]byte, error) {
+	switch compression {
+	case CompressionSnappy:
+		decodedLen, err := snappy.DecodedLen(src)
+		if err != nil {
+			return nil, err
+		}
+		dst = resizeBufferOrGrowIfNeeded(dst, decodedLen)
+		return snappy.Decode(dst, src)
+	}
+	return src, nil
+}
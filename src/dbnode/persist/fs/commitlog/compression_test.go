@@ -0,0 +1,115 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package commitlog
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestFileHeaderRoundTrip(t *testing.T) {
+	header := encodeFileHeader(CompressionSnappy)
+	require.Equal(t, fileHeaderLen, len(header))
+	require.True(t, hasFileHeader(header))
+
+	compression, err := decodeFileHeader(header)
+	require.NoError(t, err)
+	require.Equal(t, CompressionSnappy, compression)
+
+	header[fileHeaderCompressionIdx] = byte(CompressionNone)
+	_, err = decodeFileHeader(header)
+	require.Equal(t, errCommitLogReaderFileHeaderChecksumMismatch, err)
+
+	_, err = decodeFileHeader(header[:fileHeaderLen-1])
+	require.Error(t, err)
+}
+
+func TestCompressionTypeUnmarshalYAML(t *testing.T) {
+	var cfg struct {
+		Compression CompressionType `yaml:"compression"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte("compression: snappy"), &cfg))
+	require.Equal(t, CompressionSnappy, cfg.Compression)
+
+	require.NoError(t, yaml.Unmarshal([]byte("compression: none"), &cfg))
+	require.Equal(t, CompressionNone, cfg.Compression)
+
+	require.Error(t, yaml.Unmarshal([]byte("compression: lz4"), &cfg))
+}
+
+func TestCommitLogWriteCompressed(t *testing.T) {
+	opts, scope := newTestOptions(t, overrides{
+		strategy: StrategyWriteWait,
+	})
+	opts = opts.SetCompression(CompressionSnappy)
+	defer cleanup(t, opts)
+
+	writes := []testWrite{
+		{testSeries(0, "foo.bar", ident.NewTags(ident.StringTag("name1", "val1")), 127), time.Now(), 123.456, xtime.Second, []byte{1, 2, 3}, nil},
+		{testSeries(1, "foo.baz", ident.NewTags(ident.StringTag("name2", "val2")), 150), time.Now(), 456.789, xtime.Second, nil, nil},
+		{testSeries(0, "foo.bar", ident.NewTags(ident.StringTag("name1", "val1")), 127), time.Now(), 789.123, xtime.Second, make([]byte, 3*opts.FlushSize()), nil},
+	}
+
+	commitLog := newTestCommitLog(t, opts)
+	writeCommitLogs(t, scope, commitLog, writes).Wait()
+	require.NoError(t, commitLog.Close())
+
+	files, err := fs.SortedCommitLogFiles(fs.CommitLogsDirPath(
+		opts.FilesystemOptions().FilePathPrefix()))
+	require.NoError(t, err)
+	require.True(t, len(files) > 0)
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		require.True(t, hasFileHeader(data))
+	}
+
+	// Files written with compression remain readable without configuring it.
+	commitLog.opts = commitLog.opts.SetCompression(CompressionNone)
+	assertCommitLogWritesByIterating(t, commitLog, writes)
+}
+
+func TestCommitLogReadUncompressedWithCompressionEnabled(t *testing.T) {
+	opts, scope := newTestOptions(t, overrides{
+		strategy: StrategyWriteWait,
+	})
+	defer cleanup(t, opts)
+
+	writes := []testWrite{
+		{testSeries(0, "foo.bar", ident.NewTags(ident.StringTag("name1", "val1")), 127), time.Now(), 123.456, xtime.Second, []byte{1, 2, 3}, nil},
+		{testSeries(1, "foo.baz", ident.NewTags(ident.StringTag("name2", "val2")), 150), time.Now(), 456.789, xtime.Second, nil, nil},
+	}
+
+	commitLog := newTestCommitLog(t, opts)
+	writeCommitLogs(t, scope, commitLog, writes).Wait()
+	require.NoError(t, commitLog.Close())
+
+	commitLog.opts = commitLog.opts.SetCompression(CompressionSnappy)
+	assertCommitLogWritesByIterating(t, commitLog, writes)
+}
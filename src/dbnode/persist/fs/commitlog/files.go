@@ -90,6 +90,9 @@ func ReadLogInfo(filePath string, opts Options) (int64, error) {
 
 	chunkReader := newChunkReader(opts.FlushSize())
 	chunkReader.reset(fd)
+	if err := chunkReader.readFileHeader(); err != nil {
+		return 0, err
+	}
 	size, err := binary.ReadUvarint(chunkReader)
 	if err != nil {
 		return 0, err
@@ -49,6 +49,9 @@ const (
 	// defaultReadConcurrency is the default read concurrency
 	defaultReadConcurrency = 4
 
+	// defaultCompression is the default commit log chunk compression
+	defaultCompression = CompressionNone
+
 	// MaximumQueueSizeQueueChannelSizeRatio is the maximum ratio between the
 	// backlog queue size and backlog queue channel size.
 	MaximumQueueSizeQueueChannelSizeRatio = 8.0
@@ -81,6 +84,7 @@ type options struct {
 	bytesPool               pool.CheckedBytesPool
 	identPool               ident.Pool
 	readConcurrency         int
+	compression             CompressionType
 }
 
 // NewOptions creates new commit log options
@@ -99,6 +103,7 @@ func NewOptions() Options {
 			return pool.NewBytesPool(s, nil)
 		}),
 		readConcurrency: defaultReadConcurrency,
+		compression:     defaultCompression,
 	}
 	o.bytesPool.Init()
 	o.identPool = ident.NewPool(o.bytesPool, ident.PoolOptions{})
@@ -118,6 +123,10 @@ func (o *options) Validate() error {
 		return errReadConcurrencyPositive
 	}
 
+	if err := o.Compression().Validate(); err != nil {
+		return err
+	}
+
 	if float64(o.BacklogQueueSize())/float64(o.BacklogQueueChannelSize()) > MaximumQueueSizeQueueChannelSizeRatio {
 		return fmt.Errorf(
 			"BacklogQueueSize / BacklogQueueChannelSize ratio must be at most: %f, but was: %f",
@@ -246,3 +255,13 @@ func (o *options) SetIdentifierPool(value ident.Pool) Options {
 func (o *options) IdentifierPool() ident.Pool {
 	return o.identPool
 }
+
+func (o *options) SetCompression(value CompressionType) Options {
+	opts := *o
+	opts.compression = value
+	return &opts
+}
+
+func (o *options) Compression() CompressionType {
+	return o.compression
+}
@@ -81,6 +81,8 @@ type reader struct {
 	infoDecoderStream      msgpack.ByteDecoderStream
 	hasBeenOpened          bool
 	fileReadID             uint64
+	fileIndex              int64
+	numEntriesRead         int64
 
 	metadataLookup map[uint64]ts.Series
 	namespacesRead []namespaceRead
@@ -128,6 +130,10 @@ func (r *reader) Open(filePath string) (int64, error) {
 	}
 
 	r.chunkReader.reset(fd)
+	if err := r.chunkReader.readFileHeader(); err != nil {
+		r.Close()
+		return 0, err
+	}
 	info, err := r.readInfo()
 	if err != nil {
 		r.Close()
@@ -135,6 +141,7 @@ func (r *reader) Open(filePath string) (int64, error) {
 	}
 
 	r.fileReadID = commitLogFileReadCounter.Inc()
+	r.fileIndex = info.Index
 
 	index := info.Index
 	return index, nil
@@ -178,8 +185,11 @@ func (r *reader) Read() (LogEntry, error) {
 		Metadata: LogEntryMetadata{
 			FileReadID:        r.fileReadID,
 			SeriesUniqueIndex: entry.Index,
+			FileIndex:         r.fileIndex,
+			EntryOffset:       r.numEntriesRead,
 		},
 	}
+	r.numEntriesRead++
 
 	if len(entry.Annotation) > 0 {
 		// Copy annotation to prevent reference to pooled byte slice
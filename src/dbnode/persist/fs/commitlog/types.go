@@ -79,6 +79,10 @@ type CommitLog interface {
 	// the new commitlog file.
 	RotateLogs() (persist.CommitLogFile, error)
 
+	// WritePosition returns the position in the commitlog reached by the writes
+	// that were enqueued before the call.
+	WritePosition() (persist.CommitLogPosition, error)
+
 	// QueueLength returns the number of writes that are currently in the commitlog
 	// queue.
 	QueueLength() int64
@@ -101,6 +105,11 @@ type LogEntryMetadata struct {
 	// SeriesUniqueIndex is the series unique index relative to the
 	// current commit log file being read.
 	SeriesUniqueIndex uint64
+	// FileIndex is the index of the commit log file being read.
+	FileIndex int64
+	// EntryOffset is the offset of the entry within the commit log
+	// file being read, the first entry has an offset of zero.
+	EntryOffset int64
 }
 
 // Iterator provides an iterator for commit logs.
@@ -214,6 +223,13 @@ type Options interface {
 
 	// IdentifierPool returns the IdentifierPool to use for pooling identifiers.
 	IdentifierPool() ident.Pool
+
+	// SetCompression sets the compression used for newly written commit log chunks,
+	// files are always readable regardless of the compression they were written with.
+	SetCompression(value CompressionType) Options
+
+	// Compression returns the compression used for newly written commit log chunks.
+	Compression() CompressionType
 }
 
 // FileFilterInfo contains information about a commitog file that can be used to
@@ -125,7 +125,7 @@ func newCommitLogWriter(
 		newFileMode:         opts.FilesystemOptions().NewFileMode(),
 		newDirectoryMode:    opts.FilesystemOptions().NewDirectoryMode(),
 		nowFn:               opts.ClockOptions().NowFn(),
-		chunkWriter:         newChunkWriter(flushFn, shouldFsync),
+		chunkWriter:         newChunkWriter(flushFn, shouldFsync, opts.Compression(), opts.FlushSize()),
 		chunkReserveHeader:  make([]byte, chunkHeaderLen),
 		buffer:              bufio.NewWriterSize(nil, opts.FlushSize()),
 		sizeBuffer:          make([]byte, binary.MaxVarintLen64),
@@ -173,6 +173,12 @@ func (w *writer) Open() (persist.CommitLogFile, error) {
 	if err != nil {
 		return persist.CommitLogFile{}, err
 	}
+	if compression := w.opts.Compression(); compression != CompressionNone {
+		if _, err := fd.Write(encodeFileHeader(compression)); err != nil {
+			fd.Close()
+			return persist.CommitLogFile{}, err
+		}
+	}
 
 	w.chunkWriter.reset(fd)
 	w.buffer.Reset(w.chunkWriter)
@@ -317,18 +323,30 @@ func (w *writer) write(data []byte) error {
 }
 
 type fsChunkWriter struct {
-	fd      xos.File
-	flushFn flushFn
-	buff    []byte
-	fsync   bool
+	fd             xos.File
+	flushFn        flushFn
+	buff           []byte
+	fsync          bool
+	compression    CompressionType
+	compressedBuff []byte
 }
 
-func newChunkWriter(flushFn flushFn, fsync bool) chunkWriter {
-	return &fsChunkWriter{
-		flushFn: flushFn,
-		buff:    make([]byte, chunkHeaderLen),
-		fsync:   fsync,
+func newChunkWriter(
+	flushFn flushFn,
+	fsync bool,
+	compression CompressionType,
+	bufferLen int,
+) chunkWriter {
+	w := &fsChunkWriter{
+		flushFn:     flushFn,
+		buff:        make([]byte, chunkHeaderLen),
+		fsync:       fsync,
+		compression: compression,
+	}
+	if compression != CompressionNone {
+		w.compressedBuff = make([]byte, 0, bufferLen)
 	}
+	return w
 }
 
 func (w *fsChunkWriter) reset(f xos.File) {
@@ -352,8 +370,15 @@ func (w *fsChunkWriter) sync() error {
 // Writes a custom header in front of p to a file and returns number of bytes of p successfully written to the file.
 // If the header or p is not fully written to the file, then this method returns number of bytes of p actually written
 // to the file and an error explaining the reason of failure to write fully to the file.
+// If compression is enabled p is compressed before being written and the checksum is computed over the compressed
+// chunk, in which case a partial write reports that none of p was written.
 func (w *fsChunkWriter) Write(p []byte) (int, error) {
-	size := len(p)
+	chunk := p
+	if w.compression != CompressionNone {
+		w.compressedBuff = compress(w.compression, w.compressedBuff, p)
+		chunk = w.compressedBuff
+	}
+	size := len(chunk)
 
 	sizeStart, sizeEnd :=
 		0, chunkHeaderSizeLen
@@ -367,7 +392,7 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 
 	// Calculate checksums
 	checksumSize := digest.Checksum(w.buff[sizeStart:sizeEnd])
-	checksumData := digest.Checksum(p)
+	checksumData := digest.Checksum(chunk)
 
 	// Write checksums
 	digest.
@@ -378,7 +403,7 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 		WriteDigest(checksumData)
 
 	// Combine buffers to reduce to a single syscall
-	w.buff = append(w.buff[:chunkHeaderLen], p...)
+	w.buff = append(w.buff[:chunkHeaderLen], chunk...)
 
 	// Write contents to file descriptor
 	n, err := w.fd.Write(w.buff)
@@ -387,6 +412,13 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 	if pBytesWritten < 0 {
 		pBytesWritten = 0
 	}
+	if w.compression != CompressionNone {
+		if pBytesWritten == len(chunk) {
+			pBytesWritten = len(p)
+		} else {
+			pBytesWritten = 0
+		}
+	}
 
 	if err != nil {
 		w.flushFn(err)
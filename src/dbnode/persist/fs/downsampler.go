@@ -0,0 +1,268 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var errDownsampleResolutionNotPositive = errors.New("downsample resolution must be positive")
+
+type downsampler struct {
+	reader         DataFileSetReader
+	blockAllocSize int
+	srPool         xio.SegmentReaderPool
+	multiIterPool  encoding.MultiReaderIteratorPool
+	identPool      ident.Pool
+	encoderPool    encoding.EncoderPool
+	nsOpts         namespace.Options
+}
+
+// NewDownsampler returns a new Downsampler. This implementation reads every
+// series from an existing fileset and keeps only the last datapoint within
+// each resolution window, the downsampled data is then persisted.
+//
+// Similar to the merger, the downsampler does not signal to the database of
+// the existence of the newly persisted data, nor does it clean up the
+// original fileset.
+func NewDownsampler(
+	reader DataFileSetReader,
+	blockAllocSize int,
+	srPool xio.SegmentReaderPool,
+	multiIterPool encoding.MultiReaderIteratorPool,
+	identPool ident.Pool,
+	encoderPool encoding.EncoderPool,
+	nsOpts namespace.Options,
+) Downsampler {
+	return &downsampler{
+		reader:         reader,
+		blockAllocSize: blockAllocSize,
+		srPool:         srPool,
+		multiIterPool:  multiIterPool,
+		identPool:      identPool,
+		encoderPool:    encoderPool,
+		nsOpts:         nsOpts,
+	}
+}
+
+// Downsample downsamples data from a fileset to the given resolution and
+// persists it.
+func (d *downsampler) Downsample(
+	fileID FileSetFileIdentifier,
+	resolution time.Duration,
+	nextVolumeIndex int,
+	flushPreparer persist.FlushPreparer,
+	nsCtx namespace.Context,
+) (err error) {
+	if resolution <= 0 {
+		return errDownsampleResolutionNotPositive
+	}
+
+	var (
+		reader    = d.reader
+		nsOpts    = d.nsOpts
+		startTime = fileID.BlockStart
+		blockSize = nsOpts.RetentionOptions().BlockSize()
+		openOpts  = DataReaderOpenOptions{
+			Identifier:  fileID,
+			FileSetType: persist.FileSetFlushType,
+		}
+	)
+
+	if err := reader.Open(openOpts); err != nil {
+		return err
+	}
+	defer func() {
+		// Only set the error here if not set by the end of the function, since
+		// all other errors take precedence.
+		if err == nil {
+			err = reader.Close()
+		}
+	}()
+
+	nsMd, err := namespace.NewMetadata(fileID.Namespace, nsOpts)
+	if err != nil {
+		return err
+	}
+	prepared, err := flushPreparer.PrepareData(persist.DataPrepareOptions{
+		NamespaceMetadata:     nsMd,
+		Shard:                 fileID.Shard,
+		BlockStart:            startTime,
+		VolumeIndex:           nextVolumeIndex,
+		FileSetType:           persist.FileSetFlushType,
+		DeleteIfExists:        false,
+		DownsampledResolution: resolution,
+		EncodingFormat:        reader.EncodingFormat(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		segReader = d.srPool.Get()
+		multiIter = d.multiIterPool.Get()
+
+		// IDs and tags must only be finalized once the prepared persist has
+		// been closed since the underlying writer holds on to the references.
+		idsToFinalize  = make([]ident.ID, 0, reader.Entries())
+		tagsToFinalize = make([]ident.Tags, 0, reader.Entries())
+
+		segReaders = make([]xio.SegmentReader, 1)
+		window     = downsampleWindow{resolution: resolution}
+	)
+	defer func() {
+		segReader.Finalize()
+		multiIter.Close()
+		for _, res := range idsToFinalize {
+			res.Finalize()
+		}
+		for _, res := range tagsToFinalize {
+			res.Finalize()
+		}
+	}()
+
+	for id, tagsIter, data, checksum, err := reader.Read(); err != io.EOF; id, tagsIter, data, checksum, err = reader.Read() {
+		if err != nil {
+			return err
+		}
+		idsToFinalize = append(idsToFinalize, id)
+
+		tags, err := convert.TagsFromTagsIter(id, tagsIter, d.identPool)
+		tagsIter.Close()
+		if err != nil {
+			return err
+		}
+		tagsToFinalize = append(tagsToFinalize, tags)
+
+		segReaders[0] = segmentReaderFromData(data, checksum, segReader)
+		multiIter.Reset(segReaders, startTime, blockSize, nsCtx.Schema)
+
+		encoder := d.encoderPool.Get()
+		encoder.Reset(startTime, d.blockAllocSize, nsCtx.Schema)
+		window.reset()
+		for multiIter.Next() {
+			dp, unit, annotation := multiIter.Current()
+			if !window.update(dp, unit, annotation) {
+				continue
+			}
+			// Entered a new window, persist the last datapoint of the
+			// previous window.
+			if err := window.encodePrevious(encoder); err != nil {
+				encoder.Close()
+				return err
+			}
+		}
+		if err := multiIter.Err(); err != nil {
+			encoder.Close()
+			return err
+		}
+		if err := window.encodeCurrent(encoder); err != nil {
+			encoder.Close()
+			return err
+		}
+
+		segment := encoder.Discard()
+		if err := persistSegment(id, tags, segment, prepared.Persist); err != nil {
+			return err
+		}
+	}
+
+	// Close the flush preparer, which writes the rest of the files in the
+	// fileset.
+	return prepared.Close()
+}
+
+// downsampleWindow tracks the last datapoint seen for the current resolution
+// window along with the last datapoint of the previous window so that it can
+// be encoded once the window has been closed.
+type downsampleWindow struct {
+	resolution time.Duration
+
+	hasCurr        bool
+	currStart      time.Time
+	curr           ts.Datapoint
+	currUnit       xtime.Unit
+	currAnnotation []byte
+
+	hasPrev        bool
+	prev           ts.Datapoint
+	prevUnit       xtime.Unit
+	prevAnnotation []byte
+}
+
+func (w *downsampleWindow) reset() {
+	w.hasCurr = false
+	w.hasPrev = false
+	w.currAnnotation = w.currAnnotation[:0]
+	w.prevAnnotation = w.prevAnnotation[:0]
+}
+
+// update records the datapoint as the latest in its window and returns true
+// if the datapoint started a new window, closing the previous one.
+func (w *downsampleWindow) update(
+	dp ts.Datapoint,
+	unit xtime.Unit,
+	annotation ts.Annotation,
+) bool {
+	start := dp.Timestamp.Truncate(w.resolution)
+	newWindow := w.hasCurr && !start.Equal(w.currStart)
+	if newWindow {
+		// Swap the buffers so the previous window's annotation can be
+		// encoded without allocating.
+		w.prev, w.prevUnit, w.hasPrev = w.curr, w.currUnit, true
+		w.prevAnnotation, w.currAnnotation = w.currAnnotation, w.prevAnnotation
+	}
+
+	w.hasCurr = true
+	w.currStart = start
+	w.curr = dp
+	w.currUnit = unit
+	// The annotation is only valid until the iterator advances so take a copy.
+	w.currAnnotation = append(w.currAnnotation[:0], annotation...)
+	return newWindow
+}
+
+func (w *downsampleWindow) encodePrevious(encoder encoding.Encoder) error {
+	if !w.hasPrev {
+		return nil
+	}
+	w.hasPrev = false
+	return encoder.Encode(w.prev, w.prevUnit, w.prevAnnotation)
+}
+
+func (w *downsampleWindow) encodeCurrent(encoder encoding.Encoder) error {
+	if !w.hasCurr {
+		return nil
+	}
+	w.hasCurr = false
+	return encoder.Encode(w.curr, w.currUnit, w.currAnnotation)
+}
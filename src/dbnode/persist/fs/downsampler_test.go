@@ -0,0 +1,123 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownsampleKeepsLastDatapointPerWindow(t *testing.T) {
+	diskData := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	diskData.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(0 * time.Second), Value: 0},
+		{Timestamp: startTime.Add(10 * time.Second), Value: 1},
+		{Timestamp: startTime.Add(59 * time.Second), Value: 2},
+		{Timestamp: startTime.Add(60 * time.Second), Value: 3},
+		{Timestamp: startTime.Add(150 * time.Second), Value: 4},
+		{Timestamp: startTime.Add(170 * time.Second), Value: 5},
+	}))
+	diskData.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(30 * time.Second), Value: 6},
+	}))
+	diskData.Set(id2, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 7},
+		{Timestamp: startTime.Add(61 * time.Second), Value: 8},
+		{Timestamp: startTime.Add(121 * time.Second), Value: 9},
+	}))
+
+	expected := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	expected.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(59 * time.Second), Value: 2},
+		{Timestamp: startTime.Add(60 * time.Second), Value: 3},
+		{Timestamp: startTime.Add(170 * time.Second), Value: 5},
+	}))
+	expected.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(30 * time.Second), Value: 6},
+	}))
+	expected.Set(id2, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 7},
+		{Timestamp: startTime.Add(61 * time.Second), Value: 8},
+		{Timestamp: startTime.Add(121 * time.Second), Value: 9},
+	}))
+
+	testDownsample(t, diskData, time.Minute, expected)
+}
+
+func TestDownsampleNoData(t *testing.T) {
+	diskData := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	expected := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	testDownsample(t, diskData, time.Minute, expected)
+}
+
+func TestDownsampleInvalidResolution(t *testing.T) {
+	downsampler := NewDownsampler(nil, 0, srPool, multiIterPool,
+		identPool, encoderPool, namespace.NewOptions())
+	err := downsampler.Downsample(FileSetFileIdentifier{}, 0, 1, nil, namespace.Context{})
+	require.Error(t, err)
+}
+
+func testDownsample(
+	t *testing.T,
+	diskData *checkedBytesMap,
+	resolution time.Duration,
+	expectedData *checkedBytesMap,
+) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	reader := mockReaderFromData(ctrl, diskData)
+
+	var persisted []persistedData
+	preparer := persist.NewMockFlushPreparer(ctrl)
+	preparer.EXPECT().PrepareData(gomock.Any()).Do(func(opts persist.DataPrepareOptions) {
+		require.Equal(t, resolution, opts.DownsampledResolution)
+		require.Equal(t, EncodingFormatM3TSZ, opts.EncodingFormat)
+	}).Return(
+		persist.PreparedDataPersist{
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
+				persisted = append(persisted, persistedData{
+					id:      id,
+					segment: segment.Clone(nil),
+				})
+				return nil
+			},
+			Close: func() error { return nil },
+		}, nil)
+
+	downsampler := NewDownsampler(reader, 0, srPool, multiIterPool,
+		identPool, encoderPool, namespace.NewOptions())
+	fsID := FileSetFileIdentifier{
+		Namespace:  ident.StringID("test-ns"),
+		Shard:      uint32(8),
+		BlockStart: startTime,
+	}
+	err := downsampler.Downsample(fsID, resolution, 1, preparer, namespace.Context{})
+	require.NoError(t, err)
+
+	assertPersistedAsExpected(t, persisted, expectedData)
+}
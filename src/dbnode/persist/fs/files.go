@@ -283,6 +283,7 @@ func (f FileSetFilesSlice) sortByTimeAndVolumeIndexAscending() {
 type SnapshotMetadata struct {
 	ID                  SnapshotMetadataIdentifier
 	CommitlogIdentifier persist.CommitLogFile
+	HighWaterMarks      []persist.SnapshotHighWaterMark
 	MetadataFilePath    string
 	CheckpointFilePath  string
 }
@@ -1440,6 +1441,36 @@ func DataFileSetExists(
 	return CompleteCheckpointFileExists(checkpointPath)
 }
 
+// DataFileSetSize returns the size in bytes of the data file of the data
+// fileset for the given namespace, shard, block start, and volume. Zero is
+// returned if the data file does not exist.
+func DataFileSetSize(
+	filePathPrefix string,
+	namespace ident.ID,
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+) (int64, error) {
+	shardDir := ShardDataDirPath(filePathPrefix, namespace, shard)
+
+	// Check fileset with volume first to optimize for non-legacy use case.
+	paths := []string{filesetPathFromTimeAndIndex(shardDir, blockStart, volume, dataFileSuffix)}
+	if volume == 0 {
+		paths = append(paths, filesetPathFromTimeLegacy(shardDir, blockStart, dataFileSuffix))
+	}
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		return info.Size(), nil
+	}
+	return 0, nil
+}
+
 // SnapshotFileSetExistsAt determines whether snapshot fileset files exist for the given namespace, shard, and block start time.
 func SnapshotFileSetExistsAt(prefix string, namespace ident.ID, shard uint32, blockStart time.Time) (bool, error) {
 	snapshotFiles, err := SnapshotFiles(prefix, namespace, shard)
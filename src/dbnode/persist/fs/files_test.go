@@ -490,6 +490,33 @@ func TestFileSetFilesBefore(t *testing.T) {
 	}
 }
 
+func TestDataFileSetSize(t *testing.T) {
+	var (
+		dir      = createTempDir(t)
+		shard    = uint32(10)
+		start    = time.Now()
+		shardDir = ShardDataDirPath(dir, testNs1ID, shard)
+		err      = os.MkdirAll(shardDir, defaultNewDirectoryMode)
+	)
+	defer os.RemoveAll(dir)
+	require.NoError(t, err)
+
+	size, err := DataFileSetSize(dir, testNs1ID, shard, start, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), size)
+
+	createDataFile(t, shardDir, start, dataFileSuffix, make([]byte, 42))
+	size, err = DataFileSetSize(dir, testNs1ID, shard, start, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), size)
+
+	dataFilePath := filesetPathFromTimeAndIndex(shardDir, start, 1, dataFileSuffix)
+	createFile(t, dataFilePath, make([]byte, 7))
+	size, err = DataFileSetSize(dir, testNs1ID, shard, start, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), size)
+}
+
 func TestFileSetAt(t *testing.T) {
 	shard := uint32(0)
 	numIters := 20
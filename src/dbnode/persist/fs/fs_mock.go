@@ -185,6 +185,20 @@ func (mr *MockDataFileSetReaderMockRecorder) EntriesRead() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EntriesRead", reflect.TypeOf((*MockDataFileSetReader)(nil).EntriesRead))
 }
 
+// EncodingFormat mocks base method
+func (m *MockDataFileSetReader) EncodingFormat() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncodingFormat")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EncodingFormat indicates an expected call of EncodingFormat
+func (mr *MockDataFileSetReaderMockRecorder) EncodingFormat() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncodingFormat", reflect.TypeOf((*MockDataFileSetReader)(nil).EncodingFormat))
+}
+
 // MetadataRead mocks base method
 func (m *MockDataFileSetReader) MetadataRead() int {
 	m.ctrl.T.Helper()
@@ -138,6 +138,9 @@ func (m *merger) Merge(
 		VolumeIndex:       nextVolumeIndex,
 		FileSetType:       persist.FileSetFlushType,
 		DeleteIfExists:    false,
+		// Series only on disk are persisted as is so the merged volume
+		// keeps the encoding format of the volume it is merged with.
+		EncodingFormat: reader.EncodingFormat(),
 	}
 	prepared, err := flushPreparer.PrepareData(prepareOpts)
 	if err != nil {
@@ -189,6 +192,10 @@ func (m *merger) Merge(
 		}
 	}()
 
+	// Deleted ranges are only applied to data from disk since the merge
+	// target is expected to have already removed them from its own data.
+	tombstones, hasTombstones := mergeWith.(MergeWithTombstones)
+
 	// The merge is performed in two stages. The first stage is to loop through
 	// series on disk and merge it with what's in the merge target. Looping
 	// through disk in the first stage is done intentionally to read disk
@@ -226,6 +233,19 @@ func (m *merger) Merge(
 		}
 		tagsToFinalize = append(tagsToFinalize, tags)
 
+		if hasTombstones {
+			deleted, ok := tombstones.Tombstones(id, blockStart)
+			if ok {
+				err := persistExcludingDeleted(ctx, id, tags, segmentReaders,
+					deleted, iterResources, prepared.Persist)
+				if err != nil {
+					return err
+				}
+				ctx.BlockingCloseReset()
+				continue
+			}
+		}
+
 		// In the special (but common) case that we're just copying the series data from the old file
 		// into the new one without merging or adding any additional data we can avoid recalculating
 		// the checksum.
@@ -347,6 +367,58 @@ func persistIter(
 	return persistSegment(id, tags, segment, persistFn)
 }
 
+// persistExcludingDeleted persists the series after removing any datapoints
+// within the deleted ranges from the data on disk, which is expected to be the
+// first segment reader. Any other segment readers are from the merge target
+// and are merged as is. If no datapoints remain the series is not persisted.
+func persistExcludingDeleted(
+	ctx context.Context,
+	id ident.ID,
+	tags ident.Tags,
+	segReaders []xio.SegmentReader,
+	deleted xtime.Ranges,
+	ir iterResources,
+	persistFn persist.DataFn,
+) error {
+	it := ir.multiIter
+	it.Reset(segReaders[:1], ir.blockStart, ir.blockSize, ir.schema)
+	encoder := ir.encoderPool.Get()
+	encoder.Reset(ir.blockStart, ir.blockAllocSize, ir.schema)
+	for it.Next() {
+		dp, unit, annotation := it.Current()
+		if deleted.Overlaps(xtime.Range{Start: dp.Timestamp, End: dp.Timestamp.Add(1)}) {
+			continue
+		}
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			encoder.Close()
+			return err
+		}
+	}
+	if err := it.Err(); err != nil {
+		encoder.Close()
+		return err
+	}
+
+	if len(segReaders) == 1 {
+		if encoder.Len() == 0 {
+			encoder.Close()
+			return nil
+		}
+		return persistSegment(id, tags, encoder.Discard(), persistFn)
+	}
+
+	// The encoder must outlive its stream which is only used until the
+	// series has been persisted and the context is closed.
+	ctx.RegisterCloser(encoder)
+	remaining := segReaders[1:]
+	if stream, ok := encoder.Stream(ctx); ok {
+		ctx.RegisterFinalizer(stream)
+		segReaders[0] = stream
+		remaining = segReaders
+	}
+	return persistSegmentReaders(id, tags, remaining, ir, persistFn)
+}
+
 func persistSegmentReader(
 	id ident.ID,
 	tags ident.Tags,
@@ -426,6 +426,110 @@ func TestMergeWithNoData(t *testing.T) {
 	testMergeWith(t, diskData, mergeTargetData, expected)
 }
 
+func TestMergeWithTombstones(t *testing.T) {
+	// id0 has a single datapoint deleted, all of id1 is deleted, id2 is not
+	// deleted and id3 has data deleted on disk but new data in the merge target
+	// within the deleted range which must be kept.
+	diskData := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	diskData.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(0 * time.Second), Value: 0},
+		{Timestamp: startTime.Add(1 * time.Second), Value: 1},
+		{Timestamp: startTime.Add(2 * time.Second), Value: 2},
+	}))
+	diskData.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(2 * time.Second), Value: 3},
+		{Timestamp: startTime.Add(3 * time.Second), Value: 4},
+	}))
+	diskData.Set(id2, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 5},
+	}))
+	diskData.Set(id3, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 6},
+		{Timestamp: startTime.Add(4 * time.Second), Value: 7},
+	}))
+
+	mergeTargetData := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	mergeTargetData.Set(id3, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(2 * time.Second), Value: 8},
+	}))
+
+	deleted := map[string]xtime.Ranges{
+		id0.String(): xtime.NewRanges(xtime.Range{
+			Start: startTime.Add(1 * time.Second),
+			End:   startTime.Add(2 * time.Second),
+		}),
+		id1.String(): xtime.NewRanges(xtime.Range{
+			Start: startTime,
+			End:   startTime.Add(blockSize),
+		}),
+		id3.String(): xtime.NewRanges(xtime.Range{
+			Start: startTime,
+			End:   startTime.Add(3 * time.Second),
+		}),
+	}
+
+	expected := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	expected.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(0 * time.Second), Value: 0},
+		{Timestamp: startTime.Add(2 * time.Second), Value: 2},
+	}))
+	expected.Set(id2, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 5},
+	}))
+	expected.Set(id3, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(2 * time.Second), Value: 8},
+		{Timestamp: startTime.Add(4 * time.Second), Value: 7},
+	}))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	reader := mockReaderFromData(ctrl, diskData)
+
+	var persisted []persistedData
+	preparer := persist.NewMockFlushPreparer(ctrl)
+	preparer.EXPECT().PrepareData(gomock.Any()).Return(
+		persist.PreparedDataPersist{
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
+				persisted = append(persisted, persistedData{
+					id:      id,
+					segment: segment.Clone(nil),
+				})
+				return nil
+			},
+			Close: func() error { return nil },
+		}, nil)
+
+	merger := NewMerger(reader, 0, srPool, multiIterPool,
+		identPool, encoderPool, contextPool, namespace.NewOptions())
+	fsID := FileSetFileIdentifier{
+		Namespace:  ident.StringID("test-ns"),
+		Shard:      uint32(8),
+		BlockStart: startTime,
+	}
+	mergeWith := tombstonedMergeWith{
+		MockMergeWith: mockMergeWithFromData(t, ctrl, diskData, mergeTargetData),
+		deleted:       deleted,
+	}
+	err := merger.Merge(fsID, mergeWith, 1, preparer, namespace.Context{},
+		&persist.NoOpColdFlushNamespace{})
+	require.NoError(t, err)
+
+	assertPersistedAsExpected(t, persisted, expected)
+}
+
+type tombstonedMergeWith struct {
+	*MockMergeWith
+	deleted map[string]xtime.Ranges
+}
+
+func (m tombstonedMergeWith) Tombstones(
+	seriesID ident.ID,
+	_ xtime.UnixNano,
+) (xtime.Ranges, bool) {
+	ranges, ok := m.deleted[seriesID.String()]
+	return ranges, ok
+}
+
 func testMergeWith(
 	t *testing.T,
 	diskData *checkedBytesMap,
@@ -522,6 +626,7 @@ func mockReaderFromData(
 	reader := NewMockDataFileSetReader(ctrl)
 	reader.EXPECT().Open(gomock.Any()).Return(nil)
 	reader.EXPECT().Entries().Return(diskData.Len()).Times(2)
+	reader.EXPECT().EncodingFormat().Return(EncodingFormatM3TSZ)
 	reader.EXPECT().Close().Return(nil)
 	tagIter := ident.NewTagsIterator(ident.NewTags(ident.StringTag("tag-key0", "tag-val0")))
 	fakeChecksum := uint32(42)
@@ -44,10 +44,12 @@ var (
 			NumElementsM: 2075674,
 			NumHashesK:   7,
 		},
-		SnapshotTime: time.Now().UnixNano(),
-		FileType:     persist.FileSetSnapshotType,
-		SnapshotID:   []byte("some_bytes"),
-		VolumeIndex:  1,
+		SnapshotTime:          time.Now().UnixNano(),
+		FileType:              persist.FileSetSnapshotType,
+		SnapshotID:            []byte("some_bytes"),
+		VolumeIndex:           1,
+		DownsampledResolution: int64(time.Minute),
+		EncodingFormat:        "m3tsz",
 	}
 
 	testIndexEntry = schema.IndexEntry{
@@ -99,7 +101,7 @@ func TestIndexInfoRoundtrip(t *testing.T) {
 	require.Equal(t, testIndexInfo, res)
 }
 
-// Make sure the V4 decoding code can handle the V1 file format.
+// Make sure the V5 decoding code can handle the V1 file format.
 func TestIndexInfoRoundTripBackwardsCompatibilityV1(t *testing.T) {
 	var (
 		opts = legacyEncodingOptions{encodeLegacyIndexInfoVersion: legacyEncodingIndexVersionV1}
@@ -113,20 +115,26 @@ func TestIndexInfoRoundTripBackwardsCompatibilityV1(t *testing.T) {
 	// because the new decoder won't try and read the new fields from
 	// the old file format
 	var (
-		currSnapshotTime = testIndexInfo.SnapshotTime
-		currFileType     = testIndexInfo.FileType
-		currSnapshotID   = testIndexInfo.SnapshotID
-		currVolumeIndex  = testIndexInfo.VolumeIndex
+		currSnapshotTime          = testIndexInfo.SnapshotTime
+		currFileType              = testIndexInfo.FileType
+		currSnapshotID            = testIndexInfo.SnapshotID
+		currVolumeIndex           = testIndexInfo.VolumeIndex
+		currDownsampledResolution = testIndexInfo.DownsampledResolution
+		currEncodingFormat        = testIndexInfo.EncodingFormat
 	)
 	testIndexInfo.SnapshotTime = 0
 	testIndexInfo.FileType = 0
 	testIndexInfo.SnapshotID = nil
 	testIndexInfo.VolumeIndex = 0
+	testIndexInfo.DownsampledResolution = 0
+	testIndexInfo.EncodingFormat = ""
 	defer func() {
 		testIndexInfo.SnapshotTime = currSnapshotTime
 		testIndexInfo.FileType = currFileType
 		testIndexInfo.SnapshotID = currSnapshotID
 		testIndexInfo.VolumeIndex = currVolumeIndex
+		testIndexInfo.DownsampledResolution = currDownsampledResolution
+		testIndexInfo.EncodingFormat = currEncodingFormat
 	}()
 
 	enc.EncodeIndexInfo(testIndexInfo)
@@ -136,7 +144,7 @@ func TestIndexInfoRoundTripBackwardsCompatibilityV1(t *testing.T) {
 	require.Equal(t, testIndexInfo, res)
 }
 
-// Make sure the V1 decoder code can handle the V4 file format.
+// Make sure the V1 decoder code can handle the V5 file format.
 func TestIndexInfoRoundTripForwardsCompatibilityV1(t *testing.T) {
 	var (
 		opts = legacyEncodingOptions{decodeLegacyIndexInfoVersion: legacyEncodingIndexVersionV1}
@@ -148,10 +156,12 @@ func TestIndexInfoRoundTripForwardsCompatibilityV1(t *testing.T) {
 	// and then restore them at the end of the test - This is required
 	// because the old decoder won't read the new fields
 	var (
-		currSnapshotTime = testIndexInfo.SnapshotTime
-		currFileType     = testIndexInfo.FileType
-		currSnapshotID   = testIndexInfo.SnapshotID
-		currVolumeIndex  = testIndexInfo.VolumeIndex
+		currSnapshotTime          = testIndexInfo.SnapshotTime
+		currFileType              = testIndexInfo.FileType
+		currSnapshotID            = testIndexInfo.SnapshotID
+		currVolumeIndex           = testIndexInfo.VolumeIndex
+		currDownsampledResolution = testIndexInfo.DownsampledResolution
+		currEncodingFormat        = testIndexInfo.EncodingFormat
 	)
 
 	enc.EncodeIndexInfo(testIndexInfo)
@@ -162,11 +172,15 @@ func TestIndexInfoRoundTripForwardsCompatibilityV1(t *testing.T) {
 	testIndexInfo.FileType = 0
 	testIndexInfo.SnapshotID = nil
 	testIndexInfo.VolumeIndex = 0
+	testIndexInfo.DownsampledResolution = 0
+	testIndexInfo.EncodingFormat = ""
 	defer func() {
 		testIndexInfo.SnapshotTime = currSnapshotTime
 		testIndexInfo.FileType = currFileType
 		testIndexInfo.SnapshotID = currSnapshotID
 		testIndexInfo.VolumeIndex = currVolumeIndex
+		testIndexInfo.DownsampledResolution = currDownsampledResolution
+		testIndexInfo.EncodingFormat = currEncodingFormat
 	}()
 
 	dec.Reset(NewByteDecoderStream(enc.Bytes()))
@@ -175,7 +189,7 @@ func TestIndexInfoRoundTripForwardsCompatibilityV1(t *testing.T) {
 	require.Equal(t, testIndexInfo, res)
 }
 
-// Make sure the V4 decoding code can handle the V2 file format.
+// Make sure the V5 decoding code can handle the V2 file format.
 func TestIndexInfoRoundTripBackwardsCompatibilityV2(t *testing.T) {
 	var (
 		opts = legacyEncodingOptions{encodeLegacyIndexInfoVersion: legacyEncodingIndexVersionV2}
@@ -188,20 +202,26 @@ func TestIndexInfoRoundTripBackwardsCompatibilityV2(t *testing.T) {
 	// because the new decoder won't try and read the new fields from
 	// the old file format.
 	var (
-		currSnapshotTime = testIndexInfo.SnapshotTime
-		currFileType     = testIndexInfo.FileType
-		currSnapshotID   = testIndexInfo.SnapshotID
-		currVolumeIndex  = testIndexInfo.VolumeIndex
+		currSnapshotTime          = testIndexInfo.SnapshotTime
+		currFileType              = testIndexInfo.FileType
+		currSnapshotID            = testIndexInfo.SnapshotID
+		currVolumeIndex           = testIndexInfo.VolumeIndex
+		currDownsampledResolution = testIndexInfo.DownsampledResolution
+		currEncodingFormat        = testIndexInfo.EncodingFormat
 	)
 	testIndexInfo.SnapshotTime = 0
 	testIndexInfo.FileType = 0
 	testIndexInfo.SnapshotID = nil
 	testIndexInfo.VolumeIndex = 0
+	testIndexInfo.DownsampledResolution = 0
+	testIndexInfo.EncodingFormat = ""
 	defer func() {
 		testIndexInfo.SnapshotTime = currSnapshotTime
 		testIndexInfo.FileType = currFileType
 		testIndexInfo.SnapshotID = currSnapshotID
 		testIndexInfo.VolumeIndex = currVolumeIndex
+		testIndexInfo.DownsampledResolution = currDownsampledResolution
+		testIndexInfo.EncodingFormat = currEncodingFormat
 	}()
 
 	enc.EncodeIndexInfo(testIndexInfo)
@@ -211,7 +231,7 @@ func TestIndexInfoRoundTripBackwardsCompatibilityV2(t *testing.T) {
 	require.Equal(t, testIndexInfo, res)
 }
 
-// Make sure the V2 decoder code can handle the V4 file format.
+// Make sure the V2 decoder code can handle the V5 file format.
 func TestIndexInfoRoundTripForwardsCompatibilityV2(t *testing.T) {
 	var (
 		opts = legacyEncodingOptions{decodeLegacyIndexInfoVersion: legacyEncodingIndexVersionV2}
@@ -224,6 +244,8 @@ func TestIndexInfoRoundTripForwardsCompatibilityV2(t *testing.T) {
 	// because the old decoder won't read the new fields.
 	currSnapshotID := testIndexInfo.SnapshotID
 	currVolumeIndex := testIndexInfo.VolumeIndex
+	currDownsampledResolution := testIndexInfo.DownsampledResolution
+	currEncodingFormat := testIndexInfo.EncodingFormat
 
 	enc.EncodeIndexInfo(testIndexInfo)
 
@@ -231,9 +253,13 @@ func TestIndexInfoRoundTripForwardsCompatibilityV2(t *testing.T) {
 	// encoded the data.
 	testIndexInfo.SnapshotID = nil
 	testIndexInfo.VolumeIndex = 0
+	testIndexInfo.DownsampledResolution = 0
+	testIndexInfo.EncodingFormat = ""
 	defer func() {
 		testIndexInfo.SnapshotID = currSnapshotID
 		testIndexInfo.VolumeIndex = currVolumeIndex
+		testIndexInfo.DownsampledResolution = currDownsampledResolution
+		testIndexInfo.EncodingFormat = currEncodingFormat
 	}()
 
 	dec.Reset(NewByteDecoderStream(enc.Bytes()))
@@ -242,7 +268,7 @@ func TestIndexInfoRoundTripForwardsCompatibilityV2(t *testing.T) {
 	require.Equal(t, testIndexInfo, res)
 }
 
-// Make sure the V4 decoding code can handle the V3 file format.
+// Make sure the V5 decoding code can handle the V3 file format.
 func TestIndexInfoRoundTripBackwardsCompatibilityV3(t *testing.T) {
 	var (
 		opts = legacyEncodingOptions{encodeLegacyIndexInfoVersion: legacyEncodingIndexVersionV3}
@@ -255,11 +281,17 @@ func TestIndexInfoRoundTripBackwardsCompatibilityV3(t *testing.T) {
 	// because the new decoder won't try and read the new fields from
 	// the old file format.
 	var (
-		currVolumeIndex = testIndexInfo.VolumeIndex
+		currVolumeIndex           = testIndexInfo.VolumeIndex
+		currDownsampledResolution = testIndexInfo.DownsampledResolution
+		currEncodingFormat        = testIndexInfo.EncodingFormat
 	)
 	testIndexInfo.VolumeIndex = 0
+	testIndexInfo.DownsampledResolution = 0
+	testIndexInfo.EncodingFormat = ""
 	defer func() {
 		testIndexInfo.VolumeIndex = currVolumeIndex
+		testIndexInfo.DownsampledResolution = currDownsampledResolution
+		testIndexInfo.EncodingFormat = currEncodingFormat
 	}()
 
 	enc.EncodeIndexInfo(testIndexInfo)
@@ -269,7 +301,7 @@ func TestIndexInfoRoundTripBackwardsCompatibilityV3(t *testing.T) {
 	require.Equal(t, testIndexInfo, res)
 }
 
-// Make sure the V3 decoder code can handle the V4 file format.
+// Make sure the V3 decoder code can handle the V5 file format.
 func TestIndexInfoRoundTripForwardsCompatibilityV3(t *testing.T) {
 	var (
 		opts = legacyEncodingOptions{decodeLegacyIndexInfoVersion: legacyEncodingIndexVersionV3}
@@ -281,14 +313,79 @@ func TestIndexInfoRoundTripForwardsCompatibilityV3(t *testing.T) {
 	// and then restore them at the end of the test - This is required
 	// because the old decoder won't read the new fields.
 	currVolumeIndex := testIndexInfo.VolumeIndex
+	currDownsampledResolution := testIndexInfo.DownsampledResolution
+	currEncodingFormat := testIndexInfo.EncodingFormat
 
 	enc.EncodeIndexInfo(testIndexInfo)
 
 	// Make sure to zero them before we compare, but after we have
 	// encoded the data.
 	testIndexInfo.VolumeIndex = 0
+	testIndexInfo.DownsampledResolution = 0
+	testIndexInfo.EncodingFormat = ""
 	defer func() {
 		testIndexInfo.VolumeIndex = currVolumeIndex
+		testIndexInfo.DownsampledResolution = currDownsampledResolution
+		testIndexInfo.EncodingFormat = currEncodingFormat
+	}()
+
+	dec.Reset(NewByteDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeIndexInfo()
+	require.NoError(t, err)
+	require.Equal(t, testIndexInfo, res)
+}
+
+// Make sure the V5 decoding code can handle the V4 file format.
+func TestIndexInfoRoundTripBackwardsCompatibilityV4(t *testing.T) {
+	var (
+		opts = legacyEncodingOptions{encodeLegacyIndexInfoVersion: legacyEncodingIndexVersionV4}
+		enc  = newEncoder(opts)
+		dec  = newDecoder(opts, nil)
+	)
+
+	// Set the default values on the fields that did not exist in V4,
+	// and then restore them at the end of the test - This is required
+	// because the new decoder won't try and read the new fields from
+	// the old file format.
+	currDownsampledResolution := testIndexInfo.DownsampledResolution
+	currEncodingFormat := testIndexInfo.EncodingFormat
+	testIndexInfo.DownsampledResolution = 0
+	testIndexInfo.EncodingFormat = ""
+	defer func() {
+		testIndexInfo.DownsampledResolution = currDownsampledResolution
+		testIndexInfo.EncodingFormat = currEncodingFormat
+	}()
+
+	enc.EncodeIndexInfo(testIndexInfo)
+	dec.Reset(NewByteDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeIndexInfo()
+	require.NoError(t, err)
+	require.Equal(t, testIndexInfo, res)
+}
+
+// Make sure the V4 decoder code can handle the V5 file format.
+func TestIndexInfoRoundTripForwardsCompatibilityV4(t *testing.T) {
+	var (
+		opts = legacyEncodingOptions{decodeLegacyIndexInfoVersion: legacyEncodingIndexVersionV4}
+		enc  = newEncoder(opts)
+		dec  = newDecoder(opts, nil)
+	)
+
+	// Set the default values on the fields that did not exist in V4
+	// and then restore them at the end of the test - This is required
+	// because the old decoder won't read the new fields.
+	currDownsampledResolution := testIndexInfo.DownsampledResolution
+	currEncodingFormat := testIndexInfo.EncodingFormat
+
+	enc.EncodeIndexInfo(testIndexInfo)
+
+	// Make sure to zero them before we compare, but after we have
+	// encoded the data.
+	testIndexInfo.DownsampledResolution = 0
+	testIndexInfo.EncodingFormat = ""
+	defer func() {
+		testIndexInfo.DownsampledResolution = currDownsampledResolution
+		testIndexInfo.EncodingFormat = currEncodingFormat
 	}()
 
 	dec.Reset(NewByteDecoderStream(enc.Bytes()))
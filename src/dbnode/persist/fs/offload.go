@@ -0,0 +1,414 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs/remote"
+	xerrors "github.com/m3db/m3/src/x/errors"
+
+	"go.uber.org/zap"
+)
+
+const hydrateTempFilePattern = ".hydrate-*"
+
+// offloadedFileSuffixes are the suffixes of the data fileset files that are
+// evicted from local disk once a fileset has been offloaded. The info and
+// checkpoint files are always kept on local disk so that the existence and
+// metadata of offloaded filesets can be determined without the remote store.
+var offloadedFileSuffixes = []string{
+	indexFileSuffix,
+	summariesFileSuffix,
+	bloomFilterFileSuffix,
+	dataFileSuffix,
+	digestFileSuffix,
+}
+
+// hydrating tracks the data files that are being downloaded in the background
+// so that concurrent seekers of the same fileset only download it once.
+var hydrating = struct {
+	sync.Mutex
+	paths map[string]struct{}
+}{paths: make(map[string]struct{})}
+
+// OffloadDataFileSet uploads the files of a complete data fileset to the
+// remote store and evicts the bulk of its files from local disk, returning
+// whether any files were evicted. Offloaded filesets are transparently
+// downloaded again when they are next opened for reading.
+func OffloadDataFileSet(opts Options, id FileSetFileIdentifier) (bool, error) {
+	store := opts.RemoteStore()
+	if store == nil {
+		return false, nil
+	}
+
+	var (
+		filePathPrefix = opts.FilePathPrefix()
+		shardDir       = ShardDataDirPath(filePathPrefix, id.Namespace, id.Shard)
+		isLegacy       bool
+		err            error
+	)
+	if id.VolumeIndex == 0 {
+		isLegacy, err = isFirstVolumeLegacy(shardDir, id.BlockStart, checkpointFileSuffix)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	pathFn := func(suffix string) string {
+		return dataFilesetPathFromTimeAndIndex(shardDir, id.BlockStart, id.VolumeIndex, suffix, isLegacy)
+	}
+
+	checkpointPath := pathFn(checkpointFileSuffix)
+	complete, err := CompleteCheckpointFileExists(checkpointPath)
+	if err != nil {
+		return false, err
+	}
+	if !complete {
+		// Only offload filesets that have been completely written out.
+		return false, nil
+	}
+
+	dataExists, err := FileExists(pathFn(dataFileSuffix))
+	if err != nil {
+		return false, err
+	}
+	if !dataExists {
+		// Already offloaded.
+		return false, nil
+	}
+
+	checkpointKey, err := remoteKey(filePathPrefix, checkpointPath)
+	if err != nil {
+		return false, err
+	}
+
+	// The checkpoint file is uploaded last, so if it exists remotely then
+	// the fileset has been uploaded before and the local files are copies
+	// that were downloaded for reading.
+	uploaded, err := store.Exists(checkpointKey)
+	if err != nil {
+		return false, err
+	}
+	if uploaded {
+		// Keep recently downloaded copies on local disk for a while so that
+		// filesets that are actively being read are not evicted and then
+		// immediately downloaded again.
+		stat, err := os.Stat(pathFn(dataFileSuffix))
+		if err != nil {
+			return false, err
+		}
+		now := opts.ClockOptions().NowFn()()
+		if now.Sub(stat.ModTime()) < opts.RemoteHydratedPinDuration() {
+			return false, nil
+		}
+	} else {
+		suffixes := append([]string{infoFileSuffix}, offloadedFileSuffixes...)
+		suffixes = append(suffixes, checkpointFileSuffix)
+		for _, suffix := range suffixes {
+			if err := uploadFile(store, filePathPrefix, pathFn(suffix)); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	evict := make([]string, 0, len(offloadedFileSuffixes))
+	for _, suffix := range offloadedFileSuffixes {
+		evict = append(evict, pathFn(suffix))
+	}
+	if err := DeleteFiles(evict); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteRemoteFiles deletes the remote copies of the given local fileset
+// files, deleting a checkpoint file also deletes the remote copies of the
+// rest of its fileset since they may have already been evicted locally.
+func DeleteRemoteFiles(opts Options, filePaths []string) error {
+	store := opts.RemoteStore()
+	if store == nil {
+		return nil
+	}
+
+	var (
+		filePathPrefix   = opts.FilePathPrefix()
+		checkpointSuffix = checkpointFileSuffix + fileSuffix
+		multiErr         = xerrors.NewMultiError()
+	)
+	for _, filePath := range filePaths {
+		toDelete := []string{filePath}
+		if strings.HasSuffix(filePath, checkpointSuffix) {
+			base := strings.TrimSuffix(filePath, checkpointSuffix)
+			for _, suffix := range offloadedFileSuffixes {
+				toDelete = append(toDelete, base+suffix+fileSuffix)
+			}
+		}
+
+		for _, path := range toDelete {
+			key, err := remoteKey(filePathPrefix, path)
+			if err != nil {
+				multiErr = multiErr.Add(err)
+				continue
+			}
+			multiErr = multiErr.Add(store.Delete(key))
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+// hydrateDataFileSet downloads any of the files of an offloaded data fileset
+// that are missing from local disk from the remote store.
+func hydrateDataFileSet(
+	opts Options,
+	shardDir string,
+	blockStart time.Time,
+	volume int,
+	isLegacy bool,
+) error {
+	return hydrateFiles(opts, shardDir, blockStart, volume, isLegacy, offloadedFileSuffixes)
+}
+
+// hydrateDataFileSetMetadata downloads any of the files of an offloaded data
+// fileset other than the data file that are missing from local disk from the
+// remote store.
+func hydrateDataFileSetMetadata(
+	opts Options,
+	shardDir string,
+	blockStart time.Time,
+	volume int,
+	isLegacy bool,
+) error {
+	suffixes := make([]string, 0, len(offloadedFileSuffixes))
+	for _, suffix := range offloadedFileSuffixes {
+		if suffix != dataFileSuffix {
+			suffixes = append(suffixes, suffix)
+		}
+	}
+	return hydrateFiles(opts, shardDir, blockStart, volume, isLegacy, suffixes)
+}
+
+func hydrateFiles(
+	opts Options,
+	shardDir string,
+	blockStart time.Time,
+	volume int,
+	isLegacy bool,
+	suffixes []string,
+) error {
+	store := opts.RemoteStore()
+	if store == nil {
+		return nil
+	}
+
+	filePathPrefix := opts.FilePathPrefix()
+	for _, suffix := range suffixes {
+		filePath := dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volume, suffix, isLegacy)
+		exists, err := FileExists(filePath)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		if err := downloadFile(store, filePathPrefix, filePath, opts.NewFileMode()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// openDataFile opens the data file of a data fileset. If the data file has
+// been offloaded then reads are served from the remote store with ranged
+// requests while the file is downloaded to local disk in the background, so
+// that opening an offloaded fileset does not block on downloading it.
+func openDataFile(opts Options, filePath string) (dataFile, error) {
+	fd, err := os.Open(filePath)
+	if err == nil || !os.IsNotExist(err) {
+		return fd, err
+	}
+
+	store := opts.RemoteStore()
+	if store == nil {
+		return nil, err
+	}
+
+	filePathPrefix := opts.FilePathPrefix()
+	key, err := remoteKey(filePathPrefix, filePath)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := store.Exists(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("unable to open %s: %v", filePath, remote.ErrNotFound)
+	}
+
+	hydrateInBackground(opts, store, filePath)
+	return &remoteDataFile{store: store, key: key}, nil
+}
+
+func hydrateInBackground(opts Options, store remote.Store, filePath string) {
+	hydrating.Lock()
+	if _, ok := hydrating.paths[filePath]; ok {
+		hydrating.Unlock()
+		return
+	}
+	hydrating.paths[filePath] = struct{}{}
+	hydrating.Unlock()
+
+	go func() {
+		defer func() {
+			hydrating.Lock()
+			delete(hydrating.paths, filePath)
+			hydrating.Unlock()
+		}()
+
+		err := downloadFile(store, opts.FilePathPrefix(), filePath, opts.NewFileMode())
+		if err != nil {
+			opts.InstrumentOptions().Logger().Warn("unable to hydrate offloaded data file",
+				zap.String("path", filePath), zap.Error(err))
+		}
+	}()
+}
+
+// dataFile is the data file of a data fileset, which is either a local
+// file or a file in the remote store.
+type dataFile interface {
+	io.ReaderAt
+	io.Closer
+}
+
+// remoteDataFile reads a data file from the remote store with ranged requests.
+type remoteDataFile struct {
+	store remote.Store
+	key   string
+}
+
+func (f *remoteDataFile) ReadAt(b []byte, off int64) (int, error) {
+	r, err := f.store.GetRange(f.key, off, int64(len(b)))
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.ReadFull(r, b)
+	if closeErr := r.Close(); err == nil {
+		err = closeErr
+	}
+	if err == io.ErrUnexpectedEOF {
+		// Match the semantics of io.ReaderAt for reads past the end of the file.
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (f *remoteDataFile) Close() error {
+	return nil
+}
+
+func remoteKey(filePathPrefix, filePath string) (string, error) {
+	rel, err := filepath.Rel(filePathPrefix, filePath)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("file %s is not within file path prefix %s", filePath, filePathPrefix)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func uploadFile(store remote.Store, filePathPrefix, filePath string) error {
+	key, err := remoteKey(filePathPrefix, filePath)
+	if err != nil {
+		return err
+	}
+
+	fd, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+
+	stat, err := fd.Stat()
+	if err == nil {
+		err = store.Put(key, fd, stat.Size())
+	}
+	if closeErr := fd.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to upload %s to remote store: %v", filePath, err)
+	}
+	return nil
+}
+
+func downloadFile(
+	store remote.Store,
+	filePathPrefix string,
+	filePath string,
+	fileMode os.FileMode,
+) error {
+	key, err := remoteKey(filePathPrefix, filePath)
+	if err != nil {
+		return err
+	}
+
+	r, err := store.Get(key)
+	if err != nil {
+		return fmt.Errorf("unable to download %s from remote store: %v", filePath, err)
+	}
+	defer r.Close()
+
+	// Download to a temporary file and rename so that concurrent readers
+	// never observe a partially downloaded file.
+	tmp, err := ioutil.TempFile(filepath.Dir(filePath), hydrateTempFilePattern)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), fileMode)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filePath)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("unable to download %s from remote store: %v", filePath, err)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs/remote"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRemoteStore(t *testing.T) (remote.Store, string) {
+	dir, err := ioutil.TempDir("", "testremotestore")
+	require.NoError(t, err)
+	store, err := remote.NewDirectoryStore(dir)
+	require.NoError(t, err)
+	return store, dir
+}
+
+func TestOffloadDataFileSetAndHydrateOnRead(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	store, remoteDir := newTestRemoteStore(t)
+	defer os.RemoveAll(remoteDir)
+
+	entries := []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+		{"bar", map[string]string{"qux": "qaz"}, []byte{4, 5, 6}},
+	}
+	w := newTestWriter(t, filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, entries, persist.FileSetFlushType)
+
+	opts := testDefaultOpts.
+		SetFilePathPrefix(filePathPrefix).
+		SetRemoteStore(store).
+		SetRemoteOffloadAfter(time.Hour)
+	id := FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		Shard:      0,
+		BlockStart: testWriterStart,
+	}
+
+	offloaded, err := OffloadDataFileSet(opts, id)
+	require.NoError(t, err)
+	require.True(t, offloaded)
+
+	// Only the info and checkpoint files remain on local disk.
+	shardDir := ShardDataDirPath(filePathPrefix, testNs1ID, 0)
+	for _, suffix := range offloadedFileSuffixes {
+		path := filesetPathFromTimeAndIndex(shardDir, testWriterStart, 0, suffix)
+		exists, err := FileExists(path)
+		require.NoError(t, err)
+		require.False(t, exists, path)
+	}
+	exists, err := DataFileSetExists(filePathPrefix, testNs1ID, 0, testWriterStart, 0)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// Offloading again is a no-op.
+	offloaded, err = OffloadDataFileSet(opts, id)
+	require.NoError(t, err)
+	require.False(t, offloaded)
+
+	// Reading the fileset transparently downloads it from the remote store.
+	r, err := NewReader(testBytesPool, opts.
+		SetInfoReaderBufferSize(testReaderBufferSize).
+		SetDataReaderBufferSize(testReaderBufferSize))
+	require.NoError(t, err)
+	readTestData(t, r, 0, testWriterStart, entries)
+
+	// The downloaded copy is pinned to local disk for a while.
+	offloaded, err = OffloadDataFileSet(opts, id)
+	require.NoError(t, err)
+	require.False(t, offloaded)
+
+	// Offloading the downloaded copy once unpinned evicts it without
+	// uploading again.
+	offloaded, err = OffloadDataFileSet(opts.SetRemoteHydratedPinDuration(0), id)
+	require.NoError(t, err)
+	require.True(t, offloaded)
+
+	// Deleting the local fileset files also deletes the remote copies.
+	files, err := DataFiles(filePathPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	require.NoError(t, DeleteRemoteFiles(opts, files.Filepaths()))
+	for _, suffix := range append(offloadedFileSuffixes, infoFileSuffix, checkpointFileSuffix) {
+		key, err := remoteKey(filePathPrefix,
+			filesetPathFromTimeAndIndex(shardDir, testWriterStart, 0, suffix))
+		require.NoError(t, err)
+		exists, err := store.Exists(key)
+		require.NoError(t, err)
+		require.False(t, exists, key)
+	}
+}
+
+func TestOffloadDataFileSetSeekWhileHydrating(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	store, remoteDir := newTestRemoteStore(t)
+	defer os.RemoveAll(remoteDir)
+
+	entries := []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+		{"bar", map[string]string{"qux": "qaz"}, []byte{4, 5, 6}},
+	}
+	w := newTestWriter(t, filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, entries, persist.FileSetFlushType)
+
+	opts := testDefaultOpts.
+		SetFilePathPrefix(filePathPrefix).
+		SetRemoteStore(store).
+		SetRemoteOffloadAfter(time.Hour)
+	offloaded, err := OffloadDataFileSet(opts, FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		Shard:      0,
+		BlockStart: testWriterStart,
+	})
+	require.NoError(t, err)
+	require.True(t, offloaded)
+
+	// Seeking does not wait for the data file to be downloaded.
+	resources := newTestReusableSeekerResources()
+	s := NewSeeker(filePathPrefix, testReaderBufferSize, testReaderBufferSize,
+		testBytesPool, false, opts)
+	require.NoError(t, s.Open(testNs1ID, 0, testWriterStart, 0, resources))
+	for _, entry := range entries {
+		data, err := s.SeekByID(ident.StringID(entry.id), resources)
+		require.NoError(t, err)
+		data.IncRef()
+		require.Equal(t, entry.data, data.Bytes())
+		data.DecRef()
+	}
+	require.NoError(t, s.Close())
+
+	// The data file is downloaded in the background.
+	dataPath := filesetPathFromTimeAndIndex(
+		ShardDataDirPath(filePathPrefix, testNs1ID, 0), testWriterStart, 0, dataFileSuffix)
+	for start := time.Now(); time.Since(start) < 10*time.Second; {
+		if exists, err := FileExists(dataPath); err == nil && exists {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	exists, err := FileExists(dataPath)
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+func TestOffloadDataFileSetWithoutRemoteStore(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	w := newTestWriter(t, filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+	}, persist.FileSetFlushType)
+
+	offloaded, err := OffloadDataFileSet(testDefaultOpts.SetFilePathPrefix(filePathPrefix),
+		FileSetFileIdentifier{
+			Namespace:  testNs1ID,
+			Shard:      0,
+			BlockStart: testWriterStart,
+		})
+	require.NoError(t, err)
+	require.False(t, offloaded)
+
+	path := filesetPathFromTimeAndIndex(ShardDataDirPath(filePathPrefix, testNs1ID, 0),
+		testWriterStart, 0, dataFileSuffix)
+	exists, err := FileExists(path)
+	require.NoError(t, err)
+	require.True(t, exists)
+}
@@ -24,9 +24,11 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/m3db/m3/src/dbnode/clock"
 	"github.com/m3db/m3/src/dbnode/persist/fs/msgpack"
+	"github.com/m3db/m3/src/dbnode/persist/fs/remote"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst"
 	"github.com/m3db/m3/src/x/instrument"
@@ -67,6 +69,10 @@ const (
 	// defaultForceIndexBloomFilterMmapMemory is the default configuration for whether the bytes for the bloom filter
 	// should be mmap'd as an anonymous region (forced completely into memory) or mmap'd as a file.
 	defaultForceIndexBloomFilterMmapMemory = false
+
+	// defaultRemoteHydratedPinDuration is the default duration that data filesets downloaded
+	// from the remote store are kept on local disk before they are eligible to be evicted again.
+	defaultRemoteHydratedPinDuration = time.Hour
 )
 
 var (
@@ -76,6 +82,8 @@ var (
 
 	errTagEncoderPoolNotSet = errors.New("tag encoder pool is not set")
 	errTagDecoderPoolNotSet = errors.New("tag decoder pool is not set")
+
+	errRemoteOffloadAfterNotPositive = errors.New("remote offload after must be positive when remote store is set")
 )
 
 type options struct {
@@ -100,6 +108,9 @@ type options struct {
 	forceBloomFilterMmapMemory           bool
 	mmapEnableHugePages                  bool
 	mmapReporter                         mmap.Reporter
+	remoteStore                          remote.Store
+	remoteOffloadAfter                   time.Duration
+	remoteHydratedPinDuration            time.Duration
 }
 
 // NewOptions creates a new set of fs options
@@ -134,6 +145,7 @@ func NewOptions() Options {
 		tagEncoderPool:                       tagEncoderPool,
 		tagDecoderPool:                       tagDecoderPool,
 		fstOptions:                           fstOptions,
+		remoteHydratedPinDuration:            defaultRemoteHydratedPinDuration,
 	}
 }
 
@@ -154,6 +166,9 @@ func (o *options) Validate() error {
 	if o.tagDecoderPool == nil {
 		return errTagDecoderPoolNotSet
 	}
+	if o.remoteStore != nil && o.remoteOffloadAfter <= 0 {
+		return errRemoteOffloadAfterNotPositive
+	}
 	return nil
 }
 
@@ -366,3 +381,33 @@ func (o *options) SetMmapReporter(mmapReporter mmap.Reporter) Options {
 func (o *options) MmapReporter() mmap.Reporter {
 	return o.mmapReporter
 }
+
+func (o *options) SetRemoteStore(value remote.Store) Options {
+	opts := *o
+	opts.remoteStore = value
+	return &opts
+}
+
+func (o *options) RemoteStore() remote.Store {
+	return o.remoteStore
+}
+
+func (o *options) SetRemoteOffloadAfter(value time.Duration) Options {
+	opts := *o
+	opts.remoteOffloadAfter = value
+	return &opts
+}
+
+func (o *options) RemoteOffloadAfter() time.Duration {
+	return o.remoteOffloadAfter
+}
+
+func (o *options) SetRemoteHydratedPinDuration(value time.Duration) Options {
+	opts := *o
+	opts.remoteHydratedPinDuration = value
+	return &opts
+}
+
+func (o *options) RemoteHydratedPinDuration() time.Duration {
+	return o.remoteHydratedPinDuration
+}
@@ -483,6 +483,8 @@ func (pm *persistManager) PrepareData(opts persist.DataPrepareOptions) (persist.
 			BlockStart:  blockStart,
 			VolumeIndex: volumeIndex,
 		},
+		DownsampledResolution: opts.DownsampledResolution,
+		EncodingFormat:        opts.EncodingFormat,
 	}
 	if err := pm.dataPM.writer.Open(dataWriterOpts); err != nil {
 		return prepared, err
@@ -563,7 +565,10 @@ func (pm *persistManager) DoneFlush() error {
 
 // DoneSnapshot is called by the databaseFlushManager to finish the snapshot persist process.
 func (pm *persistManager) DoneSnapshot(
-	snapshotUUID uuid.UUID, commitLogIdentifier persist.CommitLogFile) error {
+	snapshotUUID uuid.UUID,
+	commitLogIdentifier persist.CommitLogFile,
+	highWaterMarks []persist.SnapshotHighWaterMark,
+) error {
 	pm.Lock()
 	defer pm.Unlock()
 
@@ -589,6 +594,7 @@ func (pm *persistManager) DoneSnapshot(
 			UUID:  snapshotUUID,
 		},
 		CommitlogIdentifier: commitLogIdentifier,
+		HighWaterMarks:      highWaterMarks,
 	})
 	if err != nil {
 		return fmt.Errorf("error writing out snapshot metadata file: %v", err)
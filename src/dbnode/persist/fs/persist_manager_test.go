@@ -273,7 +273,7 @@ func TestPersistenceManagerPrepareSnapshotSuccess(t *testing.T) {
 	require.NoError(t, err)
 
 	defer func() {
-		assert.NoError(t, flush.DoneSnapshot(nil, persist.CommitLogFile{}))
+		assert.NoError(t, flush.DoneSnapshot(nil, persist.CommitLogFile{}, nil))
 	}()
 
 	now := time.Now()
@@ -98,6 +98,7 @@ type reader struct {
 	expectedBloomFilterDigest uint32
 	shard                     uint32
 	volume                    int
+	encodingFormat            string
 	open                      bool
 }
 
@@ -177,6 +178,11 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 		bloomFilterFilepath = dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, bloomFilterFileSuffix, isLegacy)
 		indexFilepath = dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, indexFileSuffix, isLegacy)
 		dataFilepath = dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, dataFileSuffix, isLegacy)
+
+		// Download the fileset from the remote store if it has been offloaded.
+		if err := hydrateDataFileSet(r.opts, shardDir, blockStart, volumeIndex, isLegacy); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unable to open reader with fileset type: %s", opts.FileSetType)
 	}
@@ -325,6 +331,7 @@ func (r *reader) readInfo(size int) error {
 	r.entriesRead = 0
 	r.metadataRead = 0
 	r.bloomFilterInfo = info.BloomFilter
+	r.encodingFormat = info.EncodingFormat
 	return nil
 }
 
@@ -486,6 +493,10 @@ func (r *reader) MetadataRead() int {
 	return r.metadataRead
 }
 
+func (r *reader) EncodingFormat() string {
+	return r.encodingFormat
+}
+
 func (r *reader) Close() error {
 	// Close and prepare resources that are to be reused
 	multiErr := xerrors.NewMultiError()
@@ -392,6 +392,34 @@ func TestInfoReadWriteVolumeIndex(t *testing.T) {
 	require.Equal(t, int64(len(entries)), infoFile.Entries)
 }
 
+func TestInfoReadWriteVolumeMarkers(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	w := newTestWriter(t, filePathPrefix)
+	err := w.Open(DataWriterOpenOptions{
+		Identifier: FileSetFileIdentifier{
+			Namespace:   testNs1ID,
+			Shard:       0,
+			BlockStart:  testWriterStart,
+			VolumeIndex: 1,
+		},
+		BlockSize:             testBlockSize,
+		FileSetType:           persist.FileSetFlushType,
+		DownsampledResolution: time.Minute,
+		EncodingFormat:        EncodingFormatM3TSZ,
+	})
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	readInfoFileResults := ReadInfoFiles(filePathPrefix, testNs1ID, 0, 16, nil)
+	require.Equal(t, 1, len(readInfoFileResults))
+	require.NoError(t, readInfoFileResults[0].Err.Error())
+	require.Equal(t, int64(time.Minute), readInfoFileResults[0].Info.DownsampledResolution)
+	require.Equal(t, EncodingFormatM3TSZ, readInfoFileResults[0].Info.EncodingFormat)
+}
+
 func TestInfoReadWriteSnapshot(t *testing.T) {
 	dir := createTempDir(t)
 	filePathPrefix := filepath.Join(dir, "")
@@ -0,0 +1,90 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errConfigurationNoStore       = errors.New("remote store configuration requires a directory or s3 store")
+	errConfigurationMultipleStore = errors.New("remote store configuration must specify only one of directory or s3 store")
+)
+
+// Configuration is the configuration for offloading filesets to a
+// remote store.
+type Configuration struct {
+	// OffloadAfter is how long after a block has ended before its filesets
+	// are offloaded to the remote store and evicted from local disk.
+	OffloadAfter time.Duration `yaml:"offloadAfter" validate:"nonzero"`
+
+	// HydratedPinDuration is how long filesets downloaded from the remote
+	// store for reading are kept on local disk before being evicted again.
+	HydratedPinDuration *time.Duration `yaml:"hydratedPinDuration"`
+
+	// Directory configures a store backed by a local directory, such as a
+	// mounted network volume.
+	Directory *string `yaml:"directory"`
+
+	// S3 configures a store backed by an S3 compatible API.
+	S3 *S3Configuration `yaml:"s3"`
+}
+
+// S3Configuration is the configuration for an S3 compatible store.
+type S3Configuration struct {
+	Endpoint        string `yaml:"endpoint" validate:"nonzero"`
+	Bucket          string `yaml:"bucket" validate:"nonzero"`
+	Region          string `yaml:"region"`
+	Prefix          string `yaml:"prefix"`
+	AccessKeyID     string `yaml:"accessKeyID"`
+	SecretAccessKey string `yaml:"secretAccessKey"`
+
+	// Timeout is the timeout for a single request, defaults to 10 minutes.
+	Timeout time.Duration `yaml:"timeout"`
+
+	// PartSize is the size of the parts that large objects are uploaded in,
+	// defaults to 512MiB.
+	PartSize int64 `yaml:"partSize"`
+}
+
+// NewStore returns a new store from the configuration.
+func (c Configuration) NewStore() (Store, error) {
+	switch {
+	case c.Directory != nil && c.S3 != nil:
+		return nil, errConfigurationMultipleStore
+	case c.Directory != nil:
+		return NewDirectoryStore(*c.Directory)
+	case c.S3 != nil:
+		return NewS3Store(S3Options{
+			Endpoint:        c.S3.Endpoint,
+			Bucket:          c.S3.Bucket,
+			Region:          c.S3.Region,
+			Prefix:          c.S3.Prefix,
+			AccessKeyID:     c.S3.AccessKeyID,
+			SecretAccessKey: c.S3.SecretAccessKey,
+			Timeout:         c.S3.Timeout,
+			PartSize:        c.S3.PartSize,
+		})
+	default:
+		return nil, errConfigurationNoStore
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryStorePutGetExistsDelete(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remote-directory-store")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewDirectoryStore(dir)
+	require.NoError(t, err)
+
+	testStoreRoundTrip(t, store)
+
+	// Objects are stored under the root using the key as a relative path.
+	require.NoError(t, store.Put("a/b/c", bytes.NewReader([]byte("foo")), 3))
+	data, err := ioutil.ReadFile(filepath.Join(dir, "a", "b", "c"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("foo"), data)
+}
+
+func TestDirectoryStoreRejectsKeysOutsideRoot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remote-directory-store")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewDirectoryStore(dir)
+	require.NoError(t, err)
+
+	require.Error(t, store.Put("../escape", bytes.NewReader(nil), 0))
+	_, err = store.Get("")
+	require.Error(t, err)
+}
+
+func testStoreRoundTrip(t *testing.T, store Store) {
+	const key = "data/ns/0/fileset-1-0-data.db"
+
+	exists, err := store.Exists(key)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = store.Get(key)
+	require.Equal(t, ErrNotFound, err)
+	_, err = store.GetRange(key, 0, 1)
+	require.Equal(t, ErrNotFound, err)
+
+	data := []byte("some fileset data")
+	require.NoError(t, store.Put(key, bytes.NewReader(data), int64(len(data))))
+
+	exists, err = store.Exists(key)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	r, err := store.Get(key)
+	require.NoError(t, err)
+	read, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, data, read)
+
+	r, err = store.GetRange(key, 5, 7)
+	require.NoError(t, err)
+	read, err = ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, []byte("fileset"), read)
+
+	// Empty objects are valid.
+	require.NoError(t, store.Put(key, bytes.NewReader(nil), 0))
+	r, err = store.Get(key)
+	require.NoError(t, err)
+	read, err = ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, 0, len(read))
+
+	require.NoError(t, store.Delete(key))
+	exists, err = store.Exists(key)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Deleting a missing object is not an error.
+	require.NoError(t, store.Delete(key))
+}
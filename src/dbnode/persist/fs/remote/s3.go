@@ -0,0 +1,495 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3Service           = "s3"
+	s3SigningAlgorithm  = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload   = "UNSIGNED-PAYLOAD"
+	s3AmzDateFormat     = "20060102T150405Z"
+	s3ScopeDateFormat   = "20060102"
+	s3DefaultRegion     = "us-east-1"
+	s3MaxErrorBodyBytes = 1024
+
+	// s3DefaultTimeout is the default timeout for a single request, it is
+	// generous since a request may download an entire fileset file.
+	s3DefaultTimeout = 10 * time.Minute
+
+	// s3DefaultPartSize is the default size of the parts of a multipart upload,
+	// it allows for objects up to ~5TB which is the maximum S3 object size.
+	s3DefaultPartSize = 512 << 20
+
+	// s3MinPartSize is the minimum size of all but the last part of a
+	// multipart upload.
+	s3MinPartSize = 5 << 20
+
+	// s3MaxPutSize is the maximum size of an object uploaded with a single
+	// request, larger objects must use a multipart upload.
+	s3MaxPutSize = 5 << 30
+)
+
+var (
+	errS3EndpointNotSet   = errors.New("s3 endpoint not set")
+	errS3BucketNotSet     = errors.New("s3 bucket not set")
+	errS3PartSizeTooSmall = fmt.Errorf("s3 part size must be at least %d bytes", s3MinPartSize)
+	errS3PartSizeTooLarge = fmt.Errorf("s3 part size must be at most %d bytes", s3MaxPutSize)
+)
+
+// S3Options is a set of options for a store backed by an S3 compatible
+// object storage API (e.g. AWS S3 or minio).
+type S3Options struct {
+	// Endpoint is the URL of the API, e.g. https://s3.us-east-1.amazonaws.com.
+	// Buckets are always addressed with path style requests.
+	Endpoint string
+
+	// Bucket is the bucket to store objects in.
+	Bucket string
+
+	// Region is the region used for signing requests, defaults to us-east-1.
+	Region string
+
+	// Prefix is an optional prefix prepended to all object keys.
+	Prefix string
+
+	// AccessKeyID is the access key ID used for signing requests.
+	AccessKeyID string
+
+	// SecretAccessKey is the secret access key used for signing requests.
+	SecretAccessKey string
+
+	// Timeout is the timeout for a single request when HTTPClient is not
+	// set, defaults to 10 minutes.
+	Timeout time.Duration
+
+	// PartSize is the size of the parts that objects larger than it are
+	// split into and uploaded with a multipart upload, defaults to 512MiB.
+	PartSize int64
+
+	// HTTPClient is the client used to make requests, defaults to a client
+	// with the configured timeout.
+	HTTPClient *http.Client
+
+	// NowFn is the function used to get the time when signing requests,
+	// defaults to time.Now.
+	NowFn func() time.Time
+}
+
+type s3Store struct {
+	opts     S3Options
+	endpoint *url.URL
+}
+
+// NewS3Store returns a new store backed by an S3 compatible object storage
+// API, requests are signed with AWS Signature Version 4.
+func NewS3Store(opts S3Options) (Store, error) {
+	if opts.Endpoint == "" {
+		return nil, errS3EndpointNotSet
+	}
+	if opts.Bucket == "" {
+		return nil, errS3BucketNotSet
+	}
+	endpoint, err := url.Parse(opts.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %v", err)
+	}
+	if opts.Region == "" {
+		opts.Region = s3DefaultRegion
+	}
+	if opts.PartSize == 0 {
+		opts.PartSize = s3DefaultPartSize
+	}
+	if opts.PartSize < s3MinPartSize {
+		return nil, errS3PartSizeTooSmall
+	}
+	if opts.PartSize > s3MaxPutSize {
+		return nil, errS3PartSizeTooLarge
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = s3DefaultTimeout
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: opts.Timeout}
+	}
+	if opts.NowFn == nil {
+		opts.NowFn = time.Now
+	}
+	return &s3Store{opts: opts, endpoint: endpoint}, nil
+}
+
+func (s *s3Store) Put(key string, r io.Reader, size int64) error {
+	if size > s.opts.PartSize {
+		return s.putMultipart(key, r, size)
+	}
+
+	req, err := s.newRequest(http.MethodPut, key, nil, r)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		// Ensure the content length header is still sent for empty objects.
+		req.Body = http.NoBody
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	return drainAndClose(resp.Body)
+}
+
+type s3InitiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type s3CompleteMultipartUpload struct {
+	XMLName xml.Name       `xml:"CompleteMultipartUpload"`
+	Parts   []s3UploadPart `xml:"Part"`
+}
+
+type s3UploadPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// putMultipart uploads an object in parts, which is required for objects
+// larger than 5GB. The upload is aborted if any of the parts fail so that
+// the uploaded parts are not left behind in the bucket.
+func (s *s3Store) putMultipart(key string, r io.Reader, size int64) error {
+	req, err := s.newRequest(http.MethodPost, key, url.Values{"uploads": {""}}, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	var initiated s3InitiateMultipartUploadResult
+	err = xml.NewDecoder(resp.Body).Decode(&initiated)
+	if closeErr := drainAndClose(resp.Body); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to initiate s3 multipart upload for %s: %v", key, err)
+	}
+
+	if err := s.uploadParts(key, initiated.UploadID, r, size); err != nil {
+		req, abortErr := s.newRequest(http.MethodDelete, key,
+			url.Values{"uploadId": {initiated.UploadID}}, nil)
+		if abortErr == nil {
+			resp, abortErr = s.do(req)
+		}
+		if abortErr == nil {
+			drainAndClose(resp.Body)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *s3Store) uploadParts(key, uploadID string, r io.Reader, size int64) error {
+	var complete s3CompleteMultipartUpload
+	for offset, partNumber := int64(0), 1; offset < size; partNumber++ {
+		partSize := s.opts.PartSize
+		if remaining := size - offset; remaining < partSize {
+			partSize = remaining
+		}
+
+		req, err := s.newRequest(http.MethodPut, key, url.Values{
+			"partNumber": {strconv.Itoa(partNumber)},
+			"uploadId":   {uploadID},
+		}, io.LimitReader(r, partSize))
+		if err != nil {
+			return err
+		}
+		req.ContentLength = partSize
+
+		resp, err := s.do(req)
+		if err != nil {
+			return err
+		}
+		complete.Parts = append(complete.Parts, s3UploadPart{
+			PartNumber: partNumber,
+			ETag:       resp.Header.Get("ETag"),
+		})
+		if err := drainAndClose(resp.Body); err != nil {
+			return err
+		}
+		offset += partSize
+	}
+
+	body, err := xml.Marshal(complete)
+	if err != nil {
+		return err
+	}
+	req, err := s.newRequest(http.MethodPost, key,
+		url.Values{"uploadId": {uploadID}}, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+
+	// Completing a multipart upload can fail after the response status has
+	// been sent, in which case the error is returned in the response body.
+	msg, err := ioutil.ReadAll(io.LimitReader(resp.Body, s3MaxErrorBodyBytes))
+	if closeErr := drainAndClose(resp.Body); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if bytes.Contains(msg, []byte("<Error>")) {
+		return fmt.Errorf("s3 multipart upload for %s failed: body=%s", key, string(msg))
+	}
+	return nil
+}
+
+func (s *s3Store) Get(key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *s3Store) GetRange(key string, offset, length int64) (io.ReadCloser, error) {
+	if length <= 0 {
+		return ioutil.NopCloser(strings.NewReader("")), nil
+	}
+
+	req, err := s.newRequest(http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *s3Store) Exists(key string) (bool, error) {
+	req, err := s.newRequest(http.MethodHead, key, nil, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := s.do(req)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, drainAndClose(resp.Body)
+}
+
+func (s *s3Store) Delete(key string) error {
+	req, err := s.newRequest(http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return drainAndClose(resp.Body)
+}
+
+func (s *s3Store) newRequest(
+	method string,
+	key string,
+	query url.Values,
+	body io.Reader,
+) (*http.Request, error) {
+	if s.opts.Prefix != "" {
+		key = strings.TrimSuffix(s.opts.Prefix, "/") + "/" + key
+	}
+
+	u := *s.endpoint
+	u.Path, u.RawPath = "", ""
+	rawURL := u.String() + strings.TrimSuffix(s.endpoint.EscapedPath(), "/") +
+		"/" + uriEncode(s.opts.Bucket, false) + "/" + uriEncode(key, false)
+	if len(query) > 0 {
+		rawURL += "?" + canonicalQueryString(query)
+	}
+
+	req, err := http.NewRequest(method, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, s.opts.NowFn())
+	return req, nil
+}
+
+func (s *s3Store) do(req *http.Request) (*http.Response, error) {
+	resp, err := s.opts.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return resp, nil
+	case resp.StatusCode == http.StatusNotFound:
+		drainAndClose(resp.Body)
+		return nil, ErrNotFound
+	}
+
+	msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, s3MaxErrorBodyBytes))
+	drainAndClose(resp.Body)
+	return nil, fmt.Errorf("s3 %s request for %s failed: status=%d, body=%s",
+		req.Method, req.URL.Path, resp.StatusCode, string(msg))
+}
+
+// sign signs the request using AWS Signature Version 4, the payload is
+// left unsigned so that objects can be streamed without buffering.
+func (s *s3Store) sign(req *http.Request, t time.Time) {
+	var (
+		now       = t.UTC()
+		amzDate   = now.Format(s3AmzDateFormat)
+		scopeDate = now.Format(s3ScopeDateFormat)
+		scope     = strings.Join([]string{scopeDate, s.opts.Region, s3Service, "aws4_request"}, "/")
+	)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", s3UnsignedPayload)
+
+	var (
+		signedHeaders    = "host;x-amz-content-sha256;x-amz-date"
+		canonicalHeaders = "host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + s3UnsignedPayload + "\n" +
+			"x-amz-date:" + amzDate + "\n"
+		canonicalRequest = strings.Join([]string{
+			req.Method,
+			req.URL.EscapedPath(),
+			canonicalQueryString(req.URL.Query()),
+			canonicalHeaders,
+			signedHeaders,
+			s3UnsignedPayload,
+		}, "\n")
+		stringToSign = strings.Join([]string{
+			s3SigningAlgorithm,
+			amzDate,
+			scope,
+			hexSHA256([]byte(canonicalRequest)),
+		}, "\n")
+	)
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.opts.SecretAccessKey), []byte(scopeDate))
+	signingKey = hmacSHA256(signingKey, []byte(s.opts.Region))
+	signingKey = hmacSHA256(signingKey, []byte(s3Service))
+	signingKey = hmacSHA256(signingKey, []byte("aws4_request"))
+	signature := hex.EncodeToString(hmacSHA256(signingKey, []byte(stringToSign)))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, s.opts.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func canonicalQueryString(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		vs := append([]string(nil), values[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode encodes a string as specified by AWS Signature Version 4, every
+// byte except unreserved characters is percent encoded and slashes are only
+// encoded when encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func drainAndClose(body io.ReadCloser) error {
+	_, err := io.Copy(ioutil.Discard, body)
+	if closeErr := body.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
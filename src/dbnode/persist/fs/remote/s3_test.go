@@ -0,0 +1,265 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3Server is a minimal in memory implementation of the S3 object API.
+type fakeS3Server struct {
+	sync.Mutex
+
+	t       *testing.T
+	objects map[string][]byte
+	uploads map[string]map[int][]byte
+	paths   []string
+	aborted int
+
+	// failPart is a part number that fails to upload if set.
+	failPart int
+}
+
+func newFakeS3Server(t *testing.T) *fakeS3Server {
+	return &fakeS3Server{
+		t:       t,
+		objects: make(map[string][]byte),
+		uploads: make(map[string]map[int][]byte),
+	}
+}
+
+func (s *fakeS3Server) serveMultipart(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+	switch {
+	case r.Method == http.MethodPost && uploadID == "":
+		uploadID = fmt.Sprintf("upload-%d", len(s.uploads))
+		s.uploads[uploadID] = make(map[int][]byte)
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", uploadID)
+	case r.Method == http.MethodPut:
+		var partNumber int
+		_, err := fmt.Sscanf(query.Get("partNumber"), "%d", &partNumber)
+		require.NoError(s.t, err)
+		data, err := ioutil.ReadAll(r.Body)
+		require.NoError(s.t, err)
+		require.Equal(s.t, int64(len(data)), r.ContentLength)
+		if partNumber == s.failPart {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.uploads[uploadID][partNumber] = data
+		w.Header().Set("ETag", fmt.Sprintf(`"etag-%d"`, partNumber))
+	case r.Method == http.MethodPost:
+		var complete s3CompleteMultipartUpload
+		require.NoError(s.t, xml.NewDecoder(r.Body).Decode(&complete))
+		var data []byte
+		for _, part := range complete.Parts {
+			require.Equal(s.t, fmt.Sprintf(`"etag-%d"`, part.PartNumber), part.ETag)
+			data = append(data, s.uploads[uploadID][part.PartNumber]...)
+		}
+		delete(s.uploads, uploadID)
+		s.objects[r.URL.Path] = data
+		w.Write([]byte("<CompleteMultipartUploadResult></CompleteMultipartUploadResult>"))
+	case r.Method == http.MethodDelete:
+		delete(s.uploads, uploadID)
+		s.aborted++
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	require.True(s.t, strings.HasPrefix(auth,
+		"AWS4-HMAC-SHA256 Credential=key-id/20200102/eu-west-1/s3/aws4_request, "+
+			"SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature="), auth)
+	require.Equal(s.t, "20200102T030405Z", r.Header.Get("x-amz-date"))
+	require.Equal(s.t, "UNSIGNED-PAYLOAD", r.Header.Get("x-amz-content-sha256"))
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.paths = append(s.paths, r.URL.EscapedPath())
+	if query := r.URL.Query(); query.Get("uploadId") != "" || query["uploads"] != nil {
+		s.serveMultipart(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := ioutil.ReadAll(r.Body)
+		require.NoError(s.t, err)
+		require.Equal(s.t, int64(len(data)), r.ContentLength)
+		s.objects[r.URL.Path] = data
+	case http.MethodGet, http.MethodHead:
+		data, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method != http.MethodGet {
+			return
+		}
+		if rng := r.Header.Get("Range"); rng != "" {
+			var start, end int
+			_, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end)
+			require.NoError(s.t, err)
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(data[start : end+1])
+			return
+		}
+		w.Write(data)
+	case http.MethodDelete:
+		delete(s.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestS3Store(t *testing.T, endpoint string) Store {
+	store, err := NewS3Store(S3Options{
+		Endpoint:        endpoint,
+		Bucket:          "bucket",
+		Region:          "eu-west-1",
+		Prefix:          "m3db",
+		AccessKeyID:     "key-id",
+		SecretAccessKey: "secret",
+		NowFn: func() time.Time {
+			return time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		},
+	})
+	require.NoError(t, err)
+	return store
+}
+
+func TestS3StorePutGetExistsDelete(t *testing.T) {
+	fake := newFakeS3Server(t)
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	testStoreRoundTrip(t, newTestS3Store(t, server.URL))
+
+	fake.Lock()
+	defer fake.Unlock()
+	for _, p := range fake.paths {
+		require.Equal(t, "/bucket/m3db/data/ns/0/fileset-1-0-data.db", p)
+	}
+}
+
+func TestS3StoreMultipartUpload(t *testing.T) {
+	fake := newFakeS3Server(t)
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store := newTestS3Store(t, server.URL)
+	store.(*s3Store).opts.PartSize = 4
+
+	data := []byte("multipart-object")
+	require.NoError(t, store.Put("foo", bytes.NewReader(data), int64(len(data))))
+
+	r, err := store.Get("foo")
+	require.NoError(t, err)
+	actual, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, data, actual)
+
+	fake.Lock()
+	defer fake.Unlock()
+	require.Equal(t, 0, len(fake.uploads))
+	require.Equal(t, 0, fake.aborted)
+}
+
+func TestS3StoreMultipartUploadAbortsOnError(t *testing.T) {
+	fake := newFakeS3Server(t)
+	fake.failPart = 2
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store := newTestS3Store(t, server.URL)
+	store.(*s3Store).opts.PartSize = 4
+
+	data := []byte("multipart-object")
+	require.Error(t, store.Put("foo", bytes.NewReader(data), int64(len(data))))
+
+	exists, err := store.Exists("foo")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	fake.Lock()
+	defer fake.Unlock()
+	require.Equal(t, 0, len(fake.uploads))
+	require.Equal(t, 1, fake.aborted)
+}
+
+func TestS3StoreEscapesKeys(t *testing.T) {
+	fake := newFakeS3Server(t)
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store := newTestS3Store(t, server.URL)
+	require.NoError(t, store.Delete("a b/c+d"))
+
+	fake.Lock()
+	defer fake.Unlock()
+	require.Equal(t, []string{"/bucket/m3db/a%20b/c%2Bd"}, fake.paths)
+}
+
+func TestS3StoreRequestError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("AccessDenied"))
+	}))
+	defer server.Close()
+
+	_, err := newTestS3Store(t, server.URL).Get("foo")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "status=403")
+	require.Contains(t, err.Error(), "AccessDenied")
+}
+
+func TestNewS3StoreValidatesOptions(t *testing.T) {
+	_, err := NewS3Store(S3Options{Bucket: "bucket"})
+	require.Equal(t, errS3EndpointNotSet, err)
+
+	_, err = NewS3Store(S3Options{Endpoint: "http://localhost:9000"})
+	require.Equal(t, errS3BucketNotSet, err)
+
+	_, err = NewS3Store(S3Options{
+		Endpoint: "http://localhost:9000",
+		Bucket:   "bucket",
+		PartSize: 1024,
+	})
+	require.Equal(t, errS3PartSizeTooSmall, err)
+}
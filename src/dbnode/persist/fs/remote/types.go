@@ -0,0 +1,54 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package remote provides stores for offloading fileset files from local
+// disk to remote object storage.
+package remote
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when an object does not exist in a store.
+var ErrNotFound = errors.New("remote object not found")
+
+// Store is a store of immutable objects addressed by slash separated keys.
+type Store interface {
+	// Put uploads size bytes read from r to the object at key, replacing
+	// any existing object.
+	Put(key string, r io.Reader, size int64) error
+
+	// Get returns a reader for the object at key or ErrNotFound if it
+	// does not exist, the caller is responsible for closing the reader.
+	Get(key string) (io.ReadCloser, error)
+
+	// GetRange returns a reader for at most length bytes of the object at
+	// key starting at offset or ErrNotFound if it does not exist, the
+	// caller is responsible for closing the reader.
+	GetRange(key string, offset, length int64) (io.ReadCloser, error)
+
+	// Exists returns whether the object at key exists.
+	Exists(key string) (bool, error)
+
+	// Delete deletes the object at key, it is not an error to delete an
+	// object that does not exist.
+	Delete(key string) error
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io"
+	"regexp"
+	"sort"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+// Encoding formats recorded in the info files of volumes.
+const (
+	// EncodingFormatM3TSZ is the format of volumes encoded with m3tsz.
+	EncodingFormatM3TSZ = "m3tsz"
+	// EncodingFormatM3TSZIntOptimized is the format of volumes encoded with
+	// m3tsz with the int optimization enabled.
+	EncodingFormatM3TSZIntOptimized = "m3tsz-int-optimized"
+	// EncodingFormatProto is the format of volumes encoded with protobuf.
+	EncodingFormatProto = "proto"
+)
+
+// M3TSZEncodingFormat returns the encoding format of volumes encoded with
+// m3tsz.
+func M3TSZEncodingFormat(intOptimized bool) string {
+	if intOptimized {
+		return EncodingFormatM3TSZIntOptimized
+	}
+	return EncodingFormatM3TSZ
+}
+
+// RewriteOptions are the options used when rewriting filesets.
+type RewriteOptions struct {
+	// EncodingFormat identifies the encoding of the encoders the filesets
+	// are rewritten with and is recorded in the info files of the rewritten
+	// volumes, background rewrites only rewrite volumes encoded differently.
+	EncodingFormat string
+	// DropSeriesRegexp when set drops all series with an ID matching it
+	// from the rewritten filesets. Only the filesets on disk are changed so
+	// it must only be used offline while the database is not running,
+	// otherwise the series are still served from memory and the index.
+	DropSeriesRegexp *regexp.Regexp
+	// MaxVolumesPerShardFlush limits how many volumes each shard rewrites
+	// per background flush so rewrites don't starve regular flushes of
+	// disk throughput, zero means unlimited.
+	MaxVolumesPerShardFlush int
+}
+
+type rewriter struct {
+	reader         DataFileSetReader
+	blockAllocSize int
+	srPool         xio.SegmentReaderPool
+	multiIterPool  encoding.MultiReaderIteratorPool
+	identPool      ident.Pool
+	encoderPool    encoding.EncoderPool
+	opts           RewriteOptions
+}
+
+type rewrittenSeries struct {
+	id      ident.ID
+	tags    ident.Tags
+	encoder encoding.Encoder
+}
+
+// NewRewriter returns a new Rewriter. This implementation decodes every
+// series from the source filesets and re-encodes the datapoints that fall
+// within the destination block with encoders from the given encoder pool,
+// optionally dropping series, and then persists the result.
+//
+// Similar to the merger, the rewriter does not signal to the database of
+// the existence of the newly persisted data, nor does it clean up the
+// source filesets.
+func NewRewriter(
+	reader DataFileSetReader,
+	blockAllocSize int,
+	srPool xio.SegmentReaderPool,
+	multiIterPool encoding.MultiReaderIteratorPool,
+	identPool ident.Pool,
+	encoderPool encoding.EncoderPool,
+	opts RewriteOptions,
+) Rewriter {
+	return &rewriter{
+		reader:         reader,
+		blockAllocSize: blockAllocSize,
+		srPool:         srPool,
+		multiIterPool:  multiIterPool,
+		identPool:      identPool,
+		encoderPool:    encoderPool,
+		opts:           opts,
+	}
+}
+
+func (r *rewriter) Rewrite(
+	srcFileIDs []FileSetFileIdentifier,
+	prepareOpts persist.DataPrepareOptions,
+	flushPreparer persist.FlushPreparer,
+	nsCtx namespace.Context,
+) error {
+	var (
+		destBlockSize = prepareOpts.NamespaceMetadata.Options().RetentionOptions().BlockSize()
+		destStart     = prepareOpts.BlockStart
+		destEnd       = destStart.Add(destBlockSize)
+		// A single source fileset can be rewritten series by series, however
+		// when combining multiple source filesets a series may appear in
+		// several of them so all series are held until every source is read.
+		streaming = len(srcFileIDs) == 1
+		pending   = make(map[string]*rewrittenSeries)
+
+		// IDs and tags must only be finalized once the prepared persist has
+		// been closed since the underlying writer holds on to the references.
+		idsToFinalize  []ident.ID
+		tagsToFinalize []ident.Tags
+	)
+
+	// Datapoints must be encoded in order so read sources from oldest to newest.
+	srcFileIDs = append([]FileSetFileIdentifier(nil), srcFileIDs...)
+	sort.Slice(srcFileIDs, func(i, j int) bool {
+		return srcFileIDs[i].BlockStart.Before(srcFileIDs[j].BlockStart)
+	})
+
+	prepareOpts.EncodingFormat = r.opts.EncodingFormat
+	prepared, err := flushPreparer.PrepareData(prepareOpts)
+	if err != nil {
+		return err
+	}
+
+	var (
+		segReader  = r.srPool.Get()
+		multiIter  = r.multiIterPool.Get()
+		segReaders = make([]xio.SegmentReader, 1)
+	)
+	defer func() {
+		segReader.Finalize()
+		multiIter.Close()
+		for _, series := range pending {
+			series.encoder.Close()
+		}
+		for _, res := range idsToFinalize {
+			res.Finalize()
+		}
+		for _, res := range tagsToFinalize {
+			res.Finalize()
+		}
+	}()
+
+	rewriteSource := func(fileID FileSetFileIdentifier) (err error) {
+		reader := r.reader
+		if err := reader.Open(DataReaderOpenOptions{
+			Identifier:  fileID,
+			FileSetType: persist.FileSetFlushType,
+		}); err != nil {
+			return err
+		}
+		defer func() {
+			// Only set the error here if not set by the end of the function,
+			// since all other errors take precedence.
+			if err == nil {
+				err = reader.Close()
+			}
+		}()
+
+		srcRange := reader.Range()
+		for id, tagsIter, data, checksum, err := reader.Read(); err != io.EOF; id, tagsIter, data, checksum, err = reader.Read() {
+			if err != nil {
+				return err
+			}
+			if r.opts.DropSeriesRegexp != nil && r.opts.DropSeriesRegexp.Match(id.Bytes()) {
+				id.Finalize()
+				tagsIter.Close()
+				data.Finalize()
+				continue
+			}
+
+			series, ok := pending[id.String()]
+			if !ok {
+				tags, err := convert.TagsFromTagsIter(id, tagsIter, r.identPool)
+				if err != nil {
+					tagsIter.Close()
+					return err
+				}
+				encoder := r.encoderPool.Get()
+				encoder.Reset(destStart, r.blockAllocSize, nsCtx.Schema)
+				series = &rewrittenSeries{id: id, tags: tags, encoder: encoder}
+				pending[id.String()] = series
+				idsToFinalize = append(idsToFinalize, id)
+				tagsToFinalize = append(tagsToFinalize, tags)
+			} else {
+				id.Finalize()
+			}
+			tagsIter.Close()
+
+			segReaders[0] = segmentReaderFromData(data, checksum, segReader)
+			multiIter.Reset(segReaders, srcRange.Start, srcRange.Duration(), nsCtx.Schema)
+			for multiIter.Next() {
+				dp, unit, annotation := multiIter.Current()
+				if dp.Timestamp.Before(destStart) || !dp.Timestamp.Before(destEnd) {
+					continue
+				}
+				if err := series.encoder.Encode(dp, unit, annotation); err != nil {
+					return err
+				}
+			}
+			if err := multiIter.Err(); err != nil {
+				return err
+			}
+
+			if streaming {
+				if err := r.persist(series, prepared.Persist); err != nil {
+					return err
+				}
+				delete(pending, id.String())
+			}
+		}
+		return nil
+	}
+
+	for _, fileID := range srcFileIDs {
+		if err := rewriteSource(fileID); err != nil {
+			return err
+		}
+	}
+
+	for key, series := range pending {
+		if err := r.persist(series, prepared.Persist); err != nil {
+			return err
+		}
+		delete(pending, key)
+	}
+
+	// Close the flush preparer, which writes the rest of the files in the
+	// fileset.
+	return prepared.Close()
+}
+
+func (r *rewriter) persist(series *rewrittenSeries, persistFn persist.DataFn) error {
+	if series.encoder.NumEncoded() == 0 {
+		// No datapoints for the series fall within the destination block.
+		series.encoder.Close()
+		return nil
+	}
+	segment := series.encoder.Discard()
+	return persistSegment(series.id, series.tags, segment, persistFn)
+}
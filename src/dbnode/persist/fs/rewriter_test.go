@@ -0,0 +1,203 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type testRewriteSource struct {
+	blockStart time.Time
+	data       *checkedBytesMap
+}
+
+func TestRewriteDropsSeriesMatchingRegexp(t *testing.T) {
+	diskData := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	diskData.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 0},
+		{Timestamp: startTime.Add(2 * time.Second), Value: 1},
+	}))
+	diskData.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(3 * time.Second), Value: 2},
+	}))
+
+	expected := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	expected.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 0},
+		{Timestamp: startTime.Add(2 * time.Second), Value: 1},
+	}))
+
+	testRewrite(t, []testRewriteSource{{blockStart: startTime, data: diskData}},
+		startTime, blockSize, RewriteOptions{DropSeriesRegexp: regexp.MustCompile("^id1$")},
+		expected)
+}
+
+func TestRewriteToLargerBlockSize(t *testing.T) {
+	first := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	first.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 0},
+	}))
+	first.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(2 * time.Second), Value: 1},
+	}))
+	second := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	second.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(blockSize + time.Second), Value: 2},
+	}))
+	second.Set(id2, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(blockSize + 2*time.Second), Value: 3},
+	}))
+
+	expected := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	expected.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 0},
+		{Timestamp: startTime.Add(blockSize + time.Second), Value: 2},
+	}))
+	expected.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(2 * time.Second), Value: 1},
+	}))
+	expected.Set(id2, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(blockSize + 2*time.Second), Value: 3},
+	}))
+
+	testRewrite(t, []testRewriteSource{
+		{blockStart: startTime, data: first},
+		{blockStart: startTime.Add(blockSize), data: second},
+	}, startTime, 2*blockSize, RewriteOptions{EncodingFormat: EncodingFormatM3TSZ}, expected)
+}
+
+func TestRewriteToSmallerBlockSize(t *testing.T) {
+	diskData := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	diskData.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 0},
+		{Timestamp: startTime.Add(blockSize/2 + time.Second), Value: 1},
+	}))
+	diskData.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(blockSize/2 + 2*time.Second), Value: 2},
+	}))
+
+	expected := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	expected.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(blockSize/2 + time.Second), Value: 1},
+	}))
+	expected.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(blockSize/2 + 2*time.Second), Value: 2},
+	}))
+
+	testRewrite(t, []testRewriteSource{{blockStart: startTime, data: diskData}},
+		startTime.Add(blockSize/2), blockSize/2, RewriteOptions{}, expected)
+}
+
+func testRewrite(
+	t *testing.T,
+	sources []testRewriteSource,
+	destBlockStart time.Time,
+	destBlockSize time.Duration,
+	opts RewriteOptions,
+	expectedData *checkedBytesMap,
+) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		reader       = NewMockDataFileSetReader(ctrl)
+		tagIter      = ident.NewTagsIterator(ident.NewTags(ident.StringTag("tag-key0", "tag-val0")))
+		fakeChecksum = uint32(42)
+		inOrderCalls []*gomock.Call
+		srcFileIDs   []FileSetFileIdentifier
+	)
+	for _, source := range sources {
+		fileID := FileSetFileIdentifier{
+			Namespace:  ident.StringID("test-ns"),
+			Shard:      uint32(8),
+			BlockStart: source.blockStart,
+		}
+		srcFileIDs = append(srcFileIDs, fileID)
+
+		inOrderCalls = append(inOrderCalls,
+			reader.EXPECT().Open(DataReaderOpenOptions{
+				Identifier:  fileID,
+				FileSetType: persist.FileSetFlushType,
+			}).Return(nil),
+			reader.EXPECT().Range().Return(xtime.Range{
+				Start: source.blockStart,
+				End:   source.blockStart.Add(blockSize),
+			}))
+		for _, val := range source.data.Iter() {
+			inOrderCalls = append(inOrderCalls,
+				reader.EXPECT().Read().Return(val.Key(), tagIter, val.Value(), fakeChecksum, nil))
+		}
+		inOrderCalls = append(inOrderCalls,
+			reader.EXPECT().Read().Return(nil, nil, nil, uint32(0), io.EOF),
+			reader.EXPECT().Close().Return(nil))
+	}
+	gomock.InOrder(inOrderCalls...)
+
+	nsOpts := namespace.NewOptions().SetRetentionOptions(
+		retention.NewOptions().SetBlockSize(destBlockSize))
+	nsMd, err := namespace.NewMetadata(ident.StringID("test-ns"), nsOpts)
+	require.NoError(t, err)
+	prepareOpts := persist.DataPrepareOptions{
+		NamespaceMetadata: nsMd,
+		Shard:             uint32(8),
+		BlockStart:        destBlockStart,
+		VolumeIndex:       1,
+		FileSetType:       persist.FileSetFlushType,
+	}
+
+	// Rewritten volumes record the encoding format of the rewriter.
+	expectedPrepareOpts := prepareOpts
+	expectedPrepareOpts.EncodingFormat = opts.EncodingFormat
+
+	var persisted []persistedData
+	preparer := persist.NewMockFlushPreparer(ctrl)
+	preparer.EXPECT().PrepareData(expectedPrepareOpts).Return(
+		persist.PreparedDataPersist{
+			Persist: func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
+				persisted = append(persisted, persistedData{
+					id:      id,
+					segment: segment.Clone(nil),
+				})
+				return nil
+			},
+			Close: func() error { return nil },
+		}, nil)
+
+	rewriter := NewRewriter(reader, 0, srPool, multiIterPool,
+		identPool, encoderPool, opts)
+	err = rewriter.Rewrite(srcFileIDs, prepareOpts, preparer, namespace.Context{})
+	require.NoError(t, err)
+
+	assertPersistedAsExpected(t, persisted, expectedData)
+}
@@ -68,7 +68,7 @@ type seeker struct {
 	start     xtime.UnixNano
 	blockSize time.Duration
 
-	dataFd        *os.File
+	dataFd        dataFile
 	indexFd       *os.File
 	indexFileSize int64
 
@@ -167,15 +167,29 @@ func (s *seeker) Open(
 		}
 	}
 
+	// Download the fileset metadata from the remote store if it has been
+	// offloaded, the data file itself is read remotely until it has been
+	// downloaded in the background.
+	if err := hydrateDataFileSetMetadata(s.opts.opts, shardDir, blockStart, volumeIndex, isLegacy); err != nil {
+		return err
+	}
+
+	s.dataFd, err = openDataFile(s.opts.opts,
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, dataFileSuffix, isLegacy))
+	if err != nil {
+		return err
+	}
+
 	// Open necessary files
 	if err := openFiles(os.Open, map[string]**os.File{
 		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, infoFileSuffix, isLegacy):        &infoFd,
 		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, indexFileSuffix, isLegacy):       &s.indexFd,
-		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, dataFileSuffix, isLegacy):        &s.dataFd,
 		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, digestFileSuffix, isLegacy):      &digestFd,
 		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, bloomFilterFileSuffix, isLegacy): &bloomFilterFd,
 		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, summariesFileSuffix, isLegacy):   &summariesFd,
 	}); err != nil {
+		s.dataFd.Close()
+		s.dataFd = nil
 		return err
 	}
 
@@ -637,14 +651,14 @@ func (s *simpleBytesPool) Put(b []byte) {
 
 var _ io.Reader = &offsetFileReader{}
 
-// offsetFileReader implements io.Reader() and allows an *os.File to be wrapped
+// offsetFileReader implements io.Reader() and allows an io.ReaderAt to be wrapped
 // such that any calls to Read() are issued at the provided offset. This is used
 // to issue reads to specific portions of the index and data files without having
 // to first call Seek(). This reduces the number of syscalls that need to be made
 // and also allows the fds to be shared among concurrent goroutines since the
 // internal F.D offset managed by the kernel is not being used.
 type offsetFileReader struct {
-	fd     *os.File
+	fd     io.ReaderAt
 	offset int64
 }
 
@@ -658,7 +672,7 @@ func (p *offsetFileReader) Read(b []byte) (n int, err error) {
 	return n, err
 }
 
-func (p *offsetFileReader) reset(fd *os.File, offset int64) {
+func (p *offsetFileReader) reset(fd io.ReaderAt, offset int64) {
 	p.fd = fd
 	p.offset = offset
 }
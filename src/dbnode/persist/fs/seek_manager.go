@@ -756,6 +756,17 @@ func (m *seekerManager) openAnyUnopenSeekers(byTime *seekersByTime) error {
 	blockSize := m.namespaceMetadata.Options().RetentionOptions().BlockSize()
 	multiErr := xerrors.NewMultiError()
 
+	if m.opts.RemoteStore() != nil {
+		// Blocks that may have been offloaded to the remote store are opened
+		// on demand rather than up front to avoid downloading them.
+		now := m.opts.ClockOptions().NowFn()()
+		earliestNotOffloaded := now.Add(-m.opts.RemoteOffloadAfter()).
+			Add(-blockSize).Truncate(blockSize).Add(blockSize)
+		if earliestNotOffloaded.After(start) {
+			start = earliestNotOffloaded
+		}
+	}
+
 	for t := start; !t.After(end); t = t.Add(blockSize) {
 		byTime.Lock()
 		_, err := m.getOrOpenSeekersWithLock(xtime.ToUnixNano(t), byTime)
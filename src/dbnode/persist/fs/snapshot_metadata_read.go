@@ -27,6 +27,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/generated/proto/snapshot"
 	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/x/ident"
 
 	"github.com/pborman/uuid"
 )
@@ -97,6 +98,18 @@ func (w *SnapshotMetadataReader) Read(id SnapshotMetadataIdentifier) (SnapshotMe
 		return SnapshotMetadata{}, fmt.Errorf("unable to parse UUID: %v, err: %v", protoMetadata.SnapshotUUID, err)
 	}
 
+	var highWaterMarks []persist.SnapshotHighWaterMark
+	for _, mark := range protoMetadata.HighWaterMarks {
+		highWaterMarks = append(highWaterMarks, persist.SnapshotHighWaterMark{
+			Namespace: ident.BytesID(mark.Namespace),
+			Shard:     mark.Shard,
+			Position: persist.CommitLogPosition{
+				Index:      mark.CommitlogIndex,
+				NumEntries: mark.NumEntries,
+			},
+		})
+	}
+
 	return SnapshotMetadata{
 		ID: SnapshotMetadataIdentifier{
 			Index: protoMetadata.SnapshotIndex,
@@ -106,6 +119,7 @@ func (w *SnapshotMetadataReader) Read(id SnapshotMetadataIdentifier) (SnapshotMe
 			FilePath: protoMetadata.CommitlogID.FilePath,
 			Index:    protoMetadata.CommitlogID.Index,
 		},
+		HighWaterMarks:     highWaterMarks,
 		MetadataFilePath:   snapshotMetadataFilePathFromIdentifier(prefix, id),
 		CheckpointFilePath: snapshotMetadataCheckpointFilePathFromIdentifier(prefix, id),
 	}, nil
@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/x/ident"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,18 @@ func TestSnapshotMetadataWriteAndRead(t *testing.T) {
 			FilePath: "some_path",
 			Index:    1,
 		}
+		highWaterMarks = []persist.SnapshotHighWaterMark{
+			{
+				Namespace: ident.BytesID("foo"),
+				Shard:     0,
+				Position:  persist.CommitLogPosition{Index: 1, NumEntries: 42},
+			},
+			{
+				Namespace: ident.BytesID("bar"),
+				Shard:     3,
+				Position:  persist.CommitLogPosition{Index: 2, NumEntries: 0},
+			},
+		}
 		numMetadataFiles = 10
 	)
 	defer func() {
@@ -65,6 +78,7 @@ func TestSnapshotMetadataWriteAndRead(t *testing.T) {
 		err := writer.Write(SnapshotMetadataWriteArgs{
 			ID:                  snapshotMetadataIdentifier,
 			CommitlogIdentifier: commitlogIdentifier,
+			HighWaterMarks:      highWaterMarks,
 		})
 		require.NoError(t, err)
 
@@ -74,6 +88,7 @@ func TestSnapshotMetadataWriteAndRead(t *testing.T) {
 		require.Equal(t, SnapshotMetadata{
 			ID:                  snapshotMetadataIdentifier,
 			CommitlogIdentifier: commitlogIdentifier,
+			HighWaterMarks:      highWaterMarks,
 			MetadataFilePath: snapshotMetadataFilePathFromIdentifier(
 				filePathPrefix, snapshotMetadataIdentifier),
 			CheckpointFilePath: snapshotMetadataCheckpointFilePathFromIdentifier(
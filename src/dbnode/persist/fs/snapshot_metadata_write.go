@@ -56,6 +56,9 @@ type SnapshotMetadataWriter struct {
 type SnapshotMetadataWriteArgs struct {
 	ID                  SnapshotMetadataIdentifier
 	CommitlogIdentifier persist.CommitLogFile
+	// HighWaterMarks are the commit log positions before which all entries
+	// for a given namespace and shard are contained in the snapshot.
+	HighWaterMarks []persist.SnapshotHighWaterMark
 }
 
 func (w *SnapshotMetadataWriter) Write(args SnapshotMetadataWriteArgs) (finalErr error) {
@@ -104,6 +107,16 @@ func (w *SnapshotMetadataWriter) Write(args SnapshotMetadataWriteArgs) (finalErr
 	w.metadataFdWithDigest.Reset(metadataFile)
 	deferCleanup(w.metadataFdWithDigest.Close)
 
+	highWaterMarks := make([]*snapshot.HighWaterMark, 0, len(args.HighWaterMarks))
+	for _, mark := range args.HighWaterMarks {
+		highWaterMarks = append(highWaterMarks, &snapshot.HighWaterMark{
+			Namespace:      mark.Namespace.Bytes(),
+			Shard:          mark.Shard,
+			CommitlogIndex: mark.Position.Index,
+			NumEntries:     mark.Position.NumEntries,
+		})
+	}
+
 	metadataBytes, err := proto.Marshal(&snapshot.Metadata{
 		SnapshotIndex: args.ID.Index,
 		SnapshotUUID:  []byte(args.ID.UUID.String()),
@@ -111,6 +124,7 @@ func (w *SnapshotMetadataWriter) Write(args SnapshotMetadataWriteArgs) (finalErr
 			FilePath: args.CommitlogIdentifier.FilePath,
 			Index:    args.CommitlogIdentifier.Index,
 		},
+		HighWaterMarks: highWaterMarks,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,574 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+const (
+	tombstonesFileName        = "tombstones.log"
+	tombstonesTempFilePattern = "tombstones-*.tmp"
+
+	// tombstoneRecordHeaderLen is the length of the header of each record
+	// in the tombstones log, the length of the record followed by the
+	// digest of the record.
+	tombstoneRecordHeaderLen = 8
+)
+
+type tombstoneRecordType byte
+
+const (
+	// tombstoneRecordDelete records a tombstone.
+	tombstoneRecordDelete tombstoneRecordType = iota + 1
+	// tombstoneRecordRewritten records that the fileset of the block of a
+	// tombstone was rewritten without the deleted data.
+	tombstoneRecordRewritten
+	// tombstoneRecordWritten records that the series of a tombstone was
+	// written within the deleted range after the delete.
+	tombstoneRecordWritten
+)
+
+const (
+	tombstoneFlagPendingRewrite byte = 1 << iota
+	tombstoneFlagWritten
+)
+
+var (
+	errTombstoneRecordTruncated = errors.New("tombstone record truncated")
+	errTombstoneRecordInvalid   = errors.New("tombstone record invalid")
+)
+
+// Tombstone records that the data of a series within a block was deleted.
+type Tombstone struct {
+	// ID is the ID of the series deleted.
+	ID []byte
+	// BlockStart is the start of the block the data was deleted from.
+	BlockStart time.Time
+	// Start and End are the bounds of the deleted data within the block.
+	Start time.Time
+	End   time.Time
+	// Position is the commit log position reached once the data was deleted,
+	// entries for the series before it are deleted data while entries after
+	// it are writes made after the delete.
+	Position persist.CommitLogPosition
+	// PendingRewrite is whether the block had been flushed when the data was
+	// deleted and its filesets still need to be rewritten without the data.
+	PendingRewrite bool
+	// Written is whether the series was written within the deleted range
+	// after the delete, in which case the series must no longer be hidden
+	// from the index for the deleted range.
+	Written bool
+}
+
+// Deletes returns whether the tombstone deletes a datapoint of the series
+// with the given timestamp.
+func (t Tombstone) Deletes(timestamp time.Time) bool {
+	return !timestamp.Before(t.Start) && timestamp.Before(t.End)
+}
+
+// tombstoneKey identifies the tombstones that a rewritten record applies to.
+type tombstoneKey struct {
+	id         string
+	blockStart int64
+	start      int64
+	end        int64
+}
+
+func newTombstoneKey(t Tombstone) tombstoneKey {
+	return tombstoneKey{
+		id:         string(t.ID),
+		blockStart: t.BlockStart.UnixNano(),
+		start:      t.Start.UnixNano(),
+		end:        t.End.UnixNano(),
+	}
+}
+
+// TombstonesFilePath returns the path of the tombstones log for a shard.
+func TombstonesFilePath(prefix string, namespace ident.ID, shard uint32) string {
+	return path.Join(ShardDataDirPath(prefix, namespace, shard), tombstonesFileName)
+}
+
+// ReadTombstones replays the tombstones log of a shard, returning none if the
+// shard has no tombstones log. A record left partially written at the end of
+// the log by a crash is ignored.
+func ReadTombstones(
+	prefix string,
+	namespace ident.ID,
+	shard uint32,
+) ([]Tombstone, error) {
+	filePath := TombstonesFilePath(prefix, namespace, shard)
+	data, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tombstones []Tombstone
+		byKey      = make(map[tombstoneKey][]int)
+	)
+	for len(data) > 0 {
+		recordType, t, n, err := decodeTombstoneRecord(data)
+		if err == errTombstoneRecordTruncated {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tombstones log %s: %v", filePath, err)
+		}
+		data = data[n:]
+
+		key := newTombstoneKey(t)
+		switch recordType {
+		case tombstoneRecordDelete:
+			byKey[key] = append(byKey[key], len(tombstones))
+			tombstones = append(tombstones, t)
+		case tombstoneRecordRewritten:
+			for _, idx := range byKey[key] {
+				tombstones[idx].PendingRewrite = false
+			}
+		case tombstoneRecordWritten:
+			// Only the tombstones preceding the record in the log, that is
+			// with an earlier position, were superseded by the write.
+			for _, idx := range byKey[key] {
+				tombstones[idx].Written = true
+			}
+		default:
+			return nil, fmt.Errorf("tombstones log %s: unknown record type %d",
+				filePath, recordType)
+		}
+	}
+	return tombstones, nil
+}
+
+// WriteTombstones durably replaces the tombstones log of a shard, the log is
+// removed if there are no tombstones.
+func WriteTombstones(
+	opts Options,
+	namespace ident.ID,
+	shard uint32,
+	tombstones []Tombstone,
+) error {
+	prefix := opts.FilePathPrefix()
+	return writeTombstonesLog(opts, ShardDataDirPath(prefix, namespace, shard),
+		TombstonesFilePath(prefix, namespace, shard), tombstones)
+}
+
+func writeTombstonesLog(
+	opts Options,
+	dirPath string,
+	filePath string,
+	tombstones []Tombstone,
+) error {
+	if len(tombstones) == 0 {
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	var data []byte
+	for _, t := range tombstones {
+		data = appendTombstoneRecord(data, tombstoneRecordDelete, t)
+	}
+
+	if err := os.MkdirAll(dirPath, opts.NewDirectoryMode()); err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename so that a crash never leaves a
+	// partially written tombstones log behind.
+	tmp, err := ioutil.TempFile(dirPath, tombstonesTempFilePattern)
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), opts.NewFileMode())
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filePath)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return syncDir(dirPath)
+}
+
+// syncDir syncs a directory so that the files created in it are persisted.
+func syncDir(dirPath string) error {
+	dir, err := os.Open(dirPath)
+	if err != nil {
+		return err
+	}
+	err = dir.Sync()
+	if closeErr := dir.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// TombstonesWriter appends records to the tombstones log of a shard. Records
+// are buffered when appended and written to the log by Sync, this lets the
+// caller order records under its own lock without holding it while the log
+// is synced.
+type TombstonesWriter struct {
+	sync.Mutex
+	// pending are the encoded records appended but not yet synced.
+	pending    []byte
+	numPending int
+	numRecords int
+
+	// syncLock serializes writes to the log.
+	syncLock sync.Mutex
+	opts     Options
+	dirPath  string
+	filePath string
+	fd       *os.File
+	size     int64
+}
+
+// NewTombstonesWriter returns a new writer for the tombstones log of a shard.
+func NewTombstonesWriter(
+	opts Options,
+	namespace ident.ID,
+	shard uint32,
+) *TombstonesWriter {
+	prefix := opts.FilePathPrefix()
+	return &TombstonesWriter{
+		opts:     opts,
+		dirPath:  ShardDataDirPath(prefix, namespace, shard),
+		filePath: TombstonesFilePath(prefix, namespace, shard),
+	}
+}
+
+// AppendDelete appends a tombstone to the log.
+func (w *TombstonesWriter) AppendDelete(t Tombstone) {
+	w.append(tombstoneRecordDelete, t)
+}
+
+// AppendRewritten appends a record that the fileset of the block of the
+// tombstone was rewritten without the deleted data.
+func (w *TombstonesWriter) AppendRewritten(t Tombstone) {
+	w.append(tombstoneRecordRewritten, t)
+}
+
+// AppendWritten appends a record that the series of the tombstone was
+// written within the deleted range after the delete.
+func (w *TombstonesWriter) AppendWritten(t Tombstone) {
+	w.append(tombstoneRecordWritten, t)
+}
+
+func (w *TombstonesWriter) append(recordType tombstoneRecordType, t Tombstone) {
+	w.Lock()
+	w.pending = appendTombstoneRecord(w.pending, recordType, t)
+	w.numPending++
+	w.Unlock()
+}
+
+// NumRecords returns the number of records in the log, including the records
+// appended but not yet synced.
+func (w *TombstonesWriter) NumRecords() int {
+	w.Lock()
+	defer w.Unlock()
+	return w.numRecords + w.numPending
+}
+
+// Sync writes the records appended to the log and syncs the log to disk.
+func (w *TombstonesWriter) Sync() error {
+	w.syncLock.Lock()
+	defer w.syncLock.Unlock()
+
+	w.Lock()
+	data, numPending := w.pending, w.numPending
+	w.pending, w.numPending = nil, 0
+	w.Unlock()
+	if len(data) == 0 {
+		return nil
+	}
+
+	if err := w.writeWithSyncLock(data); err != nil {
+		// Requeue the records so that the log stays consistent with the
+		// records appended if a later sync succeeds.
+		w.Lock()
+		w.pending = append(data, w.pending...)
+		w.numPending += numPending
+		w.Unlock()
+		return err
+	}
+
+	w.Lock()
+	w.numRecords += numPending
+	w.Unlock()
+	return nil
+}
+
+func (w *TombstonesWriter) writeWithSyncLock(data []byte) error {
+	if w.fd == nil {
+		if err := os.MkdirAll(w.dirPath, w.opts.NewDirectoryMode()); err != nil {
+			return err
+		}
+		fd, err := os.OpenFile(w.filePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND,
+			w.opts.NewFileMode())
+		if err != nil {
+			return err
+		}
+		info, err := fd.Stat()
+		if err == nil {
+			// Ensure the log is discoverable if it was just created.
+			err = syncDir(w.dirPath)
+		}
+		if err != nil {
+			fd.Close()
+			return err
+		}
+		w.fd = fd
+		w.size = info.Size()
+	}
+
+	_, err := w.fd.Write(data)
+	if err == nil {
+		err = w.fd.Sync()
+	}
+	if err != nil {
+		// Drop any partially written record so that records appended later
+		// can still be read.
+		w.fd.Truncate(w.size)
+		return err
+	}
+	w.size += int64(len(data))
+	return nil
+}
+
+// Compact replaces the log with a single record for each of the tombstones
+// returned by tombstonesFn. The function is called while holding lock, which
+// must be the lock records are appended under, so that the tombstones returned
+// account for every record appended before it was called.
+func (w *TombstonesWriter) Compact(
+	lock sync.Locker,
+	tombstonesFn func() []Tombstone,
+) error {
+	w.syncLock.Lock()
+	defer w.syncLock.Unlock()
+
+	lock.Lock()
+	tombstones := tombstonesFn()
+	w.Lock()
+	compacted, numCompacted := w.pending, w.numPending
+	w.pending, w.numPending = nil, 0
+	w.Unlock()
+	lock.Unlock()
+
+	if w.fd != nil {
+		w.fd.Close()
+		w.fd = nil
+	}
+
+	err := writeTombstonesLog(w.opts, w.dirPath, w.filePath, tombstones)
+	w.Lock()
+	defer w.Unlock()
+	if err != nil {
+		// The previous log is left in place so the records it was missing
+		// must still be written to it.
+		w.pending = append(compacted, w.pending...)
+		w.numPending += numCompacted
+		return err
+	}
+	w.numRecords = len(tombstones)
+	return nil
+}
+
+// Close closes the writer, records not yet synced are discarded.
+func (w *TombstonesWriter) Close() error {
+	w.syncLock.Lock()
+	defer w.syncLock.Unlock()
+
+	if w.fd == nil {
+		return nil
+	}
+	err := w.fd.Close()
+	w.fd = nil
+	return err
+}
+
+func appendTombstoneRecord(
+	buf []byte,
+	recordType tombstoneRecordType,
+	t Tombstone,
+) []byte {
+	var flags byte
+	if t.PendingRewrite {
+		flags |= tombstoneFlagPendingRewrite
+	}
+	if t.Written {
+		flags |= tombstoneFlagWritten
+	}
+
+	start := len(buf)
+	buf = append(buf, make([]byte, tombstoneRecordHeaderLen)...)
+	buf = append(buf, byte(recordType), flags)
+	buf = appendVarint(buf, t.BlockStart.UnixNano())
+	buf = appendVarint(buf, t.Start.UnixNano())
+	buf = appendVarint(buf, t.End.UnixNano())
+	buf = appendVarint(buf, t.Position.Index)
+	buf = appendVarint(buf, t.Position.NumEntries)
+	buf = appendUvarint(buf, uint64(len(t.ID)))
+	buf = append(buf, t.ID...)
+
+	record := buf[start+tombstoneRecordHeaderLen:]
+	binary.BigEndian.PutUint32(buf[start:], uint32(len(record)))
+	binary.BigEndian.PutUint32(buf[start+4:], digest.Checksum(record))
+	return buf
+}
+
+func decodeTombstoneRecord(
+	data []byte,
+) (tombstoneRecordType, Tombstone, int, error) {
+	if len(data) < tombstoneRecordHeaderLen {
+		return 0, Tombstone{}, 0, errTombstoneRecordTruncated
+	}
+	var (
+		size     = int(binary.BigEndian.Uint32(data))
+		expected = binary.BigEndian.Uint32(data[4:])
+	)
+	if len(data)-tombstoneRecordHeaderLen < size {
+		return 0, Tombstone{}, 0, errTombstoneRecordTruncated
+	}
+	record := data[tombstoneRecordHeaderLen : tombstoneRecordHeaderLen+size]
+	if actual := digest.Checksum(record); actual != expected {
+		return 0, Tombstone{}, 0, fmt.Errorf(
+			"tombstone record checksum mismatch: expected=%d, actual=%d",
+			expected, actual)
+	}
+
+	dec := tombstoneRecordDecoder{buf: record}
+	var (
+		recordType = tombstoneRecordType(dec.readByte())
+		flags      = dec.readByte()
+		blockStart = dec.readVarint()
+		start      = dec.readVarint()
+		end        = dec.readVarint()
+		index      = dec.readVarint()
+		numEntries = dec.readVarint()
+		id         = dec.readBytes(int(dec.readUvarint()))
+	)
+	if dec.err != nil {
+		return 0, Tombstone{}, 0, dec.err
+	}
+	return recordType, Tombstone{
+		ID:         append([]byte(nil), id...),
+		BlockStart: time.Unix(0, blockStart),
+		Start:      time.Unix(0, start),
+		End:        time.Unix(0, end),
+		Position: persist.CommitLogPosition{
+			Index:      index,
+			NumEntries: numEntries,
+		},
+		PendingRewrite: flags&tombstoneFlagPendingRewrite != 0,
+		Written:        flags&tombstoneFlagWritten != 0,
+	}, tombstoneRecordHeaderLen + size, nil
+}
+
+func appendVarint(buf []byte, v int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+// tombstoneRecordDecoder decodes the fields of a record, recording the
+// first error encountered.
+type tombstoneRecordDecoder struct {
+	buf []byte
+	err error
+}
+
+func (d *tombstoneRecordDecoder) readByte() byte {
+	if d.err != nil || len(d.buf) < 1 {
+		d.err = errTombstoneRecordInvalid
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *tombstoneRecordDecoder) readVarint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = errTombstoneRecordInvalid
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *tombstoneRecordDecoder) readUvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.err = errTombstoneRecordInvalid
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *tombstoneRecordDecoder) readBytes(n int) []byte {
+	if d.err != nil || n < 0 || len(d.buf) < n {
+		d.err = errTombstoneRecordInvalid
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTombstonesWriteAndRead(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		opts       = testDefaultOpts.SetFilePathPrefix(dir)
+		ns         = ident.StringID("testns")
+		blockStart = time.Unix(7200, 0).UTC()
+		tombstones = []Tombstone{
+			{
+				ID:             []byte("foo"),
+				BlockStart:     blockStart,
+				Start:          blockStart,
+				End:            blockStart.Add(time.Hour),
+				Position:       persist.CommitLogPosition{Index: 2, NumEntries: 10},
+				PendingRewrite: true,
+			},
+			{
+				ID:         []byte("bar"),
+				BlockStart: blockStart,
+				Start:      blockStart.Add(time.Minute),
+				End:        blockStart.Add(2 * time.Minute),
+				Position:   persist.CommitLogPosition{Index: 3},
+			},
+		}
+	)
+
+	// No tombstones are read for a shard without a tombstones file.
+	read, err := ReadTombstones(dir, ns, 1)
+	require.NoError(t, err)
+	require.Empty(t, read)
+
+	require.NoError(t, WriteTombstones(opts, ns, 1, tombstones))
+	read, err = ReadTombstones(dir, ns, 1)
+	require.NoError(t, err)
+	require.Equal(t, len(tombstones), len(read))
+	for i := range tombstones {
+		require.Equal(t, tombstones[i].ID, read[i].ID)
+		require.True(t, tombstones[i].BlockStart.Equal(read[i].BlockStart))
+		require.True(t, tombstones[i].Start.Equal(read[i].Start))
+		require.True(t, tombstones[i].End.Equal(read[i].End))
+		require.Equal(t, tombstones[i].Position, read[i].Position)
+		require.Equal(t, tombstones[i].PendingRewrite, read[i].PendingRewrite)
+	}
+
+	require.True(t, read[1].Deletes(blockStart.Add(time.Minute)))
+	require.False(t, read[1].Deletes(blockStart.Add(2*time.Minute)))
+
+	// Writing no tombstones removes the log.
+	require.NoError(t, WriteTombstones(opts, ns, 1, nil))
+	_, err = os.Stat(TombstonesFilePath(dir, ns, 1))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestTombstonesWriterAppendAndCompact(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		opts       = testDefaultOpts.SetFilePathPrefix(dir)
+		ns         = ident.StringID("testns")
+		blockStart = time.Unix(7200, 0).UTC()
+		foo        = Tombstone{
+			ID:             []byte("foo"),
+			BlockStart:     blockStart,
+			Start:          blockStart,
+			End:            blockStart.Add(time.Hour),
+			Position:       persist.CommitLogPosition{Index: 2, NumEntries: 10},
+			PendingRewrite: true,
+		}
+		bar = Tombstone{
+			ID:             []byte("bar"),
+			BlockStart:     blockStart,
+			Start:          blockStart,
+			End:            blockStart.Add(time.Minute),
+			Position:       persist.CommitLogPosition{Index: 3},
+			PendingRewrite: true,
+		}
+		lock sync.Mutex
+	)
+
+	w := NewTombstonesWriter(opts, ns, 1)
+	defer w.Close()
+
+	// Records are only written to the log once synced.
+	w.AppendDelete(foo)
+	w.AppendDelete(bar)
+	read, err := ReadTombstones(dir, ns, 1)
+	require.NoError(t, err)
+	require.Empty(t, read)
+
+	require.NoError(t, w.Sync())
+	w.AppendRewritten(foo)
+	require.NoError(t, w.Sync())
+	require.Equal(t, 3, w.NumRecords())
+
+	read, err = ReadTombstones(dir, ns, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(read))
+	require.Equal(t, foo.ID, read[0].ID)
+	require.False(t, read[0].PendingRewrite)
+	require.Equal(t, bar.ID, read[1].ID)
+	require.True(t, read[1].PendingRewrite)
+
+	// Compacting folds the rewritten record into the tombstone while
+	// records appended during the compaction are kept.
+	foo.PendingRewrite = false
+	require.NoError(t, w.Compact(&lock, func() []Tombstone {
+		return []Tombstone{foo, bar}
+	}))
+	require.Equal(t, 2, w.NumRecords())
+
+	baz := foo
+	baz.ID = []byte("baz")
+	w.AppendDelete(baz)
+	require.NoError(t, w.Sync())
+
+	read, err = ReadTombstones(dir, ns, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(read))
+	require.Equal(t, []byte("baz"), read[2].ID)
+	require.False(t, read[0].PendingRewrite)
+	require.True(t, read[1].PendingRewrite)
+
+	// Compacting away every tombstone removes the log.
+	require.NoError(t, w.Compact(&lock, func() []Tombstone { return nil }))
+	_, err = os.Stat(TombstonesFilePath(dir, ns, 1))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestTombstonesReadTornAndCorruptRecords(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		opts       = testDefaultOpts.SetFilePathPrefix(dir)
+		ns         = ident.StringID("testns")
+		blockStart = time.Unix(7200, 0).UTC()
+		tombstones = []Tombstone{
+			{ID: []byte("foo"), BlockStart: blockStart, Start: blockStart, End: blockStart.Add(time.Hour)},
+			{ID: []byte("bar"), BlockStart: blockStart, Start: blockStart, End: blockStart.Add(time.Hour)},
+		}
+		filePath = TombstonesFilePath(dir, ns, 1)
+	)
+	require.NoError(t, WriteTombstones(opts, ns, 1, tombstones))
+	data, err := ioutil.ReadFile(filePath)
+	require.NoError(t, err)
+
+	// A record partially written by a crash is ignored.
+	require.NoError(t, ioutil.WriteFile(filePath, data[:len(data)-3], opts.NewFileMode()))
+	read, err := ReadTombstones(dir, ns, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(read))
+	require.Equal(t, []byte("foo"), read[0].ID)
+
+	// A record that fails its checksum is an error.
+	data[len(data)-1] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(filePath, data, opts.NewFileMode()))
+	_, err = ReadTombstones(dir, ns, 1)
+	require.Error(t, err)
+}
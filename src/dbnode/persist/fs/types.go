@@ -30,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs/msgpack"
+	"github.com/m3db/m3/src/dbnode/persist/fs/remote"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage/block"
@@ -66,6 +67,12 @@ type DataWriterOpenOptions struct {
 	BlockSize          time.Duration
 	// Only used when writing snapshot files
 	Snapshot DataWriterSnapshotOptions
+	// DownsampledResolution is recorded in the info file, zero means the
+	// volume holds raw datapoints.
+	DownsampledResolution time.Duration
+	// EncodingFormat is recorded in the info file, empty means the encoding
+	// of the volume is unknown.
+	EncodingFormat string
 }
 
 // DataWriterSnapshotOptions is the options struct for Open method on the DataFileSetWriter
@@ -166,6 +173,10 @@ type DataFileSetReader interface {
 
 	// MetadataRead returns the position of metadata read into the volume
 	MetadataRead() int
+
+	// EncodingFormat returns the encoding format recorded for the volume,
+	// empty if unknown
+	EncodingFormat() string
 }
 
 // DataFileSetSeeker provides an out of order reader for a TSDB file set
@@ -484,6 +495,29 @@ type Options interface {
 
 	// MmapReporter returns the mmap reporter.
 	MmapReporter() mmap.Reporter
+
+	// SetRemoteStore sets the remote store that data filesets are offloaded
+	// to, offloading is disabled if not set.
+	SetRemoteStore(value remote.Store) Options
+
+	// RemoteStore returns the remote store that data filesets are offloaded to.
+	RemoteStore() remote.Store
+
+	// SetRemoteOffloadAfter sets how long after a block has ended before its
+	// data filesets are offloaded to the remote store.
+	SetRemoteOffloadAfter(value time.Duration) Options
+
+	// RemoteOffloadAfter returns how long after a block has ended before its
+	// data filesets are offloaded to the remote store.
+	RemoteOffloadAfter() time.Duration
+
+	// SetRemoteHydratedPinDuration sets how long data filesets downloaded from
+	// the remote store are kept on local disk before being evicted again.
+	SetRemoteHydratedPinDuration(value time.Duration) Options
+
+	// RemoteHydratedPinDuration returns how long data filesets downloaded from
+	// the remote store are kept on local disk before being evicted again.
+	RemoteHydratedPinDuration() time.Duration
 }
 
 // BlockRetrieverOptions represents the options for block retrieval
@@ -547,6 +581,18 @@ type MergeWith interface {
 	) error
 }
 
+// MergeWithTombstones is a MergeWith that also tracks time ranges of series
+// data that have been deleted. When the merge target implements this
+// interface the merger drops any datapoints from disk that fall within a
+// deleted range, omitting the series entirely if no datapoints remain.
+type MergeWithTombstones interface {
+	MergeWith
+
+	// Tombstones returns the deleted time ranges for the given block start
+	// and series ID and whether any exist.
+	Tombstones(seriesID ident.ID, blockStart xtime.UnixNano) (xtime.Ranges, bool)
+}
+
 // Merger is in charge of merging filesets with some target MergeWith interface.
 type Merger interface {
 	// Merge merges the specified fileset file with a merge target.
@@ -572,6 +618,54 @@ type NewMergerFn func(
 	nsOpts namespace.Options,
 ) Merger
 
+// Downsampler is in charge of downsampling filesets to a coarser resolution.
+type Downsampler interface {
+	// Downsample downsamples the specified fileset file to the given
+	// resolution and persists the result as the next volume.
+	Downsample(
+		fileID FileSetFileIdentifier,
+		resolution time.Duration,
+		nextVolumeIndex int,
+		flushPreparer persist.FlushPreparer,
+		nsCtx namespace.Context,
+	) error
+}
+
+// NewDownsamplerFn is the function to call to get a new Downsampler.
+type NewDownsamplerFn func(
+	reader DataFileSetReader,
+	blockAllocSize int,
+	srPool xio.SegmentReaderPool,
+	multiIterPool encoding.MultiReaderIteratorPool,
+	identPool ident.Pool,
+	encoderPool encoding.EncoderPool,
+	nsOpts namespace.Options,
+) Downsampler
+
+// Rewriter is in charge of rewriting filesets, re-encoding their data and
+// optionally changing their block size or dropping series.
+type Rewriter interface {
+	// Rewrite rewrites the data of the specified fileset files that falls
+	// within the block being prepared and persists it as a new fileset.
+	Rewrite(
+		srcFileIDs []FileSetFileIdentifier,
+		prepareOpts persist.DataPrepareOptions,
+		flushPreparer persist.FlushPreparer,
+		nsCtx namespace.Context,
+	) error
+}
+
+// NewRewriterFn is the function to call to get a new Rewriter.
+type NewRewriterFn func(
+	reader DataFileSetReader,
+	blockAllocSize int,
+	srPool xio.SegmentReaderPool,
+	multiIterPool encoding.MultiReaderIteratorPool,
+	identPool ident.Pool,
+	encoderPool encoding.EncoderPool,
+	opts RewriteOptions,
+) Rewriter
+
 // Segments represents on index segments on disk for an index volume.
 type Segments interface {
 	ShardTimeRanges() result.ShardTimeRanges
@@ -70,10 +70,12 @@ type writer struct {
 	checkpointFilePath         string
 	indexEntries               indexEntries
 
-	start        time.Time
-	volumeIndex  int
-	snapshotTime time.Time
-	snapshotID   uuid.UUID
+	start                 time.Time
+	volumeIndex           int
+	snapshotTime          time.Time
+	snapshotID            uuid.UUID
+	downsampledResolution time.Duration
+	encodingFormat        string
 
 	currIdx            int64
 	currOffset         int64
@@ -227,6 +229,8 @@ func (w *writer) reset(opts DataWriterOpenOptions) {
 	w.volumeIndex = opts.Identifier.VolumeIndex
 	w.snapshotTime = opts.Snapshot.SnapshotTime
 	w.snapshotID = opts.Snapshot.SnapshotID
+	w.downsampledResolution = opts.DownsampledResolution
+	w.encodingFormat = opts.EncodingFormat
 	w.currIdx = 0
 	w.currOffset = 0
 	w.err = nil
@@ -556,6 +560,8 @@ func (w *writer) writeInfoFileContents(
 			NumElementsM: int64(bloomFilter.M()),
 			NumHashesK:   int64(bloomFilter.K()),
 		},
+		DownsampledResolution: int64(w.downsampledResolution),
+		EncodingFormat:        w.encodingFormat,
 	}
 
 	w.encoder.Reset()
@@ -240,17 +240,17 @@ func (mr *MockSnapshotPreparerMockRecorder) PrepareData(opts interface{}) *gomoc
 }
 
 // DoneSnapshot mocks base method
-func (m *MockSnapshotPreparer) DoneSnapshot(snapshotUUID uuid.UUID, commitLogIdentifier CommitLogFile) error {
+func (m *MockSnapshotPreparer) DoneSnapshot(snapshotUUID uuid.UUID, commitLogIdentifier CommitLogFile, highWaterMarks []SnapshotHighWaterMark) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DoneSnapshot", snapshotUUID, commitLogIdentifier)
+	ret := m.ctrl.Call(m, "DoneSnapshot", snapshotUUID, commitLogIdentifier, highWaterMarks)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DoneSnapshot indicates an expected call of DoneSnapshot
-func (mr *MockSnapshotPreparerMockRecorder) DoneSnapshot(snapshotUUID, commitLogIdentifier interface{}) *gomock.Call {
+func (mr *MockSnapshotPreparerMockRecorder) DoneSnapshot(snapshotUUID, commitLogIdentifier, highWaterMarks interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoneSnapshot", reflect.TypeOf((*MockSnapshotPreparer)(nil).DoneSnapshot), snapshotUUID, commitLogIdentifier)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoneSnapshot", reflect.TypeOf((*MockSnapshotPreparer)(nil).DoneSnapshot), snapshotUUID, commitLogIdentifier, highWaterMarks)
 }
 
 // MockIndexFlush is a mock of IndexFlush interface
@@ -41,6 +41,12 @@ type IndexInfo struct {
 	FileType     persist.FileSetType
 	SnapshotID   []byte
 	VolumeIndex  int
+	// DownsampledResolution is the resolution in nanoseconds that the
+	// volume was downsampled to, or zero if it holds raw datapoints.
+	DownsampledResolution int64
+	// EncodingFormat identifies the encoding of the datapoints of the
+	// volume, empty if unknown.
+	EncodingFormat string
 }
 
 // IndexSummariesInfo stores metadata about the summaries
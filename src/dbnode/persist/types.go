@@ -66,6 +66,39 @@ type CommitLogFile struct {
 	Index    int64
 }
 
+// CommitLogPosition is a position in the commit log, identified by the index
+// of a commit log file and the number of entries written to that file.
+type CommitLogPosition struct {
+	Index      int64
+	NumEntries int64
+}
+
+// Covers returns whether the entry at the given offset of the commit log file
+// with the given index was written before this position.
+func (p CommitLogPosition) Covers(fileIndex int64, entryOffset int64) bool {
+	if fileIndex != p.Index {
+		return fileIndex < p.Index
+	}
+	return entryOffset < p.NumEntries
+}
+
+// Before returns whether this position precedes the other position.
+func (p CommitLogPosition) Before(other CommitLogPosition) bool {
+	if p.Index != other.Index {
+		return p.Index < other.Index
+	}
+	return p.NumEntries < other.NumEntries
+}
+
+// SnapshotHighWaterMark is the commit log position reached before a shard
+// of a namespace began snapshotting, every commit log entry for the shard
+// preceding the position is contained in the shard's snapshot files.
+type SnapshotHighWaterMark struct {
+	Namespace ident.ID
+	Shard     uint32
+	Position  CommitLogPosition
+}
+
 // IndexFn is a function that persists a m3ninx MutableSegment.
 type IndexFn func(segment.Builder) error
 
@@ -117,8 +150,14 @@ type FlushPreparer interface {
 type SnapshotPreparer interface {
 	Preparer
 
-	// DoneSnapshot marks the snapshot as complete.
-	DoneSnapshot(snapshotUUID uuid.UUID, commitLogIdentifier CommitLogFile) error
+	// DoneSnapshot marks the snapshot as complete, recording the commit log
+	// that was rotated to before the snapshot began and the high-water marks
+	// of the shards that were successfully snapshotted.
+	DoneSnapshot(
+		snapshotUUID uuid.UUID,
+		commitLogIdentifier CommitLogFile,
+		highWaterMarks []SnapshotHighWaterMark,
+	) error
 }
 
 // IndexFlush is a persist flush cycle, each namespace, block combination needs
@@ -147,6 +186,14 @@ type DataPrepareOptions struct {
 	DeleteIfExists bool
 	// Snapshot options are applicable to snapshots (index yes, data yes)
 	Snapshot DataPrepareSnapshotOptions
+	// DownsampledResolution is the resolution the datapoints written to the
+	// volume were downsampled to, it is recorded in the info file so that it
+	// survives restarts. Zero means the volume holds raw datapoints.
+	DownsampledResolution time.Duration
+	// EncodingFormat identifies the encoding of the datapoints written to
+	// the volume, it is recorded in the info file so that background
+	// rewrites only rewrite volumes encoded differently. Empty means unknown.
+	EncodingFormat string
 }
 
 // IndexPrepareOptions is the options struct for the IndexFlush's Prepare method.
@@ -0,0 +1,261 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ratelimit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
+
+	"go.uber.org/atomic"
+)
+
+// QuotaResource is a resource that a namespace quota limits.
+type QuotaResource uint
+
+const (
+	// DatapointsQuotaResource is the number of datapoints written per second.
+	DatapointsQuotaResource QuotaResource = iota
+	// NewSeriesQuotaResource is the number of new series inserted per second.
+	NewSeriesQuotaResource
+	// IndexDocsMatchedQuotaResource is the number of index documents matched
+	// by queries per second.
+	IndexDocsMatchedQuotaResource
+)
+
+func (r QuotaResource) String() string {
+	switch r {
+	case DatapointsQuotaResource:
+		return "datapoints"
+	case NewSeriesQuotaResource:
+		return "new-series"
+	case IndexDocsMatchedQuotaResource:
+		return "index-docs-matched"
+	}
+	return "unknown"
+}
+
+// Quota is a set of per second limits for a single namespace, a zero value
+// for any limit means that limit is not enforced.
+type Quota struct {
+	DatapointsPerSecond       int64
+	NewSeriesPerSecond        int64
+	IndexDocsMatchedPerSecond int64
+}
+
+// Validate validates the quota.
+func (q Quota) Validate() error {
+	if q.DatapointsPerSecond < 0 ||
+		q.NewSeriesPerSecond < 0 ||
+		q.IndexDocsMatchedPerSecond < 0 {
+		return fmt.Errorf("quota limits cannot be negative: %+v", q)
+	}
+	return nil
+}
+
+// NamespaceQuotas is a set of quotas keyed by namespace ID, namespaces
+// without an entry have no limits enforced.
+type NamespaceQuotas map[string]Quota
+
+// Validate validates the namespace quotas.
+func (q NamespaceQuotas) Validate() error {
+	for namespace, quota := range q {
+		if err := quota.Validate(); err != nil {
+			return fmt.Errorf("invalid quota for namespace %s: %v", namespace, err)
+		}
+	}
+	return nil
+}
+
+// QuotaExceededError is returned when an operation is rejected because
+// it would exceed a namespace quota.
+type QuotaExceededError struct {
+	Namespace string
+	Resource  QuotaResource
+	Limit     int64
+}
+
+func (e QuotaExceededError) Error() string {
+	return fmt.Sprintf("namespace %s exceeded quota of %d %s per second",
+		e.Namespace, e.Limit, e.Resource.String())
+}
+
+// IsQuotaExceededError returns whether the error is or wraps an error
+// returned due to a namespace quota being exceeded.
+func IsQuotaExceededError(err error) bool {
+	for err != nil {
+		if _, ok := err.(QuotaExceededError); ok {
+			return true
+		}
+		err = xerrors.InnerError(err)
+	}
+	return false
+}
+
+// QuotaLimiter enforces a quota for a single namespace over fixed one
+// second windows, it is safe for concurrent use. Limits and counts are held
+// in atomics so that concurrent writes to a namespace do not contend on a
+// lock.
+type QuotaLimiter struct {
+	namespace string
+	nowFn     clock.NowFn
+
+	datapointsLimit       atomic.Int64
+	newSeriesLimit        atomic.Int64
+	indexDocsMatchedLimit atomic.Int64
+
+	windowNanos      atomic.Int64
+	datapoints       atomic.Int64
+	newSeries        atomic.Int64
+	indexDocsMatched atomic.Int64
+}
+
+// NewQuotaLimiter returns a new quota limiter for a namespace that enforces
+// no limits until a quota is set.
+func NewQuotaLimiter(namespace string, nowFn clock.NowFn) *QuotaLimiter {
+	return &QuotaLimiter{
+		namespace: namespace,
+		nowFn:     nowFn,
+	}
+}
+
+// SetQuota sets the quota to enforce, counts for the current window are
+// retained.
+func (l *QuotaLimiter) SetQuota(value Quota) {
+	l.datapointsLimit.Store(value.DatapointsPerSecond)
+	l.newSeriesLimit.Store(value.NewSeriesPerSecond)
+	l.indexDocsMatchedLimit.Store(value.IndexDocsMatchedPerSecond)
+}
+
+// Quota returns the quota being enforced.
+func (l *QuotaLimiter) Quota() Quota {
+	return Quota{
+		DatapointsPerSecond:       l.datapointsLimit.Load(),
+		NewSeriesPerSecond:        l.newSeriesLimit.Load(),
+		IndexDocsMatchedPerSecond: l.indexDocsMatchedLimit.Load(),
+	}
+}
+
+// AddWrite accounts for datapoints being written and the new series they
+// insert, returning an error and not accounting for either if they would
+// exceed the quota. Datapoints are only accounted for once the new series
+// have been admitted so that rejected inserts do not use the datapoints
+// quota.
+func (l *QuotaLimiter) AddWrite(datapoints, newSeries int64) error {
+	l.rotate()
+	if newSeries > 0 {
+		limit := l.newSeriesLimit.Load()
+		if !tryAdd(&l.newSeries, newSeries, limit) {
+			return l.exceededError(NewSeriesQuotaResource, limit)
+		}
+	}
+	limit := l.datapointsLimit.Load()
+	if !tryAdd(&l.datapoints, datapoints, limit) {
+		// The write was not admitted, so neither are the new series.
+		l.newSeries.Sub(newSeries)
+		return l.exceededError(DatapointsQuotaResource, limit)
+	}
+	return nil
+}
+
+// RefundWrite refunds datapoints and new series accounted for by a write
+// that was admitted but then failed. Refunds racing with the rotation into
+// a new window may refund the new window which only ever admits more than
+// the quota.
+func (l *QuotaLimiter) RefundWrite(datapoints, newSeries int64) {
+	l.datapoints.Sub(datapoints)
+	l.newSeries.Sub(newSeries)
+}
+
+// IndexDocsMatchedLimit returns the number of index documents a query may
+// still match in the current window, zero meaning no limit. An error is
+// returned if the quota for the current window is already exhausted.
+func (l *QuotaLimiter) IndexDocsMatchedLimit() (int, error) {
+	l.rotate()
+	limit := l.indexDocsMatchedLimit.Load()
+	if limit <= 0 {
+		return 0, nil
+	}
+	remaining := limit - l.indexDocsMatched.Load()
+	if remaining <= 0 {
+		return 0, l.exceededError(IndexDocsMatchedQuotaResource, limit)
+	}
+	return int(remaining), nil
+}
+
+// AddIndexDocsMatched accounts for index documents matched by a query,
+// since the query has already executed these are always accounted for. An
+// error is returned if they exceed the quota for the current window.
+func (l *QuotaLimiter) AddIndexDocsMatched(n int64) error {
+	l.rotate()
+	matched := l.indexDocsMatched.Add(n)
+	limit := l.indexDocsMatchedLimit.Load()
+	if limit > 0 && matched > limit {
+		return l.exceededError(IndexDocsMatchedQuotaResource, limit)
+	}
+	return nil
+}
+
+func (l *QuotaLimiter) rotate() {
+	windowNanos := l.nowFn().Truncate(time.Second).UnixNano()
+	current := l.windowNanos.Load()
+	if current >= windowNanos {
+		return
+	}
+	if !l.windowNanos.CAS(current, windowNanos) {
+		// Another caller rolled into the new window.
+		return
+	}
+	// Rolled into a new window, counts added concurrently with the reset
+	// may be lost which only ever admits more than the quota.
+	l.datapoints.Store(0)
+	l.newSeries.Store(0)
+	l.indexDocsMatched.Store(0)
+}
+
+func (l *QuotaLimiter) exceededError(
+	resource QuotaResource,
+	limit int64,
+) error {
+	return QuotaExceededError{
+		Namespace: l.namespace,
+		Resource:  resource,
+		Limit:     limit,
+	}
+}
+
+func tryAdd(value *atomic.Int64, n int64, limit int64) bool {
+	if limit <= 0 {
+		value.Add(n)
+		return true
+	}
+	for {
+		current := value.Load()
+		if current+n > limit {
+			return false
+		}
+		if value.CAS(current, current+n) {
+			return true
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ratelimit
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	xerrors "github.com/m3db/m3/src/x/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestQuotaLimiterNoQuota(t *testing.T) {
+	l := NewQuotaLimiter("foo", time.Now)
+	require.NoError(t, l.AddWrite(1000, 1000))
+
+	limit, err := l.IndexDocsMatchedLimit()
+	require.NoError(t, err)
+	assert.Equal(t, 0, limit)
+}
+
+func TestQuotaLimiterEnforcesPerSecondWindows(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := NewQuotaLimiter("foo", func() time.Time { return now })
+	l.SetQuota(Quota{
+		DatapointsPerSecond:       10,
+		NewSeriesPerSecond:        2,
+		IndexDocsMatchedPerSecond: 100,
+	})
+
+	require.NoError(t, l.AddWrite(8, 0))
+	err := l.AddWrite(3, 0)
+	require.Error(t, err)
+	assert.True(t, IsQuotaExceededError(err))
+	assert.Equal(t, QuotaExceededError{
+		Namespace: "foo",
+		Resource:  DatapointsQuotaResource,
+		Limit:     10,
+	}, err)
+	// Rejected datapoints are not accounted for.
+	require.NoError(t, l.AddWrite(2, 0))
+
+	now = now.Add(time.Second)
+	require.NoError(t, l.AddWrite(1, 2))
+	assert.True(t, IsQuotaExceededError(l.AddWrite(1, 1)))
+
+	limit, err := l.IndexDocsMatchedLimit()
+	require.NoError(t, err)
+	assert.Equal(t, 100, limit)
+	require.NoError(t, l.AddIndexDocsMatched(60))
+	limit, err = l.IndexDocsMatchedLimit()
+	require.NoError(t, err)
+	assert.Equal(t, 40, limit)
+	require.NoError(t, l.AddIndexDocsMatched(40))
+	_, err = l.IndexDocsMatchedLimit()
+	assert.True(t, IsQuotaExceededError(err))
+	// Documents matched beyond the quota are accounted for but rejected.
+	assert.Equal(t, QuotaExceededError{
+		Namespace: "foo",
+		Resource:  IndexDocsMatchedQuotaResource,
+		Limit:     100,
+	}, l.AddIndexDocsMatched(1))
+
+	// Moving into the next window resets all counts.
+	now = now.Add(time.Second)
+	require.NoError(t, l.AddWrite(10, 2))
+	limit, err = l.IndexDocsMatchedLimit()
+	require.NoError(t, err)
+	assert.Equal(t, 100, limit)
+}
+
+func TestQuotaLimiterAddWriteAdmitsNewSeriesAndDatapointsTogether(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := NewQuotaLimiter("foo", func() time.Time { return now })
+	l.SetQuota(Quota{
+		DatapointsPerSecond: 2,
+		NewSeriesPerSecond:  1,
+	})
+
+	require.NoError(t, l.AddWrite(1, 1))
+
+	// Writes rejected by the new series quota do not use the datapoints quota.
+	err := l.AddWrite(1, 1)
+	assert.Equal(t, QuotaExceededError{
+		Namespace: "foo",
+		Resource:  NewSeriesQuotaResource,
+		Limit:     1,
+	}, err)
+	require.NoError(t, l.AddWrite(1, 0))
+
+	// Writes rejected by the datapoints quota do not use the new series quota.
+	l.SetQuota(Quota{
+		DatapointsPerSecond: 2,
+		NewSeriesPerSecond:  2,
+	})
+	err = l.AddWrite(1, 1)
+	assert.Equal(t, QuotaExceededError{
+		Namespace: "foo",
+		Resource:  DatapointsQuotaResource,
+		Limit:     2,
+	}, err)
+	require.NoError(t, l.AddWrite(0, 1))
+}
+
+func TestQuotaLimiterConcurrentWrites(t *testing.T) {
+	l := NewQuotaLimiter("foo", func() time.Time { return time.Unix(1000, 0) })
+	l.SetQuota(Quota{DatapointsPerSecond: 100})
+
+	var (
+		wg       sync.WaitGroup
+		admitted atomic.Int64
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if l.AddWrite(1, 0) == nil {
+					admitted.Inc()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(100), admitted.Load())
+}
+
+func TestIsQuotaExceededErrorWrapped(t *testing.T) {
+	err := xerrors.NewNonRetryableError(QuotaExceededError{Namespace: "foo"})
+	assert.True(t, IsQuotaExceededError(err))
+	assert.False(t, IsQuotaExceededError(xerrors.NewNonRetryableError(assert.AnError)))
+}
+
+func TestNamespaceQuotasValidate(t *testing.T) {
+	require.NoError(t, NamespaceQuotas{"foo": {DatapointsPerSecond: 1}}.Validate())
+	require.Error(t, NamespaceQuotas{"foo": {NewSeriesPerSecond: -1}}.Validate())
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package replication
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+)
+
+const checkpointTempFilePattern = ".checkpoint-*"
+
+// checkpoint is the progress of replication persisted to disk.
+type checkpoint struct {
+	// CommitLog is the commit log position up to which every entry has been
+	// replicated.
+	CommitLog persist.CommitLogPosition `json:"commitLog"`
+
+	// CommitLogCoveredSince is by namespace the time since which every write
+	// is held by commit logs that are replicated or pending replication.
+	CommitLogCoveredSince map[string]time.Time `json:"commitLogCoveredSince"`
+
+	// FileSets are the data fileset volumes replicated by namespace and shard.
+	FileSets map[string]map[uint32][]checkpointFileSet `json:"fileSets"`
+
+	// CaughtUpAt is when replication last caught up with every fileset and
+	// rotated commit log.
+	CaughtUpAt time.Time `json:"caughtUpAt"`
+}
+
+type checkpointFileSet struct {
+	BlockStart time.Time `json:"blockStart"`
+	Volume     int       `json:"volume"`
+
+	// CommitLogIndex is set when the data of the volume is replicated by the
+	// commit logs up to and including the index rather than by the volume.
+	CommitLogIndex *int64 `json:"commitLogIndex,omitempty"`
+}
+
+func newCheckpoint() *checkpoint {
+	return &checkpoint{
+		CommitLogCoveredSince: make(map[string]time.Time),
+		FileSets:              make(map[string]map[uint32][]checkpointFileSet),
+	}
+}
+
+// readCheckpoint reads the checkpoint at the given path, returning an empty
+// checkpoint if none has been written yet.
+func readCheckpoint(path string) (*checkpoint, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return newCheckpoint(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := newCheckpoint()
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	if c.CommitLogCoveredSince == nil {
+		c.CommitLogCoveredSince = make(map[string]time.Time)
+	}
+	if c.FileSets == nil {
+		c.FileSets = make(map[string]map[uint32][]checkpointFileSet)
+	}
+	return c, nil
+}
+
+// write writes the checkpoint to the given path, the checkpoint is written
+// to a temporary file and renamed so that a partially written checkpoint is
+// never read.
+func (c *checkpoint) write(path string, fileMode os.FileMode, dirMode os.FileMode) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, checkpointTempFilePattern)
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), fileMode)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+func (c *checkpoint) fileSetReplicated(
+	namespace string,
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+) bool {
+	for _, f := range c.FileSets[namespace][shard] {
+		if f.BlockStart.Equal(blockStart) && f.Volume == volume {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *checkpoint) markFileSetReplicated(
+	namespace string,
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+) {
+	c.addFileSet(namespace, shard, checkpointFileSet{
+		BlockStart: blockStart,
+		Volume:     volume,
+	})
+}
+
+// markFileSetCoveredByCommitLogs marks the volume as replicated by the
+// commit logs up to and including the given index.
+func (c *checkpoint) markFileSetCoveredByCommitLogs(
+	namespace string,
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+	commitLogIndex int64,
+) {
+	c.addFileSet(namespace, shard, checkpointFileSet{
+		BlockStart:     blockStart,
+		Volume:         volume,
+		CommitLogIndex: &commitLogIndex,
+	})
+}
+
+func (c *checkpoint) addFileSet(
+	namespace string,
+	shard uint32,
+	fileSet checkpointFileSet,
+) {
+	shards, ok := c.FileSets[namespace]
+	if !ok {
+		shards = make(map[uint32][]checkpointFileSet)
+		c.FileSets[namespace] = shards
+	}
+	shards[shard] = append(shards[shard], fileSet)
+}
+
+// commitLogsMissed resets the commit log coverage of every namespace to the
+// given time and unmarks the volumes covered by commit logs from the given
+// index onwards, which were removed before being replicated, so that the
+// volumes themselves are replicated.
+func (c *checkpoint) commitLogsMissed(fromIndex int64, coveredSince time.Time) {
+	for ns := range c.CommitLogCoveredSince {
+		c.CommitLogCoveredSince[ns] = coveredSince
+	}
+	for ns, shards := range c.FileSets {
+		for shard := range shards {
+			c.retainFileSets(ns, shard, func(f checkpointFileSet) bool {
+				return f.CommitLogIndex == nil || *f.CommitLogIndex < fromIndex
+			})
+		}
+	}
+}
+
+// retainFileSets removes the filesets of the namespace and shard that are no
+// longer on disk, such as those removed by retention, so that the checkpoint
+// does not grow without bound.
+func (c *checkpoint) retainFileSets(
+	namespace string,
+	shard uint32,
+	exists func(f checkpointFileSet) bool,
+) {
+	fileSets := c.FileSets[namespace][shard]
+	retained := fileSets[:0]
+	for _, f := range fileSets {
+		if exists(f) {
+			retained = append(retained, f)
+		}
+	}
+	if len(retained) == 0 {
+		delete(c.FileSets[namespace], shard)
+		return
+	}
+	c.FileSets[namespace][shard] = retained
+}
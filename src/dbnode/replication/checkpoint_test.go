@@ -0,0 +1,128 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package replication
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointReadMissingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replication-checkpoint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c, err := readCheckpoint(filepath.Join(dir, "checkpoint.json"))
+	require.NoError(t, err)
+	assert.Equal(t, persist.CommitLogPosition{}, c.CommitLog)
+	assert.Equal(t, 0, len(c.FileSets))
+	assert.True(t, c.CaughtUpAt.IsZero())
+}
+
+func TestCheckpointWriteReadRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replication-checkpoint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		path       = filepath.Join(dir, "cluster", "checkpoint.json")
+		blockStart = time.Unix(1577836800, 0).UTC()
+		caughtUpAt = blockStart.Add(3 * time.Hour)
+	)
+	c := newCheckpoint()
+	c.CommitLog = persist.CommitLogPosition{Index: 3, NumEntries: 42}
+	c.CaughtUpAt = caughtUpAt
+	c.CommitLogCoveredSince["metrics"] = blockStart
+	c.markFileSetReplicated("metrics", 7, blockStart, 1)
+	c.markFileSetCoveredByCommitLogs("metrics", 7, blockStart, 2, 3)
+	require.NoError(t, c.write(path, 0666, 0755))
+
+	read, err := readCheckpoint(path)
+	require.NoError(t, err)
+	assert.Equal(t, c.CommitLog, read.CommitLog)
+	assert.True(t, caughtUpAt.Equal(read.CaughtUpAt))
+	assert.True(t, blockStart.Equal(read.CommitLogCoveredSince["metrics"]))
+	assert.True(t, read.fileSetReplicated("metrics", 7, blockStart, 1))
+	assert.True(t, read.fileSetReplicated("metrics", 7, blockStart, 2))
+	assert.Equal(t, c.FileSets, read.FileSets)
+	assert.False(t, read.fileSetReplicated("metrics", 7, blockStart, 0))
+	assert.False(t, read.fileSetReplicated("metrics", 8, blockStart, 1))
+	assert.False(t, read.fileSetReplicated("other", 7, blockStart, 1))
+
+	// Ensure no temporary files are left behind.
+	files, err := ioutil.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	assert.Equal(t, "checkpoint.json", files[0].Name())
+}
+
+func TestCheckpointRetainFileSets(t *testing.T) {
+	var (
+		c          = newCheckpoint()
+		blockStart = time.Unix(1577836800, 0)
+		blockSize  = 2 * time.Hour
+	)
+	for i := 0; i < 3; i++ {
+		c.markFileSetReplicated("metrics", 0, blockStart.Add(time.Duration(i)*blockSize), 0)
+	}
+	c.markFileSetReplicated("metrics", 1, blockStart, 0)
+
+	c.retainFileSets("metrics", 0, func(f checkpointFileSet) bool {
+		return !f.BlockStart.Equal(blockStart)
+	})
+	assert.False(t, c.fileSetReplicated("metrics", 0, blockStart, 0))
+	assert.True(t, c.fileSetReplicated("metrics", 0, blockStart.Add(blockSize), 0))
+	assert.True(t, c.fileSetReplicated("metrics", 0, blockStart.Add(2*blockSize), 0))
+	assert.True(t, c.fileSetReplicated("metrics", 1, blockStart, 0))
+
+	c.retainFileSets("metrics", 1, func(f checkpointFileSet) bool {
+		return false
+	})
+	_, ok := c.FileSets["metrics"][1]
+	assert.False(t, ok)
+}
+
+func TestCheckpointCommitLogsMissed(t *testing.T) {
+	var (
+		c            = newCheckpoint()
+		blockStart   = time.Unix(1577836800, 0)
+		blockSize    = 2 * time.Hour
+		coveredSince = blockStart.Add(3 * time.Hour)
+	)
+	c.CommitLogCoveredSince["metrics"] = blockStart
+	c.markFileSetReplicated("metrics", 0, blockStart, 0)
+	c.markFileSetCoveredByCommitLogs("metrics", 0, blockStart.Add(blockSize), 0, 2)
+	c.markFileSetCoveredByCommitLogs("metrics", 0, blockStart.Add(2*blockSize), 0, 4)
+
+	// Only the volumes held by the missed commit logs are unmarked.
+	c.commitLogsMissed(3, coveredSince)
+	assert.True(t, coveredSince.Equal(c.CommitLogCoveredSince["metrics"]))
+	assert.True(t, c.fileSetReplicated("metrics", 0, blockStart, 0))
+	assert.True(t, c.fileSetReplicated("metrics", 0, blockStart.Add(blockSize), 0))
+	assert.False(t, c.fileSetReplicated("metrics", 0, blockStart.Add(2*blockSize), 0))
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package replication
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/pool"
+	xretry "github.com/m3db/m3/src/x/retry"
+)
+
+const (
+	// defaultInterval is the default interval between replicating newly
+	// flushed filesets and rotated commit logs.
+	defaultInterval = 10 * time.Second
+
+	// defaultBatchSize is the default number of datapoints written to the
+	// remote cluster before progress is checkpointed.
+	defaultBatchSize = 4096
+
+	// defaultWriteConcurrency is the default number of concurrent writes to
+	// the remote cluster.
+	defaultWriteConcurrency = 64
+)
+
+var (
+	// defaultWriteRetrier is the default retrier of writes to the remote
+	// cluster that fail with a transient error.
+	defaultWriteRetrier = xretry.NewRetrier(
+		xretry.NewOptions().
+			SetInitialBackoff(time.Second).
+			SetBackoffFactor(2).
+			SetMaxRetries(5).
+			SetJitter(true))
+)
+
+var (
+	errNoClient                = errors.New("replication client is not set")
+	errNoRemoteNamespaceInit   = errors.New("replication remote namespace initializer is not set")
+	errInvalidInterval         = errors.New("replication interval must be positive")
+	errInvalidBatchSize        = errors.New("replication batch size must be positive")
+	errInvalidWriteConcurrency = errors.New("replication write concurrency must be positive")
+	errNoCheckpointFilePath    = errors.New("replication checkpoint file path is not set")
+)
+
+type options struct {
+	clockOpts               clock.Options
+	instrumentOpts          instrument.Options
+	client                  client.Client
+	remoteNamespaceInit     namespace.Initializer
+	localNamespaceInit      namespace.Initializer
+	namespaces              []ident.ID
+	interval                time.Duration
+	batchSize               int
+	writeConcurrency        int
+	writeRetrier            xretry.Retrier
+	checkpointFilePath      string
+	fsOpts                  fs.Options
+	commitLogOpts           commitlog.Options
+	bytesPool               pool.CheckedBytesPool
+	segmentReaderPool       xio.SegmentReaderPool
+	multiReaderIteratorPool encoding.MultiReaderIteratorPool
+	identifierPool          ident.Pool
+}
+
+// NewOptions returns new replicator options.
+func NewOptions() Options {
+	bytesPool := pool.NewCheckedBytesPool(nil, nil, func(s []pool.Bucket) pool.BytesPool {
+		return pool.NewBytesPool(s, nil)
+	})
+	bytesPool.Init()
+
+	segmentReaderPool := xio.NewSegmentReaderPool(nil)
+	segmentReaderPool.Init()
+
+	encodingOpts := encoding.NewOptions().SetBytesPool(bytesPool)
+	multiReaderIteratorPool := encoding.NewMultiReaderIteratorPool(nil)
+	multiReaderIteratorPool.Init(func(r io.Reader, _ namespace.SchemaDescr) encoding.ReaderIterator {
+		return histogram.NewReaderIteratorWithFallback(r,
+			m3tsz.NewReaderIterator(nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts),
+			encodingOpts)
+	})
+
+	return &options{
+		clockOpts:               clock.NewOptions(),
+		instrumentOpts:          instrument.NewOptions(),
+		interval:                defaultInterval,
+		batchSize:               defaultBatchSize,
+		writeConcurrency:        defaultWriteConcurrency,
+		writeRetrier:            defaultWriteRetrier,
+		fsOpts:                  fs.NewOptions(),
+		commitLogOpts:           commitlog.NewOptions(),
+		bytesPool:               bytesPool,
+		segmentReaderPool:       segmentReaderPool,
+		multiReaderIteratorPool: multiReaderIteratorPool,
+		identifierPool:          ident.NewPool(bytesPool, ident.PoolOptions{}),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.client == nil {
+		return errNoClient
+	}
+	if o.remoteNamespaceInit == nil {
+		return errNoRemoteNamespaceInit
+	}
+	if o.interval <= 0 {
+		return errInvalidInterval
+	}
+	if o.batchSize <= 0 {
+		return errInvalidBatchSize
+	}
+	if o.writeConcurrency <= 0 {
+		return errInvalidWriteConcurrency
+	}
+	if o.checkpointFilePath == "" {
+		return errNoCheckpointFilePath
+	}
+	return nil
+}
+
+func (o *options) SetClockOptions(value clock.Options) Options {
+	opts := *o
+	opts.clockOpts = value
+	return &opts
+}
+
+func (o *options) ClockOptions() clock.Options {
+	return o.clockOpts
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
+
+func (o *options) SetClient(value client.Client) Options {
+	opts := *o
+	opts.client = value
+	return &opts
+}
+
+func (o *options) Client() client.Client {
+	return o.client
+}
+
+func (o *options) SetRemoteNamespaceInitializer(value namespace.Initializer) Options {
+	opts := *o
+	opts.remoteNamespaceInit = value
+	return &opts
+}
+
+func (o *options) RemoteNamespaceInitializer() namespace.Initializer {
+	return o.remoteNamespaceInit
+}
+
+func (o *options) SetLocalNamespaceInitializer(value namespace.Initializer) Options {
+	opts := *o
+	opts.localNamespaceInit = value
+	return &opts
+}
+
+func (o *options) LocalNamespaceInitializer() namespace.Initializer {
+	return o.localNamespaceInit
+}
+
+func (o *options) SetNamespaces(value []ident.ID) Options {
+	opts := *o
+	opts.namespaces = value
+	return &opts
+}
+
+func (o *options) Namespaces() []ident.ID {
+	return o.namespaces
+}
+
+func (o *options) SetInterval(value time.Duration) Options {
+	opts := *o
+	opts.interval = value
+	return &opts
+}
+
+func (o *options) Interval() time.Duration {
+	return o.interval
+}
+
+func (o *options) SetBatchSize(value int) Options {
+	opts := *o
+	opts.batchSize = value
+	return &opts
+}
+
+func (o *options) BatchSize() int {
+	return o.batchSize
+}
+
+func (o *options) SetWriteConcurrency(value int) Options {
+	opts := *o
+	opts.writeConcurrency = value
+	return &opts
+}
+
+func (o *options) WriteConcurrency() int {
+	return o.writeConcurrency
+}
+
+func (o *options) SetWriteRetrier(value xretry.Retrier) Options {
+	opts := *o
+	opts.writeRetrier = value
+	return &opts
+}
+
+func (o *options) WriteRetrier() xretry.Retrier {
+	return o.writeRetrier
+}
+
+func (o *options) SetCheckpointFilePath(value string) Options {
+	opts := *o
+	opts.checkpointFilePath = value
+	return &opts
+}
+
+func (o *options) CheckpointFilePath() string {
+	return o.checkpointFilePath
+}
+
+func (o *options) SetFilesystemOptions(value fs.Options) Options {
+	opts := *o
+	opts.fsOpts = value
+	return &opts
+}
+
+func (o *options) FilesystemOptions() fs.Options {
+	return o.fsOpts
+}
+
+func (o *options) SetCommitLogOptions(value commitlog.Options) Options {
+	opts := *o
+	opts.commitLogOpts = value
+	return &opts
+}
+
+func (o *options) CommitLogOptions() commitlog.Options {
+	return o.commitLogOpts
+}
+
+func (o *options) SetBytesPool(value pool.CheckedBytesPool) Options {
+	opts := *o
+	opts.bytesPool = value
+	return &opts
+}
+
+func (o *options) BytesPool() pool.CheckedBytesPool {
+	return o.bytesPool
+}
+
+func (o *options) SetSegmentReaderPool(value xio.SegmentReaderPool) Options {
+	opts := *o
+	opts.segmentReaderPool = value
+	return &opts
+}
+
+func (o *options) SegmentReaderPool() xio.SegmentReaderPool {
+	return o.segmentReaderPool
+}
+
+func (o *options) SetMultiReaderIteratorPool(value encoding.MultiReaderIteratorPool) Options {
+	opts := *o
+	opts.multiReaderIteratorPool = value
+	return &opts
+}
+
+func (o *options) MultiReaderIteratorPool() encoding.MultiReaderIteratorPool {
+	return o.multiReaderIteratorPool
+}
+
+func (o *options) SetIdentifierPool(value ident.Pool) Options {
+	opts := *o
+	opts.identifierPool = value
+	return &opts
+}
+
+func (o *options) IdentifierPool() ident.Pool {
+	return o.identifierPool
+}
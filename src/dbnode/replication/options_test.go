@@ -0,0 +1,51 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package replication
+
+import (
+	"testing"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/namespace"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionsValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := NewOptions()
+	assert.Equal(t, errNoClient, opts.Validate())
+
+	opts = opts.SetClient(client.NewMockClient(ctrl))
+	assert.Equal(t, errNoRemoteNamespaceInit, opts.Validate())
+
+	opts = opts.SetRemoteNamespaceInitializer(namespace.NewStaticInitializer(nil))
+	assert.Equal(t, errNoCheckpointFilePath, opts.Validate())
+
+	opts = opts.SetCheckpointFilePath("/var/lib/m3db/replication/checkpoint.json")
+	assert.NoError(t, opts.Validate())
+
+	assert.Equal(t, errInvalidInterval, opts.SetInterval(0).Validate())
+	assert.Equal(t, errInvalidBatchSize, opts.SetBatchSize(0).Validate())
+	assert.Equal(t, errInvalidWriteConcurrency, opts.SetWriteConcurrency(-1).Validate())
+}
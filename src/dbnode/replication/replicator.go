@@ -0,0 +1,844 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package replication
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/checked"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+var (
+	errReplicatorAlreadyOpen = errors.New("replicator is already open")
+	errReplicatorNotOpen     = errors.New("replicator is not open")
+	errReplicatorClosed      = errors.New("replicator is closed")
+)
+
+type replicatorState int
+
+const (
+	replicatorNotOpen replicatorState = iota
+	replicatorOpen
+	replicatorClosed
+)
+
+type replicatorMetrics struct {
+	lag                  tally.Gauge
+	fileSetsReplicated   tally.Counter
+	commitLogsReplicated tally.Counter
+	commitLogsMissed     tally.Counter
+	fileSetsCovered      tally.Counter
+	datapointsReplicated tally.Counter
+	datapointsSkipped    tally.Counter
+	writeErrors          tally.Counter
+	errors               tally.Counter
+}
+
+func newReplicatorMetrics(scope tally.Scope) replicatorMetrics {
+	return replicatorMetrics{
+		lag:                  scope.Gauge("lag"),
+		fileSetsReplicated:   scope.Counter("filesets-replicated"),
+		commitLogsReplicated: scope.Counter("commitlogs-replicated"),
+		commitLogsMissed:     scope.Counter("commitlogs-missed"),
+		fileSetsCovered:      scope.Counter("filesets-covered"),
+		datapointsReplicated: scope.Counter("datapoints-replicated"),
+		datapointsSkipped:    scope.Counter("datapoints-skipped"),
+		writeErrors:          scope.Counter("write-errors"),
+		errors:               scope.Counter("errors"),
+	}
+}
+
+type replicatedWrite struct {
+	namespace  ident.ID
+	id         ident.ID
+	tags       ident.Tags
+	datapoint  ts.Datapoint
+	unit       xtime.Unit
+	annotation ts.Annotation
+}
+
+type replicator struct {
+	sync.Mutex
+
+	opts    Options
+	log     *zap.Logger
+	nowFn   func() time.Time
+	reader  fs.DataFileSetReader
+	metrics replicatorMetrics
+
+	// namespaceIDs and namespaces are replaced rather than mutated when the
+	// namespaces are set so they can be used without holding the lock.
+	namespaceIDs []ident.ID
+	namespaces   map[string]struct{}
+
+	// localRegistry and localNamespaces are only set when the local
+	// namespace initializer is set.
+	localRegistry   namespace.Registry
+	localNamespaces namespace.Watch
+
+	session    client.Session
+	checkpoint *checkpoint
+	openedAt   time.Time
+
+	state   replicatorState
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewReplicator returns a new replicator. Every node replicates the data
+// of the shards it owns so the remote cluster receives each write once per
+// replica, writes are idempotent so this only costs bandwidth. Flushed
+// filesets whose data is held by commit logs that are replicated are not
+// replicated themselves so data is only shipped once. Commit logs that are
+// removed by cleanup before being replicated are skipped, their data is
+// replicated once flushed to filesets. Flushed filesets hold historical data
+// so the remote namespaces must have cold writes enabled, this is verified
+// when the replicator is opened. Writes rejected by the remote cluster are
+// skipped rather than stalling replication.
+func NewReplicator(opts Options) (Replicator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	reader, err := fs.NewReader(opts.BytesPool(), opts.FilesystemOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, err := readCheckpoint(opts.CheckpointFilePath())
+	if err != nil {
+		return nil, err
+	}
+
+	iopts := opts.InstrumentOptions()
+	return &replicator{
+		opts:         opts,
+		log:          iopts.Logger(),
+		nowFn:        opts.ClockOptions().NowFn(),
+		reader:       reader,
+		metrics:      newReplicatorMetrics(iopts.MetricsScope().SubScope("replication")),
+		namespaceIDs: opts.Namespaces(),
+		namespaces:   namespaceSet(opts.Namespaces()),
+		checkpoint:   checkpoint,
+		closeCh:      make(chan struct{}),
+		doneCh:       make(chan struct{}),
+	}, nil
+}
+
+func (r *replicator) Open() error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.state != replicatorNotOpen {
+		return errReplicatorAlreadyOpen
+	}
+	if _, err := r.verifyRemoteNamespaces(r.namespaceIDs); err != nil {
+		return err
+	}
+	if nsInit := r.opts.LocalNamespaceInitializer(); nsInit != nil {
+		registry, err := nsInit.Init()
+		if err != nil {
+			return err
+		}
+		watch, err := registry.Watch()
+		if err != nil {
+			registry.Close()
+			return err
+		}
+		r.localRegistry = registry
+		r.localNamespaces = watch
+	}
+	r.state = replicatorOpen
+	r.openedAt = r.nowFn()
+
+	go r.run()
+	return nil
+}
+
+func (r *replicator) SetNamespaces(value []ident.ID) error {
+	r.Lock()
+	current := r.namespaces
+	r.Unlock()
+
+	// Only namespaces not already being replicated need to be verified.
+	var added []ident.ID
+	for _, ns := range value {
+		if _, ok := current[ns.String()]; !ok {
+			added = append(added, ns)
+		}
+	}
+	accepted, err := r.verifyRemoteNamespaces(added)
+
+	namespaceIDs := make([]ident.ID, 0, len(value))
+	for _, ns := range value {
+		if _, ok := current[ns.String()]; ok {
+			namespaceIDs = append(namespaceIDs, ns)
+		}
+	}
+	namespaceIDs = append(namespaceIDs, accepted...)
+
+	r.Lock()
+	r.namespaceIDs = namespaceIDs
+	r.namespaces = namespaceSet(namespaceIDs)
+	r.Unlock()
+	return err
+}
+
+// verifyRemoteNamespaces verifies that the remote namespaces accept the
+// writes replicated from flushed filesets, returning the namespaces that
+// do. Flushed filesets are historical and so are rejected by namespaces
+// that do not have cold writes enabled.
+func (r *replicator) verifyRemoteNamespaces(
+	namespaces []ident.ID,
+) ([]ident.ID, error) {
+	if len(namespaces) == 0 {
+		return nil, nil
+	}
+
+	registry, err := r.opts.RemoteNamespaceInitializer().Init()
+	if err != nil {
+		return nil, err
+	}
+	defer registry.Close()
+
+	watch, err := registry.Watch()
+	if err != nil {
+		return nil, err
+	}
+	defer watch.Close()
+
+	var (
+		remote   = watch.Get()
+		accepted = make([]ident.ID, 0, len(namespaces))
+		multiErr = xerrors.NewMultiError()
+	)
+	for _, ns := range namespaces {
+		md, err := remote.Get(ns)
+		if err != nil {
+			multiErr = multiErr.Add(fmt.Errorf(
+				"remote namespace %s is not available: %v", ns.String(), err))
+			continue
+		}
+		if !md.Options().ColdWritesEnabled() {
+			multiErr = multiErr.Add(fmt.Errorf("remote namespace %s must have "+
+				"cold writes enabled to accept replicated writes", ns.String()))
+			continue
+		}
+		accepted = append(accepted, ns)
+	}
+	return accepted, multiErr.FinalError()
+}
+
+func (r *replicator) Close() error {
+	r.Lock()
+	if r.state != replicatorOpen {
+		r.Unlock()
+		return errReplicatorNotOpen
+	}
+	r.state = replicatorClosed
+	close(r.closeCh)
+	r.Unlock()
+
+	<-r.doneCh
+
+	if r.localRegistry != nil {
+		r.localNamespaces.Close()
+		return r.localRegistry.Close()
+	}
+	return nil
+}
+
+func (r *replicator) run() {
+	defer close(r.doneCh)
+
+	ticker := time.NewTicker(r.opts.Interval())
+	defer ticker.Stop()
+
+	for {
+		if err := r.replicate(); err != nil && err != errReplicatorClosed {
+			r.metrics.errors.Inc(1)
+			r.log.Error("replication failed, will resume from last checkpoint",
+				zap.Error(err))
+		}
+		r.reportLag()
+
+		select {
+		case <-r.closeCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *replicator) closed() bool {
+	select {
+	case <-r.closeCh:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *replicator) reportLag() {
+	caughtUpAt := r.checkpoint.CaughtUpAt
+	if caughtUpAt.IsZero() {
+		caughtUpAt = r.openedAt
+	}
+	r.metrics.lag.Update(r.nowFn().Sub(caughtUpAt).Seconds())
+}
+
+// replicate replicates every complete data fileset and rotated commit log
+// not yet replicated.
+func (r *replicator) replicate() error {
+	start := r.nowFn()
+	session, err := r.remoteSession()
+	if err != nil {
+		return err
+	}
+
+	r.Lock()
+	namespaceIDs, namespaces := r.namespaceIDs, r.namespaces
+	r.Unlock()
+
+	if err := r.updateCommitLogCoverage(namespaceIDs, start); err != nil {
+		return err
+	}
+	for _, ns := range namespaceIDs {
+		if err := r.replicateFileSets(session, ns); err != nil {
+			return err
+		}
+	}
+	if err := r.replicateCommitLogs(session, namespaces); err != nil {
+		return err
+	}
+
+	r.checkpoint.CaughtUpAt = start
+	return r.writeCheckpoint()
+}
+
+func (r *replicator) remoteSession() (client.Session, error) {
+	if r.session != nil {
+		return r.session, nil
+	}
+	session, err := r.opts.Client().DefaultSession()
+	if err != nil {
+		return nil, err
+	}
+	r.session = session
+	return session, nil
+}
+
+func (r *replicator) writeCheckpoint() error {
+	fsOpts := r.opts.FilesystemOptions()
+	return r.checkpoint.write(r.opts.CheckpointFilePath(),
+		fsOpts.NewFileMode(), fsOpts.NewDirectoryMode())
+}
+
+// updateCommitLogCoverage updates the time since which the writes of each
+// namespace are held by commit logs that are replicated or pending
+// replication. Every write made from now on is held by the commit log being
+// written to or a later one, all of which are replicated, until commit logs
+// are removed before being replicated.
+func (r *replicator) updateCommitLogCoverage(namespaceIDs []ident.ID, now time.Time) error {
+	files, _, err := commitlog.Files(r.opts.CommitLogOptions())
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	rotated := files[:len(files)-1]
+	position := r.checkpoint.CommitLog
+	if len(rotated) > 0 && position != (persist.CommitLogPosition{}) &&
+		rotated[0].Index > position.Index {
+		r.metrics.commitLogsMissed.Inc(rotated[0].Index - position.Index)
+		r.log.Warn("commit logs removed before being replicated",
+			zap.Int64("fromIndex", position.Index),
+			zap.Int64("toIndex", rotated[0].Index))
+		r.checkpoint.commitLogsMissed(position.Index, now)
+		// The checkpoint is moved past the missed commit logs so they are
+		// only accounted for once.
+		r.checkpoint.CommitLog = persist.CommitLogPosition{Index: rotated[0].Index}
+	}
+
+	coveredSince := make(map[string]time.Time, len(namespaceIDs))
+	for _, ns := range namespaceIDs {
+		since, ok := r.checkpoint.CommitLogCoveredSince[ns.String()]
+		if !ok {
+			// Commit log entries are only replicated for the namespaces
+			// being replicated.
+			since = now
+		}
+		coveredSince[ns.String()] = since
+	}
+	r.checkpoint.CommitLogCoveredSince = coveredSince
+	return r.writeCheckpoint()
+}
+
+// commitLogCoveredBlockStart returns the earliest block start of the
+// namespace whose writes are all held by commit logs that are replicated or
+// pending replication, writes for a block are accepted from buffer future
+// before the block starts.
+func (r *replicator) commitLogCoveredBlockStart(nsID ident.ID) (time.Time, bool) {
+	if r.localNamespaces == nil {
+		return time.Time{}, false
+	}
+	since, ok := r.checkpoint.CommitLogCoveredSince[nsID.String()]
+	if !ok {
+		return time.Time{}, false
+	}
+	md, err := r.localNamespaces.Get().Get(nsID)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return since.Add(md.Options().RetentionOptions().BufferFuture()), true
+}
+
+func (r *replicator) replicateFileSets(session client.Session, nsID ident.ID) error {
+	var (
+		filePathPrefix = r.opts.FilesystemOptions().FilePathPrefix()
+		nsDir          = fs.NamespaceDataDirPath(filePathPrefix, nsID)
+	)
+	dirs, err := ioutil.ReadDir(nsDir)
+	if os.IsNotExist(err) {
+		// Nothing flushed for the namespace yet.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		shard, err := strconv.ParseUint(dir.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		if err := r.replicateShardFileSets(session, nsID, uint32(shard)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *replicator) replicateShardFileSets(
+	session client.Session,
+	nsID ident.ID,
+	shard uint32,
+) error {
+	filePathPrefix := r.opts.FilesystemOptions().FilePathPrefix()
+	files, err := fs.DataFiles(filePathPrefix, nsID, shard)
+	if err != nil {
+		return err
+	}
+
+	var (
+		ns      = nsID.String()
+		onDisk  = make(map[checkpointFileSet]struct{}, len(files))
+		pending = make([]fs.FileSetFile, 0, len(files))
+	)
+	for _, f := range files {
+		if !f.HasCompleteCheckpointFile() {
+			continue
+		}
+		onDisk[checkpointFileSet{
+			BlockStart: f.ID.BlockStart,
+			Volume:     f.ID.VolumeIndex,
+		}] = struct{}{}
+		if !r.checkpoint.fileSetReplicated(ns, shard, f.ID.BlockStart, f.ID.VolumeIndex) {
+			pending = append(pending, f)
+		}
+	}
+	r.checkpoint.retainFileSets(ns, shard, func(f checkpointFileSet) bool {
+		for key := range onDisk {
+			if key.BlockStart.Equal(f.BlockStart) && key.Volume == f.Volume {
+				return true
+			}
+		}
+		return false
+	})
+
+	// The newest commit log is only listed once the volumes are, every write
+	// of the volumes is then held by it or an earlier commit log.
+	var (
+		coveredBlockStart, coverable = r.commitLogCoveredBlockStart(nsID)
+		newestCommitLogIndex         = int64(-1)
+	)
+	if coverable && len(pending) > 0 {
+		commitLogs, _, err := commitlog.Files(r.opts.CommitLogOptions())
+		if err != nil {
+			return err
+		}
+		if len(commitLogs) > 0 {
+			newestCommitLogIndex = commitLogs[len(commitLogs)-1].Index
+		}
+	}
+
+	for _, f := range pending {
+		if r.closed() {
+			return errReplicatorClosed
+		}
+		if newestCommitLogIndex >= 0 && !f.ID.BlockStart.Before(coveredBlockStart) {
+			r.checkpoint.markFileSetCoveredByCommitLogs(ns, shard,
+				f.ID.BlockStart, f.ID.VolumeIndex, newestCommitLogIndex)
+			if err := r.writeCheckpoint(); err != nil {
+				return err
+			}
+			r.metrics.fileSetsCovered.Inc(1)
+			continue
+		}
+		if err := r.replicateFileSet(session, f.ID); err != nil {
+			return err
+		}
+		r.checkpoint.markFileSetReplicated(ns, shard, f.ID.BlockStart, f.ID.VolumeIndex)
+		if err := r.writeCheckpoint(); err != nil {
+			return err
+		}
+		r.metrics.fileSetsReplicated.Inc(1)
+	}
+	return nil
+}
+
+func (r *replicator) replicateFileSet(
+	session client.Session,
+	fileID fs.FileSetFileIdentifier,
+) (err error) {
+	reader := r.reader
+	if err := reader.Open(fs.DataReaderOpenOptions{
+		Identifier:  fileID,
+		FileSetType: persist.FileSetFlushType,
+	}); err != nil {
+		return err
+	}
+	defer func() {
+		// Only set the error here if not set by the end of the function,
+		// since all other errors take precedence.
+		if err == nil {
+			err = reader.Close()
+		} else {
+			reader.Close()
+		}
+	}()
+
+	var (
+		srcRange   = reader.Range()
+		segReader  = r.opts.SegmentReaderPool().Get()
+		multiIter  = r.opts.MultiReaderIteratorPool().Get()
+		segReaders = make([]xio.SegmentReader, 1)
+		writes     = make([]replicatedWrite, 0, r.opts.BatchSize())
+
+		// IDs and tags must only be finalized once the writes referencing
+		// them have completed.
+		idsToFinalize  []ident.ID
+		tagsToFinalize []ident.Tags
+	)
+	finalize := func() {
+		for _, id := range idsToFinalize {
+			id.Finalize()
+		}
+		for _, tags := range tagsToFinalize {
+			tags.Finalize()
+		}
+		idsToFinalize = idsToFinalize[:0]
+		tagsToFinalize = tagsToFinalize[:0]
+	}
+	defer func() {
+		segReader.Finalize()
+		multiIter.Close()
+		finalize()
+	}()
+
+	for {
+		id, tagsIter, data, checksum, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		tags, err := convert.TagsFromTagsIter(id, tagsIter, r.opts.IdentifierPool())
+		tagsIter.Close()
+		if err != nil {
+			id.Finalize()
+			data.Finalize()
+			return err
+		}
+		idsToFinalize = append(idsToFinalize, id)
+		tagsToFinalize = append(tagsToFinalize, tags)
+
+		segReaders[0] = segmentReaderFromData(data, checksum, segReader)
+		multiIter.Reset(segReaders, srcRange.Start, srcRange.Duration(), nil)
+		for multiIter.Next() {
+			dp, unit, annotation := multiIter.Current()
+			writes = append(writes, replicatedWrite{
+				namespace:  fileID.Namespace,
+				id:         id,
+				tags:       tags,
+				datapoint:  dp,
+				unit:       unit,
+				annotation: copyAnnotation(annotation),
+			})
+		}
+		if err := multiIter.Err(); err != nil {
+			return err
+		}
+
+		if len(writes) >= r.opts.BatchSize() {
+			if err := r.write(session, writes); err != nil {
+				return err
+			}
+			writes = writes[:0]
+			finalize()
+		}
+	}
+
+	return r.write(session, writes)
+}
+
+func (r *replicator) replicateCommitLogs(
+	session client.Session,
+	namespaces map[string]struct{},
+) error {
+	files, _, err := commitlog.Files(r.opts.CommitLogOptions())
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	// The newest commit log is still being written to so only the commit
+	// logs rotated out before it are replicated, commit logs missed are
+	// accounted for when the commit log coverage is updated.
+	var (
+		rotated  = files[:len(files)-1]
+		position = r.checkpoint.CommitLog
+	)
+	for _, file := range rotated {
+		if file.Index < position.Index {
+			continue
+		}
+		if r.closed() {
+			return errReplicatorClosed
+		}
+		if err := r.replicateCommitLog(session, file, namespaces); err != nil {
+			return err
+		}
+		r.checkpoint.CommitLog = persist.CommitLogPosition{Index: file.Index + 1}
+		if err := r.writeCheckpoint(); err != nil {
+			return err
+		}
+		r.metrics.commitLogsReplicated.Inc(1)
+	}
+	return nil
+}
+
+func (r *replicator) replicateCommitLog(
+	session client.Session,
+	file persist.CommitLogFile,
+	namespaces map[string]struct{},
+) error {
+	iter, _, err := commitlog.NewIterator(commitlog.IteratorOpts{
+		CommitLogOptions: r.opts.CommitLogOptions(),
+		FileFilterPredicate: func(f commitlog.FileFilterInfo) bool {
+			return !f.IsCorrupt && f.File.Index == file.Index
+		},
+	})
+	if err != nil {
+		return err
+	}
+	// NB: the series of the entries are owned by the iterator so it must
+	// only be closed once the writes referencing them have completed.
+	defer iter.Close()
+
+	var (
+		position = r.checkpoint.CommitLog
+		writes   = make([]replicatedWrite, 0, r.opts.BatchSize())
+		last     int64
+	)
+	for iter.Next() {
+		entry := iter.Current()
+		if position.Covers(entry.Metadata.FileIndex, entry.Metadata.EntryOffset) {
+			continue
+		}
+		last = entry.Metadata.EntryOffset
+		if _, ok := namespaces[entry.Series.Namespace.String()]; !ok {
+			continue
+		}
+
+		writes = append(writes, replicatedWrite{
+			namespace:  entry.Series.Namespace,
+			id:         entry.Series.ID,
+			tags:       entry.Series.Tags,
+			datapoint:  entry.Datapoint,
+			unit:       entry.Unit,
+			annotation: copyAnnotation(entry.Annotation),
+		})
+		if len(writes) < r.opts.BatchSize() {
+			continue
+		}
+
+		if err := r.write(session, writes); err != nil {
+			return err
+		}
+		writes = writes[:0]
+
+		r.checkpoint.CommitLog = persist.CommitLogPosition{
+			Index:      file.Index,
+			NumEntries: last + 1,
+		}
+		if err := r.writeCheckpoint(); err != nil {
+			return err
+		}
+		if r.closed() {
+			return errReplicatorClosed
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	return r.write(session, writes)
+}
+
+// write writes the datapoints to the remote cluster concurrently and waits
+// for every write to complete. Writes failing with a transient error are
+// retried and fail the batch if they keep failing, writes rejected by the
+// remote cluster are skipped since retrying them would stall replication.
+func (r *replicator) write(session client.Session, writes []replicatedWrite) error {
+	if len(writes) == 0 {
+		return nil
+	}
+
+	var (
+		wg       sync.WaitGroup
+		errLock  sync.Mutex
+		multiErr = xerrors.NewMultiError()
+		skipped  int64
+		next     = int64(-1)
+		workers  = r.opts.WriteConcurrency()
+		retrier  = r.opts.WriteRetrier()
+	)
+	if workers > len(writes) {
+		workers = len(writes)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				idx := int(atomic.AddInt64(&next, 1))
+				if idx >= len(writes) {
+					return
+				}
+
+				w := writes[idx]
+				err := retrier.Attempt(func() error {
+					err := session.WriteTagged(w.namespace, w.id, ident.NewTagsIterator(w.tags),
+						w.datapoint.Timestamp, w.datapoint.Value, w.unit, w.annotation)
+					if isRejectedWriteError(err) {
+						return xerrors.NewNonRetryableError(err)
+					}
+					return err
+				})
+				if err == nil {
+					continue
+				}
+				if isRejectedWriteError(err) {
+					atomic.AddInt64(&skipped, 1)
+					r.log.Warn("write rejected by remote cluster, skipping",
+						zap.Stringer("namespace", w.namespace),
+						zap.Stringer("id", w.id),
+						zap.Time("timestamp", w.datapoint.Timestamp),
+						zap.Error(err))
+					continue
+				}
+				errLock.Lock()
+				multiErr = multiErr.Add(err)
+				errLock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	numErrs := int64(multiErr.NumErrors())
+	r.metrics.datapointsReplicated.Inc(int64(len(writes)) - numErrs - skipped)
+	r.metrics.datapointsSkipped.Inc(skipped)
+	r.metrics.writeErrors.Inc(numErrs)
+	return multiErr.FinalError()
+}
+
+// isRejectedWriteError returns whether the write was rejected by the remote
+// cluster, such as a write outside of the retention of the remote namespace,
+// and so will fail however many times it is retried.
+func isRejectedWriteError(err error) bool {
+	return err != nil && (client.IsBadRequestError(err) || xerrors.IsNonRetryableError(err))
+}
+
+func namespaceSet(namespaces []ident.ID) map[string]struct{} {
+	set := make(map[string]struct{}, len(namespaces))
+	for _, ns := range namespaces {
+		set[ns.String()] = struct{}{}
+	}
+	return set
+}
+
+func segmentReaderFromData(
+	data checked.Bytes,
+	checksum uint32,
+	segReader xio.SegmentReader,
+) xio.SegmentReader {
+	seg := ts.NewSegment(data, nil, checksum, ts.FinalizeHead)
+	segReader.Reset(seg)
+	return segReader
+}
+
+func copyAnnotation(annotation ts.Annotation) ts.Annotation {
+	if len(annotation) == 0 {
+		return nil
+	}
+	return append(ts.Annotation(nil), annotation...)
+}
@@ -0,0 +1,411 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xretry "github.com/m3db/m3/src/x/retry"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+var (
+	testReplicatorNs         = ident.StringID("metrics")
+	testReplicatorBlockSize  = 2 * time.Hour
+	testReplicatorBlockStart = time.Unix(1577836800, 0)
+)
+
+func newTestReplicatorOptions(
+	t *testing.T,
+	dir string,
+	c client.Client,
+	remoteOpts namespace.Options,
+) Options {
+	md, err := namespace.NewMetadata(testReplicatorNs, remoteOpts)
+	require.NoError(t, err)
+
+	fsOpts := fs.NewOptions().SetFilePathPrefix(dir)
+	return NewOptions().
+		SetClient(c).
+		SetRemoteNamespaceInitializer(namespace.NewStaticInitializer(
+			[]namespace.Metadata{md})).
+		SetNamespaces([]ident.ID{testReplicatorNs}).
+		SetCheckpointFilePath(filepath.Join(dir, "replication", "checkpoint.json")).
+		SetFilesystemOptions(fsOpts).
+		SetCommitLogOptions(commitlog.NewOptions().SetFilesystemOptions(fsOpts)).
+		SetWriteConcurrency(1).
+		SetWriteRetrier(xretry.NewRetrier(xretry.NewOptions().SetMaxRetries(0))).
+		SetInterval(time.Hour)
+}
+
+func writeTestReplicatorFileSet(
+	t *testing.T,
+	fsOpts fs.Options,
+	series map[string][]ts.Datapoint,
+) {
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  testReplicatorNs,
+			Shard:      0,
+			BlockStart: testReplicatorBlockStart,
+		},
+		BlockSize:   testReplicatorBlockSize,
+		FileSetType: persist.FileSetFlushType,
+	}))
+
+	for id, dps := range series {
+		encoder := m3tsz.NewEncoder(testReplicatorBlockStart, nil, true,
+			encoding.NewOptions())
+		for _, dp := range dps {
+			require.NoError(t, encoder.Encode(dp, xtime.Second, nil))
+		}
+
+		ctx := context.NewContext()
+		reader, ok := encoder.Stream(ctx)
+		require.True(t, ok)
+		seg, err := reader.Segment()
+		require.NoError(t, err)
+		data := make([]byte, seg.Len())
+		_, err = reader.Read(data)
+		require.NoError(t, err)
+		ctx.Close()
+
+		bytes := checked.NewBytes(data, nil)
+		bytes.IncRef()
+		tags := ident.NewTags(ident.StringTag("city", "nyc"))
+		require.NoError(t, writer.Write(ident.StringID(id), tags, bytes,
+			digest.Checksum(data)))
+	}
+	require.NoError(t, writer.Close())
+}
+
+func TestReplicatorOpenVerifiesRemoteColdWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "replicator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	session := client.NewMockSession(ctrl)
+	c := client.NewMockClient(ctrl)
+	c.EXPECT().DefaultSession().Return(session, nil).AnyTimes()
+
+	// Remote namespaces without cold writes reject replicated filesets.
+	opts := newTestReplicatorOptions(t, dir, c, namespace.NewOptions())
+	r, err := NewReplicator(opts)
+	require.NoError(t, err)
+	err = r.Open()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cold writes")
+
+	// Remote namespaces that do not exist are rejected too.
+	r, err = NewReplicator(opts.SetNamespaces([]ident.ID{ident.StringID("other")}))
+	require.NoError(t, err)
+	err = r.Open()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not available")
+
+	opts = newTestReplicatorOptions(t, dir, c,
+		namespace.NewOptions().SetColdWritesEnabled(true))
+	r, err = NewReplicator(opts)
+	require.NoError(t, err)
+	require.NoError(t, r.Open())
+	require.NoError(t, r.Close())
+}
+
+func TestReplicatorResumesFileSetsAfterFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "replicator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		failWrites = true
+		written    []string
+	)
+	session := client.NewMockSession(ctrl)
+	session.EXPECT().
+		WriteTagged(testReplicatorNs, gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_, id ident.ID,
+			_ ident.TagIterator,
+			timestamp time.Time,
+			value float64,
+			_ xtime.Unit,
+			_ []byte,
+		) error {
+			if failWrites {
+				return errors.New("remote unavailable")
+			}
+			written = append(written, fmt.Sprintf("%s=%v@%v",
+				id.String(), value, timestamp.Sub(testReplicatorBlockStart)))
+			return nil
+		}).
+		AnyTimes()
+	c := client.NewMockClient(ctrl)
+	c.EXPECT().DefaultSession().Return(session, nil).AnyTimes()
+
+	scope := tally.NewTestScope("", nil)
+	opts := newTestReplicatorOptions(t, dir, c,
+		namespace.NewOptions().SetColdWritesEnabled(true)).
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope))
+	writeTestReplicatorFileSet(t, opts.FilesystemOptions(), map[string][]ts.Datapoint{
+		"foo": {
+			{Timestamp: testReplicatorBlockStart.Add(time.Minute), Value: 1},
+			{Timestamp: testReplicatorBlockStart.Add(2 * time.Minute), Value: 2},
+		},
+	})
+
+	r, err := NewReplicator(opts)
+	require.NoError(t, err)
+	rep := r.(*replicator)
+
+	// The fileset is not checkpointed when writing it to the remote fails.
+	require.Error(t, rep.replicate())
+	assert.False(t, rep.checkpoint.fileSetReplicated(
+		testReplicatorNs.String(), 0, testReplicatorBlockStart, 0))
+	assert.Empty(t, written)
+
+	failWrites = false
+	require.NoError(t, rep.replicate())
+	assert.Equal(t, []string{"foo=1@1m0s", "foo=2@2m0s"}, written)
+	assert.True(t, rep.checkpoint.fileSetReplicated(
+		testReplicatorNs.String(), 0, testReplicatorBlockStart, 0))
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(2), counters["replication.write-errors+"].Value())
+	assert.Equal(t, int64(1), counters["replication.filesets-replicated+"].Value())
+	assert.Equal(t, int64(2), counters["replication.datapoints-replicated+"].Value())
+
+	// Replicated filesets are not replicated again, including after a
+	// restart that resumes from the checkpoint.
+	require.NoError(t, rep.replicate())
+	r, err = NewReplicator(opts)
+	require.NoError(t, err)
+	require.NoError(t, r.(*replicator).replicate())
+	assert.Len(t, written, 2)
+}
+
+func TestReplicatorSkipsRejectedWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "replicator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	attempts := make(map[string]int)
+	session := client.NewMockSession(ctrl)
+	session.EXPECT().
+		WriteTagged(testReplicatorNs, gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_, id ident.ID,
+			_ ident.TagIterator,
+			_ time.Time,
+			_ float64,
+			_ xtime.Unit,
+			_ []byte,
+		) error {
+			attempts[id.String()]++
+			switch {
+			case id.String() == "rejected":
+				return xerrors.NewInvalidParamsError(errors.New("datapoint too far in past"))
+			case attempts[id.String()] == 1:
+				return errors.New("remote unavailable")
+			}
+			return nil
+		}).
+		AnyTimes()
+	c := client.NewMockClient(ctrl)
+	c.EXPECT().DefaultSession().Return(session, nil).AnyTimes()
+
+	scope := tally.NewTestScope("", nil)
+	opts := newTestReplicatorOptions(t, dir, c,
+		namespace.NewOptions().SetColdWritesEnabled(true)).
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope)).
+		SetWriteRetrier(xretry.NewRetrier(xretry.NewOptions().
+			SetMaxRetries(1).
+			SetInitialBackoff(time.Millisecond)))
+	writeTestReplicatorFileSet(t, opts.FilesystemOptions(), map[string][]ts.Datapoint{
+		"accepted": {
+			{Timestamp: testReplicatorBlockStart.Add(time.Minute), Value: 1},
+		},
+		"rejected": {
+			{Timestamp: testReplicatorBlockStart.Add(time.Minute), Value: 2},
+		},
+	})
+
+	r, err := NewReplicator(opts)
+	require.NoError(t, err)
+	rep := r.(*replicator)
+
+	// Transient errors are retried while rejected writes are skipped rather
+	// than failing the fileset.
+	require.NoError(t, rep.replicate())
+	assert.Equal(t, map[string]int{"accepted": 2, "rejected": 1}, attempts)
+	assert.True(t, rep.checkpoint.fileSetReplicated(
+		testReplicatorNs.String(), 0, testReplicatorBlockStart, 0))
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(0), counters["replication.write-errors+"].Value())
+	assert.Equal(t, int64(1), counters["replication.datapoints-skipped+"].Value())
+	assert.Equal(t, int64(1), counters["replication.datapoints-replicated+"].Value())
+}
+
+func TestReplicatorSkipsFileSetsCoveredByCommitLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "replicator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	session := client.NewMockSession(ctrl)
+	c := client.NewMockClient(ctrl)
+	c.EXPECT().DefaultSession().Return(session, nil).AnyTimes()
+
+	bufferFuture := 10 * time.Minute
+	localMd, err := namespace.NewMetadata(testReplicatorNs, namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().
+			SetBlockSize(testReplicatorBlockSize).
+			SetBufferFuture(bufferFuture)))
+	require.NoError(t, err)
+
+	scope := tally.NewTestScope("", nil)
+	now := testReplicatorBlockStart.Add(-bufferFuture)
+	opts := newTestReplicatorOptions(t, dir, c,
+		namespace.NewOptions().SetColdWritesEnabled(true)).
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope)).
+		SetClockOptions(clock.NewOptions().SetNowFn(func() time.Time {
+			return now
+		})).
+		SetLocalNamespaceInitializer(namespace.NewStaticInitializer(
+			[]namespace.Metadata{localMd}))
+
+	commitLog, err := commitlog.NewCommitLog(opts.CommitLogOptions())
+	require.NoError(t, err)
+	require.NoError(t, commitLog.Open())
+	require.NoError(t, commitLog.Close())
+
+	r, err := NewReplicator(opts)
+	require.NoError(t, err)
+	rep := r.(*replicator)
+
+	// The local namespaces are watched once opened, which also starts
+	// replicating in the background.
+	registry, err := opts.LocalNamespaceInitializer().Init()
+	require.NoError(t, err)
+	defer registry.Close()
+	rep.localNamespaces, err = registry.Watch()
+	require.NoError(t, err)
+
+	// Every write of the block is made once commit logs are replicated so
+	// the fileset flushed for it is not replicated, the commit logs are.
+	require.NoError(t, rep.replicate())
+	writeTestReplicatorFileSet(t, opts.FilesystemOptions(), map[string][]ts.Datapoint{
+		"foo": {
+			{Timestamp: testReplicatorBlockStart.Add(time.Minute), Value: 1},
+		},
+	})
+	require.NoError(t, rep.replicate())
+	assert.True(t, rep.checkpoint.fileSetReplicated(
+		testReplicatorNs.String(), 0, testReplicatorBlockStart, 0))
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(1), counters["replication.filesets-covered+"].Value())
+	assert.Nil(t, counters["replication.filesets-replicated+"])
+
+	// Once commit logs holding writes of the block are missed the fileset
+	// is replicated after all.
+	rep.checkpoint.commitLogsMissed(0, now)
+	assert.False(t, rep.checkpoint.fileSetReplicated(
+		testReplicatorNs.String(), 0, testReplicatorBlockStart, 0))
+}
+
+func TestReplicatorSetNamespacesVerifiesAddedNamespaces(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "replicator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	other := ident.StringID("other")
+	otherMd, err := namespace.NewMetadata(other, namespace.NewOptions())
+	require.NoError(t, err)
+	md, err := namespace.NewMetadata(testReplicatorNs,
+		namespace.NewOptions().SetColdWritesEnabled(true))
+	require.NoError(t, err)
+
+	opts := newTestReplicatorOptions(t, dir, client.NewMockClient(ctrl),
+		namespace.NewOptions()).
+		SetRemoteNamespaceInitializer(namespace.NewStaticInitializer(
+			[]namespace.Metadata{md, otherMd})).
+		SetNamespaces(nil)
+	r, err := NewReplicator(opts)
+	require.NoError(t, err)
+	rep := r.(*replicator)
+
+	// Namespaces whose remote namespace rejects replicated writes are not
+	// replicated.
+	err = r.SetNamespaces([]ident.ID{testReplicatorNs, other})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), other.String())
+	assert.Equal(t, []ident.ID{testReplicatorNs}, rep.namespaceIDs)
+
+	require.NoError(t, r.SetNamespaces(nil))
+	assert.Empty(t, rep.namespaceIDs)
+	assert.Empty(t, rep.namespaces)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+// Package replication ships the data of namespaces to a remote cluster
+// asynchronously for disaster recovery between regions.
+package replication
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/pool"
+	xretry "github.com/m3db/m3/src/x/retry"
+)
+
+// Replicator periodically ships the flushed data filesets and the rotated
+// commit logs of namespaces to a remote cluster. The progress made is
+// checkpointed to disk so that replication resumes where it left off after
+// a failure or a restart.
+type Replicator interface {
+	// Open starts replicating in the background.
+	Open() error
+
+	// SetNamespaces sets the namespaces to replicate. Namespaces whose remote
+	// namespace does not accept replicated writes are not replicated and an
+	// error is returned for them.
+	SetNamespaces(value []ident.ID) error
+
+	// Close stops replicating.
+	Close() error
+}
+
+// Options are the options for a replicator.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetClockOptions sets the clock options.
+	SetClockOptions(value clock.Options) Options
+
+	// ClockOptions returns the clock options.
+	ClockOptions() clock.Options
+
+	// SetInstrumentOptions sets the instrumentation options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrumentation options.
+	InstrumentOptions() instrument.Options
+
+	// SetClient sets the client of the remote cluster.
+	SetClient(value client.Client) Options
+
+	// Client returns the client of the remote cluster.
+	Client() client.Client
+
+	// SetRemoteNamespaceInitializer sets the initializer of the namespace
+	// registry of the remote cluster.
+	SetRemoteNamespaceInitializer(value namespace.Initializer) Options
+
+	// RemoteNamespaceInitializer returns the initializer of the namespace
+	// registry of the remote cluster.
+	RemoteNamespaceInitializer() namespace.Initializer
+
+	// SetLocalNamespaceInitializer sets the initializer of the namespace
+	// registry of the local cluster. Without it every flushed fileset is
+	// replicated, with it the filesets whose data is only held by commit
+	// logs that are replicated are not.
+	SetLocalNamespaceInitializer(value namespace.Initializer) Options
+
+	// LocalNamespaceInitializer returns the initializer of the namespace
+	// registry of the local cluster.
+	LocalNamespaceInitializer() namespace.Initializer
+
+	// SetNamespaces sets the namespaces to replicate when opened.
+	SetNamespaces(value []ident.ID) Options
+
+	// Namespaces returns the namespaces to replicate when opened.
+	Namespaces() []ident.ID
+
+	// SetInterval sets the interval between replicating newly flushed
+	// filesets and rotated commit logs.
+	SetInterval(value time.Duration) Options
+
+	// Interval returns the interval between replicating newly flushed
+	// filesets and rotated commit logs.
+	Interval() time.Duration
+
+	// SetBatchSize sets the number of datapoints written to the remote
+	// cluster before progress is checkpointed.
+	SetBatchSize(value int) Options
+
+	// BatchSize returns the number of datapoints written to the remote
+	// cluster before progress is checkpointed.
+	BatchSize() int
+
+	// SetWriteConcurrency sets the number of concurrent writes to the
+	// remote cluster.
+	SetWriteConcurrency(value int) Options
+
+	// WriteConcurrency returns the number of concurrent writes to the
+	// remote cluster.
+	WriteConcurrency() int
+
+	// SetWriteRetrier sets the retrier of writes to the remote cluster that
+	// fail with a transient error. Writes rejected by the remote cluster are
+	// not retried.
+	SetWriteRetrier(value xretry.Retrier) Options
+
+	// WriteRetrier returns the retrier of writes to the remote cluster that
+	// fail with a transient error.
+	WriteRetrier() xretry.Retrier
+
+	// SetCheckpointFilePath sets the path of the file progress is
+	// checkpointed to.
+	SetCheckpointFilePath(value string) Options
+
+	// CheckpointFilePath returns the path of the file progress is
+	// checkpointed to.
+	CheckpointFilePath() string
+
+	// SetFilesystemOptions sets the filesystem options.
+	SetFilesystemOptions(value fs.Options) Options
+
+	// FilesystemOptions returns the filesystem options.
+	FilesystemOptions() fs.Options
+
+	// SetCommitLogOptions sets the commit log options.
+	SetCommitLogOptions(value commitlog.Options) Options
+
+	// CommitLogOptions returns the commit log options.
+	CommitLogOptions() commitlog.Options
+
+	// SetBytesPool sets the bytes pool.
+	SetBytesPool(value pool.CheckedBytesPool) Options
+
+	// BytesPool returns the bytes pool.
+	BytesPool() pool.CheckedBytesPool
+
+	// SetSegmentReaderPool sets the segment reader pool.
+	SetSegmentReaderPool(value xio.SegmentReaderPool) Options
+
+	// SegmentReaderPool returns the segment reader pool.
+	SegmentReaderPool() xio.SegmentReaderPool
+
+	// SetMultiReaderIteratorPool sets the multi reader iterator pool.
+	SetMultiReaderIteratorPool(value encoding.MultiReaderIteratorPool) Options
+
+	// MultiReaderIteratorPool returns the multi reader iterator pool.
+	MultiReaderIteratorPool() encoding.MultiReaderIteratorPool
+
+	// SetIdentifierPool sets the identifier pool.
+	SetIdentifierPool(value ident.Pool) Options
+
+	// IdentifierPool returns the identifier pool.
+	IdentifierPool() ident.Pool
+}
@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/ratelimit"
+	"github.com/m3db/m3/src/dbnode/storage/coldcompaction"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/x/close"
 
@@ -408,6 +409,62 @@ func (mr *MockOptionsMockRecorder) IndexDefaultQueryTimeout() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexDefaultQueryTimeout", reflect.TypeOf((*MockOptions)(nil).IndexDefaultQueryTimeout))
 }
 
+// SetNamespaceQuotas mocks base method
+func (m *MockOptions) SetNamespaceQuotas(value ratelimit.NamespaceQuotas) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNamespaceQuotas", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetNamespaceQuotas indicates an expected call of SetNamespaceQuotas
+func (mr *MockOptionsMockRecorder) SetNamespaceQuotas(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespaceQuotas", reflect.TypeOf((*MockOptions)(nil).SetNamespaceQuotas), value)
+}
+
+// NamespaceQuotas mocks base method
+func (m *MockOptions) NamespaceQuotas() ratelimit.NamespaceQuotas {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceQuotas")
+	ret0, _ := ret[0].(ratelimit.NamespaceQuotas)
+	return ret0
+}
+
+// NamespaceQuotas indicates an expected call of NamespaceQuotas
+func (mr *MockOptionsMockRecorder) NamespaceQuotas() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceQuotas", reflect.TypeOf((*MockOptions)(nil).NamespaceQuotas))
+}
+
+// SetColdFlushPlannerOptions mocks base method
+func (m *MockOptions) SetColdFlushPlannerOptions(value coldcompaction.PlannerOptions) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetColdFlushPlannerOptions", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetColdFlushPlannerOptions indicates an expected call of SetColdFlushPlannerOptions
+func (mr *MockOptionsMockRecorder) SetColdFlushPlannerOptions(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetColdFlushPlannerOptions", reflect.TypeOf((*MockOptions)(nil).SetColdFlushPlannerOptions), value)
+}
+
+// ColdFlushPlannerOptions mocks base method
+func (m *MockOptions) ColdFlushPlannerOptions() coldcompaction.PlannerOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ColdFlushPlannerOptions")
+	ret0, _ := ret[0].(coldcompaction.PlannerOptions)
+	return ret0
+}
+
+// ColdFlushPlannerOptions indicates an expected call of ColdFlushPlannerOptions
+func (mr *MockOptionsMockRecorder) ColdFlushPlannerOptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlushPlannerOptions", reflect.TypeOf((*MockOptions)(nil).ColdFlushPlannerOptions))
+}
+
 // MockOptionsManager is a mock of OptionsManager interface
 type MockOptionsManager struct {
 	ctrl     *gomock.Controller
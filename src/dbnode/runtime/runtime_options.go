@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/ratelimit"
+	"github.com/m3db/m3/src/dbnode/storage/coldcompaction"
 	"github.com/m3db/m3/src/dbnode/topology"
 )
 
@@ -75,6 +76,8 @@ type options struct {
 	clientReadConsistencyLevel           topology.ReadConsistencyLevel
 	clientWriteConsistencyLevel          topology.ConsistencyLevel
 	indexDefaultQueryTimeout             time.Duration
+	namespaceQuotas                      ratelimit.NamespaceQuotas
+	coldFlushPlannerOpts                 coldcompaction.PlannerOptions
 }
 
 // NewOptions creates a new set of runtime options with defaults
@@ -92,6 +95,7 @@ func NewOptions() Options {
 		clientReadConsistencyLevel:           DefaultReadConsistencyLevel,
 		clientWriteConsistencyLevel:          DefaultWriteConsistencyLevel,
 		indexDefaultQueryTimeout:             DefaultIndexDefaultQueryTimeout,
+		coldFlushPlannerOpts:                 coldcompaction.DefaultOptions,
 	}
 }
 
@@ -117,6 +121,14 @@ func (o *options) Validate() error {
 
 	// tickMinimumInterval can be zero if user desires
 
+	if err := o.namespaceQuotas.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.coldFlushPlannerOpts.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -239,3 +251,23 @@ func (o *options) SetIndexDefaultQueryTimeout(value time.Duration) Options {
 func (o *options) IndexDefaultQueryTimeout() time.Duration {
 	return o.indexDefaultQueryTimeout
 }
+
+func (o *options) SetNamespaceQuotas(value ratelimit.NamespaceQuotas) Options {
+	opts := *o
+	opts.namespaceQuotas = value
+	return &opts
+}
+
+func (o *options) NamespaceQuotas() ratelimit.NamespaceQuotas {
+	return o.namespaceQuotas
+}
+
+func (o *options) SetColdFlushPlannerOptions(value coldcompaction.PlannerOptions) Options {
+	opts := *o
+	opts.coldFlushPlannerOpts = value
+	return &opts
+}
+
+func (o *options) ColdFlushPlannerOptions() coldcompaction.PlannerOptions {
+	return o.coldFlushPlannerOpts
+}
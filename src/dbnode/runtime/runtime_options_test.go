@@ -22,6 +22,10 @@ package runtime
 
 import (
 	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/ratelimit"
+	"github.com/m3db/m3/src/dbnode/storage/coldcompaction"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -30,3 +34,28 @@ func TestRuntimeOptionsDefaultsIsValid(t *testing.T) {
 	v := NewOptions()
 	assert.NoError(t, v.Validate())
 }
+
+func TestRuntimeOptionsNamespaceQuotasValidate(t *testing.T) {
+	v := NewOptions().SetNamespaceQuotas(ratelimit.NamespaceQuotas{
+		"foo": {DatapointsPerSecond: 100},
+	})
+	assert.NoError(t, v.Validate())
+
+	v = v.SetNamespaceQuotas(ratelimit.NamespaceQuotas{
+		"foo": {IndexDocsMatchedPerSecond: -1},
+	})
+	assert.Error(t, v.Validate())
+}
+
+func TestRuntimeOptionsColdFlushPlannerOptionsValidate(t *testing.T) {
+	v := NewOptions().SetColdFlushPlannerOptions(coldcompaction.PlannerOptions{
+		MaxPendingAge:     time.Hour,
+		MaxBlocksPerFlush: 4,
+	})
+	assert.NoError(t, v.Validate())
+
+	v = v.SetColdFlushPlannerOptions(coldcompaction.PlannerOptions{
+		MaxBlocksPerFlush: -1,
+	})
+	assert.Error(t, v.Validate())
+}
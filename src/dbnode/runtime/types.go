@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/ratelimit"
+	"github.com/m3db/m3/src/dbnode/storage/coldcompaction"
 	"github.com/m3db/m3/src/dbnode/topology"
 	xclose "github.com/m3db/m3/src/x/close"
 )
@@ -168,6 +169,22 @@ type Options interface {
 	// IndexDefaultQueryTimeout is the hard timeout value to use if none is
 	// specified for a specific query, zero specifies to use no timeout at all.
 	IndexDefaultQueryTimeout() time.Duration
+
+	// SetNamespaceQuotas sets the per namespace quotas on datapoints written,
+	// new series inserted and index documents matched per second.
+	SetNamespaceQuotas(value ratelimit.NamespaceQuotas) Options
+
+	// NamespaceQuotas returns the per namespace quotas on datapoints written,
+	// new series inserted and index documents matched per second.
+	NamespaceQuotas() ratelimit.NamespaceQuotas
+
+	// SetColdFlushPlannerOptions sets the thresholds used to decide which
+	// blocks with cold writes are merged into new volumes on a cold flush.
+	SetColdFlushPlannerOptions(value coldcompaction.PlannerOptions) Options
+
+	// ColdFlushPlannerOptions returns the thresholds used to decide which
+	// blocks with cold writes are merged into new volumes on a cold flush.
+	ColdFlushPlannerOptions() coldcompaction.PlannerOptions
 }
 
 // OptionsManager updates and supplies runtime options.
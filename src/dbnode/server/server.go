@@ -29,6 +29,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"reflect"
 	"runtime"
 	"runtime/debug"
 	"strings"
@@ -44,11 +45,14 @@ import (
 	queryconfig "github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/encoding/proto"
 	"github.com/m3db/m3/src/dbnode/environment"
+	quotapb "github.com/m3db/m3/src/dbnode/generated/proto/quota"
 	"github.com/m3db/m3/src/dbnode/kvconfig"
 	"github.com/m3db/m3/src/dbnode/namespace"
+	grpcnode "github.com/m3db/m3/src/dbnode/network/server/grpc/node"
 	hjcluster "github.com/m3db/m3/src/dbnode/network/server/httpjson/cluster"
 	hjnode "github.com/m3db/m3/src/dbnode/network/server/httpjson/node"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
@@ -57,6 +61,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
 	"github.com/m3db/m3/src/dbnode/ratelimit"
+	"github.com/m3db/m3/src/dbnode/replication"
 	"github.com/m3db/m3/src/dbnode/retention"
 	m3dbruntime "github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/sharding"
@@ -95,6 +100,7 @@ import (
 	"github.com/uber/tchannel-go"
 	"go.etcd.io/etcd/embed"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -400,6 +406,9 @@ func Run(runOpts RunOptions) {
 		plCacheOptions = index.PostingsListCacheOptions{
 			InstrumentOptions: opts.InstrumentOptions().
 				SetMetricsScope(scope.SubScope("postings-list-cache")),
+			HotQueriesSize: plCacheConfig.HotQueriesSizeOrDefault(),
+			HotQueriesFilePath: path.Join(cfg.Filesystem.FilePathPrefixOrDefault(),
+				"postings_list_cache", "hot_queries.json"),
 		}
 	)
 	postingsListCache, stopReporting, err := index.NewPostingsListCache(plCacheSize, plCacheOptions)
@@ -426,8 +435,9 @@ func Run(runOpts RunOptions) {
 	indexOpts = indexOpts.SetInsertMode(insertMode).
 		SetPostingsListCache(postingsListCache).
 		SetReadThroughSegmentOptions(index.ReadThroughSegmentOptions{
-			CacheRegexp: plCacheConfig.CacheRegexpOrDefault(),
-			CacheTerms:  plCacheConfig.CacheTermsOrDefault(),
+			CacheRegexp:   plCacheConfig.CacheRegexpOrDefault(),
+			CacheTerms:    plCacheConfig.CacheTermsOrDefault(),
+			CacheSearches: plCacheConfig.CacheSearchesOrDefault(),
 		}).
 		SetMmapReporter(mmapReporter).
 		SetQueryStats(queryStats)
@@ -440,6 +450,11 @@ func Run(runOpts RunOptions) {
 			SetTickMinimumInterval(tick.MinimumInterval)
 	}
 
+	if planner := cfg.ColdFlushPlanner; planner != nil {
+		runtimeOpts = runtimeOpts.
+			SetColdFlushPlannerOptions(planner.PlannerOptions())
+	}
+
 	runtimeOptsMgr := m3dbruntime.NewOptionsManager()
 	if err := runtimeOptsMgr.Update(runtimeOpts); err != nil {
 		logger.Fatal("could not set initial runtime options", zap.Error(err))
@@ -489,6 +504,19 @@ func Run(runOpts RunOptions) {
 		SetIndexBloomFilterFalsePositivePercent(cfg.Filesystem.BloomFilterFalsePositivePercentOrDefault()).
 		SetMmapReporter(mmapReporter)
 
+	if remoteCfg := cfg.Filesystem.Remote; remoteCfg != nil {
+		remoteStore, err := remoteCfg.NewStore()
+		if err != nil {
+			logger.Fatal("could not create remote fileset store", zap.Error(err))
+		}
+		fsopts = fsopts.
+			SetRemoteStore(remoteStore).
+			SetRemoteOffloadAfter(remoteCfg.OffloadAfter)
+		if remoteCfg.HydratedPinDuration != nil {
+			fsopts = fsopts.SetRemoteHydratedPinDuration(*remoteCfg.HydratedPinDuration)
+		}
+	}
+
 	var commitLogQueueSize int
 	specified := cfg.CommitLog.Queue.Size
 	switch cfg.CommitLog.Queue.CalculationType {
@@ -517,6 +545,23 @@ func Run(runOpts RunOptions) {
 		commitLogQueueChannelSize = int(float64(commitLogQueueSize) / commitlog.MaximumQueueSizeQueueChannelSizeRatio)
 	}
 
+	// The encoding format is recorded in the info files of flushed volumes
+	// even when rewrites are disabled so that enabling them later only
+	// rewrites volumes encoded differently.
+	rewriteOpts := fs.RewriteOptions{}
+	if rewriteCfg := cfg.Filesystem.Rewrite; rewriteCfg != nil && rewriteCfg.Enabled {
+		rewriteOpts, err = rewriteCfg.RewriteOptions()
+		if err != nil {
+			logger.Fatal("could not create fileset rewrite options", zap.Error(err))
+		}
+		opts = opts.SetFileSetRewriteEnabled(true)
+	}
+	rewriteOpts.EncodingFormat = fs.M3TSZEncodingFormat(m3tsz.DefaultIntOptimizationEnabled)
+	if cfg.Proto != nil && cfg.Proto.Enabled {
+		rewriteOpts.EncodingFormat = fs.EncodingFormatProto
+	}
+	opts = opts.SetFileSetRewriteOptions(rewriteOpts)
+
 	// Set the series cache policy.
 	seriesCachePolicy := cfg.Cache.SeriesConfiguration().Policy
 	opts = opts.SetSeriesCachePolicy(seriesCachePolicy)
@@ -532,6 +577,10 @@ func Run(runOpts RunOptions) {
 		SetFlushInterval(cfg.CommitLog.FlushEvery).
 		SetBacklogQueueSize(commitLogQueueSize).
 		SetBacklogQueueChannelSize(commitLogQueueChannelSize))
+	if compression := cfg.CommitLog.Compression; compression != nil {
+		opts = opts.SetCommitLogOptions(opts.CommitLogOptions().
+			SetCompression(*compression))
+	}
 
 	// Setup the block retriever
 	switch seriesCachePolicy {
@@ -651,6 +700,24 @@ func Run(runOpts RunOptions) {
 	defer httpjsonNodeClose()
 	logger.Info("node httpjson: listening", zap.String("address", cfg.HTTPNodeListenAddress))
 
+	if cfg.GRPCListenAddress != "" {
+		var grpcOpts []grpc.ServerOption
+		if cfg.GRPC != nil {
+			grpcOpts, err = cfg.GRPC.ServerOptions()
+			if err != nil {
+				logger.Fatal("could not create grpc server options", zap.Error(err))
+			}
+		}
+		grpcNodeClose, err := grpcnode.NewServer(service,
+			cfg.GRPCListenAddress, contextPool, grpcOpts...).ListenAndServe()
+		if err != nil {
+			logger.Fatal("could not open grpc interface",
+				zap.String("address", cfg.GRPCListenAddress), zap.Error(err))
+		}
+		defer grpcNodeClose()
+		logger.Info("node grpc: listening", zap.String("address", cfg.GRPCListenAddress))
+	}
+
 	if cfg.DebugListenAddress != "" {
 		var debugWriter xdebug.ZipWriter
 		handlerOpts, err := placement.NewHandlerOptions(syncCfg.ClusterClient,
@@ -708,6 +775,8 @@ func Run(runOpts RunOptions) {
 		logger.Fatal("could not initialize m3db topology", zap.Error(err))
 	}
 
+	kvWatchNamespaceQuotas(syncCfg.KVStore, logger, runtimeOptsMgr)
+
 	var protoEnabled bool
 	if cfg.Proto != nil && cfg.Proto.Enabled {
 		protoEnabled = true
@@ -729,7 +798,7 @@ func Run(runOpts RunOptions) {
 	origin := topology.NewHost(hostID, "")
 	m3dbClient, err := newAdminClient(
 		cfg.Client, iopts, tchannelOpts, syncCfg.TopologyInitializer,
-		runtimeOptsMgr, origin, protoEnabled, schemaRegistry,
+		syncCfg.NamespaceInitializer, runtimeOptsMgr, origin, protoEnabled, schemaRegistry,
 		syncCfg.KVStore, logger, runOpts.CustomOptions)
 
 	if err != nil {
@@ -758,14 +827,14 @@ func Run(runOpts RunOptions) {
 				continue
 			}
 
-			// Pass nil for the topology initializer because we want to create
-			// a new one for the cluster we wish to replicate from, not use the
-			// same one as the cluster this node belongs to.
+			// Pass nil for the topology and namespace initializers because we
+			// want to create new ones for the cluster we wish to replicate from,
+			// not use the same ones as the cluster this node belongs to.
 			var topologyInitializer topology.Initializer
 			// Guaranteed to not be nil if repair is enabled by config validation.
 			clientCfg := *cluster.Client
 			clusterClient, err := newAdminClient(
-				clientCfg, iopts, tchannelOpts, topologyInitializer,
+				clientCfg, iopts, tchannelOpts, topologyInitializer, nil,
 				runtimeOptsMgr, origin, protoEnabled, schemaRegistry,
 				syncCfg.KVStore, logger, runOpts.CustomOptions)
 			if err != nil {
@@ -908,6 +977,73 @@ func Run(runOpts RunOptions) {
 	// Now that we've initialized the database we can set it on the service.
 	service.SetDatabase(db)
 
+	if cfg.Replication != nil {
+		for _, cluster := range cfg.Replication.Clusters {
+			if cluster.AsyncReplication == nil {
+				continue
+			}
+
+			// Guaranteed to not be nil if async replication is enabled by
+			// config validation.
+			clientCfg := *cluster.Client
+			clusterClient, err := newAdminClient(
+				clientCfg, iopts, tchannelOpts, nil, nil,
+				runtimeOptsMgr, origin, protoEnabled, schemaRegistry,
+				syncCfg.KVStore, logger, runOpts.CustomOptions)
+			if err != nil {
+				logger.Fatal(
+					"unable to create client for async replication cluster",
+					zap.String("clusterName", cluster.Name), zap.Error(err))
+			}
+
+			var nsMetadatas []namespace.Metadata
+			for _, ns := range db.Namespaces() {
+				nsMetadatas = append(nsMetadatas, ns.Metadata())
+			}
+			namespaces := asyncReplicationNamespaces(cluster, nsMetadatas)
+
+			remoteEnvCfg, err := clientCfg.EnvironmentConfig.Configure(
+				environment.ConfigurationParameters{InstrumentOpts: iopts})
+			if err != nil {
+				logger.Fatal(
+					"unable to configure async replication cluster environment",
+					zap.String("clusterName", cluster.Name), zap.Error(err))
+			}
+			remoteSyncCfg, err := remoteEnvCfg.SyncCluster()
+			if err != nil {
+				logger.Fatal(
+					"unable to configure async replication cluster environment",
+					zap.String("clusterName", cluster.Name), zap.Error(err))
+			}
+
+			replicator, err := newAsyncReplicator(cluster, clusterClient,
+				remoteSyncCfg.NamespaceInitializer, syncCfg.NamespaceInitializer,
+				namespaces, opts, cfg.Filesystem.FilePathPrefixOrDefault())
+			if err != nil {
+				logger.Fatal("unable to create async replicator",
+					zap.String("clusterName", cluster.Name), zap.Error(err))
+			}
+			if err := replicator.Open(); err != nil {
+				logger.Fatal("unable to open async replicator",
+					zap.String("clusterName", cluster.Name), zap.Error(err))
+			}
+			defer replicator.Close()
+
+			// Namespaces added to the registry, or updated to name the
+			// cluster as a replication cluster, are replicated as they change.
+			nsWatchClose, err := watchAsyncReplicationNamespaces(cluster, replicator,
+				syncCfg.NamespaceInitializer, iopts)
+			if err != nil {
+				logger.Fatal("unable to watch namespaces for async replication",
+					zap.String("clusterName", cluster.Name), zap.Error(err))
+			}
+			defer nsWatchClose()
+			logger.Info("async replication: started",
+				zap.String("clusterName", cluster.Name),
+				zap.Int("numNamespaces", len(namespaces)))
+		}
+	}
+
 	go func() {
 		if runOpts.BootstrapCh != nil {
 			// Notify on bootstrap chan if specified.
@@ -1041,6 +1177,73 @@ func kvWatchNewSeriesLimitPerShard(
 	}()
 }
 
+func kvWatchNamespaceQuotas(
+	store kv.Store,
+	logger *zap.Logger,
+	runtimeOptsMgr m3dbruntime.OptionsManager,
+) {
+	value, err := store.Get(kvconfig.NamespaceQuotasKey)
+	if err == nil {
+		protoValue := &quotapb.NamespaceQuotas{}
+		err = value.Unmarshal(protoValue)
+		if err == nil {
+			err = setNamespaceQuotasOnChange(runtimeOptsMgr, protoValue)
+		}
+	}
+	if err != nil && err != kv.ErrNotFound {
+		logger.Warn("unable to set namespace quotas", zap.Error(err))
+	}
+
+	watch, err := store.Watch(kvconfig.NamespaceQuotasKey)
+	if err != nil {
+		logger.Error("could not watch namespace quotas", zap.Error(err))
+		return
+	}
+
+	go func() {
+		for range watch.C() {
+			protoValue := &quotapb.NamespaceQuotas{}
+			if newValue := watch.Get(); newValue != nil {
+				if err := newValue.Unmarshal(protoValue); err != nil {
+					logger.Warn("unable to parse new namespace quotas", zap.Error(err))
+					continue
+				}
+			}
+
+			err := setNamespaceQuotasOnChange(runtimeOptsMgr, protoValue)
+			if err != nil {
+				logger.Warn("unable to set namespace quotas", zap.Error(err))
+				continue
+			}
+		}
+	}()
+}
+
+func setNamespaceQuotasOnChange(
+	runtimeOptsMgr m3dbruntime.OptionsManager,
+	protoValue *quotapb.NamespaceQuotas,
+) error {
+	quotas := make(ratelimit.NamespaceQuotas, len(protoValue.Namespaces))
+	for nsID, quota := range protoValue.Namespaces {
+		if quota == nil {
+			continue
+		}
+		quotas[nsID] = ratelimit.Quota{
+			DatapointsPerSecond:       quota.DatapointsPerSecond,
+			NewSeriesPerSecond:        quota.NewSeriesPerSecond,
+			IndexDocsMatchedPerSecond: quota.IndexDocsMatchedPerSecond,
+		}
+	}
+
+	runtimeOpts := runtimeOptsMgr.Get()
+	if reflect.DeepEqual(runtimeOpts.NamespaceQuotas(), quotas) {
+		// Not changed, no need to set the value and trigger a runtime options update
+		return nil
+	}
+
+	return runtimeOptsMgr.Update(runtimeOpts.SetNamespaceQuotas(quotas))
+}
+
 func kvWatchClientConsistencyLevels(
 	store kv.Store,
 	logger *zap.Logger,
@@ -1320,6 +1523,11 @@ func withEncodingAndPoolingOptions(
 			policy.EncoderPool,
 			scope.SubScope("encoder-pool")))
 
+	histogramEncoderPool := encoding.NewEncoderPool(
+		poolOptions(
+			policy.HistogramEncoderPool,
+			scope.SubScope("histogram-encoder-pool")))
+
 	closersPoolOpts := poolOptions(
 		policy.ClosersPool,
 		scope.SubScope("closers-pool"))
@@ -1437,11 +1645,20 @@ func withEncodingAndPoolingOptions(
 		return m3tsz.NewEncoder(time.Time{}, nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
 	})
 
+	// Series of namespaces with histograms enabled are encoded with the
+	// histogram encoder, which returns itself to its own pool on close.
+	histogramEncodingOpts := encodingOpts.SetEncoderPool(histogramEncoderPool)
+	histogramEncoderPool.Init(func() encoding.Encoder {
+		return histogram.NewEncoder(time.Time{}, histogramEncodingOpts)
+	})
+
 	iteratorPool.Init(func(r io.Reader, descr namespace.SchemaDescr) encoding.ReaderIterator {
 		if cfg.Proto != nil && cfg.Proto.Enabled {
 			return proto.NewIterator(r, descr, encodingOpts)
 		}
-		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+		return histogram.NewReaderIteratorWithFallback(r,
+			m3tsz.NewReaderIterator(nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts),
+			encodingOpts)
 	})
 
 	multiIteratorPool.Init(func(r io.Reader, descr namespace.SchemaDescr) encoding.ReaderIterator {
@@ -1465,6 +1682,7 @@ func withEncodingAndPoolingOptions(
 		SetBytesPool(bytesPool).
 		SetContextPool(contextPool).
 		SetEncoderPool(encoderPool).
+		SetHistogramEncoderPool(histogramEncoderPool).
 		SetReaderIteratorPool(iteratorPool).
 		SetMultiReaderIteratorPool(multiIteratorPool).
 		SetIdentifierPool(identifierPool).
@@ -1569,7 +1787,8 @@ func withEncodingAndPoolingOptions(
 		SetAggregateResultsPool(aggregateQueryResultsPool).
 		SetAggregateValuesPool(aggregateQueryValuesPool).
 		SetForwardIndexProbability(cfg.Index.ForwardIndexProbability).
-		SetForwardIndexThreshold(cfg.Index.ForwardIndexThreshold)
+		SetForwardIndexThreshold(cfg.Index.ForwardIndexThreshold).
+		SetCardinalityTrackingEnabled(cfg.Index.CardinalityTrackingEnabled)
 
 	queryResultsPool.Init(func() index.QueryResults {
 		// NB(r): Need to initialize after setting the index opts so
@@ -1590,11 +1809,122 @@ func withEncodingAndPoolingOptions(
 	return opts.SetIndexOptions(indexOpts)
 }
 
+// asyncReplicationNamespaces returns the namespaces listed by the async
+// replication policy of the cluster along with the namespaces whose options
+// name the cluster as a replication cluster.
+func asyncReplicationNamespaces(
+	cluster config.ReplicatedCluster,
+	namespaces []namespace.Metadata,
+) []ident.ID {
+	var (
+		policy = cluster.AsyncReplication
+		seen   = make(map[string]struct{}, len(policy.Namespaces))
+		result = make([]ident.ID, 0, len(policy.Namespaces))
+	)
+	for _, ns := range policy.Namespaces {
+		if _, ok := seen[ns]; ok {
+			continue
+		}
+		seen[ns] = struct{}{}
+		result = append(result, ident.StringID(ns))
+	}
+	for _, ns := range namespaces {
+		id := ns.ID().String()
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		for _, name := range ns.Options().ReplicationClusters() {
+			if name == cluster.Name {
+				seen[id] = struct{}{}
+				result = append(result, ident.StringID(id))
+				break
+			}
+		}
+	}
+	return result
+}
+
+// watchAsyncReplicationNamespaces updates the namespaces replicated to the
+// cluster whenever the namespace registry is updated.
+func watchAsyncReplicationNamespaces(
+	cluster config.ReplicatedCluster,
+	replicator replication.Replicator,
+	nsInit namespace.Initializer,
+	iopts instrument.Options,
+) (func(), error) {
+	registry, err := nsInit.Init()
+	if err != nil {
+		return nil, err
+	}
+	watch, err := registry.Watch()
+	if err != nil {
+		registry.Close()
+		return nil, err
+	}
+
+	iopts = iopts.SetMetricsScope(iopts.MetricsScope().
+		SubScope("replication").
+		Tagged(map[string]string{"cluster": cluster.Name}))
+	nsWatch := namespace.NewNamespaceWatch(func(namespaces namespace.Map) error {
+		return replicator.SetNamespaces(
+			asyncReplicationNamespaces(cluster, namespaces.Metadatas()))
+	}, watch, iopts)
+	if err := nsWatch.Start(); err != nil {
+		registry.Close()
+		return nil, err
+	}
+
+	return func() {
+		nsWatch.Close()
+		registry.Close()
+	}, nil
+}
+
+func newAsyncReplicator(
+	cluster config.ReplicatedCluster,
+	clusterClient client.AdminClient,
+	remoteNamespaceInit namespace.Initializer,
+	localNamespaceInit namespace.Initializer,
+	namespaces []ident.ID,
+	opts storage.Options,
+	filePathPrefix string,
+) (replication.Replicator, error) {
+	policy := cluster.AsyncReplication
+	iopts := opts.InstrumentOptions()
+	replicatorOpts := replication.NewOptions().
+		SetClockOptions(opts.ClockOptions()).
+		SetInstrumentOptions(iopts.SetMetricsScope(iopts.MetricsScope().Tagged(
+			map[string]string{"cluster": cluster.Name}))).
+		SetClient(clusterClient).
+		SetRemoteNamespaceInitializer(remoteNamespaceInit).
+		SetLocalNamespaceInitializer(localNamespaceInit).
+		SetNamespaces(namespaces).
+		SetCheckpointFilePath(path.Join(filePathPrefix, "replication",
+			cluster.Name, "checkpoint.json")).
+		SetFilesystemOptions(opts.CommitLogOptions().FilesystemOptions()).
+		SetCommitLogOptions(opts.CommitLogOptions()).
+		SetBytesPool(opts.BytesPool()).
+		SetSegmentReaderPool(opts.SegmentReaderPool()).
+		SetMultiReaderIteratorPool(opts.MultiReaderIteratorPool()).
+		SetIdentifierPool(opts.IdentifierPool())
+	if policy.Interval != nil {
+		replicatorOpts = replicatorOpts.SetInterval(*policy.Interval)
+	}
+	if policy.BatchSize != nil {
+		replicatorOpts = replicatorOpts.SetBatchSize(*policy.BatchSize)
+	}
+	if policy.WriteConcurrency != nil {
+		replicatorOpts = replicatorOpts.SetWriteConcurrency(*policy.WriteConcurrency)
+	}
+	return replication.NewReplicator(replicatorOpts)
+}
+
 func newAdminClient(
 	config client.Configuration,
 	iopts instrument.Options,
 	tchannelOpts *tchannel.ChannelOptions,
 	topologyInitializer topology.Initializer,
+	namespaceInitializer namespace.Initializer,
 	runtimeOptsMgr m3dbruntime.OptionsManager,
 	origin topology.Host,
 	protoEnabled bool,
@@ -1607,6 +1937,7 @@ func newAdminClient(
 		// If the user has provided an override for the dynamic client configuration
 		// then we need to honor it by not passing our own topology initializer.
 		topologyInitializer = nil
+		namespaceInitializer = nil
 	}
 
 	// NB: append custom options coming from run options to existing options.
@@ -1639,7 +1970,8 @@ func newAdminClient(
 		client.ConfigurationParameters{
 			InstrumentOptions: iopts.
 				SetMetricsScope(iopts.MetricsScope().SubScope("m3dbclient")),
-			TopologyInitializer: topologyInitializer,
+			TopologyInitializer:  topologyInitializer,
+			NamespaceInitializer: namespaceInitializer,
 		},
 		options...,
 	)
@@ -35,10 +35,16 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	idxconvert "github.com/m3db/m3/src/dbnode/storage/index/convert"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/index"
+	"github.com/m3db/m3/src/m3ninx/index/segment"
+	"github.com/m3db/m3/src/m3ninx/index/segment/mem"
+	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
 	"github.com/m3db/m3/src/x/checked"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
@@ -46,6 +52,7 @@ import (
 	"github.com/m3db/m3/src/x/pool"
 	xtime "github.com/m3db/m3/src/x/time"
 
+	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 )
@@ -57,6 +64,8 @@ const (
 type newIteratorFn func(opts commitlog.IteratorOpts) (
 	iter commitlog.Iterator, corruptFiles []commitlog.ErrorWithPath, err error)
 type snapshotFilesFn func(filePathPrefix string, namespace ident.ID, shard uint32) (fs.FileSetFilesSlice, error)
+type snapshotMetadataFilesFn func(opts fs.Options) (
+	[]fs.SnapshotMetadata, []fs.SnapshotMetadataErrorWithPaths, error)
 type newReaderFn func(bytesPool pool.CheckedBytesPool, opts fs.Options) (fs.DataFileSetReader, error)
 
 type commitLogSource struct {
@@ -67,9 +76,10 @@ type commitLogSource struct {
 	// Filesystem inspection capture before node was started.
 	inspection fs.Inspection
 
-	newIteratorFn   newIteratorFn
-	snapshotFilesFn snapshotFilesFn
-	newReaderFn     newReaderFn
+	newIteratorFn           newIteratorFn
+	snapshotFilesFn         snapshotFilesFn
+	snapshotMetadataFilesFn snapshotMetadataFilesFn
+	newReaderFn             newReaderFn
 
 	metrics commitLogSourceMetrics
 }
@@ -82,6 +92,135 @@ type bootstrapNamespace struct {
 	namespaceContext        namespace.Context
 	dataBlockSize           time.Duration
 	accumulator             bootstrap.NamespaceDataAccumulator
+	snapshotCoverage        map[uint32]shardSnapshotCoverage
+	tombstones              map[uint32]shardTombstones
+	// documents collects the documents written to an index only namespace,
+	// nil if the namespace is not index only.
+	documents *namespaceDocuments
+}
+
+// namespaceDocuments are the documents of an index only namespace replayed
+// from the commit log, keyed by index block start.
+type namespaceDocuments struct {
+	indexBlockSize time.Duration
+	byBlock        map[xtime.UnixNano]*blockDocuments
+}
+
+type blockDocuments struct {
+	// docs are keyed by document ID and only hold the first write of each
+	// document, matching the index which rejects writes of documents that it
+	// already holds.
+	docs      map[string]doc.Document
+	fulfilled result.ShardTimeRanges
+}
+
+func newNamespaceDocuments(indexBlockSize time.Duration) *namespaceDocuments {
+	return &namespaceDocuments{
+		indexBlockSize: indexBlockSize,
+		byBlock:        make(map[xtime.UnixNano]*blockDocuments),
+	}
+}
+
+func (d *namespaceDocuments) add(shard uint32, timestamp time.Time, document doc.Document) {
+	blockStart := xtime.ToUnixNano(timestamp.Truncate(d.indexBlockSize))
+	block, ok := d.byBlock[blockStart]
+	if !ok {
+		block = &blockDocuments{
+			docs:      make(map[string]doc.Document),
+			fulfilled: result.NewShardTimeRanges(),
+		}
+		d.byBlock[blockStart] = block
+	}
+	if _, ok := block.docs[string(document.ID)]; !ok {
+		block.docs[string(document.ID)] = document
+	}
+	// NB: Only mark the times of the documents fulfilled so that the
+	// segments read from index filesets for the block are not replaced.
+	block.fulfilled.GetOrAdd(shard).AddRange(xtime.Range{
+		Start: timestamp,
+		End:   timestamp.Add(time.Nanosecond),
+	})
+}
+
+// indexResults builds a mutable segment per block so that the documents are
+// persisted by the next index flush of the block.
+func (d *namespaceDocuments) indexResults() (result.IndexResults, error) {
+	results := make(result.IndexResults, len(d.byBlock))
+	for blockStart, block := range d.byBlock {
+		seg, err := mem.NewSegment(0, mem.NewOptions())
+		if err != nil {
+			return nil, err
+		}
+		docs := make([]doc.Document, 0, len(block.docs))
+		for _, document := range block.docs {
+			docs = append(docs, document)
+		}
+		if err := seg.InsertBatch(index.NewBatch(docs)); err != nil {
+			return nil, err
+		}
+		blocks := result.NewIndexBlockByVolumeType(blockStart.ToTime())
+		blocks.SetBlock(idxpersist.DefaultIndexVolumeType,
+			result.NewIndexBlock([]segment.Segment{seg}, block.fulfilled))
+		results.Add(blocks)
+	}
+	return results, nil
+}
+
+// shardTombstones are the tombstones of a shard keyed by series ID.
+type shardTombstones map[string][]fs.Tombstone
+
+// shardSnapshotCoverage describes the commit log entries of a shard that
+// are contained in the snapshot files read while bootstrapping.
+type shardSnapshotCoverage struct {
+	// highWaterMark is the commit log position before which all entries
+	// for the shard are contained in the snapshot.
+	highWaterMark persist.CommitLogPosition
+	// blocks are the block starts of the snapshot files that were read and
+	// that belong to the snapshot the high-water mark was recorded for.
+	blocks map[xtime.UnixNano]struct{}
+	// complete is whether the snapshot files of every block that were read
+	// belong to the snapshot the high-water mark was recorded for, in which
+	// case every entry for the shard before the high-water mark is contained
+	// in the snapshot files read since blocks without snapshot files held no
+	// unflushed data when the snapshot was taken.
+	complete bool
+}
+
+// coveredBySnapshot returns whether a commit log entry is contained in the
+// snapshot files that were read and therefore need not be replayed.
+func (n *bootstrapNamespace) coveredBySnapshot(
+	shard uint32,
+	entry commitlog.LogEntry,
+) bool {
+	coverage, ok := n.snapshotCoverage[shard]
+	if !ok {
+		return false
+	}
+	if !coverage.highWaterMark.Covers(entry.Metadata.FileIndex, entry.Metadata.EntryOffset) {
+		return false
+	}
+	blockStart := xtime.ToUnixNano(entry.Datapoint.Timestamp.Truncate(n.dataBlockSize))
+	_, ok = coverage.blocks[blockStart]
+	return ok
+}
+
+// deletedByTombstone returns whether a commit log entry was written before
+// its data was deleted and therefore must not be replayed.
+func (n *bootstrapNamespace) deletedByTombstone(
+	shard uint32,
+	entry commitlog.LogEntry,
+) bool {
+	tombstones, ok := n.tombstones[shard]
+	if !ok {
+		return false
+	}
+	for _, t := range tombstones[string(entry.Series.ID.Bytes())] {
+		if t.Deletes(entry.Datapoint.Timestamp) &&
+			t.Position.Covers(entry.Metadata.FileIndex, entry.Metadata.EntryOffset) {
+			return true
+		}
+	}
+	return false
 }
 
 type seriesMap map[seriesMapKey]*seriesMapEntry
@@ -95,6 +234,8 @@ type seriesMapEntry struct {
 	shardNoLongerOwned bool
 	namespace          *bootstrapNamespace
 	series             bootstrap.CheckoutSeriesResult
+	// document is set instead of series for entries of index only namespaces.
+	document doc.Document
 }
 
 // accumulateArg contains all the information a worker go-routine needs to
@@ -135,9 +276,10 @@ func newCommitLogSource(
 
 		inspection: inspection,
 
-		newIteratorFn:   commitlog.NewIterator,
-		snapshotFilesFn: fs.SnapshotFiles,
-		newReaderFn:     fs.NewReader,
+		newIteratorFn:           commitlog.NewIterator,
+		snapshotFilesFn:         fs.SnapshotFiles,
+		snapshotMetadataFilesFn: fs.SortedSnapshotMetadataFiles,
+		newReaderFn:             fs.NewReader,
 
 		metrics: newCommitLogSourceMetrics(scope),
 	}
@@ -162,12 +304,16 @@ func (s *commitLogSource) AvailableIndex(
 type readNamespaceResult struct {
 	namespace               bootstrap.Namespace
 	dataAndIndexShardRanges result.ShardTimeRanges
+	snapshotCoverage        map[uint32]shardSnapshotCoverage
+	tombstones              map[uint32]shardTombstones
+	documents               *namespaceDocuments
 }
 
 // Read will read all commitlog files on disk, as well as as the latest snapshot for
-// each shard/block combination (if it exists) and merge them.
-// TODO(rartoul): Make this take the SnapshotMetadata files into account to reduce the
-// number of commitlogs / snapshots that we need to read.
+// each shard/block combination (if it exists) and merge them. Commit log entries
+// that are below the high-water marks recorded in the most recent snapshot metadata
+// and that are contained in the snapshot files read are skipped, as is any data
+// deleted by the tombstones of each shard.
 func (s *commitLogSource) Read(
 	ctx context.Context,
 	namespaces bootstrap.Namespaces,
@@ -209,6 +355,8 @@ func (s *commitLogSource) Read(
 	s.log.Info("read snapshots start")
 	span.LogEvent("read_snapshots_start")
 
+	latestSnapshotMetadata, hasSnapshotMetadata := s.latestSnapshotMetadata(fsOpts)
+
 	for _, elem := range namespaceIter {
 		ns := elem.Value()
 		accumulator := ns.DataAccumulator
@@ -221,10 +369,15 @@ func (s *commitLogSource) Read(
 			shardTimeRanges.AddRanges(ns.IndexRunOptions.ShardTimeRanges)
 		}
 
-		namespaceResults[ns.Metadata.ID().String()] = &readNamespaceResult{
+		nsResult := &readNamespaceResult{
 			namespace:               ns,
 			dataAndIndexShardRanges: shardTimeRanges,
 		}
+		if ns.Metadata.Options().IndexOnly() {
+			nsResult.documents = newNamespaceDocuments(
+				ns.Metadata.Options().IndexOptions().BlockSize())
+		}
+		namespaceResults[ns.Metadata.ID().String()] = nsResult
 
 		// Make the initial topology state available.
 		if !setInitialTopologyState {
@@ -245,12 +398,26 @@ func (s *commitLogSource) Read(
 			return bootstrap.NamespaceResults{}, err
 		}
 
+		if hasSnapshotMetadata {
+			nsResult.snapshotCoverage = s.snapshotCoverage(
+				ns.Metadata, shardTimeRanges, latestSnapshotMetadata,
+				mostRecentCompleteSnapshotByBlockShard)
+		}
+
+		nsResult.tombstones, err = s.readTombstones(
+			ns.Metadata, filePathPrefix, shardTimeRanges)
+		if err != nil {
+			return bootstrap.NamespaceResults{}, err
+		}
+
 		// Start by reading any available snapshot files.
 		blockSize := ns.Metadata.Options().RetentionOptions().BlockSize()
 		for shard, tr := range shardTimeRanges.Iter() {
+			coverage, hasCoverage := nsResult.snapshotCoverage[shard]
 			err := s.bootstrapShardSnapshots(
 				ns.Metadata, accumulator, shard, tr, blockSize,
-				mostRecentCompleteSnapshotByBlockShard)
+				mostRecentCompleteSnapshotByBlockShard,
+				nsResult.tombstones[shard], coverage, hasCoverage)
 			if err != nil {
 				return bootstrap.NamespaceResults{}, err
 			}
@@ -285,11 +452,25 @@ func (s *commitLogSource) Read(
 	// NB(r): Ensure that channels always get closed.
 	defer closeWorkerChannels()
 
+	// Commit log files before the high-water marks of every shard only hold
+	// entries contained in the snapshot files read, so they are not read.
+	var (
+		commitLogFilesSkippedCoveredBySnapshot = 0
+		coveredFileIndex, hasCoveredFiles      = snapshotsCoveredCommitLogFileIndex(namespaceResults)
+	)
+	readCommitLogFilePredicate := func(f commitlog.FileFilterInfo) bool {
+		if !f.IsCorrupt && hasCoveredFiles && f.File.Index < coveredFileIndex {
+			commitLogFilesSkippedCoveredBySnapshot++
+			return false
+		}
+		return s.readCommitLogFilePredicate(f)
+	}
+
 	// Setup the commit log iterator.
 	var (
 		iterOpts = commitlog.IteratorOpts{
 			CommitLogOptions:    s.opts.CommitLogOptions(),
-			FileFilterPredicate: s.readCommitLogFilePredicate,
+			FileFilterPredicate: readCommitLogFilePredicate,
 			// NB(r): ReturnMetadataAsRef used to all series metadata as
 			// references instead of pulling from pool and allocating,
 			// which means need to not hold onto any references returned
@@ -299,6 +480,9 @@ func (s *commitLogSource) Read(
 		datapointsSkippedNotBootstrappingNamespace = 0
 		datapointsSkippedNotBootstrappingShard     = 0
 		datapointsSkippedShardNoLongerOwned        = 0
+		datapointsSkippedCoveredBySnapshot         = 0
+		datapointsSkippedDeleted                   = 0
+		documentsRead                              = 0
 		startCommitLogsRead                        = s.nowFn()
 	)
 	s.log.Info("read commit logs start")
@@ -311,9 +495,16 @@ func (s *commitLogSource) Read(
 		s.log.Info("read commit logs done",
 			zap.Duration("took", s.nowFn().Sub(startCommitLogsRead)),
 			zap.Int("datapointsRead", datapointsRead),
+			zap.Int("commitLogFilesSkippedCoveredBySnapshot", commitLogFilesSkippedCoveredBySnapshot),
 			zap.Int("datapointsSkippedNotBootstrappingNamespace", datapointsSkippedNotBootstrappingNamespace),
 			zap.Int("datapointsSkippedNotBootstrappingShard", datapointsSkippedNotBootstrappingShard),
-			zap.Int("datapointsSkippedShardNoLongerOwned", datapointsSkippedShardNoLongerOwned))
+			zap.Int("datapointsSkippedShardNoLongerOwned", datapointsSkippedShardNoLongerOwned),
+			zap.Int("datapointsSkippedCoveredBySnapshot", datapointsSkippedCoveredBySnapshot),
+			zap.Int("datapointsSkippedDeleted", datapointsSkippedDeleted),
+			zap.Int("documentsRead", documentsRead))
+		s.metrics.datapointsRead.Inc(int64(datapointsRead))
+		s.metrics.datapointsSkippedCoveredBySnapshot.Inc(int64(datapointsSkippedCoveredBySnapshot))
+		s.metrics.datapointsSkippedDeleted.Inc(int64(datapointsSkippedDeleted))
 		span.LogEvent("read_commitlogs_done")
 	}()
 
@@ -420,6 +611,9 @@ func (s *commitLogSource) Read(
 						namespaceContext:        namespace.NewContextFrom(nsMetadata),
 						dataBlockSize:           nsMetadata.Options().RetentionOptions().BlockSize(),
 						accumulator:             nsResult.namespace.DataAccumulator,
+						snapshotCoverage:        nsResult.snapshotCoverage,
+						tombstones:              nsResult.tombstones,
+						documents:               nsResult.documents,
 					}
 				}
 				// Append for quick re-lookup with other series.
@@ -460,28 +654,42 @@ func (s *commitLogSource) Read(
 					tagIter = ident.EmptyTagIterator
 				}
 
-				// Check out the series for writing, no need for concurrency
-				// as commit log bootstrapper does not perform parallel
-				// checking out of series.
-				series, owned, err := accumulator.CheckoutSeriesWithoutLock(
-					entry.Series.Shard,
-					entry.Series.ID,
-					tagIter)
-				if err != nil {
-					if !owned {
-						// If we encounter a log entry for a shard that we're
-						// not responsible for, skip this entry. This can occur
-						// when a topology change happens and we bootstrap from
-						// a commit log which contains this data.
-						commitLogSeries[seriesKey] = seriesMapEntry{shardNoLongerOwned: true}
-						continue
+				if ns.documents != nil {
+					// Index only namespaces have no series, the entry
+					// holds a document with the tags as its fields.
+					document, err := idxconvert.FromMetricIter(entry.Series.ID, tagIter)
+					if err != nil {
+						return bootstrap.NamespaceResults{}, err
+					}
+					seriesEntry = seriesMapEntry{
+						namespace: ns,
+						series:    bootstrap.CheckoutSeriesResult{Shard: entry.Series.Shard},
+						document:  document,
+					}
+				} else {
+					// Check out the series for writing, no need for concurrency
+					// as commit log bootstrapper does not perform parallel
+					// checking out of series.
+					series, owned, err := accumulator.CheckoutSeriesWithoutLock(
+						entry.Series.Shard,
+						entry.Series.ID,
+						tagIter)
+					if err != nil {
+						if !owned {
+							// If we encounter a log entry for a shard that we're
+							// not responsible for, skip this entry. This can occur
+							// when a topology change happens and we bootstrap from
+							// a commit log which contains this data.
+							commitLogSeries[seriesKey] = seriesMapEntry{shardNoLongerOwned: true}
+							continue
+						}
+						return bootstrap.NamespaceResults{}, err
 					}
-					return bootstrap.NamespaceResults{}, err
-				}
 
-				seriesEntry = seriesMapEntry{
-					namespace: ns,
-					series:    series,
+					seriesEntry = seriesMapEntry{
+						namespace: ns,
+						series:    series,
+					}
 				}
 			}
 
@@ -511,6 +719,32 @@ func (s *commitLogSource) Read(
 			continue
 		}
 
+		// Documents are not snapshotted so they are always replayed, as long
+		// as they are within the ranges being bootstrapped.
+		if documents := seriesEntry.namespace.documents; documents != nil {
+			ranges, _ := seriesEntry.namespace.dataAndIndexShardRanges.Get(shard)
+			timestamp := entry.Datapoint.Timestamp
+			if ranges.Overlaps(xtime.Range{Start: timestamp, End: timestamp.Add(time.Nanosecond)}) {
+				documents.add(shard, timestamp, seriesEntry.document)
+				documentsRead++
+			}
+			continue
+		}
+
+		// If the entry is contained in the snapshot files that were already
+		// read then there is no need to replay it.
+		if seriesEntry.namespace.coveredBySnapshot(shard, entry) {
+			datapointsSkippedCoveredBySnapshot++
+			continue
+		}
+
+		// If the data was deleted after the entry was written then it must
+		// not be restored.
+		if seriesEntry.namespace.deletedByTombstone(shard, entry) {
+			datapointsSkippedDeleted++
+			continue
+		}
+
 		// Distribute work.
 		// NB(r): In future we could batch a few points together before sending
 		// to a channel to alleviate lock contention/stress on the channels.
@@ -568,6 +802,13 @@ func (s *commitLogSource) Read(
 				shardTimeRanges := ns.namespace.IndexRunOptions.ShardTimeRanges
 				indexResult = shardTimeRanges.ToUnfulfilledIndexResult()
 			}
+			if ns.documents != nil {
+				indexResults, err := ns.documents.indexResults()
+				if err != nil {
+					return bootstrap.NamespaceResults{}, err
+				}
+				indexResult.IndexResults().AddResults(indexResults)
+			}
 		}
 		bootstrapResult.Results.Set(id, bootstrap.NamespaceResult{
 			Metadata:    ns.namespace.Metadata,
@@ -580,6 +821,138 @@ func (s *commitLogSource) Read(
 	return bootstrapResult, nil
 }
 
+// latestSnapshotMetadata returns the most recent snapshot metadata, if any can be
+// read then its high-water marks can be used to skip commit log entries.
+func (s *commitLogSource) latestSnapshotMetadata(
+	fsOpts fs.Options,
+) (fs.SnapshotMetadata, bool) {
+	metadatas, _, err := s.snapshotMetadataFilesFn(fsOpts)
+	if err != nil {
+		// Not fatal, simply replay all the commit log entries.
+		s.log.Error("unable to read snapshot metadata files", zap.Error(err))
+		return fs.SnapshotMetadata{}, false
+	}
+	if len(metadatas) == 0 {
+		return fs.SnapshotMetadata{}, false
+	}
+
+	// They should already be sorted by index.
+	return metadatas[len(metadatas)-1], true
+}
+
+// readTombstones reads the tombstones of each shard being bootstrapped.
+func (s *commitLogSource) readTombstones(
+	ns namespace.Metadata,
+	filePathPrefix string,
+	shardsTimeRanges result.ShardTimeRanges,
+) (map[uint32]shardTombstones, error) {
+	tombstonesByShard := make(map[uint32]shardTombstones)
+	for shard := range shardsTimeRanges.Iter() {
+		tombstones, err := fs.ReadTombstones(filePathPrefix, ns.ID(), shard)
+		if err != nil {
+			return nil, err
+		}
+		if len(tombstones) == 0 {
+			continue
+		}
+
+		byID := make(shardTombstones, len(tombstones))
+		for _, t := range tombstones {
+			byID[string(t.ID)] = append(byID[string(t.ID)], t)
+		}
+		tombstonesByShard[shard] = byID
+	}
+	return tombstonesByShard, nil
+}
+
+// snapshotCoverage returns the commit log entries of each shard that are contained
+// in the snapshot files read, which is only the case for blocks whose most recent
+// snapshot file was written by the snapshot the metadata was recorded for.
+func (s *commitLogSource) snapshotCoverage(
+	ns namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	metadata fs.SnapshotMetadata,
+	mostRecentCompleteSnapshotByBlockShard map[xtime.UnixNano]map[uint32]fs.FileSetFile,
+) map[uint32]shardSnapshotCoverage {
+	var (
+		blockSize = ns.Options().RetentionOptions().BlockSize()
+		coverage  = make(map[uint32]shardSnapshotCoverage)
+	)
+	for _, mark := range metadata.HighWaterMarks {
+		if !mark.Namespace.Equal(ns.ID()) {
+			continue
+		}
+		tr, ok := shardsTimeRanges.Get(mark.Shard)
+		if !ok {
+			continue
+		}
+
+		var (
+			blocks    = make(map[xtime.UnixNano]struct{})
+			complete  = true
+			rangeIter = tr.Iter()
+		)
+		for rangeIter.Next() {
+			currRange := rangeIter.Value()
+			for blockStart := currRange.Start.Truncate(blockSize); blockStart.Before(currRange.End); blockStart = blockStart.Add(blockSize) {
+				blockStartNanos := xtime.ToUnixNano(blockStart)
+				snapshot := mostRecentCompleteSnapshotByBlockShard[blockStartNanos][mark.Shard]
+				if snapshot.IsZero() || snapshot.CachedSnapshotTime.Equal(blockStart) {
+					// Snapshot was not read for this block.
+					continue
+				}
+				if !uuid.Equal(snapshot.CachedSnapshotID, metadata.ID.UUID) {
+					// Snapshot for this block was written by a different snapshot
+					// than the one the high-water mark was recorded for.
+					complete = false
+					continue
+				}
+				blocks[blockStartNanos] = struct{}{}
+			}
+		}
+
+		if len(blocks) > 0 {
+			coverage[mark.Shard] = shardSnapshotCoverage{
+				highWaterMark: mark.Position,
+				blocks:        blocks,
+				complete:      complete,
+			}
+		}
+	}
+
+	return coverage
+}
+
+// snapshotsCoveredCommitLogFileIndex returns the index of the commit log file
+// before which every commit log file only holds entries contained in the
+// snapshot files read, which is only the case if the snapshots of every shard
+// bootstrapped have a complete coverage.
+func snapshotsCoveredCommitLogFileIndex(
+	namespaceResults map[string]*readNamespaceResult,
+) (int64, bool) {
+	var (
+		minIndex int64
+		found    bool
+	)
+	for _, nsResult := range namespaceResults {
+		// Documents are always replayed.
+		if nsResult.documents != nil {
+			return 0, false
+		}
+		for shard := range nsResult.dataAndIndexShardRanges.Iter() {
+			coverage, ok := nsResult.snapshotCoverage[shard]
+			if !ok || !coverage.complete {
+				return 0, false
+			}
+			if index := coverage.highWaterMark.Index; !found || index < minIndex {
+				minIndex = index
+				found = true
+			}
+		}
+	}
+	return minIndex, found
+}
+
 func (s *commitLogSource) snapshotFilesByShard(
 	nsID ident.ID,
 	filePathPrefix string,
@@ -691,6 +1064,9 @@ func (s *commitLogSource) bootstrapShardSnapshots(
 	shardTimeRanges xtime.Ranges,
 	blockSize time.Duration,
 	mostRecentCompleteSnapshotByBlockShard map[xtime.UnixNano]map[uint32]fs.FileSetFile,
+	tombstones shardTombstones,
+	coverage shardSnapshotCoverage,
+	hasCoverage bool,
 ) error {
 	rangeIter := shardTimeRanges.Iter()
 	for rangeIter.Next() {
@@ -724,9 +1100,17 @@ func (s *commitLogSource) bootstrapShardSnapshots(
 				continue
 			}
 
+			// Only snapshots with a known high-water mark can be known to have
+			// been written after data was deleted.
+			var mark *persist.CommitLogPosition
+			if _, ok := coverage.blocks[xtime.ToUnixNano(blockStart)]; hasCoverage && ok {
+				mark = &coverage.highWaterMark
+			}
+
 			if err := s.bootstrapShardBlockSnapshot(
 				ns, accumulator, shard, blockStart, blockSize,
-				mostRecentCompleteSnapshotForShardBlock); err != nil {
+				mostRecentCompleteSnapshotForShardBlock,
+				tombstones, mark); err != nil {
 				return err
 			}
 		}
@@ -742,6 +1126,8 @@ func (s *commitLogSource) bootstrapShardBlockSnapshot(
 	blockStart time.Time,
 	blockSize time.Duration,
 	mostRecentCompleteSnapshot fs.FileSetFile,
+	tombstones shardTombstones,
+	mark *persist.CommitLogPosition,
 ) error {
 	var (
 		bOpts      = s.opts.ResultOptions()
@@ -825,6 +1211,19 @@ func (s *commitLogSource) bootstrapShardBlockSnapshot(
 			return err
 		}
 
+		// Remove any data deleted after the snapshot was taken.
+		for _, t := range tombstones[id.String()] {
+			if !t.BlockStart.Equal(blockStart) {
+				continue
+			}
+			if mark != nil && !mark.Before(t.Position) {
+				continue
+			}
+			if err := ref.Series.DeleteRange(t.Start, t.End, nsCtx); err != nil {
+				return err
+			}
+		}
+
 		// Always finalize both ID and tags after loading block.
 		id.Finalize()
 		tags.Close()
@@ -1074,14 +1473,21 @@ func (s *commitLogSource) shardsReplicated(
 }
 
 type commitLogSourceMetrics struct {
-	corruptCommitlogFile tally.Counter
-	bootstrapping        tally.Gauge
+	corruptCommitlogFile               tally.Counter
+	datapointsRead                     tally.Counter
+	datapointsSkippedCoveredBySnapshot tally.Counter
+	datapointsSkippedDeleted           tally.Counter
+	bootstrapping                      tally.Gauge
 }
 
 func newCommitLogSourceMetrics(scope tally.Scope) commitLogSourceMetrics {
+	commitLogScope := scope.SubScope("commitlog")
 	return commitLogSourceMetrics{
-		corruptCommitlogFile: scope.SubScope("commitlog").Counter("corrupt"),
-		bootstrapping:        scope.SubScope("status").Gauge("bootstrapping"),
+		corruptCommitlogFile:               commitLogScope.Counter("corrupt"),
+		datapointsRead:                     commitLogScope.Counter("datapoints-read"),
+		datapointsSkippedCoveredBySnapshot: commitLogScope.Counter("datapoints-skipped-covered-by-snapshot"),
+		datapointsSkippedDeleted:           commitLogScope.Counter("datapoints-skipped-deleted"),
+		bootstrapping:                      scope.SubScope("status").Gauge("bootstrapping"),
 	}
 }
 
@@ -23,6 +23,8 @@ package commitlog
 import (
 	"errors"
 	"io"
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
@@ -43,7 +45,9 @@ import (
 	xtime "github.com/m3db/m3/src/x/time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
 )
 
 var (
@@ -424,6 +428,274 @@ func testItMergesSnapshotsAndCommitLogs(t *testing.T, opts Options,
 	enforceValuesAreCorrect(t, snapshotValues, read)
 }
 
+func TestItSkipsCommitLogEntriesCoveredBySnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		scope = tally.NewTestScope("", nil)
+		iOpts = testDefaultOpts.ResultOptions().InstrumentOptions().SetMetricsScope(scope)
+		opts  = testDefaultOpts.SetResultOptions(
+			testDefaultOpts.ResultOptions().SetInstrumentOptions(iOpts))
+		md         = testNsMetadata(t)
+		nsCtx      = namespace.NewContextFrom(md)
+		src        = newCommitLogSource(opts, fs.Inspection{}).(*commitLogSource)
+		blockSize  = md.Options().RetentionOptions().BlockSize()
+		now        = time.Now()
+		start      = now.Truncate(blockSize).Add(-blockSize)
+		end        = now.Truncate(blockSize)
+		ranges     = xtime.NewRanges()
+		snapshotID = uuid.NewRandom()
+
+		foo = ts.Series{Namespace: nsCtx.ID, Shard: 0, ID: ident.StringID("foo")}
+		// The first two commit log entries were written before the snapshot
+		// began and so are contained in it.
+		snapshotValues = testValues{
+			{foo, start.Add(1 * time.Minute), 1.0, xtime.Nanosecond, nil},
+			{foo, start.Add(2 * time.Minute), 2.0, xtime.Nanosecond, nil},
+		}
+		commitLogValues = testValues{
+			{foo, start.Add(1 * time.Minute), 1.0, xtime.Nanosecond, nil},
+			{foo, start.Add(2 * time.Minute), 2.0, xtime.Nanosecond, nil},
+			{foo, start.Add(3 * time.Minute), 3.0, xtime.Nanosecond, nil},
+		}
+	)
+
+	ranges.AddRange(xtime.Range{
+		Start: start,
+		End:   end,
+	})
+
+	src.newIteratorFn = func(
+		_ commitlog.IteratorOpts,
+	) (commitlog.Iterator, []commitlog.ErrorWithPath, error) {
+		return newTestCommitLogIterator(commitLogValues, nil), nil, nil
+	}
+
+	src.snapshotMetadataFilesFn = func(
+		_ fs.Options,
+	) ([]fs.SnapshotMetadata, []fs.SnapshotMetadataErrorWithPaths, error) {
+		return []fs.SnapshotMetadata{
+			{
+				ID: fs.SnapshotMetadataIdentifier{Index: 0, UUID: snapshotID},
+				HighWaterMarks: []persist.SnapshotHighWaterMark{
+					{
+						Namespace: md.ID(),
+						Shard:     0,
+						Position:  persist.CommitLogPosition{Index: 0, NumEntries: 2},
+					},
+				},
+			},
+		}, nil, nil
+	}
+
+	src.snapshotFilesFn = func(
+		filePathPrefix string,
+		namespace ident.ID,
+		shard uint32,
+	) (fs.FileSetFilesSlice, error) {
+		return fs.FileSetFilesSlice{
+			fs.FileSetFile{
+				ID: fs.FileSetFileIdentifier{
+					Namespace:   namespace,
+					BlockStart:  start,
+					Shard:       shard,
+					VolumeIndex: 0,
+				},
+				// Make sure path passes the "is snapshot" check in SnapshotTimeAndID method.
+				AbsoluteFilePaths:               []string{"snapshots/checkpoint"},
+				CachedHasCompleteCheckpointFile: fs.EvalTrue,
+				CachedSnapshotTime:              start.Add(2 * time.Minute),
+				CachedSnapshotID:                snapshotID,
+			},
+		}, nil
+	}
+
+	encoder := opts.ResultOptions().DatabaseBlockOptions().EncoderPool().Get()
+	encoder.Reset(snapshotValues[0].t, 10, nsCtx.Schema)
+	for _, value := range snapshotValues {
+		dp := ts.Datapoint{
+			Timestamp: value.t,
+			Value:     value.v,
+		}
+		require.NoError(t, encoder.Encode(dp, value.u, value.a))
+	}
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	reader, ok := encoder.Stream(ctx)
+	require.True(t, ok)
+
+	seg, err := reader.Segment()
+	require.NoError(t, err)
+
+	bytes := make([]byte, seg.Len())
+	_, err = reader.Read(bytes)
+	require.NoError(t, err)
+
+	mockReader := fs.NewMockDataFileSetReader(ctrl)
+	mockReader.EXPECT().Open(gomock.Any()).Return(nil)
+	mockReader.EXPECT().Read().Return(
+		foo.ID,
+		ident.EmptyTagIterator,
+		checked.NewBytes(bytes, nil),
+		digest.Checksum(bytes),
+		nil,
+	)
+	mockReader.EXPECT().Read().Return(nil, nil, nil, uint32(0), io.EOF)
+	mockReader.EXPECT().Close().Return(nil)
+	src.newReaderFn = func(
+		bytesPool pool.CheckedBytesPool,
+		opts fs.Options,
+	) (fs.DataFileSetReader, error) {
+		return mockReader, nil
+	}
+
+	targetRanges := result.NewShardTimeRanges().Set(0, ranges)
+	tester := bootstrap.BuildNamespacesTesterWithReaderIteratorPool(
+		t,
+		testDefaultRunOpts,
+		targetRanges,
+		opts.ResultOptions().DatabaseBlockOptions().MultiReaderIteratorPool(),
+		md,
+	)
+
+	defer tester.Finish()
+	tester.TestReadWith(src)
+	tester.TestUnfulfilledForNamespaceIsEmpty(md)
+
+	// Only the entry written after the snapshot began is replayed.
+	read := tester.EnsureDumpWritesForNamespace(md)
+	enforceValuesAreCorrect(t, commitLogValues[2:], read)
+
+	read = tester.EnsureDumpLoadedBlocksForNamespace(md)
+	enforceValuesAreCorrect(t, snapshotValues, read)
+
+	skipped, ok := scope.Snapshot().Counters()["bootstrapper-commitlog.commitlog.datapoints-skipped-covered-by-snapshot+"]
+	require.True(t, ok)
+	require.Equal(t, int64(2), skipped.Value())
+}
+
+func TestSnapshotsCoveredCommitLogFileIndex(t *testing.T) {
+	complete := func(index int64) shardSnapshotCoverage {
+		return shardSnapshotCoverage{
+			highWaterMark: persist.CommitLogPosition{Index: index},
+			complete:      true,
+		}
+	}
+	newResult := func(coverage map[uint32]shardSnapshotCoverage) *readNamespaceResult {
+		shardRanges := result.NewShardTimeRanges()
+		for shard := range coverage {
+			shardRanges.Set(shard, xtime.NewRanges(xtime.Range{
+				Start: time.Unix(0, 0),
+				End:   time.Unix(3600, 0),
+			}))
+		}
+		return &readNamespaceResult{
+			dataAndIndexShardRanges: shardRanges,
+			snapshotCoverage:        coverage,
+		}
+	}
+
+	index, ok := snapshotsCoveredCommitLogFileIndex(map[string]*readNamespaceResult{
+		"a": newResult(map[uint32]shardSnapshotCoverage{0: complete(3), 1: complete(2)}),
+		"b": newResult(map[uint32]shardSnapshotCoverage{0: complete(4)}),
+	})
+	require.True(t, ok)
+	require.Equal(t, int64(2), index)
+
+	incomplete := complete(2)
+	incomplete.complete = false
+	_, ok = snapshotsCoveredCommitLogFileIndex(map[string]*readNamespaceResult{
+		"a": newResult(map[uint32]shardSnapshotCoverage{0: complete(3), 1: incomplete}),
+	})
+	require.False(t, ok)
+
+	missing := newResult(map[uint32]shardSnapshotCoverage{0: complete(3)})
+	missing.dataAndIndexShardRanges.Set(1, xtime.NewRanges(xtime.Range{
+		Start: time.Unix(0, 0),
+		End:   time.Unix(3600, 0),
+	}))
+	_, ok = snapshotsCoveredCommitLogFileIndex(map[string]*readNamespaceResult{
+		"a": missing,
+	})
+	require.False(t, ok)
+
+	withDocuments := newResult(map[uint32]shardSnapshotCoverage{0: complete(3)})
+	withDocuments.documents = &namespaceDocuments{}
+	_, ok = snapshotsCoveredCommitLogFileIndex(map[string]*readNamespaceResult{
+		"a": withDocuments,
+	})
+	require.False(t, ok)
+}
+
+func TestItSkipsCommitLogEntriesDeletedByTombstone(t *testing.T) {
+	dir, err := ioutil.TempDir("", "commitlog-tombstones")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		scope  = tally.NewTestScope("", nil)
+		iOpts  = testDefaultOpts.ResultOptions().InstrumentOptions().SetMetricsScope(scope)
+		fsOpts = testDefaultOpts.CommitLogOptions().FilesystemOptions().
+			SetFilePathPrefix(dir)
+		opts = testDefaultOpts.
+			SetResultOptions(testDefaultOpts.ResultOptions().SetInstrumentOptions(iOpts)).
+			SetCommitLogOptions(testDefaultOpts.CommitLogOptions().SetFilesystemOptions(fsOpts))
+		md        = testNsMetadata(t)
+		nsCtx     = namespace.NewContextFrom(md)
+		src       = newCommitLogSource(opts, fs.Inspection{}).(*commitLogSource)
+		blockSize = md.Options().RetentionOptions().BlockSize()
+		now       = time.Now()
+		start     = now.Truncate(blockSize).Add(-blockSize)
+		end       = now.Truncate(blockSize)
+		ranges    = xtime.NewRanges(xtime.Range{Start: start, End: end})
+
+		foo = ts.Series{Namespace: nsCtx.ID, Shard: 0, ID: ident.StringID("foo")}
+		// The data of the first two entries was deleted, the third was
+		// written within the deleted range after the delete and the fourth
+		// is outside of the deleted range.
+		values = testValues{
+			{foo, start.Add(1 * time.Minute), 1.0, xtime.Nanosecond, nil},
+			{foo, start.Add(2 * time.Minute), 2.0, xtime.Nanosecond, nil},
+			{foo, start.Add(3 * time.Minute), 3.0, xtime.Nanosecond, nil},
+			{foo, start.Add(5 * time.Minute), 4.0, xtime.Nanosecond, nil},
+		}
+	)
+
+	require.NoError(t, fs.WriteTombstones(fsOpts, md.ID(), 0, []fs.Tombstone{
+		{
+			ID:         foo.ID.Bytes(),
+			BlockStart: start,
+			Start:      start,
+			End:        start.Add(4 * time.Minute),
+			Position:   persist.CommitLogPosition{Index: 0, NumEntries: 2},
+		},
+	}))
+
+	src.newIteratorFn = func(
+		_ commitlog.IteratorOpts,
+	) (commitlog.Iterator, []commitlog.ErrorWithPath, error) {
+		return newTestCommitLogIterator(values, nil), nil, nil
+	}
+
+	targetRanges := result.NewShardTimeRanges().Set(0, ranges)
+	tester := bootstrap.BuildNamespacesTester(t, testDefaultRunOpts, targetRanges, md)
+	defer tester.Finish()
+
+	tester.TestReadWith(src)
+	tester.TestUnfulfilledForNamespaceIsEmpty(md)
+
+	read := tester.EnsureDumpWritesForNamespace(md)
+	enforceValuesAreCorrect(t, values[2:], read)
+	tester.EnsureNoLoadedBlocks()
+
+	skipped, ok := scope.Snapshot().Counters()["bootstrapper-commitlog.commitlog.datapoints-skipped-deleted+"]
+	require.True(t, ok)
+	require.Equal(t, int64(2), skipped.Value())
+}
+
 type setAnnotation func(testValues) testValues
 type annotationEqual func([]byte, []byte) bool
 
@@ -504,6 +776,7 @@ func (i *testCommitLogIterator) Current() commitlog.LogEntry {
 		Metadata: commitlog.LogEntryMetadata{
 			FileReadID:        uint64(idx) + 1,
 			SeriesUniqueIndex: v.s.UniqueIndex,
+			EntryOffset:       int64(idx),
 		},
 	}
 }
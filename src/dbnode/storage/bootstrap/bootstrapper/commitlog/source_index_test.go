@@ -234,6 +234,112 @@ func TestBootstrapIndexEmptyShardTimeRanges(t *testing.T) {
 	tester.EnsureNoWrites()
 }
 
+func TestBootstrapIndexOnlyNamespaceDocuments(t *testing.T) {
+	var (
+		opts             = testDefaultOpts
+		src              = newCommitLogSource(opts, fs.Inspection{}).(*commitLogSource)
+		dataBlockSize    = 2 * time.Hour
+		indexBlockSize   = 4 * time.Hour
+		namespaceOptions = namespace.NewOptions().
+					SetIndexOnly(true).
+					SetRetentionOptions(
+				namespace.NewOptions().
+					RetentionOptions().
+					SetBlockSize(dataBlockSize),
+			).
+			SetIndexOptions(
+				namespace.NewOptions().
+					IndexOptions().
+					SetBlockSize(indexBlockSize).
+					SetEnabled(true),
+			)
+	)
+	md, err := namespace.NewMetadata(testNamespaceID, namespaceOptions)
+	require.NoError(t, err)
+
+	start := time.Now().Truncate(indexBlockSize)
+
+	testTagEncodingPool := serialize.
+		NewTagEncoderPool(serialize.NewTagEncoderOptions(),
+			pool.NewObjectPoolOptions().SetSize(1))
+	testTagEncodingPool.Init()
+
+	fooTags := toEncodedBytes(t, testTagEncodingPool,
+		ident.StringTag("city", "ny"))
+	fooDuplicateTags := toEncodedBytes(t, testTagEncodingPool,
+		ident.StringTag("city", "sf"))
+	barTags := toEncodedBytes(t, testTagEncodingPool,
+		ident.StringTag("city", "oakland"))
+
+	// Every document written gets a new unique index.
+	foo := ts.Series{UniqueIndex: 0, Namespace: testNamespaceID, Shard: 0,
+		ID: ident.StringID("foo"), EncodedTags: fooTags}
+	// Writes of a document already indexed are rejected, only concurrent
+	// writes of the same document may be in the commit log and only the
+	// first of them is kept.
+	fooDuplicate := ts.Series{UniqueIndex: 1, Namespace: testNamespaceID, Shard: 0,
+		ID: ident.StringID("foo"), EncodedTags: fooDuplicateTags}
+	bar := ts.Series{UniqueIndex: 2, Namespace: testNamespaceID, Shard: 1,
+		ID: ident.StringID("bar"), EncodedTags: barTags}
+	// Make sure documents outside of the bootstrap range are skipped.
+	outOfRange := ts.Series{UniqueIndex: 3, Namespace: testNamespaceID, Shard: 0,
+		ID: ident.StringID("outOfRange"), EncodedTags: barTags}
+
+	values := testValues{
+		{foo, start, 0, xtime.Second, nil},
+		{fooDuplicate, start.Add(time.Minute), 0, xtime.Second, nil},
+		{bar, start.Add(indexBlockSize), 0, xtime.Second, nil},
+		{outOfRange, start.Add(-indexBlockSize), 0, xtime.Second, nil},
+	}
+	src.newIteratorFn = func(
+		_ commitlog.IteratorOpts,
+	) (commitlog.Iterator, []commitlog.ErrorWithPath, error) {
+		return newTestCommitLogIterator(values, nil), nil, nil
+	}
+
+	ranges := xtime.NewRanges(xtime.Range{Start: start, End: start.Add(2 * indexBlockSize)})
+	targetRanges := result.NewShardTimeRanges().Set(0, ranges).Set(1, ranges)
+
+	tester := bootstrap.BuildNamespacesTester(t, testDefaultRunOpts, targetRanges, md)
+	defer tester.Finish()
+
+	tester.TestReadWith(src)
+	tester.TestUnfulfilledForNamespaceIsEmpty(md)
+	tester.EnsureNoWrites()
+
+	indexResults := tester.ResultForNamespace(md.ID()).IndexResult.IndexResults()
+	require.Equal(t, 2, len(indexResults))
+
+	expected := map[xtime.UnixNano]map[string]string{
+		xtime.ToUnixNano(start):                     {"foo": "ny"},
+		xtime.ToUnixNano(start.Add(indexBlockSize)): {"bar": "oakland"},
+	}
+	for blockStart, expectedDocs := range expected {
+		blocks, ok := indexResults[blockStart]
+		require.True(t, ok)
+		block, ok := blocks.GetBlock(idxpersist.DefaultIndexVolumeType)
+		require.True(t, ok)
+		require.False(t, block.Fulfilled().IsEmpty())
+		require.Equal(t, 1, len(block.Segments()))
+
+		reader, err := block.Segments()[0].Reader()
+		require.NoError(t, err)
+		docs, err := reader.AllDocs()
+		require.NoError(t, err)
+
+		actualDocs := make(map[string]string)
+		for docs.Next() {
+			curr := docs.Current()
+			require.Equal(t, 1, len(curr.Fields))
+			actualDocs[string(curr.ID)] = string(curr.Fields[0].Value)
+		}
+		require.NoError(t, docs.Err())
+		require.NoError(t, docs.Close())
+		require.NoError(t, reader.Close())
+		require.Equal(t, expectedDocs, actualDocs)
+	}
+}
+
 func verifyIndexResultsAreCorrect(
 	values testValues,
 	seriesNotToExpect map[string]struct{},
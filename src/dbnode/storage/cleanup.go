@@ -81,6 +81,7 @@ type cleanupManagerMetrics struct {
 	corruptSnapshotFile         tally.Counter
 	corruptSnapshotMetadataFile tally.Counter
 	deletedCommitlogFile        tally.Counter
+	retainedCommitlogFile       tally.Counter
 	deletedSnapshotFile         tally.Counter
 	deletedSnapshotMetadataFile tally.Counter
 }
@@ -95,6 +96,7 @@ func newCleanupManagerMetrics(scope tally.Scope) cleanupManagerMetrics {
 		corruptSnapshotFile:         sScope.Counter("corrupt"),
 		corruptSnapshotMetadataFile: smScope.Counter("corrupt"),
 		deletedCommitlogFile:        clScope.Counter("deleted"),
+		retainedCommitlogFile:       clScope.Counter("retained-cold-writes"),
 		deletedSnapshotFile:         sScope.Counter("deleted"),
 		deletedSnapshotMetadataFile: smScope.Counter("deleted"),
 	}
@@ -152,6 +154,11 @@ func (m *cleanupManager) Cleanup(t time.Time, isBootstrapped bool) error {
 			"encountered errors when cleaning up data files for %v: %v", t, err))
 	}
 
+	if err := m.offloadDataFiles(t, namespaces); err != nil {
+		multiErr = multiErr.Add(fmt.Errorf(
+			"encountered errors when offloading data files for %v: %v", t, err))
+	}
+
 	if err := m.cleanupExpiredIndexFiles(t, namespaces); err != nil {
 		multiErr = multiErr.Add(fmt.Errorf(
 			"encountered errors when cleaning up index files for %v: %v", t, err))
@@ -251,6 +258,20 @@ func (m *cleanupManager) cleanupDataFiles(t time.Time, namespaces []databaseName
 	return multiErr.FinalError()
 }
 
+func (m *cleanupManager) offloadDataFiles(t time.Time, namespaces []databaseNamespace) error {
+	if m.opts.CommitLogOptions().FilesystemOptions().RemoteStore() == nil {
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, n := range namespaces {
+		for _, shard := range n.OwnedShards() {
+			multiErr = multiErr.Add(shard.OffloadFileSets(t))
+		}
+	}
+	return multiErr.FinalError()
+}
+
 func (m *cleanupManager) cleanupExpiredIndexFiles(t time.Time, namespaces []databaseNamespace) error {
 	multiErr := xerrors.NewMultiError()
 	for _, n := range namespaces {
@@ -317,6 +338,8 @@ func (m *cleanupManager) cleanupCompactedNamespaceDataFiles(shards []databaseSha
 //        in the most recent snapshot metadata file. This is because the snapshotting and commitlog rotation process
 //        guarantees that the most recent snapshot contains all data stored in commitlogs that were created before
 //        the rotation / snapshot process began.
+//     4. All commitlog files whose index is larger than or equal to the oldest commitlog that may contain cold
+//        writes deferred by the cold flush planner, since those are not contained in any snapshot or volume yet.
 //
 // cleanupSnapshotsAndCommitlogs accomplishes this goal by performing the following steps:
 //
@@ -331,7 +354,8 @@ func (m *cleanupManager) cleanupCompactedNamespaceDataFiles(shards []databaseSha
 //     6. List all the commitlog files on disk.
 //     7. List all the commitlog files that are being actively written to.
 //     8. Delete all commitlog files whose index is lower than the index of the commitlog file referenced in the
-//        most recent snapshot metadata file (ignoring any commitlog files being actively written to.)
+//        most recent snapshot metadata file (ignoring any commitlog files being actively written to or
+//        retained for deferred cold writes.)
 //     9. Delete all corrupt commitlog files (ignoring any commitlog files being actively written to.)
 //
 // This process is also modeled formally in TLA+ in the file `SnapshotsSpec.tla`.
@@ -456,6 +480,9 @@ func (m *cleanupManager) cleanupSnapshotsAndCommitlogs(namespaces []databaseName
 		return err
 	}
 
+	// Figure out which commitlog files may contain deferred cold writes.
+	retainedIndex, hasRetained := coldFlushRetainedCommitLogIndex(namespaces)
+
 	// Delete all commitlog files prior to the one captured by the most recent snapshot.
 	for _, file := range files {
 		if activeCommitlogs.Contains(file.FilePath) {
@@ -464,6 +491,12 @@ func (m *cleanupManager) cleanupSnapshotsAndCommitlogs(namespaces []databaseName
 		}
 
 		if file.Index < mostRecentSnapshot.CommitlogIdentifier.Index {
+			if hasRetained && file.Index >= retainedIndex {
+				// Skip over any commitlog files that may contain cold writes
+				// which have not been merged into a volume yet.
+				m.metrics.retainedCommitlogFile.Inc(1)
+				continue
+			}
 			m.metrics.deletedCommitlogFile.Inc(1)
 			filesToDelete = append(filesToDelete, file.FilePath)
 		}
@@ -491,3 +524,21 @@ func (m *cleanupManager) cleanupSnapshotsAndCommitlogs(namespaces []databaseName
 
 	return finalErr
 }
+
+func coldFlushRetainedCommitLogIndex(namespaces []databaseNamespace) (int64, bool) {
+	var (
+		minIndex int64
+		found    bool
+	)
+	for _, ns := range namespaces {
+		index, ok := ns.ColdFlushRetainedCommitLogIndex()
+		if !ok {
+			continue
+		}
+		if !found || index < minIndex {
+			minIndex = index
+			found = true
+		}
+	}
+	return minIndex, found
+}
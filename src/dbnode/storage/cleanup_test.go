@@ -81,12 +81,19 @@ func TestCleanupManagerCleanupCommitlogsAndSnapshots(t *testing.T) {
 		MetadataFilePath:    "metadata-filepath-1",
 		CheckpointFilePath:  "checkpoint-filepath-1",
 	}
+	testSnapshotMetadata2 := fs.SnapshotMetadata{
+		ID:                  testSnapshotMetadataIdentifier2,
+		CommitlogIdentifier: persist.CommitLogFile{FilePath: "commitlog-file-3", Index: 3},
+		MetadataFilePath:    "metadata-filepath-1",
+		CheckpointFilePath:  "checkpoint-filepath-1",
+	}
 
 	testCases := []struct {
 		title                string
 		snapshotMetadata     snapshotMetadataFilesFn
 		commitlogs           commitLogFilesFn
 		snapshots            snapshotFilesFn
+		retainedCommitlog    *int64
 		expectedDeletedFiles []string
 		expectErr            bool
 	}{
@@ -235,6 +242,27 @@ func TestCleanupManagerCleanupCommitlogsAndSnapshots(t *testing.T) {
 			// Should only delete anything with an index lower than 1.
 			expectedDeletedFiles: []string{"commitlog-file-0"},
 		},
+		{
+			title: "Does not delete commitlogs that may contain deferred cold writes",
+			snapshotMetadata: func(fs.Options) ([]fs.SnapshotMetadata, []fs.SnapshotMetadataErrorWithPaths, error) {
+				return []fs.SnapshotMetadata{testSnapshotMetadata2}, nil, nil
+			},
+			snapshots: func(filePathPrefix string, namespace ident.ID, shard uint32) (fs.FileSetFilesSlice, error) {
+				return nil, nil
+			},
+			commitlogs: func(commitlog.Options) (persist.CommitLogFiles, []commitlog.ErrorWithPath, error) {
+				return persist.CommitLogFiles{
+					{FilePath: "commitlog-file-0", Index: 0},
+					testCommitlogFileIdentifier,
+					{FilePath: "commitlog-file-2", Index: 2},
+					{FilePath: "commitlog-file-3", Index: 3},
+				}, nil, nil
+			},
+			retainedCommitlog: &testCommitlogFileIdentifier.Index,
+			// Should only delete anything with an index lower than 1 even though
+			// the most recent snapshot metadata file points to index 3.
+			expectedDeletedFiles: []string{"commitlog-file-0"},
+		},
 		{
 			title: "Deletes all corrupt commitlog files",
 			snapshotMetadata: func(fs.Options) ([]fs.SnapshotMetadata, []fs.SnapshotMetadataErrorWithPaths, error) {
@@ -297,6 +325,11 @@ func TestCleanupManagerCleanupCommitlogsAndSnapshots(t *testing.T) {
 				ns.EXPECT().Options().Return(nsOpts).AnyTimes()
 				ns.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
 				ns.EXPECT().OwnedShards().Return(shards).AnyTimes()
+				if tc.retainedCommitlog != nil {
+					ns.EXPECT().ColdFlushRetainedCommitLogIndex().Return(*tc.retainedCommitlog, true).AnyTimes()
+				} else {
+					ns.EXPECT().ColdFlushRetainedCommitLogIndex().Return(int64(0), false).AnyTimes()
+				}
 				namespaces = append(namespaces, ns)
 			}
 
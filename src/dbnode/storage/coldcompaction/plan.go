@@ -0,0 +1,155 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package coldcompaction
+
+import (
+	"errors"
+	"sort"
+)
+
+var (
+	errMaxPendingAgeNegative         = errors.New("max pending age must not be negative")
+	errMinDirtySeriesNegative        = errors.New("min dirty series must not be negative")
+	errMaxWriteAmplificationNegative = errors.New("max write amplification must not be negative")
+	errSmallVolumeSizeNegative       = errors.New("small volume size must not be negative")
+	errMaxBlocksPerFlushNegative     = errors.New("max blocks per flush must not be negative")
+)
+
+// DefaultOptions are the default PlannerOptions which merge every block
+// with cold writes on each cold flush.
+var DefaultOptions = PlannerOptions{
+	MaxPendingAge:         0, // blocks are never deferred based on thresholds
+	MinDirtySeries:        0, // any number of dirty series is eligible for merging
+	MaxWriteAmplification: 0, // any write amplification is eligible for merging
+	SmallVolumeSize:       0, // no volume is considered small
+	MaxBlocksPerFlush:     0, // no bound on the number of new volumes per flush
+}
+
+// NewPlan returns a new Plan per the rules above and the knobs provided.
+func NewPlan(blocks []Block, opts PlannerOptions) (*Plan, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{
+		Merge: make([]Block, 0, len(blocks)),
+	}
+
+	// Come up with a plan for all blocks using the following steps:
+	//  (a) Forced blocks are always merged.
+	//  (b) Blocks deferred for at least MaxPendingAge, or with a small latest
+	//      volume, or meeting both the dirty series and write amplification
+	//      thresholds are merged.
+	//  (c) Blocks to merge are prioritized forced first, then by oldest
+	//      pending, then by lowest write amplification.
+	//  (d) Any blocks past MaxBlocksPerFlush that are not forced are deferred.
+	for _, b := range blocks {
+		if opts.shouldMerge(b) {
+			plan.Merge = append(plan.Merge, b)
+			continue
+		}
+		plan.Defer = append(plan.Defer, b)
+	}
+
+	sort.Stable(plan)
+
+	if opts.MaxBlocksPerFlush > 0 {
+		var (
+			merge  = plan.Merge[:0]
+			merged int
+		)
+		for _, b := range plan.Merge {
+			if !b.Forced && merged >= opts.MaxBlocksPerFlush {
+				plan.Defer = append(plan.Defer, b)
+				continue
+			}
+			if !b.Forced {
+				merged++
+			}
+			merge = append(merge, b)
+		}
+		plan.Merge = merge
+	}
+
+	return plan, nil
+}
+
+func (o PlannerOptions) shouldMerge(b Block) bool {
+	if b.Forced || o.MaxPendingAge == 0 {
+		return true
+	}
+	if b.PendingAge >= o.MaxPendingAge {
+		return true
+	}
+	if b.VolumeSize < o.SmallVolumeSize {
+		return true
+	}
+	if b.DirtySeries < o.MinDirtySeries {
+		return false
+	}
+	return o.MaxWriteAmplification == 0 ||
+		b.WriteAmplification() <= o.MaxWriteAmplification
+}
+
+func (p *Plan) Len() int      { return len(p.Merge) }
+func (p *Plan) Swap(i, j int) { p.Merge[i], p.Merge[j] = p.Merge[j], p.Merge[i] }
+func (p *Plan) Less(i, j int) bool {
+	bi, bj := p.Merge[i], p.Merge[j]
+	if bi.Forced != bj.Forced {
+		// i.e. put blocks with deleted data first
+		return bi.Forced
+	}
+	if bi.PendingAge != bj.PendingAge {
+		// i.e. put blocks deferred for longest first
+		return bi.PendingAge > bj.PendingAge
+	}
+	// i.e. cheaper merges over more expensive ones
+	return bi.WriteAmplification() < bj.WriteAmplification()
+}
+
+// Validate ensures the receiver PlannerOptions specify valid values
+// for each of the knobs.
+func (o PlannerOptions) Validate() error {
+	if o.MaxPendingAge < 0 {
+		return errMaxPendingAgeNegative
+	}
+	if o.MinDirtySeries < 0 {
+		return errMinDirtySeriesNegative
+	}
+	if o.MaxWriteAmplification < 0 {
+		return errMaxWriteAmplificationNegative
+	}
+	if o.SmallVolumeSize < 0 {
+		return errSmallVolumeSizeNegative
+	}
+	if o.MaxBlocksPerFlush < 0 {
+		return errMaxBlocksPerFlushNegative
+	}
+	return nil
+}
+
+// WriteAmplification returns the number of bytes of the latest volume
+// rewritten per dirty series when merging the block.
+func (b Block) WriteAmplification() float64 {
+	if b.DirtySeries == 0 {
+		return float64(b.VolumeSize)
+	}
+	return float64(b.VolumeSize) / float64(b.DirtySeries)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package coldcompaction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultOptsValidate(t *testing.T) {
+	require.NoError(t, DefaultOptions.Validate())
+}
+
+func TestOptsValidateNegative(t *testing.T) {
+	for _, opts := range []PlannerOptions{
+		{MaxPendingAge: -time.Second},
+		{MinDirtySeries: -1},
+		{MaxWriteAmplification: -1},
+		{SmallVolumeSize: -1},
+		{MaxBlocksPerFlush: -1},
+	} {
+		require.Error(t, opts.Validate())
+		_, err := NewPlan(nil, opts)
+		require.Error(t, err)
+	}
+}
+
+func TestDefaultOptsMergeAll(t *testing.T) {
+	blocks := []Block{
+		{BlockStart: time.Unix(0, 0), VolumeSize: 1 << 30, DirtySeries: 1},
+		{BlockStart: time.Unix(7200, 0), VolumeSize: 10, DirtySeries: 100},
+	}
+	plan, err := NewPlan(blocks, DefaultOptions)
+	require.NoError(t, err)
+	require.Empty(t, plan.Defer)
+	require.Equal(t, []Block{blocks[1], blocks[0]}, plan.Merge)
+}
+
+func TestDeferBlocksOverThresholds(t *testing.T) {
+	opts := PlannerOptions{
+		MaxPendingAge:         time.Hour,
+		MinDirtySeries:        10,
+		MaxWriteAmplification: 1024,
+		SmallVolumeSize:       1 << 10,
+	}
+	var (
+		fewDirty     = Block{BlockStart: time.Unix(0, 0), VolumeSize: 1 << 20, DirtySeries: 5}
+		amplified    = Block{BlockStart: time.Unix(7200, 0), VolumeSize: 1 << 30, DirtySeries: 100}
+		cheap        = Block{BlockStart: time.Unix(14400, 0), VolumeSize: 1 << 20, DirtySeries: 1 << 12}
+		small        = Block{BlockStart: time.Unix(21600, 0), VolumeSize: 1 << 8, DirtySeries: 1}
+		overdue      = Block{BlockStart: time.Unix(28800, 0), VolumeSize: 1 << 30, DirtySeries: 1, PendingAge: time.Hour}
+		forced       = Block{BlockStart: time.Unix(36000, 0), VolumeSize: 1 << 30, Forced: true}
+		blocks       = []Block{fewDirty, amplified, cheap, small, overdue, forced}
+		expectMerge  = []Block{forced, overdue, cheap, small}
+		expectDefers = []Block{fewDirty, amplified}
+	)
+	plan, err := NewPlan(blocks, opts)
+	require.NoError(t, err)
+	require.Equal(t, expectMerge, plan.Merge)
+	require.Equal(t, expectDefers, plan.Defer)
+}
+
+func TestMaxBlocksPerFlush(t *testing.T) {
+	opts := DefaultOptions
+	opts.MaxBlocksPerFlush = 2
+	var (
+		b1     = Block{BlockStart: time.Unix(0, 0), VolumeSize: 100, DirtySeries: 1}
+		b2     = Block{BlockStart: time.Unix(7200, 0), VolumeSize: 100, DirtySeries: 10}
+		b3     = Block{BlockStart: time.Unix(14400, 0), VolumeSize: 100, DirtySeries: 1, PendingAge: time.Minute}
+		forced = Block{BlockStart: time.Unix(21600, 0), VolumeSize: 1 << 30, Forced: true}
+	)
+	plan, err := NewPlan([]Block{b1, b2, b3, forced}, opts)
+	require.NoError(t, err)
+	require.Equal(t, []Block{forced, b3, b2}, plan.Merge)
+	require.Equal(t, []Block{b1}, plan.Defer)
+}
+
+func TestWriteAmplification(t *testing.T) {
+	require.Equal(t, float64(100), Block{VolumeSize: 100}.WriteAmplification())
+	require.Equal(t, float64(25), Block{VolumeSize: 100, DirtySeries: 4}.WriteAmplification())
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package coldcompaction
+
+import (
+	"sort"
+	"time"
+)
+
+// Block identifies a block start with pending cold writes that is a
+// candidate for being merged into a new fileset volume.
+type Block struct {
+	BlockStart time.Time
+	// VolumeSize is the size in bytes of the latest fileset volume
+	// of the block which is rewritten in full by a merge.
+	VolumeSize int64
+	// DirtySeries is the number of series with pending cold writes.
+	DirtySeries int
+	// PendingAge is how long the cold writes of the block have been
+	// deferred for by previous plans.
+	PendingAge time.Duration
+	// Forced is set for blocks which must be merged regardless of
+	// thresholds, i.e. blocks with deleted series data.
+	Forced bool
+}
+
+// Plan is a logical collection of blocks to merge and blocks whose cold
+// writes are deferred to a later cold flush.
+type Plan struct {
+	Merge []Block
+	Defer []Block
+}
+
+// ensure Plan is sortable.
+var _ sort.Interface = &Plan{}
+
+// PlannerOptions are the knobs to tweak planning behaviour.
+type PlannerOptions struct {
+	// MaxPendingAge is the maximum amount of time cold writes for a block
+	// are deferred for before being merged regardless of the other
+	// thresholds. Zero disables deferring blocks based on thresholds.
+	MaxPendingAge time.Duration
+	// MinDirtySeries is the minimum number of dirty series a block
+	// requires to be merged before reaching MaxPendingAge.
+	MinDirtySeries int
+	// MaxWriteAmplification is the maximum number of bytes of the latest
+	// volume rewritten per dirty series for a block to be merged before
+	// reaching MaxPendingAge. Zero disables the threshold.
+	MaxWriteAmplification float64
+	// SmallVolumeSize is the volume size below which blocks are always
+	// merged since rewriting them is cheap.
+	SmallVolumeSize int64
+	// MaxBlocksPerFlush bounds the number of new volumes created by a
+	// single cold flush of a shard, forced blocks are not bounded.
+	// Zero means unbounded.
+	MaxBlocksPerFlush int
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/x/context"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
@@ -118,6 +119,7 @@ type databaseMetrics struct {
 	unknownNamespaceRead                tally.Counter
 	unknownNamespaceWrite               tally.Counter
 	unknownNamespaceWriteTagged         tally.Counter
+	unknownNamespaceWriteDocument       tally.Counter
 	unknownNamespaceBatchWriter         tally.Counter
 	unknownNamespaceWriteBatch          tally.Counter
 	unknownNamespaceWriteTaggedBatch    tally.Counter
@@ -135,6 +137,7 @@ func newDatabaseMetrics(scope tally.Scope) databaseMetrics {
 		unknownNamespaceRead:                unknownNamespaceScope.Counter("read"),
 		unknownNamespaceWrite:               unknownNamespaceScope.Counter("write"),
 		unknownNamespaceWriteTagged:         unknownNamespaceScope.Counter("write-tagged"),
+		unknownNamespaceWriteDocument:       unknownNamespaceScope.Counter("write-document"),
 		unknownNamespaceBatchWriter:         unknownNamespaceScope.Counter("batch-writer"),
 		unknownNamespaceWriteBatch:          unknownNamespaceScope.Counter("write-batch"),
 		unknownNamespaceWriteTaggedBatch:    unknownNamespaceScope.Counter("write-tagged-batch"),
@@ -636,6 +639,38 @@ func (d *db) WriteTagged(
 	return d.commitLog.Write(ctx, series, dp, unit, annotation)
 }
 
+func (d *db) WriteDocument(
+	ctx context.Context,
+	namespace ident.ID,
+	document doc.Document,
+	timestamp time.Time,
+) error {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		d.metrics.unknownNamespaceWriteDocument.Inc(1)
+		return err
+	}
+
+	series, err := n.WriteDocument(ctx, document, timestamp)
+	if err != nil {
+		return err
+	}
+
+	if !n.Options().WritesToCommitLog() {
+		return nil
+	}
+
+	// NB: Documents are written to the commit log as a datapoint of a series
+	// with the document's fields as tags, the commit log bootstrapper indexes
+	// them again for index only namespaces.
+	dp := ts.Datapoint{
+		Timestamp:      timestamp,
+		TimestampNanos: xtime.ToUnixNano(timestamp),
+	}
+
+	return d.commitLog.Write(ctx, series, dp, xtime.Second, nil)
+}
+
 func (d *db) BatchWriter(namespace ident.ID, batchSize int) (ts.BatchWriter, error) {
 	n, err := d.namespaceFor(namespace)
 	if err != nil {
@@ -971,6 +1006,31 @@ func (d *db) Truncate(namespace ident.ID) (int64, error) {
 	return n.Truncate()
 }
 
+func (d *db) DeleteTagged(
+	ctx context.Context,
+	namespace ident.ID,
+	query index.Query,
+	start, end time.Time,
+) (DeleteTaggedResult, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		return DeleteTaggedResult{}, err
+	}
+	return n.DeleteTagged(ctx, query, start, end)
+}
+
+func (d *db) Cardinality(
+	ctx context.Context,
+	namespace ident.ID,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		return index.CardinalityResult{}, err
+	}
+	return n.Cardinality(ctx, opts)
+}
+
 func (d *db) IsOverloaded() bool {
 	queueSize := float64(d.commitLog.QueueLength())
 	queueCapacity := float64(d.opts.CommitLogOptions().BacklogQueueSize())
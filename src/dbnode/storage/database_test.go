@@ -43,6 +43,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
 	xmetrics "github.com/m3db/m3/src/dbnode/x/metrics"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/idx"
 	xclock "github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/context"
@@ -289,6 +290,60 @@ func TestDatabaseReadEncodedNamespaceOwned(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestDatabaseWriteDocumentNamespaceNotOwned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	d, mapCh, _ := defaultTestDatabase(t, ctrl, Bootstrapped)
+	defer func() {
+		close(mapCh)
+	}()
+	err := d.WriteDocument(ctx, ident.StringID("nonexistent"),
+		doc.Document{ID: []byte("foo")}, time.Now())
+	require.True(t, dberrors.IsUnknownNamespaceError(err))
+}
+
+func TestDatabaseWriteDocumentNamespaceOwned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	d, mapCh, _ := defaultTestDatabase(t, ctrl, Bootstrapped)
+	defer func() {
+		close(mapCh)
+	}()
+
+	var (
+		ns       = ident.StringID("testns1")
+		document = doc.Document{ID: []byte("foo")}
+		now      = time.Now()
+		series   = ts.Series{
+			UniqueIndex: 1,
+			Namespace:   ns,
+			ID:          ident.StringID("foo"),
+		}
+	)
+	mockNamespace := NewMockdatabaseNamespace(ctrl)
+	mockNamespace.EXPECT().WriteDocument(ctx, document, now).Return(series, nil)
+	mockNamespace.EXPECT().Options().Return(namespace.NewOptions())
+	d.namespaces.Set(ns, mockNamespace)
+
+	// The document is written to the commit log so that it survives a crash.
+	mockCL := commitlog.NewMockCommitLog(ctrl)
+	mockCL.EXPECT().Write(ctx, series, ts.Datapoint{
+		Timestamp:      now,
+		TimestampNanos: xtime.ToUnixNano(now),
+	}, xtime.Second, nil).Return(nil)
+	d.commitLog = mockCL
+
+	require.NoError(t, d.WriteDocument(ctx, ns, document, now))
+}
+
 func TestDatabaseFetchBlocksNamespaceNotOwned(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
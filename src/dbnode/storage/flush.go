@@ -23,6 +23,7 @@ package storage
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
 	"github.com/m3db/m3/src/dbnode/retention"
 	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
 
 	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
@@ -48,6 +50,8 @@ const (
 	flushManagerNotIdle
 	flushManagerFlushInProgress
 	flushManagerColdFlushInProgress
+	flushManagerDownsampleFlushInProgress
+	flushManagerRewriteFlushInProgress
 	flushManagerSnapshotInProgress
 	flushManagerIndexFlushInProgress
 )
@@ -62,11 +66,13 @@ type flushManager struct {
 	// state is used to protect the flush manager against concurrent use,
 	// while flushInProgress and snapshotInProgress are more granular and
 	// are used for emitting granular gauges.
-	state           flushManagerState
-	isFlushing      tally.Gauge
-	isColdFlushing  tally.Gauge
-	isSnapshotting  tally.Gauge
-	isIndexFlushing tally.Gauge
+	state                flushManagerState
+	isFlushing           tally.Gauge
+	isColdFlushing       tally.Gauge
+	isDownsampleFlushing tally.Gauge
+	isRewriteFlushing    tally.Gauge
+	isSnapshotting       tally.Gauge
+	isIndexFlushing      tally.Gauge
 	// This is a "debug" metric for making sure that the snapshotting process
 	// is not overly aggressive.
 	maxBlocksSnapshottedByNamespace tally.Gauge
@@ -87,6 +93,8 @@ func newFlushManager(
 		pm:                              opts.PersistManager(),
 		isFlushing:                      scope.Gauge("flush"),
 		isColdFlushing:                  scope.Gauge("cold-flush"),
+		isDownsampleFlushing:            scope.Gauge("downsample-flush"),
+		isRewriteFlushing:               scope.Gauge("rewrite-flush"),
 		isSnapshotting:                  scope.Gauge("snapshot"),
 		isIndexFlushing:                 scope.Gauge("index-flush"),
 		maxBlocksSnapshottedByNamespace: scope.Gauge("max-blocks-snapshotted-by-namespace"),
@@ -149,7 +157,7 @@ func (m *flushManager) Flush(startTime time.Time) error {
 		// t13: memTracker.DecPendingLoadedBytes() --> (numLoadedBytes == 0, numPendingLoadedBytes == 0)
 		memTracker := m.opts.MemoryTracker()
 		memTracker.MarkLoadedAsPending()
-		if err = m.dataColdFlush(namespaces); err != nil {
+		if err = m.dataColdFlush(namespaces, rotatedCommitlogID); err != nil {
 			multiErr = multiErr.Add(err)
 			// If cold flush fails, we can't proceed to snapshotting because
 			// commit log cleanup logic uses the presence of a successful
@@ -165,6 +173,17 @@ func (m *flushManager) Flush(startTime time.Time) error {
 		// value by however many bytes had been tracked when the cold flush began.
 		memTracker.DecPendingLoadedBytes()
 
+		// Downsampling only rewrites data that has already been persisted
+		// so a failure here does not prevent snapshotting.
+		if err = m.dataDownsampleFlush(namespaces, startTime); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+
+		// Similarly background rewrites only rewrite persisted data.
+		if err = m.dataRewriteFlush(namespaces, startTime); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+
 		if err = m.dataSnapshot(namespaces, startTime, rotatedCommitlogID); err != nil {
 			multiErr = multiErr.Add(err)
 		}
@@ -213,6 +232,7 @@ func (m *flushManager) dataWarmFlush(
 
 func (m *flushManager) dataColdFlush(
 	namespaces []databaseNamespace,
+	rotatedCommitlogID persist.CommitLogFile,
 ) error {
 	flushPersist, err := m.pm.StartFlushPersist()
 	if err != nil {
@@ -222,7 +242,71 @@ func (m *flushManager) dataColdFlush(
 	m.setState(flushManagerColdFlushInProgress)
 	multiErr := xerrors.NewMultiError()
 	for _, ns := range namespaces {
-		if err = ns.ColdFlush(flushPersist); err != nil {
+		if err = ns.ColdFlush(flushPersist, rotatedCommitlogID); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	err = flushPersist.DoneFlush()
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
+	return multiErr.FinalError()
+}
+
+func (m *flushManager) dataDownsampleFlush(
+	namespaces []databaseNamespace,
+	startTime time.Time,
+) error {
+	var tiered []databaseNamespace
+	for _, ns := range namespaces {
+		if len(ns.Options().ResolutionTiers()) > 0 {
+			tiered = append(tiered, ns)
+		}
+	}
+	if len(tiered) == 0 {
+		return nil
+	}
+
+	flushPersist, err := m.pm.StartFlushPersist()
+	if err != nil {
+		return err
+	}
+
+	m.setState(flushManagerDownsampleFlushInProgress)
+	multiErr := xerrors.NewMultiError()
+	for _, ns := range tiered {
+		if err = ns.DownsampleFlush(startTime, flushPersist); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	err = flushPersist.DoneFlush()
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
+	return multiErr.FinalError()
+}
+
+func (m *flushManager) dataRewriteFlush(
+	namespaces []databaseNamespace,
+	startTime time.Time,
+) error {
+	if !m.opts.FileSetRewriteEnabled() {
+		return nil
+	}
+
+	flushPersist, err := m.pm.StartFlushPersist()
+	if err != nil {
+		return err
+	}
+
+	m.setState(flushManagerRewriteFlushInProgress)
+	multiErr := xerrors.NewMultiError()
+	for _, ns := range namespaces {
+		if err = ns.RewriteFlush(startTime, flushPersist); err != nil {
 			multiErr = multiErr.Add(err)
 		}
 	}
@@ -247,6 +331,10 @@ func (m *flushManager) dataSnapshot(
 		return err
 	}
 
+	// Track the commit log positions covered by each shard's snapshot so that
+	// the commit log bootstrapper can skip the entries already snapshotted.
+	highWaterMarks := newHighWaterMarkSnapshotPreparer(snapshotPersist, m.commitlog)
+
 	m.setState(flushManagerSnapshotInProgress)
 	var (
 		maxBlocksSnapshottedByNamespace = 0
@@ -267,7 +355,7 @@ func (m *flushManager) dataSnapshot(
 		}
 		for _, snapshotBlockStart := range snapshotBlockStarts {
 			err := ns.Snapshot(
-				snapshotBlockStart, startTime, snapshotPersist)
+				snapshotBlockStart, startTime, highWaterMarks)
 
 			if err != nil {
 				detailedErr := fmt.Errorf(
@@ -280,7 +368,8 @@ func (m *flushManager) dataSnapshot(
 	}
 	m.maxBlocksSnapshottedByNamespace.Update(float64(maxBlocksSnapshottedByNamespace))
 
-	err = snapshotPersist.DoneSnapshot(snapshotID, rotatedCommitlogID)
+	err = snapshotPersist.DoneSnapshot(
+		snapshotID, rotatedCommitlogID, highWaterMarks.highWaterMarks())
 	multiErr = multiErr.Add(err)
 
 	finalErr := multiErr.FinalError()
@@ -332,6 +421,18 @@ func (m *flushManager) Report() {
 		m.isColdFlushing.Update(0)
 	}
 
+	if state == flushManagerDownsampleFlushInProgress {
+		m.isDownsampleFlushing.Update(1)
+	} else {
+		m.isDownsampleFlushing.Update(0)
+	}
+
+	if state == flushManagerRewriteFlushInProgress {
+		m.isRewriteFlushing.Update(1)
+	} else {
+		m.isRewriteFlushing.Update(0)
+	}
+
 	if state == flushManagerSnapshotInProgress {
 		m.isSnapshotting.Update(1)
 	} else {
@@ -425,3 +526,97 @@ func (m *flushManager) flushNamespaceWithTimes(
 func (m *flushManager) LastSuccessfulSnapshotStartTime() (time.Time, bool) {
 	return m.lastSuccessfulSnapshotStartTime, !m.lastSuccessfulSnapshotStartTime.IsZero()
 }
+
+type highWaterMarkKey struct {
+	namespace string
+	shard     uint32
+}
+
+// highWaterMarkSnapshotPreparer wraps a snapshot preparer to record the
+// commit log position reached before each shard of a namespace began
+// snapshotting. Synchronous writes are applied in memory before they are
+// enqueued to the commit log and the shard drains its insert queue of
+// asynchronous writes once the snapshot is prepared, so every commit log
+// entry before the position is contained in the snapshot of the shard.
+type highWaterMarkSnapshotPreparer struct {
+	persist.SnapshotPreparer
+
+	sync.Mutex
+	commitlog commitlog.CommitLog
+	marks     map[highWaterMarkKey]persist.SnapshotHighWaterMark
+}
+
+func newHighWaterMarkSnapshotPreparer(
+	preparer persist.SnapshotPreparer,
+	commitlog commitlog.CommitLog,
+) *highWaterMarkSnapshotPreparer {
+	return &highWaterMarkSnapshotPreparer{
+		SnapshotPreparer: preparer,
+		commitlog:        commitlog,
+		marks:            make(map[highWaterMarkKey]persist.SnapshotHighWaterMark),
+	}
+}
+
+func (p *highWaterMarkSnapshotPreparer) PrepareData(
+	opts persist.DataPrepareOptions,
+) (persist.PreparedDataPersist, error) {
+	position, err := p.commitlog.WritePosition()
+	if err != nil {
+		return persist.PreparedDataPersist{}, err
+	}
+
+	prepared, err := p.SnapshotPreparer.PrepareData(opts)
+	if err != nil {
+		return prepared, err
+	}
+
+	closer := prepared.Close
+	prepared.Close = func() error {
+		if err := closer(); err != nil {
+			return err
+		}
+		// Only record the position once the snapshot of the shard has been
+		// successfully persisted.
+		p.record(opts.NamespaceMetadata.ID(), opts.Shard, position)
+		return nil
+	}
+	return prepared, nil
+}
+
+func (p *highWaterMarkSnapshotPreparer) record(
+	nsID ident.ID,
+	shard uint32,
+	position persist.CommitLogPosition,
+) {
+	p.Lock()
+	defer p.Unlock()
+
+	// A shard is snapshotted once per block start, the entries covered by
+	// every one of the block snapshots are those before the earliest position.
+	key := highWaterMarkKey{namespace: nsID.String(), shard: shard}
+	if existing, ok := p.marks[key]; ok && !position.Before(existing.Position) {
+		return
+	}
+	p.marks[key] = persist.SnapshotHighWaterMark{
+		Namespace: ident.StringID(key.namespace),
+		Shard:     shard,
+		Position:  position,
+	}
+}
+
+func (p *highWaterMarkSnapshotPreparer) highWaterMarks() []persist.SnapshotHighWaterMark {
+	p.Lock()
+	defer p.Unlock()
+
+	marks := make([]persist.SnapshotHighWaterMark, 0, len(p.marks))
+	for _, mark := range p.marks {
+		marks = append(marks, mark)
+	}
+	sort.Slice(marks, func(i, j int) bool {
+		if ns1, ns2 := marks[i].Namespace.String(), marks[j].Namespace.String(); ns1 != ns2 {
+			return ns1 < ns2
+		}
+		return marks[i].Shard < marks[j].Shard
+	})
+	return marks
+}
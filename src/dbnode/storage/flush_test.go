@@ -97,7 +97,7 @@ func TestFlushManagerFlushAlreadyInProgress(t *testing.T) {
 		<-doneCh
 	}).Return(mockFlushPerist, nil).AnyTimes()
 
-	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockPersistManager.EXPECT().StartSnapshotPersist(gomock.Any()).Do(func(_ interface{}) {
 		startCh <- struct{}{}
 		<-doneCh
@@ -177,7 +177,7 @@ func TestFlushManagerFlushDoneFlushError(t *testing.T) {
 	)
 	mockPersistManager.EXPECT().StartFlushPersist().Return(mockFlushPersist, nil).Times(2)
 
-	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile).Return(nil)
+	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile, gomock.Any()).Return(nil)
 	mockPersistManager.EXPECT().StartSnapshotPersist(gomock.Any()).Return(mockSnapshotPersist, nil)
 
 	mockIndexFlusher := persist.NewMockIndexFlush(ctrl)
@@ -216,7 +216,7 @@ func TestFlushManagerNamespaceFlushTimesErr(t *testing.T) {
 	mockFlushPersist.EXPECT().DoneFlush().Return(nil).Times(2)
 	mockPersistManager.EXPECT().StartFlushPersist().Return(mockFlushPersist, nil).Times(2)
 
-	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile).Return(nil)
+	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile, gomock.Any()).Return(nil)
 	mockPersistManager.EXPECT().StartSnapshotPersist(gomock.Any()).Return(mockSnapshotPersist, nil)
 
 	mockIndexFlusher := persist.NewMockIndexFlush(ctrl)
@@ -232,7 +232,7 @@ func TestFlushManagerNamespaceFlushTimesErr(t *testing.T) {
 	ns.EXPECT().Options().Return(nsOpts).AnyTimes()
 	ns.EXPECT().ID().Return(defaultTestNs1ID).AnyTimes()
 	ns.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(false, fakeErr).AnyTimes()
-	ns.EXPECT().ColdFlush(gomock.Any()).Return(nil).AnyTimes()
+	ns.EXPECT().ColdFlush(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	ns.EXPECT().Snapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	db.EXPECT().OwnedNamespaces().Return([]databaseNamespace{ns}, nil)
 
@@ -262,7 +262,7 @@ func TestFlushManagerFlushDoneSnapshotError(t *testing.T) {
 	mockFlushPersist.EXPECT().DoneFlush().Return(nil).Times(2)
 	mockPersistManager.EXPECT().StartFlushPersist().Return(mockFlushPersist, nil).Times(2)
 
-	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile).Return(fakeErr)
+	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile, gomock.Any()).Return(fakeErr)
 	mockPersistManager.EXPECT().StartSnapshotPersist(gomock.Any()).Return(mockSnapshotPersist, nil)
 
 	mockIndexFlusher := persist.NewMockIndexFlush(ctrl)
@@ -297,7 +297,7 @@ func TestFlushManagerFlushDoneIndexError(t *testing.T) {
 	mockFlushPersist.EXPECT().DoneFlush().Return(nil).Times(2)
 	mockPersistManager.EXPECT().StartFlushPersist().Return(mockFlushPersist, nil).Times(2)
 
-	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile).Return(nil)
+	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile, gomock.Any()).Return(nil)
 	mockPersistManager.EXPECT().StartSnapshotPersist(gomock.Any()).Return(mockSnapshotPersist, nil)
 
 	fakeErr := errors.New("fake error while marking flush done")
@@ -320,6 +320,44 @@ func TestFlushManagerFlushDoneIndexError(t *testing.T) {
 	require.EqualError(t, fakeErr, fm.Flush(now).Error())
 }
 
+func TestFlushManagerDownsampleFlushOnlyTieredNamespaces(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{T: t})
+	defer ctrl.Finish()
+
+	var (
+		now                = time.Now()
+		mockPersistManager = persist.NewMockManager(ctrl)
+		mockFlushPersist   = persist.NewMockFlushPreparer(ctrl)
+		tieredOpts         = defaultTestNs1Opts.SetResolutionTiers([]namespace.ResolutionTier{
+			{Resolution: time.Minute, Age: 24 * time.Hour},
+		})
+	)
+
+	tiered := NewMockdatabaseNamespace(ctrl)
+	tiered.EXPECT().Options().Return(tieredOpts).AnyTimes()
+	tiered.EXPECT().DownsampleFlush(now, mockFlushPersist).Return(nil)
+
+	untiered := NewMockdatabaseNamespace(ctrl)
+	untiered.EXPECT().Options().Return(defaultTestNs1Opts).AnyTimes()
+
+	mockPersistManager.EXPECT().StartFlushPersist().Return(mockFlushPersist, nil)
+	mockFlushPersist.EXPECT().DoneFlush().Return(nil)
+
+	testOpts := DefaultTestOptions().SetPersistManager(mockPersistManager)
+	db := newMockdatabase(ctrl)
+	db.EXPECT().Options().Return(testOpts).AnyTimes()
+
+	cl := commitlog.NewMockCommitLog(ctrl)
+	fm := newFlushManager(db, cl, tally.NoopScope).(*flushManager)
+	fm.pm = mockPersistManager
+
+	namespaces := []databaseNamespace{tiered, untiered}
+	require.NoError(t, fm.dataDownsampleFlush(namespaces, now))
+
+	// No flush persist should be started when no namespace has tiers.
+	require.NoError(t, fm.dataDownsampleFlush([]databaseNamespace{untiered}, now))
+}
+
 func TestFlushManagerSkipNamespaceIndexingDisabled(t *testing.T) {
 	ctrl := gomock.NewController(xtest.Reporter{T: t})
 	defer ctrl.Finish()
@@ -330,7 +368,7 @@ func TestFlushManagerSkipNamespaceIndexingDisabled(t *testing.T) {
 	ns.EXPECT().ID().Return(defaultTestNs1ID).AnyTimes()
 	ns.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
 	ns.EXPECT().WarmFlush(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	ns.EXPECT().ColdFlush(gomock.Any()).Return(nil).AnyTimes()
+	ns.EXPECT().ColdFlush(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	ns.EXPECT().Snapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	var (
@@ -342,7 +380,7 @@ func TestFlushManagerSkipNamespaceIndexingDisabled(t *testing.T) {
 	mockFlushPersist.EXPECT().DoneFlush().Return(nil).Times(2)
 	mockPersistManager.EXPECT().StartFlushPersist().Return(mockFlushPersist, nil).Times(2)
 
-	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile).Return(nil)
+	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile, gomock.Any()).Return(nil)
 	mockPersistManager.EXPECT().StartSnapshotPersist(gomock.Any()).Return(mockSnapshotPersist, nil)
 
 	mockIndexFlusher := persist.NewMockIndexFlush(ctrl)
@@ -374,7 +412,7 @@ func TestFlushManagerNamespaceIndexingEnabled(t *testing.T) {
 	ns.EXPECT().ID().Return(defaultTestNs1ID).AnyTimes()
 	ns.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
 	ns.EXPECT().WarmFlush(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	ns.EXPECT().ColdFlush(gomock.Any()).Return(nil).AnyTimes()
+	ns.EXPECT().ColdFlush(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	ns.EXPECT().Snapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	ns.EXPECT().FlushIndex(gomock.Any()).Return(nil)
 
@@ -387,7 +425,7 @@ func TestFlushManagerNamespaceIndexingEnabled(t *testing.T) {
 	mockFlushPersist.EXPECT().DoneFlush().Return(nil).Times(2)
 	mockPersistManager.EXPECT().StartFlushPersist().Return(mockFlushPersist, nil).Times(2)
 
-	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile).Return(nil)
+	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile, gomock.Any()).Return(nil)
 	mockPersistManager.EXPECT().StartSnapshotPersist(gomock.Any()).Return(mockSnapshotPersist, nil)
 
 	mockIndexFlusher := persist.NewMockIndexFlush(ctrl)
@@ -553,7 +591,7 @@ func TestFlushManagerFlushSnapshot(t *testing.T) {
 			ns.EXPECT().NeedsFlush(st, st).Return(false, nil)
 		}
 
-		ns.EXPECT().ColdFlush(gomock.Any())
+		ns.EXPECT().ColdFlush(gomock.Any(), gomock.Any())
 
 		snapshotEnd := now.Add(bufferFuture).Truncate(blockSize)
 		num = numIntervals(start, snapshotEnd, blockSize)
@@ -571,6 +609,69 @@ func TestFlushManagerFlushSnapshot(t *testing.T) {
 	require.Equal(t, now, lastSuccessfulSnapshot)
 }
 
+func TestFlushManagerSnapshotHighWaterMarks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cl       = commitlog.NewMockCommitLog(ctrl)
+		preparer = persist.NewMockSnapshotPreparer(ctrl)
+		marks    = newHighWaterMarkSnapshotPreparer(preparer, cl)
+		nsOpts   = namespace.NewOptions()
+		fakeErr  = errors.New("fake error")
+	)
+	ns1, err := namespace.NewMetadata(ident.StringID("ns1"), nsOpts)
+	require.NoError(t, err)
+	ns2, err := namespace.NewMetadata(ident.StringID("ns2"), nsOpts)
+	require.NoError(t, err)
+
+	snapshot := func(
+		ns namespace.Metadata,
+		shard uint32,
+		position persist.CommitLogPosition,
+		closeErr error,
+	) {
+		cl.EXPECT().WritePosition().Return(position, nil)
+		preparer.EXPECT().PrepareData(gomock.Any()).Return(persist.PreparedDataPersist{
+			Close: func() error { return closeErr },
+		}, nil)
+
+		prepared, err := marks.PrepareData(persist.DataPrepareOptions{
+			NamespaceMetadata: ns,
+			Shard:             shard,
+			FileSetType:       persist.FileSetSnapshotType,
+		})
+		require.NoError(t, err)
+		require.Equal(t, closeErr, prepared.Close())
+	}
+
+	snapshot(ns2, 1, persist.CommitLogPosition{Index: 1, NumEntries: 5}, nil)
+	// Later snapshots of the same shard do not advance the high-water mark.
+	snapshot(ns2, 1, persist.CommitLogPosition{Index: 1, NumEntries: 9}, nil)
+	snapshot(ns1, 1, persist.CommitLogPosition{Index: 2, NumEntries: 0}, nil)
+	snapshot(ns1, 0, persist.CommitLogPosition{Index: 1, NumEntries: 7}, nil)
+	// Failed snapshots do not record a high-water mark.
+	snapshot(ns1, 2, persist.CommitLogPosition{Index: 2, NumEntries: 3}, fakeErr)
+
+	require.Equal(t, []persist.SnapshotHighWaterMark{
+		{
+			Namespace: ident.StringID("ns1"),
+			Shard:     0,
+			Position:  persist.CommitLogPosition{Index: 1, NumEntries: 7},
+		},
+		{
+			Namespace: ident.StringID("ns1"),
+			Shard:     1,
+			Position:  persist.CommitLogPosition{Index: 2, NumEntries: 0},
+		},
+		{
+			Namespace: ident.StringID("ns2"),
+			Shard:     1,
+			Position:  persist.CommitLogPosition{Index: 1, NumEntries: 5},
+		},
+	}, marks.highWaterMarks())
+}
+
 type timesInOrder []time.Time
 
 func (a timesInOrder) Len() int           { return len(a) }
@@ -66,7 +66,13 @@ type fileOpState struct {
 	// cold version that has been flushed and to validate lease requests from the SeekerManager when it
 	// receives a signal to open a new lease.
 	ColdVersionFlushed int
-	NumFailures        int
+	// DownsampledResolution is the resolution the latest volume was
+	// downsampled to, or zero if the latest volume holds raw datapoints.
+	DownsampledResolution time.Duration
+	// EncodingFormat is the encoding format of the latest volume, it is
+	// bootstrapped from the info file of the volume.
+	EncodingFormat string
+	NumFailures    int
 }
 
 type runType int
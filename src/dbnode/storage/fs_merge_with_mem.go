@@ -36,12 +36,14 @@ import (
 // within the shard informing fsMergeWithMem which series require merging.
 // These data structures enable efficient reading of data as well as keeping
 // track of which series were read so that the remaining series can be looped
-// through.
+// through. It also implements fs.MergeWithTombstones so that data deleted
+// from series is dropped from disk when the fileset is rewritten.
 type fsMergeWithMem struct {
 	shard              databaseShard
 	retriever          series.QueryableBlockRetriever
 	dirtySeries        *dirtySeriesMap
 	dirtySeriesToWrite map[xtime.UnixNano]*idList
+	tombstones         map[xtime.UnixNano]map[string]xtime.Ranges
 }
 
 func newFSMergeWithMem(
@@ -49,15 +51,29 @@ func newFSMergeWithMem(
 	retriever series.QueryableBlockRetriever,
 	dirtySeries *dirtySeriesMap,
 	dirtySeriesToWrite map[xtime.UnixNano]*idList,
+	tombstones map[xtime.UnixNano]map[string]xtime.Ranges,
 ) fs.MergeWith {
 	return &fsMergeWithMem{
 		shard:              shard,
 		retriever:          retriever,
 		dirtySeries:        dirtySeries,
 		dirtySeriesToWrite: dirtySeriesToWrite,
+		tombstones:         tombstones,
 	}
 }
 
+func (m *fsMergeWithMem) Tombstones(
+	seriesID ident.ID,
+	blockStart xtime.UnixNano,
+) (xtime.Ranges, bool) {
+	byID, ok := m.tombstones[blockStart]
+	if !ok {
+		return nil, false
+	}
+	deleted, ok := byID[seriesID.String()]
+	return deleted, ok
+}
+
 func (m *fsMergeWithMem) Read(
 	ctx context.Context,
 	seriesID ident.ID,
@@ -79,7 +79,7 @@ func TestRead(t *testing.T) {
 			Return(result, nil)
 	}
 
-	mergeWith := newFSMergeWithMem(shard, retriever, dirtySeries, dirtySeriesToWrite)
+	mergeWith := newFSMergeWithMem(shard, retriever, dirtySeries, dirtySeriesToWrite, nil)
 
 	for _, d := range data {
 		require.True(t, dirtySeries.Contains(idAndBlockStart{blockStart: d.start, id: d.id}))
@@ -165,7 +165,7 @@ func TestForEachRemaining(t *testing.T) {
 		addDirtySeries(dirtySeries, dirtySeriesToWrite, d.id, d.start)
 	}
 
-	mergeWith := newFSMergeWithMem(shard, retriever, dirtySeries, dirtySeriesToWrite)
+	mergeWith := newFSMergeWithMem(shard, retriever, dirtySeries, dirtySeriesToWrite, nil)
 
 	var forEachCalls []ident.ID
 	shard.EXPECT().TagsFromSeriesID(gomock.Any()).Return(ident.Tags{}, true, nil).Times(2)
@@ -80,6 +80,10 @@ var (
 const (
 	defaultFlushReadDataBlocksBatchSize = int64(4096)
 	nsIndexReportStatsInterval          = 10 * time.Second
+
+	// postingsListCacheWarmBudget bounds the time spent warming the postings
+	// list cache with hot queries after bootstrapping.
+	postingsListCacheWarmBudget = time.Minute
 )
 
 var (
@@ -139,6 +143,10 @@ type nsIndexState struct {
 	bootstrapState BootstrapState
 	bootstrapsDone uint
 
+	// postingsListCacheWarmed is set once the postings list cache has
+	// begun warming after the first bootstrap.
+	postingsListCacheWarmed bool
+
 	runtimeOpts nsIndexRuntimeOptions
 
 	insertQueue namespaceIndexInsertQueue
@@ -160,6 +168,13 @@ type nsIndexState struct {
 	shardsFilterID func(ident.ID) bool
 
 	shardsAssigned map[uint32]struct{}
+
+	// deletedByBlock contains the time ranges deleted from series within
+	// each index block, series are masked from the results of queries whose
+	// range within the block was entirely deleted until the block expires.
+	// Each map is copied on write so it can be read by queries without
+	// holding the lock.
+	deletedByBlock map[xtime.UnixNano]map[string]xtime.Ranges
 }
 
 // NB: nsIndexRuntimeOptions does not contain its own mutex as some of the variables
@@ -311,6 +326,7 @@ func newNamespaceIndexWithOptions(
 			},
 			blocksByTime:   make(map[xtime.UnixNano]index.Block),
 			shardsAssigned: make(map[uint32]struct{}),
+			deletedByBlock: make(map[xtime.UnixNano]map[string]xtime.Ranges),
 		},
 
 		nowFn:                 nowFn,
@@ -788,7 +804,15 @@ func (i *nsIndex) Bootstrap(
 		i.state.Lock()
 		i.state.bootstrapState = Bootstrapped
 		i.state.bootstrapsDone++
+		warmPostingsListCache := !i.state.postingsListCacheWarmed
+		i.state.postingsListCacheWarmed = true
 		i.state.Unlock()
+
+		// The postings list cache is warmed once, in the background, so that
+		// it neither delays bootstraps nor holds the lock writers wait on.
+		if warmPostingsListCache {
+			go i.warmPostingsListCache()
+		}
 	}()
 
 	var multiErr xerrors.MultiError
@@ -806,6 +830,72 @@ func (i *nsIndex) Bootstrap(
 	return multiErr.FinalError()
 }
 
+// warmPostingsListCache searches the blocks with the hot queries recorded
+// for the namespace, including those persisted before a restart, so that
+// their postings lists are cached before serving queries. Blocks are warmed
+// one at a time, most recent first, until the warm budget is spent.
+func (i *nsIndex) warmPostingsListCache() {
+	plCache := i.opts.IndexOptions().PostingsListCache()
+	if plCache == nil {
+		return
+	}
+
+	queries := plCache.HotQueries(i.nsMetadata.ID())
+	if len(queries) == 0 {
+		return
+	}
+
+	i.state.RLock()
+	closeCh := i.state.closeCh
+	blocks := make([]index.Block, 0, len(i.state.blockStartsDescOrder))
+	for _, blockStart := range i.state.blockStartsDescOrder {
+		if block, ok := i.state.blocksByTime[blockStart]; ok {
+			blocks = append(blocks, block)
+		}
+	}
+	i.state.RUnlock()
+
+	var (
+		deadline  = i.nowFn().Add(postingsListCacheWarmBudget)
+		numWarmed int
+		multiErr  xerrors.MultiError
+	)
+WarmLoop:
+	for _, query := range queries {
+		for _, block := range blocks {
+			select {
+			case <-closeCh:
+				return
+			default:
+			}
+			if i.nowFn().After(deadline) {
+				i.logger.Info("postings list cache warm budget spent",
+					zap.Int("numWarmed", numWarmed),
+					zap.Duration("budget", postingsListCacheWarmBudget))
+				break WarmLoop
+			}
+
+			err := block.WarmPostingsListCache(index.Query{Query: query})
+			if err == index.ErrUnableToQueryBlockClosed {
+				// The block expired while warming.
+				continue
+			}
+			if err != nil {
+				multiErr = multiErr.Add(err)
+				continue
+			}
+			numWarmed++
+		}
+	}
+
+	// The cache is only warmed to speed up the first queries after a restart,
+	// so failing to warm it is not fatal.
+	if err := multiErr.FinalError(); err != nil {
+		i.logger.Warn("could not warm postings list cache with hot queries",
+			zap.Int("numQueries", len(queries)), zap.Error(err))
+	}
+}
+
 func (i *nsIndex) BootstrapsDone() uint {
 	i.state.RLock()
 	result := i.state.bootstrapsDone
@@ -857,6 +947,14 @@ func (i *nsIndex) Tick(c context.Cancellable, startTime time.Time) (namespaceInd
 		}
 	}
 
+	// Deletes may be recorded for blocks that were never created so expire
+	// them separately from the blocks.
+	for blockStart := range i.state.deletedByBlock {
+		if blockStart.ToTime().Before(earliestBlockStartToRetain) {
+			delete(i.state.deletedByBlock, blockStart)
+		}
+	}
+
 	return result, multiErr.FinalError()
 }
 
@@ -942,6 +1040,12 @@ func (i *nsIndex) canFlushBlock(
 		return false, nil
 	}
 
+	// Index only namespaces have no data files, the block's mutable segments
+	// hold all of its documents.
+	if i.nsMetadata.Options().IndexOnly() {
+		return true, nil
+	}
+
 	// Check all data files exist for the shards we own
 	for _, shard := range shards {
 		start := block.StartTime()
@@ -1015,6 +1119,15 @@ func (i *nsIndex) flushBlockSegment(
 	// Reset the builder
 	builder.Reset(0)
 
+	if i.nsMetadata.Options().IndexOnly() {
+		// Index only namespaces have no data filesets to read the series
+		// from, so build the segment from the documents held in memory.
+		if err := indexBlock.AddMutableSegmentsDocuments(builder); err != nil {
+			return err
+		}
+		return preparedPersist.Persist(builder)
+	}
+
 	ctx := i.opts.ContextPool().Get()
 	for _, shard := range shards {
 		var (
@@ -1119,6 +1232,41 @@ func (i *nsIndex) Query(
 		FilterID:  i.shardsFilterID(),
 	})
 	ctx.RegisterFinalizer(results)
+
+	// Record the query so that the postings list cache can be warmed with the
+	// most recent queries after a restart.
+	if plCache := i.opts.IndexOptions().PostingsListCache(); plCache != nil {
+		plCache.RecordQuery(i.nsMetadata.ID(), query.Query)
+	}
+
+	if opts.Explain != nil {
+		opts.Explain.Query = query.String()
+	}
+
+	if opts.Cursor != nil {
+		// NB: Every block is queried without a limit so that the page holds
+		// the series with the smallest IDs after the cursor, which makes the
+		// page the same on every replica that indexed the same series.
+		page := newQueryPageResults(results, *opts.Cursor, opts.Limit,
+			i.shardsFilterID())
+		scanOpts := opts
+		scanOpts.Limit = 0
+		_, err := i.query(ctx, query, page, scanOpts, i.execBlockQueryFn, logFields)
+		var next *index.QueryCursor
+		if err == nil {
+			next, err = page.flush()
+		}
+		if err != nil {
+			sp.LogFields(opentracinglog.Error(err))
+			return index.QueryResult{}, err
+		}
+		return index.QueryResult{
+			Results:    results,
+			Exhaustive: next == nil,
+			NextCursor: next,
+		}, nil
+	}
+
 	exhaustive, err := i.query(ctx, query, results, opts, i.execBlockQueryFn, logFields)
 	if err != nil {
 		sp.LogFields(opentracinglog.Error(err))
@@ -1375,6 +1523,8 @@ func (i *nsIndex) execBlockQueryFn(
 	sp.LogFields(logFields...)
 	defer sp.Finish()
 
+	results = i.withDeletedSeriesFiltered(results, block, opts)
+
 	blockExhaustive, err := block.Query(ctx, cancellable, query, opts, results, logFields)
 	if err == index.ErrUnableToQueryBlockClosed {
 		// NB(r): Because we query this block outside of the results lock, it's
@@ -1394,6 +1544,217 @@ func (i *nsIndex) execBlockQueryFn(
 	state.exhaustive = state.exhaustive && blockExhaustive
 }
 
+func (i *nsIndex) DeleteSeries(ids []ident.ID, start, end time.Time) {
+	if len(ids) == 0 {
+		return
+	}
+
+	i.state.Lock()
+	defer i.state.Unlock()
+
+	// NB: Deletes are recorded for blocks that do not exist yet too since
+	// tombstones are reapplied while bootstrapping before the index blocks
+	// have been bootstrapped.
+	for t := start.Truncate(i.blockSize); t.Before(end); t = t.Add(i.blockSize) {
+		var (
+			blockStart = xtime.ToUnixNano(t)
+			blockRange = xtime.Range{Start: t, End: t.Add(i.blockSize)}
+		)
+		deletedRange, ok := blockRange.Intersect(xtime.Range{Start: start, End: end})
+		if !ok {
+			continue
+		}
+
+		var (
+			existing = i.state.deletedByBlock[blockStart]
+			deleted  = make(map[string]xtime.Ranges, len(existing)+len(ids))
+			removed  [][]byte
+		)
+		for id, ranges := range existing {
+			deleted[id] = ranges
+		}
+		for _, id := range ids {
+			// Ranges are never modified once published since queries read
+			// them without holding the lock.
+			ranges := xtime.NewRanges(deletedRange)
+			if curr, ok := deleted[id.String()]; ok {
+				ranges = curr.Clone()
+				ranges.AddRange(deletedRange)
+			}
+			deleted[id.String()] = ranges
+
+			// Series with all data in the block deleted no longer belong in
+			// the block and their documents can be removed.
+			if rangesCover(ranges, blockRange) {
+				removed = append(removed, id.Bytes())
+			}
+		}
+		i.state.deletedByBlock[blockStart] = deleted
+		if block, ok := i.state.blocksByTime[blockStart]; ok && len(removed) > 0 {
+			block.RemoveDocuments(removed)
+		}
+	}
+}
+
+func (i *nsIndex) ClearDeletedSeries(id ident.ID, start, end time.Time) {
+	i.state.Lock()
+	defer i.state.Unlock()
+
+	for t := start.Truncate(i.blockSize); t.Before(end); t = t.Add(i.blockSize) {
+		blockStart := xtime.ToUnixNano(t)
+		existing := i.state.deletedByBlock[blockStart]
+		curr, ok := existing[id.String()]
+		if !ok {
+			continue
+		}
+
+		deleted := make(map[string]xtime.Ranges, len(existing))
+		for k, v := range existing {
+			deleted[k] = v
+		}
+		// Ranges are never modified once published since queries read
+		// them without holding the lock.
+		ranges := curr.Clone()
+		ranges.RemoveRange(xtime.Range{Start: start, End: end})
+		if ranges.IsEmpty() {
+			delete(deleted, id.String())
+		} else {
+			deleted[id.String()] = ranges
+		}
+		if len(deleted) == 0 {
+			delete(i.state.deletedByBlock, blockStart)
+		} else {
+			i.state.deletedByBlock[blockStart] = deleted
+		}
+
+		// The series may have been removed from the block if all its data
+		// in the block was deleted, it is indexed again by the write.
+		if block, ok := i.state.blocksByTime[blockStart]; ok {
+			block.RestoreDocuments([][]byte{id.Bytes()})
+		}
+	}
+}
+
+// fullyDeletedWithLock returns the IDs of the series with all data in the
+// block deleted.
+func (i *nsIndex) fullyDeletedWithLock(blockStart xtime.UnixNano) [][]byte {
+	var (
+		t          = blockStart.ToTime()
+		blockRange = xtime.Range{Start: t, End: t.Add(i.blockSize)}
+		removed    [][]byte
+	)
+	for id, ranges := range i.state.deletedByBlock[blockStart] {
+		if rangesCover(ranges, blockRange) {
+			removed = append(removed, []byte(id))
+		}
+	}
+	return removed
+}
+
+func (i *nsIndex) Cardinality(
+	ctx context.Context,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	if !i.opts.IndexOptions().CardinalityTrackingEnabled() {
+		return index.CardinalityResult{}, index.ErrCardinalityTrackingDisabled
+	}
+
+	i.state.RLock()
+	if !i.isOpenWithRLock() {
+		i.state.RUnlock()
+		return index.CardinalityResult{}, errDbIndexUnableToQueryClosed
+	}
+	blocks, err := i.blocksForQueryWithRLock(xtime.NewRanges(xtime.Range{
+		Start: opts.StartInclusive,
+		End:   opts.EndExclusive,
+	}))
+	i.state.RUnlock()
+	if err != nil {
+		return index.CardinalityResult{}, err
+	}
+
+	tracker := index.NewCardinalityTracker()
+	for _, block := range blocks {
+		err := block.Cardinality(tracker)
+		if err == index.ErrUnableReportCardinalityBlockClosed {
+			// Block was rotated out since it was retrieved, skip it.
+			continue
+		}
+		if err != nil {
+			return index.CardinalityResult{}, err
+		}
+	}
+	return tracker.Result(opts), nil
+}
+
+// withDeletedSeriesFiltered wraps the results to drop the documents of series
+// with all data in the range queried from the block deleted.
+func (i *nsIndex) withDeletedSeriesFiltered(
+	results index.BaseResults,
+	block index.Block,
+	opts index.QueryOptions,
+) index.BaseResults {
+	i.state.RLock()
+	deleted := i.state.deletedByBlock[xtime.ToUnixNano(block.StartTime())]
+	i.state.RUnlock()
+	if len(deleted) == 0 {
+		return results
+	}
+
+	queryRange, ok := xtime.Range{
+		Start: block.StartTime(),
+		End:   block.EndTime(),
+	}.Intersect(xtime.Range{
+		Start: opts.StartInclusive,
+		End:   opts.EndExclusive,
+	})
+	if !ok {
+		return results
+	}
+	return newDeletedSeriesFilteredResults(results, deleted, queryRange)
+}
+
+// rangesCover returns whether the ranges contain the entirety of the range.
+func rangesCover(ranges xtime.Ranges, r xtime.Range) bool {
+	remaining := xtime.NewRanges(r)
+	remaining.RemoveRanges(ranges)
+	return remaining.IsEmpty()
+}
+
+// deletedSeriesFilteredResults drops documents for series with all data in
+// the queried range deleted before adding them to the underlying results.
+type deletedSeriesFilteredResults struct {
+	index.BaseResults
+
+	deleted    map[string]xtime.Ranges
+	queryRange xtime.Range
+}
+
+func newDeletedSeriesFilteredResults(
+	results index.BaseResults,
+	deleted map[string]xtime.Ranges,
+	queryRange xtime.Range,
+) index.BaseResults {
+	return &deletedSeriesFilteredResults{
+		BaseResults: results,
+		deleted:     deleted,
+		queryRange:  queryRange,
+	}
+}
+
+func (r *deletedSeriesFilteredResults) AddDocuments(
+	batch []doc.Document,
+) (int, error) {
+	filtered := batch[:0]
+	for _, d := range batch {
+		if ranges, ok := r.deleted[string(d.ID)]; ok && rangesCover(ranges, r.queryRange) {
+			continue
+		}
+		filtered = append(filtered, d)
+	}
+	return r.BaseResults.AddDocuments(filtered)
+}
+
 func (i *nsIndex) execBlockAggregateQueryFn(
 	ctx context.Context,
 	cancellable *resource.CancellableLifetime,
@@ -1548,6 +1909,13 @@ func (i *nsIndex) ensureBlockPresentWithRLock(blockStart time.Time) (index.Block
 		return nil, i.unableToAllocBlockInvariantError(err)
 	}
 
+	// Series fully deleted from the block before it was created, such as
+	// when tombstones are reapplied while bootstrapping, are removed as
+	// documents are added to the block.
+	if removed := i.fullyDeletedWithLock(blockStartNanos); len(removed) > 0 {
+		block.RemoveDocuments(removed)
+	}
+
 	// add to tracked blocks map
 	i.state.blocksByTime[blockStartNanos] = block
 
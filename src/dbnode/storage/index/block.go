@@ -30,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index/compaction"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
 	"github.com/m3db/m3/src/dbnode/storage/index/segments"
 	"github.com/m3db/m3/src/dbnode/storage/stats"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
@@ -41,6 +42,7 @@ import (
 	"github.com/m3db/m3/src/m3ninx/persist"
 	"github.com/m3db/m3/src/m3ninx/search"
 	"github.com/m3db/m3/src/m3ninx/search/executor"
+	xclock "github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/context"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
@@ -60,6 +62,8 @@ var (
 	ErrUnableToQueryBlockClosed = errors.New("unable to query, index block is closed")
 	// ErrUnableReportStatsBlockClosed is returned from Stats when the block is closed.
 	ErrUnableReportStatsBlockClosed = errors.New("unable to report stats, block is closed")
+	// ErrUnableReportCardinalityBlockClosed is returned from Cardinality when the block is closed.
+	ErrUnableReportCardinalityBlockClosed = errors.New("unable to report cardinality, block is closed")
 
 	errUnableToWriteBlockClosed                = errors.New("unable to write, index block is closed")
 	errUnableToWriteBlockSealed                = errors.New("unable to write, index block is sealed")
@@ -149,6 +153,10 @@ type block struct {
 	iopts                           instrument.Options
 	nsMD                            namespace.Metadata
 	queryStats                      stats.QueryStats
+	cardinality                     *CardinalityTracker
+	// removedIDs are the IDs of documents to drop from the in-memory segments,
+	// it is copied on write so it can be read after releasing the lock.
+	removedIDs removedDocumentsFilter
 
 	compact blockCompact
 
@@ -237,6 +245,9 @@ func NewBlock(
 	}
 	b.newFieldsAndTermsIteratorFn = newFieldsAndTermsIterator
 	b.newExecutorFn = b.executorWithRLock
+	if indexOpts.CardinalityTrackingEnabled() {
+		b.cardinality = NewCardinalityTracker()
+	}
 
 	return b, nil
 }
@@ -390,8 +401,16 @@ func (b *block) backgroundCompactWithTask(
 		segments = append(segments, seg.Segment)
 	}
 
+	b.RLock()
+	removed := b.removedIDs
+	b.RUnlock()
+	var keep segment.DocumentsFilter
+	if len(removed) > 0 {
+		keep = removed
+	}
+
 	start := time.Now()
-	compacted, err := b.compact.backgroundCompactor.Compact(segments, mmap.ReporterOptions{
+	compacted, err := b.compact.backgroundCompactor.Compact(segments, keep, mmap.ReporterOptions{
 		Context: mmap.Context{
 			Name: mmapIndexBlockName,
 		},
@@ -499,6 +518,12 @@ func (b *block) WriteBatch(inserts *WriteBatch) (WriteBatchResult, error) {
 		return b.writeBatchResult(inserts, err)
 	}
 
+	if b.cardinality != nil {
+		for _, d := range builder.Docs() {
+			b.cardinality.Add(d)
+		}
+	}
+
 	// Return result from the original insertion since compaction was successful.
 	return b.writeBatchResult(inserts, insertResultErr)
 }
@@ -882,8 +907,20 @@ func (b *block) queryWithSpan(
 		}
 	}()
 
+	var (
+		nowFn       = b.opts.ClockOptions().NowFn()
+		start       = nowFn()
+		explanation *search.Explanation
+		iter        doc.Iterator
+	)
 	// FOLLOWUP(prateek): push down QueryOptions to restrict results
-	iter, err := exec.Execute(query.Query.SearchQuery())
+	if opts.Explain != nil {
+		explanation = &search.Explanation{}
+		iter, err = exec.ExecuteExplain(query.Query.SearchQuery(), explanation,
+			xclock.NowFn(nowFn))
+	} else {
+		iter, err = exec.Execute(query.Query.SearchQuery())
+	}
 	if err != nil {
 		return false, err
 	}
@@ -952,6 +989,16 @@ func (b *block) queryWithSpan(
 		return false, err
 	}
 
+	if explanation != nil {
+		// NB: Segments are searched lazily as documents are iterated so the
+		// segments after the limit was exceeded are not explained.
+		opts.Explain.AddBlock(BlockExplanation{
+			BlockStart: b.blockStart,
+			Duration:   nowFn().Sub(start),
+			Segments:   explanation.Segments,
+		})
+	}
+
 	exhaustive := !opts.LimitExceeded(size)
 	return exhaustive, nil
 }
@@ -1235,6 +1282,40 @@ func (b *block) addAggregateResults(
 	return batch, size, nil
 }
 
+// WarmPostingsListCache searches the block's bootstrapped segments with the
+// query so that the postings lists it resolves are cached, without retrieving
+// any of the documents it matches.
+func (b *block) WarmPostingsListCache(query Query) error {
+	b.RLock()
+	defer b.RUnlock()
+
+	if b.state == blockStateClosed {
+		return ErrUnableToQueryBlockClosed
+	}
+
+	searchQuery := query.Query.SearchQuery()
+	searcher, err := searchQuery.Searcher()
+	if err != nil {
+		return err
+	}
+
+	// Only the bootstrapped segments are wrapped with a read through cache.
+	return b.shardRangesSegmentsByVolumeType.forEachSegment(func(seg segment.Segment) error {
+		reader, err := seg.Reader()
+		if err != nil {
+			return err
+		}
+
+		readThrough, ok := reader.(search.ReadThroughSegmentSearcher)
+		if !ok {
+			return reader.Close()
+		}
+
+		_, err = readThrough.Search(searchQuery, searcher)
+		return xerrors.FirstError(err, reader.Close())
+	})
+}
+
 func (b *block) AddResults(
 	resultsByVolumeType result.IndexBlockByVolumeType,
 ) error {
@@ -1291,6 +1372,14 @@ func (b *block) addResults(
 		readThroughSegments = append(readThroughSegments, readThroughSeg)
 	}
 
+	if b.cardinality != nil {
+		for _, seg := range segments {
+			if err := b.addSegmentCardinality(seg); err != nil {
+				return err
+			}
+		}
+	}
+
 	entry := blockShardRangesSegments{
 		shardTimeRanges: results.Fulfilled(),
 		segments:        readThroughSegments,
@@ -1426,6 +1515,42 @@ func (b *block) Stats(reporter BlockStatsReporter) error {
 	return nil
 }
 
+func (b *block) addSegmentCardinality(seg segment.Segment) error {
+	reader, err := seg.Reader()
+	if err != nil {
+		return err
+	}
+
+	iter, err := reader.AllDocs()
+	if err != nil {
+		reader.Close()
+		return err
+	}
+
+	for iter.Next() {
+		b.cardinality.Add(iter.Current())
+	}
+
+	multiErr := xerrors.NewMultiError()
+	multiErr = multiErr.Add(iter.Err())
+	multiErr = multiErr.Add(iter.Close())
+	multiErr = multiErr.Add(reader.Close())
+	return multiErr.FinalError()
+}
+
+func (b *block) Cardinality(tracker *CardinalityTracker) error {
+	b.RLock()
+	defer b.RUnlock()
+
+	if b.state == blockStateClosed {
+		return ErrUnableReportCardinalityBlockClosed
+	}
+	if b.cardinality == nil {
+		return ErrCardinalityTrackingDisabled
+	}
+	return tracker.Merge(b.cardinality)
+}
+
 func (b *block) IsSealedWithRLock() bool {
 	return b.state == blockStateSealed
 }
@@ -1530,6 +1655,114 @@ func (b *block) MemorySegmentsData(ctx context.Context) ([]fst.SegmentData, erro
 	return results, nil
 }
 
+func (b *block) RemoveDocuments(ids [][]byte) {
+	b.Lock()
+	defer b.Unlock()
+
+	removed := make(removedDocumentsFilter, len(b.removedIDs)+len(ids))
+	for id := range b.removedIDs {
+		removed[id] = struct{}{}
+	}
+	for _, id := range ids {
+		removed[string(id)] = struct{}{}
+	}
+	b.removedIDs = removed
+}
+
+func (b *block) RestoreDocuments(ids [][]byte) {
+	b.Lock()
+	defer b.Unlock()
+
+	if len(b.removedIDs) == 0 {
+		return
+	}
+	removed := make(removedDocumentsFilter, len(b.removedIDs))
+	for id := range b.removedIDs {
+		removed[id] = struct{}{}
+	}
+	for _, id := range ids {
+		delete(removed, string(id))
+	}
+	b.removedIDs = removed
+}
+
+// removedDocumentsFilter keeps every document not marked for removal.
+type removedDocumentsFilter map[string]struct{}
+
+func (f removedDocumentsFilter) Contains(d doc.Document) bool {
+	_, removed := f[string(d.ID)]
+	return !removed
+}
+
+func (b *block) AddMutableSegmentsDocuments(builder segment.DocumentsBuilder) error {
+	b.RLock()
+	defer b.RUnlock()
+	if b.state == blockStateClosed {
+		return errBlockAlreadyClosed
+	}
+
+	segs := make([]segment.Segment, 0, len(b.foregroundSegments)+len(b.backgroundSegments))
+	for _, readable := range b.foregroundSegments {
+		segs = append(segs, readable.Segment())
+	}
+	for _, readable := range b.backgroundSegments {
+		segs = append(segs, readable.Segment())
+	}
+	b.shardRangesSegmentsByVolumeType.forEachSegment(func(seg segment.Segment) error {
+		if mutableSeg, ok := seg.(segment.MutableSegment); ok {
+			segs = append(segs, mutableSeg)
+		}
+		return nil
+	})
+
+	for _, seg := range segs {
+		if err := addSegmentDocuments(builder, seg, b.removedIDs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addSegmentDocuments(
+	builder segment.DocumentsBuilder,
+	seg segment.Segment,
+	keep segment.DocumentsFilter,
+) error {
+	reader, err := seg.Reader()
+	if err != nil {
+		return err
+	}
+
+	iter, err := reader.AllDocs()
+	if err != nil {
+		reader.Close()
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for iter.Next() {
+		if !keep.Contains(iter.Current()) {
+			continue
+		}
+		// NB: Copy the document since the segment it was read from may be
+		// closed by a compaction before the builder is done with it.
+		_, err := builder.Insert(convert.CloneDocument(iter.Current()))
+		if err != nil && m3ninxindex.IsBatchPartialError(err) {
+			// The same document may be held by more than one segment.
+			err = err.(*m3ninxindex.BatchPartialError).FilterDuplicateIDErrors()
+		}
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			break
+		}
+	}
+
+	multiErr = multiErr.Add(iter.Err())
+	multiErr = multiErr.Add(iter.Close())
+	multiErr = multiErr.Add(reader.Close())
+	return multiErr.FinalError()
+}
+
 func (b *block) Close() error {
 	b.Lock()
 	defer b.Unlock()
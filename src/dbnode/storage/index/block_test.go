@@ -35,9 +35,13 @@ import (
 	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/index/segment"
+	"github.com/m3db/m3/src/m3ninx/index/segment/builder"
+	"github.com/m3db/m3/src/m3ninx/index/segment/fst"
 	"github.com/m3db/m3/src/m3ninx/index/segment/mem"
 	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
+	"github.com/m3db/m3/src/m3ninx/postings/roaring"
 	"github.com/m3db/m3/src/m3ninx/search"
+	xclock "github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
@@ -290,6 +294,56 @@ func TestBlockWriteActualSegmentPartialFailure(t *testing.T) {
 	require.Equal(t, 2, verified)
 }
 
+func TestBlockWriteTracksCardinality(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	md := newTestNSMetadata(t)
+	blockSize := time.Hour
+
+	now := time.Now()
+	blockStart := now.Truncate(blockSize)
+
+	opts := testOpts.SetCardinalityTrackingEnabled(true)
+	blk, err := NewBlock(blockStart, md, BlockOptions{}, opts)
+	require.NoError(t, err)
+
+	h1 := NewMockOnIndexSeries(ctrl)
+	h1.EXPECT().OnIndexFinalize(xtime.ToUnixNano(blockStart))
+	h1.EXPECT().OnIndexSuccess(xtime.ToUnixNano(blockStart))
+
+	batch := NewWriteBatch(WriteBatchOptions{
+		IndexBlockSize: blockSize,
+	})
+	batch.Append(WriteBatchEntry{
+		Timestamp:     now,
+		OnIndexSeries: h1,
+	}, testDoc1())
+	_, err = blk.WriteBatch(batch)
+	require.NoError(t, err)
+
+	tracker := NewCardinalityTracker()
+	require.NoError(t, blk.Cardinality(tracker))
+
+	result := tracker.Result(CardinalityOptions{})
+	require.Len(t, result.Fields, 1)
+	require.Equal(t, "bar", string(result.Fields[0].Field))
+	require.Equal(t, uint64(1), result.Fields[0].Series.Estimate())
+	require.Len(t, result.Fields[0].TopValues, 1)
+	require.Equal(t, "baz", string(result.Fields[0].TopValues[0].Value))
+
+	require.NoError(t, blk.Close())
+	require.Equal(t, ErrUnableReportCardinalityBlockClosed, blk.Cardinality(tracker))
+}
+
+func TestBlockCardinalityTrackingDisabled(t *testing.T) {
+	md := newTestNSMetadata(t)
+	blk, err := NewBlock(time.Now().Truncate(time.Hour), md, BlockOptions{}, testOpts)
+	require.NoError(t, err)
+	require.Equal(t, ErrCardinalityTrackingDisabled,
+		blk.Cardinality(NewCardinalityTracker()))
+}
+
 func TestBlockWritePartialFailure(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -719,6 +773,76 @@ func TestBlockMockQueryLimitExhaustive(t *testing.T) {
 	ctx.BlockingClose()
 }
 
+func TestBlockMockQueryExplain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMD := newTestNSMetadata(t)
+	start := time.Now().Truncate(time.Hour)
+	now := start
+	opts := testOpts.SetClockOptions(testOpts.ClockOptions().SetNowFn(func() time.Time {
+		return now
+	}))
+	blk, err := NewBlock(start, testMD, BlockOptions{}, opts)
+	require.NoError(t, err)
+
+	b, ok := blk.(*block)
+	require.True(t, ok)
+
+	exec := search.NewMockExecutor(ctrl)
+	b.newExecutorFn = func() (search.Executor, error) {
+		return exec, nil
+	}
+
+	segmentExplanation := search.SegmentExplanation{
+		Query: search.QueryExplanation{
+			Query:        defaultQuery.String(),
+			PostingsSize: 1,
+		},
+		CacheHits: 1,
+	}
+
+	dIter := doc.NewMockIterator(ctrl)
+	gomock.InOrder(
+		exec.EXPECT().ExecuteExplain(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(
+				_ search.Query,
+				explanation *search.Explanation,
+				_ xclock.NowFn,
+			) (doc.Iterator, error) {
+				now = now.Add(time.Second)
+				explanation.Segments = append(explanation.Segments, segmentExplanation)
+				return dIter, nil
+			}),
+		dIter.EXPECT().Next().Return(true),
+		dIter.EXPECT().Current().Return(testDoc1()),
+		dIter.EXPECT().Next().Return(false),
+		dIter.EXPECT().Err().Return(nil),
+		dIter.EXPECT().Close().Return(nil),
+		exec.EXPECT().Close().Return(nil),
+	)
+
+	var (
+		explanation = &QueryExplanation{}
+		results     = NewQueryResults(nil, QueryResultsOptions{}, testOpts)
+		ctx         = context.NewContext()
+	)
+	_, err = b.Query(ctx, resource.NewCancellableLifetime(),
+		defaultQuery, QueryOptions{Explain: explanation}, results, emptyLogFields)
+	require.NoError(t, err)
+	require.Equal(t, 1, results.Size())
+
+	require.Equal(t, 1, len(explanation.Blocks))
+	require.True(t, start.Equal(explanation.Blocks[0].BlockStart))
+	require.Equal(t, time.Second, explanation.Blocks[0].Duration)
+	require.Equal(t, []search.SegmentExplanation{segmentExplanation},
+		explanation.Blocks[0].Segments)
+
+	// NB(r): Make sure to call finalizers blockingly (to finish
+	// the expected close calls)
+	ctx.BlockingClose()
+}
+
 func TestBlockMockQueryMergeResultsMapLimit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -872,6 +996,73 @@ func TestBlockAddResultsAfterCloseFails(t *testing.T) {
 	require.Error(t, blk.AddResults(results))
 }
 
+func TestBlockWarmPostingsListCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	plCache, stopReporting, err := NewPostingsListCache(10, testPostingListCacheOptions)
+	require.NoError(t, err)
+	defer stopReporting()
+
+	opts := testOpts.
+		SetPostingsListCache(plCache).
+		SetReadThroughSegmentOptions(ReadThroughSegmentOptions{
+			CacheSearches: true,
+		})
+
+	testMD := newTestNSMetadata(t)
+	start := time.Now().Truncate(time.Hour)
+	blk, err := NewBlock(start, testMD, BlockOptions{}, opts)
+	require.NoError(t, err)
+
+	b, ok := blk.(*block)
+	require.True(t, ok)
+
+	seg := fst.NewMockSegment(ctrl)
+	results := result.NewIndexBlockByVolumeType(start)
+	results.SetBlock(idxpersist.DefaultIndexVolumeType, result.NewIndexBlock([]segment.Segment{seg},
+		result.NewShardTimeRangesFromRange(start, start.Add(time.Hour), 1, 2, 3)))
+	require.NoError(t, b.AddResults(results))
+
+	var (
+		query = Query{
+			Query: idx.NewConjunctionQuery(
+				idx.NewTermQuery([]byte("foo"), []byte("bar")),
+				idx.NewTermQuery([]byte("baz"), []byte("qux")),
+			),
+		}
+		reader = index.NewMockReader(ctrl)
+		pl     = roaring.NewPostingsList()
+	)
+	require.NoError(t, pl.Insert(1))
+
+	gomock.InOrder(
+		seg.EXPECT().Reader().Return(reader, nil),
+		reader.EXPECT().MatchTerm([]byte("foo"), []byte("bar")).Return(pl, nil),
+		reader.EXPECT().MatchTerm([]byte("baz"), []byte("qux")).Return(pl, nil),
+		reader.EXPECT().Close().Return(nil),
+	)
+	require.NoError(t, b.WarmPostingsListCache(query))
+
+	// The whole query should now be cached for the segment.
+	readThrough, ok := b.shardRangesSegmentsByVolumeType[idxpersist.DefaultIndexVolumeType][0].
+		segments[0].(*ReadThroughSegment)
+	require.True(t, ok)
+	cached, ok := plCache.GetSearch(readThrough.uuid, query.String())
+	require.True(t, ok)
+	require.True(t, cached.Equal(pl))
+}
+
+func TestBlockWarmPostingsListCacheAfterCloseFails(t *testing.T) {
+	testMD := newTestNSMetadata(t)
+	start := time.Now().Truncate(time.Hour)
+	blk, err := NewBlock(start, testMD, BlockOptions{}, testOpts)
+	require.NoError(t, err)
+	require.NoError(t, blk.Close())
+
+	require.Error(t, blk.WarmPostingsListCache(defaultQuery))
+}
+
 func TestBlockAddResultsAfterSealWorks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1184,6 +1375,69 @@ func TestBlockEvictMutableSegmentsAddResults(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestBlockAddMutableSegmentsDocuments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blockSize := time.Hour
+
+	testMD := newTestNSMetadata(t)
+	blockStart := time.Now().Truncate(blockSize)
+
+	blk, err := NewBlock(blockStart, testMD, BlockOptions{}, testOpts)
+	require.NoError(t, err)
+	b, ok := blk.(*block)
+	require.True(t, ok)
+
+	h1 := NewMockOnIndexSeries(ctrl)
+	h1.EXPECT().OnIndexFinalize(xtime.ToUnixNano(blockStart))
+	h1.EXPECT().OnIndexSuccess(xtime.ToUnixNano(blockStart))
+
+	batch := NewWriteBatch(WriteBatchOptions{
+		IndexBlockSize: blockSize,
+	})
+	batch.Append(WriteBatchEntry{
+		Timestamp:     blockStart.Add(time.Minute),
+		OnIndexSeries: h1,
+	}, testDoc1())
+
+	res, err := b.WriteBatch(batch)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.NumSuccess)
+
+	// Bootstrapped mutable segments are included and duplicate IDs skipped.
+	results := result.NewIndexBlockByVolumeType(blockStart)
+	results.SetBlock(idxpersist.DefaultIndexVolumeType, result.NewIndexBlock(
+		[]segment.Segment{testSegment(t, testDoc1DupeID(), testDoc2())},
+		result.NewShardTimeRangesFromRange(blockStart, blockStart.Add(blockSize), 1, 2, 3)))
+	require.NoError(t, b.AddResults(results))
+
+	docsBuilder, err := builder.NewBuilderFromDocuments(testOpts.SegmentBuilderOptions())
+	require.NoError(t, err)
+	defer docsBuilder.Close()
+
+	require.NoError(t, b.AddMutableSegmentsDocuments(docsBuilder))
+
+	docs := docsBuilder.Docs()
+	require.Equal(t, 2, len(docs))
+	require.Equal(t, testDoc1(), docs[0])
+	require.Equal(t, testDoc2(), docs[1])
+}
+
+func TestBlockAddMutableSegmentsDocumentsAfterCloseFails(t *testing.T) {
+	testMD := newTestNSMetadata(t)
+	start := time.Now().Truncate(time.Hour)
+	blk, err := NewBlock(start, testMD, BlockOptions{}, testOpts)
+	require.NoError(t, err)
+	require.NoError(t, blk.Close())
+
+	docsBuilder, err := builder.NewBuilderFromDocuments(testOpts.SegmentBuilderOptions())
+	require.NoError(t, err)
+	defer docsBuilder.Close()
+
+	require.Error(t, blk.AddMutableSegmentsDocuments(docsBuilder))
+}
+
 func TestBlockE2EInsertQuery(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1617,6 +1871,121 @@ func TestBlockWriteBackgroundCompact(t *testing.T) {
 	b.RUnlock()
 }
 
+func TestBlockBackgroundCompactRemovesDocuments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMD := newTestNSMetadata(t)
+	blockSize := time.Hour
+
+	now := time.Now()
+	blockStart := now.Truncate(blockSize)
+
+	nowNotBlockStartAligned := now.
+		Truncate(blockSize).
+		Add(time.Minute)
+
+	blk, err := NewBlock(blockStart, testMD, BlockOptions{}, testOpts)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, blk.Close())
+	}()
+
+	b, ok := blk.(*block)
+	require.True(t, ok)
+
+	// First write
+	h1 := NewMockOnIndexSeries(ctrl)
+	h1.EXPECT().OnIndexFinalize(xtime.ToUnixNano(blockStart))
+	h1.EXPECT().OnIndexSuccess(xtime.ToUnixNano(blockStart))
+
+	h2 := NewMockOnIndexSeries(ctrl)
+	h2.EXPECT().OnIndexFinalize(xtime.ToUnixNano(blockStart))
+	h2.EXPECT().OnIndexSuccess(xtime.ToUnixNano(blockStart))
+
+	batch := NewWriteBatch(WriteBatchOptions{
+		IndexBlockSize: blockSize,
+	})
+	batch.Append(WriteBatchEntry{
+		Timestamp:     nowNotBlockStartAligned,
+		OnIndexSeries: h1,
+	}, testDoc1())
+	batch.Append(WriteBatchEntry{
+		Timestamp:     nowNotBlockStartAligned,
+		OnIndexSeries: h2,
+	}, testDoc2())
+
+	res, err := b.WriteBatch(batch)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.NumSuccess)
+	require.Equal(t, int64(0), res.NumError)
+
+	// Move the segment to background
+	b.Lock()
+	b.maybeMoveForegroundSegmentsToBackgroundWithLock([]compaction.Segment{
+		{Segment: b.foregroundSegments[0].Segment()},
+	})
+	b.Unlock()
+
+	// Second write
+	h1 = NewMockOnIndexSeries(ctrl)
+	h1.EXPECT().OnIndexFinalize(xtime.ToUnixNano(blockStart))
+	h1.EXPECT().OnIndexSuccess(xtime.ToUnixNano(blockStart))
+
+	batch = NewWriteBatch(WriteBatchOptions{
+		IndexBlockSize: blockSize,
+	})
+	batch.Append(WriteBatchEntry{
+		Timestamp:     nowNotBlockStartAligned,
+		OnIndexSeries: h1,
+	}, testDoc3())
+
+	res, err = b.WriteBatch(batch)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.NumSuccess)
+	require.Equal(t, int64(0), res.NumError)
+
+	// Documents removed from the block are dropped by the next compaction
+	b.RemoveDocuments([][]byte{testDoc1().ID})
+
+	// Move last segment to background, this should kick off a background compaction
+	b.Lock()
+	b.maybeMoveForegroundSegmentsToBackgroundWithLock([]compaction.Segment{
+		{Segment: b.foregroundSegments[0].Segment()},
+	})
+	require.Equal(t, 2, len(b.backgroundSegments))
+	require.True(t, b.compact.compactingBackground)
+	b.Unlock()
+
+	// Wait for compaction to finish
+	for {
+		b.RLock()
+		compacting := b.compact.compactingBackground
+		b.RUnlock()
+		if !compacting {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Make sure compacted into a single segment without the removed document
+	b.RLock()
+	require.Equal(t, 1, len(b.backgroundSegments))
+	compacted := b.backgroundSegments[0].Segment()
+	b.RUnlock()
+	require.Equal(t, 2, int(compacted.Size()))
+	reader, err := compacted.Reader()
+	require.NoError(t, err)
+	defer reader.Close()
+	docs, err := reader.AllDocs()
+	require.NoError(t, err)
+	for docs.Next() {
+		require.NotEqual(t, testDoc1().ID, docs.Current().ID)
+	}
+	require.NoError(t, docs.Err())
+	require.NoError(t, docs.Close())
+}
+
 func TestBlockAggregateAfterClose(t *testing.T) {
 	testMD := newTestNSMetadata(t)
 	start := time.Now().Truncate(time.Hour)
@@ -0,0 +1,470 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"bytes"
+	"container/heap"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/x/hll"
+
+	"github.com/cespare/xxhash"
+)
+
+const (
+	// maxTrackedValuesPerField bounds the number of values tracked per field
+	// so that a single exploding field cannot consume unbounded memory, only
+	// the heaviest hitters are tracked and the rest are still accounted for
+	// in the field level sketches.
+	maxTrackedValuesPerField = 1 << 10
+
+	// numCardinalityTrackerShards is the number of shards fields are spread
+	// across so that concurrent inserts of different fields do not contend
+	// on a single lock.
+	numCardinalityTrackerShards = 32
+
+	// cardinalityOverFetchFactor is how many times the limits are fetched
+	// from each host so that fields and values just below the limits of a
+	// host can still make the limits once the results of hosts are merged.
+	cardinalityOverFetchFactor = 3
+
+	// cardinalityOverFetchHeavyHittersError is the number of values fetched
+	// from each host in addition to the over fetched limit to account for the
+	// error of the heavy hitters tracking, whose tracked values are only
+	// ordered approximately.
+	cardinalityOverFetchHeavyHittersError = maxTrackedValuesPerField / 16
+)
+
+// ErrCardinalityTrackingDisabled is returned when cardinality is requested
+// from an index that does not have cardinality tracking enabled.
+var ErrCardinalityTrackingDisabled = errors.New("index cardinality tracking is disabled")
+
+// CardinalityOptions enables users to specify constraints on cardinality
+// reports.
+type CardinalityOptions struct {
+	StartInclusive time.Time
+	EndExclusive   time.Time
+	// FieldsLimit limits the number of fields returned, fields with the
+	// highest series cardinality are returned first, zero means unlimited.
+	FieldsLimit int
+	// ValuesLimit limits the number of values returned per field, values with
+	// the highest series cardinality are returned first, zero means unlimited.
+	ValuesLimit int
+}
+
+// HostOptions returns the options to request from each host of a cluster,
+// the limits are raised by a bounded amount since each host only ranks the
+// fields and values of its own series, the original options are applied
+// once the results of every host are merged.
+func (o CardinalityOptions) HostOptions() CardinalityOptions {
+	if o.FieldsLimit > 0 {
+		o.FieldsLimit = o.FieldsLimit*cardinalityOverFetchFactor +
+			cardinalityOverFetchHeavyHittersError
+	}
+	if o.ValuesLimit > 0 {
+		o.ValuesLimit = o.ValuesLimit*cardinalityOverFetchFactor +
+			cardinalityOverFetchHeavyHittersError
+		if o.ValuesLimit > maxTrackedValuesPerField {
+			o.ValuesLimit = maxTrackedValuesPerField
+		}
+	}
+	return o
+}
+
+// CardinalityResult is the approximate series cardinality of fields and
+// their values, ordered by descending series cardinality.
+type CardinalityResult struct {
+	Fields []FieldCardinality
+}
+
+// FieldCardinality is the approximate cardinality of a single field.
+type FieldCardinality struct {
+	Field []byte
+	// Series is a sketch of the series that have the field.
+	Series *hll.Sketch
+	// Values is a sketch of the distinct values of the field.
+	Values *hll.Sketch
+	// TopValues are the field values with the most series.
+	TopValues []ValueCardinality
+}
+
+// ValueCardinality is the approximate cardinality of a single field value.
+type ValueCardinality struct {
+	Value []byte
+	// Series is a sketch of the series that have the field value.
+	Series *hll.Sketch
+}
+
+// CardinalityTracker tracks the approximate number of series per field and
+// per field value using HyperLogLog sketches. Since series cannot be removed
+// from sketches, deleted series are still accounted for until the index
+// block tracking them is evicted.
+type CardinalityTracker struct {
+	shards []cardinalityTrackerShard
+}
+
+type cardinalityTrackerShard struct {
+	sync.RWMutex
+
+	fields map[string]*fieldCardinalityTracker
+}
+
+type fieldCardinalityTracker struct {
+	series *hll.Sketch
+	values *hll.Sketch
+	byName map[string]*valueCardinalityTracker
+	// heaviest is a min heap of the tracked values ordered by the number of
+	// times they were seen, used to evict the lightest tracked value.
+	heaviest valueCardinalityHeap
+}
+
+// valueCardinalityTracker tracks the series of a single field value. Values
+// are tracked with the Space-Saving heavy hitters algorithm, when a value is
+// evicted its count is inherited by the value replacing it so that count is
+// an upper bound of the number of times the value was seen since it started
+// being tracked.
+type valueCardinalityTracker struct {
+	value  string
+	count  uint64
+	series *hll.Sketch
+	index  int
+}
+
+func newFieldCardinalityTracker() *fieldCardinalityTracker {
+	return &fieldCardinalityTracker{
+		series: hll.NewDefault(),
+		values: hll.NewDefault(),
+		byName: make(map[string]*valueCardinalityTracker),
+	}
+}
+
+// valueTracker returns the tracker of the value with the count incremented
+// by the given count, tracking the value if it is not yet tracked. Returns
+// false if the value is lighter than every tracked value and not tracked.
+func (f *fieldCardinalityTracker) valueTracker(
+	value string,
+	count uint64,
+) (*valueCardinalityTracker, bool) {
+	if tracker, ok := f.byName[value]; ok {
+		tracker.count += count
+		heap.Fix(&f.heaviest, tracker.index)
+		return tracker, true
+	}
+
+	if len(f.heaviest) < maxTrackedValuesPerField {
+		tracker := &valueCardinalityTracker{
+			value:  value,
+			count:  count,
+			series: hll.NewDefault(),
+		}
+		f.byName[value] = tracker
+		heap.Push(&f.heaviest, tracker)
+		return tracker, true
+	}
+
+	// Values seen once always replace the lightest tracked value as per the
+	// Space-Saving algorithm, values merged from another tracker only replace
+	// it if they are heavier so that merging light values does not churn.
+	lightest := f.heaviest[0]
+	if count > 1 && count <= lightest.count {
+		return nil, false
+	}
+
+	delete(f.byName, lightest.value)
+	lightest.value = value
+	lightest.count += count
+	lightest.series = hll.NewDefault()
+	f.byName[value] = lightest
+	heap.Fix(&f.heaviest, lightest.index)
+	return lightest, true
+}
+
+// valueCardinalityHeap is a min heap of value trackers by count.
+type valueCardinalityHeap []*valueCardinalityTracker
+
+func (h valueCardinalityHeap) Len() int { return len(h) }
+
+func (h valueCardinalityHeap) Less(i, j int) bool {
+	if h[i].count != h[j].count {
+		return h[i].count < h[j].count
+	}
+	return h[i].value < h[j].value
+}
+
+func (h valueCardinalityHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *valueCardinalityHeap) Push(x interface{}) {
+	tracker := x.(*valueCardinalityTracker)
+	tracker.index = len(*h)
+	*h = append(*h, tracker)
+}
+
+func (h *valueCardinalityHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	tracker := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return tracker
+}
+
+// NewCardinalityTracker returns a new empty cardinality tracker.
+func NewCardinalityTracker() *CardinalityTracker {
+	shards := make([]cardinalityTrackerShard, numCardinalityTrackerShards)
+	for i := range shards {
+		shards[i].fields = make(map[string]*fieldCardinalityTracker)
+	}
+	return &CardinalityTracker{shards: shards}
+}
+
+func (t *CardinalityTracker) shard(field []byte) *cardinalityTrackerShard {
+	return &t.shards[xxhash.Sum64(field)%uint64(len(t.shards))]
+}
+
+// Add accounts for the series represented by the document.
+func (t *CardinalityTracker) Add(d doc.Document) {
+	seriesHash := xxhash.Sum64(d.ID)
+	for _, f := range d.Fields {
+		shard := t.shard(f.Name)
+		shard.Lock()
+		field, ok := shard.fields[string(f.Name)]
+		if !ok {
+			field = newFieldCardinalityTracker()
+			shard.fields[string(f.Name)] = field
+		}
+		field.series.Add(seriesHash)
+		field.values.Add(xxhash.Sum64(f.Value))
+		if value, ok := field.valueTracker(string(f.Value), 1); ok {
+			value.series.Add(seriesHash)
+		}
+		shard.Unlock()
+	}
+}
+
+// Merge merges the cardinality tracked by another tracker into this tracker.
+func (t *CardinalityTracker) Merge(other *CardinalityTracker) error {
+	for i := range other.shards {
+		if err := t.shards[i].merge(&other.shards[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *cardinalityTrackerShard) merge(other *cardinalityTrackerShard) error {
+	other.RLock()
+	defer other.RUnlock()
+
+	s.Lock()
+	defer s.Unlock()
+
+	for name, otherField := range other.fields {
+		field, ok := s.fields[name]
+		if !ok {
+			field = newFieldCardinalityTracker()
+			s.fields[name] = field
+		}
+		if err := field.series.Merge(otherField.series); err != nil {
+			return err
+		}
+		if err := field.values.Merge(otherField.values); err != nil {
+			return err
+		}
+		for _, otherValue := range otherField.heaviest {
+			value, ok := field.valueTracker(otherValue.value, otherValue.count)
+			if !ok {
+				continue
+			}
+			if err := value.series.Merge(otherValue.series); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Result returns the tracked cardinality constrained by the given options,
+// the sketches returned are copies and safe to retain.
+func (t *CardinalityTracker) Result(opts CardinalityOptions) CardinalityResult {
+	var fields []FieldCardinality
+	for i := range t.shards {
+		fields = t.shards[i].appendResult(fields)
+	}
+	return newCardinalityResult(fields, opts)
+}
+
+func (s *cardinalityTrackerShard) appendResult(
+	fields []FieldCardinality,
+) []FieldCardinality {
+	s.RLock()
+	defer s.RUnlock()
+
+	for name, field := range s.fields {
+		values := make([]ValueCardinality, 0, len(field.heaviest))
+		for _, value := range field.heaviest {
+			values = append(values, ValueCardinality{
+				Value:  []byte(value.value),
+				Series: value.series.Clone(),
+			})
+		}
+		fields = append(fields, FieldCardinality{
+			Field:     []byte(name),
+			Series:    field.series.Clone(),
+			Values:    field.values.Clone(),
+			TopValues: values,
+		})
+	}
+	return fields
+}
+
+// MergeCardinalityResults merges cardinality results, for instance from
+// several hosts, into a single result constrained by the given options.
+func MergeCardinalityResults(
+	results []CardinalityResult,
+	opts CardinalityOptions,
+) (CardinalityResult, error) {
+	var (
+		fields  []FieldCardinality
+		indexes = make(map[string]int)
+	)
+	for _, result := range results {
+		for _, field := range result.Fields {
+			idx, ok := indexes[string(field.Field)]
+			if !ok {
+				indexes[string(field.Field)] = len(fields)
+				fields = append(fields, FieldCardinality{
+					Field:     field.Field,
+					Series:    field.Series.Clone(),
+					Values:    field.Values.Clone(),
+					TopValues: cloneValueCardinalities(field.TopValues),
+				})
+				continue
+			}
+
+			merged := &fields[idx]
+			if err := merged.Series.Merge(field.Series); err != nil {
+				return CardinalityResult{}, err
+			}
+			if err := merged.Values.Merge(field.Values); err != nil {
+				return CardinalityResult{}, err
+			}
+			values, err := mergeValueCardinalities(merged.TopValues, field.TopValues)
+			if err != nil {
+				return CardinalityResult{}, err
+			}
+			merged.TopValues = values
+		}
+	}
+
+	return newCardinalityResult(fields, opts), nil
+}
+
+func cloneValueCardinalities(values []ValueCardinality) []ValueCardinality {
+	cloned := make([]ValueCardinality, 0, len(values))
+	for _, value := range values {
+		cloned = append(cloned, ValueCardinality{
+			Value:  value.Value,
+			Series: value.Series.Clone(),
+		})
+	}
+	return cloned
+}
+
+func mergeValueCardinalities(
+	merged []ValueCardinality,
+	values []ValueCardinality,
+) ([]ValueCardinality, error) {
+	indexes := make(map[string]int, len(merged))
+	for i, value := range merged {
+		indexes[string(value.Value)] = i
+	}
+	for _, value := range values {
+		idx, ok := indexes[string(value.Value)]
+		if !ok {
+			indexes[string(value.Value)] = len(merged)
+			merged = append(merged, ValueCardinality{
+				Value:  value.Value,
+				Series: value.Series.Clone(),
+			})
+			continue
+		}
+		if err := merged[idx].Series.Merge(value.Series); err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
+
+func newCardinalityResult(
+	fields []FieldCardinality,
+	opts CardinalityOptions,
+) CardinalityResult {
+	sortFieldCardinalities(fields)
+	if opts.FieldsLimit > 0 && len(fields) > opts.FieldsLimit {
+		fields = fields[:opts.FieldsLimit]
+	}
+	for i := range fields {
+		values := fields[i].TopValues
+		sortValueCardinalities(values)
+		if opts.ValuesLimit > 0 && len(values) > opts.ValuesLimit {
+			values = values[:opts.ValuesLimit]
+		}
+		fields[i].TopValues = values
+	}
+	return CardinalityResult{Fields: fields}
+}
+
+func sortFieldCardinalities(fields []FieldCardinality) {
+	estimates := make(map[string]uint64, len(fields))
+	for _, field := range fields {
+		estimates[string(field.Field)] = field.Series.Estimate()
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		ei, ej := estimates[string(fields[i].Field)], estimates[string(fields[j].Field)]
+		if ei != ej {
+			return ei > ej
+		}
+		return bytes.Compare(fields[i].Field, fields[j].Field) < 0
+	})
+}
+
+func sortValueCardinalities(values []ValueCardinality) {
+	estimates := make(map[string]uint64, len(values))
+	for _, value := range values {
+		estimates[string(value.Value)] = value.Series.Estimate()
+	}
+	sort.Slice(values, func(i, j int) bool {
+		ei, ej := estimates[string(values[i].Value)], estimates[string(values[j].Value)]
+		if ei != ej {
+			return ei > ej
+		}
+		return bytes.Compare(values[i].Value, values[j].Value) < 0
+	})
+}
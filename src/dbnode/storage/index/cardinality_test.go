@@ -0,0 +1,216 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/m3db/m3/src/m3ninx/doc"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newCardinalityTestDoc(id string, fields ...string) doc.Document {
+	d := doc.Document{ID: []byte(id)}
+	for i := 0; i < len(fields); i += 2 {
+		d.Fields = append(d.Fields, doc.Field{
+			Name:  []byte(fields[i]),
+			Value: []byte(fields[i+1]),
+		})
+	}
+	return d
+}
+
+func requireCardinalityField(
+	t *testing.T,
+	field FieldCardinality,
+	name string,
+	series, values uint64,
+) {
+	require.Equal(t, name, string(field.Field))
+	require.Equal(t, series, field.Series.Estimate())
+	require.Equal(t, values, field.Values.Estimate())
+}
+
+func TestCardinalityTrackerResult(t *testing.T) {
+	tracker := NewCardinalityTracker()
+	for i := 0; i < 100; i++ {
+		tracker.Add(newCardinalityTestDoc(fmt.Sprintf("series-%d", i),
+			"host", fmt.Sprintf("host-%d", i),
+			"dc", fmt.Sprintf("dc-%d", i%2)))
+	}
+	for i := 0; i < 10; i++ {
+		tracker.Add(newCardinalityTestDoc(fmt.Sprintf("other-%d", i),
+			"dc", "dc-0"))
+	}
+	// Adding the same series again must not change the result.
+	tracker.Add(newCardinalityTestDoc("series-0", "host", "host-0", "dc", "dc-0"))
+
+	result := tracker.Result(CardinalityOptions{})
+	require.Len(t, result.Fields, 2)
+	requireCardinalityField(t, result.Fields[0], "dc", 110, 2)
+	requireCardinalityField(t, result.Fields[1], "host", 100, 100)
+
+	values := result.Fields[0].TopValues
+	require.Len(t, values, 2)
+	require.Equal(t, "dc-0", string(values[0].Value))
+	require.Equal(t, uint64(60), values[0].Series.Estimate())
+	require.Equal(t, "dc-1", string(values[1].Value))
+	require.Equal(t, uint64(50), values[1].Series.Estimate())
+	require.Len(t, result.Fields[1].TopValues, 100)
+
+	limited := tracker.Result(CardinalityOptions{FieldsLimit: 1, ValuesLimit: 1})
+	require.Len(t, limited.Fields, 1)
+	requireCardinalityField(t, limited.Fields[0], "dc", 110, 2)
+	require.Len(t, limited.Fields[0].TopValues, 1)
+	require.Equal(t, "dc-0", string(limited.Fields[0].TopValues[0].Value))
+}
+
+func TestCardinalityTrackerMaxTrackedValues(t *testing.T) {
+	tracker := NewCardinalityTracker()
+	for i := 0; i < maxTrackedValuesPerField+10; i++ {
+		tracker.Add(newCardinalityTestDoc(fmt.Sprintf("series-%d", i),
+			"id", fmt.Sprintf("id-%d", i)))
+	}
+
+	result := tracker.Result(CardinalityOptions{})
+	require.Len(t, result.Fields, 1)
+	require.Len(t, result.Fields[0].TopValues, maxTrackedValuesPerField)
+}
+
+func TestCardinalityTrackerHeavyHitters(t *testing.T) {
+	tracker := NewCardinalityTracker()
+	for i := 0; i < maxTrackedValuesPerField; i++ {
+		tracker.Add(newCardinalityTestDoc(fmt.Sprintf("series-%d", i),
+			"id", fmt.Sprintf("id-%d", i)))
+	}
+	// A value seen after every tracked slot is taken must still be tracked
+	// once it becomes a heavy hitter.
+	for i := 0; i < 100; i++ {
+		tracker.Add(newCardinalityTestDoc(fmt.Sprintf("hot-%d", i), "id", "hot"))
+	}
+
+	result := tracker.Result(CardinalityOptions{ValuesLimit: 1})
+	require.Len(t, result.Fields, 1)
+	require.Len(t, result.Fields[0].TopValues, 1)
+	require.Equal(t, "hot", string(result.Fields[0].TopValues[0].Value))
+	require.InDelta(t, 100, float64(result.Fields[0].TopValues[0].Series.Estimate()), 2)
+
+	// Merging a heavier value into a full tracker evicts the lightest value.
+	other := NewCardinalityTracker()
+	for i := 0; i < 200; i++ {
+		other.Add(newCardinalityTestDoc(fmt.Sprintf("hotter-%d", i), "id", "hotter"))
+	}
+	require.NoError(t, tracker.Merge(other))
+
+	result = tracker.Result(CardinalityOptions{ValuesLimit: 2})
+	values := result.Fields[0].TopValues
+	require.Len(t, values, 2)
+	require.Equal(t, "hotter", string(values[0].Value))
+	require.InDelta(t, 200, float64(values[0].Series.Estimate()), 4)
+	require.Equal(t, "hot", string(values[1].Value))
+	require.Len(t, tracker.Result(CardinalityOptions{}).Fields[0].TopValues,
+		maxTrackedValuesPerField)
+}
+
+func TestCardinalityTrackerMerge(t *testing.T) {
+	a := NewCardinalityTracker()
+	b := NewCardinalityTracker()
+	for i := 0; i < 20; i++ {
+		a.Add(newCardinalityTestDoc(fmt.Sprintf("series-%d", i), "city", "nyc"))
+	}
+	for i := 10; i < 40; i++ {
+		b.Add(newCardinalityTestDoc(fmt.Sprintf("series-%d", i), "city", "sf"))
+	}
+
+	merged := NewCardinalityTracker()
+	require.NoError(t, merged.Merge(a))
+	require.NoError(t, merged.Merge(b))
+
+	result := merged.Result(CardinalityOptions{})
+	require.Len(t, result.Fields, 1)
+	requireCardinalityField(t, result.Fields[0], "city", 40, 2)
+	values := result.Fields[0].TopValues
+	require.Len(t, values, 2)
+	require.Equal(t, "sf", string(values[0].Value))
+	require.Equal(t, uint64(30), values[0].Series.Estimate())
+	require.Equal(t, "nyc", string(values[1].Value))
+	require.Equal(t, uint64(20), values[1].Series.Estimate())
+
+	// Merged sources are left untouched.
+	requireCardinalityField(t, a.Result(CardinalityOptions{}).Fields[0], "city", 20, 1)
+}
+
+func TestMergeCardinalityResults(t *testing.T) {
+	a := NewCardinalityTracker()
+	b := NewCardinalityTracker()
+	for i := 0; i < 30; i++ {
+		a.Add(newCardinalityTestDoc(fmt.Sprintf("series-%d", i),
+			"city", "nyc", "host", fmt.Sprintf("host-%d", i)))
+	}
+	for i := 0; i < 10; i++ {
+		b.Add(newCardinalityTestDoc(fmt.Sprintf("series-%d", i),
+			"city", "nyc", "zone", "a"))
+	}
+
+	result, err := MergeCardinalityResults([]CardinalityResult{
+		a.Result(CardinalityOptions{}),
+		b.Result(CardinalityOptions{}),
+	}, CardinalityOptions{FieldsLimit: 2})
+	require.NoError(t, err)
+	require.Len(t, result.Fields, 2)
+	requireCardinalityField(t, result.Fields[0], "city", 30, 1)
+	requireCardinalityField(t, result.Fields[1], "host", 30, 30)
+	require.Equal(t, uint64(30), result.Fields[0].TopValues[0].Series.Estimate())
+}
+
+func TestMergeCardinalityResultsHostOptions(t *testing.T) {
+	a := NewCardinalityTracker()
+	b := NewCardinalityTracker()
+	for i := 0; i < 10; i++ {
+		a.Add(newCardinalityTestDoc(fmt.Sprintf("a-%d", i), "x", "x"))
+		b.Add(newCardinalityTestDoc(fmt.Sprintf("b-%d", i), "z", "z"))
+	}
+	for i := 0; i < 9; i++ {
+		a.Add(newCardinalityTestDoc(fmt.Sprintf("a-%d", i), "y", "a"))
+		b.Add(newCardinalityTestDoc(fmt.Sprintf("b-%d", i), "y", "b"))
+	}
+
+	// The field ranked second on every host has the most series once the
+	// results of the hosts are merged.
+	opts := CardinalityOptions{FieldsLimit: 1, ValuesLimit: 1}
+	hostOpts := opts.HostOptions()
+	require.True(t, hostOpts.FieldsLimit > opts.FieldsLimit)
+	require.True(t, hostOpts.ValuesLimit > opts.ValuesLimit)
+	require.Equal(t, CardinalityOptions{}, CardinalityOptions{}.HostOptions())
+	require.Equal(t, maxTrackedValuesPerField,
+		CardinalityOptions{ValuesLimit: maxTrackedValuesPerField}.HostOptions().ValuesLimit)
+
+	result, err := MergeCardinalityResults([]CardinalityResult{
+		a.Result(hostOpts),
+		b.Result(hostOpts),
+	}, opts)
+	require.NoError(t, err)
+	require.Len(t, result.Fields, 1)
+	requireCardinalityField(t, result.Fields[0], "y", 18, 2)
+	require.Len(t, result.Fields[0].TopValues, 1)
+}
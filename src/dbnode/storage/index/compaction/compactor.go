@@ -101,10 +101,12 @@ func NewCompactor(
 // converted into an FST segment, otherwise an intermediary mutable segment
 // (reused by the compactor between runs) is used to combine all the segments
 // together first before compacting into an FST segment.
+// Documents not contained by the filter, if one is set, are dropped.
 // Note: This is not thread safe and only a single compaction may happen at a
 // time.
 func (c *Compactor) Compact(
 	segs []segment.Segment,
+	keep segment.DocumentsFilter,
 	reporterOptions mmap.ReporterOptions,
 ) (segment.Segment, error) {
 	c.Lock()
@@ -115,6 +117,7 @@ func (c *Compactor) Compact(
 	}
 
 	c.builder.Reset(0)
+	c.builder.SetFilter(keep)
 	if err := c.builder.AddSegments(segs); err != nil {
 		return nil, err
 	}
@@ -259,6 +259,22 @@ func TagsFromTagsIter(
 	return tags, nil
 }
 
+// CloneDocument returns a copy of the provided document which does not
+// share any bytes with it.
+func CloneDocument(d doc.Document) doc.Document {
+	fields := make([]doc.Field, 0, len(d.Fields))
+	for _, f := range d.Fields {
+		fields = append(fields, doc.Field{
+			Name:  append([]byte(nil), f.Name...),
+			Value: append([]byte(nil), f.Value...),
+		})
+	}
+	return doc.Document{
+		ID:     append([]byte(nil), d.ID...),
+		Fields: fields,
+	}
+}
+
 // NB(prateek): we take an independent copy of the bytes underlying
 // any ids provided, as we need to maintain the lifecycle of the indexed
 // bytes separately from the rest of the storage subsystem.
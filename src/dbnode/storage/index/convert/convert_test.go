@@ -131,6 +131,24 @@ func TestToMetricValid(t *testing.T) {
 		ident.MustNewTagStringsIterator("bar", "baz", "some", "others")).Matches(tags))
 }
 
+func TestCloneDocument(t *testing.T) {
+	d := doc.Document{
+		ID: []byte("foo"),
+		Fields: []doc.Field{
+			doc.Field{Name: []byte("bar"), Value: []byte("baz")},
+		},
+	}
+	cloned := convert.CloneDocument(d)
+	assert.Equal(t, d, cloned)
+
+	d.ID[0] = 'g'
+	d.Fields[0].Name[0] = 'c'
+	d.Fields[0].Value[0] = 'q'
+	assert.Equal(t, "foo", string(cloned.ID))
+	assert.Equal(t, "bar", string(cloned.Fields[0].Name))
+	assert.Equal(t, "baz", string(cloned.Fields[0].Value))
+}
+
 func TestTagsFromTagsIter(t *testing.T) {
 	var (
 		id           = ident.StringID("foo")
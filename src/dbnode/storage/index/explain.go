@@ -0,0 +1,76 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/m3ninx/search"
+)
+
+// QueryExplanation describes how a query was evaluated against each of the
+// index blocks it was executed over. Blocks are queried concurrently so
+// blocks must be added with AddBlock.
+type QueryExplanation struct {
+	Query  string             `json:"query"`
+	Blocks []BlockExplanation `json:"blocks"`
+
+	mu sync.Mutex
+}
+
+// BlockExplanation describes how a query was evaluated against the segments
+// of an index block, the host is only set once the explanations of the
+// hosts that executed the query are merged by a client.
+type BlockExplanation struct {
+	Host       string                      `json:"host,omitempty"`
+	BlockStart time.Time                   `json:"blockStart"`
+	Duration   time.Duration               `json:"duration"`
+	Segments   []search.SegmentExplanation `json:"segments"`
+}
+
+// AddBlock adds the explanation of a block, blocks are kept sorted by block
+// start and then host.
+func (e *QueryExplanation) AddBlock(block BlockExplanation) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.Blocks = append(e.Blocks, block)
+	sort.SliceStable(e.Blocks, func(i, j int) bool {
+		if !e.Blocks[i].BlockStart.Equal(e.Blocks[j].BlockStart) {
+			return e.Blocks[i].BlockStart.Before(e.Blocks[j].BlockStart)
+		}
+		return e.Blocks[i].Host < e.Blocks[j].Host
+	})
+}
+
+// MarshalJSON marshals the explanation, blocks may still be added while it
+// is marshalled if the query timed out before all blocks were queried.
+func (e *QueryExplanation) MarshalJSON() ([]byte, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// Marshal as a distinct type to avoid recursing into MarshalJSON.
+	type queryExplanation QueryExplanation
+	return json.Marshal((*queryExplanation)(e))
+}
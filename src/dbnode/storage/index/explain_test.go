@@ -0,0 +1,75 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/m3ninx/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryExplanationAddBlock(t *testing.T) {
+	var (
+		now         = time.Now().Truncate(time.Hour)
+		explanation = &QueryExplanation{Query: "term(foo, bar)"}
+	)
+	explanation.AddBlock(BlockExplanation{Host: "b", BlockStart: now})
+	explanation.AddBlock(BlockExplanation{Host: "a", BlockStart: now.Add(time.Hour)})
+	explanation.AddBlock(BlockExplanation{Host: "a", BlockStart: now})
+
+	require.Equal(t, []BlockExplanation{
+		{Host: "a", BlockStart: now},
+		{Host: "b", BlockStart: now},
+		{Host: "a", BlockStart: now.Add(time.Hour)},
+	}, explanation.Blocks)
+}
+
+func TestQueryExplanationJSONRoundTrip(t *testing.T) {
+	now := time.Unix(1600000000, 0).UTC()
+	explanation := &QueryExplanation{Query: "term(foo, bar)"}
+	explanation.AddBlock(BlockExplanation{
+		BlockStart: now,
+		Duration:   time.Millisecond,
+		Segments: []search.SegmentExplanation{
+			{
+				Query: search.QueryExplanation{
+					Query:        "term(foo, bar)",
+					PostingsSize: 3,
+					Duration:     time.Microsecond,
+				},
+				CacheHits:   1,
+				CacheMisses: 2,
+			},
+		},
+	})
+
+	data, err := json.Marshal(explanation)
+	require.NoError(t, err)
+
+	var decoded QueryExplanation
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, explanation.Query, decoded.Query)
+	require.Equal(t, explanation.Blocks, decoded.Blocks)
+}
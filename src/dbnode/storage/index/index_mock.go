@@ -729,6 +729,44 @@ func (mr *MockBlockMockRecorder) AddResults(resultsByVolumeType interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddResults", reflect.TypeOf((*MockBlock)(nil).AddResults), resultsByVolumeType)
 }
 
+// RemoveDocuments mocks base method
+func (m *MockBlock) RemoveDocuments(ids [][]byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveDocuments", ids)
+}
+
+// RemoveDocuments indicates an expected call of RemoveDocuments
+func (mr *MockBlockMockRecorder) RemoveDocuments(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDocuments", reflect.TypeOf((*MockBlock)(nil).RemoveDocuments), ids)
+}
+
+// RestoreDocuments mocks base method
+func (m *MockBlock) RestoreDocuments(ids [][]byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RestoreDocuments", ids)
+}
+
+// RestoreDocuments indicates an expected call of RestoreDocuments
+func (mr *MockBlockMockRecorder) RestoreDocuments(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDocuments", reflect.TypeOf((*MockBlock)(nil).RestoreDocuments), ids)
+}
+
+// WarmPostingsListCache mocks base method
+func (m *MockBlock) WarmPostingsListCache(query Query) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WarmPostingsListCache", query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WarmPostingsListCache indicates an expected call of WarmPostingsListCache
+func (mr *MockBlockMockRecorder) WarmPostingsListCache(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarmPostingsListCache", reflect.TypeOf((*MockBlock)(nil).WarmPostingsListCache), query)
+}
+
 // Tick mocks base method
 func (m *MockBlock) Tick(c context.Cancellable) (BlockTickResult, error) {
 	m.ctrl.T.Helper()
@@ -758,6 +796,20 @@ func (mr *MockBlockMockRecorder) Stats(reporter interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockBlock)(nil).Stats), reporter)
 }
 
+// Cardinality mocks base method
+func (m *MockBlock) Cardinality(tracker *CardinalityTracker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", tracker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockBlockMockRecorder) Cardinality(tracker interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockBlock)(nil).Cardinality), tracker)
+}
+
 // Seal mocks base method
 func (m *MockBlock) Seal() error {
 	m.ctrl.T.Helper()
@@ -829,6 +881,20 @@ func (mr *MockBlockMockRecorder) MemorySegmentsData(ctx interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemorySegmentsData", reflect.TypeOf((*MockBlock)(nil).MemorySegmentsData), ctx)
 }
 
+// AddMutableSegmentsDocuments mocks base method
+func (m *MockBlock) AddMutableSegmentsDocuments(builder segment.DocumentsBuilder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMutableSegmentsDocuments", builder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMutableSegmentsDocuments indicates an expected call of AddMutableSegmentsDocuments
+func (mr *MockBlockMockRecorder) AddMutableSegmentsDocuments(builder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMutableSegmentsDocuments", reflect.TypeOf((*MockBlock)(nil).AddMutableSegmentsDocuments), builder)
+}
+
 // Close mocks base method
 func (m *MockBlock) Close() error {
 	m.ctrl.T.Helper()
@@ -1596,3 +1662,31 @@ func (mr *MockOptionsMockRecorder) QueryStats() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStats", reflect.TypeOf((*MockOptions)(nil).QueryStats))
 }
+
+// SetCardinalityTrackingEnabled mocks base method
+func (m *MockOptions) SetCardinalityTrackingEnabled(value bool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardinalityTrackingEnabled", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetCardinalityTrackingEnabled indicates an expected call of SetCardinalityTrackingEnabled
+func (mr *MockOptionsMockRecorder) SetCardinalityTrackingEnabled(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardinalityTrackingEnabled", reflect.TypeOf((*MockOptions)(nil).SetCardinalityTrackingEnabled), value)
+}
+
+// CardinalityTrackingEnabled mocks base method
+func (m *MockOptions) CardinalityTrackingEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityTrackingEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CardinalityTrackingEnabled indicates an expected call of CardinalityTrackingEnabled
+func (mr *MockOptionsMockRecorder) CardinalityTrackingEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityTrackingEnabled", reflect.TypeOf((*MockOptions)(nil).CardinalityTrackingEnabled))
+}
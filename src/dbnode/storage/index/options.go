@@ -125,6 +125,7 @@ type opts struct {
 	readThroughSegmentOptions       ReadThroughSegmentOptions
 	mmapReporter                    mmap.Reporter
 	queryStats                      stats.QueryStats
+	cardinalityTrackingEnabled      bool
 }
 
 var undefinedUUIDFn = func() ([]byte, error) { return nil, errIDGenerationDisabled }
@@ -431,3 +432,13 @@ func (o *opts) SetQueryStats(value stats.QueryStats) Options {
 func (o *opts) QueryStats() stats.QueryStats {
 	return o.queryStats
 }
+
+func (o *opts) SetCardinalityTrackingEnabled(value bool) Options {
+	opts := *o
+	opts.cardinalityTrackingEnabled = value
+	return &opts
+}
+
+func (o *opts) CardinalityTrackingEnabled() bool {
+	return o.cardinalityTrackingEnabled
+}
@@ -24,11 +24,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/m3ninx/postings"
+	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
 
 	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
+	"go.uber.org/zap"
 )
 
 // PatternType is an enum for the various pattern types. It allows us
@@ -45,6 +48,8 @@ const (
 	PatternTypeTerm
 	// PatternTypeField indicates that the pattern is of type field.
 	PatternTypeField
+	// PatternTypeSearch indicates that the pattern is a whole search query.
+	PatternTypeSearch
 
 	reportLoopInterval = 10 * time.Second
 	emptyPattern       = ""
@@ -53,17 +58,28 @@ const (
 // PostingsListCacheOptions is the options struct for the query cache.
 type PostingsListCacheOptions struct {
 	InstrumentOptions instrument.Options
+
+	// HotQueriesSize is the number of most recent distinct queries recorded
+	// per namespace that the cache is pre-warmed with after bootstrapping,
+	// zero disables recording queries.
+	HotQueriesSize int
+
+	// HotQueriesFilePath is the path the hot queries are persisted to so that
+	// they survive restarts, if empty the hot queries are not persisted.
+	HotQueriesFilePath string
 }
 
 // PostingsListCache implements an LRU for caching queries and their results.
 type PostingsListCache struct {
 	sync.Mutex
 
-	lru *postingsListLRU
+	lru        *postingsListLRU
+	hotQueries *hotQueries
 
 	size    int
 	opts    PostingsListCacheOptions
 	metrics *postingsListCacheMetrics
+	logger  *zap.Logger
 }
 
 // NewPostingsListCache creates a new query cache.
@@ -78,6 +94,19 @@ func NewPostingsListCache(size int, opts PostingsListCacheOptions) (*PostingsLis
 		size:    size,
 		opts:    opts,
 		metrics: newPostingsListCacheMetrics(opts.InstrumentOptions.MetricsScope()),
+		logger:  opts.InstrumentOptions.Logger(),
+	}
+
+	if opts.HotQueriesSize > 0 {
+		plc.hotQueries = newHotQueries(opts.HotQueriesSize, opts.HotQueriesFilePath)
+		if opts.HotQueriesFilePath != "" {
+			// The hot queries only pre-warm the cache, so failing to load them
+			// should not prevent the cache from being used.
+			if err := plc.hotQueries.load(); err != nil {
+				plc.logger.Warn("could not load postings list cache hot queries",
+					zap.String("path", opts.HotQueriesFilePath), zap.Error(err))
+			}
+		}
 	}
 
 	closer := plc.startReportLoop()
@@ -110,6 +139,14 @@ func (q *PostingsListCache) GetField(
 	return q.get(segmentUUID, field, emptyPattern, PatternTypeField)
 }
 
+// GetSearch returns the cached results for the provided search query, if any.
+func (q *PostingsListCache) GetSearch(
+	segmentUUID uuid.UUID,
+	query string,
+) (postings.List, bool) {
+	return q.get(segmentUUID, emptyPattern, query, PatternTypeSearch)
+}
+
 func (q *PostingsListCache) get(
 	segmentUUID uuid.UUID,
 	field string,
@@ -159,6 +196,15 @@ func (q *PostingsListCache) PutField(
 	q.put(segmentUUID, field, emptyPattern, PatternTypeField, pl)
 }
 
+// PutSearch updates the LRU with the result of the search query.
+func (q *PostingsListCache) PutSearch(
+	segmentUUID uuid.UUID,
+	query string,
+	pl postings.List,
+) {
+	q.put(segmentUUID, emptyPattern, query, PatternTypeSearch, pl)
+}
+
 func (q *PostingsListCache) put(
 	segmentUUID uuid.UUID,
 	field string,
@@ -178,6 +224,34 @@ func (q *PostingsListCache) put(
 	q.emitCachePutMetrics(patternType)
 }
 
+// RecordQuery records the query as the most recent query of the namespace,
+// the most recent queries are persisted so that the cache can be pre-warmed
+// with them after a restart.
+func (q *PostingsListCache) RecordQuery(namespace ident.ID, query idx.Query) {
+	if q.hotQueries == nil {
+		return
+	}
+	q.hotQueries.record(namespace.String(), query)
+}
+
+// HotQueries returns the most recent queries recorded for the namespace,
+// including those persisted before a restart, from most to least recent.
+func (q *PostingsListCache) HotQueries(namespace ident.ID) []idx.Query {
+	if q.hotQueries == nil {
+		return nil
+	}
+	return q.hotQueries.queries(namespace.String())
+}
+
+// PersistHotQueries persists the hot queries if any have been recorded since
+// they were last persisted.
+func (q *PostingsListCache) PersistHotQueries() error {
+	if q.hotQueries == nil || q.opts.HotQueriesFilePath == "" {
+		return nil
+	}
+	return q.hotQueries.persist()
+}
+
 // PurgeSegment removes all postings lists associated with the specified
 // segment from the cache.
 func (q *PostingsListCache) PurgeSegment(segmentUUID uuid.UUID) {
@@ -187,8 +261,8 @@ func (q *PostingsListCache) PurgeSegment(segmentUUID uuid.UUID) {
 }
 
 // startReportLoop starts a background process that will call Report()
-// on a regular basis and returns a function that will end the background
-// process.
+// and persist the hot queries on a regular basis and returns a function
+// that will end the background process.
 func (q *PostingsListCache) startReportLoop() Closer {
 	doneCh := make(chan struct{})
 
@@ -201,11 +275,22 @@ func (q *PostingsListCache) startReportLoop() Closer {
 			}
 
 			q.Report()
+			q.persistHotQueries()
 			time.Sleep(reportLoopInterval)
 		}
 	}()
 
-	return func() { close(doneCh) }
+	return func() {
+		close(doneCh)
+		q.persistHotQueries()
+	}
+}
+
+func (q *PostingsListCache) persistHotQueries() {
+	if err := q.PersistHotQueries(); err != nil {
+		q.logger.Error("could not persist postings list cache hot queries",
+			zap.String("path", q.opts.HotQueriesFilePath), zap.Error(err))
+	}
 }
 
 // Report will emit metrics about the status of the cache.
@@ -233,6 +318,8 @@ func (q *PostingsListCache) emitCacheGetMetrics(patternType PatternType, hit boo
 		method = q.metrics.term
 	case PatternTypeField:
 		method = q.metrics.field
+	case PatternTypeSearch:
+		method = q.metrics.search
 	default:
 		method = q.metrics.unknown // should never happen
 	}
@@ -251,6 +338,8 @@ func (q *PostingsListCache) emitCachePutMetrics(patternType PatternType) {
 		q.metrics.term.puts.Inc(1)
 	case PatternTypeField:
 		q.metrics.field.puts.Inc(1)
+	case PatternTypeSearch:
+		q.metrics.search.puts.Inc(1)
 	default:
 		q.metrics.unknown.puts.Inc(1) // should never happen
 	}
@@ -260,6 +349,7 @@ type postingsListCacheMetrics struct {
 	regexp  *postingsListCacheMethodMetrics
 	term    *postingsListCacheMethodMetrics
 	field   *postingsListCacheMethodMetrics
+	search  *postingsListCacheMethodMetrics
 	unknown *postingsListCacheMethodMetrics
 
 	size     tally.Gauge
@@ -277,6 +367,9 @@ func newPostingsListCacheMetrics(scope tally.Scope) *postingsListCacheMetrics {
 		field: newPostingsListCacheMethodMetrics(scope.Tagged(map[string]string{
 			"query_type": "field",
 		})),
+		search: newPostingsListCacheMethodMetrics(scope.Tagged(map[string]string{
+			"query_type": "search",
+		})),
 		unknown: newPostingsListCacheMethodMetrics(scope.Tagged(map[string]string{
 			"query_type": "unknown",
 		})),
@@ -0,0 +1,238 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"container/list"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/m3db/m3/src/m3ninx/idx"
+)
+
+const (
+	hotQueriesTempFileSuffix = ".tmp"
+	hotQueriesFileMode       = os.FileMode(0644)
+	hotQueriesDirMode        = os.ModeDir | os.FileMode(0755)
+)
+
+// hotQueries records the most recent distinct queries executed against each
+// namespace so that they can be persisted and used to pre-warm the postings
+// list cache after a restart. Each namespace keeps its own LRU of queries so
+// that a busy namespace does not evict the queries of the others.
+type hotQueries struct {
+	sync.Mutex
+
+	size        int
+	filePath    string
+	byNamespace map[string]*hotNamespaceQueries
+	dirty       bool
+
+	// writeLock serializes writes of the file since they share the same
+	// temporary file.
+	writeLock sync.Mutex
+}
+
+type hotNamespaceQueries struct {
+	evictList *list.List
+	items     map[string]*list.Element
+}
+
+type hotQuery struct {
+	key   string
+	query idx.Query
+}
+
+// hotQueriesFile is the persisted form of the hot queries, each query is
+// encoded using the index query codec and ordered from most to least recent.
+type hotQueriesFile struct {
+	Namespaces map[string][][]byte `json:"namespaces"`
+}
+
+func newHotQueries(size int, filePath string) *hotQueries {
+	return &hotQueries{
+		size:        size,
+		filePath:    filePath,
+		byNamespace: make(map[string]*hotNamespaceQueries),
+	}
+}
+
+// record records the query as the most recent query of the namespace.
+func (h *hotQueries) record(namespace string, query idx.Query) {
+	key := query.String()
+
+	h.Lock()
+	defer h.Unlock()
+
+	queries, ok := h.byNamespace[namespace]
+	if !ok {
+		queries = &hotNamespaceQueries{
+			evictList: list.New(),
+			items:     make(map[string]*list.Element),
+		}
+		h.byNamespace[namespace] = queries
+	}
+
+	h.dirty = true
+	if elem, ok := queries.items[key]; ok {
+		queries.evictList.MoveToFront(elem)
+		return
+	}
+
+	queries.items[key] = queries.evictList.PushFront(&hotQuery{
+		key:   key,
+		query: query,
+	})
+	if queries.evictList.Len() > h.size {
+		oldest := queries.evictList.Back()
+		queries.evictList.Remove(oldest)
+		delete(queries.items, oldest.Value.(*hotQuery).key)
+	}
+}
+
+// queries returns the queries of the namespace from most to least recent.
+func (h *hotQueries) queries(namespace string) []idx.Query {
+	h.Lock()
+	defer h.Unlock()
+
+	queries, ok := h.byNamespace[namespace]
+	if !ok {
+		return nil
+	}
+
+	result := make([]idx.Query, 0, queries.evictList.Len())
+	for elem := queries.evictList.Front(); elem != nil; elem = elem.Next() {
+		result = append(result, elem.Value.(*hotQuery).query)
+	}
+	return result
+}
+
+// load reads the queries persisted at the file path, if any.
+func (h *hotQueries) load() error {
+	data, err := ioutil.ReadFile(h.filePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var file hotQueriesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return err
+	}
+
+	for namespace, encoded := range file.Namespaces {
+		// Record from least to most recent so that the order is preserved.
+		for i := len(encoded) - 1; i >= 0; i-- {
+			query, err := idx.Unmarshal(encoded[i])
+			if err != nil {
+				return err
+			}
+			h.record(namespace, query)
+		}
+	}
+
+	h.Lock()
+	h.dirty = false
+	h.Unlock()
+	return nil
+}
+
+// persist writes the queries to the file path if any have been recorded since
+// they were last persisted, the queries are written to a temporary file and
+// renamed so that a partially written file is never read.
+func (h *hotQueries) persist() error {
+	h.Lock()
+	if !h.dirty {
+		h.Unlock()
+		return nil
+	}
+
+	file := hotQueriesFile{
+		Namespaces: make(map[string][][]byte, len(h.byNamespace)),
+	}
+	for namespace, queries := range h.byNamespace {
+		encoded := make([][]byte, 0, queries.evictList.Len())
+		for elem := queries.evictList.Front(); elem != nil; elem = elem.Next() {
+			data, err := idx.Marshal(elem.Value.(*hotQuery).query)
+			if err != nil {
+				h.Unlock()
+				return err
+			}
+			encoded = append(encoded, data)
+		}
+		file.Namespaces[namespace] = encoded
+	}
+	h.dirty = false
+	h.Unlock()
+
+	if err := h.write(file); err != nil {
+		// Mark the queries as dirty so that they are persisted again.
+		h.Lock()
+		h.dirty = true
+		h.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (h *hotQueries) write(file hotQueriesFile) error {
+	data, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(h.filePath)
+	if err := os.MkdirAll(dir, hotQueriesDirMode); err != nil {
+		return err
+	}
+
+	h.writeLock.Lock()
+	defer h.writeLock.Unlock()
+
+	// NB: The file is created with the file mode rather than changed to it
+	// so that the umask of the process applies.
+	tmpPath := h.filePath + hotQueriesTempFileSuffix
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, hotQueriesFileMode)
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, h.filePath)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
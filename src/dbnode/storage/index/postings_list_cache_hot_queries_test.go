@@ -0,0 +1,147 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostingsListCacheHotQueries(t *testing.T) {
+	plCache, stopReporting, err := NewPostingsListCache(1, PostingsListCacheOptions{
+		InstrumentOptions: testPostingListCacheOptions.InstrumentOptions,
+		HotQueriesSize:    2,
+	})
+	require.NoError(t, err)
+	defer stopReporting()
+
+	var (
+		ns1 = ident.StringID("ns1")
+		ns2 = ident.StringID("ns2")
+		q0  = idx.NewTermQuery([]byte("foo"), []byte("bar"))
+		q1  = idx.NewFieldQuery([]byte("foo"))
+		q2  = idx.NewConjunctionQuery(q0, q1)
+	)
+	require.Empty(t, plCache.HotQueries(ns1))
+
+	plCache.RecordQuery(ns1, q0)
+	plCache.RecordQuery(ns1, q1)
+	requireQueriesEqual(t, []idx.Query{q1, q0}, plCache.HotQueries(ns1))
+
+	// Recording an existing query makes it the most recent.
+	plCache.RecordQuery(ns1, q0)
+	requireQueriesEqual(t, []idx.Query{q0, q1}, plCache.HotQueries(ns1))
+
+	// Recording more queries than the size evicts the least recent.
+	plCache.RecordQuery(ns1, q2)
+	requireQueriesEqual(t, []idx.Query{q2, q0}, plCache.HotQueries(ns1))
+
+	// Queries are recorded per namespace.
+	plCache.RecordQuery(ns2, q1)
+	requireQueriesEqual(t, []idx.Query{q1}, plCache.HotQueries(ns2))
+	requireQueriesEqual(t, []idx.Query{q2, q0}, plCache.HotQueries(ns1))
+}
+
+func TestPostingsListCacheHotQueriesDisabled(t *testing.T) {
+	plCache, stopReporting, err := NewPostingsListCache(1, testPostingListCacheOptions)
+	require.NoError(t, err)
+	defer stopReporting()
+
+	ns := ident.StringID("ns")
+	plCache.RecordQuery(ns, idx.NewFieldQuery([]byte("foo")))
+	require.Empty(t, plCache.HotQueries(ns))
+	require.NoError(t, plCache.PersistHotQueries())
+}
+
+func TestPostingsListCacheHotQueriesPersist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hot-queries")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		filePath = filepath.Join(dir, "postings_list_cache", "hot_queries.json")
+		opts     = PostingsListCacheOptions{
+			InstrumentOptions:  testPostingListCacheOptions.InstrumentOptions,
+			HotQueriesSize:     10,
+			HotQueriesFilePath: filePath,
+		}
+		ns = ident.StringID("ns")
+		q0 = idx.NewTermQuery([]byte("foo"), []byte("bar"))
+		q1 = idx.NewConjunctionQuery(q0, idx.NewFieldQuery([]byte("baz")))
+	)
+
+	plCache, stopReporting, err := NewPostingsListCache(1, opts)
+	require.NoError(t, err)
+
+	plCache.RecordQuery(ns, q0)
+	plCache.RecordQuery(ns, q1)
+	require.NoError(t, plCache.PersistHotQueries())
+	stopReporting()
+
+	// The file is not writable by others and no temporary file is left.
+	info, err := os.Stat(filePath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0), info.Mode().Perm()&0022)
+	_, err = os.Stat(filePath + hotQueriesTempFileSuffix)
+	require.True(t, os.IsNotExist(err))
+
+	// The queries should be loaded in the same order after a restart.
+	restarted, stopReporting, err := NewPostingsListCache(1, opts)
+	require.NoError(t, err)
+	defer stopReporting()
+
+	requireQueriesEqual(t, []idx.Query{q1, q0}, restarted.HotQueries(ns))
+}
+
+func TestPostingsListCacheHotQueriesCorruptFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hot-queries")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, "hot_queries.json")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte("not json"), 0666))
+
+	// A corrupt file should not prevent the cache from being created.
+	plCache, stopReporting, err := NewPostingsListCache(1, PostingsListCacheOptions{
+		InstrumentOptions:  testPostingListCacheOptions.InstrumentOptions,
+		HotQueriesSize:     10,
+		HotQueriesFilePath: filePath,
+	})
+	require.NoError(t, err)
+	defer stopReporting()
+
+	require.Empty(t, plCache.HotQueries(ident.StringID("ns")))
+}
+
+func requireQueriesEqual(t *testing.T, expected, actual []idx.Query) {
+	require.Equal(t, len(expected), len(actual))
+	for i := range expected {
+		require.True(t, expected[i].Equal(actual[i]),
+			"expected %s, actual %s", expected[i], actual[i])
+	}
+}
@@ -61,12 +61,15 @@ func init() {
 		pl.Insert(postings.ID(i))
 
 		patternType := PatternTypeRegexp
-		switch i % 3 {
+		switch i % 4 {
 		case 0:
 			patternType = PatternTypeTerm
 		case 1:
 			patternType = PatternTypeField
 			pattern = "" // field queries don't have patterns
+		case 2:
+			patternType = PatternTypeSearch
+			field = "" // search queries don't have fields
 		}
 
 		testPlEntries = append(testPlEntries, testEntry{
@@ -314,6 +317,17 @@ func putEntry(t *testing.T, cache *PostingsListCache, i int) {
 			testPlEntries[i].key.field,
 			testPlEntries[i].postingsList,
 		)
+	case PatternTypeSearch:
+		cache.PutSearch(
+			testPlEntries[i].segmentUUID,
+			testPlEntries[i].key.pattern,
+			testPlEntries[i].postingsList,
+		)
+		cache.PutSearch(
+			testPlEntries[i].segmentUUID,
+			testPlEntries[i].key.pattern,
+			testPlEntries[i].postingsList,
+		)
 	default:
 		require.FailNow(t, "unknown pattern type", testPlEntries[i].key.patternType)
 	}
@@ -338,6 +352,11 @@ func getEntry(t *testing.T, cache *PostingsListCache, i int) (postings.List, boo
 			testPlEntries[i].segmentUUID,
 			testPlEntries[i].key.field,
 		)
+	case PatternTypeSearch:
+		return cache.GetSearch(
+			testPlEntries[i].segmentUUID,
+			testPlEntries[i].key.pattern,
+		)
 	default:
 		require.FailNow(t, "unknown pattern type", testPlEntries[i].key.patternType)
 	}
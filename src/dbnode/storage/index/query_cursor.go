@@ -0,0 +1,68 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"bytes"
+	"errors"
+)
+
+const queryCursorVersion = 3
+
+var errQueryCursorInvalid = errors.New("invalid query cursor")
+
+// QueryCursor is a position within the results of a paginated query. Pages
+// return the series matched by the query in ID order so the cursor is the ID
+// of the last series returned, which is the same position on every replica
+// regardless of how each replica laid out its index, a page resumed from the
+// cursor on any replica returns the series that follow it. The zero value
+// starts a query from the first series.
+type QueryCursor struct {
+	// After is the ID of the last series returned by the previous page.
+	After []byte
+}
+
+// Includes returns whether the series with the given ID comes after the
+// cursor and so belongs to a page resumed from the cursor.
+func (c QueryCursor) Includes(id []byte) bool {
+	return bytes.Compare(id, c.After) > 0
+}
+
+// MarshalBinary encodes the cursor so it can be returned to a client as an
+// opaque page token.
+func (c QueryCursor) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 1+len(c.After))
+	buf = append(buf, queryCursorVersion)
+	return append(buf, c.After...), nil
+}
+
+// UnmarshalBinary decodes a cursor previously encoded with MarshalBinary.
+func (c *QueryCursor) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != queryCursorVersion {
+		return errQueryCursorInvalid
+	}
+
+	*c = QueryCursor{}
+	if len(data) > 1 {
+		c.After = append([]byte(nil), data[1:]...)
+	}
+	return nil
+}
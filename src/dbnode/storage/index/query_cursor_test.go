@@ -0,0 +1,59 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryCursorRoundTrip(t *testing.T) {
+	for _, cursor := range []QueryCursor{
+		{},
+		{After: []byte("foo")},
+	} {
+		data, err := cursor.MarshalBinary()
+		require.NoError(t, err)
+
+		var decoded QueryCursor
+		require.NoError(t, decoded.UnmarshalBinary(data))
+		require.Equal(t, cursor, decoded)
+	}
+}
+
+func TestQueryCursorIncludes(t *testing.T) {
+	require.True(t, QueryCursor{}.Includes([]byte("a")))
+	require.False(t, QueryCursor{After: []byte("b")}.Includes([]byte("a")))
+	require.False(t, QueryCursor{After: []byte("b")}.Includes([]byte("b")))
+	require.True(t, QueryCursor{After: []byte("b")}.Includes([]byte("ba")))
+}
+
+func TestQueryCursorUnmarshalInvalid(t *testing.T) {
+	for _, data := range [][]byte{
+		nil,
+		{},
+		{queryCursorVersion + 1, 'f', 'o', 'o'},
+	} {
+		var cursor QueryCursor
+		require.Equal(t, errQueryCursorInvalid, cursor.UnmarshalBinary(data))
+	}
+}
@@ -25,9 +25,11 @@ import (
 	"sync"
 
 	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/index/segment"
 	"github.com/m3db/m3/src/m3ninx/postings"
+	"github.com/m3db/m3/src/m3ninx/search"
 
 	"github.com/pborman/uuid"
 )
@@ -41,6 +43,13 @@ var (
 // and mmap's can be freed.
 var _ segment.ImmutableSegment = (*ReadThroughSegment)(nil)
 
+// Ensure the read through segment reader can be searched through the cache.
+var _ search.ReadThroughSegmentSearcher = (*readThroughSegmentReader)(nil)
+
+// Ensure the read through segment reader reports its cache usage when
+// queries are explained.
+var _ search.CacheStatsReader = (*readThroughSegmentReader)(nil)
+
 // ReadThroughSegment wraps a segment with a postings list cache so that
 // queries can be transparently cached in a read through manner. In addition,
 // the postings lists returned by the segments may not be safe to use once the
@@ -68,6 +77,8 @@ type ReadThroughSegmentOptions struct {
 	CacheRegexp bool
 	// Whether the postings list for term queries should be cached.
 	CacheTerms bool
+	// Whether the postings list for whole conjunction queries should be cached.
+	CacheSearches bool
 }
 
 // NewReadThroughSegment creates a new read through segment.
@@ -163,6 +174,11 @@ type readThroughSegmentReader struct {
 	opts              ReadThroughSegmentOptions
 	uuid              uuid.UUID
 	postingsListCache *PostingsListCache
+
+	// NB: Readers are not used concurrently so the cache stats do
+	// not need to be synchronized.
+	cacheHits   int
+	cacheMisses int
 }
 
 func newReadThroughSegmentReader(
@@ -194,9 +210,12 @@ func (s *readThroughSegmentReader) MatchRegexp(
 	patternStr := c.FSTSyntax.String()
 	pl, ok := s.postingsListCache.GetRegexp(s.uuid, fieldStr, patternStr)
 	if ok {
+		s.cacheHits++
 		return pl, nil
 	}
 
+	s.cacheMisses++
+
 	pl, err := s.reader.MatchRegexp(field, c)
 	if err == nil {
 		s.postingsListCache.PutRegexp(s.uuid, fieldStr, patternStr, pl)
@@ -218,9 +237,12 @@ func (s *readThroughSegmentReader) MatchTerm(
 	patternStr := string(term)
 	pl, ok := s.postingsListCache.GetTerm(s.uuid, fieldStr, patternStr)
 	if ok {
+		s.cacheHits++
 		return pl, nil
 	}
 
+	s.cacheMisses++
+
 	pl, err := s.reader.MatchTerm(field, term)
 	if err == nil {
 		s.postingsListCache.PutTerm(s.uuid, fieldStr, patternStr, pl)
@@ -239,9 +261,12 @@ func (s *readThroughSegmentReader) MatchField(field []byte) (postings.List, erro
 	fieldStr := string(field)
 	pl, ok := s.postingsListCache.GetField(s.uuid, fieldStr)
 	if ok {
+		s.cacheHits++
 		return pl, nil
 	}
 
+	s.cacheMisses++
+
 	pl, err := s.reader.MatchField(field)
 	if err == nil {
 		s.postingsListCache.PutField(s.uuid, fieldStr, pl)
@@ -249,6 +274,51 @@ func (s *readThroughSegmentReader) MatchField(field []byte) (postings.List, erro
 	return pl, err
 }
 
+// Search returns a cached postings list for conjunction queries or searches
+// the segment if there is a cache miss. Other queries are searched directly
+// since the postings lists of the terms they match are cached individually.
+func (s *readThroughSegmentReader) Search(
+	query search.Query,
+	searcher search.Searcher,
+) (postings.List, error) {
+	if s.postingsListCache == nil || !s.opts.CacheSearches ||
+		!idx.IsConjunctionQuery(query) {
+		return searcher.Search(s)
+	}
+
+	// TODO(rartoul): Would be nice to not allocate strings here.
+	queryStr := query.String()
+	pl, ok := s.postingsListCache.GetSearch(s.uuid, queryStr)
+	if ok {
+		s.cacheHits++
+		return pl, nil
+	}
+
+	s.cacheMisses++
+
+	pl, err := searcher.Search(s)
+	if err == nil {
+		s.postingsListCache.PutSearch(s.uuid, queryStr, pl)
+	}
+	return pl, err
+}
+
+// MatchRange is a pass through call, since ranges are not cached.
+func (s *readThroughSegmentReader) MatchRange(
+	field []byte,
+	r index.TermRange,
+) (postings.List, error) {
+	return s.reader.MatchRange(field, r)
+}
+
+// MatchFuzzy is a pass through call, since fuzzy matches are not cached.
+func (s *readThroughSegmentReader) MatchFuzzy(
+	field []byte,
+	f index.CompiledFuzzy,
+) (postings.List, error) {
+	return s.reader.MatchFuzzy(field, f)
+}
+
 // MatchAll is a pass through call, since there's no postings list to cache.
 // NB(r): The postings list returned by match all is just an iterator
 // from zero to the maximum document number indexed by the segment and as such
@@ -272,6 +342,12 @@ func (s *readThroughSegmentReader) Docs(pl postings.List) (doc.Iterator, error)
 	return s.reader.Docs(pl)
 }
 
+// CacheStats returns the number of postings list cache hits and misses
+// of the reader.
+func (s *readThroughSegmentReader) CacheStats() (int, int) {
+	return s.cacheHits, s.cacheMisses
+}
+
 // Close is a pass through call.
 func (s *readThroughSegmentReader) Close() error {
 	return s.reader.Close()
@@ -24,9 +24,11 @@ import (
 	"regexp/syntax"
 	"testing"
 
+	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst"
 	"github.com/m3db/m3/src/m3ninx/postings/roaring"
+	"github.com/m3db/m3/src/m3ninx/search"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -34,8 +36,9 @@ import (
 
 var (
 	defaultReadThroughSegmentOptions = ReadThroughSegmentOptions{
-		CacheRegexp: true,
-		CacheTerms:  true,
+		CacheRegexp:   true,
+		CacheTerms:    true,
+		CacheSearches: true,
 	}
 )
 
@@ -413,3 +416,173 @@ func TestCloseNoCache(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, readThrough.(*ReadThroughSegment).closed)
 }
+
+func TestReadThroughSegmentSearch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	segment := fst.NewMockSegment(ctrl)
+	reader := index.NewMockReader(ctrl)
+	segment.EXPECT().Reader().Return(reader, nil)
+
+	cache, stopReporting, err := NewPostingsListCache(1, testPostingListCacheOptions)
+	require.NoError(t, err)
+	defer stopReporting()
+
+	var (
+		query = idx.NewConjunctionQuery(
+			idx.NewTermQuery([]byte("foo"), []byte("bar")),
+			idx.NewTermQuery([]byte("baz"), []byte("qux")),
+		).SearchQuery()
+		searcher = search.NewMockSearcher(ctrl)
+
+		originalPL = roaring.NewPostingsList()
+	)
+	require.NoError(t, originalPL.Insert(1))
+
+	readThrough, err := NewReadThroughSegment(
+		segment, cache, defaultReadThroughSegmentOptions).Reader()
+	require.NoError(t, err)
+
+	searcher.EXPECT().Search(readThrough).Return(originalPL, nil)
+
+	// Make sure it searches the segment when the cache misses.
+	pl, err := readThrough.(search.ReadThroughSegmentSearcher).Search(query, searcher)
+	require.NoError(t, err)
+	require.True(t, pl.Equal(originalPL))
+
+	// Make sure it relies on the cache if its present (mock only expects
+	// one call.)
+	pl, err = readThrough.(search.ReadThroughSegmentSearcher).Search(query, searcher)
+	require.NoError(t, err)
+	require.True(t, pl.Equal(originalPL))
+}
+
+func TestReadThroughSegmentSearchNotConjunction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	segment := fst.NewMockSegment(ctrl)
+	reader := index.NewMockReader(ctrl)
+	segment.EXPECT().Reader().Return(reader, nil)
+
+	cache, stopReporting, err := NewPostingsListCache(1, testPostingListCacheOptions)
+	require.NoError(t, err)
+	defer stopReporting()
+
+	var (
+		query    = idx.NewTermQuery([]byte("foo"), []byte("bar")).SearchQuery()
+		searcher = search.NewMockSearcher(ctrl)
+
+		originalPL = roaring.NewPostingsList()
+	)
+	require.NoError(t, originalPL.Insert(1))
+
+	readThrough, err := NewReadThroughSegment(
+		segment, cache, defaultReadThroughSegmentOptions).Reader()
+	require.NoError(t, err)
+
+	searcher.EXPECT().
+		Search(readThrough).
+		Return(originalPL, nil).
+		Times(2)
+
+	// Make sure it searches the segment both times since only conjunction
+	// queries are cached as a whole.
+	pl, err := readThrough.(search.ReadThroughSegmentSearcher).Search(query, searcher)
+	require.NoError(t, err)
+	require.True(t, pl.Equal(originalPL))
+
+	pl, err = readThrough.(search.ReadThroughSegmentSearcher).Search(query, searcher)
+	require.NoError(t, err)
+	require.True(t, pl.Equal(originalPL))
+}
+
+func TestReadThroughSegmentSearchCacheDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	segment := fst.NewMockSegment(ctrl)
+	reader := index.NewMockReader(ctrl)
+	segment.EXPECT().Reader().Return(reader, nil)
+
+	cache, stopReporting, err := NewPostingsListCache(1, testPostingListCacheOptions)
+	require.NoError(t, err)
+	defer stopReporting()
+
+	var (
+		query = idx.NewConjunctionQuery(
+			idx.NewTermQuery([]byte("foo"), []byte("bar")),
+			idx.NewTermQuery([]byte("baz"), []byte("qux")),
+		).SearchQuery()
+		searcher = search.NewMockSearcher(ctrl)
+
+		originalPL = roaring.NewPostingsList()
+	)
+	require.NoError(t, originalPL.Insert(1))
+
+	readThrough, err := NewReadThroughSegment(segment, cache, ReadThroughSegmentOptions{
+		CacheSearches: false,
+	}).Reader()
+	require.NoError(t, err)
+
+	searcher.EXPECT().
+		Search(readThrough).
+		Return(originalPL, nil).
+		Times(2)
+
+	// Make sure it searches the segment both times - meaning the cache was
+	// disabled.
+	pl, err := readThrough.(search.ReadThroughSegmentSearcher).Search(query, searcher)
+	require.NoError(t, err)
+	require.True(t, pl.Equal(originalPL))
+
+	pl, err = readThrough.(search.ReadThroughSegmentSearcher).Search(query, searcher)
+	require.NoError(t, err)
+	require.True(t, pl.Equal(originalPL))
+}
+
+func TestReadThroughSegmentCacheStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	segment := fst.NewMockSegment(ctrl)
+	reader := index.NewMockReader(ctrl)
+	segment.EXPECT().Reader().Return(reader, nil)
+
+	cache, stopReporting, err := NewPostingsListCache(1, testPostingListCacheOptions)
+	require.NoError(t, err)
+	defer stopReporting()
+
+	var (
+		field = []byte("some-field")
+
+		originalPL = roaring.NewPostingsList()
+	)
+	require.NoError(t, originalPL.Insert(1))
+
+	readThrough, err := NewReadThroughSegment(
+		segment, cache, defaultReadThroughSegmentOptions).Reader()
+	require.NoError(t, err)
+
+	stats := readThrough.(search.CacheStatsReader)
+	hits, misses := stats.CacheStats()
+	require.Equal(t, 0, hits)
+	require.Equal(t, 0, misses)
+
+	reader.EXPECT().MatchField(field).Return(originalPL, nil)
+
+	_, err = readThrough.MatchField(field)
+	require.NoError(t, err)
+
+	hits, misses = stats.CacheStats()
+	require.Equal(t, 0, hits)
+	require.Equal(t, 1, misses)
+
+	_, err = readThrough.MatchField(field)
+	require.NoError(t, err)
+
+	hits, misses = stats.CacheStats()
+	require.Equal(t, 1, hits)
+	require.Equal(t, 1, misses)
+}
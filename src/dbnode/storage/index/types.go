@@ -84,6 +84,13 @@ type QueryOptions struct {
 	EndExclusive     time.Time
 	Limit            int
 	IterationOptions IterationOptions
+	// Cursor when set paginates a query, the query returns the series with
+	// the smallest IDs after the cursor, at most Limit of them. Only honored
+	// by Query.
+	Cursor *QueryCursor
+	// Explain when set records how the query was evaluated against each
+	// block, segment and sub-query. Only honored by Query.
+	Explain *QueryExplanation
 }
 
 // IterationOptions enables users to specify iteration preferences.
@@ -108,6 +115,9 @@ type AggregationOptions struct {
 type QueryResult struct {
 	Results    QueryResults
 	Exhaustive bool
+	// NextCursor is the position to resume a paginated query from, it is
+	// nil once all results of the query have been returned.
+	NextCursor *QueryCursor
 }
 
 // AggregateQueryResult is the collection of results for an aggregate query.
@@ -337,12 +347,29 @@ type Block interface {
 	// AddResults adds bootstrap results to the block.
 	AddResults(resultsByVolumeType result.IndexBlockByVolumeType) error
 
+	// RemoveDocuments marks the documents with the given IDs for removal, they
+	// are dropped from the block's in-memory segments when next compacted.
+	RemoveDocuments(ids [][]byte)
+
+	// RestoreDocuments stops the documents with the given IDs from being
+	// removed, such as when their series are written again after a delete.
+	RestoreDocuments(ids [][]byte)
+
+	// WarmPostingsListCache searches the block's bootstrapped segments with the
+	// query so that the postings lists it resolves are cached, without retrieving
+	// any of the documents it matches.
+	WarmPostingsListCache(query Query) error
+
 	// Tick does internal house keeping operations.
 	Tick(c context.Cancellable) (BlockTickResult, error)
 
 	// Stats returns block stats.
 	Stats(reporter BlockStatsReporter) error
 
+	// Cardinality merges the approximate series cardinality tracked by the
+	// block into the provided tracker.
+	Cardinality(tracker *CardinalityTracker) error
+
 	// Seal prevents the block from taking any more writes, but, it still permits
 	// addition of segments via Bootstrap().
 	Seal() error
@@ -365,6 +392,10 @@ type Block interface {
 	// MemorySegmentsData returns all in memory segments data.
 	MemorySegmentsData(ctx context.Context) ([]fst.SegmentData, error)
 
+	// AddMutableSegmentsDocuments inserts a copy of every document held by
+	// the block's mutable segments into the provided builder.
+	AddMutableSegmentsDocuments(builder segment.DocumentsBuilder) error
+
 	// Close will release any held resources and close the Block.
 	Close() error
 }
@@ -917,4 +948,12 @@ type Options interface {
 
 	// QueryStats returns the current query stats.
 	QueryStats() stats.QueryStats
+
+	// SetCardinalityTrackingEnabled sets whether index blocks track approximate
+	// per field and per field value series cardinality.
+	SetCardinalityTrackingEnabled(value bool) Options
+
+	// CardinalityTrackingEnabled returns whether index blocks track approximate
+	// per field and per field value series cardinality.
+	CardinalityTrackingEnabled() bool
 }
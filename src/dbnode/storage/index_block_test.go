@@ -23,6 +23,7 @@ package storage
 import (
 	stdlibctx "context"
 	"fmt"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -38,11 +39,13 @@ import (
 	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/resource"
 	xtest "github.com/m3db/m3/src/x/test"
 	xtime "github.com/m3db/m3/src/x/time"
 
 	"github.com/golang/mock/gomock"
 	opentracing "github.com/opentracing/opentracing-go"
+	opentracinglog "github.com/opentracing/opentracing-go/log"
 	"github.com/opentracing/opentracing-go/mocktracer"
 	"github.com/stretchr/testify/require"
 )
@@ -390,6 +393,78 @@ func TestNamespaceIndexBootstrap(t *testing.T) {
 	require.NoError(t, idx.Bootstrap(bootstrapResults))
 }
 
+func TestNamespaceIndexBootstrapWarmsPostingsListCache(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	blockSize := time.Hour
+	now := time.Now().Truncate(blockSize).Add(2 * time.Minute)
+	t0 := now.Truncate(blockSize)
+	t0Nanos := xtime.ToUnixNano(t0)
+	t1 := t0.Add(1 * blockSize)
+	nowFn := func() time.Time { return now }
+
+	md := testNamespaceMetadata(blockSize, 4*time.Hour)
+	plCache, stopReporting, err := index.NewPostingsListCache(10, index.PostingsListCacheOptions{
+		InstrumentOptions: DefaultTestOptions().InstrumentOptions(),
+		HotQueriesSize:    10,
+	})
+	require.NoError(t, err)
+	defer stopReporting()
+	plCache.RecordQuery(md.ID(), defaultQuery.Query)
+
+	opts := DefaultTestOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(nowFn)).
+		SetIndexOptions(opts.IndexOptions().SetPostingsListCache(plCache))
+
+	b0 := index.NewMockBlock(ctrl)
+	b0.EXPECT().Stats(gomock.Any()).Return(nil).AnyTimes()
+	b0.EXPECT().StartTime().Return(t0).AnyTimes()
+	newBlockFn := func(
+		ts time.Time,
+		md namespace.Metadata,
+		_ index.BlockOptions,
+		io index.Options,
+	) (index.Block, error) {
+		if ts.Equal(t0) {
+			return b0, nil
+		}
+		panic("should never get here")
+	}
+	idx, err := newNamespaceIndexWithNewBlockFn(md, testShardSet, newBlockFn, opts)
+	require.NoError(t, err)
+
+	seg := segment.NewMockSegment(ctrl)
+	t0Results := result.NewIndexBlockByVolumeType(t0)
+	t0Results.SetBlock(idxpersist.DefaultIndexVolumeType, result.NewIndexBlock([]segment.Segment{seg},
+		result.NewShardTimeRangesFromRange(t0, t1, 1, 2, 3)))
+	bootstrapResults := result.IndexResults{
+		t0Nanos: t0Results,
+	}
+
+	// The block should be warmed with the recorded query in the background
+	// once its results have been added, and only after the first bootstrap.
+	warmed := make(chan struct{})
+	gomock.InOrder(
+		b0.EXPECT().AddResults(bootstrapResults[t0Nanos]).Return(nil),
+		b0.EXPECT().WarmPostingsListCache(defaultQuery).
+			DoAndReturn(func(index.Query) error {
+				close(warmed)
+				return nil
+			}),
+	)
+	require.NoError(t, idx.Bootstrap(bootstrapResults))
+
+	select {
+	case <-warmed:
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "postings list cache not warmed")
+	}
+
+	b0.EXPECT().AddResults(bootstrapResults[t0Nanos]).Return(nil)
+	require.NoError(t, idx.Bootstrap(bootstrapResults))
+}
+
 func TestNamespaceIndexTickExpire(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -636,6 +711,146 @@ func TestNamespaceIndexBlockQuery(t *testing.T) {
 	require.Len(t, spans, 11)
 }
 
+func TestNamespaceIndexBlockQueryPage(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	retention := 2 * time.Hour
+	blockSize := time.Hour
+	now := time.Now().Truncate(blockSize).Add(10 * time.Minute)
+	t0 := now.Truncate(blockSize)
+	t0Nanos := xtime.ToUnixNano(t0)
+	t1 := t0.Add(1 * blockSize)
+	t1Nanos := xtime.ToUnixNano(t1)
+	t2 := t1.Add(1 * blockSize)
+	var nowLock sync.Mutex
+	nowFn := func() time.Time {
+		nowLock.Lock()
+		defer nowLock.Unlock()
+		return now
+	}
+	opts := DefaultTestOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(nowFn))
+
+	b0 := index.NewMockBlock(ctrl)
+	b0.EXPECT().Stats(gomock.Any()).Return(nil).AnyTimes()
+	b0.EXPECT().Close().Return(nil)
+	b0.EXPECT().StartTime().Return(t0).AnyTimes()
+	b0.EXPECT().EndTime().Return(t0.Add(blockSize)).AnyTimes()
+	b1 := index.NewMockBlock(ctrl)
+	b1.EXPECT().Stats(gomock.Any()).Return(nil).AnyTimes()
+	b1.EXPECT().Close().Return(nil)
+	b1.EXPECT().StartTime().Return(t1).AnyTimes()
+	b1.EXPECT().EndTime().Return(t1.Add(blockSize)).AnyTimes()
+	newBlockFn := func(
+		ts time.Time,
+		md namespace.Metadata,
+		_ index.BlockOptions,
+		io index.Options,
+	) (index.Block, error) {
+		if ts.Equal(t0) {
+			return b0, nil
+		}
+		if ts.Equal(t1) {
+			return b1, nil
+		}
+		panic("should never get here")
+	}
+	md := testNamespaceMetadata(blockSize, retention)
+	idx, err := newNamespaceIndexWithNewBlockFn(md, testShardSet, newBlockFn, opts)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, idx.Close())
+	}()
+
+	seg1 := segment.NewMockSegment(ctrl)
+	seg2 := segment.NewMockSegment(ctrl)
+	t0Results := result.NewIndexBlockByVolumeType(t0)
+	t0Results.SetBlock(idxpersist.DefaultIndexVolumeType, result.NewIndexBlock([]segment.Segment{seg1},
+		result.NewShardTimeRangesFromRange(t0, t1, 1, 2, 3)))
+	t1Results := result.NewIndexBlockByVolumeType(t1)
+	t1Results.SetBlock(idxpersist.DefaultIndexVolumeType, result.NewIndexBlock([]segment.Segment{seg2},
+		result.NewShardTimeRangesFromRange(t1, t2, 1, 2, 3)))
+	bootstrapResults := result.IndexResults{
+		t0Nanos: t0Results,
+		t1Nanos: t1Results,
+	}
+
+	b0.EXPECT().AddResults(bootstrapResults[t0Nanos]).Return(nil)
+	b1.EXPECT().AddResults(bootstrapResults[t1Nanos]).Return(nil)
+	require.NoError(t, idx.Bootstrap(bootstrapResults))
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+	q := defaultQuery
+
+	addDocs := func(ids ...string) func(
+		context.Context,
+		*resource.CancellableLifetime,
+		index.Query,
+		index.QueryOptions,
+		index.BaseResults,
+		[]opentracinglog.Field,
+	) (bool, error) {
+		return func(
+			_ context.Context,
+			_ *resource.CancellableLifetime,
+			_ index.Query,
+			opts index.QueryOptions,
+			results index.BaseResults,
+			_ []opentracinglog.Field,
+		) (bool, error) {
+			// Blocks are queried without a limit to find the smallest IDs.
+			require.Equal(t, 0, opts.Limit)
+			docs := make([]doc.Document, 0, len(ids))
+			for _, id := range ids {
+				docs = append(docs, doc.Document{ID: []byte(id)})
+			}
+			_, err := results.AddDocuments(docs)
+			return true, err
+		}
+	}
+	resultIDs := func(res index.QueryResult) []string {
+		var ids []string
+		for _, entry := range res.Results.Map().Iter() {
+			ids = append(ids, entry.Key().String())
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	// first page holds the smallest IDs across blocks, with series indexed
+	// in both blocks returned once
+	qOpts := index.QueryOptions{
+		StartInclusive: t0,
+		EndExclusive:   t2.Add(time.Minute),
+		Limit:          2,
+		Cursor:         &index.QueryCursor{},
+	}
+	b1.EXPECT().Query(gomock.Any(), gomock.Any(), q, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(addDocs("c", "a"))
+	b0.EXPECT().Query(gomock.Any(), gomock.Any(), q, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(addDocs("b", "a", "d"))
+	res, err := idx.Query(ctx, q, qOpts)
+	require.NoError(t, err)
+	require.False(t, res.Exhaustive)
+	require.Equal(t, []string{"a", "b"}, resultIDs(res))
+	require.Equal(t, &index.QueryCursor{After: []byte("b")}, res.NextCursor)
+
+	// next page resumes after the last ID and is the last one
+	qOpts.Cursor = res.NextCursor
+	b1.EXPECT().Query(gomock.Any(), gomock.Any(), q, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(addDocs("c", "a"))
+	b0.EXPECT().Query(gomock.Any(), gomock.Any(), q, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(addDocs("b", "a", "d"))
+	res, err = idx.Query(ctx, q, qOpts)
+	require.NoError(t, err)
+	require.True(t, res.Exhaustive)
+	require.Equal(t, []string{"c", "d"}, resultIDs(res))
+	require.Nil(t, res.NextCursor)
+}
+
 func TestNamespaceIndexBlockQueryReleasingContext(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
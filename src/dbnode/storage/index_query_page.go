@@ -0,0 +1,154 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"bytes"
+	"container/heap"
+	"sort"
+	"sync"
+
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+// queryPageResults collects the page of a paginated query, the documents
+// with the smallest IDs after the cursor up to the limit. The documents
+// matched in every block are considered before the page is added to the
+// underlying results, so that the page and the cursor it ends at only depend
+// on the series matched and not on how the index laid them out.
+type queryPageResults struct {
+	sync.Mutex
+
+	results  index.BaseResults
+	cursor   index.QueryCursor
+	limit    int
+	filterID func(id ident.ID) bool
+
+	// docs is a max heap by ID so that the document with the largest ID is
+	// evicted once the page is full.
+	docs queryPageDocs
+	ids  map[string]struct{}
+	// more is whether documents after the cursor were left out of the page.
+	more bool
+}
+
+func newQueryPageResults(
+	results index.BaseResults,
+	cursor index.QueryCursor,
+	limit int,
+	filterID func(id ident.ID) bool,
+) *queryPageResults {
+	return &queryPageResults{
+		results:  results,
+		cursor:   cursor,
+		limit:    limit,
+		filterID: filterID,
+		ids:      make(map[string]struct{}),
+	}
+}
+
+func (r *queryPageResults) Namespace() ident.ID {
+	return r.results.Namespace()
+}
+
+func (r *queryPageResults) Size() int {
+	r.Lock()
+	defer r.Unlock()
+	return len(r.docs)
+}
+
+func (r *queryPageResults) AddDocuments(batch []doc.Document) (int, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, d := range batch {
+		if !r.cursor.Includes(d.ID) {
+			continue
+		}
+		if _, ok := r.ids[string(d.ID)]; ok {
+			// Series indexed in more than one block.
+			continue
+		}
+		if r.filterID != nil && !r.filterID(ident.BytesID(d.ID)) {
+			continue
+		}
+		if r.limit > 0 && len(r.docs) >= r.limit {
+			r.more = true
+			if bytes.Compare(d.ID, r.docs[0].ID) >= 0 {
+				continue
+			}
+			evicted := heap.Pop(&r.docs).(doc.Document)
+			delete(r.ids, string(evicted.ID))
+		}
+
+		// NB: Documents are only valid until the batch is reused so the
+		// documents kept for the page are copied.
+		d = convert.CloneDocument(d)
+		r.ids[string(d.ID)] = struct{}{}
+		heap.Push(&r.docs, d)
+	}
+	return len(r.docs), nil
+}
+
+// Finalize is a no-op, the underlying results are finalized by their owner.
+func (r *queryPageResults) Finalize() {}
+
+// flush adds the page to the underlying results in ID order and returns the
+// cursor to resume the query from, or nil if the page is the last one.
+func (r *queryPageResults) flush() (*index.QueryCursor, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	docs := []doc.Document(r.docs)
+	sort.Slice(docs, func(i, j int) bool {
+		return bytes.Compare(docs[i].ID, docs[j].ID) < 0
+	})
+	if _, err := r.results.AddDocuments(docs); err != nil {
+		return nil, err
+	}
+	if !r.more || len(docs) == 0 {
+		return nil, nil
+	}
+	return &index.QueryCursor{After: docs[len(docs)-1].ID}, nil
+}
+
+// queryPageDocs is a max heap of documents by ID.
+type queryPageDocs []doc.Document
+
+func (d queryPageDocs) Len() int           { return len(d) }
+func (d queryPageDocs) Less(i, j int) bool { return bytes.Compare(d[i].ID, d[j].ID) > 0 }
+func (d queryPageDocs) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+
+func (d *queryPageDocs) Push(x interface{}) {
+	*d = append(*d, x.(doc.Document))
+}
+
+func (d *queryPageDocs) Pop() interface{} {
+	old := *d
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = doc.Document{}
+	*d = old[:n-1]
+	return x
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
 	"testing"
 	"time"
 
@@ -34,17 +35,20 @@ import (
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/m3ninx/index/segment"
 	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
 	"github.com/m3db/m3/src/x/context"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/resource"
 	xtest "github.com/m3db/m3/src/x/test"
 	xtime "github.com/m3db/m3/src/x/time"
 
 	protobuftypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
+	opentracinglog "github.com/opentracing/opentracing-go/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -339,6 +343,69 @@ func TestNamespaceIndexFlushShardStateNotSuccess(t *testing.T) {
 	require.NoError(t, idx.Flush(mockFlush, shards))
 }
 
+func TestNamespaceIndexFlushIndexOnly(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{T: t})
+	defer ctrl.Finish()
+
+	test := newTestIndexWithIndexOnly(t, ctrl, true)
+
+	now := time.Now().Truncate(test.indexBlockSize)
+	idx := test.index.(*nsIndex)
+
+	defer func() {
+		require.NoError(t, idx.Close())
+	}()
+
+	mockBlock := index.NewMockBlock(ctrl)
+	mockBlock.EXPECT().Stats(gomock.Any()).Return(nil).AnyTimes()
+	blockTime := now.Add(-2 * test.indexBlockSize)
+	mockBlock.EXPECT().StartTime().Return(blockTime).AnyTimes()
+	mockBlock.EXPECT().EndTime().Return(blockTime.Add(test.indexBlockSize)).AnyTimes()
+	idx.state.blocksByTime[xtime.ToUnixNano(blockTime)] = mockBlock
+
+	mockBlock.EXPECT().IsSealed().Return(true)
+	mockBlock.EXPECT().NeedsMutableSegmentsEvicted().Return(true)
+	mockBlock.EXPECT().Close().Return(nil)
+
+	// Index only namespaces have no data filesets so neither the shard flush
+	// states nor the shard data are read.
+	mockShard := NewMockdatabaseShard(ctrl)
+	mockShard.EXPECT().ID().Return(uint32(0)).AnyTimes()
+	shards := []databaseShard{mockShard}
+
+	mockFlush := persist.NewMockIndexFlush(ctrl)
+
+	persistClosed := false
+	persistCalled := false
+	closer := func() ([]segment.Segment, error) {
+		persistClosed = true
+		return nil, nil
+	}
+	persistFn := func(segment.Builder) error {
+		persistCalled = true
+		return nil
+	}
+	preparedPersist := persist.PreparedIndexPersist{
+		Close:   closer,
+		Persist: persistFn,
+	}
+	mockFlush.EXPECT().PrepareIndex(xtest.CmpMatcher(persist.IndexPrepareOptions{
+		NamespaceMetadata: test.metadata,
+		BlockStart:        blockTime,
+		FileSetType:       persist.FileSetFlushType,
+		Shards:            map[uint32]struct{}{0: struct{}{}},
+		IndexVolumeType:   idxpersist.DefaultIndexVolumeType,
+	})).Return(preparedPersist, nil)
+
+	mockBlock.EXPECT().AddMutableSegmentsDocuments(gomock.Any()).Return(nil)
+	mockBlock.EXPECT().AddResults(gomock.Any()).Return(nil)
+	mockBlock.EXPECT().EvictMutableSegments().Return(nil)
+
+	require.NoError(t, idx.Flush(mockFlush, shards))
+	require.True(t, persistCalled)
+	require.True(t, persistClosed)
+}
+
 func TestNamespaceIndexFlushSuccessMultipleShards(t *testing.T) {
 	ctrl := gomock.NewController(xtest.Reporter{T: t})
 	defer ctrl.Finish()
@@ -465,6 +532,129 @@ func TestNamespaceIndexQueryNoMatchingBlocks(t *testing.T) {
 	assert.Equal(t, 0, aggResult.Results.Size())
 }
 
+func TestNamespaceIndexDeleteSeriesMasksQueryResults(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{T: t})
+	defer ctrl.Finish()
+
+	test := newTestIndex(t, ctrl)
+
+	now := time.Now().Truncate(test.indexBlockSize)
+	query := index.Query{Query: idx.NewTermQuery([]byte("foo"), []byte("bar"))}
+	idx := test.index.(*nsIndex)
+
+	defer func() {
+		require.NoError(t, idx.Close())
+	}()
+
+	newMockBlock := func(blockStart time.Time) *index.MockBlock {
+		mockBlock := index.NewMockBlock(ctrl)
+		mockBlock.EXPECT().Stats(gomock.Any()).Return(nil).AnyTimes()
+		mockBlock.EXPECT().StartTime().Return(blockStart).AnyTimes()
+		mockBlock.EXPECT().EndTime().Return(blockStart.Add(test.indexBlockSize)).AnyTimes()
+		mockBlock.EXPECT().Close().Return(nil)
+		mockBlock.EXPECT().
+			Query(gomock.Any(), gomock.Any(), query, gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				_ *resource.CancellableLifetime,
+				_ index.Query,
+				_ index.QueryOptions,
+				results index.BaseResults,
+				_ []opentracinglog.Field,
+			) (bool, error) {
+				_, err := results.AddDocuments([]doc.Document{
+					{ID: []byte("a")},
+					{ID: []byte("b")},
+				})
+				return true, err
+			}).
+			AnyTimes()
+		idx.state.blocksByTime[xtime.ToUnixNano(blockStart)] = mockBlock
+		return mockBlock
+	}
+
+	var (
+		fullyDeletedStart     = now.Add(-2 * test.indexBlockSize)
+		partiallyDeletedStart = now.Add(-1 * test.indexBlockSize)
+		fullyDeletedBlock     = newMockBlock(fullyDeletedStart)
+		partiallyDeletedBlock = newMockBlock(partiallyDeletedStart)
+		partiallyDeletedEnd   = partiallyDeletedStart.Add(test.indexBlockSize / 2)
+	)
+
+	// Only the block entirely covered by the delete has the document removed.
+	fullyDeletedBlock.EXPECT().RemoveDocuments([][]byte{[]byte("a")})
+
+	// Delete series "a" from the whole of the first block but only part of
+	// the second block.
+	idx.DeleteSeries([]ident.ID{ident.StringID("a")}, fullyDeletedStart,
+		partiallyDeletedEnd)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	for _, tc := range []struct {
+		block    index.Block
+		end      time.Time
+		expected []string
+	}{
+		{block: fullyDeletedBlock, end: now, expected: []string{"b"}},
+		{block: partiallyDeletedBlock, end: now, expected: []string{"a", "b"}},
+		{block: partiallyDeletedBlock, end: partiallyDeletedEnd, expected: []string{"b"}},
+	} {
+		var (
+			results = index.NewQueryResults(test.metadata.ID(),
+				index.QueryResultsOptions{}, test.opts.IndexOptions())
+			state = asyncQueryExecState{exhaustive: true}
+		)
+		opts := index.QueryOptions{
+			StartInclusive: fullyDeletedStart,
+			EndExclusive:   tc.end,
+		}
+		idx.execBlockQueryFn(ctx, resource.NewCancellableLifetime(), tc.block,
+			query, opts, &state, results, nil)
+		require.NoError(t, state.multiErr.FinalError())
+
+		actual := make([]string, 0, results.Size())
+		for _, entry := range results.Map().Iter() {
+			actual = append(actual, entry.Key().String())
+		}
+		sort.Strings(actual)
+		require.Equal(t, tc.expected, actual)
+	}
+
+	// Writing series "a" again within the first block after the delete
+	// restores its document and returns it from queries again.
+	fullyDeletedBlock.EXPECT().RestoreDocuments([][]byte{[]byte("a")})
+	idx.ClearDeletedSeries(ident.StringID("a"), fullyDeletedStart,
+		partiallyDeletedStart)
+
+	var (
+		results = index.NewQueryResults(test.metadata.ID(),
+			index.QueryResultsOptions{}, test.opts.IndexOptions())
+		state = asyncQueryExecState{exhaustive: true}
+		opts  = index.QueryOptions{
+			StartInclusive: fullyDeletedStart,
+			EndExclusive:   partiallyDeletedStart,
+		}
+	)
+	idx.execBlockQueryFn(ctx, resource.NewCancellableLifetime(), fullyDeletedBlock,
+		query, opts, &state, results, nil)
+	require.NoError(t, state.multiErr.FinalError())
+	require.Equal(t, 2, results.Size())
+
+	// The part of the second block that was not written is still masked.
+	results = index.NewQueryResults(test.metadata.ID(),
+		index.QueryResultsOptions{}, test.opts.IndexOptions())
+	opts = index.QueryOptions{
+		StartInclusive: partiallyDeletedStart,
+		EndExclusive:   partiallyDeletedEnd,
+	}
+	idx.execBlockQueryFn(ctx, resource.NewCancellableLifetime(), partiallyDeletedBlock,
+		query, opts, &state, results, nil)
+	require.NoError(t, state.multiErr.FinalError())
+	require.Equal(t, 1, results.Size())
+}
+
 type testIndex struct {
 	index          NamespaceIndex
 	metadata       namespace.Metadata
@@ -475,6 +665,14 @@ type testIndex struct {
 }
 
 func newTestIndex(t *testing.T, ctrl *gomock.Controller) testIndex {
+	return newTestIndexWithIndexOnly(t, ctrl, false)
+}
+
+func newTestIndexWithIndexOnly(
+	t *testing.T,
+	ctrl *gomock.Controller,
+	indexOnly bool,
+) testIndex {
 	blockSize := time.Hour
 	indexBlockSize := 2 * time.Hour
 	retentionPeriod := 24 * time.Hour
@@ -485,6 +683,11 @@ func newTestIndex(t *testing.T, ctrl *gomock.Controller) testIndex {
 	nopts := namespace.NewOptions().
 		SetRetentionOptions(ropts).
 		SetIndexOptions(namespace.NewIndexOptions().SetBlockSize(indexBlockSize))
+	if indexOnly {
+		nopts = nopts.
+			SetIndexOnly(true).
+			SetIndexOptions(nopts.IndexOptions().SetEnabled(true))
+	}
 	md, err := namespace.NewMetadata(ident.StringID("testns"), nopts)
 	require.NoError(t, err)
 	opts := DefaultTestOptions()
@@ -32,15 +32,20 @@ import (
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/ratelimit"
+	m3dbruntime "github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/idx"
 	xclose "github.com/m3db/m3/src/x/close"
 	"github.com/m3db/m3/src/x/context"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -56,8 +61,15 @@ import (
 )
 
 var (
-	errNamespaceAlreadyClosed    = errors.New("namespace already closed")
-	errNamespaceIndexingDisabled = errors.New("namespace indexing is disabled")
+	errNamespaceAlreadyClosed       = errors.New("namespace already closed")
+	errNamespaceIndexingDisabled    = errors.New("namespace indexing is disabled")
+	errNamespaceDeleteInvalidRange  = errors.New("delete range start must be before end")
+	errNamespaceIndexOnly           = errors.New("namespace is index only and does not accept series writes")
+	errNamespaceNotIndexOnly        = errors.New("namespace is not index only and does not accept document writes")
+	errDocumentIDRequired           = errors.New("document written to index only namespace must have an ID")
+	errDocumentAlreadyExists        = errors.New("document with the same ID already written to the index block")
+	errNamespaceDeleteNotExhaustive = errors.New(
+		"delete query matched more series than could be deleted at once, retry to delete the remaining series")
 )
 
 type commitLogWriter interface {
@@ -68,35 +80,27 @@ type commitLogWriter interface {
 		unit xtime.Unit,
 		annotation ts.Annotation,
 	) error
+
+	WritePosition() (persist.CommitLogPosition, error)
 }
 
-type commitLogWriterFn func(
-	ctx context.Context,
-	series ts.Series,
-	datapoint ts.Datapoint,
-	unit xtime.Unit,
-	annotation ts.Annotation,
-) error
+type commitLogWriterNoOp struct{}
 
-func (fn commitLogWriterFn) Write(
+func (commitLogWriterNoOp) Write(
 	ctx context.Context,
 	series ts.Series,
 	datapoint ts.Datapoint,
 	unit xtime.Unit,
 	annotation ts.Annotation,
 ) error {
-	return fn(ctx, series, datapoint, unit, annotation)
+	return nil
 }
 
-var commitLogWriteNoOp = commitLogWriter(commitLogWriterFn(func(
-	ctx context.Context,
-	series ts.Series,
-	datapoint ts.Datapoint,
-	unit xtime.Unit,
-	annotation ts.Annotation,
-) error {
-	return nil
-}))
+func (commitLogWriterNoOp) WritePosition() (persist.CommitLogPosition, error) {
+	return persist.CommitLogPosition{}, nil
+}
+
+var commitLogWriteNoOp = commitLogWriter(commitLogWriterNoOp{})
 
 type dbNamespace struct {
 	sync.RWMutex
@@ -121,6 +125,11 @@ type dbNamespace struct {
 	schemaListener xclose.SimpleCloser
 	schemaDescr    namespace.SchemaDescr
 
+	// quotaLimiter enforces the namespace quota which is updated whenever
+	// the runtime options are updated.
+	quotaLimiter        *ratelimit.QuotaLimiter
+	runtimeOptsListener xclose.SimpleCloser
+
 	// Contains an entry to all shards for fast shard lookup, an
 	// entry will be nil when this shard does not belong to current database
 	shards []databaseShard
@@ -129,6 +138,14 @@ type dbNamespace struct {
 	commitLogWriter commitLogWriter
 	reverseIndex    NamespaceIndex
 
+	// deleteLock orders deletes by the commit log position recorded for
+	// them, the position is taken and the data removed while holding it.
+	deleteLock sync.Mutex
+
+	// documentCommitLogs tracks the commit logs holding documents written to
+	// an index only namespace that have not been persisted by an index flush.
+	documentCommitLogs namespaceDocumentCommitLogs
+
 	tickWorkers            xsync.WorkerPool
 	tickWorkersConcurrency int
 	statsLastTick          databaseNamespaceStatsLastTick
@@ -136,6 +153,30 @@ type dbNamespace struct {
 	metrics databaseNamespaceMetrics
 }
 
+// namespaceDocumentCommitLogs tracks the index blocks of an index only
+// namespace with documents that are only durable in the commit log, along
+// with the commit logs that must be retained until the blocks are flushed.
+type namespaceDocumentCommitLogs struct {
+	sync.Mutex
+	byBlock map[xtime.UnixNano]unflushedDocuments
+	// lastCommitLogIndex is the index of the commit log rotated before the
+	// last cold flush, all documents written since are in commit logs with
+	// at least this index.
+	lastCommitLogIndex    int64
+	hasLastCommitLogIndex bool
+}
+
+type unflushedDocuments struct {
+	commitLogIndex int64
+	writes         int64
+}
+
+func newNamespaceDocumentCommitLogs() namespaceDocumentCommitLogs {
+	return namespaceDocumentCommitLogs{
+		byBlock: make(map[xtime.UnixNano]unflushedDocuments),
+	}
+}
+
 type databaseNamespaceStatsLastTick struct {
 	sync.RWMutex
 	activeSeries int64
@@ -153,21 +194,27 @@ type databaseNamespaceMetrics struct {
 	bootstrap           instrument.MethodMetrics
 	flushWarmData       instrument.MethodMetrics
 	flushColdData       instrument.MethodMetrics
+	flushDownsample     instrument.MethodMetrics
+	flushRewrite        instrument.MethodMetrics
 	flushIndex          instrument.MethodMetrics
 	snapshot            instrument.MethodMetrics
 	write               instrument.MethodMetrics
 	writeTagged         instrument.MethodMetrics
+	writeDocument       instrument.MethodMetrics
 	read                instrument.MethodMetrics
 	fetchBlocks         instrument.MethodMetrics
 	fetchBlocksMetadata instrument.MethodMetrics
 	queryIDs            instrument.MethodMetrics
 	aggregateQuery      instrument.MethodMetrics
+	cardinality         instrument.MethodMetrics
+	deleteTagged        instrument.MethodMetrics
 	unfulfilled         tally.Counter
 	bootstrapStart      tally.Counter
 	bootstrapEnd        tally.Counter
 	shards              databaseNamespaceShardMetrics
 	tick                databaseNamespaceTickMetrics
 	status              databaseNamespaceStatusMetrics
+	quotaExceeded       databaseNamespaceQuotaExceededMetrics
 }
 
 type databaseNamespaceShardMetrics struct {
@@ -176,6 +223,29 @@ type databaseNamespaceShardMetrics struct {
 	closeErrors tally.Counter
 }
 
+type databaseNamespaceQuotaExceededMetrics struct {
+	datapoints       tally.Counter
+	newSeries        tally.Counter
+	indexDocsMatched tally.Counter
+}
+
+func (m databaseNamespaceQuotaExceededMetrics) report(err error) {
+	for err != nil {
+		if quotaErr, ok := err.(ratelimit.QuotaExceededError); ok {
+			switch quotaErr.Resource {
+			case ratelimit.DatapointsQuotaResource:
+				m.datapoints.Inc(1)
+			case ratelimit.NewSeriesQuotaResource:
+				m.newSeries.Inc(1)
+			case ratelimit.IndexDocsMatchedQuotaResource:
+				m.indexDocsMatched.Inc(1)
+			}
+			return
+		}
+		err = xerrors.InnerError(err)
+	}
+}
+
 type databaseNamespaceTickMetrics struct {
 	activeSeries           tally.Gauge
 	expiredSeries          tally.Counter
@@ -231,19 +301,29 @@ func newDatabaseNamespaceMetrics(
 	indexTickScope := tickScope.SubScope("index")
 	statusScope := scope.SubScope("status")
 	indexStatusScope := statusScope.SubScope("index")
+	quotaExceededCounter := func(resource ratelimit.QuotaResource) tally.Counter {
+		return scope.Tagged(map[string]string{
+			"resource": resource.String(),
+		}).Counter("quota-exceeded")
+	}
 	return databaseNamespaceMetrics{
 		bootstrap:           instrument.NewMethodMetrics(scope, "bootstrap", opts),
 		flushWarmData:       instrument.NewMethodMetrics(scope, "flushWarmData", opts),
 		flushColdData:       instrument.NewMethodMetrics(scope, "flushColdData", opts),
+		flushDownsample:     instrument.NewMethodMetrics(scope, "flushDownsample", opts),
+		flushRewrite:        instrument.NewMethodMetrics(scope, "flushRewrite", opts),
 		flushIndex:          instrument.NewMethodMetrics(scope, "flushIndex", opts),
 		snapshot:            instrument.NewMethodMetrics(scope, "snapshot", opts),
 		write:               instrument.NewMethodMetrics(scope, "write", opts),
 		writeTagged:         instrument.NewMethodMetrics(scope, "write-tagged", opts),
+		writeDocument:       instrument.NewMethodMetrics(scope, "write-document", opts),
 		read:                instrument.NewMethodMetrics(scope, "read", opts),
 		fetchBlocks:         instrument.NewMethodMetrics(scope, "fetchBlocks", opts),
 		fetchBlocksMetadata: instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", opts),
 		queryIDs:            instrument.NewMethodMetrics(scope, "queryIDs", opts),
 		aggregateQuery:      instrument.NewMethodMetrics(scope, "aggregateQuery", opts),
+		cardinality:         instrument.NewMethodMetrics(scope, "cardinality", opts),
+		deleteTagged:        instrument.NewMethodMetrics(scope, "deleteTagged", opts),
 		unfulfilled:         scope.Counter("bootstrap.unfulfilled"),
 		bootstrapStart:      scope.Counter("bootstrap.start"),
 		bootstrapEnd:        scope.Counter("bootstrap.end"),
@@ -281,6 +361,11 @@ func newDatabaseNamespaceMetrics(
 				numSegments: indexStatusScope.Gauge("num-segments"),
 			},
 		},
+		quotaExceeded: databaseNamespaceQuotaExceededMetrics{
+			datapoints:       quotaExceededCounter(ratelimit.DatapointsQuotaResource),
+			newSeries:        quotaExceededCounter(ratelimit.NewSeriesQuotaResource),
+			indexDocsMatched: quotaExceededCounter(ratelimit.IndexDocsMatchedQuotaResource),
+		},
 	}
 }
 
@@ -317,6 +402,15 @@ func newDatabaseNamespace(
 	seriesOpts := NewSeriesOptionsFromOptions(opts, nopts.RetentionOptions()).
 		SetStats(series.NewStats(scope)).
 		SetColdWritesEnabled(nopts.ColdWritesEnabled())
+	if nopts.HistogramsEnabled() {
+		// Series of histogram namespaces encode their buffered datapoints
+		// as native histograms.
+		histogramEncoderPool := opts.HistogramEncoderPool()
+		seriesOpts = seriesOpts.
+			SetEncoderPool(histogramEncoderPool).
+			SetDatabaseBlockOptions(seriesOpts.DatabaseBlockOptions().
+				SetEncoderPool(histogramEncoderPool))
+	}
 	if err := seriesOpts.Validate(); err != nil {
 		return nil, fmt.Errorf(
 			"unable to create namespace %v, invalid series options: %v",
@@ -350,8 +444,10 @@ func newDatabaseNamespace(
 		increasingIndex:        increasingIndex,
 		commitLogWriter:        commitLogWriter,
 		reverseIndex:           index,
+		documentCommitLogs:     newNamespaceDocumentCommitLogs(),
 		tickWorkers:            tickWorkers,
 		tickWorkersConcurrency: tickWorkersConcurrency,
+		quotaLimiter:           ratelimit.NewQuotaLimiter(id.String(), opts.ClockOptions().NowFn()),
 		metrics:                newDatabaseNamespaceMetrics(scope, iops.TimerOptions()),
 	}
 
@@ -364,6 +460,7 @@ func newDatabaseNamespace(
 			metadata.ID().String(), err)
 	}
 	n.schemaListener = sl
+	n.runtimeOptsListener = opts.RuntimeOptionsManager().RegisterListener(n)
 	n.assignShardSet(shardSet, assignShardSetOptions{
 		needsBootstrap:    nopts.BootstrapEnabled(),
 		initialAssignment: true,
@@ -394,6 +491,11 @@ func (n *dbNamespace) SetSchemaHistory(value namespace.SchemaHistory) {
 	n.metadata = metadata
 }
 
+// SetRuntimeOptions implements runtime.OptionsListener.
+func (n *dbNamespace) SetRuntimeOptions(value m3dbruntime.Options) {
+	n.quotaLimiter.SetQuota(value.NamespaceQuotas()[n.id.String()])
+}
+
 func (n *dbNamespace) reportStatusLoop(reportInterval time.Duration) {
 	ticker := time.NewTicker(reportInterval)
 	defer ticker.Stop()
@@ -509,7 +611,7 @@ func (n *dbNamespace) assignShardSet(
 		// shard created for this shard ID.
 		n.shards[shard] = newDatabaseShard(metadata, shard, n.blockRetriever,
 			n.namespaceReaderMgr, n.increasingIndex, n.reverseIndex,
-			opts.needsBootstrap, n.opts, n.seriesOpts)
+			n.quotaLimiter, opts.needsBootstrap, n.opts, n.seriesOpts)
 		// NB(bodu): We only record shard add metrics for shards created in non
 		// initial assignments.
 		if !opts.initialAssignment {
@@ -665,6 +767,10 @@ func (n *dbNamespace) Write(
 	annotation []byte,
 ) (ts.Series, bool, error) {
 	callStart := n.nowFn()
+	if n.nopts.IndexOnly() {
+		n.metrics.write.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, false, xerrors.NewInvalidParamsError(errNamespaceIndexOnly)
+	}
 	shard, nsCtx, err := n.shardFor(id)
 	if err != nil {
 		n.metrics.write.ReportError(n.nowFn().Sub(callStart))
@@ -676,6 +782,7 @@ func (n *dbNamespace) Write(
 	}
 	series, wasWritten, err := shard.Write(ctx, id, timestamp,
 		value, unit, annotation, opts)
+	n.metrics.quotaExceeded.report(err)
 	n.metrics.write.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return series, wasWritten, err
 }
@@ -694,6 +801,10 @@ func (n *dbNamespace) WriteTagged(
 		n.metrics.writeTagged.ReportError(n.nowFn().Sub(callStart))
 		return ts.Series{}, false, errNamespaceIndexingDisabled
 	}
+	if n.nopts.IndexOnly() {
+		n.metrics.writeTagged.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, false, xerrors.NewInvalidParamsError(errNamespaceIndexOnly)
+	}
 	shard, nsCtx, err := n.shardFor(id)
 	if err != nil {
 		n.metrics.writeTagged.ReportError(n.nowFn().Sub(callStart))
@@ -705,10 +816,139 @@ func (n *dbNamespace) WriteTagged(
 	}
 	series, wasWritten, err := shard.WriteTagged(ctx, id, tags, timestamp,
 		value, unit, annotation, opts)
+	n.metrics.quotaExceeded.report(err)
 	n.metrics.writeTagged.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return series, wasWritten, err
 }
 
+func (n *dbNamespace) WriteDocument(
+	ctx context.Context,
+	d doc.Document,
+	timestamp time.Time,
+) (ts.Series, error) {
+	callStart := n.nowFn()
+	if !n.nopts.IndexOnly() {
+		n.metrics.writeDocument.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, xerrors.NewInvalidParamsError(errNamespaceNotIndexOnly)
+	}
+	if n.reverseIndex == nil { // only happens if indexing is enabled.
+		n.metrics.writeDocument.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, errNamespaceIndexingDisabled
+	}
+	if !d.HasID() {
+		n.metrics.writeDocument.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, xerrors.NewInvalidParamsError(errDocumentIDRequired)
+	}
+	if err := d.Validate(); err != nil {
+		n.metrics.writeDocument.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, xerrors.NewInvalidParamsError(err)
+	}
+
+	// NB: Documents are sharded by ID like series so that only the
+	// replicas owning the shard accept the write.
+	shard, _, err := n.shardFor(ident.BytesID(d.ID))
+	if err != nil {
+		n.metrics.writeDocument.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, err
+	}
+
+	// NB: Documents are not replaced, the index would keep serving the
+	// document first written with the ID. Concurrent writes of the same
+	// document may still all be accepted, in which case the first one is
+	// kept both by the index and when bootstrapping from the commit log.
+	exists, err := n.documentExists(ctx, d.ID, timestamp)
+	if err != nil {
+		n.metrics.writeDocument.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, err
+	}
+	if exists {
+		n.metrics.writeDocument.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, xerrors.NewInvalidParamsError(errDocumentAlreadyExists)
+	}
+
+	batch := index.NewWriteBatch(index.WriteBatchOptions{
+		InitialCapacity: 1,
+		IndexBlockSize:  n.nopts.IndexOptions().BlockSize(),
+	})
+	d = convert.CloneDocument(d)
+	batch.Append(index.WriteBatchEntry{
+		Timestamp:     timestamp,
+		OnIndexSeries: indexOnlyEntry{},
+		EnqueuedAt:    callStart,
+	}, d)
+
+	if err := n.reverseIndex.WriteBatch(batch); err != nil {
+		n.metrics.writeDocument.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, err
+	}
+
+	// NB: Mark the block unflushed before the document is written to the
+	// commit log so that the commit log is retained until the block is
+	// persisted by an index flush.
+	n.markDocumentUnflushed(timestamp)
+
+	// The document is returned as a series so that it can be written to the
+	// commit log, its fields are the series tags.
+	tags := make([]ident.Tag, 0, len(d.Fields))
+	for _, f := range d.Fields {
+		tags = append(tags, ident.Tag{
+			Name:  ident.BytesID(f.Name),
+			Value: ident.BytesID(f.Value),
+		})
+	}
+	series := ts.Series{
+		UniqueIndex: n.increasingIndex.nextIndex(),
+		Namespace:   n.ID(),
+		ID:          ident.BytesID(d.ID),
+		Tags:        ident.NewTags(tags...),
+		Shard:       shard.ID(),
+	}
+
+	n.metrics.writeDocument.ReportSuccess(n.nowFn().Sub(callStart))
+	return series, nil
+}
+
+// documentExists returns whether the index block the timestamp falls in
+// holds a document with the ID.
+func (n *dbNamespace) documentExists(
+	ctx context.Context,
+	id []byte,
+	timestamp time.Time,
+) (bool, error) {
+	var (
+		blockSize  = n.nopts.IndexOptions().BlockSize()
+		blockStart = timestamp.Truncate(blockSize)
+	)
+	res, err := n.reverseIndex.Query(ctx, index.Query{
+		Query: idx.NewTermQuery(doc.IDReservedFieldName, id),
+	}, index.QueryOptions{
+		StartInclusive: blockStart,
+		EndExclusive:   blockStart.Add(blockSize),
+		Limit:          1,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Results.Size() > 0, nil
+}
+
+// indexOnlyEntry tracks the indexing of a document written to an index only
+// namespace, there is no series to record the indexed blocks on so every
+// write is sent to the index.
+type indexOnlyEntry struct{}
+
+var _ index.OnIndexSeries = indexOnlyEntry{}
+
+func (indexOnlyEntry) OnIndexSuccess(blockStart xtime.UnixNano) {}
+
+func (indexOnlyEntry) OnIndexFinalize(blockStart xtime.UnixNano) {}
+
+func (indexOnlyEntry) OnIndexPrepare() {}
+
+func (indexOnlyEntry) NeedsIndexUpdate(indexBlockStartForWrite xtime.UnixNano) bool {
+	return true
+}
+
 func (n *dbNamespace) SeriesReadWriteRef(
 	shardID uint32,
 	id ident.ID,
@@ -763,12 +1003,27 @@ func (n *dbNamespace) QueryIDs(
 			xerrors.NewRetryableError(err)
 	}
 
+	quotaLimit, err := n.quotaLimiter.IndexDocsMatchedLimit()
+	if err != nil {
+		n.metrics.quotaExceeded.report(err)
+		n.metrics.queryIDs.ReportError(n.nowFn().Sub(callStart))
+		sp.LogFields(opentracinglog.Error(err))
+		return index.QueryResult{}, err
+	}
+	opts.Limit = indexQueryQuotaLimit(opts.Limit, quotaLimit)
+
 	res, err := n.reverseIndex.Query(ctx, query, opts)
+	if err == nil && quotaLimit > 0 {
+		err = n.quotaLimiter.AddIndexDocsMatched(int64(res.Results.Size()))
+		n.metrics.quotaExceeded.report(err)
+	}
 	if err != nil {
 		sp.LogFields(opentracinglog.Error(err))
+		n.metrics.queryIDs.ReportError(n.nowFn().Sub(callStart))
+		return index.QueryResult{}, err
 	}
-	n.metrics.queryIDs.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
-	return res, err
+	n.metrics.queryIDs.ReportSuccess(n.nowFn().Sub(callStart))
+	return res, nil
 }
 
 func (n *dbNamespace) AggregateQuery(
@@ -789,8 +1044,51 @@ func (n *dbNamespace) AggregateQuery(
 			xerrors.NewRetryableError(errIndexNotBootstrappedToRead)
 	}
 
+	quotaLimit, err := n.quotaLimiter.IndexDocsMatchedLimit()
+	if err != nil {
+		n.metrics.quotaExceeded.report(err)
+		n.metrics.aggregateQuery.ReportError(n.nowFn().Sub(callStart))
+		return index.AggregateQueryResult{}, err
+	}
+	opts.Limit = indexQueryQuotaLimit(opts.Limit, quotaLimit)
+
 	res, err := n.reverseIndex.AggregateQuery(ctx, query, opts)
-	n.metrics.aggregateQuery.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	if err == nil && quotaLimit > 0 {
+		err = n.quotaLimiter.AddIndexDocsMatched(int64(res.Results.Size()))
+		n.metrics.quotaExceeded.report(err)
+	}
+	if err != nil {
+		n.metrics.aggregateQuery.ReportError(n.nowFn().Sub(callStart))
+		return index.AggregateQueryResult{}, err
+	}
+	n.metrics.aggregateQuery.ReportSuccess(n.nowFn().Sub(callStart))
+	return res, nil
+}
+
+// indexQueryQuotaLimit returns the limit to query the index with given the
+// number of documents that remain of the namespace quota, zero meaning no
+// quota. Queries match at most one document more than remains of the quota
+// so that queries exceeding it are rejected rather than silently returning
+// partial results.
+func indexQueryQuotaLimit(limit int, quotaLimit int) int {
+	if quotaLimit > 0 && (limit <= 0 || quotaLimit < limit) {
+		return quotaLimit + 1
+	}
+	return limit
+}
+
+func (n *dbNamespace) Cardinality(
+	ctx context.Context,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	callStart := n.nowFn()
+	if n.reverseIndex == nil { // only happens if indexing is enabled.
+		n.metrics.cardinality.ReportError(n.nowFn().Sub(callStart))
+		return index.CardinalityResult{}, errNamespaceIndexingDisabled
+	}
+
+	res, err := n.reverseIndex.Cardinality(ctx, opts)
+	n.metrics.cardinality.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return res, err
 }
 
@@ -989,6 +1287,14 @@ func (n *dbNamespace) Bootstrap(
 			zap.Int("numIndexBlocks", len(indexResults)))
 		err := n.reverseIndex.Bootstrap(indexResults)
 		multiErr = multiErr.Add(err)
+
+		if n.nopts.IndexOnly() {
+			// Documents replayed from the commit log are only durable there
+			// until their blocks are flushed again.
+			for blockStart := range indexResults {
+				n.markDocumentUnflushed(blockStart.ToTime())
+			}
+		}
 	}
 
 	markAnyUnfulfilled := func(
@@ -1147,7 +1453,10 @@ func (r *coldFlushReuseableResources) reset() {
 	r.dirtySeries.Reset()
 }
 
-func (n *dbNamespace) ColdFlush(flushPersist persist.FlushPreparer) error {
+func (n *dbNamespace) ColdFlush(
+	flushPersist persist.FlushPreparer,
+	commitLogID persist.CommitLogFile,
+) error {
 	// NB(rartoul): This value can be used for emitting metrics, but should not be used
 	// for business logic.
 	callStart := n.nowFn()
@@ -1161,15 +1470,26 @@ func (n *dbNamespace) ColdFlush(flushPersist persist.FlushPreparer) error {
 	nsCtx := n.nsContextWithRLock()
 	n.RUnlock()
 
+	// Documents written from now on are in commit logs with at least the
+	// index of the commit log rotated for this cold flush.
+	if n.nopts.IndexOnly() {
+		n.documentCommitLogs.Lock()
+		n.documentCommitLogs.lastCommitLogIndex = commitLogID.Index
+		n.documentCommitLogs.hasLastCommitLogIndex = true
+		n.documentCommitLogs.Unlock()
+	}
+
+	shards := n.OwnedShards()
+
 	// If repair is enabled we still need cold flush regardless of whether cold writes is
-	// enabled since repairs are dependent on the cold flushing logic.
-	if !n.nopts.ColdWritesEnabled() && !n.nopts.RepairEnabled() {
+	// enabled since repairs are dependent on the cold flushing logic. The same applies
+	// to deletes since flushed data is removed by rewriting filesets in the cold flush.
+	if !n.nopts.ColdWritesEnabled() && !n.nopts.RepairEnabled() && !hasPendingTombstones(shards) {
 		n.metrics.flushColdData.ReportSuccess(n.nowFn().Sub(callStart))
 		return nil
 	}
 
 	multiErr := xerrors.NewMultiError()
-	shards := n.OwnedShards()
 
 	resources, err := newColdFlushReuseableResources(n.opts)
 	if err != nil {
@@ -1182,7 +1502,7 @@ func (n *dbNamespace) ColdFlush(flushPersist persist.FlushPreparer) error {
 	}
 
 	for _, shard := range shards {
-		err := shard.ColdFlush(flushPersist, resources, nsCtx, onColdFlushNs)
+		err := shard.ColdFlush(flushPersist, resources, nsCtx, onColdFlushNs, commitLogID)
 		if err != nil {
 			detailedErr := fmt.Errorf("shard %d failed to compact: %v", shard.ID(), err)
 			multiErr = multiErr.Add(detailedErr)
@@ -1205,6 +1525,112 @@ func (n *dbNamespace) ColdFlush(flushPersist persist.FlushPreparer) error {
 	return res
 }
 
+func hasPendingTombstones(shards []databaseShard) bool {
+	for _, shard := range shards {
+		if shard.HasPendingTombstones() {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *dbNamespace) ColdFlushRetainedCommitLogIndex() (int64, bool) {
+	minIndex, found := n.documentsRetainedCommitLogIndex()
+	for _, shard := range n.OwnedShards() {
+		index, ok := shard.ColdFlushRetainedCommitLogIndex()
+		if !ok {
+			continue
+		}
+		if !found || index < minIndex {
+			minIndex = index
+			found = true
+		}
+	}
+	return minIndex, found
+}
+
+func (n *dbNamespace) DownsampleFlush(
+	tickStart time.Time,
+	flushPersist persist.FlushPreparer,
+) error {
+	callStart := n.nowFn()
+
+	n.RLock()
+	if n.bootstrapState != Bootstrapped {
+		n.RUnlock()
+		n.metrics.flushDownsample.ReportError(n.nowFn().Sub(callStart))
+		return errNamespaceNotBootstrapped
+	}
+	nsCtx := n.nsContextWithRLock()
+	n.RUnlock()
+
+	if !n.nopts.FlushEnabled() || len(n.nopts.ResolutionTiers()) == 0 {
+		n.metrics.flushDownsample.ReportSuccess(n.nowFn().Sub(callStart))
+		return nil
+	}
+
+	fsReader, err := fs.NewReader(n.opts.BytesPool(), n.opts.CommitLogOptions().FilesystemOptions())
+	if err != nil {
+		n.metrics.flushDownsample.ReportError(n.nowFn().Sub(callStart))
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, shard := range n.OwnedShards() {
+		err := shard.DownsampleFlush(tickStart, flushPersist, fsReader, nsCtx)
+		if err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to downsample: %v", shard.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+			// Continue with remaining shards.
+		}
+	}
+
+	res := multiErr.FinalError()
+	n.metrics.flushDownsample.ReportSuccessOrError(res, n.nowFn().Sub(callStart))
+	return res
+}
+
+func (n *dbNamespace) RewriteFlush(
+	tickStart time.Time,
+	flushPersist persist.FlushPreparer,
+) error {
+	callStart := n.nowFn()
+
+	n.RLock()
+	if n.bootstrapState != Bootstrapped {
+		n.RUnlock()
+		n.metrics.flushRewrite.ReportError(n.nowFn().Sub(callStart))
+		return errNamespaceNotBootstrapped
+	}
+	nsCtx := n.nsContextWithRLock()
+	n.RUnlock()
+
+	if !n.nopts.FlushEnabled() || !n.opts.FileSetRewriteEnabled() {
+		n.metrics.flushRewrite.ReportSuccess(n.nowFn().Sub(callStart))
+		return nil
+	}
+
+	fsReader, err := fs.NewReader(n.opts.BytesPool(), n.opts.CommitLogOptions().FilesystemOptions())
+	if err != nil {
+		n.metrics.flushRewrite.ReportError(n.nowFn().Sub(callStart))
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, shard := range n.OwnedShards() {
+		err := shard.RewriteFlush(tickStart, flushPersist, fsReader, nsCtx)
+		if err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to rewrite: %v", shard.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+			// Continue with remaining shards.
+		}
+	}
+
+	res := multiErr.FinalError()
+	n.metrics.flushRewrite.ReportSuccessOrError(res, n.nowFn().Sub(callStart))
+	return res
+}
+
 func (n *dbNamespace) FlushIndex(flush persist.IndexFlush) error {
 	callStart := n.nowFn()
 	n.RLock()
@@ -1220,12 +1646,104 @@ func (n *dbNamespace) FlushIndex(flush persist.IndexFlush) error {
 		return nil
 	}
 
+	var unflushed map[xtime.UnixNano]unflushedDocuments
+	if n.nopts.IndexOnly() {
+		unflushed = n.unflushedDocuments()
+	}
+
 	shards := n.OwnedShards()
 	err := n.reverseIndex.Flush(flush, shards)
+	if err == nil && len(unflushed) > 0 {
+		err = n.markDocumentsFlushed(unflushed)
+	}
 	n.metrics.flushIndex.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return err
 }
 
+func (n *dbNamespace) markDocumentUnflushed(timestamp time.Time) {
+	blockStart := xtime.ToUnixNano(timestamp.Truncate(n.nopts.IndexOptions().BlockSize()))
+
+	n.documentCommitLogs.Lock()
+	defer n.documentCommitLogs.Unlock()
+
+	unflushed, ok := n.documentCommitLogs.byBlock[blockStart]
+	if !ok {
+		// Documents written before the first cold flush of the process may be
+		// in any commit log, so retain them all until the block is flushed.
+		if n.documentCommitLogs.hasLastCommitLogIndex {
+			unflushed.commitLogIndex = n.documentCommitLogs.lastCommitLogIndex
+		}
+	}
+	unflushed.writes++
+	n.documentCommitLogs.byBlock[blockStart] = unflushed
+}
+
+func (n *dbNamespace) unflushedDocuments() map[xtime.UnixNano]unflushedDocuments {
+	n.documentCommitLogs.Lock()
+	defer n.documentCommitLogs.Unlock()
+
+	result := make(map[xtime.UnixNano]unflushedDocuments, len(n.documentCommitLogs.byBlock))
+	for blockStart, unflushed := range n.documentCommitLogs.byBlock {
+		result[blockStart] = unflushed
+	}
+	return result
+}
+
+// markDocumentsFlushed stops retaining the commit logs of blocks that have
+// been persisted by an index flush, or that have expired, and that were not
+// written to since the flush began.
+func (n *dbNamespace) markDocumentsFlushed(
+	beforeFlush map[xtime.UnixNano]unflushedDocuments,
+) error {
+	var (
+		filePathPrefix = n.opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
+		blockSize      = n.nopts.IndexOptions().BlockSize()
+		retentionStart = n.nowFn().Add(-n.nopts.RetentionOptions().RetentionPeriod()).Truncate(blockSize)
+		flushed        = make([]xtime.UnixNano, 0, len(beforeFlush))
+	)
+	for blockStart := range beforeFlush {
+		if blockStart.ToTime().Before(retentionStart) {
+			flushed = append(flushed, blockStart)
+			continue
+		}
+		filesets, err := fs.IndexFileSetsAt(filePathPrefix, n.id, blockStart.ToTime())
+		if err != nil {
+			return err
+		}
+		if len(filesets) > 0 {
+			flushed = append(flushed, blockStart)
+		}
+	}
+
+	n.documentCommitLogs.Lock()
+	defer n.documentCommitLogs.Unlock()
+
+	for _, blockStart := range flushed {
+		current, ok := n.documentCommitLogs.byBlock[blockStart]
+		if ok && current.writes == beforeFlush[blockStart].writes {
+			delete(n.documentCommitLogs.byBlock, blockStart)
+		}
+	}
+	return nil
+}
+
+func (n *dbNamespace) documentsRetainedCommitLogIndex() (int64, bool) {
+	n.documentCommitLogs.Lock()
+	defer n.documentCommitLogs.Unlock()
+
+	var (
+		minIndex int64
+		found    bool
+	)
+	for _, unflushed := range n.documentCommitLogs.byBlock {
+		if !found || unflushed.commitLogIndex < minIndex {
+			minIndex = unflushed.commitLogIndex
+			found = true
+		}
+	}
+	return minIndex, found
+}
+
 func (n *dbNamespace) Snapshot(
 	blockStart,
 	snapshotTime time.Time,
@@ -1278,6 +1796,12 @@ func (n *dbNamespace) NeedsFlush(
 	// are failed with the minimum num failures less than max retries then
 	// we need to flush - otherwise if any in progress we can't flush and if
 	// any not started then we need to flush.
+	if n.nopts.IndexOnly() {
+		// Index only namespaces have no data filesets, their documents are
+		// persisted by the index flush instead.
+		return false, nil
+	}
+
 	n.RLock()
 	defer n.RUnlock()
 	return n.needsFlushWithLock(alignedInclusiveStart, alignedInclusiveEnd)
@@ -1338,6 +1862,92 @@ func (n *dbNamespace) Truncate() (int64, error) {
 	return totalNumSeries, nil
 }
 
+func (n *dbNamespace) DeleteTagged(
+	ctx context.Context,
+	query index.Query,
+	start, end time.Time,
+) (DeleteTaggedResult, error) {
+	callStart := n.nowFn()
+	if n.reverseIndex == nil { // only happens if indexing is enabled.
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return DeleteTaggedResult{}, errNamespaceIndexingDisabled
+	}
+
+	if !start.Before(end) {
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return DeleteTaggedResult{}, xerrors.NewInvalidParamsError(errNamespaceDeleteInvalidRange)
+	}
+
+	if n.reverseIndex.BootstrapsDone() < 1 {
+		// Similar to reading shard data, return not bootstrapped
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return DeleteTaggedResult{}, xerrors.NewRetryableError(errIndexNotBootstrappedToRead)
+	}
+
+	res, err := n.reverseIndex.Query(ctx, query, index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+	})
+	if err != nil {
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return DeleteTaggedResult{}, err
+	}
+
+	n.deleteLock.Lock()
+	defer n.deleteLock.Unlock()
+
+	// NB: The position is taken before the data is removed from memory so
+	// that every entry replayed from the commit log after a restart was
+	// written after the delete. Entries of writes racing with the delete are
+	// replayed rather than lost since they may not have been deleted.
+	position, err := n.commitLogWriter.WritePosition()
+	if err != nil {
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return DeleteTaggedResult{}, err
+	}
+
+	var (
+		multiErr = xerrors.NewMultiError()
+		results  = res.Results.Map()
+		byShard  = make(map[uint32][]ident.ID)
+		shards   = make(map[uint32]databaseShard)
+	)
+	for _, entry := range results.Iter() {
+		id := entry.Key()
+		shard, nsCtx, err := n.shardFor(id)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		if err := shard.DeleteSeriesRange(id, start, end, nsCtx); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		byShard[shard.ID()] = append(byShard[shard.ID()], id)
+		shards[shard.ID()] = shard
+	}
+
+	result := DeleteTaggedResult{
+		NumSeriesByShard: make(map[uint32]int64, len(byShard)),
+	}
+	for shardID, ids := range byShard {
+		// NB: Writing the tombstones also hides the series from the index.
+		if err := shards[shardID].WriteTombstones(ids, start, end, position); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		result.NumSeriesByShard[shardID] = int64(len(ids))
+		result.NumSeries += int64(len(ids))
+	}
+	if !res.Exhaustive {
+		multiErr = multiErr.Add(errNamespaceDeleteNotExhaustive)
+	}
+
+	err = multiErr.FinalError()
+	n.metrics.deleteTagged.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	return result, err
+}
+
 func (n *dbNamespace) Repair(
 	repairer databaseShardRepairer,
 	tr xtime.Range,
@@ -1363,8 +1973,11 @@ func (n *dbNamespace) Repair(
 	shards := n.OwnedShards()
 	numShards := len(shards)
 	if numShards > 0 {
-		throttlePerShard = time.Duration(
-			int64(repairer.Options().RepairThrottle()) / int64(numShards))
+		throttle := repairer.Options().RepairThrottle()
+		if nsThrottle, ok := n.nopts.RepairThrottle(); ok {
+			throttle = nsThrottle
+		}
+		throttlePerShard = time.Duration(int64(throttle) / int64(numShards))
 	}
 
 	workers := xsync.NewWorkerPool(repairer.Options().RepairShardConcurrency())
@@ -1510,6 +2123,9 @@ func (n *dbNamespace) Close() error {
 	n.namespaceReaderMgr.close()
 	n.closeShards(shards, true)
 	close(n.shutdownCh)
+	if n.runtimeOptsListener != nil {
+		n.runtimeOptsListener.Close()
+	}
 	if n.reverseIndex != nil {
 		return n.reverseIndex.Close()
 	}
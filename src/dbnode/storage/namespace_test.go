@@ -30,6 +30,8 @@ import (
 
 	"github.com/m3db/m3/src/cluster/shard"
 	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/ratelimit"
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/sharding"
@@ -41,6 +43,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
 	xmetrics "github.com/m3db/m3/src/dbnode/x/metrics"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	xidx "github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/x/context"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -123,6 +126,22 @@ func newTestNamespaceWithIndex(
 	return ns, closer
 }
 
+func newTestIndexOnlyNamespace(
+	t *testing.T,
+	index NamespaceIndex,
+) (*dbNamespace, closerFn) {
+	opts := defaultTestNs1Opts.
+		SetIndexOnly(true).
+		SetIndexOptions(namespace.NewIndexOptions().
+			SetEnabled(true).
+			SetBlockSize(defaultTestRetentionOpts.BlockSize()))
+	ns, closer := newTestNamespaceWithIDOpts(t, defaultTestNs1ID, opts)
+	require.NoError(t, ns.reverseIndex.Close())
+	ns.reverseIndex = index
+	ns.increasingIndex = &testIncreasingIndex{}
+	return ns, closer
+}
+
 func newTestNamespaceWithTruncateType(
 	t *testing.T,
 	index NamespaceIndex,
@@ -237,6 +256,211 @@ func TestNamespaceWriteShardOwned(t *testing.T) {
 	}
 }
 
+func TestNamespaceWriteQuotaExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	ns, closer := newTestNamespace(t)
+	defer closer()
+
+	// Quotas are enforced by the shard once a write is admitted.
+	var (
+		id       = ident.StringID("foo")
+		now      = time.Now()
+		quotaErr = ratelimit.QuotaExceededError{
+			Namespace: defaultTestNs1ID.String(),
+			Resource:  ratelimit.DatapointsQuotaResource,
+			Limit:     1,
+		}
+	)
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().Write(ctx, id, now, 1.0, xtime.Second, nil, gomock.Any()).
+		Return(ts.Series{}, false, quotaErr).Times(1)
+	ns.shards[testShardIDs[0].ID()] = shard
+
+	_, wasWritten, err := ns.Write(ctx, id, now, 1.0, xtime.Second, nil)
+	require.Error(t, err)
+	require.True(t, ratelimit.IsQuotaExceededError(err))
+	require.False(t, wasWritten)
+}
+
+func TestNamespaceWriteDocument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	idx := NewMockNamespaceIndex(ctrl)
+	ns, closer := newTestIndexOnlyNamespace(t, idx)
+	defer closer()
+
+	var (
+		now = time.Now()
+		d   = doc.Document{
+			ID: []byte("deploy-1"),
+			Fields: []doc.Field{
+				{Name: []byte("service"), Value: []byte("api")},
+			},
+		}
+	)
+	notFound := index.NewMockQueryResults(ctrl)
+	notFound.EXPECT().Size().Return(0)
+	idx.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ index.Query, opts index.QueryOptions) (index.QueryResult, error) {
+			blockSize := ns.nopts.IndexOptions().BlockSize()
+			require.Equal(t, now.Truncate(blockSize), opts.StartInclusive)
+			require.Equal(t, now.Truncate(blockSize).Add(blockSize), opts.EndExclusive)
+			return index.QueryResult{Results: notFound}, nil
+		})
+	idx.EXPECT().WriteBatch(gomock.Any()).DoAndReturn(func(batch *index.WriteBatch) error {
+		require.Equal(t, 1, batch.Len())
+		entries, docs := batch.PendingEntries(), batch.PendingDocs()
+		require.Equal(t, now, entries[0].Timestamp)
+		require.True(t, entries[0].OnIndexSeries.NeedsIndexUpdate(xtime.ToUnixNano(now)))
+		require.Equal(t, d, docs[0])
+		return nil
+	})
+	series, err := ns.WriteDocument(ctx, d, now)
+	require.NoError(t, err)
+
+	// The document is returned as a series to write to the commit log.
+	require.True(t, ns.ID().Equal(series.Namespace))
+	require.Equal(t, "deploy-1", series.ID.String())
+	require.Equal(t, 1, len(series.Tags.Values()))
+	require.Equal(t, "service", series.Tags.Values()[0].Name.String())
+	require.Equal(t, "api", series.Tags.Values()[0].Value.String())
+
+	_, err = ns.WriteDocument(ctx, doc.Document{Fields: d.Fields}, now)
+	require.True(t, xerrors.IsInvalidParams(err))
+}
+
+func TestNamespaceWriteDocumentAlreadyExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	idx := NewMockNamespaceIndex(ctrl)
+	ns, closer := newTestIndexOnlyNamespace(t, idx)
+	defer closer()
+
+	// Documents are not replaced, writing a document with the ID of a
+	// document already in the index block is rejected.
+	found := index.NewMockQueryResults(ctrl)
+	found.EXPECT().Size().Return(1)
+	idx.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(index.QueryResult{Results: found}, nil)
+
+	_, err := ns.WriteDocument(ctx, doc.Document{ID: []byte("deploy-1")}, time.Now())
+	require.Error(t, err)
+	require.True(t, xerrors.IsInvalidParams(err))
+}
+
+func TestNamespaceWriteDocumentRetainsCommitLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	notFound := index.NewMockQueryResults(ctrl)
+	notFound.EXPECT().Size().Return(0).AnyTimes()
+	idx := NewMockNamespaceIndex(ctrl)
+	idx.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(index.QueryResult{Results: notFound}, nil).AnyTimes()
+	idx.EXPECT().WriteBatch(gomock.Any()).Return(nil).AnyTimes()
+	ns, closer := newTestIndexOnlyNamespace(t, idx)
+	defer closer()
+
+	var (
+		blockSize = ns.nopts.IndexOptions().BlockSize()
+		expired   = time.Now().Add(-2 * ns.nopts.RetentionOptions().RetentionPeriod())
+		d         = doc.Document{ID: []byte("deploy-1")}
+	)
+	_, ok := ns.ColdFlushRetainedCommitLogIndex()
+	require.False(t, ok)
+
+	// Documents written before the first cold flush may be in any commit log.
+	_, err := ns.WriteDocument(ctx, d, expired)
+	require.NoError(t, err)
+	retained, ok := ns.ColdFlushRetainedCommitLogIndex()
+	require.True(t, ok)
+	require.Equal(t, int64(0), retained)
+
+	// Later documents are in the commit logs since the last cold flush.
+	ns.documentCommitLogs.lastCommitLogIndex = 5
+	ns.documentCommitLogs.hasLastCommitLogIndex = true
+	_, err = ns.WriteDocument(ctx, d, expired.Add(blockSize))
+	require.NoError(t, err)
+
+	// Blocks that expired are no longer retained unless written to while
+	// they were being flushed.
+	unflushed := ns.unflushedDocuments()
+	_, err = ns.WriteDocument(ctx, d, expired.Add(blockSize))
+	require.NoError(t, err)
+	require.NoError(t, ns.markDocumentsFlushed(unflushed))
+
+	retained, ok = ns.ColdFlushRetainedCommitLogIndex()
+	require.True(t, ok)
+	require.Equal(t, int64(5), retained)
+}
+
+func TestNamespaceWriteDocumentShardNotOwned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	ns, closer := newTestIndexOnlyNamespace(t, NewMockNamespaceIndex(ctrl))
+	defer closer()
+	for i := range ns.shards {
+		ns.shards[i] = nil
+	}
+
+	_, err := ns.WriteDocument(ctx, doc.Document{ID: []byte("foo")}, time.Now())
+	require.Error(t, err)
+	require.True(t, xerrors.IsRetryableError(err))
+}
+
+func TestNamespaceWriteDocumentNotIndexOnly(t *testing.T) {
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	ns, closer := newTestNamespace(t)
+	defer closer()
+
+	_, err := ns.WriteDocument(ctx, doc.Document{ID: []byte("foo")}, time.Now())
+	require.Error(t, err)
+	require.True(t, xerrors.IsInvalidParams(err))
+}
+
+func TestNamespaceWriteIndexOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	ns, closer := newTestIndexOnlyNamespace(t, NewMockNamespaceIndex(ctrl))
+	defer closer()
+
+	now := time.Now()
+	_, wasWritten, err := ns.Write(ctx, ident.StringID("foo"), now, 0.0, xtime.Second, nil)
+	require.True(t, xerrors.IsInvalidParams(err))
+	require.False(t, wasWritten)
+
+	_, wasWritten, err = ns.WriteTagged(ctx, ident.StringID("foo"),
+		ident.EmptyTagIterator, now, 0.0, xtime.Second, nil)
+	require.True(t, xerrors.IsInvalidParams(err))
+	require.False(t, wasWritten)
+}
+
 func TestNamespaceReadEncodedShardNotOwned(t *testing.T) {
 	ctx := context.NewContext()
 	defer ctx.Close()
@@ -433,7 +657,7 @@ func TestNamespaceFlushNotBootstrapped(t *testing.T) {
 	ns, closer := newTestNamespace(t)
 	defer closer()
 	require.Equal(t, errNamespaceNotBootstrapped, ns.WarmFlush(time.Now(), nil))
-	require.Equal(t, errNamespaceNotBootstrapped, ns.ColdFlush(nil))
+	require.Equal(t, errNamespaceNotBootstrapped, ns.ColdFlush(nil, persist.CommitLogFile{}))
 }
 
 func TestNamespaceFlushDontNeedFlush(t *testing.T) {
@@ -443,7 +667,7 @@ func TestNamespaceFlushDontNeedFlush(t *testing.T) {
 
 	ns.bootstrapState = Bootstrapped
 	require.NoError(t, ns.WarmFlush(time.Now(), nil))
-	require.NoError(t, ns.ColdFlush(nil))
+	require.NoError(t, ns.ColdFlush(nil, persist.CommitLogFile{}))
 }
 
 func TestNamespaceFlushSkipFlushed(t *testing.T) {
@@ -892,6 +1116,19 @@ func TestNamespaceNeedsFlushRangeSingleShardConflict(t *testing.T) {
 	assertNeedsFlush(t, ns, t2, t0, false)
 }
 
+func TestNamespaceNeedsFlushIndexOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ns, closer := newTestIndexOnlyNamespace(t, NewMockNamespaceIndex(ctrl))
+	defer closer()
+
+	blockStart := time.Now().Truncate(defaultTestRetentionOpts.BlockSize())
+	needsFlush, err := ns.NeedsFlush(blockStart, blockStart)
+	require.NoError(t, err)
+	require.False(t, needsFlush)
+}
+
 func TestNamespaceNeedsFlushAllSuccess(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1166,6 +1403,45 @@ func TestNamespaceIndexQuery(t *testing.T) {
 	assert.Equal(t, "root", spans[1].OperationName)
 }
 
+func TestNamespaceIndexQueryQuotaExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idx := NewMockNamespaceIndex(ctrl)
+	idx.EXPECT().BootstrapsDone().Return(uint(1)).AnyTimes()
+
+	ns, closer := newTestNamespaceWithIndex(t, idx)
+	defer closer()
+	now := time.Now()
+	ns.quotaLimiter = ratelimit.NewQuotaLimiter(ns.ID().String(),
+		func() time.Time { return now })
+	ns.quotaLimiter.SetQuota(ratelimit.Quota{IndexDocsMatchedPerSecond: 2})
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	query := index.Query{
+		Query: xidx.NewTermQuery([]byte("foo"), []byte("bar")),
+	}
+
+	// Queries match at most one more document than remains of the quota
+	// and are rejected rather than returning partial results when they do.
+	results := index.NewMockQueryResults(ctrl)
+	results.EXPECT().Size().Return(3)
+	idx.EXPECT().Query(gomock.Any(), query, index.QueryOptions{Limit: 3}).
+		Return(index.QueryResult{Results: results}, nil)
+	_, err := ns.QueryIDs(ctx, query, index.QueryOptions{Limit: 10})
+	require.Error(t, err)
+	require.True(t, ratelimit.IsQuotaExceededError(err))
+
+	// The quota for the current window is used up.
+	_, err = ns.QueryIDs(ctx, query, index.QueryOptions{Limit: 10})
+	require.True(t, ratelimit.IsQuotaExceededError(err))
+
+	idx.EXPECT().Close().Return(nil)
+	require.NoError(t, ns.Close())
+}
+
 func TestNamespaceAggregateQuery(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1213,6 +1489,98 @@ func TestNamespaceTicksIndex(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNamespaceDeleteTagged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idx := NewMockNamespaceIndex(ctrl)
+	idx.EXPECT().BootstrapsDone().Return(uint(1))
+
+	ns, closer := newTestNamespaceWithIndex(t, idx)
+	defer closer()
+
+	var (
+		ctx   = context.NewContext()
+		end   = time.Now().Truncate(time.Hour)
+		start = end.Add(-2 * time.Hour)
+		query = index.Query{
+			Query: xidx.NewTermQuery([]byte("foo"), []byte("bar")),
+		}
+		opts = index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+		}
+	)
+	defer ctx.Close()
+
+	results := index.NewQueryResults(ns.ID(), index.QueryResultsOptions{},
+		ns.opts.IndexOptions())
+	_, err := results.AddDocuments([]doc.Document{
+		{ID: []byte("a")},
+		{ID: []byte("b")},
+	})
+	require.NoError(t, err)
+	idx.EXPECT().Query(gomock.Any(), query, opts).Return(index.QueryResult{
+		Results:    results,
+		Exhaustive: true,
+	}, nil)
+
+	// All series are owned by the first shard.
+	shardID := testShardIDs[0].ID()
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().ID().Return(shardID).AnyTimes()
+	ns.shards[shardID] = shard
+
+	position := persist.CommitLogPosition{Index: 3, NumEntries: 7}
+	commitLogWriter := &testCommitLogWriter{position: position}
+	ns.commitLogWriter = commitLogWriter
+
+	// The position is taken before any data is deleted so that writes after
+	// the delete are replayed from the commit log after a restart.
+	deleteSeriesRange := func(ident.ID, time.Time, time.Time, namespace.Context) error {
+		require.True(t, commitLogWriter.positionTaken)
+		return nil
+	}
+	shard.EXPECT().DeleteSeriesRange(ident.NewIDMatcher("a"), start, end, gomock.Any()).
+		DoAndReturn(deleteSeriesRange)
+	shard.EXPECT().DeleteSeriesRange(ident.NewIDMatcher("b"), start, end, gomock.Any()).
+		DoAndReturn(deleteSeriesRange)
+
+	shard.EXPECT().WriteTombstones(gomock.Len(2), start, end, position).Return(nil)
+
+	result, err := ns.DeleteTagged(ctx, query, start, end)
+	require.NoError(t, err)
+	require.Equal(t, DeleteTaggedResult{
+		NumSeries:        2,
+		NumSeriesByShard: map[uint32]int64{shardID: 2},
+	}, result)
+
+	// Deletes require a valid time range.
+	_, err = ns.DeleteTagged(ctx, query, end, start)
+	require.Error(t, err)
+	require.True(t, xerrors.IsInvalidParams(err))
+}
+
+type testCommitLogWriter struct {
+	position      persist.CommitLogPosition
+	positionTaken bool
+}
+
+func (w *testCommitLogWriter) Write(
+	ctx context.Context,
+	series ts.Series,
+	datapoint ts.Datapoint,
+	unit xtime.Unit,
+	annotation ts.Annotation,
+) error {
+	return nil
+}
+
+func (w *testCommitLogWriter) WritePosition() (persist.CommitLogPosition, error) {
+	w.positionTaken = true
+	return w.position, nil
+}
+
 func TestNamespaceIndexDisabledQuery(t *testing.T) {
 	ns, closer := newTestNamespace(t)
 	defer closer()
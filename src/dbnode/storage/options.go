@@ -30,6 +30,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/clock"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
@@ -94,6 +95,7 @@ var (
 	errPersistManagerNotSet       = errors.New("persist manager is not set")
 	errBlockLeaserNotSet          = errors.New("block leaser is not set")
 	errOnColdFlushNotSet          = errors.New("on cold flush is not set, requires at least a no-op implementation")
+	errFileSetRewriteDropSeries   = errors.New("dropping series is not supported by background fileset rewrites")
 )
 
 // NewSeriesOptionsFromOptions creates a new set of database series options from provided options.
@@ -131,6 +133,8 @@ type options struct {
 	transformOptions               series.WriteTransformOptions
 	indexOpts                      index.Options
 	repairOpts                     repair.Options
+	fileSetRewriteEnabled          bool
+	fileSetRewriteOpts             fs.RewriteOptions
 	newEncoderFn                   encoding.NewEncoderFn
 	newDecoderFn                   encoding.NewDecoderFn
 	bootstrapProcessProvider       bootstrap.ProcessProvider
@@ -143,6 +147,7 @@ type options struct {
 	seriesPool                     series.DatabaseSeriesPool
 	bytesPool                      pool.CheckedBytesPool
 	encoderPool                    encoding.EncoderPool
+	histogramEncoderPool           encoding.EncoderPool
 	segmentReaderPool              xio.SegmentReaderPool
 	readerIteratorPool             encoding.ReaderIteratorPool
 	multiReaderIteratorPool        encoding.MultiReaderIteratorPool
@@ -213,6 +218,7 @@ func newOptions(poolOpts pool.ObjectPoolOptions) Options {
 		seriesPool:              series.NewDatabaseSeriesPool(poolOpts),
 		bytesPool:               bytesPool,
 		encoderPool:             encoding.NewEncoderPool(poolOpts),
+		histogramEncoderPool:    encoding.NewEncoderPool(poolOpts),
 		segmentReaderPool:       segmentReaderPool,
 		readerIteratorPool:      encoding.NewReaderIteratorPool(poolOpts),
 		multiReaderIteratorPool: encoding.NewMultiReaderIteratorPool(poolOpts),
@@ -289,6 +295,12 @@ func (o *options) Validate() error {
 		return errOnColdFlushNotSet
 	}
 
+	// Background rewrites only change the filesets on disk so dropped series
+	// would still be served from memory and the index.
+	if o.fileSetRewriteOpts.DropSeriesRegexp != nil {
+		return errFileSetRewriteDropSeries
+	}
+
 	return nil
 }
 
@@ -399,6 +411,26 @@ func (o *options) RepairEnabled() bool {
 	return o.repairEnabled
 }
 
+func (o *options) SetFileSetRewriteEnabled(b bool) Options {
+	opts := *o
+	opts.fileSetRewriteEnabled = b
+	return &opts
+}
+
+func (o *options) FileSetRewriteEnabled() bool {
+	return o.fileSetRewriteEnabled
+}
+
+func (o *options) SetFileSetRewriteOptions(value fs.RewriteOptions) Options {
+	opts := *o
+	opts.fileSetRewriteOpts = value
+	return &opts
+}
+
+func (o *options) FileSetRewriteOptions() fs.RewriteOptions {
+	return o.fileSetRewriteOpts
+}
+
 func (o *options) SetTruncateType(value series.TruncateType) Options {
 	opts := *o
 	opts.truncateType = value
@@ -470,16 +502,29 @@ func (o *options) SetEncodingM3TSZPooled() Options {
 	})
 	opts.encoderPool = encoderPool
 
-	// initialize single reader iterator pool
+	// initialize histogram encoder pool
+	histogramEncoderPool := encoding.NewEncoderPool(opts.poolOpts)
+	histogramEncodingOpts := encodingOpts.SetEncoderPool(histogramEncoderPool)
+	histogramEncoderPool.Init(func() encoding.Encoder {
+		return histogram.NewEncoder(timeZero, histogramEncodingOpts)
+	})
+	opts.histogramEncoderPool = histogramEncoderPool
+
+	// initialize single reader iterator pool, series of namespaces with
+	// histograms enabled are read with the histogram iterator
 	readerIteratorPool.Init(func(r io.Reader, descr namespace.SchemaDescr) encoding.ReaderIterator {
-		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+		return histogram.NewReaderIteratorWithFallback(r,
+			m3tsz.NewReaderIterator(nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts),
+			encodingOpts)
 	})
 	opts.readerIteratorPool = readerIteratorPool
 
 	// initialize multi reader iterator pool
 	multiReaderIteratorPool := encoding.NewMultiReaderIteratorPool(opts.poolOpts)
 	multiReaderIteratorPool.Init(func(r io.Reader, descr namespace.SchemaDescr) encoding.ReaderIterator {
-		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+		return histogram.NewReaderIteratorWithFallback(r,
+			m3tsz.NewReaderIterator(nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts),
+			encodingOpts)
 	})
 	opts.multiReaderIteratorPool = multiReaderIteratorPool
 
@@ -603,6 +648,16 @@ func (o *options) EncoderPool() encoding.EncoderPool {
 	return o.encoderPool
 }
 
+func (o *options) SetHistogramEncoderPool(value encoding.EncoderPool) Options {
+	opts := *o
+	opts.histogramEncoderPool = value
+	return &opts
+}
+
+func (o *options) HistogramEncoderPool() encoding.EncoderPool {
+	return o.histogramEncoderPool
+}
+
 func (o *options) SetSegmentReaderPool(value xio.SegmentReaderPool) Options {
 	opts := *o
 	opts.segmentReaderPool = value
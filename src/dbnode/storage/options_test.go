@@ -21,9 +21,11 @@
 package storage
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -49,3 +51,16 @@ func TestOptionsValidateIndexOptions(t *testing.T) {
 	opts := DefaultTestOptions().SetIndexOptions(nil)
 	require.Error(t, opts.Validate())
 }
+
+func TestOptionsValidateFileSetRewriteDropSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInit := namespace.NewMockInitializer(ctrl)
+	opts := DefaultTestOptions().
+		SetNamespaceInitializer(mockInit).
+		SetFileSetRewriteOptions(fs.RewriteOptions{
+			DropSeriesRegexp: regexp.MustCompile("^foo$"),
+		})
+	require.Equal(t, errFileSetRewriteDropSeries, opts.Validate())
+}
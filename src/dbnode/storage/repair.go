@@ -204,6 +204,9 @@ func (r shardRepairer) Repair(
 		metadatasToFetchBlocksForPerSession = make([][]block.ReplicaMetadata, len(sessions))
 		metadataRes                         = metadata.Compare()
 		seriesWithChecksumMismatches        = metadataRes.ChecksumDifferences.Series()
+		// Tags of the mismatched series as reported by peers so that series
+		// which do not exist locally yet can be created and indexed with them.
+		peerTagsBySeriesID = make(map[string]ident.Tags, seriesWithChecksumMismatches.Len())
 	)
 
 	originID := origin.ID()
@@ -229,6 +232,10 @@ func (r shardRepairer) Repair(
 					continue
 				}
 
+				if len(replicaMetadata.Tags.Values()) > 0 {
+					peerTagsBySeriesID[replicaMetadata.ID.String()] = replicaMetadata.Tags
+				}
+
 				if len(sessions) == 1 {
 					// Optimized path for single session case.
 					metadatasToFetchBlocksForPerSession[0] = append(metadatasToFetchBlocksForPerSession[0], replicaMetadata)
@@ -279,14 +286,23 @@ func (r shardRepairer) Repair(
 
 		for perSeriesReplicaIter.Next() {
 			_, id, block := perSeriesReplicaIter.Current()
-			// TODO(rartoul): Handle tags in both branches: https://github.com/m3db/m3/issues/1848
 			if existing, ok := results.BlockAt(id, block.StartTime()); ok {
 				if err := existing.Merge(block); err != nil {
 					return repair.MetadataComparisonResult{}, err
 				}
-			} else {
-				results.AddBlock(id, ident.Tags{}, block)
+				continue
+			}
+
+			// Tags are only retained for the first block of each series and are
+			// finalized by the shard once loaded, so they must be copied out of
+			// the peer metadata which is finalized along with the context.
+			var tags ident.Tags
+			if _, ok := results.AllSeries().Get(id); !ok {
+				if peerTags, ok := peerTagsBySeriesID[id.String()]; ok {
+					tags = r.opts.IdentifierPool().CloneTags(peerTags)
+				}
 			}
+			results.AddBlock(id, tags, block)
 		}
 	}
 
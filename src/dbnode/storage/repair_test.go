@@ -322,6 +322,104 @@ func testDatabaseShardRepairerRepair(t *testing.T, withLimit bool) {
 	}
 }
 
+func TestDatabaseShardRepairerRepairLoadsPeerTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockAdminSession(ctrl)
+	session.EXPECT().Origin().Return(topology.NewHost("0", "addr0")).AnyTimes()
+	session.EXPECT().TopologyMap().AnyTimes()
+
+	mockClient := client.NewMockAdminClient(ctrl)
+	mockClient.EXPECT().DefaultAdminSession().Return(session, nil).AnyTimes()
+
+	var (
+		rpOpts = testRepairOptions(ctrl).
+			SetAdminClients([]client.AdminClient{mockClient})
+		now   = time.Now()
+		opts  = DefaultTestOptions()
+		iopts = opts.InstrumentOptions()
+	)
+	opts = opts.
+		SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time { return now })).
+		SetInstrumentOptions(iopts.SetMetricsScope(tally.NoopScope))
+
+	var (
+		namespaceID     = ident.StringID("testNamespace")
+		start           = now
+		end             = now.Add(defaultTestRetentionOpts.BlockSize())
+		repairTimeRange = xtime.Range{Start: start, End: end}
+		blockStart      = now.Add(30 * time.Minute)
+		checksums       = []uint32{1, 2}
+		lastRead        = now.Add(-time.Minute)
+		shardID         = uint32(0)
+		shard           = NewMockdatabaseShard(ctrl)
+		seriesTags      = ident.NewTags(ident.StringTag("city", "nyc"))
+	)
+
+	localResults := block.NewFetchBlocksMetadataResults()
+	results := block.NewFetchBlockMetadataResults()
+	results.Add(block.NewFetchBlockMetadataResult(blockStart, 1, &checksums[0], lastRead, nil))
+	localResults.Add(block.NewFetchBlocksMetadataResult(ident.StringID("foo"), nil, results))
+	shard.EXPECT().
+		FetchBlocksMetadataV2(gomock.Any(), start, end, gomock.Any(), nil, gomock.Any()).
+		Return(localResults, nil, nil)
+	shard.EXPECT().ID().Return(shardID).AnyTimes()
+
+	// Mismatched checksum so the peer's block should be streamed and loaded
+	// along with the tags the peer reported for the series.
+	peerBlock := block.ReplicaMetadata{
+		Host:     topology.NewHost("1", "addr1"),
+		Metadata: block.NewMetadata(ident.StringID("foo"), seriesTags, blockStart, 1, &checksums[1], lastRead),
+	}
+	peerIter := client.NewMockPeerBlockMetadataIter(ctrl)
+	gomock.InOrder(
+		peerIter.EXPECT().Next().Return(true),
+		peerIter.EXPECT().Current().Return(peerBlock.Host, peerBlock.Metadata),
+		peerIter.EXPECT().Next().Return(false),
+		peerIter.EXPECT().Err().Return(nil),
+	)
+	session.EXPECT().
+		FetchBlocksMetadataFromPeers(namespaceID, shardID, start, end,
+			rpOpts.RepairConsistencyLevel(), gomock.Any()).
+		Return(peerIter, nil)
+
+	dbBlock := block.NewMockDatabaseBlock(ctrl)
+	dbBlock.EXPECT().StartTime().Return(blockStart).AnyTimes()
+	peerBlocksIter := client.NewMockPeerBlocksIter(ctrl)
+	gomock.InOrder(
+		peerBlocksIter.EXPECT().Next().Return(true),
+		peerBlocksIter.EXPECT().Current().Return(peerBlock.Host, peerBlock.Metadata.ID, dbBlock),
+		peerBlocksIter.EXPECT().Next().Return(false),
+	)
+	nsMeta, err := namespace.NewMetadata(namespaceID, namespace.NewOptions())
+	require.NoError(t, err)
+	session.EXPECT().
+		FetchBlocksFromPeers(nsMeta, shardID, rpOpts.RepairConsistencyLevel(),
+			[]block.ReplicaMetadata{peerBlock}, gomock.Any()).
+		Return(peerBlocksIter, nil)
+
+	shard.EXPECT().LoadBlocks(gomock.Any()).DoAndReturn(func(series *result.Map) error {
+		require.Equal(t, 1, series.Len())
+		loaded, ok := series.Get(ident.StringID("foo"))
+		require.True(t, ok)
+		require.True(t, ident.NewTagIterMatcher(ident.NewTagsIterator(seriesTags)).
+			Matches(ident.NewTagsIterator(loaded.Tags)))
+		loadedBlock, ok := loaded.Blocks.BlockAt(blockStart)
+		require.True(t, ok)
+		require.Equal(t, dbBlock, loadedBlock)
+		return nil
+	})
+
+	repairer := newShardRepairer(opts, rpOpts).(shardRepairer)
+	repairer.recordFn = func(ident.ID, databaseShard, repair.MetadataComparisonResult) {}
+
+	ctx := context.NewContext()
+	res, err := repairer.Repair(ctx, namespace.Context{ID: namespaceID}, nsMeta, repairTimeRange, shard)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.ChecksumDifferences.NumSeries())
+}
+
 type multiSessionTestMock struct {
 	host    topology.Host
 	client  *client.MockAdminClient
@@ -127,6 +127,11 @@ type databaseBuffer interface {
 		opts FetchBlocksMetadataOptions,
 	) (block.FetchBlockMetadataResults, error)
 
+	DeleteRange(
+		start, end time.Time,
+		nsCtx namespace.Context,
+	) error
+
 	IsEmpty() bool
 
 	ColdFlushBlockStarts(blockStates map[xtime.UnixNano]BlockState) OptimizedTimes
@@ -375,6 +380,32 @@ func (b *dbBuffer) Write(
 	return ok, writeType, err
 }
 
+// DeleteRange rewrites any buckets overlapping [start, end) without the
+// datapoints that fall within the range.
+func (b *dbBuffer) DeleteRange(
+	start, end time.Time,
+	nsCtx namespace.Context,
+) error {
+	var (
+		deleted   = xtime.Range{Start: start, End: end}
+		blockSize = b.opts.RetentionOptions().BlockSize()
+	)
+	for _, blockStart := range b.inOrderBlockStarts {
+		blockRange := xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)}
+		if !deleted.Overlaps(blockRange) {
+			continue
+		}
+		buckets, exists := b.bucketVersionsAt(blockStart)
+		if !exists {
+			continue
+		}
+		if err := buckets.deleteRange(deleted, nsCtx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *dbBuffer) IsEmpty() bool {
 	// A buffer can only be empty if there are no buckets in its map, since
 	// buckets are only created when a write for a new block start is done, and
@@ -1034,6 +1065,15 @@ func (b *BufferBucketVersions) merge(writeType WriteType, nsCtx namespace.Contex
 	return res, nil
 }
 
+func (b *BufferBucketVersions) deleteRange(deleted xtime.Range, nsCtx namespace.Context) error {
+	for _, bucket := range b.buckets {
+		if err := bucket.deleteRange(deleted, nsCtx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *BufferBucketVersions) removeBucketsUpToVersion(
 	writeType WriteType,
 	version int,
@@ -1373,6 +1413,26 @@ func (b *BufferBucket) merge(nsCtx namespace.Context) (int, error) {
 		return 0, nil
 	}
 
+	return b.mergeExcluding(xtime.Range{}, nsCtx)
+}
+
+// deleteRange rewrites the bucket without any datapoints that fall within the
+// deleted range.
+func (b *BufferBucket) deleteRange(deleted xtime.Range, nsCtx namespace.Context) error {
+	if len(b.encoders) == 0 && len(b.loadedBlocks) == 0 {
+		return nil
+	}
+
+	_, err := b.mergeExcluding(deleted, nsCtx)
+	return err
+}
+
+// mergeExcluding merges all encoders and loaded blocks into a single encoder,
+// dropping any datapoints that fall within the excluded range.
+func (b *BufferBucket) mergeExcluding(
+	excluded xtime.Range,
+	nsCtx namespace.Context,
+) (int, error) {
 	var (
 		start   = b.start
 		readers = make([]xio.SegmentReader, 0, len(b.encoders)+len(b.loadedBlocks))
@@ -1408,7 +1468,8 @@ func (b *BufferBucket) merge(nsCtx namespace.Context) (int, error) {
 		}
 	}
 
-	encoder, lastWriteAt, err := mergeStreamsToEncoder(start, readers, b.opts, nsCtx)
+	encoder, lastWriteAt, err := mergeStreamsToEncoderExcluding(start, readers,
+		excluded, b.opts, nsCtx)
 	if err != nil {
 		return 0, err
 	}
@@ -1432,6 +1493,18 @@ func mergeStreamsToEncoder(
 	streams []xio.SegmentReader,
 	opts Options,
 	nsCtx namespace.Context,
+) (encoding.Encoder, time.Time, error) {
+	return mergeStreamsToEncoderExcluding(blockStart, streams, xtime.Range{}, opts, nsCtx)
+}
+
+// mergeStreamsToEncoderExcluding is the same as mergeStreamsToEncoder but
+// skips any datapoints with a timestamp within the excluded range.
+func mergeStreamsToEncoderExcluding(
+	blockStart time.Time,
+	streams []xio.SegmentReader,
+	excluded xtime.Range,
+	opts Options,
+	nsCtx namespace.Context,
 ) (encoding.Encoder, time.Time, error) {
 	bopts := opts.DatabaseBlockOptions()
 	encoder := opts.EncoderPool().Get()
@@ -1443,6 +1516,9 @@ func mergeStreamsToEncoder(
 	iter.Reset(streams, blockStart, opts.RetentionOptions().BlockSize(), nsCtx.Schema)
 	for iter.Next() {
 		dp, unit, annotation := iter.Current()
+		if !dp.Timestamp.Before(excluded.Start) && dp.Timestamp.Before(excluded.End) {
+			continue
+		}
 		if err := encoder.Encode(dp, unit, annotation); err != nil {
 			encoder.Close()
 			return nil, timeZero, err
@@ -166,6 +166,20 @@ func (mr *MockdatabaseBufferMockRecorder) FetchBlocksMetadata(ctx, start, end, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMetadata", reflect.TypeOf((*MockdatabaseBuffer)(nil).FetchBlocksMetadata), ctx, start, end, opts)
 }
 
+// DeleteRange mocks base method
+func (m *MockdatabaseBuffer) DeleteRange(start, end time.Time, nsCtx namespace.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRange", start, end, nsCtx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRange indicates an expected call of DeleteRange
+func (mr *MockdatabaseBufferMockRecorder) DeleteRange(start, end, nsCtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRange", reflect.TypeOf((*MockdatabaseBuffer)(nil).DeleteRange), start, end, nsCtx)
+}
+
 // IsEmpty mocks base method
 func (m *MockdatabaseBuffer) IsEmpty() bool {
 	m.ctrl.T.Helper()
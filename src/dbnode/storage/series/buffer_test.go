@@ -332,6 +332,54 @@ func TestBufferReadOnlyMatchingBuckets(t *testing.T) {
 	requireReaderValuesEqual(t, []DecodedTestValue{data[1]}, results, opts, namespace.Context{})
 }
 
+func TestBufferDeleteRange(t *testing.T) {
+	opts := newBufferTestOptions()
+	rops := opts.RetentionOptions()
+	curr := time.Now().Truncate(rops.BlockSize())
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+	buffer := newDatabaseBuffer().(*dbBuffer)
+	buffer.Reset(databaseBufferResetOptions{
+		ID:      ident.StringID("foo"),
+		Options: opts,
+	})
+
+	data := []DecodedTestValue{
+		{curr.Add(secs(10)), 1, xtime.Second, nil},
+		{curr.Add(secs(20)), 2, xtime.Second, nil},
+		{curr.Add(secs(30)), 3, xtime.Second, nil},
+		{curr.Add(mins(3)), 4, xtime.Second, nil},
+	}
+
+	for _, v := range data {
+		curr = v.Timestamp
+		verifyWriteToBufferSuccess(t, buffer, v, nil)
+	}
+
+	// Delete the second datapoint only, the bucket for the second block
+	// should remain untouched.
+	require.NoError(t, buffer.DeleteRange(start.Add(secs(15)),
+		start.Add(secs(25)), namespace.Context{}))
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	results, err := buffer.ReadEncoded(ctx, timeZero, timeDistantFuture, namespace.Context{})
+	require.NoError(t, err)
+	requireReaderValuesEqual(t, []DecodedTestValue{data[0], data[2], data[3]},
+		results, opts, namespace.Context{})
+
+	// Writes into the deleted range are accepted after the delete.
+	curr = start.Add(secs(30))
+	verifyWriteToBufferSuccess(t, buffer, data[1], nil)
+
+	results, err = buffer.ReadEncoded(ctx, timeZero, timeDistantFuture, namespace.Context{})
+	require.NoError(t, err)
+	requireReaderValuesEqual(t, data, results, opts, namespace.Context{})
+}
+
 func TestBufferWriteOutOfOrder(t *testing.T) {
 	opts := newBufferTestOptions()
 	rops := opts.RetentionOptions()
@@ -41,6 +41,7 @@ type Entry struct {
 	Series         series.DatabaseSeries
 	Index          uint64
 	curReadWriters int32
+	tombstoned     int32
 	reverseIndex   entryIndexState
 }
 
@@ -81,6 +82,22 @@ func (entry *Entry) DecrementReaderWriterCount() {
 	atomic.AddInt32(&entry.curReadWriters, -1)
 }
 
+// IsTombstoned returns whether the series may have tombstones that have not
+// been cleared by a write within their deleted range.
+func (entry *Entry) IsTombstoned() bool {
+	return atomic.LoadInt32(&entry.tombstoned) == 1
+}
+
+// SetTombstoned sets whether the series may have tombstones that have not
+// been cleared by a write within their deleted range.
+func (entry *Entry) SetTombstoned(tombstoned bool) {
+	var v int32
+	if tombstoned {
+		v = 1
+	}
+	atomic.StoreInt32(&entry.tombstoned, v)
+}
+
 // OnReleaseReadWriteRef decrements a read/write ref, it's named
 // differently to decouple the concrete task needed when a ref
 // is released and the intent to release the ref (simpler for
@@ -161,6 +178,15 @@ func (entry *Entry) OnIndexFinalize(blockStartNanos xtime.UnixNano) {
 	entry.DecrementReaderWriterCount()
 }
 
+// OnIndexRemoved marks the given block start as no longer indexed, such as
+// when the entry was removed from the index block, so that the next write
+// for the block start indexes the entry again.
+func (entry *Entry) OnIndexRemoved(blockStartNanos xtime.UnixNano) {
+	entry.reverseIndex.Lock()
+	entry.reverseIndex.setRemovedWithWLock(blockStartNanos)
+	entry.reverseIndex.Unlock()
+}
+
 // entryIndexState is used to capture the state of indexing for a single shard
 // entry. It's used to prevent redundant indexing operations.
 // NB(prateek): We need this amount of state because in the worst case, as we can have 3 active blocks being
@@ -227,6 +253,15 @@ func (s *entryIndexState) setSuccessWithWLock(t xtime.UnixNano) {
 	})
 }
 
+func (s *entryIndexState) setRemovedWithWLock(t xtime.UnixNano) {
+	for i := range s.states {
+		if s.states[i].blockStart.Equal(t) {
+			s.states[i].success = false
+			return
+		}
+	}
+}
+
 func (s *entryIndexState) setAttemptWithWLock(t xtime.UnixNano, attempt bool) {
 	// first check if we have the block start in the slice already
 	for i := range s.states {
@@ -555,6 +555,38 @@ func (s *dbSeries) ColdFlushBlockStarts(blockStates BootstrappedBlockStateSnapsh
 	return s.buffer.ColdFlushBlockStarts(blockStates.Snapshot)
 }
 
+func (s *dbSeries) DeleteRange(
+	start, end time.Time,
+	nsCtx namespace.Context,
+) error {
+	var (
+		deleted     = xtime.Range{Start: start, End: end}
+		blockSize   = s.opts.RetentionOptions().BlockSize()
+		cachePolicy = s.opts.CachePolicy()
+	)
+
+	s.Lock()
+	defer s.Unlock()
+
+	// Remove any cached blocks overlapping the range so that subsequent reads
+	// go through the buffer and the block retriever.
+	for startNano, currBlock := range s.cachedBlocks.AllBlocks() {
+		blockStart := startNano.ToTime()
+		blockRange := xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)}
+		if !deleted.Overlaps(blockRange) {
+			continue
+		}
+		s.cachedBlocks.RemoveBlockAt(blockStart)
+		// Same as when expiring blocks in a tick, blocks retrieved from disk
+		// with the LRU policy are closed by the WiredList.
+		if cachePolicy != CacheLRU || !currBlock.WasRetrievedFromDisk() {
+			currBlock.Close()
+		}
+	}
+
+	return s.buffer.DeleteRange(start, end, nsCtx)
+}
+
 func (s *dbSeries) Close() {
 	s.Lock()
 	defer s.Unlock()
@@ -140,6 +140,13 @@ type DatabaseSeries interface {
 	// ColdFlushBlockStarts returns the block starts that need cold flushes.
 	ColdFlushBlockStarts(blockStates BootstrappedBlockStateSnapshot) OptimizedTimes
 
+	// DeleteRange removes any data within [start, end) held in memory for
+	// this series. Data that has already been persisted is not affected.
+	DeleteRange(
+		start, end time.Time,
+		nsCtx namespace.Context,
+	) error
+
 	// Close will close the series and if pooled returned to the pool.
 	Close()
 
@@ -27,6 +27,7 @@ import (
 	"io"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/clock"
@@ -34,10 +35,12 @@ import (
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/ratelimit"
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/storage/coldcompaction"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/storage/index/convert"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
@@ -64,6 +67,10 @@ import (
 const (
 	shardIterateBatchPercent = 0.01
 	shardIterateBatchMinSize = 16
+
+	// minTombstoneRecordsToCompact is the number of records the tombstones
+	// log must hold before it is compacted outside of a cold flush.
+	minTombstoneRecordsToCompact = 1024
 )
 
 var (
@@ -98,6 +105,14 @@ type filesetPathsBeforeFn func(
 	t time.Time,
 ) ([]string, error)
 
+type dataFileSetSizeFn func(
+	filePathPrefix string,
+	namespace ident.ID,
+	shardID uint32,
+	blockStart time.Time,
+	volume int,
+) (int64, error)
+
 type tickPolicy int
 
 const (
@@ -162,20 +177,26 @@ type dbShard struct {
 	increasingIndex          increasingIndex
 	seriesPool               series.DatabaseSeriesPool
 	reverseIndex             NamespaceIndex
+	quotaLimiter             *ratelimit.QuotaLimiter
 	insertQueue              *dbShardInsertQueue
 	lookup                   *shardMap
 	list                     *list.List
 	bootstrapState           BootstrapState
 	newMergerFn              fs.NewMergerFn
 	newFSMergeWithMemFn      newFSMergeWithMemFn
+	newDownsamplerFn         fs.NewDownsamplerFn
+	newRewriterFn            fs.NewRewriterFn
 	filesetsFn               filesetsFn
 	filesetPathsBeforeFn     filesetPathsBeforeFn
+	dataFileSetSizeFn        dataFileSetSizeFn
 	deleteFilesFn            deleteFilesFn
 	snapshotFilesFn          snapshotFilesFn
 	sleepFn                  func(time.Duration)
 	identifierPool           ident.Pool
 	contextPool              context.Pool
 	flushState               shardFlushState
+	tombstones               shardTombstones
+	deferredColdFlushes      shardDeferredColdFlushes
 	tickWg                   *sync.WaitGroup
 	runtimeOptsListenClosers []xclose.SimpleCloser
 	currRuntimeOptions       dbShardRuntimeOptions
@@ -195,6 +216,7 @@ type dbShardRuntimeOptions struct {
 	writeNewSeriesAsync      bool
 	tickSleepSeriesBatchSize int
 	tickSleepPerSeries       time.Duration
+	coldFlushPlannerOpts     coldcompaction.PlannerOptions
 }
 
 type dbShardMetrics struct {
@@ -208,6 +230,10 @@ type dbShardMetrics struct {
 	insertAsyncWriteInvalidParamsErrors tally.Counter
 	insertAsyncIndexErrors              tally.Counter
 	insertColdWriteSkipIndex            tally.Counter
+	offloadedFileSets                   tally.Counter
+	coldFlushBlocksMerged               tally.Counter
+	coldFlushBlocksDeferred             tally.Counter
+	coldFlushWriteAmplification         tally.Histogram
 }
 
 func newDatabaseShardMetrics(shardID uint32, scope tally.Scope) dbShardMetrics {
@@ -237,6 +263,11 @@ func newDatabaseShardMetrics(shardID uint32, scope tally.Scope) dbShardMetrics {
 			"suberror_type": "write-batch-error",
 		}).Counter(insertErrorName),
 		insertColdWriteSkipIndex: scope.Counter("insert-cold-write-skip-index"),
+		offloadedFileSets:        scope.Counter("offloaded-filesets"),
+		coldFlushBlocksMerged:    scope.Counter("cold-flush-blocks-merged"),
+		coldFlushBlocksDeferred:  scope.Counter("cold-flush-blocks-deferred"),
+		coldFlushWriteAmplification: scope.Histogram("cold-flush-write-amplification",
+			append(tally.ValueBuckets{0}, tally.MustMakeExponentialValueBuckets(16, 2, 16)...)),
 	}
 }
 
@@ -258,6 +289,108 @@ func newShardFlushState() shardFlushState {
 	}
 }
 
+// shardTombstones tracks the time ranges of series data deleted from blocks
+// that have already been flushed, until the filesets for those blocks have
+// been rewritten by a cold flush, along with every tombstone persisted for
+// the shard so that deleted data is not restored by a bootstrap.
+type shardTombstones struct {
+	// numUnwritten is the number of tombstones whose series have not been
+	// written within the deleted range since, it lets writes of series not
+	// yet inserted skip looking up tombstones when there are none to clear.
+	// Writes of inserted series check the tombstoned flag of their entry.
+	numUnwritten int64
+	// hasPending is whether any block is pending a rewrite or being
+	// rewritten, it lets reads skip looking up tombstones to filter.
+	hasPending int32
+
+	sync.Mutex
+	byBlock map[xtime.UnixNano]map[string]xtime.Ranges
+	// rewriting are the tombstones taken by a cold flush until it has
+	// rewritten their blocks, reads keep filtering the data of those blocks.
+	rewriting map[xtime.UnixNano]map[string]xtime.Ranges
+	persisted []fs.Tombstone
+	// unwritten are the indexes of the persisted tombstones whose series
+	// have not been written within the deleted range since, by series ID.
+	unwritten map[string][]int
+	// writer appends to the tombstones log, records are appended while
+	// holding the lock but synced after releasing it.
+	writer *fs.TombstonesWriter
+}
+
+func newShardTombstones(writer *fs.TombstonesWriter) shardTombstones {
+	return shardTombstones{
+		byBlock:   make(map[xtime.UnixNano]map[string]xtime.Ranges),
+		unwritten: make(map[string][]int),
+		writer:    writer,
+	}
+}
+
+func (t *shardTombstones) updatePendingWithLock() {
+	var hasPending int32
+	if len(t.byBlock) > 0 || len(t.rewriting) > 0 {
+		hasPending = 1
+	}
+	atomic.StoreInt32(&t.hasPending, hasPending)
+}
+
+// deletedWithLock returns the time ranges deleted from the series in the
+// block that are still held by its fileset.
+func (t *shardTombstones) deletedWithLock(
+	id ident.ID,
+	blockStart xtime.UnixNano,
+) (xtime.Ranges, bool) {
+	var deleted xtime.Ranges
+	for _, byBlock := range []map[xtime.UnixNano]map[string]xtime.Ranges{
+		t.byBlock, t.rewriting,
+	} {
+		ranges, ok := byBlock[blockStart][string(id.Bytes())]
+		if !ok {
+			continue
+		}
+		if deleted == nil {
+			deleted = xtime.NewRanges()
+		}
+		deleted.AddRanges(ranges)
+	}
+	return deleted, deleted != nil
+}
+
+// indexUnwrittenWithLock rebuilds the index of the tombstones whose series
+// have not been written within the deleted range since.
+func (t *shardTombstones) indexUnwrittenWithLock() {
+	t.unwritten = make(map[string][]int)
+	for i, tombstone := range t.persisted {
+		if !tombstone.Written {
+			t.unwritten[string(tombstone.ID)] = append(t.unwritten[string(tombstone.ID)], i)
+		}
+	}
+	atomic.StoreInt64(&t.numUnwritten, int64(len(t.unwritten)))
+}
+
+// shardDeferredColdFlushes tracks the blocks whose cold writes were deferred
+// by the cold flush planner along with the commit logs that must be retained
+// until those cold writes have been merged into a new volume.
+type shardDeferredColdFlushes struct {
+	sync.Mutex
+	byBlock map[xtime.UnixNano]deferredColdFlush
+	// lastCommitLogIndex is the index of the commit log rotated before the
+	// last cold flush, all cold writes of blocks that were not deferred by
+	// the last cold flush are in commit logs with at least this index.
+	lastCommitLogIndex    int64
+	hasLastCommitLogIndex bool
+}
+
+type deferredColdFlush struct {
+	since          time.Time
+	commitLogIndex int64
+}
+
+func newShardDeferredColdFlushes() shardDeferredColdFlushes {
+	return shardDeferredColdFlushes{
+		byBlock: make(map[xtime.UnixNano]deferredColdFlush),
+	}
+}
+
 func newDatabaseShard(
 	namespaceMetadata namespace.Metadata,
 	shard uint32,
@@ -265,12 +398,17 @@ func newDatabaseShard(
 	namespaceReaderMgr databaseNamespaceReaderManager,
 	increasingIndex increasingIndex,
 	reverseIndex NamespaceIndex,
+	quotaLimiter *ratelimit.QuotaLimiter,
 	needsBootstrap bool,
 	opts Options,
 	seriesOpts series.Options,
 ) databaseShard {
-	scope := opts.InstrumentOptions().MetricsScope().
-		SubScope("dbshard")
+	var (
+		scope = opts.InstrumentOptions().MetricsScope().
+			SubScope("dbshard")
+		tombstonesWriter = fs.NewTombstonesWriter(
+			opts.CommitLogOptions().FilesystemOptions(), namespaceMetadata.ID(), shard)
+	)
 
 	s := &dbShard{
 		opts:                 opts,
@@ -283,18 +421,24 @@ func newDatabaseShard(
 		increasingIndex:      increasingIndex,
 		seriesPool:           opts.DatabaseSeriesPool(),
 		reverseIndex:         reverseIndex,
+		quotaLimiter:         quotaLimiter,
 		lookup:               newShardMap(shardMapOptions{}),
 		list:                 list.New(),
 		newMergerFn:          fs.NewMerger,
 		newFSMergeWithMemFn:  newFSMergeWithMem,
+		newDownsamplerFn:     fs.NewDownsampler,
+		newRewriterFn:        fs.NewRewriter,
 		filesetsFn:           fs.DataFiles,
 		filesetPathsBeforeFn: fs.DataFileSetsBefore,
+		dataFileSetSizeFn:    fs.DataFileSetSize,
 		deleteFilesFn:        fs.DeleteFiles,
 		snapshotFilesFn:      fs.SnapshotFiles,
 		sleepFn:              time.Sleep,
 		identifierPool:       opts.IdentifierPool(),
 		contextPool:          opts.ContextPool(),
 		flushState:           newShardFlushState(),
+		tombstones:           newShardTombstones(tombstonesWriter),
+		deferredColdFlushes:  newShardDeferredColdFlushes(),
 		tickWg:               &sync.WaitGroup{},
 		coldWritesEnabled:    namespaceMetadata.Options().ColdWritesEnabled(),
 		logger:               opts.InstrumentOptions().Logger(),
@@ -342,6 +486,7 @@ func (s *dbShard) SetRuntimeOptions(value runtime.Options) {
 		writeNewSeriesAsync:      value.WriteNewSeriesAsync(),
 		tickSleepSeriesBatchSize: value.TickSeriesBatchSize(),
 		tickSleepPerSeries:       value.TickPerSeriesSleepDuration(),
+		coldFlushPlannerOpts:     value.ColdFlushPlannerOptions(),
 	}
 	s.Unlock()
 }
@@ -365,7 +510,75 @@ func (s *dbShard) Stream(
 	onRetrieve block.OnRetrieveBlock,
 	nsCtx namespace.Context,
 ) (xio.BlockReader, error) {
-	return s.DatabaseBlockRetriever.Stream(ctx, s.shard, id, blockStart, onRetrieve, nsCtx)
+	deleted, ok := s.pendingTombstones(id, blockStart)
+	if !ok {
+		return s.DatabaseBlockRetriever.Stream(ctx, s.shard, id, blockStart, onRetrieve, nsCtx)
+	}
+
+	// NB: Data deleted from a block is only removed from its fileset by the
+	// next cold flush, until then it is filtered from every read of the
+	// block which is not cached by the series since it holds the data.
+	reader, err := s.DatabaseBlockRetriever.Stream(ctx, s.shard, id, blockStart, nil, nsCtx)
+	if err != nil || reader.IsEmpty() {
+		return reader, err
+	}
+	return s.readerExcludingDeleted(ctx, reader, deleted, nsCtx)
+}
+
+// pendingTombstones returns the time ranges deleted from the series in the
+// block whose fileset has not been rewritten without them yet.
+func (s *dbShard) pendingTombstones(id ident.ID, blockStart time.Time) (xtime.Ranges, bool) {
+	if atomic.LoadInt32(&s.tombstones.hasPending) == 0 {
+		return nil, false
+	}
+
+	s.tombstones.Lock()
+	defer s.tombstones.Unlock()
+	return s.tombstones.deletedWithLock(id, xtime.ToUnixNano(blockStart))
+}
+
+func (s *dbShard) readerExcludingDeleted(
+	ctx context.Context,
+	reader xio.BlockReader,
+	deleted xtime.Ranges,
+	nsCtx namespace.Context,
+) (xio.BlockReader, error) {
+	iter := s.opts.MultiReaderIteratorPool().Get()
+	iter.Reset([]xio.SegmentReader{reader.SegmentReader}, reader.Start,
+		reader.BlockSize, nsCtx.Schema)
+	defer iter.Close()
+
+	encoder := s.opts.EncoderPool().Get()
+	encoder.Reset(reader.Start, s.opts.DatabaseBlockOptions().DatabaseBlockAllocSize(),
+		nsCtx.Schema)
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if deleted.Overlaps(xtime.Range{Start: dp.Timestamp, End: dp.Timestamp.Add(1)}) {
+			continue
+		}
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			encoder.Close()
+			return xio.EmptyBlockReader, err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		encoder.Close()
+		return xio.EmptyBlockReader, err
+	}
+
+	// The encoder must outlive its stream which is read until the context
+	// is closed.
+	ctx.RegisterCloser(encoder)
+	stream, ok := encoder.Stream(ctx)
+	if !ok {
+		return xio.EmptyBlockReader, nil
+	}
+	ctx.RegisterFinalizer(stream)
+	return xio.BlockReader{
+		SegmentReader: stream,
+		Start:         reader.Start,
+		BlockSize:     reader.BlockSize,
+	}, nil
 }
 
 // IsBlockRetrievable implements series.QueryableBlockRetriever
@@ -645,6 +858,9 @@ func (s *dbShard) Close() error {
 	// should be increased.
 	cancellable := context.NewNoOpCanncellable()
 	_, err := s.tickAndExpire(cancellable, tickPolicyCloseShard, namespace.Context{})
+	if closeErr := s.tombstones.writer.Close(); err == nil {
+		err = closeErr
+	}
 	return err
 }
 
@@ -661,6 +877,12 @@ func (s *dbShard) isClosingWithLock() bool {
 
 func (s *dbShard) Tick(c context.Cancellable, startTime time.Time, nsCtx namespace.Context) (tickResult, error) {
 	s.removeAnyFlushStatesTooEarly(startTime)
+
+	// Persist the records of writes made after deletes, these are not
+	// synced on the write path.
+	if err := s.tombstones.writer.Sync(); err != nil {
+		s.logger.Error("failed to sync tombstones log", zap.Error(err))
+	}
 	return s.tickAndExpire(c, tickPolicyRegular, nsCtx)
 }
 
@@ -875,7 +1097,7 @@ func (s *dbShard) writeAndIndex(
 	annotation []byte,
 	wOpts series.WriteOptions,
 	shouldReverseIndex bool,
-) (ts.Series, bool, error) {
+) (_ ts.Series, _ bool, err error) {
 	// Prepare write
 	entry, opts, err := s.tryRetrieveWritableSeries(id)
 	if err != nil {
@@ -884,6 +1106,27 @@ func (s *dbShard) writeAndIndex(
 
 	writable := entry != nil
 
+	// Writes count towards the namespace quota, as do any new series
+	// they insert, and are refunded if they fail.
+	if s.quotaLimiter != nil {
+		var newSeries int64
+		if !writable {
+			newSeries = 1
+		}
+		if err := s.quotaLimiter.AddWrite(1, newSeries); err != nil {
+			if writable {
+				// Release the reference taken by tryRetrieveWritableSeries.
+				entry.DecrementReaderWriterCount()
+			}
+			return ts.Series{}, false, err
+		}
+		defer func() {
+			if err != nil {
+				s.quotaLimiter.RefundWrite(1, newSeries)
+			}
+		}()
+	}
+
 	// If no entry and we are not writing new series asynchronously.
 	if !writable && !opts.writeNewSeriesAsync {
 		// Avoid double lookup by enqueueing insert immediately.
@@ -935,6 +1178,12 @@ func (s *dbShard) writeAndIndex(
 		commitLogSeriesID = entry.Series.ID()
 		commitLogSeriesTags = entry.Series.Tags()
 		commitLogSeriesUniqueIndex = entry.Index
+		if err == nil && s.clearTombstonesForWrite(entry, commitLogSeriesID, timestamp) &&
+			s.reverseIndex != nil {
+			// The series may have been removed from the index block when its
+			// data was deleted so index it again.
+			entry.OnIndexRemoved(s.reverseIndex.BlockStartForWriteTime(timestamp))
+		}
 		if err == nil && shouldReverseIndex {
 			if entry.NeedsIndexUpdate(s.reverseIndex.BlockStartForWriteTime(timestamp)) {
 				err = s.insertSeriesForIndexingAsyncBatched(entry, timestamp,
@@ -947,6 +1196,10 @@ func (s *dbShard) writeAndIndex(
 			return ts.Series{}, false, err
 		}
 	} else {
+		// New series are indexed by the insert so only the tombstones need
+		// to be cleared.
+		s.clearTombstonesForWrite(nil, id, timestamp)
+
 		// This is an asynchronous insert and write which means we need to clone the annotation
 		// because its lifecycle in the commit log is independent of the calling function.
 		var annotationClone checked.Bytes
@@ -1385,6 +1638,18 @@ func (s *dbShard) insertNewShardEntryWithLock(entry *lookup.Entry) {
 		NoCopyKey:     true,
 		NoFinalizeKey: true,
 	})
+
+	// NB: Tombstones are added before their series are looked up to be
+	// flagged, checking them after inserting the entry ensures that the
+	// entry is flagged either here or by the tombstones being written.
+	if atomic.LoadInt64(&s.tombstones.numUnwritten) > 0 {
+		s.tombstones.Lock()
+		_, tombstoned := s.tombstones.unwritten[string(copiedID.Bytes())]
+		s.tombstones.Unlock()
+		if tombstoned {
+			entry.SetTombstoned(true)
+		}
+	}
 }
 
 func (s *dbShard) insertSeriesBatch(inserts []dbShardInsert) error {
@@ -1627,6 +1892,371 @@ func (s *dbShard) FetchBlocksForColdFlush(
 	return entry.Series.FetchBlocksForColdFlush(ctx, start, version, nsCtx)
 }
 
+func (s *dbShard) DeleteSeriesRange(
+	id ident.ID,
+	start, end time.Time,
+	nsCtx namespace.Context,
+) error {
+	s.RLock()
+	entry, _, err := s.lookupEntryWithLock(id)
+	if entry != nil {
+		// NB: Ensure the series is not expired while data is removed from it.
+		entry.IncrementReaderWriterCount()
+		defer entry.DecrementReaderWriterCount()
+	}
+	s.RUnlock()
+
+	if err != nil && err != errShardEntryNotFound {
+		return err
+	}
+	if entry == nil {
+		return nil
+	}
+	return entry.Series.DeleteRange(start, end, nsCtx)
+}
+
+func (s *dbShard) HasPendingTombstones() bool {
+	s.tombstones.Lock()
+	defer s.tombstones.Unlock()
+	return len(s.tombstones.byBlock) > 0
+}
+
+func (s *dbShard) WriteTombstones(
+	ids []ident.ID,
+	start, end time.Time,
+	position persist.CommitLogPosition,
+) error {
+	var (
+		nsOpts         = s.namespace.Options()
+		blockSize      = nsOpts.RetentionOptions().BlockSize()
+		indexBlockSize = nsOpts.IndexOptions().BlockSize()
+		added          []fs.Tombstone
+	)
+	if indexBlockSize <= 0 {
+		indexBlockSize = blockSize
+	}
+	// NB: Tombstones are split at both block and index block boundaries so
+	// that a write after the delete only clears the tombstones of the index
+	// block it was written to.
+	for curr := start; curr.Before(end); {
+		var (
+			blockStart = curr.Truncate(blockSize)
+			next       = xtime.MinTime(blockStart.Add(blockSize),
+				curr.Truncate(indexBlockSize).Add(indexBlockSize))
+		)
+		next = xtime.MinTime(next, end)
+
+		// Blocks that have already been flushed need their filesets
+		// rewritten by the next cold flush without the deleted data.
+		hasWarmFlushed, err := s.hasWarmFlushed(blockStart)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			added = append(added, fs.Tombstone{
+				ID:             append([]byte(nil), id.Bytes()...),
+				BlockStart:     blockStart,
+				Start:          curr,
+				End:            next,
+				Position:       position,
+				PendingRewrite: hasWarmFlushed,
+			})
+		}
+		curr = next
+	}
+
+	// NB: The index is updated while holding the lock so that the delete
+	// is ordered with any write clearing it.
+	s.tombstones.Lock()
+	for _, t := range added {
+		if t.PendingRewrite {
+			s.addTombstoneWithLock(t)
+		}
+		id := string(t.ID)
+		s.tombstones.unwritten[id] = append(s.tombstones.unwritten[id],
+			len(s.tombstones.persisted))
+		s.tombstones.persisted = append(s.tombstones.persisted, t)
+		s.tombstones.writer.AppendDelete(t)
+	}
+	atomic.StoreInt64(&s.tombstones.numUnwritten, int64(len(s.tombstones.unwritten)))
+	if s.reverseIndex != nil {
+		s.reverseIndex.DeleteSeries(ids, start, end)
+	}
+	numPersisted := len(s.tombstones.persisted)
+	s.tombstones.Unlock()
+
+	s.markTombstoned(ids)
+
+	if err := s.tombstones.writer.Sync(); err != nil {
+		return err
+	}
+
+	// Compact the log once it mostly holds records that no longer apply.
+	if n := s.tombstones.writer.NumRecords(); n > minTombstoneRecordsToCompact &&
+		n > 2*numPersisted {
+		return s.compactTombstones()
+	}
+	return nil
+}
+
+func (s *dbShard) addTombstoneWithLock(t fs.Tombstone) {
+	var (
+		blockStart = xtime.ToUnixNano(t.BlockStart)
+		id         = string(t.ID)
+		deleted    = xtime.Range{Start: t.Start, End: t.End}
+	)
+	byID, ok := s.tombstones.byBlock[blockStart]
+	if !ok {
+		byID = make(map[string]xtime.Ranges)
+		s.tombstones.byBlock[blockStart] = byID
+		s.tombstones.updatePendingWithLock()
+	}
+	if ranges, ok := byID[id]; ok {
+		ranges.AddRange(deleted)
+		return
+	}
+	byID[id] = xtime.NewRanges(deleted)
+}
+
+// markTombstoned flags the entries of the series as having tombstones so
+// that their writes clear them, series not inserted yet are flagged when
+// their entry is inserted.
+func (s *dbShard) markTombstoned(ids []ident.ID) {
+	s.RLock()
+	defer s.RUnlock()
+	for _, id := range ids {
+		entry, _, err := s.lookupEntryWithLock(id)
+		if err != nil {
+			continue
+		}
+		entry.SetTombstoned(true)
+	}
+}
+
+// compactTombstones drops any tombstones for blocks past retention and
+// replaces the tombstones log with a record for each remaining tombstone.
+func (s *dbShard) compactTombstones() error {
+	return s.tombstones.writer.Compact(&s.tombstones, func() []fs.Tombstone {
+		var (
+			ropts     = s.namespace.Options().RetentionOptions()
+			earliest  = retention.FlushTimeStart(ropts, s.nowFn())
+			persisted = s.tombstones.persisted[:0]
+		)
+		for _, t := range s.tombstones.persisted {
+			if t.BlockStart.Before(earliest) {
+				continue
+			}
+			persisted = append(persisted, t)
+		}
+		s.tombstones.persisted = persisted
+		s.tombstones.indexUnwrittenWithLock()
+
+		// Tombstones are modified in place so compact a copy of them.
+		return append([]fs.Tombstone(nil), persisted...)
+	})
+}
+
+// clearTombstonesForWrite records that the series was written within the
+// ranges of any of its tombstones, the series is returned by index queries
+// for those ranges again. Returns whether any tombstones were cleared. The
+// entry is nil for series not inserted yet, otherwise the tombstones lock is
+// only taken if the entry is flagged as having tombstones.
+func (s *dbShard) clearTombstonesForWrite(
+	entry *lookup.Entry,
+	id ident.ID,
+	timestamp time.Time,
+) bool {
+	if entry != nil && !entry.IsTombstoned() {
+		return false
+	}
+	if entry == nil && atomic.LoadInt64(&s.tombstones.numUnwritten) == 0 {
+		return false
+	}
+
+	s.tombstones.Lock()
+	defer s.tombstones.Unlock()
+
+	idxs, ok := s.tombstones.unwritten[string(id.Bytes())]
+	if !ok {
+		if entry != nil {
+			// Tombstones dropped by a compaction.
+			entry.SetTombstoned(false)
+		}
+		return false
+	}
+	var (
+		remaining = make([]int, 0, len(idxs))
+		cleared   bool
+	)
+	for _, i := range idxs {
+		t := &s.tombstones.persisted[i]
+		if !t.Deletes(timestamp) {
+			remaining = append(remaining, i)
+			continue
+		}
+		// NB: The record is synced by the next tick rather than on the
+		// write path, if lost the series stays hidden after a restart
+		// until it is written again.
+		t.Written = true
+		s.tombstones.writer.AppendWritten(*t)
+		if s.reverseIndex != nil {
+			s.reverseIndex.ClearDeletedSeries(id, t.Start, t.End)
+		}
+		cleared = true
+	}
+	if len(remaining) == 0 {
+		delete(s.tombstones.unwritten, string(id.Bytes()))
+		atomic.StoreInt64(&s.tombstones.numUnwritten, int64(len(s.tombstones.unwritten)))
+		if entry != nil {
+			entry.SetTombstoned(false)
+		}
+	} else {
+		s.tombstones.unwritten[string(id.Bytes())] = remaining
+	}
+	return cleared
+}
+
+// loadTombstones reads the tombstones persisted for the shard, restoring
+// the blocks still pending a rewrite and reapplying the deletes to the index.
+func (s *dbShard) loadTombstones() error {
+	filePathPrefix := s.opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
+	tombstones, err := fs.ReadTombstones(filePathPrefix, s.namespace.ID(), s.ID())
+	if err != nil {
+		return err
+	}
+
+	s.tombstones.Lock()
+	s.tombstones.persisted = tombstones
+	for _, t := range tombstones {
+		if t.PendingRewrite {
+			s.addTombstoneWithLock(t)
+		}
+	}
+	s.tombstones.Unlock()
+
+	// Fold the records appended before the restart into the tombstones
+	// they apply to.
+	if err := s.compactTombstones(); err != nil {
+		return err
+	}
+
+	// Series may have been inserted by the bootstrap before the tombstones
+	// were loaded.
+	s.tombstones.Lock()
+	unwritten := make([]ident.ID, 0, len(s.tombstones.unwritten))
+	for id := range s.tombstones.unwritten {
+		unwritten = append(unwritten, ident.StringID(id))
+	}
+	s.tombstones.Unlock()
+	s.markTombstoned(unwritten)
+
+	if s.reverseIndex == nil {
+		return nil
+	}
+
+	s.tombstones.Lock()
+	defer s.tombstones.Unlock()
+
+	type deletedRange struct {
+		start, end xtime.UnixNano
+	}
+	byRange := make(map[deletedRange][]ident.ID)
+	for _, t := range s.tombstones.persisted {
+		// Tombstones whose series were written within the deleted range
+		// after the delete, that is past the position of the tombstone in
+		// the log, no longer hide the series.
+		if t.Written {
+			continue
+		}
+		r := deletedRange{start: xtime.ToUnixNano(t.Start), end: xtime.ToUnixNano(t.End)}
+		byRange[r] = append(byRange[r], ident.BytesID(t.ID))
+	}
+	for r, ids := range byRange {
+		s.reverseIndex.DeleteSeries(ids, r.start.ToTime(), r.end.ToTime())
+	}
+	return nil
+}
+
+// markTombstonesRewritten records that the fileset of a block was rewritten
+// without the data deleted by the rewritten tombstones.
+func (s *dbShard) markTombstonesRewritten(
+	blockStart xtime.UnixNano,
+	rewritten map[string]xtime.Ranges,
+) error {
+	s.tombstones.Lock()
+	for i, t := range s.tombstones.persisted {
+		if !t.PendingRewrite || xtime.ToUnixNano(t.BlockStart) != blockStart {
+			continue
+		}
+		// Tombstones added while the block was being rewritten are still
+		// pending the next rewrite.
+		ranges, ok := rewritten[string(t.ID)]
+		if !ok || !rangesCover(ranges, xtime.Range{Start: t.Start, End: t.End}) {
+			continue
+		}
+		s.tombstones.persisted[i].PendingRewrite = false
+		s.tombstones.writer.AppendRewritten(t)
+	}
+	s.tombstones.Unlock()
+
+	return s.tombstones.writer.Sync()
+}
+
+// takeTombstones returns all pending tombstones and clears them from the
+// shard, tombstones for blocks that fail to be rewritten must be restored
+// with restoreTombstones. Reads filter the taken tombstones until
+// finishRewritingTombstones is called.
+func (s *dbShard) takeTombstones() map[xtime.UnixNano]map[string]xtime.Ranges {
+	s.tombstones.Lock()
+	defer s.tombstones.Unlock()
+
+	if len(s.tombstones.byBlock) == 0 {
+		return nil
+	}
+	taken := s.tombstones.byBlock
+	s.tombstones.byBlock = make(map[xtime.UnixNano]map[string]xtime.Ranges)
+	s.tombstones.rewriting = taken
+	return taken
+}
+
+// finishRewritingTombstones stops filtering reads with the tombstones taken
+// by a cold flush once it has rewritten or restored their blocks.
+func (s *dbShard) finishRewritingTombstones() {
+	s.tombstones.Lock()
+	defer s.tombstones.Unlock()
+
+	s.tombstones.rewriting = nil
+	s.tombstones.updatePendingWithLock()
+}
+
+func (s *dbShard) restoreTombstones(blockStart xtime.UnixNano, byID map[string]xtime.Ranges) {
+	s.tombstones.Lock()
+	defer s.tombstones.Unlock()
+
+	existing, ok := s.tombstones.byBlock[blockStart]
+	if !ok {
+		s.tombstones.byBlock[blockStart] = byID
+		s.tombstones.updatePendingWithLock()
+		return
+	}
+	for id, ranges := range byID {
+		if curr, ok := existing[id]; ok {
+			curr.AddRanges(ranges)
+			continue
+		}
+		existing[id] = ranges
+	}
+}
+
+func (s *dbShard) restoreBlockTombstones(
+	tombstones map[xtime.UnixNano]map[string]xtime.Ranges,
+	blockStart xtime.UnixNano,
+) {
+	if byID, ok := tombstones[blockStart]; ok {
+		s.restoreTombstones(blockStart, byID)
+	}
+}
+
 func (s *dbShard) fetchActiveBlocksMetadata(
 	ctx context.Context,
 	start, end time.Time,
@@ -1965,6 +2595,15 @@ func (s *dbShard) UpdateFlushStates() {
 			s.setFlushStateColdVersionRetrievable(at, info.VolumeIndex)
 			s.setFlushStateColdVersionFlushed(at, info.VolumeIndex)
 		}
+
+		// The downsampled resolution and the encoding format are tracked
+		// for the latest volume only so that blocks are not downsampled or
+		// rewritten again after a restart.
+		if currState.ColdVersionRetrievable <= info.VolumeIndex {
+			s.setFlushStateDownsampledResolution(at,
+				time.Duration(info.DownsampledResolution))
+			s.setFlushStateEncodingFormat(at, info.EncodingFormat)
+		}
 	}
 }
 
@@ -1995,6 +2634,10 @@ func (s *dbShard) Bootstrap(ctx context.Context) error {
 		multiErr = multiErr.Add(err)
 	}
 
+	if err := s.loadTombstones(); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
 	// Now that this shard has finished bootstrapping, attempt to cache all of its seekers. Cannot call
 	// this earlier as block lease verification will fail due to the shards not being bootstrapped
 	// (and as a result no leases can be verified since the flush state is not yet known).
@@ -2182,6 +2825,8 @@ func (s *dbShard) WarmFlush(
 		// is a bug in the code.
 		DeleteIfExists: false,
 		FileSetType:    persist.FileSetFlushType,
+		// Warm flushes encode every series with the encoders of the database.
+		EncodingFormat: s.opts.FileSetRewriteOptions().EncodingFormat,
 	}
 	prepared, err := flushPreparer.PrepareData(prepareOpts)
 	if err != nil {
@@ -2219,7 +2864,11 @@ func (s *dbShard) WarmFlush(
 		multiErr = multiErr.Add(err)
 	}
 
-	return s.markWarmFlushStateSuccessOrError(blockStart, multiErr.FinalError())
+	err = s.markWarmFlushStateSuccessOrError(blockStart, multiErr.FinalError())
+	if err == nil {
+		s.setFlushStateEncodingFormat(blockStart, prepareOpts.EncodingFormat)
+	}
+	return err
 }
 
 func (s *dbShard) ColdFlush(
@@ -2227,6 +2876,7 @@ func (s *dbShard) ColdFlush(
 	resources coldFlushReuseableResources,
 	nsCtx namespace.Context,
 	onFlush persist.OnFlushSeries,
+	commitLogID persist.CommitLogFile,
 ) error {
 	// We don't flush data when the shard is still bootstrapping.
 	s.RLock()
@@ -2293,25 +2943,51 @@ func (s *dbShard) ColdFlush(
 		return loopErr
 	}
 
-	if dirtySeries.Len() == 0 {
+	// Blocks with deleted series data need their filesets rewritten even if
+	// none of their series have cold writes.
+	tombstones := s.takeTombstones()
+	if tombstones != nil {
+		defer s.finishRewritingTombstones()
+	}
+	for blockStart := range tombstones {
+		if dirtySeriesToWrite[blockStart] == nil {
+			dirtySeriesToWrite[blockStart] = newIDList(idElementPool)
+		}
+	}
+
+	if dirtySeries.Len() == 0 && len(tombstones) == 0 {
 		// Early exit if there is nothing dirty to merge. dirtySeriesToWrite
 		// may be non-empty when dirtySeries is empty because we purposely
 		// leave empty seriesLists in the dirtySeriesToWrite map to avoid having
 		// to reallocate them in subsequent usages of the shared resource.
+		s.updateDeferredColdFlushes(nil, commitLogID, true)
 		return nil
 	}
 
+	// Decide which blocks to merge into new volumes now and which blocks
+	// have their cold writes deferred to a later cold flush so that heavy
+	// late data does not churn volumes on every cold flush.
+	plan, err := s.planColdFlush(dirtySeriesToWrite, tombstones)
+	if err != nil {
+		for blockStart := range tombstones {
+			s.restoreBlockTombstones(tombstones, blockStart)
+		}
+		return err
+	}
+
 	merger := s.newMergerFn(resources.fsReader, s.opts.DatabaseBlockOptions().DatabaseBlockAllocSize(),
 		s.opts.SegmentReaderPool(), s.opts.MultiReaderIteratorPool(),
 		s.opts.IdentifierPool(), s.opts.EncoderPool(), s.opts.ContextPool(), s.namespace.Options())
-	mergeWithMem := s.newFSMergeWithMemFn(s, s, dirtySeries, dirtySeriesToWrite)
-	// Loop through each block that we know has ColdWrites. Since each block
+	mergeWithMem := s.newFSMergeWithMemFn(s, s, dirtySeries, dirtySeriesToWrite, tombstones)
+	// Loop through each block that the plan merges. Since each block
 	// has its own fileset, if we encounter an error while trying to persist
 	// a block, we continue to try persisting other blocks.
-	for blockStart := range dirtySeriesToWrite {
-		startTime := blockStart.ToTime()
+	for _, b := range plan.Merge {
+		startTime := b.BlockStart
+		blockStart := xtime.ToUnixNano(startTime)
 		coldVersion, err := s.RetrievableBlockColdVersion(startTime)
 		if err != nil {
+			s.restoreBlockTombstones(tombstones, blockStart)
 			multiErr = multiErr.Add(err)
 			continue
 		}
@@ -2326,46 +3002,349 @@ func (s *dbShard) ColdFlush(
 		nextVersion := coldVersion + 1
 		err = merger.Merge(fsID, mergeWithMem, nextVersion, flushPreparer, nsCtx, onFlush)
 		if err != nil {
+			s.restoreBlockTombstones(tombstones, blockStart)
 			multiErr = multiErr.Add(err)
 			continue
 		}
 
-		// After writing the full block successfully update the ColdVersionFlushed number. This will
-		// allow the SeekerManager to open a lease on the latest version of the fileset files because
-		// the BlockLeaseVerifier will check the ColdVersionFlushed value, but the buffer only looks at
-		// ColdVersionRetrievable so a concurrent tick will not yet cause the blocks in memory to be
-		// evicted (which is the desired behavior because we haven't updated the open leases yet which
-		// means the newly written data is not available for querying via the SeekerManager yet.)
-		s.setFlushStateColdVersionFlushed(startTime, nextVersion)
-
-		// Notify all block leasers that a new volume for the namespace/shard/blockstart
-		// has been created. This will block until all leasers have relinquished their
-		// leases.
-		_, err = s.opts.BlockLeaseManager().UpdateOpenLeases(block.LeaseDescriptor{
-			Namespace:  s.namespace.ID(),
-			Shard:      s.ID(),
-			BlockStart: startTime,
-		}, block.LeaseState{Volume: nextVersion})
-		// After writing the full block successfully **and** propagating the new lease to the
-		// BlockLeaseManager, update the ColdVersionRetrievable in the flush state. Once this function
-		// completes concurrent ticks will be able to evict the data from memory that was just flushed
-		// (which is now safe to do since the SeekerManager has been notified of the presence of new
-		// files).
-		//
-		// NB(rartoul): Ideally the ColdVersionRetrievable would only be updated if the call to UpdateOpenLeases
-		// succeeded, but that would allow the ColdVersionRetrievable and ColdVersionFlushed numbers to drift
-		// which would increase the complexity of the code to address a situation that is probably not
-		// recoverable (failure to UpdateOpenLeases is an invariant violated error).
-		s.setFlushStateColdVersionRetrievable(startTime, nextVersion)
+		// The merged volume may contain raw datapoints again so any
+		// previous downsampling of this block no longer applies, while it
+		// keeps the encoding format of the volume it was merged with.
+		s.setFlushStateDownsampledResolution(startTime, 0)
+		if err := s.markColdVersionPersisted(startTime, nextVersion); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		if byID, ok := tombstones[blockStart]; ok {
+			if err := s.markTombstonesRewritten(blockStart, byID); err != nil {
+				multiErr = multiErr.Add(err)
+			}
+		}
+	}
+
+	// Compact the tombstones log now that the rewrites have run.
+	if len(tombstones) > 0 {
+		if err := s.compactTombstones(); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	err = multiErr.FinalError()
+	s.updateDeferredColdFlushes(plan.Defer, commitLogID, err == nil)
+	return err
+}
+
+// planColdFlush returns the plan of which blocks with cold writes or
+// deleted series data to merge into new volumes.
+func (s *dbShard) planColdFlush(
+	dirtySeriesToWrite map[xtime.UnixNano]*idList,
+	tombstones map[xtime.UnixNano]map[string]xtime.Ranges,
+) (*coldcompaction.Plan, error) {
+	s.RLock()
+	opts := s.currRuntimeOptions.coldFlushPlannerOpts
+	s.RUnlock()
+
+	var (
+		now            = s.nowFn()
+		filePathPrefix = s.opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
+		blocks         = make([]coldcompaction.Block, 0, len(dirtySeriesToWrite))
+	)
+	s.deferredColdFlushes.Lock()
+	for blockStart, seriesList := range dirtySeriesToWrite {
+		_, forced := tombstones[blockStart]
+		if seriesList.Len() == 0 && !forced {
+			// Lists are left in place for blocks dirty in other shards.
+			continue
+		}
+
+		b := coldcompaction.Block{
+			BlockStart:  blockStart.ToTime(),
+			DirtySeries: seriesList.Len(),
+			Forced:      forced,
+		}
+		if deferred, ok := s.deferredColdFlushes.byBlock[blockStart]; ok {
+			b.PendingAge = now.Sub(deferred.since)
+		}
+		blocks = append(blocks, b)
+	}
+	s.deferredColdFlushes.Unlock()
+
+	for i := range blocks {
+		coldVersion, err := s.RetrievableBlockColdVersion(blocks[i].BlockStart)
+		if err != nil {
+			// The merge itself will surface the error.
+			continue
+		}
+		size, err := s.dataFileSetSizeFn(filePathPrefix, s.namespace.ID(), s.ID(),
+			blocks[i].BlockStart, coldVersion)
+		if err != nil {
+			s.logger.Warn("could not determine volume size for cold flush plan",
+				zap.Stringer("namespace", s.namespace.ID()),
+				zap.Uint32("shard", s.ID()),
+				zap.Time("blockStart", blocks[i].BlockStart),
+				zap.Error(err))
+			continue
+		}
+		blocks[i].VolumeSize = size
+	}
+
+	plan, err := coldcompaction.NewPlan(blocks, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range plan.Merge {
+		s.metrics.coldFlushWriteAmplification.RecordValue(b.WriteAmplification())
+	}
+	s.metrics.coldFlushBlocksMerged.Inc(int64(len(plan.Merge)))
+	s.metrics.coldFlushBlocksDeferred.Inc(int64(len(plan.Defer)))
+	return plan, nil
+}
+
+// updateDeferredColdFlushes records the blocks deferred by the last cold flush
+// plan, pinning the commit logs that may contain their cold writes.
+func (s *dbShard) updateDeferredColdFlushes(
+	deferred []coldcompaction.Block,
+	commitLogID persist.CommitLogFile,
+	success bool,
+) {
+	s.deferredColdFlushes.Lock()
+	defer s.deferredColdFlushes.Unlock()
+
+	// Cold writes of a block deferred for the first time arrived after the
+	// last successful cold flush, so they are all in commit logs from the one
+	// rotated before that cold flush onwards. If there was no successful cold
+	// flush since startup then every commit log has to be retained.
+	var pin int64
+	if s.deferredColdFlushes.hasLastCommitLogIndex {
+		pin = s.deferredColdFlushes.lastCommitLogIndex
+	}
+
+	byBlock := make(map[xtime.UnixNano]deferredColdFlush, len(deferred))
+	for _, b := range deferred {
+		blockStart := xtime.ToUnixNano(b.BlockStart)
+		if existing, ok := s.deferredColdFlushes.byBlock[blockStart]; ok {
+			byBlock[blockStart] = existing
+			continue
+		}
+		byBlock[blockStart] = deferredColdFlush{
+			since:          s.nowFn(),
+			commitLogIndex: pin,
+		}
+	}
+	s.deferredColdFlushes.byBlock = byBlock
+
+	// Only advance the last commit log index if every merged block was
+	// persisted, otherwise cold writes of blocks that failed to merge could
+	// be pinned to a commit log newer than the one containing them.
+	if success {
+		s.deferredColdFlushes.lastCommitLogIndex = commitLogID.Index
+		s.deferredColdFlushes.hasLastCommitLogIndex = true
+	}
+}
+
+func (s *dbShard) ColdFlushRetainedCommitLogIndex() (int64, bool) {
+	s.deferredColdFlushes.Lock()
+	defer s.deferredColdFlushes.Unlock()
+
+	var (
+		minIndex int64
+		found    bool
+	)
+	for _, deferred := range s.deferredColdFlushes.byBlock {
+		if !found || deferred.commitLogIndex < minIndex {
+			minIndex = deferred.commitLogIndex
+			found = true
+		}
+	}
+	return minIndex, found
+}
+
+// markColdVersionPersisted updates the flush state and the open block leases
+// once a new volume has been fully persisted for the block start.
+func (s *dbShard) markColdVersionPersisted(startTime time.Time, nextVersion int) error {
+	// After writing the full block successfully update the ColdVersionFlushed number. This will
+	// allow the SeekerManager to open a lease on the latest version of the fileset files because
+	// the BlockLeaseVerifier will check the ColdVersionFlushed value, but the buffer only looks at
+	// ColdVersionRetrievable so a concurrent tick will not yet cause the blocks in memory to be
+	// evicted (which is the desired behavior because we haven't updated the open leases yet which
+	// means the newly written data is not available for querying via the SeekerManager yet.)
+	s.setFlushStateColdVersionFlushed(startTime, nextVersion)
+
+	// Notify all block leasers that a new volume for the namespace/shard/blockstart
+	// has been created. This will block until all leasers have relinquished their
+	// leases.
+	_, err := s.opts.BlockLeaseManager().UpdateOpenLeases(block.LeaseDescriptor{
+		Namespace:  s.namespace.ID(),
+		Shard:      s.ID(),
+		BlockStart: startTime,
+	}, block.LeaseState{Volume: nextVersion})
+	// After writing the full block successfully **and** propagating the new lease to the
+	// BlockLeaseManager, update the ColdVersionRetrievable in the flush state. Once this function
+	// completes concurrent ticks will be able to evict the data from memory that was just flushed
+	// (which is now safe to do since the SeekerManager has been notified of the presence of new
+	// files).
+	//
+	// NB(rartoul): Ideally the ColdVersionRetrievable would only be updated if the call to UpdateOpenLeases
+	// succeeded, but that would allow the ColdVersionRetrievable and ColdVersionFlushed numbers to drift
+	// which would increase the complexity of the code to address a situation that is probably not
+	// recoverable (failure to UpdateOpenLeases is an invariant violated error).
+	s.setFlushStateColdVersionRetrievable(startTime, nextVersion)
+	if err != nil {
+		instrument.EmitAndLogInvariantViolation(s.opts.InstrumentOptions(), func(l *zap.Logger) {
+			l.With(
+				zap.String("namespace", s.namespace.ID().String()),
+				zap.Uint32("shard", s.ID()),
+				zap.Time("blockStart", startTime),
+				zap.Int("nextVersion", nextVersion),
+			).Error("failed to update open leases after updating flush state cold version")
+		})
+		return err
+	}
+	return nil
+}
+
+func (s *dbShard) DownsampleFlush(
+	tickStart time.Time,
+	flushPreparer persist.FlushPreparer,
+	fsReader fs.DataFileSetReader,
+	nsCtx namespace.Context,
+) error {
+	// We don't flush data when the shard is still bootstrapping.
+	s.RLock()
+	if s.bootstrapState != Bootstrapped {
+		s.RUnlock()
+		return errShardNotBootstrappedToFlush
+	}
+	s.RUnlock()
+
+	nsOpts := s.namespace.Options()
+	tiers := nsOpts.ResolutionTiers()
+	if len(tiers) == 0 {
+		return nil
+	}
+
+	var (
+		multiErr    xerrors.MultiError
+		rOpts       = nsOpts.RetentionOptions()
+		blockSize   = rOpts.BlockSize()
+		earliest    = retention.FlushTimeStart(rOpts, tickStart)
+		downsampler = s.newDownsamplerFn(fsReader,
+			s.opts.DatabaseBlockOptions().DatabaseBlockAllocSize(),
+			s.opts.SegmentReaderPool(), s.opts.MultiReaderIteratorPool(),
+			s.opts.IdentifierPool(), s.opts.EncoderPool(), nsOpts)
+	)
+	for blockStart := earliest; ; blockStart = blockStart.Add(blockSize) {
+		// Blocks are visited from oldest to newest so once a block is too
+		// young for the first tier all subsequent blocks are as well.
+		age := tickStart.Sub(blockStart.Add(blockSize))
+		resolution, ok := namespace.ResolutionForAge(tiers, age)
+		if !ok {
+			break
+		}
+
+		state, err := s.FlushState(blockStart)
+		if err != nil {
+			return err
+		}
+		if !statusIsRetrievable(state.WarmStatus) ||
+			state.DownsampledResolution >= resolution {
+			continue
+		}
+
+		fsID := fs.FileSetFileIdentifier{
+			Namespace:   s.namespace.ID(),
+			Shard:       s.ID(),
+			BlockStart:  blockStart,
+			VolumeIndex: state.ColdVersionFlushed,
+		}
+		nextVersion := state.ColdVersionFlushed + 1
+		err = downsampler.Downsample(fsID, resolution, nextVersion, flushPreparer, nsCtx)
 		if err != nil {
-			instrument.EmitAndLogInvariantViolation(s.opts.InstrumentOptions(), func(l *zap.Logger) {
-				l.With(
-					zap.String("namespace", s.namespace.ID().String()),
-					zap.Uint32("shard", s.ID()),
-					zap.Time("blockStart", startTime),
-					zap.Int("nextVersion", nextVersion),
-				).Error("failed to update open leases after updating flush state cold version")
-			})
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		s.setFlushStateDownsampledResolution(blockStart, resolution)
+		if err := s.markColdVersionPersisted(blockStart, nextVersion); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) RewriteFlush(
+	tickStart time.Time,
+	flushPreparer persist.FlushPreparer,
+	fsReader fs.DataFileSetReader,
+	nsCtx namespace.Context,
+) error {
+	// We don't flush data when the shard is still bootstrapping.
+	s.RLock()
+	if s.bootstrapState != Bootstrapped {
+		s.RUnlock()
+		return errShardNotBootstrappedToFlush
+	}
+	s.RUnlock()
+
+	var (
+		multiErr    xerrors.MultiError
+		rOpts       = s.namespace.Options().RetentionOptions()
+		blockSize   = rOpts.BlockSize()
+		earliest    = retention.FlushTimeStart(rOpts, tickStart)
+		latest      = retention.FlushTimeEnd(rOpts, tickStart)
+		rewriteOpts = s.opts.FileSetRewriteOptions()
+		maxVolumes  = rewriteOpts.MaxVolumesPerShardFlush
+		numVolumes  int
+		rewriter    = s.newRewriterFn(fsReader,
+			s.opts.DatabaseBlockOptions().DatabaseBlockAllocSize(),
+			s.opts.SegmentReaderPool(), s.opts.MultiReaderIteratorPool(),
+			s.opts.IdentifierPool(), s.opts.EncoderPool(), rewriteOpts)
+	)
+	for blockStart := earliest; !blockStart.After(latest); blockStart = blockStart.Add(blockSize) {
+		// Remaining blocks are rewritten by subsequent flushes, oldest first.
+		if maxVolumes > 0 && numVolumes >= maxVolumes {
+			break
+		}
+
+		state, err := s.FlushState(blockStart)
+		if err != nil {
+			return err
+		}
+		// Only volumes encoded differently from the rewrite encoding format
+		// are rewritten, volumes flushed since are already encoded with it.
+		if !statusIsRetrievable(state.WarmStatus) ||
+			state.EncodingFormat == rewriteOpts.EncodingFormat {
+			continue
+		}
+
+		fsID := fs.FileSetFileIdentifier{
+			Namespace:   s.namespace.ID(),
+			Shard:       s.ID(),
+			BlockStart:  blockStart,
+			VolumeIndex: state.ColdVersionFlushed,
+		}
+		nextVersion := state.ColdVersionFlushed + 1
+		numVolumes++
+		err = rewriter.Rewrite([]fs.FileSetFileIdentifier{fsID}, persist.DataPrepareOptions{
+			NamespaceMetadata: s.namespace,
+			Shard:             s.ID(),
+			BlockStart:        blockStart,
+			VolumeIndex:       nextVersion,
+			FileSetType:       persist.FileSetFlushType,
+			// Rewriting does not change the datapoints so the rewritten
+			// volume keeps the resolution of the volume it replaces.
+			DownsampledResolution: state.DownsampledResolution,
+		}, flushPreparer, nsCtx)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		s.setFlushStateEncodingFormat(blockStart, rewriteOpts.EncodingFormat)
+		if err := s.markColdVersionPersisted(blockStart, nextVersion); err != nil {
 			multiErr = multiErr.Add(err)
 			continue
 		}
@@ -2411,6 +3390,12 @@ func (s *dbShard) Snapshot(
 		return err
 	}
 
+	// When writing new series asynchronously a write is enqueued to the
+	// commit log before it is applied to the series, wait for the inserts
+	// enqueued before the snapshot was prepared to be applied so that the
+	// snapshot contains every commit log entry preceding its high-water mark.
+	s.insertQueue.Drain()
+
 	snapshotCtx := s.contextPool.Get()
 	s.forEachShardEntry(func(entry *lookup.Entry) bool {
 		series := entry.Series
@@ -2517,6 +3502,22 @@ func (s *dbShard) setFlushStateColdVersionFlushed(blockStart time.Time, version
 	s.flushState.Unlock()
 }
 
+func (s *dbShard) setFlushStateDownsampledResolution(blockStart time.Time, resolution time.Duration) {
+	s.flushState.Lock()
+	state := s.flushState.statesByTime[xtime.ToUnixNano(blockStart)]
+	state.DownsampledResolution = resolution
+	s.flushState.statesByTime[xtime.ToUnixNano(blockStart)] = state
+	s.flushState.Unlock()
+}
+
+func (s *dbShard) setFlushStateEncodingFormat(blockStart time.Time, format string) {
+	s.flushState.Lock()
+	state := s.flushState.statesByTime[xtime.ToUnixNano(blockStart)]
+	state.EncodingFormat = format
+	s.flushState.statesByTime[xtime.ToUnixNano(blockStart)] = state
+	s.flushState.Unlock()
+}
+
 func (s *dbShard) removeAnyFlushStatesTooEarly(startTime time.Time) {
 	s.flushState.Lock()
 	earliestFlush := retention.FlushTimeStart(s.namespace.Options().RetentionOptions(), startTime)
@@ -2529,18 +3530,23 @@ func (s *dbShard) removeAnyFlushStatesTooEarly(startTime time.Time) {
 }
 
 func (s *dbShard) CleanupExpiredFileSets(earliestToRetain time.Time) error {
-	filePathPrefix := s.opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	filePathPrefix := fsOpts.FilePathPrefix()
 	expired, err := s.filesetPathsBeforeFn(filePathPrefix, s.namespace.ID(), s.ID(), earliestToRetain)
 	if err != nil {
 		return fmt.Errorf("encountered errors when getting fileset files for prefix %s namespace %s shard %d: %v",
 			filePathPrefix, s.namespace.ID(), s.ID(), err)
 	}
 
-	return s.deleteFilesFn(expired)
+	multiErr := xerrors.NewMultiError()
+	multiErr = multiErr.Add(s.deleteFilesFn(expired))
+	multiErr = multiErr.Add(fs.DeleteRemoteFiles(fsOpts, expired))
+	return multiErr.FinalError()
 }
 
 func (s *dbShard) CleanupCompactedFileSets() error {
-	filePathPrefix := s.opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	filePathPrefix := fsOpts.FilePathPrefix()
 	filesets, err := s.filesetsFn(filePathPrefix, s.namespace.ID(), s.ID())
 	if err != nil {
 		return fmt.Errorf("encountered errors when getting fileset files for prefix %s namespace %s shard %d: %v",
@@ -2565,7 +3571,52 @@ func (s *dbShard) CleanupCompactedFileSets() error {
 		}
 	}
 
-	return s.deleteFilesFn(toDelete.Filepaths())
+	multiErr := xerrors.NewMultiError()
+	multiErr = multiErr.Add(s.deleteFilesFn(toDelete.Filepaths()))
+	multiErr = multiErr.Add(fs.DeleteRemoteFiles(fsOpts, toDelete.Filepaths()))
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) OffloadFileSets(t time.Time) error {
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	if fsOpts.RemoteStore() == nil {
+		return nil
+	}
+
+	filePathPrefix := fsOpts.FilePathPrefix()
+	filesets, err := s.filesetsFn(filePathPrefix, s.namespace.ID(), s.ID())
+	if err != nil {
+		return fmt.Errorf("encountered errors when getting fileset files for prefix %s namespace %s shard %d: %v",
+			filePathPrefix, s.namespace.ID(), s.ID(), err)
+	}
+
+	var (
+		blockSize     = s.namespace.Options().RetentionOptions().BlockSize()
+		offloadBefore = t.Add(-fsOpts.RemoteOffloadAfter())
+		multiErr      = xerrors.NewMultiError()
+	)
+	for i, fileset := range filesets {
+		blockStart := fileset.ID.BlockStart
+		if i+1 < len(filesets) && filesets[i+1].ID.BlockStart.Equal(blockStart) {
+			// Filesets are sorted by block start and volume, only offload the
+			// latest volume since earlier volumes are cleaned up once compacted.
+			continue
+		}
+		if blockStart.Add(blockSize).After(offloadBefore) {
+			continue
+		}
+
+		offloaded, err := fs.OffloadDataFileSet(fsOpts, fileset.ID)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		if offloaded {
+			s.metrics.offloadedFileSets.Inc(1)
+		}
+	}
+
+	return multiErr.FinalError()
 }
 
 func (s *dbShard) Repair(
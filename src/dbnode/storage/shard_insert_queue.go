@@ -300,6 +300,29 @@ func (q *dbShardInsertQueue) Stop() error {
 	return nil
 }
 
+// Drain waits until every insert enqueued before the call has been applied
+// to the shard, it returns immediately if the queue is not open.
+func (q *dbShardInsertQueue) Drain() {
+	q.Lock()
+	if q.state != dbShardInsertQueueStateOpen {
+		q.Unlock()
+		return
+	}
+	// Batches are applied in order so once the current batch has been applied
+	// every batch before it has been applied too.
+	wg := q.currBatch.wg
+	q.Unlock()
+
+	// Notify insert loop
+	select {
+	case q.notifyInsert <- struct{}{}:
+	default:
+		// Loop busy, already ready to consume notification
+	}
+
+	wg.Wait()
+}
+
 func (q *dbShardInsertQueue) Insert(insert dbShardInsert) (*sync.WaitGroup, error) {
 	windowNanos := q.nowFn().Truncate(time.Second).UnixNano()
 
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -36,10 +37,13 @@ import (
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/remote"
+	"github.com/m3db/m3/src/dbnode/ratelimit"
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/storage/coldcompaction"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/storage/series/lookup"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -84,7 +88,7 @@ func testDatabaseShardWithIndexFn(
 		SetBufferBucketVersionsPool(series.NewBufferBucketVersionsPool(nil)).
 		SetBufferBucketPool(series.NewBufferBucketPool(nil))
 	return newDatabaseShard(metadata, 0, nil, nsReaderMgr,
-		&testIncreasingIndex{}, idx, true, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, idx, nil, true, opts, seriesOpts).(*dbShard)
 }
 
 func addMockSeries(ctrl *gomock.Controller, shard *dbShard, id ident.ID, tags ident.Tags, index uint64) *series.MockDatabaseSeries {
@@ -104,7 +108,7 @@ func TestShardDontNeedBootstrap(t *testing.T) {
 	defer closer()
 	seriesOpts := NewSeriesOptionsFromOptions(opts, testNs.Options().RetentionOptions())
 	shard := newDatabaseShard(testNs.metadata, 0, nil, nil,
-		&testIncreasingIndex{}, nil, false, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, nil, nil, false, opts, seriesOpts).(*dbShard)
 	defer shard.Close()
 
 	require.Equal(t, Bootstrapped, shard.bootstrapState)
@@ -117,7 +121,7 @@ func TestShardErrorIfDoubleBootstrap(t *testing.T) {
 	defer closer()
 	seriesOpts := NewSeriesOptionsFromOptions(opts, testNs.Options().RetentionOptions())
 	shard := newDatabaseShard(testNs.metadata, 0, nil, nil,
-		&testIncreasingIndex{}, nil, false, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, nil, nil, false, opts, seriesOpts).(*dbShard)
 	defer shard.Close()
 
 	require.Equal(t, Bootstrapped, shard.bootstrapState)
@@ -306,6 +310,78 @@ func TestShardBootstrapWithFlushVersionNoCleanUp(t *testing.T) {
 	require.Equal(t, numVolumes-1, flushState.ColdVersionFlushed)
 }
 
+// TestShardBootstrapWithVolumeMarkers ensures that the shard bootstraps
+// the downsampled resolution and the encoding format of the latest volume
+// of each block from the info files so that blocks aren't downsampled or
+// rewritten again.
+func TestShardBootstrapWithVolumeMarkers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		opts   = DefaultTestOptions()
+		fsOpts = opts.CommitLogOptions().FilesystemOptions().
+			SetFilePathPrefix(dir)
+		newClOpts = opts.
+				CommitLogOptions().
+				SetFilesystemOptions(fsOpts)
+	)
+	opts = opts.
+		SetCommitLogOptions(newClOpts)
+
+	s := testDatabaseShard(t, opts)
+	defer s.Close()
+
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+
+	var (
+		blockSize   = 2 * time.Hour
+		downsampled = time.Now().Truncate(blockSize)
+		merged      = downsampled.Add(blockSize)
+	)
+	for _, volume := range []struct {
+		blockStart  time.Time
+		volumeIndex int
+		resolution  time.Duration
+		format      string
+	}{
+		{blockStart: downsampled, volumeIndex: 0},
+		{blockStart: downsampled, volumeIndex: 1, resolution: time.Minute, format: fs.EncodingFormatM3TSZ},
+		{blockStart: merged, volumeIndex: 0, resolution: time.Minute, format: fs.EncodingFormatM3TSZ},
+		{blockStart: merged, volumeIndex: 1},
+	} {
+		writer.Open(fs.DataWriterOpenOptions{
+			FileSetType: persist.FileSetFlushType,
+			Identifier: fs.FileSetFileIdentifier{
+				Namespace:   defaultTestNs1ID,
+				Shard:       s.ID(),
+				BlockStart:  volume.blockStart,
+				VolumeIndex: volume.volumeIndex,
+			},
+			DownsampledResolution: volume.resolution,
+			EncodingFormat:        volume.format,
+		})
+		require.NoError(t, writer.Close())
+	}
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	require.NoError(t, s.Bootstrap(ctx))
+
+	flushState, err := s.FlushState(downsampled)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, flushState.DownsampledResolution)
+	require.Equal(t, fs.EncodingFormatM3TSZ, flushState.EncodingFormat)
+
+	flushState, err = s.FlushState(merged)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), flushState.DownsampledResolution)
+	require.Equal(t, "", flushState.EncodingFormat)
+}
+
 // TestShardBootstrapWithCacheShardIndices ensures that the shard is able to bootstrap
 // and call CacheShardIndices if a BlockRetrieverManager is present.
 func TestShardBootstrapWithCacheShardIndices(t *testing.T) {
@@ -478,7 +554,9 @@ func TestShardFlushSeriesFlushSuccess(t *testing.T) {
 	nowFn := func() time.Time {
 		return now
 	}
-	opts := DefaultTestOptions()
+	opts := DefaultTestOptions().SetFileSetRewriteOptions(fs.RewriteOptions{
+		EncodingFormat: fs.EncodingFormatM3TSZIntOptimized,
+	})
 	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(nowFn))
 
 	s := testDatabaseShard(t, opts)
@@ -505,6 +583,7 @@ func TestShardFlushSeriesFlushSuccess(t *testing.T) {
 		NamespaceMetadata: s.namespace,
 		Shard:             s.shard,
 		BlockStart:        blockStart,
+		EncodingFormat:    fs.EncodingFormatM3TSZIntOptimized,
 	})
 	flush.EXPECT().PrepareData(prepareOpts).Return(prepared, nil)
 
@@ -539,6 +618,7 @@ func TestShardFlushSeriesFlushSuccess(t *testing.T) {
 	require.Equal(t, fileOpState{
 		WarmStatus:             fileOpSuccess,
 		ColdVersionRetrievable: 0,
+		EncodingFormat:         fs.EncodingFormatM3TSZIntOptimized,
 		NumFailures:            0,
 	}, flushState)
 }
@@ -635,7 +715,7 @@ func TestShardColdFlush(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 0, coldVersion)
 	}
-	err = shard.ColdFlush(preparer, resources, nsCtx, &persist.NoOpColdFlushNamespace{})
+	err = shard.ColdFlush(preparer, resources, nsCtx, &persist.NoOpColdFlushNamespace{}, persist.CommitLogFile{})
 	require.NoError(t, err)
 	// After a cold flush, t0-t6 previously dirty block starts should be updated
 	// to version 1.
@@ -701,7 +781,7 @@ func TestShardColdFlushNoMergeIfNothingDirty(t *testing.T) {
 	}
 	nsCtx := namespace.Context{}
 
-	shard.ColdFlush(preparer, resources, nsCtx, &persist.NoOpColdFlushNamespace{})
+	shard.ColdFlush(preparer, resources, nsCtx, &persist.NoOpColdFlushNamespace{}, persist.CommitLogFile{})
 	// After a cold flush, t0-t3 should remain version 0, since nothing should
 	// actually be merged.
 	for i := t0; i.Before(t3.Add(blockSize)); i = i.Add(blockSize) {
@@ -711,6 +791,399 @@ func TestShardColdFlushNoMergeIfNothingDirty(t *testing.T) {
 	}
 }
 
+func TestShardColdFlushDefersBlocksPerPlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	now := time.Now()
+	nowFn := func() time.Time {
+		return now
+	}
+	opts := DefaultTestOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(nowFn))
+	blockSize := opts.SeriesOptions().RetentionOptions().BlockSize()
+	shard := testDatabaseShard(t, opts)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	require.NoError(t, shard.Bootstrap(ctx))
+	shard.newMergerFn = newMergerTestFn
+	shard.newFSMergeWithMemFn = newFSMergeWithMemTestFn
+	shard.dataFileSetSizeFn = func(string, ident.ID, uint32, time.Time, int) (int64, error) {
+		return 100, nil
+	}
+	shard.SetRuntimeOptions(runtime.NewOptions().
+		SetColdFlushPlannerOptions(coldcompaction.PlannerOptions{
+			MaxPendingAge:     time.Hour,
+			MaxBlocksPerFlush: 1,
+		}))
+
+	t0 := now.Truncate(blockSize).Add(-10 * blockSize)
+	t1 := t0.Add(1 * blockSize)
+	shard.markWarmFlushStateSuccess(t0)
+	shard.markWarmFlushStateSuccess(t1)
+
+	// t1 has more dirty series and therefore a lower write amplification
+	// so it is merged first while t0 is deferred.
+	dirtyData := []testDirtySeries{
+		{id: ident.StringID("id0"), dirtyTimes: []time.Time{t0, t1}},
+		{id: ident.StringID("id1"), dirtyTimes: []time.Time{t1}},
+	}
+	mocks := make([]*series.MockDatabaseSeries, 0, len(dirtyData))
+	for _, ds := range dirtyData {
+		curr := series.NewMockDatabaseSeries(ctrl)
+		curr.EXPECT().ID().Return(ds.id)
+		curr.EXPECT().ColdFlushBlockStarts(gomock.Any()).
+			Return(optimizedTimesFromTimes(ds.dirtyTimes))
+		shard.list.PushBack(lookup.NewEntry(curr, 0))
+		mocks = append(mocks, curr)
+	}
+
+	preparer := persist.NewMockFlushPreparer(ctrl)
+	resources := coldFlushReuseableResources{
+		dirtySeries:        newDirtySeriesMap(dirtySeriesMapOptions{}),
+		dirtySeriesToWrite: make(map[xtime.UnixNano]*idList),
+		idElementPool:      newIDElementPool(nil),
+		fsReader:           fs.NewMockDataFileSetReader(ctrl),
+	}
+	nsCtx := namespace.Context{}
+
+	_, retained := shard.ColdFlushRetainedCommitLogIndex()
+	require.False(t, retained)
+
+	err := shard.ColdFlush(preparer, resources, nsCtx, &persist.NoOpColdFlushNamespace{},
+		persist.CommitLogFile{Index: 2})
+	require.NoError(t, err)
+
+	coldVersion, err := shard.RetrievableBlockColdVersion(t0)
+	require.NoError(t, err)
+	require.Equal(t, 0, coldVersion)
+	coldVersion, err = shard.RetrievableBlockColdVersion(t1)
+	require.NoError(t, err)
+	require.Equal(t, 1, coldVersion)
+
+	// There was no previous cold flush so every commit log must be retained.
+	index, retained := shard.ColdFlushRetainedCommitLogIndex()
+	require.True(t, retained)
+	require.Equal(t, int64(0), index)
+
+	// Once the thresholds allow merging every block the deferred block is
+	// merged and the commit logs are no longer retained.
+	shard.SetRuntimeOptions(runtime.NewOptions())
+	mocks[0].EXPECT().ID().Return(dirtyData[0].id)
+	mocks[0].EXPECT().ColdFlushBlockStarts(gomock.Any()).
+		Return(optimizedTimesFromTimes([]time.Time{t0}))
+	mocks[1].EXPECT().ID().Return(dirtyData[1].id)
+	mocks[1].EXPECT().ColdFlushBlockStarts(gomock.Any()).
+		Return(optimizedTimesFromTimes(nil))
+
+	err = shard.ColdFlush(preparer, resources, nsCtx, &persist.NoOpColdFlushNamespace{},
+		persist.CommitLogFile{Index: 3})
+	require.NoError(t, err)
+
+	coldVersion, err = shard.RetrievableBlockColdVersion(t0)
+	require.NoError(t, err)
+	require.Equal(t, 1, coldVersion)
+
+	_, retained = shard.ColdFlushRetainedCommitLogIndex()
+	require.False(t, retained)
+}
+
+func TestShardColdFlushRewritesTombstonedBlocks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	now := time.Now()
+	nowFn := func() time.Time {
+		return now
+	}
+	opts := DefaultTestOptions()
+	fsOpts := opts.CommitLogOptions().FilesystemOptions().
+		SetFilePathPrefix(dir)
+	opts = opts.
+		SetClockOptions(opts.ClockOptions().SetNowFn(nowFn)).
+		SetCommitLogOptions(opts.CommitLogOptions().
+			SetFilesystemOptions(fsOpts))
+	blockSize := opts.SeriesOptions().RetentionOptions().BlockSize()
+	shard := testDatabaseShard(t, opts)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	require.NoError(t, shard.Bootstrap(ctx))
+
+	id := ident.StringID("foo")
+	merger := &tombstoneRecordingMerger{
+		id:      id,
+		deleted: make(map[xtime.UnixNano]xtime.Ranges),
+	}
+	shard.newMergerFn = func(
+		reader fs.DataFileSetReader,
+		blockAllocSize int,
+		srPool xio.SegmentReaderPool,
+		multiIterPool encoding.MultiReaderIteratorPool,
+		identPool ident.Pool,
+		encoderPool encoding.EncoderPool,
+		contextPool context.Pool,
+		nsOpts namespace.Options,
+	) fs.Merger {
+		return merger
+	}
+
+	t0 := now.Truncate(blockSize).Add(-10 * blockSize)
+	t1 := t0.Add(1 * blockSize)
+	t2 := t0.Add(2 * blockSize)
+	// Only t0 and t1 have been flushed so only they have filesets to rewrite.
+	shard.markWarmFlushStateSuccess(t0)
+	shard.markWarmFlushStateSuccess(t1)
+
+	start := t0.Add(blockSize / 2)
+	end := t2.Add(blockSize / 2)
+	position := persist.CommitLogPosition{Index: 1, NumEntries: 10}
+	require.NoError(t, shard.DeleteSeriesRange(id, start, end, namespace.Context{}))
+	require.NoError(t, shard.WriteTombstones([]ident.ID{id}, start, end, position))
+
+	// Tombstones are restored and reapplied to the index after a restart.
+	idx := NewMockNamespaceIndex(ctrl)
+	idx.EXPECT().DeleteSeries(gomock.Len(1), gomock.Any(), gomock.Any()).Times(3)
+	restarted := testDatabaseShardWithIndexFn(t, opts, idx, false)
+	require.NoError(t, restarted.Bootstrap(ctx))
+	require.True(t, restarted.HasPendingTombstones())
+	require.Equal(t, 2, len(restarted.takeTombstones()))
+
+	resources := coldFlushReuseableResources{
+		dirtySeries:        newDirtySeriesMap(dirtySeriesMapOptions{}),
+		dirtySeriesToWrite: make(map[xtime.UnixNano]*idList),
+		idElementPool:      newIDElementPool(nil),
+		fsReader:           fs.NewMockDataFileSetReader(ctrl),
+	}
+	preparer := persist.NewMockFlushPreparer(ctrl)
+	err = shard.ColdFlush(preparer, resources, namespace.Context{},
+		&persist.NoOpColdFlushNamespace{}, persist.CommitLogFile{})
+	require.NoError(t, err)
+
+	require.Equal(t, 2, len(merger.deleted))
+	require.True(t, merger.deleted[xtime.ToUnixNano(t0)].Overlaps(
+		xtime.Range{Start: start, End: t1}))
+	require.False(t, merger.deleted[xtime.ToUnixNano(t0)].Overlaps(
+		xtime.Range{Start: t0, End: start}))
+	require.True(t, merger.deleted[xtime.ToUnixNano(t1)].Overlaps(
+		xtime.Range{Start: t1, End: t2}))
+
+	for _, blockStart := range []time.Time{t0, t1} {
+		coldVersion, err := shard.RetrievableBlockColdVersion(blockStart)
+		require.NoError(t, err)
+		require.Equal(t, 1, coldVersion)
+	}
+	coldVersion, err := shard.RetrievableBlockColdVersion(t2)
+	require.NoError(t, err)
+	require.Equal(t, 0, coldVersion)
+
+	// Tombstones are cleared once the filesets have been rewritten but are
+	// kept on disk for bootstrapping from the commit log.
+	require.Nil(t, shard.takeTombstones())
+	require.False(t, shard.HasPendingTombstones())
+
+	tombstones, err := fs.ReadTombstones(dir, shard.namespace.ID(), shard.ID())
+	require.NoError(t, err)
+	require.Equal(t, 3, len(tombstones))
+	for i, blockStart := range []time.Time{t0, t1, t2} {
+		require.True(t, blockStart.Equal(tombstones[i].BlockStart))
+		require.Equal(t, id.Bytes(), tombstones[i].ID)
+		require.Equal(t, position, tombstones[i].Position)
+		require.False(t, tombstones[i].PendingRewrite)
+	}
+}
+
+func TestShardWriteAfterDeleteClearsTombstones(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := DefaultTestOptions()
+	blockSize := opts.SeriesOptions().RetentionOptions().BlockSize()
+	now := time.Now().Truncate(blockSize).Add(blockSize / 2)
+	nowFn := func() time.Time {
+		return now
+	}
+	fsOpts := opts.CommitLogOptions().FilesystemOptions().
+		SetFilePathPrefix(dir)
+	opts = opts.
+		SetClockOptions(opts.ClockOptions().SetNowFn(nowFn)).
+		SetCommitLogOptions(opts.CommitLogOptions().
+			SetFilesystemOptions(fsOpts))
+
+	idx := NewMockNamespaceIndex(ctrl)
+	shard := testDatabaseShardWithIndexFn(t, opts, idx, false)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	require.NoError(t, shard.Bootstrap(ctx))
+
+	var (
+		id         = ident.StringID("foo")
+		blockStart = now.Truncate(blockSize)
+		start      = blockStart
+		end        = blockStart.Add(blockSize)
+		position   = persist.CommitLogPosition{Index: 1, NumEntries: 10}
+	)
+	idx.EXPECT().DeleteSeries(gomock.Len(1), start, end)
+	require.NoError(t, shard.WriteTombstones([]ident.ID{id}, start, end, position))
+
+	// Writing the series within the deleted range clears the delete from the
+	// index and indexes the series again.
+	idx.EXPECT().ClearDeletedSeries(ident.NewIDMatcher("foo"), start, end)
+	idx.EXPECT().BlockStartForWriteTime(now).Return(xtime.ToUnixNano(blockStart))
+	_, _, err = shard.Write(ctx, id, now, 1.0, xtime.Second, nil, series.WriteOptions{})
+	require.NoError(t, err)
+
+	// Later writes have nothing left to clear and skip looking up the
+	// tombstones of the series.
+	lookupEntry := func() *lookup.Entry {
+		shard.RLock()
+		defer shard.RUnlock()
+		entry, _, err := shard.lookupEntryWithLock(id)
+		require.NoError(t, err)
+		return entry
+	}
+	require.False(t, lookupEntry().IsTombstoned())
+	_, _, err = shard.Write(ctx, id, now.Add(time.Second), 2.0, xtime.Second,
+		nil, series.WriteOptions{})
+	require.NoError(t, err)
+
+	// The write is persisted so the delete is not reapplied to the index
+	// after a restart.
+	require.NoError(t, shard.tombstones.writer.Sync())
+	tombstones, err := fs.ReadTombstones(dir, shard.namespace.ID(), shard.ID())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tombstones))
+	require.True(t, tombstones[0].Written)
+
+	restarted := testDatabaseShardWithIndexFn(t, opts, NewMockNamespaceIndex(ctrl), false)
+	require.NoError(t, restarted.Bootstrap(ctx))
+	require.Equal(t, int64(0), restarted.tombstones.numUnwritten)
+
+	// Deleting the series again flags its entry.
+	idx.EXPECT().DeleteSeries(gomock.Len(1), start, end)
+	require.NoError(t, shard.WriteTombstones([]ident.ID{id}, start, end, position))
+	require.True(t, lookupEntry().IsTombstoned())
+}
+
+func TestShardStreamFiltersPendingTombstones(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	opts := DefaultTestOptions()
+	fsOpts := opts.CommitLogOptions().FilesystemOptions().
+		SetFilePathPrefix(dir)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().
+		SetFilesystemOptions(fsOpts))
+	shard := testDatabaseShard(t, opts)
+	defer shard.Close()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	require.NoError(t, shard.Bootstrap(ctx))
+
+	var (
+		id         = ident.StringID("foo")
+		blockSize  = shard.seriesOpts.RetentionOptions().BlockSize()
+		blockStart = time.Now().Truncate(blockSize).Add(-2 * blockSize)
+		nsCtx      = namespace.Context{}
+	)
+	shard.markWarmFlushStateSuccess(blockStart)
+
+	newBlockReader := func() xio.BlockReader {
+		encoder := opts.EncoderPool().Get()
+		encoder.Reset(blockStart, 0, nil)
+		for i := 0; i < 3; i++ {
+			dp := ts.Datapoint{
+				Timestamp: blockStart.Add(time.Duration(i) * time.Minute),
+				Value:     float64(i),
+			}
+			require.NoError(t, encoder.Encode(dp, xtime.Second, nil))
+		}
+		return xio.BlockReader{
+			SegmentReader: xio.NewSegmentReader(encoder.Discard()),
+			Start:         blockStart,
+			BlockSize:     blockSize,
+		}
+	}
+	readValues := func(reader xio.BlockReader) []float64 {
+		iter := opts.MultiReaderIteratorPool().Get()
+		iter.Reset([]xio.SegmentReader{reader.SegmentReader}, reader.Start,
+			reader.BlockSize, nil)
+		defer iter.Close()
+		var values []float64
+		for iter.Next() {
+			dp, _, _ := iter.Current()
+			values = append(values, dp.Value)
+		}
+		require.NoError(t, iter.Err())
+		return values
+	}
+
+	retriever := block.NewMockDatabaseBlockRetriever(ctrl)
+	shard.setBlockRetriever(retriever)
+
+	// Without tombstones the block is read as is.
+	retriever.EXPECT().
+		Stream(ctx, shard.shard, id, blockStart, shard.seriesOnRetrieveBlock, nsCtx).
+		Return(newBlockReader(), nil)
+	reader, err := shard.Stream(ctx, id, blockStart, shard.seriesOnRetrieveBlock, nsCtx)
+	require.NoError(t, err)
+	require.Equal(t, []float64{0, 1, 2}, readValues(reader))
+
+	// Deleted data is filtered until the fileset is rewritten and the block
+	// is not cached by the series.
+	start := blockStart.Add(time.Minute)
+	end := start.Add(time.Minute)
+	require.NoError(t, shard.WriteTombstones([]ident.ID{id}, start, end,
+		persist.CommitLogPosition{Index: 1}))
+	retriever.EXPECT().
+		Stream(ctx, shard.shard, id, blockStart, nil, nsCtx).
+		Return(newBlockReader(), nil)
+	reader, err = shard.Stream(ctx, id, blockStart, shard.seriesOnRetrieveBlock, nsCtx)
+	require.NoError(t, err)
+	require.Equal(t, []float64{0, 2}, readValues(reader))
+}
+
+type tombstoneRecordingMerger struct {
+	id      ident.ID
+	deleted map[xtime.UnixNano]xtime.Ranges
+}
+
+func (m *tombstoneRecordingMerger) Merge(
+	fileID fs.FileSetFileIdentifier,
+	mergeWith fs.MergeWith,
+	nextVersion int,
+	flushPreparer persist.FlushPreparer,
+	nsCtx namespace.Context,
+	onFlush persist.OnFlushSeries,
+) error {
+	blockStart := xtime.ToUnixNano(fileID.BlockStart)
+	tombstones, ok := mergeWith.(fs.MergeWithTombstones)
+	if !ok {
+		return nil
+	}
+	if deleted, ok := tombstones.Tombstones(m.id, blockStart); ok {
+		m.deleted[blockStart] = deleted
+	}
+	return nil
+}
+
 func newMergerTestFn(
 	reader fs.DataFileSetReader,
 	blockAllocSize int,
@@ -742,6 +1215,7 @@ func newFSMergeWithMemTestFn(
 	retriever series.QueryableBlockRetriever,
 	dirtySeries *dirtySeriesMap,
 	dirtySeriesToWrite map[xtime.UnixNano]*idList,
+	tombstones map[xtime.UnixNano]map[string]xtime.Ranges,
 ) fs.MergeWith {
 	return &noopMergeWith{}
 }
@@ -766,6 +1240,290 @@ func (m *noopMergeWith) ForEachRemaining(
 	return nil
 }
 
+func TestShardDownsampleFlush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		blockSize = 2 * time.Hour
+		day       = 24 * time.Hour
+		now       = time.Now().Truncate(blockSize)
+		rOpts     = defaultTestRetentionOpts.
+				SetBlockSize(blockSize).
+				SetRetentionPeriod(10 * day)
+		nsOpts = defaultTestNs1Opts.
+			SetRetentionOptions(rOpts).
+			SetResolutionTiers([]namespace.ResolutionTier{
+				{Resolution: time.Minute, Age: day},
+				{Resolution: time.Hour, Age: 4 * day},
+			})
+	)
+	metadata, err := namespace.NewMetadata(defaultTestNs1ID, nsOpts)
+	require.NoError(t, err)
+
+	opts := DefaultTestOptions()
+	seriesOpts := NewSeriesOptionsFromOptions(opts, rOpts)
+	shard := newDatabaseShard(metadata, 0, nil, nil,
+		&testIncreasingIndex{}, nil, nil, true, opts, seriesOpts).(*dbShard)
+	defer shard.Close()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+	require.NoError(t, shard.Bootstrap(ctx))
+
+	downsampler := &recordingDownsampler{}
+	shard.newDownsamplerFn = downsampler.newDownsamplerFn
+
+	var (
+		hourTier   = now.Add(-5 * day)
+		minuteTier = now.Add(-2 * day)
+		alreadyRaw = now.Add(-2 * day).Add(blockSize)
+		rawTier    = now.Add(-12 * time.Hour)
+		notFlushed = now.Add(-3 * day)
+	)
+	shard.markWarmFlushStateSuccess(hourTier)
+	shard.markWarmFlushStateSuccess(minuteTier)
+	shard.markWarmFlushStateSuccess(alreadyRaw)
+	shard.markWarmFlushStateSuccess(rawTier)
+	shard.setFlushStateDownsampledResolution(alreadyRaw, time.Minute)
+	shard.setFlushStateColdVersionFlushed(minuteTier, 2)
+	shard.setFlushStateColdVersionRetrievable(minuteTier, 2)
+
+	preparer := persist.NewMockFlushPreparer(ctrl)
+	fsReader := fs.NewMockDataFileSetReader(ctrl)
+	require.NoError(t, shard.DownsampleFlush(now, preparer, fsReader, namespace.Context{}))
+
+	require.Equal(t, []downsampleCall{
+		{blockStart: hourTier, resolution: time.Hour, volume: 0, nextVolume: 1},
+		{blockStart: minuteTier, resolution: time.Minute, volume: 2, nextVolume: 3},
+	}, downsampler.calls)
+
+	for _, blockStart := range []time.Time{hourTier, minuteTier} {
+		state, err := shard.FlushState(blockStart)
+		require.NoError(t, err)
+		require.Equal(t, state.ColdVersionFlushed, state.ColdVersionRetrievable)
+	}
+	state, err := shard.FlushState(hourTier)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, state.DownsampledResolution)
+	require.Equal(t, 1, state.ColdVersionRetrievable)
+
+	state, err = shard.FlushState(notFlushed)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), state.DownsampledResolution)
+
+	// Downsampling again should be a no-op since every aged block is
+	// already at the resolution of its tier.
+	downsampler.calls = nil
+	require.NoError(t, shard.DownsampleFlush(now, preparer, fsReader, namespace.Context{}))
+	require.Empty(t, downsampler.calls)
+}
+
+type downsampleCall struct {
+	blockStart time.Time
+	resolution time.Duration
+	volume     int
+	nextVolume int
+}
+
+type recordingDownsampler struct {
+	calls []downsampleCall
+}
+
+func (d *recordingDownsampler) newDownsamplerFn(
+	reader fs.DataFileSetReader,
+	blockAllocSize int,
+	srPool xio.SegmentReaderPool,
+	multiIterPool encoding.MultiReaderIteratorPool,
+	identPool ident.Pool,
+	encoderPool encoding.EncoderPool,
+	nsOpts namespace.Options,
+) fs.Downsampler {
+	return d
+}
+
+func (d *recordingDownsampler) Downsample(
+	fileID fs.FileSetFileIdentifier,
+	resolution time.Duration,
+	nextVolumeIndex int,
+	flushPreparer persist.FlushPreparer,
+	nsCtx namespace.Context,
+) error {
+	d.calls = append(d.calls, downsampleCall{
+		blockStart: fileID.BlockStart,
+		resolution: resolution,
+		volume:     fileID.VolumeIndex,
+		nextVolume: nextVolumeIndex,
+	})
+	return nil
+}
+
+func TestShardRewriteFlush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		blockSize = 2 * time.Hour
+		now       = time.Now().Truncate(blockSize)
+		rOpts     = defaultTestRetentionOpts.
+				SetBlockSize(blockSize).
+				SetRetentionPeriod(24 * time.Hour)
+		nsOpts = defaultTestNs1Opts.SetRetentionOptions(rOpts)
+	)
+	metadata, err := namespace.NewMetadata(defaultTestNs1ID, nsOpts)
+	require.NoError(t, err)
+
+	format := fs.EncodingFormatM3TSZIntOptimized
+	opts := DefaultTestOptions().SetFileSetRewriteOptions(fs.RewriteOptions{
+		EncodingFormat: format,
+	})
+	seriesOpts := NewSeriesOptionsFromOptions(opts, rOpts)
+	shard := newDatabaseShard(metadata, 0, nil, nil,
+		&testIncreasingIndex{}, nil, nil, true, opts, seriesOpts).(*dbShard)
+	defer shard.Close()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+	require.NoError(t, shard.Bootstrap(ctx))
+
+	rewriter := &recordingRewriter{}
+	shard.newRewriterFn = rewriter.newRewriterFn
+
+	var (
+		notFlushed   = now.Add(-5 * blockSize)
+		unknown      = now.Add(-4 * blockSize)
+		otherFormat  = now.Add(-3 * blockSize)
+		targetFormat = now.Add(-2 * blockSize)
+	)
+	shard.markWarmFlushStateSuccess(unknown)
+	shard.markWarmFlushStateSuccess(otherFormat)
+	shard.markWarmFlushStateSuccess(targetFormat)
+	shard.setFlushStateColdVersionFlushed(otherFormat, 2)
+	shard.setFlushStateColdVersionRetrievable(otherFormat, 2)
+	shard.setFlushStateEncodingFormat(otherFormat, fs.EncodingFormatM3TSZ)
+	shard.setFlushStateEncodingFormat(targetFormat, format)
+
+	preparer := persist.NewMockFlushPreparer(ctrl)
+	fsReader := fs.NewMockDataFileSetReader(ctrl)
+	require.NoError(t, shard.RewriteFlush(now, preparer, fsReader, namespace.Context{}))
+
+	// Only volumes encoded differently from the target format are rewritten.
+	require.Equal(t, []rewriteCall{
+		{blockStart: unknown, volume: 0, nextVolume: 1},
+		{blockStart: otherFormat, volume: 2, nextVolume: 3},
+	}, rewriter.calls)
+
+	for _, blockStart := range []time.Time{unknown, otherFormat} {
+		state, err := shard.FlushState(blockStart)
+		require.NoError(t, err)
+		require.Equal(t, format, state.EncodingFormat)
+		require.Equal(t, state.ColdVersionFlushed, state.ColdVersionRetrievable)
+	}
+	state, err := shard.FlushState(notFlushed)
+	require.NoError(t, err)
+	require.Equal(t, "", state.EncodingFormat)
+
+	// Cold flushes keep the encoding format of the volume they merge with
+	// so a block is not rewritten again after being cold flushed.
+	require.NoError(t, shard.markColdVersionPersisted(otherFormat, 4))
+	rewriter.calls = nil
+	require.NoError(t, shard.RewriteFlush(now, preparer, fsReader, namespace.Context{}))
+	require.Empty(t, rewriter.calls)
+}
+
+func TestShardRewriteFlushMaxVolumesPerShardFlush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		blockSize = 2 * time.Hour
+		now       = time.Now().Truncate(blockSize)
+		rOpts     = defaultTestRetentionOpts.
+				SetBlockSize(blockSize).
+				SetRetentionPeriod(24 * time.Hour)
+		nsOpts = defaultTestNs1Opts.SetRetentionOptions(rOpts)
+	)
+	metadata, err := namespace.NewMetadata(defaultTestNs1ID, nsOpts)
+	require.NoError(t, err)
+
+	opts := DefaultTestOptions().SetFileSetRewriteOptions(fs.RewriteOptions{
+		EncodingFormat:          fs.EncodingFormatM3TSZIntOptimized,
+		MaxVolumesPerShardFlush: 1,
+	})
+	seriesOpts := NewSeriesOptionsFromOptions(opts, rOpts)
+	shard := newDatabaseShard(metadata, 0, nil, nil,
+		&testIncreasingIndex{}, nil, nil, true, opts, seriesOpts).(*dbShard)
+	defer shard.Close()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+	require.NoError(t, shard.Bootstrap(ctx))
+
+	rewriter := &recordingRewriter{}
+	shard.newRewriterFn = rewriter.newRewriterFn
+
+	var (
+		older = now.Add(-4 * blockSize)
+		newer = now.Add(-3 * blockSize)
+	)
+	shard.markWarmFlushStateSuccess(older)
+	shard.markWarmFlushStateSuccess(newer)
+
+	preparer := persist.NewMockFlushPreparer(ctrl)
+	fsReader := fs.NewMockDataFileSetReader(ctrl)
+
+	// Each flush rewrites at most a single volume, oldest first.
+	for _, blockStart := range []time.Time{older, newer} {
+		rewriter.calls = nil
+		require.NoError(t, shard.RewriteFlush(now, preparer, fsReader, namespace.Context{}))
+		require.Equal(t, []rewriteCall{
+			{blockStart: blockStart, volume: 0, nextVolume: 1},
+		}, rewriter.calls)
+	}
+
+	rewriter.calls = nil
+	require.NoError(t, shard.RewriteFlush(now, preparer, fsReader, namespace.Context{}))
+	require.Empty(t, rewriter.calls)
+}
+
+type rewriteCall struct {
+	blockStart time.Time
+	volume     int
+	nextVolume int
+}
+
+type recordingRewriter struct {
+	calls []rewriteCall
+}
+
+func (r *recordingRewriter) newRewriterFn(
+	reader fs.DataFileSetReader,
+	blockAllocSize int,
+	srPool xio.SegmentReaderPool,
+	multiIterPool encoding.MultiReaderIteratorPool,
+	identPool ident.Pool,
+	encoderPool encoding.EncoderPool,
+	opts fs.RewriteOptions,
+) fs.Rewriter {
+	return r
+}
+
+func (r *recordingRewriter) Rewrite(
+	srcFileIDs []fs.FileSetFileIdentifier,
+	prepareOpts persist.DataPrepareOptions,
+	flushPreparer persist.FlushPreparer,
+	nsCtx namespace.Context,
+) error {
+	for _, fileID := range srcFileIDs {
+		r.calls = append(r.calls, rewriteCall{
+			blockStart: fileID.BlockStart,
+			volume:     fileID.VolumeIndex,
+			nextVolume: prepareOpts.VolumeIndex,
+		})
+	}
+	return nil
+}
+
 func TestShardSnapshotShardNotBootstrapped(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -836,6 +1594,63 @@ func TestShardSnapshotSeriesSnapshotSuccess(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestShardSnapshotWaitsForAsyncInserts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	blockStart := now.Truncate(defaultTestRetentionOpts.BlockSize())
+
+	s := testDatabaseShard(t, DefaultTestOptions())
+	defer s.Close()
+	s.Bootstrap(ctx)
+	s.SetRuntimeOptions(runtime.NewOptions().SetWriteNewSeriesAsync(true))
+
+	// Delay every insert batch after the first so that the second write is
+	// still pending in the insert queue when the snapshot begins.
+	s.insertQueue.Lock()
+	s.insertQueue.insertBatchBackoff = time.Hour
+	s.insertQueue.sleepFn = func(time.Duration) { time.Sleep(100 * time.Millisecond) }
+	s.insertQueue.Unlock()
+
+	_, _, err := s.Write(ctx, ident.StringID("foo"), now, 1.0,
+		xtime.Second, nil, series.WriteOptions{})
+	require.NoError(t, err)
+	s.insertQueue.Drain()
+
+	_, _, err = s.Write(ctx, ident.StringID("bar"), now, 2.0,
+		xtime.Second, nil, series.WriteOptions{})
+	require.NoError(t, err)
+
+	var (
+		lock        sync.Mutex
+		snapshotted = make(map[string]struct{})
+	)
+	snapshotPreparer := persist.NewMockSnapshotPreparer(ctrl)
+	snapshotPreparer.EXPECT().PrepareData(gomock.Any()).Return(persist.PreparedDataPersist{
+		Persist: func(id ident.ID, _ ident.Tags, _ ts.Segment, _ uint32) error {
+			lock.Lock()
+			snapshotted[id.String()] = struct{}{}
+			lock.Unlock()
+			return nil
+		},
+		Close: func() error { return nil },
+	}, nil)
+
+	err = s.Snapshot(blockStart, now, snapshotPreparer, namespace.Context{})
+	require.NoError(t, err)
+
+	// Both writes were enqueued to the commit log before the snapshot was
+	// prepared so both must be contained in the snapshot.
+	require.Equal(t, map[string]struct{}{
+		"foo": {},
+		"bar": {},
+	}, snapshotted)
+}
+
 func addMockTestSeries(ctrl *gomock.Controller, shard *dbShard, id ident.ID) *series.MockDatabaseSeries {
 	series := series.NewMockDatabaseSeries(ctrl)
 	series.EXPECT().ID().AnyTimes().Return(id)
@@ -884,6 +1699,97 @@ func writeShardAndVerify(
 	assert.Equal(t, expectedIdx, series.UniqueIndex)
 }
 
+func TestShardWriteNewSeriesQuotaExceeded(t *testing.T) {
+	now := time.Now()
+	opts := DefaultTestOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return now
+	}))
+	shard := testDatabaseShard(t, opts)
+	defer shard.Close()
+	shard.SetRuntimeOptions(runtime.NewOptions().SetWriteNewSeriesAsync(false))
+
+	shard.quotaLimiter = ratelimit.NewQuotaLimiter("testns1", opts.ClockOptions().NowFn())
+	shard.quotaLimiter.SetQuota(ratelimit.Quota{NewSeriesPerSecond: 1})
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	writeShardAndVerify(ctx, t, shard, "foo", now, 1.0, true, 0)
+	// Writes to existing series are not limited by the new series quota.
+	writeShardAndVerify(ctx, t, shard, "foo", now.Add(time.Second), 2.0, true, 0)
+
+	_, _, err := shard.Write(ctx, ident.StringID("bar"), now, 1.0,
+		xtime.Second, nil, series.WriteOptions{})
+	require.Error(t, err)
+	require.True(t, ratelimit.IsQuotaExceededError(err))
+	require.Equal(t, int64(1), shard.NumSeries())
+}
+
+func TestShardWriteDatapointsQuotaExceeded(t *testing.T) {
+	now := time.Now()
+	opts := DefaultTestOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return now
+	}))
+	shard := testDatabaseShard(t, opts)
+	defer shard.Close()
+	shard.SetRuntimeOptions(runtime.NewOptions().SetWriteNewSeriesAsync(false))
+
+	shard.quotaLimiter = ratelimit.NewQuotaLimiter("testns1", opts.ClockOptions().NowFn())
+	shard.quotaLimiter.SetQuota(ratelimit.Quota{
+		DatapointsPerSecond: 2,
+		NewSeriesPerSecond:  1,
+	})
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	writeShardAndVerify(ctx, t, shard, "foo", now, 1.0, true, 0)
+
+	// Writes rejected by the new series quota do not use the datapoints quota.
+	_, _, err := shard.Write(ctx, ident.StringID("bar"), now, 1.0,
+		xtime.Second, nil, series.WriteOptions{})
+	require.Error(t, err)
+	require.Equal(t, ratelimit.NewSeriesQuotaResource,
+		err.(ratelimit.QuotaExceededError).Resource)
+	writeShardAndVerify(ctx, t, shard, "foo", now.Add(time.Second), 2.0, true, 0)
+
+	_, _, err = shard.Write(ctx, ident.StringID("foo"), now.Add(2*time.Second), 3.0,
+		xtime.Second, nil, series.WriteOptions{})
+	require.Error(t, err)
+	require.Equal(t, ratelimit.DatapointsQuotaResource,
+		err.(ratelimit.QuotaExceededError).Resource)
+}
+
+func TestShardWriteFailedRefundsQuota(t *testing.T) {
+	now := time.Now()
+	opts := DefaultTestOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return now
+	}))
+	shard := testDatabaseShard(t, opts)
+	defer shard.Close()
+	shard.SetRuntimeOptions(runtime.NewOptions().SetWriteNewSeriesAsync(false))
+
+	shard.quotaLimiter = ratelimit.NewQuotaLimiter("testns1", opts.ClockOptions().NowFn())
+	shard.quotaLimiter.SetQuota(ratelimit.Quota{DatapointsPerSecond: 2})
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	writeShardAndVerify(ctx, t, shard, "foo", now, 1.0, true, 0)
+
+	// Writes outside of the retention fail and do not use the quota.
+	tooOld := now.Add(-2 * shard.namespace.Options().RetentionOptions().RetentionPeriod())
+	_, _, err := shard.Write(ctx, ident.StringID("foo"), tooOld, 2.0,
+		xtime.Second, nil, series.WriteOptions{})
+	require.Error(t, err)
+	require.False(t, ratelimit.IsQuotaExceededError(err))
+
+	writeShardAndVerify(ctx, t, shard, "foo", now.Add(time.Second), 3.0, true, 0)
+}
+
 func TestShardTick(t *testing.T) {
 	dir, err := ioutil.TempDir("", "testdir")
 	require.NoError(t, err)
@@ -1498,6 +2404,81 @@ func TestShardCleanupExpiredFileSets(t *testing.T) {
 	require.Equal(t, []string{defaultTestNs1ID.String(), "0"}, deletedFiles)
 }
 
+func TestShardOffloadFileSets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	remoteDir, err := ioutil.TempDir("", "testremotedir")
+	require.NoError(t, err)
+	defer os.RemoveAll(remoteDir)
+
+	store, err := remote.NewDirectoryStore(remoteDir)
+	require.NoError(t, err)
+
+	var (
+		opts   = DefaultTestOptions()
+		fsOpts = opts.CommitLogOptions().FilesystemOptions().
+			SetFilePathPrefix(dir).
+			SetRemoteStore(store).
+			SetRemoteOffloadAfter(24 * time.Hour)
+	)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().
+		SetFilesystemOptions(fsOpts))
+
+	s := testDatabaseShard(t, opts)
+	defer s.Close()
+
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+
+	var (
+		blockSize = s.namespace.Options().RetentionOptions().BlockSize()
+		now       = time.Now().Truncate(blockSize)
+		cold      = now.Add(-48 * time.Hour)
+		warm      = now.Add(-blockSize)
+	)
+	for _, id := range []fs.FileSetFileIdentifier{
+		{Namespace: defaultTestNs1ID, Shard: s.ID(), BlockStart: cold, VolumeIndex: 0},
+		{Namespace: defaultTestNs1ID, Shard: s.ID(), BlockStart: cold, VolumeIndex: 1},
+		{Namespace: defaultTestNs1ID, Shard: s.ID(), BlockStart: warm, VolumeIndex: 0},
+	} {
+		require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+			FileSetType: persist.FileSetFlushType,
+			Identifier:  id,
+			BlockSize:   blockSize,
+		}))
+		require.NoError(t, writer.Close())
+	}
+
+	require.NoError(t, s.OffloadFileSets(now))
+
+	dataExists := func(blockStart time.Time, volume int) bool {
+		exists, err := fs.DataFileSetExists(dir, defaultTestNs1ID, s.ID(), blockStart, volume)
+		require.NoError(t, err)
+		return exists
+	}
+	// Only the latest volume of cold blocks is offloaded, the info and
+	// checkpoint files remain so the filesets are still considered present.
+	require.True(t, dataExists(cold, 0))
+	require.True(t, dataExists(cold, 1))
+	require.True(t, dataExists(warm, 0))
+
+	shardDir := fs.ShardDataDirPath(dir, defaultTestNs1ID, s.ID())
+	dataFiles, err := filepath.Glob(filepath.Join(shardDir, "*-data.db"))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(dataFiles))
+
+	// Deleting the compacted volumes also deletes their remote copies.
+	remoteFiles, err := filepath.Glob(filepath.Join(remoteDir, "data", "*", "*", "*"))
+	require.NoError(t, err)
+	require.NotEmpty(t, remoteFiles)
+	require.NoError(t, s.CleanupExpiredFileSets(now))
+	remoteFiles, err = filepath.Glob(filepath.Join(remoteDir, "data", "*", "*", "*"))
+	require.NoError(t, err)
+	require.Empty(t, remoteFiles)
+}
+
 type testCloser struct {
 	called int
 }
@@ -46,6 +46,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/x/xio"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
@@ -254,6 +255,20 @@ func (mr *MockDatabaseMockRecorder) WriteTagged(ctx, namespace, id, tags, timest
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTagged", reflect.TypeOf((*MockDatabase)(nil).WriteTagged), ctx, namespace, id, tags, timestamp, value, unit, annotation)
 }
 
+// WriteDocument mocks base method
+func (m *MockDatabase) WriteDocument(ctx context.Context, namespace ident.ID, d doc.Document, timestamp time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDocument", ctx, namespace, d, timestamp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteDocument indicates an expected call of WriteDocument
+func (mr *MockDatabaseMockRecorder) WriteDocument(ctx, namespace, d, timestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDocument", reflect.TypeOf((*MockDatabase)(nil).WriteDocument), ctx, namespace, d, timestamp)
+}
+
 // BatchWriter mocks base method
 func (m *MockDatabase) BatchWriter(namespace ident.ID, batchSize int) (ts.BatchWriter, error) {
 	m.ctrl.T.Helper()
@@ -458,6 +473,36 @@ func (mr *MockDatabaseMockRecorder) Truncate(namespace interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*MockDatabase)(nil).Truncate), namespace)
 }
 
+// DeleteTagged mocks base method
+func (m *MockDatabase) DeleteTagged(ctx context.Context, namespace ident.ID, query index.Query, start, end time.Time) (DeleteTaggedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", ctx, namespace, query, start, end)
+	ret0, _ := ret[0].(DeleteTaggedResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged
+func (mr *MockDatabaseMockRecorder) DeleteTagged(ctx, namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*MockDatabase)(nil).DeleteTagged), ctx, namespace, query, start, end)
+}
+
+// Cardinality mocks base method
+func (m *MockDatabase) Cardinality(ctx context.Context, namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", ctx, namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockDatabaseMockRecorder) Cardinality(ctx, namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockDatabase)(nil).Cardinality), ctx, namespace, opts)
+}
+
 // BootstrapState mocks base method
 func (m *MockDatabase) BootstrapState() DatabaseBootstrapState {
 	m.ctrl.T.Helper()
@@ -649,6 +694,20 @@ func (mr *MockdatabaseMockRecorder) WriteTagged(ctx, namespace, id, tags, timest
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTagged", reflect.TypeOf((*Mockdatabase)(nil).WriteTagged), ctx, namespace, id, tags, timestamp, value, unit, annotation)
 }
 
+// WriteDocument mocks base method
+func (m *Mockdatabase) WriteDocument(ctx context.Context, namespace ident.ID, d doc.Document, timestamp time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDocument", ctx, namespace, d, timestamp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteDocument indicates an expected call of WriteDocument
+func (mr *MockdatabaseMockRecorder) WriteDocument(ctx, namespace, d, timestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDocument", reflect.TypeOf((*Mockdatabase)(nil).WriteDocument), ctx, namespace, d, timestamp)
+}
+
 // BatchWriter mocks base method
 func (m *Mockdatabase) BatchWriter(namespace ident.ID, batchSize int) (ts.BatchWriter, error) {
 	m.ctrl.T.Helper()
@@ -853,6 +912,36 @@ func (mr *MockdatabaseMockRecorder) Truncate(namespace interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*Mockdatabase)(nil).Truncate), namespace)
 }
 
+// DeleteTagged mocks base method
+func (m *Mockdatabase) DeleteTagged(ctx context.Context, namespace ident.ID, query index.Query, start, end time.Time) (DeleteTaggedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", ctx, namespace, query, start, end)
+	ret0, _ := ret[0].(DeleteTaggedResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged
+func (mr *MockdatabaseMockRecorder) DeleteTagged(ctx, namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*Mockdatabase)(nil).DeleteTagged), ctx, namespace, query, start, end)
+}
+
+// Cardinality mocks base method
+func (m *Mockdatabase) Cardinality(ctx context.Context, namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", ctx, namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockdatabaseMockRecorder) Cardinality(ctx, namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*Mockdatabase)(nil).Cardinality), ctx, namespace, opts)
+}
+
 // BootstrapState mocks base method
 func (m *Mockdatabase) BootstrapState() DatabaseBootstrapState {
 	m.ctrl.T.Helper()
@@ -1269,6 +1358,21 @@ func (mr *MockdatabaseNamespaceMockRecorder) WriteTagged(ctx, id, tags, timestam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTagged", reflect.TypeOf((*MockdatabaseNamespace)(nil).WriteTagged), ctx, id, tags, timestamp, value, unit, annotation)
 }
 
+// WriteDocument mocks base method
+func (m *MockdatabaseNamespace) WriteDocument(ctx context.Context, d doc.Document, timestamp time.Time) (ts.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDocument", ctx, d, timestamp)
+	ret0, _ := ret[0].(ts.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteDocument indicates an expected call of WriteDocument
+func (mr *MockdatabaseNamespaceMockRecorder) WriteDocument(ctx, d, timestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDocument", reflect.TypeOf((*MockdatabaseNamespace)(nil).WriteDocument), ctx, d, timestamp)
+}
+
 // QueryIDs mocks base method
 func (m *MockdatabaseNamespace) QueryIDs(ctx context.Context, query index.Query, opts index.QueryOptions) (index.QueryResult, error) {
 	m.ctrl.T.Helper()
@@ -1403,17 +1507,60 @@ func (mr *MockdatabaseNamespaceMockRecorder) FlushIndex(flush interface{}) *gomo
 }
 
 // ColdFlush mocks base method
-func (m *MockdatabaseNamespace) ColdFlush(flush persist.FlushPreparer) error {
+func (m *MockdatabaseNamespace) ColdFlush(flush persist.FlushPreparer, commitLogID persist.CommitLogFile) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ColdFlush", flush)
+	ret := m.ctrl.Call(m, "ColdFlush", flush, commitLogID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ColdFlush indicates an expected call of ColdFlush
-func (mr *MockdatabaseNamespaceMockRecorder) ColdFlush(flush interface{}) *gomock.Call {
+func (mr *MockdatabaseNamespaceMockRecorder) ColdFlush(flush, commitLogID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlush", reflect.TypeOf((*MockdatabaseNamespace)(nil).ColdFlush), flush, commitLogID)
+}
+
+// ColdFlushRetainedCommitLogIndex mocks base method
+func (m *MockdatabaseNamespace) ColdFlushRetainedCommitLogIndex() (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ColdFlushRetainedCommitLogIndex")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// ColdFlushRetainedCommitLogIndex indicates an expected call of ColdFlushRetainedCommitLogIndex
+func (mr *MockdatabaseNamespaceMockRecorder) ColdFlushRetainedCommitLogIndex() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlushRetainedCommitLogIndex", reflect.TypeOf((*MockdatabaseNamespace)(nil).ColdFlushRetainedCommitLogIndex))
+}
+
+// DownsampleFlush mocks base method
+func (m *MockdatabaseNamespace) DownsampleFlush(tickStart time.Time, flush persist.FlushPreparer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownsampleFlush", tickStart, flush)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownsampleFlush indicates an expected call of DownsampleFlush
+func (mr *MockdatabaseNamespaceMockRecorder) DownsampleFlush(tickStart, flush interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownsampleFlush", reflect.TypeOf((*MockdatabaseNamespace)(nil).DownsampleFlush), tickStart, flush)
+}
+
+// RewriteFlush mocks base method
+func (m *MockdatabaseNamespace) RewriteFlush(tickStart time.Time, flush persist.FlushPreparer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewriteFlush", tickStart, flush)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RewriteFlush indicates an expected call of RewriteFlush
+func (mr *MockdatabaseNamespaceMockRecorder) RewriteFlush(tickStart, flush interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlush", reflect.TypeOf((*MockdatabaseNamespace)(nil).ColdFlush), flush)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewriteFlush", reflect.TypeOf((*MockdatabaseNamespace)(nil).RewriteFlush), tickStart, flush)
 }
 
 // Snapshot mocks base method
@@ -1460,6 +1607,36 @@ func (mr *MockdatabaseNamespaceMockRecorder) Truncate() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*MockdatabaseNamespace)(nil).Truncate))
 }
 
+// DeleteTagged mocks base method
+func (m *MockdatabaseNamespace) DeleteTagged(ctx context.Context, query index.Query, start, end time.Time) (DeleteTaggedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", ctx, query, start, end)
+	ret0, _ := ret[0].(DeleteTaggedResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged
+func (mr *MockdatabaseNamespaceMockRecorder) DeleteTagged(ctx, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*MockdatabaseNamespace)(nil).DeleteTagged), ctx, query, start, end)
+}
+
+// Cardinality mocks base method
+func (m *MockdatabaseNamespace) Cardinality(ctx context.Context, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", ctx, opts)
+	ret0, _ := ret[0].(index.CardinalityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockdatabaseNamespaceMockRecorder) Cardinality(ctx, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockdatabaseNamespace)(nil).Cardinality), ctx, opts)
+}
+
 // Repair mocks base method
 func (m *MockdatabaseNamespace) Repair(repairer databaseShardRepairer, tr time0.Range) error {
 	m.ctrl.T.Helper()
@@ -1795,6 +1972,48 @@ func (mr *MockdatabaseShardMockRecorder) FetchBlocksForColdFlush(ctx, seriesID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksForColdFlush", reflect.TypeOf((*MockdatabaseShard)(nil).FetchBlocksForColdFlush), ctx, seriesID, start, version, nsCtx)
 }
 
+// DeleteSeriesRange mocks base method
+func (m *MockdatabaseShard) DeleteSeriesRange(id ident.ID, start, end time.Time, nsCtx namespace.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeriesRange", id, start, end, nsCtx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeriesRange indicates an expected call of DeleteSeriesRange
+func (mr *MockdatabaseShardMockRecorder) DeleteSeriesRange(id, start, end, nsCtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeriesRange", reflect.TypeOf((*MockdatabaseShard)(nil).DeleteSeriesRange), id, start, end, nsCtx)
+}
+
+// HasPendingTombstones mocks base method
+func (m *MockdatabaseShard) HasPendingTombstones() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPendingTombstones")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasPendingTombstones indicates an expected call of HasPendingTombstones
+func (mr *MockdatabaseShardMockRecorder) HasPendingTombstones() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPendingTombstones", reflect.TypeOf((*MockdatabaseShard)(nil).HasPendingTombstones))
+}
+
+// WriteTombstones mocks base method
+func (m *MockdatabaseShard) WriteTombstones(ids []ident.ID, start, end time.Time, position persist.CommitLogPosition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteTombstones", ids, start, end, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteTombstones indicates an expected call of WriteTombstones
+func (mr *MockdatabaseShardMockRecorder) WriteTombstones(ids, start, end, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTombstones", reflect.TypeOf((*MockdatabaseShard)(nil).WriteTombstones), ids, start, end, position)
+}
+
 // FetchBlocksMetadataV2 mocks base method
 func (m *MockdatabaseShard) FetchBlocksMetadataV2(ctx context.Context, start, end time.Time, limit int64, pageToken PageToken, opts block.FetchBlocksMetadataOptions) (block.FetchBlocksMetadataResults, PageToken, error) {
 	m.ctrl.T.Helper()
@@ -1880,17 +2099,60 @@ func (mr *MockdatabaseShardMockRecorder) WarmFlush(blockStart, flush, nsCtx inte
 }
 
 // ColdFlush mocks base method
-func (m *MockdatabaseShard) ColdFlush(flush persist.FlushPreparer, resources coldFlushReuseableResources, nsCtx namespace.Context, onFlush persist.OnFlushSeries) error {
+func (m *MockdatabaseShard) ColdFlush(flush persist.FlushPreparer, resources coldFlushReuseableResources, nsCtx namespace.Context, onFlush persist.OnFlushSeries, commitLogID persist.CommitLogFile) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ColdFlush", flush, resources, nsCtx, onFlush)
+	ret := m.ctrl.Call(m, "ColdFlush", flush, resources, nsCtx, onFlush, commitLogID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ColdFlush indicates an expected call of ColdFlush
-func (mr *MockdatabaseShardMockRecorder) ColdFlush(flush, resources, nsCtx, onFlush interface{}) *gomock.Call {
+func (mr *MockdatabaseShardMockRecorder) ColdFlush(flush, resources, nsCtx, onFlush, commitLogID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlush", reflect.TypeOf((*MockdatabaseShard)(nil).ColdFlush), flush, resources, nsCtx, onFlush, commitLogID)
+}
+
+// ColdFlushRetainedCommitLogIndex mocks base method
+func (m *MockdatabaseShard) ColdFlushRetainedCommitLogIndex() (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ColdFlushRetainedCommitLogIndex")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// ColdFlushRetainedCommitLogIndex indicates an expected call of ColdFlushRetainedCommitLogIndex
+func (mr *MockdatabaseShardMockRecorder) ColdFlushRetainedCommitLogIndex() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlushRetainedCommitLogIndex", reflect.TypeOf((*MockdatabaseShard)(nil).ColdFlushRetainedCommitLogIndex))
+}
+
+// DownsampleFlush mocks base method
+func (m *MockdatabaseShard) DownsampleFlush(tickStart time.Time, flush persist.FlushPreparer, fsReader fs.DataFileSetReader, nsCtx namespace.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownsampleFlush", tickStart, flush, fsReader, nsCtx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownsampleFlush indicates an expected call of DownsampleFlush
+func (mr *MockdatabaseShardMockRecorder) DownsampleFlush(tickStart, flush, fsReader, nsCtx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlush", reflect.TypeOf((*MockdatabaseShard)(nil).ColdFlush), flush, resources, nsCtx, onFlush)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownsampleFlush", reflect.TypeOf((*MockdatabaseShard)(nil).DownsampleFlush), tickStart, flush, fsReader, nsCtx)
+}
+
+// RewriteFlush mocks base method
+func (m *MockdatabaseShard) RewriteFlush(tickStart time.Time, flush persist.FlushPreparer, fsReader fs.DataFileSetReader, nsCtx namespace.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewriteFlush", tickStart, flush, fsReader, nsCtx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RewriteFlush indicates an expected call of RewriteFlush
+func (mr *MockdatabaseShardMockRecorder) RewriteFlush(tickStart, flush, fsReader, nsCtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewriteFlush", reflect.TypeOf((*MockdatabaseShard)(nil).RewriteFlush), tickStart, flush, fsReader, nsCtx)
 }
 
 // Snapshot mocks base method
@@ -1950,6 +2212,20 @@ func (mr *MockdatabaseShardMockRecorder) CleanupCompactedFileSets() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupCompactedFileSets", reflect.TypeOf((*MockdatabaseShard)(nil).CleanupCompactedFileSets))
 }
 
+// OffloadFileSets mocks base method
+func (m *MockdatabaseShard) OffloadFileSets(t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OffloadFileSets", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OffloadFileSets indicates an expected call of OffloadFileSets
+func (mr *MockdatabaseShardMockRecorder) OffloadFileSets(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffloadFileSets", reflect.TypeOf((*MockdatabaseShard)(nil).OffloadFileSets), t)
+}
+
 // Repair mocks base method
 func (m *MockdatabaseShard) Repair(ctx context.Context, nsCtx namespace.Context, nsMeta namespace.Metadata, tr time0.Range, repairer databaseShardRepairer) (repair.MetadataComparisonResult, error) {
 	m.ctrl.T.Helper()
@@ -2104,6 +2380,45 @@ func (mr *MockNamespaceIndexMockRecorder) AggregateQuery(ctx, query, opts interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateQuery", reflect.TypeOf((*MockNamespaceIndex)(nil).AggregateQuery), ctx, query, opts)
 }
 
+// DeleteSeries mocks base method
+func (m *MockNamespaceIndex) DeleteSeries(ids []ident.ID, start, end time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteSeries", ids, start, end)
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries
+func (mr *MockNamespaceIndexMockRecorder) DeleteSeries(ids, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockNamespaceIndex)(nil).DeleteSeries), ids, start, end)
+}
+
+// ClearDeletedSeries mocks base method
+func (m *MockNamespaceIndex) ClearDeletedSeries(id ident.ID, start, end time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ClearDeletedSeries", id, start, end)
+}
+
+// ClearDeletedSeries indicates an expected call of ClearDeletedSeries
+func (mr *MockNamespaceIndexMockRecorder) ClearDeletedSeries(id, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDeletedSeries", reflect.TypeOf((*MockNamespaceIndex)(nil).ClearDeletedSeries), id, start, end)
+}
+
+// Cardinality mocks base method
+func (m *MockNamespaceIndex) Cardinality(ctx context.Context, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", ctx, opts)
+	ret0, _ := ret[0].(index.CardinalityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockNamespaceIndexMockRecorder) Cardinality(ctx, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockNamespaceIndex)(nil).Cardinality), ctx, opts)
+}
+
 // Bootstrap mocks base method
 func (m *MockNamespaceIndex) Bootstrap(bootstrapResults result.IndexResults) error {
 	m.ctrl.T.Helper()
@@ -3461,6 +3776,62 @@ func (mr *MockOptionsMockRecorder) RepairOptions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairOptions", reflect.TypeOf((*MockOptions)(nil).RepairOptions))
 }
 
+// SetFileSetRewriteEnabled mocks base method
+func (m *MockOptions) SetFileSetRewriteEnabled(b bool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFileSetRewriteEnabled", b)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFileSetRewriteEnabled indicates an expected call of SetFileSetRewriteEnabled
+func (mr *MockOptionsMockRecorder) SetFileSetRewriteEnabled(b interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFileSetRewriteEnabled", reflect.TypeOf((*MockOptions)(nil).SetFileSetRewriteEnabled), b)
+}
+
+// FileSetRewriteEnabled mocks base method
+func (m *MockOptions) FileSetRewriteEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileSetRewriteEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// FileSetRewriteEnabled indicates an expected call of FileSetRewriteEnabled
+func (mr *MockOptionsMockRecorder) FileSetRewriteEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileSetRewriteEnabled", reflect.TypeOf((*MockOptions)(nil).FileSetRewriteEnabled))
+}
+
+// SetFileSetRewriteOptions mocks base method
+func (m *MockOptions) SetFileSetRewriteOptions(value fs.RewriteOptions) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFileSetRewriteOptions", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFileSetRewriteOptions indicates an expected call of SetFileSetRewriteOptions
+func (mr *MockOptionsMockRecorder) SetFileSetRewriteOptions(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFileSetRewriteOptions", reflect.TypeOf((*MockOptions)(nil).SetFileSetRewriteOptions), value)
+}
+
+// FileSetRewriteOptions mocks base method
+func (m *MockOptions) FileSetRewriteOptions() fs.RewriteOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileSetRewriteOptions")
+	ret0, _ := ret[0].(fs.RewriteOptions)
+	return ret0
+}
+
+// FileSetRewriteOptions indicates an expected call of FileSetRewriteOptions
+func (mr *MockOptionsMockRecorder) FileSetRewriteOptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileSetRewriteOptions", reflect.TypeOf((*MockOptions)(nil).FileSetRewriteOptions))
+}
+
 // SetBootstrapProcessProvider mocks base method
 func (m *MockOptions) SetBootstrapProcessProvider(value bootstrap.ProcessProvider) Options {
 	m.ctrl.T.Helper()
@@ -3713,6 +4084,34 @@ func (mr *MockOptionsMockRecorder) EncoderPool() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncoderPool", reflect.TypeOf((*MockOptions)(nil).EncoderPool))
 }
 
+// SetHistogramEncoderPool mocks base method
+func (m *MockOptions) SetHistogramEncoderPool(value encoding.EncoderPool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHistogramEncoderPool", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHistogramEncoderPool indicates an expected call of SetHistogramEncoderPool
+func (mr *MockOptionsMockRecorder) SetHistogramEncoderPool(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHistogramEncoderPool", reflect.TypeOf((*MockOptions)(nil).SetHistogramEncoderPool), value)
+}
+
+// HistogramEncoderPool mocks base method
+func (m *MockOptions) HistogramEncoderPool() encoding.EncoderPool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HistogramEncoderPool")
+	ret0, _ := ret[0].(encoding.EncoderPool)
+	return ret0
+}
+
+// HistogramEncoderPool indicates an expected call of HistogramEncoderPool
+func (mr *MockOptionsMockRecorder) HistogramEncoderPool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HistogramEncoderPool", reflect.TypeOf((*MockOptions)(nil).HistogramEncoderPool))
+}
+
 // SetSegmentReaderPool mocks base method
 func (m *MockOptions) SetSegmentReaderPool(value xio.SegmentReaderPool) Options {
 	m.ctrl.T.Helper()
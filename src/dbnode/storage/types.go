@@ -43,6 +43,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/x/xio"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
@@ -115,6 +116,18 @@ type Database interface {
 		annotation []byte,
 	) error
 
+	// WriteDocument indexes a document in an index only namespace and writes
+	// it to the commit log, the document is retained for as long as the index
+	// block for the timestamp is within the namespace's retention. Documents
+	// are not replaced, writing a document with the ID of a document already
+	// in the index block is rejected.
+	WriteDocument(
+		ctx context.Context,
+		namespace ident.ID,
+		d doc.Document,
+		timestamp time.Time,
+	) error
+
 	// BatchWriter returns a batch writer for the provided namespace that can
 	// be used to issue a batch of writes to either WriteBatch
 	// or WriteTaggedBatch.
@@ -211,6 +224,23 @@ type Database interface {
 	// Truncate truncates data for the given namespace.
 	Truncate(namespace ident.ID) (int64, error)
 
+	// DeleteTagged deletes data within [start, end) for all series in the
+	// given namespace matching the query.
+	DeleteTagged(
+		ctx context.Context,
+		namespace ident.ID,
+		query index.Query,
+		start, end time.Time,
+	) (DeleteTaggedResult, error)
+
+	// Cardinality returns the approximate series cardinality per field and
+	// per field value of the given namespace.
+	Cardinality(
+		ctx context.Context,
+		namespace ident.ID,
+		opts index.CardinalityOptions,
+	) (index.CardinalityResult, error)
+
 	// BootstrapState captures and returns a snapshot of the databases'
 	// bootstrap state.
 	BootstrapState() DatabaseBootstrapState
@@ -302,6 +332,14 @@ type databaseNamespace interface {
 		annotation []byte,
 	) (ts.Series, bool, error)
 
+	// WriteDocument indexes a document in an index only namespace and
+	// returns the series to write to the commit log for the document.
+	WriteDocument(
+		ctx context.Context,
+		d doc.Document,
+		timestamp time.Time,
+	) (ts.Series, error)
+
 	// QueryIDs resolves the given query into known IDs.
 	QueryIDs(
 		ctx context.Context,
@@ -358,9 +396,32 @@ type databaseNamespace interface {
 		flush persist.IndexFlush,
 	) error
 
-	// ColdFlush flushes unflushed in-memory ColdWrites.
+	// ColdFlush flushes unflushed in-memory ColdWrites, the commit log ID is
+	// the commit log rotated to before the cold flush started.
 	ColdFlush(
 		flush persist.FlushPreparer,
+		commitLogID persist.CommitLogFile,
+	) error
+
+	// ColdFlushRetainedCommitLogIndex returns the index of the oldest commit
+	// log that may contain cold writes deferred by cold flushes of any owned
+	// shard or documents not yet persisted by an index flush, false if there
+	// are none.
+	ColdFlushRetainedCommitLogIndex() (int64, bool)
+
+	// DownsampleFlush downsamples flushed blocks that have aged into one of
+	// the namespace's resolution tiers.
+	DownsampleFlush(
+		tickStart time.Time,
+		flush persist.FlushPreparer,
+	) error
+
+	// RewriteFlush rewrites flushed blocks whose latest volume is encoded
+	// differently from the fileset rewrite encoding format when background
+	// rewrites are enabled.
+	RewriteFlush(
+		tickStart time.Time,
+		flush persist.FlushPreparer,
 	) error
 
 	// Snapshot snapshots unflushed in-memory WarmWrites.
@@ -374,6 +435,21 @@ type databaseNamespace interface {
 	// Truncate truncates the in-memory data for this namespace.
 	Truncate() (int64, error)
 
+	// DeleteTagged deletes data within [start, end) for all series matching
+	// the query.
+	DeleteTagged(
+		ctx context.Context,
+		query index.Query,
+		start, end time.Time,
+	) (DeleteTaggedResult, error)
+
+	// Cardinality returns the approximate series cardinality per field and
+	// per field value.
+	Cardinality(
+		ctx context.Context,
+		opts index.CardinalityOptions,
+	) (index.CardinalityResult, error)
+
 	// Repair repairs the namespace data for a given time range
 	Repair(repairer databaseShardRepairer, tr xtime.Range) error
 
@@ -488,6 +564,29 @@ type databaseShard interface {
 		nsCtx namespace.Context,
 	) (block.FetchBlockResult, error)
 
+	// DeleteSeriesRange deletes the data within [start, end) held in memory
+	// for a series.
+	DeleteSeriesRange(
+		id ident.ID,
+		start, end time.Time,
+		nsCtx namespace.Context,
+	) error
+
+	// HasPendingTombstones returns whether any flushed blocks still need to
+	// be rewritten without deleted data.
+	HasPendingTombstones() bool
+
+	// WriteTombstones durably records that the data within [start, end) of
+	// the series was deleted once the commit log reached the given position
+	// and hides the series from the index for the range until written again.
+	// Data in flushed blocks is filtered from reads until their filesets are
+	// rewritten without it by the next cold flush.
+	WriteTombstones(
+		ids []ident.ID,
+		start, end time.Time,
+		position persist.CommitLogPosition,
+	) error
+
 	// FetchBlocksMetadataV2 retrieves blocks metadata.
 	FetchBlocksMetadataV2(
 		ctx context.Context,
@@ -521,12 +620,37 @@ type databaseShard interface {
 		nsCtx namespace.Context,
 	) error
 
-	// ColdFlush flushes the unflushed ColdWrites in this shard.
+	// ColdFlush flushes the unflushed ColdWrites in this shard, the commit
+	// log ID is the commit log rotated to before the cold flush started.
 	ColdFlush(
 		flush persist.FlushPreparer,
 		resources coldFlushReuseableResources,
 		nsCtx namespace.Context,
 		onFlush persist.OnFlushSeries,
+		commitLogID persist.CommitLogFile,
+	) error
+
+	// ColdFlushRetainedCommitLogIndex returns the index of the oldest commit
+	// log that may contain cold writes deferred by cold flushes of this shard,
+	// false if no cold writes are deferred.
+	ColdFlushRetainedCommitLogIndex() (int64, bool)
+
+	// DownsampleFlush downsamples the flushed blocks in this shard that have
+	// aged into one of the namespace's resolution tiers.
+	DownsampleFlush(
+		tickStart time.Time,
+		flush persist.FlushPreparer,
+		fsReader fs.DataFileSetReader,
+		nsCtx namespace.Context,
+	) error
+
+	// RewriteFlush rewrites the flushed blocks in this shard whose latest
+	// volume is encoded differently from the fileset rewrite encoding format.
+	RewriteFlush(
+		tickStart time.Time,
+		flush persist.FlushPreparer,
+		fsReader fs.DataFileSetReader,
+		nsCtx namespace.Context,
 	) error
 
 	// Snapshot snapshot's the unflushed WarmWrites in this shard.
@@ -548,6 +672,10 @@ type databaseShard interface {
 	// fileset for that block.
 	CleanupCompactedFileSets() error
 
+	// OffloadFileSets offloads the latest data fileset of each block that
+	// ended before the configured offload age to the remote store.
+	OffloadFileSets(t time.Time) error
+
 	// Repair repairs the shard data for a given time.
 	Repair(
 		ctx context.Context,
@@ -613,6 +741,29 @@ type NamespaceIndex interface {
 		opts index.AggregationOptions,
 	) (index.AggregateQueryResult, error)
 
+	// DeleteSeries records that the data within [start, end) of the series was
+	// deleted, the series are no longer returned by queries whose range falls
+	// within the deleted data and are removed from blocks the range covers.
+	DeleteSeries(
+		ids []ident.ID,
+		start, end time.Time,
+	)
+
+	// ClearDeletedSeries records that the series was written again within
+	// [start, end) after its data was deleted, the series is returned by
+	// queries for the range again.
+	ClearDeletedSeries(
+		id ident.ID,
+		start, end time.Time,
+	)
+
+	// Cardinality returns the approximate series cardinality per field and
+	// per field value tracked by the index blocks overlapping the range.
+	Cardinality(
+		ctx context.Context,
+		opts index.CardinalityOptions,
+	) (index.CardinalityResult, error)
+
 	// Bootstrap bootstraps the index the provided segments.
 	Bootstrap(
 		bootstrapResults result.IndexResults,
@@ -699,6 +850,16 @@ type BootstrapResult struct {
 	AlreadyBootstrapping bool
 }
 
+// DeleteTaggedResult is the result of deleting data for the series matching
+// a query.
+type DeleteTaggedResult struct {
+	// NumSeries is the number of series that data was deleted from.
+	NumSeries int64
+	// NumSeriesByShard is the number of series that data was deleted from
+	// in each shard.
+	NumSeriesByShard map[uint32]int64
+}
+
 // databaseFlushManager manages flushing in-memory data to persistent storage.
 type databaseFlushManager interface {
 	// Flush flushes in-memory data to persistent storage.
@@ -933,6 +1094,21 @@ type Options interface {
 	// RepairOptions returns the repair options.
 	RepairOptions() repair.Options
 
+	// SetFileSetRewriteEnabled sets whether flushed filesets are rewritten in
+	// the background with the current encoding and fileset rewrite options.
+	SetFileSetRewriteEnabled(b bool) Options
+
+	// FileSetRewriteEnabled returns whether flushed filesets are rewritten in
+	// the background.
+	FileSetRewriteEnabled() bool
+
+	// SetFileSetRewriteOptions sets the background fileset rewrite options,
+	// dropping series is not supported by background rewrites.
+	SetFileSetRewriteOptions(value fs.RewriteOptions) Options
+
+	// FileSetRewriteOptions returns the background fileset rewrite options.
+	FileSetRewriteOptions() fs.RewriteOptions
+
 	// SetBootstrapProcessProvider sets the bootstrap process provider for the database.
 	SetBootstrapProcessProvider(value bootstrap.ProcessProvider) Options
 
@@ -998,6 +1174,14 @@ type Options interface {
 	// EncoderPool returns the contextPool.
 	EncoderPool() encoding.EncoderPool
 
+	// SetHistogramEncoderPool sets the encoder pool used by namespaces with
+	// histograms enabled.
+	SetHistogramEncoderPool(value encoding.EncoderPool) Options
+
+	// HistogramEncoderPool returns the encoder pool used by namespaces with
+	// histograms enabled.
+	HistogramEncoderPool() encoding.EncoderPool
+
 	// SetSegmentReaderPool sets the contextPool.
 	SetSegmentReaderPool(value xio.SegmentReaderPool) Options
 
@@ -1164,4 +1348,5 @@ type newFSMergeWithMemFn func(
 	retriever series.QueryableBlockRetriever,
 	dirtySeries *dirtySeriesMap,
 	dirtySeriesToWrite map[xtime.UnixNano]*idList,
+	tombstones map[xtime.UnixNano]map[string]xtime.Ranges,
 ) fs.MergeWith
@@ -39,8 +39,10 @@ func Majority(replicas int) int {
 }
 
 type host struct {
-	id      string
-	address string
+	id             string
+	address        string
+	isolationGroup string
+	grpcPort       int
 }
 
 func (h *host) ID() string {
@@ -51,6 +53,14 @@ func (h *host) Address() string {
 	return h.address
 }
 
+func (h *host) IsolationGroup() string {
+	return h.isolationGroup
+}
+
+func (h *host) GRPCPort() int {
+	return h.grpcPort
+}
+
 func (h *host) String() string {
 	return fmt.Sprintf("Host<ID=%s, Address=%s>", h.id, h.address)
 }
@@ -60,6 +70,11 @@ func NewHost(id, address string) Host {
 	return &host{id: id, address: address}
 }
 
+// NewHostWithIsolationGroup creates a new host in the given isolation group
+func NewHostWithIsolationGroup(id, address, isolationGroup string) Host {
+	return &host{id: id, address: address, isolationGroup: isolationGroup}
+}
+
 type hostShardSet struct {
 	host     Host
 	shardSet sharding.ShardSet
@@ -86,7 +101,13 @@ func NewHostShardSetFromServiceInstance(
 	if err != nil {
 		return nil, err
 	}
-	return NewHostShardSet(NewHost(si.InstanceID(), si.Endpoint()), shardSet), nil
+	h := &host{
+		id:             si.InstanceID(),
+		address:        si.Endpoint(),
+		isolationGroup: si.IsolationGroup(),
+		grpcPort:       int(si.GRPCPort()),
+	}
+	return NewHostShardSet(h, shardSet), nil
 }
 
 func (h *hostShardSet) Host() Host {
@@ -87,6 +87,34 @@ func (mr *MockHostMockRecorder) Address() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Address", reflect.TypeOf((*MockHost)(nil).Address))
 }
 
+// IsolationGroup mocks base method
+func (m *MockHost) IsolationGroup() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsolationGroup")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// IsolationGroup indicates an expected call of IsolationGroup
+func (mr *MockHostMockRecorder) IsolationGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsolationGroup", reflect.TypeOf((*MockHost)(nil).IsolationGroup))
+}
+
+// GRPCPort mocks base method
+func (m *MockHost) GRPCPort() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCPort")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GRPCPort indicates an expected call of GRPCPort
+func (mr *MockHostMockRecorder) GRPCPort() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCPort", reflect.TypeOf((*MockHost)(nil).GRPCPort))
+}
+
 // String mocks base method
 func (m *MockHost) String() string {
 	m.ctrl.T.Helper()
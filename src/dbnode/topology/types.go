@@ -37,6 +37,13 @@ type Host interface {
 	// Address returns the address of the host
 	Address() string
 
+	// IsolationGroup returns the isolation group of the host, such as the
+	// zone or rack it runs in, or empty if unknown
+	IsolationGroup() string
+
+	// GRPCPort returns the port the host serves gRPC on, or zero if unknown
+	GRPCPort() int
+
 	// String returns a string representation of the host
 	String() string
 }
@@ -114,4 +114,46 @@ const (
 
 	// BootstrapperFilesystemSourceRead is the operation for the peers Read path.
 	BootstrapperFilesystemSourceRead = "bootstrapper.fs.filesystemSource.Read"
+
+	// ClientSessionWrite is the operation name for the client session Write path.
+	ClientSessionWrite = "client.session.Write"
+
+	// ClientSessionWriteTagged is the operation name for the client session WriteTagged path.
+	ClientSessionWriteTagged = "client.session.WriteTagged"
+
+	// ClientSessionFetchIDs is the operation name for the client session FetchIDs path.
+	ClientSessionFetchIDs = "client.session.FetchIDs"
+
+	// ClientSessionFetchTagged is the operation name for the client session FetchTagged path.
+	ClientSessionFetchTagged = "client.session.FetchTagged"
+
+	// ClientSessionFetchTaggedIDs is the operation name for the client session FetchTaggedIDs path.
+	ClientSessionFetchTaggedIDs = "client.session.FetchTaggedIDs"
+
+	// ClientSessionFetchTaggedPage is the operation name for the client session FetchTaggedPage path.
+	ClientSessionFetchTaggedPage = "client.session.FetchTaggedPage"
+
+	// ClientSessionFetchTaggedIDsPage is the operation name for the client session FetchTaggedIDsPage path.
+	ClientSessionFetchTaggedIDsPage = "client.session.FetchTaggedIDsPage"
+
+	// ClientSessionAggregate is the operation name for the client session Aggregate path.
+	ClientSessionAggregate = "client.session.Aggregate"
+
+	// ClientSessionCardinality is the operation name for the client session Cardinality path.
+	ClientSessionCardinality = "client.session.Cardinality"
+
+	// ClientQueueFetchTagged is the operation name for the client host queue FetchTagged request path.
+	ClientQueueFetchTagged = "client.queue.FetchTagged"
+
+	// ClientQueueWriteBatch is the operation name for the client host queue write batch request path.
+	ClientQueueWriteBatch = "client.queue.WriteBatch"
+
+	// ClientQueueFetchBatch is the operation name for the client host queue fetch batch request path.
+	ClientQueueFetchBatch = "client.queue.FetchBatch"
+
+	// ClientQueueAggregate is the operation name for the client host queue Aggregate request path.
+	ClientQueueAggregate = "client.queue.Aggregate"
+
+	// ClientQueueCardinality is the operation name for the client host queue Cardinality request path.
+	ClientQueueCardinality = "client.queue.Cardinality"
 )
@@ -43,6 +43,30 @@ func TestMarshal(t *testing.T) {
 			name:  "regexp query",
 			query: MustCreateRegexpQuery([]byte("fruit"), []byte(".*ple")),
 		},
+		{
+			name:  "case insensitive term query",
+			query: mustCreateQuery(NewCaseInsensitiveTermQuery([]byte("fruit"), []byte("Apple"))),
+		},
+		{
+			name:  "case insensitive regexp query",
+			query: mustCreateQuery(NewCaseInsensitiveRegexpQuery([]byte("fruit"), []byte("A.*ple"))),
+		},
+		{
+			name:  "fuzzy query",
+			query: mustCreateQuery(NewFuzzyQuery([]byte("fruit"), []byte("aple"), 1)),
+		},
+		{
+			name:  "prefix query",
+			query: NewPrefixQuery([]byte("fruit"), []byte("app")),
+		},
+		{
+			name:  "range query",
+			query: NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("pear"), true, false),
+		},
+		{
+			name:  "numeric range query",
+			query: mustCreateNumericRangeQuery([]byte("size"), nil, []byte("100"), false, true),
+		},
 		{
 			name:  "negation query",
 			query: NewNegationQuery(NewTermQuery([]byte("fruit"), []byte("apple"))),
@@ -85,3 +109,21 @@ func TestMarshal(t *testing.T) {
 		})
 	}
 }
+
+func mustCreateNumericRangeQuery(
+	field, min, max []byte,
+	minInclusive, maxInclusive bool,
+) Query {
+	q, err := NewNumericRangeQuery(field, min, max, minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+func mustCreateQuery(q Query, err error) Query {
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
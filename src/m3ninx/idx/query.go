@@ -94,6 +94,70 @@ func MustCreateRegexpQuery(field, regexp []byte) Query {
 	}
 }
 
+// NewCaseInsensitiveTermQuery returns a new query for finding documents which match a
+// term regardless of case.
+func NewCaseInsensitiveTermQuery(field, term []byte) (Query, error) {
+	q, err := query.NewCaseInsensitiveTermQuery(field, term)
+	if err != nil {
+		return Query{}, err
+	}
+	return Query{
+		query: q,
+	}, nil
+}
+
+// NewCaseInsensitiveRegexpQuery returns a new query for finding documents which match a
+// regular expression regardless of case.
+func NewCaseInsensitiveRegexpQuery(field, regexp []byte) (Query, error) {
+	q, err := query.NewCaseInsensitiveRegexpQuery(field, regexp)
+	if err != nil {
+		return Query{}, err
+	}
+	return Query{
+		query: q,
+	}, nil
+}
+
+// NewFuzzyQuery returns a new query for finding documents which have a term within
+// maxEdits insertions, deletions or substitutions of the given term.
+func NewFuzzyQuery(field, term []byte, maxEdits int) (Query, error) {
+	q, err := query.NewFuzzyQuery(field, term, maxEdits)
+	if err != nil {
+		return Query{}, err
+	}
+	return Query{
+		query: q,
+	}, nil
+}
+
+// NewPrefixQuery returns a new query for finding documents which have a term beginning
+// with the given prefix.
+func NewPrefixQuery(field, prefix []byte) Query {
+	return Query{
+		query: query.NewPrefixQuery(field, prefix),
+	}
+}
+
+// NewRangeQuery returns a new query for finding documents which have a term sorting
+// byte-wise between min and max. An empty bound is unbounded.
+func NewRangeQuery(field, min, max []byte, minInclusive, maxInclusive bool) Query {
+	return Query{
+		query: query.NewRangeQuery(field, min, max, minInclusive, maxInclusive),
+	}
+}
+
+// NewNumericRangeQuery returns a new query for finding documents which have a term
+// parsing as a number between min and max. An empty bound is unbounded.
+func NewNumericRangeQuery(field, min, max []byte, minInclusive, maxInclusive bool) (Query, error) {
+	q, err := query.NewNumericRangeQuery(field, min, max, minInclusive, maxInclusive)
+	if err != nil {
+		return Query{}, err
+	}
+	return Query{
+		query: q,
+	}, nil
+}
+
 // NewNegationQuery returns a new query for finding documents which don't match a given query.
 func NewNegationQuery(q Query) Query {
 	return Query{
@@ -113,6 +177,13 @@ func NewConjunctionQuery(queries ...Query) Query {
 	}
 }
 
+// IsConjunctionQuery returns a bool indicating whether the search query is a
+// ConjunctionQuery.
+func IsConjunctionQuery(q search.Query) bool {
+	_, ok := q.(*query.ConjuctionQuery)
+	return ok
+}
+
 // NewDisjunctionQuery returns a new query for finding documents which match at least one
 // of the given queries.
 func NewDisjunctionQuery(queries ...Query) Query {
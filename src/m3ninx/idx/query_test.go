@@ -62,3 +62,39 @@ func TestFieldQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestIsConjunctionQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      Query
+		expectedOK bool
+	}{
+		{
+			name: "conjunction query should be a conjunction",
+			query: NewConjunctionQuery(
+				NewTermQuery([]byte("a"), []byte("b")),
+				NewTermQuery([]byte("c"), []byte("d")),
+			),
+			expectedOK: true,
+		},
+		{
+			name: "disjunction query should not be a conjunction",
+			query: NewDisjunctionQuery(
+				NewTermQuery([]byte("a"), []byte("b")),
+				NewTermQuery([]byte("c"), []byte("d")),
+			),
+			expectedOK: false,
+		},
+		{
+			name:       "term query should not be a conjunction",
+			query:      NewTermQuery([]byte("a"), []byte("b")),
+			expectedOK: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expectedOK, IsConjunctionQuery(test.query.SearchQuery()))
+		})
+	}
+}
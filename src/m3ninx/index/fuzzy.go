@@ -0,0 +1,40 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package index
+
+import (
+	fstregexp "github.com/m3db/m3/src/m3ninx/index/segment/fst/regexp"
+)
+
+// CompileFuzzy compiles the provided term into an automaton matching every
+// term within the maximum number of edits of it, which can be used to query
+// the various segment implementations. Edits are measured in bytes.
+func CompileFuzzy(term []byte, maxEdits int) (CompiledFuzzy, error) {
+	automaton, err := fstregexp.NewLevenshteinAutomaton(term, maxEdits)
+	if err != nil {
+		return CompiledFuzzy{}, err
+	}
+
+	return CompiledFuzzy{
+		Term:      term,
+		MaxEdits:  maxEdits,
+		Automaton: automaton,
+	}, nil
+}
@@ -47,16 +47,33 @@ func DotStarCompiledRegex() CompiledRegex {
 	return dotStarCompiledRegex
 }
 
+// CompileRegexOptions are the options used to compile a regexp.
+type CompileRegexOptions struct {
+	// CaseInsensitive compiles the regexp so that it matches regardless of case,
+	// equivalent to prefixing the regexp with the "(?i)" flag.
+	CaseInsensitive bool
+}
+
 // CompileRegex compiles the provided regexp into an object that can be used to query the various
 // segment implementations.
 func CompileRegex(r []byte) (CompiledRegex, error) {
+	return CompileRegexWithOptions(r, CompileRegexOptions{})
+}
+
+// CompileRegexWithOptions compiles the provided regexp using the given options into an object
+// that can be used to query the various segment implementations.
+func CompileRegexWithOptions(r []byte, opts CompileRegexOptions) (CompiledRegex, error) {
 	// NB(prateek): We currently use two segment implementations: map-backed, and fst-backed (Vellum).
 	// Due to peculiarities in the implementation of Vellum, we have to make certain modifications
 	// to all incoming regular expressions to ensure compatibility between them.
 
 	// first, we parse the regular expression into the equivalent regex
 	reString := string(r)
-	reAst, err := parseRegexp(reString)
+	flags := syntax.Perl
+	if opts.CaseInsensitive {
+		flags |= syntax.FoldCase
+	}
+	reAst, err := parseRegexpWithFlags(reString, flags)
 	if err != nil {
 		return CompiledRegex{}, err
 	}
@@ -86,6 +103,9 @@ func CompileRegex(r []byte) (CompiledRegex, error) {
 		FSTSyntax: vellumRe,
 	}
 
+	// Issue (c): Vellum matches literals exactly, so case-insensitive literals are rewritten
+	// as character classes matching each case when creating Vellum's RE, this is done on a
+	// copy of the parsed syntax.Regexp so FSTSyntax retains the case-insensitive flags.
 	fstRE, start, end, err := fstregexp.ParsedRegexp(vellumRe.String(), vellumRe)
 	if err != nil {
 		return CompiledRegex{}, err
@@ -98,7 +118,11 @@ func CompileRegex(r []byte) (CompiledRegex, error) {
 }
 
 func parseRegexp(re string) (*syntax.Regexp, error) {
-	return syntax.Parse(re, syntax.Perl)
+	return parseRegexpWithFlags(re, syntax.Perl)
+}
+
+func parseRegexpWithFlags(re string, flags syntax.Flags) (*syntax.Regexp, error) {
+	return syntax.Parse(re, flags)
 }
 
 // ensureRegexpAnchored adds '^' and '$' characters to appropriate locations in the parsed syntax.Regexp,
@@ -226,6 +226,40 @@ func TestEnsureRegexpUnachored(t *testing.T) {
 	}
 }
 
+func TestCompileRegexWithOptionsCaseInsensitive(t *testing.T) {
+	compiled, err := CompileRegexWithOptions([]byte("api-.*"), CompileRegexOptions{
+		CaseInsensitive: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, compiled.FST)
+	require.Nil(t, compiled.PrefixBegin)
+	require.Nil(t, compiled.PrefixEnd)
+
+	for _, term := range []string{"api-gateway", "API-gateway", "Api-Gateway"} {
+		require.True(t, compiled.Simple.MatchString(term), term)
+	}
+	for _, term := range []string{"apigateway", "my-api-gateway"} {
+		require.False(t, compiled.Simple.MatchString(term), term)
+	}
+
+	sensitive, err := CompileRegex([]byte("api-.*"))
+	require.NoError(t, err)
+	require.NotEqual(t, sensitive.FSTSyntax.String(), compiled.FSTSyntax.String())
+	require.False(t, sensitive.Simple.MatchString("API-gateway"))
+}
+
+func TestCompileFuzzy(t *testing.T) {
+	compiled, err := CompileFuzzy([]byte("checkout"), 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("checkout"), compiled.Term)
+	require.Equal(t, 1, compiled.MaxEdits)
+	require.True(t, compiled.Automaton.Matches([]byte("chekout")))
+	require.False(t, compiled.Automaton.Matches([]byte("chekuot")))
+
+	_, err = CompileFuzzy([]byte("checkout"), 3)
+	require.Error(t, err)
+}
+
 func TestEnsureRegexpAnchored(t *testing.T) {
 	testCases := []testCase{
 		testCase{
@@ -34,6 +34,7 @@ import (
 type builderFromSegments struct {
 	docs           []doc.Document
 	idSet          *IDsMap
+	filter         segment.DocumentsFilter
 	segments       []segmentMetadata
 	termsIter      *termsIterFromSegments
 	offset         postings.ID
@@ -45,8 +46,9 @@ type segmentMetadata struct {
 	offset  postings.ID
 	// duplicatesAsc is a lookup of document IDs are duplicates
 	// in this segment, that is documents that are already
-	// contained by other segments and hence should not be
-	// returned when looking up documents.
+	// contained by other segments or that were dropped by the
+	// filter and hence should not be returned when looking up
+	// documents.
 	duplicatesAsc []postings.ID
 }
 
@@ -84,6 +86,10 @@ func (b *builderFromSegments) Reset(offset postings.ID) {
 	b.termsIter.clear()
 }
 
+func (b *builderFromSegments) SetFilter(keep segment.DocumentsFilter) {
+	b.filter = keep
+}
+
 func (b *builderFromSegments) AddSegments(segments []segment.Segment) error {
 	// numMaxDocs can sometimes be larger than the actual number of documents
 	// since some are duplicates
@@ -115,6 +121,11 @@ func (b *builderFromSegments) AddSegments(segments []segment.Segment) error {
 				duplicates = append(duplicates, iter.PostingsID())
 				continue
 			}
+			if b.filter != nil && !b.filter.Contains(d) {
+				// Dropped documents are skipped the same as duplicates.
+				duplicates = append(duplicates, iter.PostingsID())
+				continue
+			}
 			b.idSet.SetUnsafe(d.ID, struct{}{}, IDsMapSetUnsafeOptions{
 				NoCopyKey:     true,
 				NoFinalizeKey: true,
@@ -149,9 +149,9 @@ func (i *multiKeyPostingsListIterator) Next() bool {
 			return false
 		}
 
-		if fieldsKeyIter.segment.offset == 0 {
+		if fieldsKeyIter.segment.offset == 0 && len(fieldsKeyIter.segment.duplicatesAsc) == 0 {
 			// No offset, which means is first segment we are combining from
-			// so can just direct union
+			// so can just direct union if no documents were dropped
 			i.currFieldPostingsList.Union(pl)
 			continue
 		}
@@ -127,6 +127,16 @@ func (i *termsIterFromSegments) setField(field []byte) error {
 }
 
 func (i *termsIterFromSegments) Next() bool {
+	for i.next() {
+		// Skip terms whose documents were all dropped by the filter.
+		if !i.currPostingsList.IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *termsIterFromSegments) next() bool {
 	if i.err != nil {
 		return false
 	}
@@ -141,9 +151,9 @@ func (i *termsIterFromSegments) Next() bool {
 		termsKeyIter := iter.(*termsKeyIter)
 		_, list := termsKeyIter.iter.Current()
 
-		if termsKeyIter.segment.offset == 0 {
+		if termsKeyIter.segment.offset == 0 && len(termsKeyIter.segment.duplicatesAsc) == 0 {
 			// No offset, which means is first segment we are combining from
-			// so can just direct union
+			// so can just direct union if no documents were dropped
 			i.currPostingsList.Union(list)
 			continue
 		}
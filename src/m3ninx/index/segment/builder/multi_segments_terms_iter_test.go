@@ -91,6 +91,60 @@ func TestTermsIterFromSegmentsDeduplicates(t *testing.T) {
 	})
 }
 
+func TestTermsIterFromSegmentsFiltersDocuments(t *testing.T) {
+	segments := []segment.Segment{
+		newTestSegmentWithDocs(t, []doc.Document{
+			{
+				ID: []byte("foo"),
+				Fields: []doc.Field{
+					{Name: []byte("fruit"), Value: []byte("apple")},
+				},
+			},
+			{
+				ID: []byte("bar"),
+				Fields: []doc.Field{
+					{Name: []byte("fruit"), Value: []byte("banana")},
+				},
+			},
+		}),
+		newTestSegmentWithDocs(t, []doc.Document{
+			{
+				ID: []byte("baz"),
+				Fields: []doc.Field{
+					{Name: []byte("fruit"), Value: []byte("apple")},
+				},
+			},
+			{
+				ID: []byte("qux"),
+				Fields: []doc.Field{
+					{Name: []byte("fruit"), Value: []byte("banana")},
+				},
+			},
+		}),
+	}
+
+	builder := NewBuilderFromSegments(testOptions)
+	builder.Reset(0)
+	builder.SetFilter(testDocumentsFilter(func(d doc.Document) bool {
+		return string(d.ID) != "foo" && string(d.ID) != "baz"
+	}))
+	require.NoError(t, builder.AddSegments(segments))
+	require.Equal(t, 2, len(builder.Docs()))
+	iter, err := builder.Terms([]byte("fruit"))
+	require.NoError(t, err)
+
+	// The apple term has no documents remaining so must be omitted.
+	assertTermsPostings(t, builder.Docs(), iter, termPostings{
+		"banana": []int{0, 1},
+	})
+}
+
+type testDocumentsFilter func(d doc.Document) bool
+
+func (f testDocumentsFilter) Contains(d doc.Document) bool {
+	return f(d)
+}
+
 func assertTermsPostings(
 	t *testing.T,
 	docs []doc.Document,
@@ -0,0 +1,128 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package regexp
+
+import (
+	"regexp/syntax"
+	"sort"
+	"unicode"
+)
+
+// FoldCase returns an equivalent parse tree in which every case-insensitive
+// literal is rewritten as a sequence of character classes matching each
+// case of its runes, this is required since the FST automaton only matches
+// literals exactly. The provided parse tree is never modified, the returned
+// tree shares any nodes which did not need to be rewritten.
+func FoldCase(parsed *syntax.Regexp) *syntax.Regexp {
+	folded, _ := foldCase(parsed)
+	return folded
+}
+
+func foldCase(parsed *syntax.Regexp) (*syntax.Regexp, bool) {
+	if parsed == nil {
+		return nil, false
+	}
+
+	if parsed.Op == syntax.OpLiteral {
+		if parsed.Flags&syntax.FoldCase == 0 {
+			return parsed, false
+		}
+		return foldCaseLiteral(parsed), true
+	}
+
+	var subs []*syntax.Regexp
+	for idx, sub := range parsed.Sub {
+		folded, changed := foldCase(sub)
+		if !changed {
+			continue
+		}
+		if subs == nil {
+			subs = make([]*syntax.Regexp, len(parsed.Sub))
+			copy(subs, parsed.Sub)
+		}
+		subs[idx] = folded
+	}
+	if subs == nil {
+		return parsed, false
+	}
+
+	copied := *parsed
+	copied.Sub = subs
+	// NB: Sub0 is only used by the parser as backing storage for Sub.
+	copied.Sub0 = [1]*syntax.Regexp{}
+	return &copied, true
+}
+
+func foldCaseLiteral(literal *syntax.Regexp) *syntax.Regexp {
+	flags := literal.Flags &^ syntax.FoldCase
+	subs := make([]*syntax.Regexp, 0, len(literal.Rune))
+	for _, r := range literal.Rune {
+		orbit := foldOrbit(r)
+		if len(orbit) == 1 {
+			// i.e. the rune has no other cases, so extend the preceding literal
+			// if there is one to retain as long a literal prefix as possible.
+			if l := len(subs); l > 0 && subs[l-1].Op == syntax.OpLiteral {
+				subs[l-1].Rune = append(subs[l-1].Rune, r)
+				continue
+			}
+			subs = append(subs, &syntax.Regexp{
+				Op:    syntax.OpLiteral,
+				Flags: flags,
+				Rune:  []rune{r},
+			})
+			continue
+		}
+
+		class := make([]rune, 0, 2*len(orbit))
+		for _, c := range orbit {
+			class = append(class, c, c)
+		}
+		subs = append(subs, &syntax.Regexp{
+			Op:    syntax.OpCharClass,
+			Flags: flags,
+			Rune:  class,
+		})
+	}
+
+	switch len(subs) {
+	case 0:
+		return &syntax.Regexp{Op: syntax.OpEmptyMatch, Flags: flags}
+	case 1:
+		return subs[0]
+	}
+	return &syntax.Regexp{
+		Op:    syntax.OpConcat,
+		Flags: flags,
+		Sub:   subs,
+	}
+}
+
+// foldOrbit returns the sorted runes which are equivalent to the given rune
+// under simple case folding, including the rune itself.
+func foldOrbit(r rune) []rune {
+	orbit := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		orbit = append(orbit, f)
+	}
+	sort.Slice(orbit, func(i, j int) bool {
+		return orbit[i] < orbit[j]
+	})
+	return orbit
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package regexp
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFoldCase(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		matches  []string
+		excludes []string
+	}{
+		{
+			pattern:  "(?i)checkout",
+			matches:  []string{"checkout", "CHECKOUT", "CheckOut"},
+			excludes: []string{"checkou", "checkout1"},
+		},
+		{
+			pattern:  "(?i)api-v2",
+			matches:  []string{"api-v2", "API-V2"},
+			excludes: []string{"api_v2"},
+		},
+		{
+			pattern:  "foo(?i:bar)",
+			matches:  []string{"foobar", "fooBAR"},
+			excludes: []string{"FOObar"},
+		},
+		{
+			pattern:  "(?i)(foo|bar)+baz",
+			matches:  []string{"FOObarBaz", "barbaz"},
+			excludes: []string{"baz"},
+		},
+		{
+			pattern:  "(?i)straße",
+			matches:  []string{"STRAßE", "straße"},
+			excludes: []string{"strasse"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			parsed, err := syntax.Parse(test.pattern, syntax.Perl)
+			require.NoError(t, err)
+			original := parsed.String()
+
+			folded := FoldCase(parsed)
+			require.Equal(t, original, parsed.String(), "parsed regexp was modified")
+			requireNoFoldCaseLiterals(t, folded)
+
+			re, err := regexp.Compile("^(?:" + folded.String() + ")$")
+			require.NoError(t, err)
+			for _, m := range test.matches {
+				require.True(t, re.MatchString(m), "expected %s to match", m)
+			}
+			for _, e := range test.excludes {
+				require.False(t, re.MatchString(e), "expected %s not to match", e)
+			}
+		})
+	}
+}
+
+func TestFoldCaseUnchanged(t *testing.T) {
+	parsed, err := syntax.Parse("foo.*[a-z]+", syntax.Perl)
+	require.NoError(t, err)
+	require.True(t, parsed == FoldCase(parsed))
+}
+
+func TestFoldCaseLiteralPrefix(t *testing.T) {
+	tests := []struct {
+		input, expected string
+	}{
+		{"(?i)hello", ""},
+		{"(?i)123abc", "123"},
+		{"abc(?i:def)", "abc"},
+	}
+
+	for _, test := range tests {
+		parsed, err := syntax.Parse(test.input, syntax.Perl)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, LiteralPrefix(FoldCase(parsed)), test.input)
+	}
+}
+
+func requireNoFoldCaseLiterals(t *testing.T, re *syntax.Regexp) {
+	if re.Op == syntax.OpLiteral {
+		require.Zero(t, re.Flags&syntax.FoldCase, "literal %s is case-insensitive", re)
+	}
+	for _, sub := range re.Sub {
+		requireNoFoldCaseLiterals(t, sub)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package regexp
+
+import "fmt"
+
+const (
+	// MaxLevenshteinDistance is the maximum edit distance supported by a
+	// Levenshtein automaton.
+	MaxLevenshteinDistance = 2
+
+	// MaxLevenshteinTermLength is the maximum length, in bytes, of the term
+	// a Levenshtein automaton can be built for.
+	MaxLevenshteinTermLength = 256
+
+	levenshteinDeadState  = 0
+	levenshteinStartState = 1
+)
+
+var (
+	errLevenshteinDistance = fmt.Errorf(
+		"levenshtein distance must be between 0 and %d", MaxLevenshteinDistance)
+	errLevenshteinTermTooLong = fmt.Errorf(
+		"levenshtein term must be at most %d bytes", MaxLevenshteinTermLength)
+)
+
+// LevenshteinAutomaton is a deterministic automaton which matches every term
+// within a maximum Levenshtein distance of a given term. It implements the
+// vellum.Automaton interface so it can be intersected with an FST to find
+// the matching terms without visiting every term in the FST.
+//
+// NB: edits are measured in bytes rather than runes, so a substitution of a
+// multi-byte rune may count as more than one edit.
+type LevenshteinAutomaton struct {
+	// classes maps each byte to its equivalence class, bytes which do not
+	// appear in the term all share class zero.
+	classes [256]int
+	// transitions holds the next state for each state and byte class.
+	transitions [][]int
+	matches     []bool
+}
+
+// NewLevenshteinAutomaton returns a new automaton matching every term which
+// is within the given Levenshtein distance of the provided term.
+func NewLevenshteinAutomaton(term []byte, distance int) (*LevenshteinAutomaton, error) {
+	if distance < 0 || distance > MaxLevenshteinDistance {
+		return nil, errLevenshteinDistance
+	}
+	if len(term) > MaxLevenshteinTermLength {
+		return nil, errLevenshteinTermTooLong
+	}
+
+	a := &LevenshteinAutomaton{}
+	numClasses := 1
+	for _, b := range term {
+		if a.classes[b] == 0 {
+			a.classes[b] = numClasses
+			numClasses++
+		}
+	}
+
+	// Each state is a row of the Levenshtein distance matrix between the
+	// term and the input consumed so far, with every distance clipped to
+	// distance+1 so there are only a finite number of distinct rows.
+	var (
+		limit = uint8(distance + 1)
+		start = make([]uint8, len(term)+1)
+		dead  = make([]uint8, len(term)+1)
+	)
+	for i := range start {
+		start[i] = minUint8(uint8(i), limit)
+		dead[i] = limit
+	}
+
+	var (
+		rows   = [][]uint8{dead, start}
+		states = map[string]int{
+			string(dead):  levenshteinDeadState,
+			string(start): levenshteinStartState,
+		}
+	)
+	for state := 0; state < len(rows); state++ {
+		row := rows[state]
+		a.matches = append(a.matches, row[len(term)] < limit)
+
+		transitions := make([]int, numClasses)
+		for class := range transitions {
+			next := make([]uint8, len(row))
+			next[0] = minUint8(row[0]+1, limit)
+			for i := 1; i < len(row); i++ {
+				cost := uint8(1)
+				if a.classes[term[i-1]] == class {
+					cost = 0
+				}
+				d := minUint8(row[i-1]+cost, row[i]+1)
+				d = minUint8(d, next[i-1]+1)
+				next[i] = minUint8(d, limit)
+			}
+
+			nextState, ok := states[string(next)]
+			if !ok {
+				nextState = len(rows)
+				states[string(next)] = nextState
+				rows = append(rows, next)
+			}
+			transitions[class] = nextState
+		}
+		a.transitions = append(a.transitions, transitions)
+	}
+
+	return a, nil
+}
+
+// Start returns the start state of the automaton.
+func (a *LevenshteinAutomaton) Start() int {
+	return levenshteinStartState
+}
+
+// IsMatch returns whether the state is a matching state.
+func (a *LevenshteinAutomaton) IsMatch(state int) bool {
+	return a.matches[state]
+}
+
+// CanMatch returns whether any matching state is reachable from the state.
+func (a *LevenshteinAutomaton) CanMatch(state int) bool {
+	return state != levenshteinDeadState
+}
+
+// WillAlwaysMatch returns whether every state reachable from the state is a
+// matching state, which is never the case for a Levenshtein automaton.
+func (a *LevenshteinAutomaton) WillAlwaysMatch(int) bool {
+	return false
+}
+
+// Accept returns the state transitioned to from the state on the given byte.
+func (a *LevenshteinAutomaton) Accept(state int, b byte) int {
+	return a.transitions[state][a.classes[b]]
+}
+
+// Matches returns whether the given term is within the distance of the
+// automaton's term.
+func (a *LevenshteinAutomaton) Matches(term []byte) bool {
+	state := a.Start()
+	for _, b := range term {
+		state = a.Accept(state, b)
+		if !a.CanMatch(state) {
+			return false
+		}
+	}
+	return a.IsMatch(state)
+}
+
+func minUint8(a, b uint8) uint8 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package regexp
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevenshteinAutomatonMatches(t *testing.T) {
+	tests := []struct {
+		term     string
+		distance int
+		input    string
+		expected bool
+	}{
+		{"", 0, "", true},
+		{"", 0, "a", false},
+		{"", 1, "a", true},
+		{"api", 0, "api", true},
+		{"api", 0, "apj", false},
+		{"api", 1, "apj", true},
+		{"api", 1, "ap", true},
+		{"api", 1, "apii", true},
+		{"api", 1, "pai", false},
+		{"api", 2, "pai", true},
+		{"checkout", 1, "chekout", true},
+		{"checkout", 1, "chekcout", false},
+		{"checkout", 2, "chekcout", true},
+		{"checkout", 2, "checkout-service", false},
+	}
+
+	for _, test := range tests {
+		a, err := NewLevenshteinAutomaton([]byte(test.term), test.distance)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, a.Matches([]byte(test.input)),
+			"term: %s, distance: %d, input: %s", test.term, test.distance, test.input)
+	}
+}
+
+func TestLevenshteinAutomatonMatchesDistance(t *testing.T) {
+	var (
+		rng      = rand.New(rand.NewSource(0))
+		alphabet = []byte("abc")
+		randTerm = func() []byte {
+			term := make([]byte, rng.Intn(8))
+			for i := range term {
+				term[i] = alphabet[rng.Intn(len(alphabet))]
+			}
+			return term
+		}
+	)
+
+	for i := 0; i < 1000; i++ {
+		var (
+			term     = randTerm()
+			input    = randTerm()
+			distance = rng.Intn(MaxLevenshteinDistance + 1)
+		)
+		a, err := NewLevenshteinAutomaton(term, distance)
+		require.NoError(t, err)
+
+		expected := levenshteinDistance(term, input) <= distance
+		require.Equal(t, expected, a.Matches(input),
+			"term: %s, distance: %d, input: %s", term, distance, input)
+	}
+}
+
+func TestLevenshteinAutomatonInvalid(t *testing.T) {
+	_, err := NewLevenshteinAutomaton([]byte("foo"), -1)
+	require.Error(t, err)
+
+	_, err = NewLevenshteinAutomaton([]byte("foo"), MaxLevenshteinDistance+1)
+	require.Error(t, err)
+
+	_, err = NewLevenshteinAutomaton(make([]byte, MaxLevenshteinTermLength+1), 1)
+	require.Error(t, err)
+}
+
+func levenshteinDistance(a, b []byte) int {
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			next := prev + cost
+			if row[j]+1 < next {
+				next = row[j] + 1
+			}
+			if row[j-1]+1 < next {
+				next = row[j-1] + 1
+			}
+			prev, row[j] = row[j], next
+		}
+	}
+	return row[len(b)]
+}
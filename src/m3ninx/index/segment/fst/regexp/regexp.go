@@ -39,8 +39,10 @@ func ParseRegexp(pattern string) (a *vregexp.Regexp, prefixBeg, prefixEnd []byte
 }
 
 // ParsedRegexp uses the pre-parsed regexp pattern and creates an equivalent matching automaton, and
-// corresponding keys to bound prefix beginning and end during the FST search.
+// corresponding keys to bound prefix beginning and end during the FST search. Any case-insensitive
+// literals in the parsed regexp are rewritten with FoldCase.
 func ParsedRegexp(pattern string, parsed *syntax.Regexp) (a *vregexp.Regexp, prefixBeg, prefixEnd []byte, err error) {
+	parsed = FoldCase(parsed)
 	re, err := vregexp.NewParsedWithLimit(pattern, parsed, vregexp.DefaultLimit)
 	if err != nil {
 		return nil, nil, nil, err
@@ -68,7 +70,7 @@ func LiteralPrefix(s *syntax.Regexp) string {
 		s = s.Sub[0]
 	}
 
-	if s.Op == syntax.OpLiteral {
+	if s.Op == syntax.OpLiteral && s.Flags&syntax.FoldCase == 0 {
 		return string(s.Rune)
 	}
 
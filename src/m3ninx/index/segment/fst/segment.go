@@ -48,6 +48,7 @@ import (
 var (
 	errReaderClosed            = errors.New("segment is closed")
 	errReaderNilRegexp         = errors.New("nil regexp provided")
+	errReaderNilFuzzy          = errors.New("nil fuzzy automaton provided")
 	errUnsupportedMajorVersion = errors.New("unsupported major version")
 	errDocumentsDataUnset      = errors.New("documents data bytes are not set")
 	errDocumentsIdxUnset       = errors.New("documents index bytes are not set")
@@ -533,6 +534,151 @@ func (r *fsSegment) matchRegexpWithRLock(field []byte, compiled index.CompiledRe
 	return pl, nil
 }
 
+func (r *fsSegment) MatchRange(field []byte, tr index.TermRange) (postings.List, error) {
+	r.RLock()
+	pl, err := r.matchRangeWithRLock(field, tr)
+	r.RUnlock()
+	return pl, err
+}
+
+func (r *fsSegment) matchRangeWithRLock(field []byte, tr index.TermRange) (postings.List, error) {
+	if r.closed {
+		return nil, errReaderClosed
+	}
+
+	termsFST, exists, err := r.retrieveTermsFSTWithRLock(field)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		// i.e. we don't know anything about the field, so can early return an empty postings list
+		return r.opts.PostingsListPool().Get(), nil
+	}
+
+	var (
+		fstCloser = x.NewSafeCloser(termsFST)
+		// NB: the terms are sorted so only those within the lexicographic bounds
+		// of the range need to be visited, each of which is then tested against
+		// the range itself to account for exclusive and numeric bounds.
+		iter, iterErr = termsFST.Iterator(tr.Start, tr.End)
+		iterCloser    = x.NewSafeCloser(iter)
+		pls           []postings.List
+	)
+	defer func() {
+		iterCloser.Close()
+		fstCloser.Close()
+	}()
+
+	for {
+		if iterErr == vellum.ErrIteratorDone {
+			break
+		}
+
+		if iterErr != nil {
+			return nil, iterErr
+		}
+
+		term, postingsOffset := iter.Current()
+		if tr.Contains(term) {
+			nextPl, err := r.retrievePostingsListWithRLock(postingsOffset)
+			if err != nil {
+				return nil, err
+			}
+			pls = append(pls, nextPl)
+		}
+		iterErr = iter.Next()
+	}
+
+	pl, err := roaring.Union(pls)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := iterCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := fstCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+func (r *fsSegment) MatchFuzzy(field []byte, f index.CompiledFuzzy) (postings.List, error) {
+	r.RLock()
+	pl, err := r.matchFuzzyWithRLock(field, f)
+	r.RUnlock()
+	return pl, err
+}
+
+func (r *fsSegment) matchFuzzyWithRLock(field []byte, f index.CompiledFuzzy) (postings.List, error) {
+	if r.closed {
+		return nil, errReaderClosed
+	}
+
+	if f.Automaton == nil {
+		return nil, errReaderNilFuzzy
+	}
+
+	termsFST, exists, err := r.retrieveTermsFSTWithRLock(field)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		// i.e. we don't know anything about the field, so can early return an empty postings list
+		return r.opts.PostingsListPool().Get(), nil
+	}
+
+	var (
+		fstCloser = x.NewSafeCloser(termsFST)
+		// NB: intersecting the automaton with the FST only visits the terms which
+		// are prefixes of a term within the maximum edit distance.
+		iter, iterErr = termsFST.Search(f.Automaton, nil, nil)
+		iterCloser    = x.NewSafeCloser(iter)
+		pls           []postings.List
+	)
+	defer func() {
+		iterCloser.Close()
+		fstCloser.Close()
+	}()
+
+	for {
+		if iterErr == vellum.ErrIteratorDone {
+			break
+		}
+
+		if iterErr != nil {
+			return nil, iterErr
+		}
+
+		_, postingsOffset := iter.Current()
+		nextPl, err := r.retrievePostingsListWithRLock(postingsOffset)
+		if err != nil {
+			return nil, err
+		}
+		pls = append(pls, nextPl)
+		iterErr = iter.Next()
+	}
+
+	pl, err := roaring.Union(pls)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := iterCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := fstCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
 func (r *fsSegment) MatchAll() (postings.MutableList, error) {
 	r.RLock()
 	defer r.RUnlock()
@@ -798,6 +944,28 @@ func (sr *fsSegmentReader) MatchRegexp(field []byte, compiled index.CompiledRege
 	return pl, err
 }
 
+func (sr *fsSegmentReader) MatchRange(field []byte, tr index.TermRange) (postings.List, error) {
+	sr.RLock()
+	if sr.closed {
+		sr.RUnlock()
+		return nil, errReaderClosed
+	}
+	pl, err := sr.fsSegment.MatchRange(field, tr)
+	sr.RUnlock()
+	return pl, err
+}
+
+func (sr *fsSegmentReader) MatchFuzzy(field []byte, f index.CompiledFuzzy) (postings.List, error) {
+	sr.RLock()
+	if sr.closed {
+		sr.RUnlock()
+		return nil, errReaderClosed
+	}
+	pl, err := sr.fsSegment.MatchFuzzy(field, f)
+	sr.RUnlock()
+	return pl, err
+}
+
 func (sr *fsSegmentReader) MatchAll() (postings.MutableList, error) {
 	sr.RLock()
 	if sr.closed {
@@ -23,7 +23,9 @@ package fst
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 
@@ -394,6 +396,168 @@ func TestPostingsListRegexAll(t *testing.T) {
 	}
 }
 
+func TestPostingsListEqualForMatchRange(t *testing.T) {
+	for _, test := range testDocuments {
+		t.Run(test.name, func(t *testing.T) {
+			for _, tc := range newTestCases(t, test.docs) {
+				t.Run(tc.name, func(t *testing.T) {
+					expSeg, obsSeg := tc.expected, tc.observed
+					expReader, err := expSeg.Reader()
+					require.NoError(t, err)
+					obsReader, err := obsSeg.Reader()
+					require.NoError(t, err)
+
+					fieldsIter, err := expSeg.FieldsIterable().Fields()
+					require.NoError(t, err)
+					fields := toSlice(t, fieldsIter)
+					for _, f := range fields {
+						termsIter, err := expSeg.TermsIterable().Terms(f)
+						require.NoError(t, err)
+						terms := toTermPostings(t, termsIter)
+
+						numeric, err := index.NewNumericTermRange([]byte("0"), []byte("100"), true, false)
+						require.NoError(t, err)
+						ranges := []index.TermRange{
+							numeric,
+							index.NewLexicographicTermRange(nil, nil, false, false),
+						}
+						// NB: only use a sample of the terms to keep the test fast
+						// for fields with many terms.
+						sampled := 0
+						for term := range terms {
+							if sampled == 16 {
+								break
+							}
+							sampled++
+							ranges = append(ranges,
+								index.NewPrefixTermRange([]byte(term[:len(term)/2])),
+								index.NewLexicographicTermRange([]byte(term), nil, false, false),
+								index.NewLexicographicTermRange(nil, []byte(term), false, true))
+						}
+
+						for _, r := range ranges {
+							expPl, err := expReader.MatchRange(f, r)
+							require.NoError(t, err)
+							obsPl, err := obsReader.MatchRange(f, r)
+							require.NoError(t, err)
+							require.True(t, expPl.Equal(obsPl),
+								fmt.Sprintf("%s:%v - [%v] != [%v]", string(f), r, pprintIter(expPl), pprintIter(obsPl)))
+						}
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestPostingsListEqualForMatchFuzzy(t *testing.T) {
+	for _, test := range testDocuments {
+		t.Run(test.name, func(t *testing.T) {
+			for _, tc := range newTestCases(t, test.docs) {
+				t.Run(tc.name, func(t *testing.T) {
+					expSeg, obsSeg := tc.expected, tc.observed
+					expReader, err := expSeg.Reader()
+					require.NoError(t, err)
+					obsReader, err := obsSeg.Reader()
+					require.NoError(t, err)
+
+					fieldsIter, err := expSeg.FieldsIterable().Fields()
+					require.NoError(t, err)
+					fields := toSlice(t, fieldsIter)
+					for _, f := range fields {
+						termsIter, err := expSeg.TermsIterable().Terms(f)
+						require.NoError(t, err)
+						terms := toTermPostings(t, termsIter)
+
+						// NB: only use a sample of the terms to keep the test fast
+						// for fields with many terms.
+						sampled := 0
+						for term := range terms {
+							if sampled == 16 {
+								break
+							}
+							sampled++
+							// Drop the first byte of the term so that there are
+							// both matches and non-matches at each distance.
+							fuzzy := term
+							if len(fuzzy) > 0 {
+								fuzzy = fuzzy[1:]
+							}
+							if len(fuzzy) > 32 {
+								fuzzy = fuzzy[:32]
+							}
+							for maxEdits := 0; maxEdits <= 2; maxEdits++ {
+								c, err := index.CompileFuzzy([]byte(fuzzy), maxEdits)
+								require.NoError(t, err)
+
+								expPl, err := expReader.MatchFuzzy(f, c)
+								require.NoError(t, err)
+								obsPl, err := obsReader.MatchFuzzy(f, c)
+								require.NoError(t, err)
+								require.True(t, expPl.Equal(obsPl),
+									fmt.Sprintf("%s:%s~%d - [%v] != [%v]", string(f), fuzzy, maxEdits,
+										pprintIter(expPl), pprintIter(obsPl)))
+							}
+						}
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestPostingsListEqualForCaseInsensitiveMatchRegexp(t *testing.T) {
+	for _, test := range testDocuments {
+		t.Run(test.name, func(t *testing.T) {
+			for _, tc := range newTestCases(t, test.docs) {
+				t.Run(tc.name, func(t *testing.T) {
+					expSeg, obsSeg := tc.expected, tc.observed
+					expReader, err := expSeg.Reader()
+					require.NoError(t, err)
+					obsReader, err := obsSeg.Reader()
+					require.NoError(t, err)
+
+					fieldsIter, err := expSeg.FieldsIterable().Fields()
+					require.NoError(t, err)
+					fields := toSlice(t, fieldsIter)
+					for _, f := range fields {
+						termsIter, err := expSeg.TermsIterable().Terms(f)
+						require.NoError(t, err)
+						terms := toTermPostings(t, termsIter)
+
+						// NB: only use a sample of the terms to keep the test fast
+						// for fields with many terms.
+						sampled := 0
+						for term := range terms {
+							if sampled == 16 {
+								break
+							}
+							sampled++
+							for _, r := range []string{
+								regexp.QuoteMeta(strings.ToUpper(term)),
+								regexp.QuoteMeta(strings.ToLower(term[:len(term)/2])) + ".*",
+							} {
+								c, err := index.CompileRegexWithOptions([]byte(r), index.CompileRegexOptions{
+									CaseInsensitive: true,
+								})
+								require.NoError(t, err)
+
+								expPl, err := expReader.MatchRegexp(f, c)
+								require.NoError(t, err)
+								obsPl, err := obsReader.MatchRegexp(f, c)
+								require.NoError(t, err)
+								require.True(t, expPl.Equal(obsPl),
+									fmt.Sprintf("%s:%s - [%v] != [%v]", string(f), r,
+										pprintIter(expPl), pprintIter(obsPl)))
+							}
+						}
+					}
+				})
+			}
+		})
+	}
+}
+
 func TestSegmentDocs(t *testing.T) {
 	for _, test := range testDocuments {
 		t.Run(test.name, func(t *testing.T) {
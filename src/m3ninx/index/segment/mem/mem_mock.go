@@ -29,6 +29,7 @@ import (
 	"regexp"
 
 	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/postings"
 
 	"github.com/golang/mock/gomock"
@@ -72,6 +73,36 @@ func (mr *MockReadableSegmentMockRecorder) getDoc(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getDoc", reflect.TypeOf((*MockReadableSegment)(nil).getDoc), arg0)
 }
 
+// matchFuzzy mocks base method
+func (m *MockReadableSegment) matchFuzzy(arg0 []byte, arg1 index.CompiledFuzzy) (postings.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "matchFuzzy", arg0, arg1)
+	ret0, _ := ret[0].(postings.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// matchFuzzy indicates an expected call of matchFuzzy
+func (mr *MockReadableSegmentMockRecorder) matchFuzzy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "matchFuzzy", reflect.TypeOf((*MockReadableSegment)(nil).matchFuzzy), arg0, arg1)
+}
+
+// matchRange mocks base method
+func (m *MockReadableSegment) matchRange(arg0 []byte, arg1 index.TermRange) (postings.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "matchRange", arg0, arg1)
+	ret0, _ := ret[0].(postings.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// matchRange indicates an expected call of matchRange
+func (mr *MockReadableSegmentMockRecorder) matchRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "matchRange", reflect.TypeOf((*MockReadableSegment)(nil).matchRange), arg0, arg1)
+}
+
 // matchRegexp mocks base method
 func (m *MockReadableSegment) matchRegexp(arg0 []byte, arg1 *regexp.Regexp) (postings.List, error) {
 	m.ctrl.T.Helper()
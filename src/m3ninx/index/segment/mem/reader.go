@@ -32,6 +32,7 @@ import (
 var (
 	errSegmentReaderClosed = errors.New("segment reader is closed")
 	errReaderNilRegex      = errors.New("nil regex received")
+	errReaderNilFuzzy      = errors.New("nil fuzzy automaton received")
 )
 
 type reader struct {
@@ -96,6 +97,34 @@ func (r *reader) MatchRegexp(field []byte, compiled index.CompiledRegex) (postin
 	return r.segment.matchRegexp(field, compileRE)
 }
 
+func (r *reader) MatchRange(field []byte, tr index.TermRange) (postings.List, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return nil, errSegmentReaderClosed
+	}
+
+	// A reader can return IDs in the posting list which are greater than its maximum
+	// permitted ID, see MatchRegexp.
+	return r.segment.matchRange(field, tr)
+}
+
+func (r *reader) MatchFuzzy(field []byte, f index.CompiledFuzzy) (postings.List, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return nil, errSegmentReaderClosed
+	}
+
+	if f.Automaton == nil {
+		return nil, errReaderNilFuzzy
+	}
+
+	// A reader can return IDs in the posting list which are greater than its maximum
+	// permitted ID, see MatchRegexp.
+	return r.segment.matchFuzzy(field, f)
+}
+
 func (r *reader) MatchAll() (postings.MutableList, error) {
 	r.RLock()
 	defer r.RUnlock()
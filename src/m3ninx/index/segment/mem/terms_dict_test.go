@@ -27,6 +27,7 @@ import (
 	"testing"
 
 	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/postings"
 
 	"github.com/leanovate/gopter"
@@ -274,6 +275,83 @@ func (t *termsDictionaryTestSuite) TestMatchRegex() {
 	props.TestingRun(t.T())
 }
 
+func (t *termsDictionaryTestSuite) TestMatchRange() {
+	props := getProperties()
+	props.Property(
+		"The dictionary should support prefix and range queries",
+		prop.ForAll(
+			func(f doc.Field, id postings.ID) (bool, error) {
+				t.termsDict.Insert(f, id)
+
+				for _, r := range []index.TermRange{
+					index.NewPrefixTermRange(f.Value[:len(f.Value)/2]),
+					index.NewLexicographicTermRange(f.Value, f.Value, true, true),
+				} {
+					pl := t.termsDict.MatchRange(f.Name, r)
+					if pl == nil {
+						return false, fmt.Errorf("postings list of documents matching query should not be nil")
+					}
+					if !pl.Contains(id) {
+						return false, fmt.Errorf("id of new document '%v' is not in list of matching documents", id)
+					}
+				}
+
+				pl := t.termsDict.MatchRange(f.Name,
+					index.NewLexicographicTermRange(f.Value, f.Value, false, true))
+				if pl == nil {
+					return false, fmt.Errorf("postings list returned should not be nil")
+				}
+				if pl.Contains(id) {
+					return false, fmt.Errorf("id of new document '%v' is in list of documents excluded by range", id)
+				}
+
+				return true, nil
+			},
+			genField(),
+			genDocID(),
+		))
+
+	props.TestingRun(t.T())
+}
+
+func (t *termsDictionaryTestSuite) TestMatchFuzzy() {
+	props := getProperties()
+	props.Property(
+		"The dictionary should support fuzzy queries",
+		prop.ForAll(
+			func(f doc.Field, id postings.ID) (bool, error) {
+				t.termsDict.Insert(f, id)
+
+				// NB: appending a byte to the term is a single edit away from it.
+				term := append([]byte("x"), f.Value...)
+				if len(term) > 16 {
+					term = term[:16]
+				}
+				matches := len(term) == len(f.Value)+1
+
+				for maxEdits := 0; maxEdits <= 2; maxEdits++ {
+					c, err := index.CompileFuzzy(term, maxEdits)
+					if err != nil {
+						return false, err
+					}
+					pl := t.termsDict.MatchFuzzy(f.Name, c)
+					if pl == nil {
+						return false, fmt.Errorf("postings list of documents matching query should not be nil")
+					}
+					if matches && maxEdits > 0 && !pl.Contains(id) {
+						return false, fmt.Errorf("id of new document '%v' is not in list of matching documents", id)
+					}
+				}
+
+				return true, nil
+			},
+			genField(),
+			genDocID(),
+		))
+
+	props.TestingRun(t.T())
+}
+
 func (t *termsDictionaryTestSuite) TestMatchRegexNoResults() {
 	props := getProperties()
 	props.Property(
@@ -1522,6 +1522,18 @@ func (mr *MockSegmentsBuilderMockRecorder) AllDocs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllDocs", reflect.TypeOf((*MockSegmentsBuilder)(nil).AllDocs))
 }
 
+// SetFilter mocks base method
+func (m *MockSegmentsBuilder) SetFilter(keep DocumentsFilter) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFilter", keep)
+}
+
+// SetFilter indicates an expected call of SetFilter
+func (mr *MockSegmentsBuilderMockRecorder) SetFilter(keep interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFilter", reflect.TypeOf((*MockSegmentsBuilder)(nil).SetFilter), keep)
+}
+
 // AddSegments mocks base method
 func (m *MockSegmentsBuilder) AddSegments(segments []Segment) error {
 	m.ctrl.T.Helper()
@@ -1535,3 +1547,40 @@ func (mr *MockSegmentsBuilderMockRecorder) AddSegments(segments interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSegments", reflect.TypeOf((*MockSegmentsBuilder)(nil).AddSegments), segments)
 }
+
+// MockDocumentsFilter is a mock of DocumentsFilter interface
+type MockDocumentsFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDocumentsFilterMockRecorder
+}
+
+// MockDocumentsFilterMockRecorder is the mock recorder for MockDocumentsFilter
+type MockDocumentsFilterMockRecorder struct {
+	mock *MockDocumentsFilter
+}
+
+// NewMockDocumentsFilter creates a new mock instance
+func NewMockDocumentsFilter(ctrl *gomock.Controller) *MockDocumentsFilter {
+	mock := &MockDocumentsFilter{ctrl: ctrl}
+	mock.recorder = &MockDocumentsFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDocumentsFilter) EXPECT() *MockDocumentsFilterMockRecorder {
+	return m.recorder
+}
+
+// Contains mocks base method
+func (m *MockDocumentsFilter) Contains(d doc.Document) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Contains", d)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Contains indicates an expected call of Contains
+func (mr *MockDocumentsFilterMockRecorder) Contains(d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contains", reflect.TypeOf((*MockDocumentsFilter)(nil).Contains), d)
+}
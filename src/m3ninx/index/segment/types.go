@@ -205,6 +205,17 @@ type CloseableDocumentsBuilder interface {
 type SegmentsBuilder interface {
 	Builder
 
+	// SetFilter sets a filter for the documents to keep when segments are
+	// added, documents not contained by the filter are dropped. A nil filter
+	// keeps all documents.
+	SetFilter(keep DocumentsFilter)
+
 	// AddSegments adds segments to build from.
 	AddSegments(segments []Segment) error
 }
+
+// DocumentsFilter is a filter for documents.
+type DocumentsFilter interface {
+	// Contains returns whether the document is contained by the filter.
+	Contains(d doc.Document) bool
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package index
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// TermRange is a range of terms to match, compiled from either a prefix or
+// from lexicographic or numeric bounds so that it can be used to query the
+// various segment implementations.
+type TermRange struct {
+	// Start is the inclusive lower bound of the terms that can possibly match
+	// the range when ordered lexicographically, nil if unbounded.
+	Start []byte
+	// End is the exclusive upper bound of the terms that can possibly match
+	// the range when ordered lexicographically, nil if unbounded.
+	End []byte
+
+	numeric      bool
+	minInclusive bool
+	maxInclusive bool
+	min          []byte
+	max          []byte
+	minValue     float64
+	maxValue     float64
+}
+
+// NewPrefixTermRange returns a range matching all terms which begin with
+// the given prefix.
+func NewPrefixTermRange(prefix []byte) TermRange {
+	return NewLexicographicTermRange(prefix, prefixSuccessor(prefix), true, false)
+}
+
+// NewLexicographicTermRange returns a range matching all terms which sort
+// between the given bounds byte-wise. An empty bound is unbounded.
+func NewLexicographicTermRange(
+	min, max []byte,
+	minInclusive, maxInclusive bool,
+) TermRange {
+	r := TermRange{
+		minInclusive: minInclusive,
+		maxInclusive: maxInclusive,
+	}
+	if len(min) > 0 {
+		r.min = min
+		r.Start = min
+	}
+	if len(max) > 0 {
+		r.max = max
+		r.End = max
+		if maxInclusive {
+			// The smallest term which sorts after max is max followed by a
+			// zero byte.
+			r.End = append(append(make([]byte, 0, len(max)+1), max...), 0)
+		}
+	}
+	return r
+}
+
+// NewNumericTermRange returns a range matching all terms which parse as
+// numbers between the given bounds. An empty bound is unbounded, terms which
+// do not parse as numbers never match.
+func NewNumericTermRange(
+	min, max []byte,
+	minInclusive, maxInclusive bool,
+) (TermRange, error) {
+	r := TermRange{
+		numeric:      true,
+		minInclusive: minInclusive,
+		maxInclusive: maxInclusive,
+	}
+	if len(min) > 0 {
+		v, err := strconv.ParseFloat(string(min), 64)
+		if err != nil {
+			return TermRange{}, fmt.Errorf("invalid numeric range min %s: %v", min, err)
+		}
+		r.min = min
+		r.minValue = v
+	}
+	if len(max) > 0 {
+		v, err := strconv.ParseFloat(string(max), 64)
+		if err != nil {
+			return TermRange{}, fmt.Errorf("invalid numeric range max %s: %v", max, err)
+		}
+		r.max = max
+		r.maxValue = v
+	}
+	// NB: numbers do not sort lexicographically so Start and End are left
+	// unbounded and every term of the field needs to be tested.
+	return r, nil
+}
+
+// Contains returns whether the term is within the range.
+func (r TermRange) Contains(term []byte) bool {
+	if r.numeric {
+		return r.containsNumeric(term)
+	}
+	if r.min != nil {
+		cmp := bytes.Compare(term, r.min)
+		if cmp < 0 || (cmp == 0 && !r.minInclusive) {
+			return false
+		}
+	}
+	if r.max != nil {
+		cmp := bytes.Compare(term, r.max)
+		if cmp > 0 || (cmp == 0 && !r.maxInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r TermRange) containsNumeric(term []byte) bool {
+	v, err := strconv.ParseFloat(string(term), 64)
+	if err != nil {
+		return false
+	}
+	if r.min != nil {
+		if v < r.minValue || (v == r.minValue && !r.minInclusive) {
+			return false
+		}
+	}
+	if r.max != nil {
+		if v > r.maxValue || (v == r.maxValue && !r.maxInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// prefixSuccessor returns the smallest term which sorts after every term with
+// the given prefix, or nil if there is no such term.
+func prefixSuccessor(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] != 0xff {
+			succ := append(make([]byte, 0, i+1), prefix[:i+1]...)
+			succ[i]++
+			return succ
+		}
+	}
+	return nil
+}
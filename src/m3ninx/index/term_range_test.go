@@ -0,0 +1,180 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixTermRange(t *testing.T) {
+	r := NewPrefixTermRange([]byte("app"))
+	require.Equal(t, []byte("app"), r.Start)
+	require.Equal(t, []byte("apq"), r.End)
+
+	for _, term := range []string{"app", "apple", "app\xff"} {
+		require.True(t, r.Contains([]byte(term)), term)
+	}
+	for _, term := range []string{"ap", "apq", "banana", ""} {
+		require.False(t, r.Contains([]byte(term)), term)
+	}
+}
+
+func TestPrefixTermRangeUnboundedEnd(t *testing.T) {
+	r := NewPrefixTermRange([]byte("a\xff\xff"))
+	require.Equal(t, []byte("b"), r.End)
+
+	r = NewPrefixTermRange([]byte("\xff"))
+	require.Nil(t, r.End)
+	require.True(t, r.Contains([]byte("\xff\xff")))
+	require.False(t, r.Contains([]byte("\xfe")))
+
+	r = NewPrefixTermRange(nil)
+	require.Nil(t, r.Start)
+	require.Nil(t, r.End)
+	require.True(t, r.Contains([]byte("anything")))
+}
+
+func TestLexicographicTermRange(t *testing.T) {
+	tests := []struct {
+		name                       string
+		min, max                   string
+		minInclusive, maxInclusive bool
+		start, end                 []byte
+		matches, misses            []string
+	}{
+		{
+			name:         "inclusive bounds",
+			min:          "b",
+			max:          "d",
+			minInclusive: true,
+			maxInclusive: true,
+			start:        []byte("b"),
+			end:          []byte("d\x00"),
+			matches:      []string{"b", "bz", "c", "d"},
+			misses:       []string{"a", "az", "d\x00", "da", "e"},
+		},
+		{
+			name:    "exclusive bounds",
+			min:     "b",
+			max:     "d",
+			start:   []byte("b"),
+			end:     []byte("d"),
+			matches: []string{"b\x00", "bz", "c", "cz"},
+			misses:  []string{"a", "b", "d", "da"},
+		},
+		{
+			name:         "unbounded min",
+			max:          "d",
+			maxInclusive: true,
+			end:          []byte("d\x00"),
+			matches:      []string{"", "a", "d"},
+			misses:       []string{"da"},
+		},
+		{
+			name:         "unbounded max",
+			min:          "b",
+			minInclusive: true,
+			start:        []byte("b"),
+			matches:      []string{"b", "zzz"},
+			misses:       []string{"", "a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := NewLexicographicTermRange([]byte(test.min), []byte(test.max),
+				test.minInclusive, test.maxInclusive)
+			require.Equal(t, test.start, r.Start)
+			require.Equal(t, test.end, r.End)
+			for _, term := range test.matches {
+				require.True(t, r.Contains([]byte(term)), term)
+			}
+			for _, term := range test.misses {
+				require.False(t, r.Contains([]byte(term)), term)
+			}
+		})
+	}
+}
+
+func TestNumericTermRange(t *testing.T) {
+	tests := []struct {
+		name                       string
+		min, max                   string
+		minInclusive, maxInclusive bool
+		matches, misses            []string
+	}{
+		{
+			name:         "inclusive bounds",
+			min:          "2",
+			max:          "10",
+			minInclusive: true,
+			maxInclusive: true,
+			matches:      []string{"2", "2.0", "9", "10", "1e1"},
+			misses:       []string{"1", "1.99", "10.01", "100", "abc", ""},
+		},
+		{
+			name:    "exclusive bounds",
+			min:     "2",
+			max:     "10",
+			matches: []string{"2.5", "9.99"},
+			misses:  []string{"2", "10"},
+		},
+		{
+			name:         "unbounded min",
+			max:          "0",
+			maxInclusive: true,
+			matches:      []string{"-100", "0"},
+			misses:       []string{"0.5", "x"},
+		},
+		{
+			name:         "unbounded max",
+			min:          "-1.5",
+			minInclusive: true,
+			matches:      []string{"-1.5", "1000000"},
+			misses:       []string{"-2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := NewNumericTermRange([]byte(test.min), []byte(test.max),
+				test.minInclusive, test.maxInclusive)
+			require.NoError(t, err)
+			require.Nil(t, r.Start)
+			require.Nil(t, r.End)
+			for _, term := range test.matches {
+				require.True(t, r.Contains([]byte(term)), term)
+			}
+			for _, term := range test.misses {
+				require.False(t, r.Contains([]byte(term)), term)
+			}
+		})
+	}
+}
+
+func TestNumericTermRangeInvalidBounds(t *testing.T) {
+	_, err := NewNumericTermRange([]byte("one"), nil, true, true)
+	require.Error(t, err)
+
+	_, err = NewNumericTermRange(nil, []byte("ten"), true, true)
+	require.Error(t, err)
+}
@@ -26,6 +26,7 @@ import (
 	"regexp/syntax"
 
 	"github.com/m3db/m3/src/m3ninx/doc"
+	fstregexp "github.com/m3db/m3/src/m3ninx/index/segment/fst/regexp"
 	"github.com/m3db/m3/src/m3ninx/postings"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	vregex "github.com/m3db/vellum/regexp"
@@ -72,6 +73,14 @@ type Readable interface {
 	// regular expression.
 	MatchRegexp(field []byte, c CompiledRegex) (postings.List, error)
 
+	// MatchRange returns a postings list over all documents which have a term
+	// within the given range.
+	MatchRange(field []byte, r TermRange) (postings.List, error)
+
+	// MatchFuzzy returns a postings list over all documents which have a term
+	// within the maximum edit distance of the given fuzzy term.
+	MatchFuzzy(field []byte, f CompiledFuzzy) (postings.List, error)
+
 	// MatchAll returns a postings list for all documents known to the Reader.
 	MatchAll() (postings.MutableList, error)
 
@@ -93,6 +102,14 @@ type CompiledRegex struct {
 	PrefixEnd   []byte
 }
 
+// CompiledFuzzy is a fuzzy term compiled into an automaton to allow
+// amortisation of automaton construction costs.
+type CompiledFuzzy struct {
+	Term      []byte
+	MaxEdits  int
+	Automaton *fstregexp.LevenshteinAutomaton
+}
+
 // DocRetriever returns the document associated with a postings ID. It returns
 // ErrDocNotFound if there is no document corresponding to the given postings ID.
 type DocRetriever interface {
@@ -27,13 +27,19 @@ import (
 	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/search"
+	"github.com/m3db/m3/src/x/clock"
 )
 
 var (
 	errExecutorClosed = errors.New("executor is closed")
 )
 
-type newIteratorFn func(s search.Searcher, rs index.Readers) (doc.Iterator, error)
+type newIteratorFn func(
+	q search.Query,
+	s search.Searcher,
+	rs index.Readers,
+	ex *explainer,
+) (doc.Iterator, error)
 
 type executor struct {
 	sync.RWMutex
@@ -64,7 +70,36 @@ func (e *executor) Execute(q search.Query) (doc.Iterator, error) {
 		return nil, err
 	}
 
-	iter, err := e.newIteratorFn(s, e.readers)
+	iter, err := e.newIteratorFn(q, s, e.readers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter, nil
+}
+
+func (e *executor) ExecuteExplain(
+	q search.Query,
+	explanation *search.Explanation,
+	nowFn clock.NowFn,
+) (doc.Iterator, error) {
+	e.RLock()
+	defer e.RUnlock()
+	if e.closed {
+		return nil, errExecutorClosed
+	}
+
+	s, err := newExplainSearcher(q, nowFn)
+	if err != nil {
+		return nil, err
+	}
+
+	ex := &explainer{
+		explanation: explanation,
+		searcher:    s,
+		nowFn:       nowFn,
+	}
+	iter, err := e.newIteratorFn(q, s, e.readers, ex)
 	if err != nil {
 		return nil, err
 	}
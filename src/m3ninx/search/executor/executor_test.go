@@ -22,6 +22,7 @@ package executor
 
 import (
 	"testing"
+	"time"
 
 	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/index"
@@ -58,7 +59,12 @@ func TestExecutor(t *testing.T) {
 	e := NewExecutor(rs).(*executor)
 
 	// Override newIteratorFn to return test iterator.
-	e.newIteratorFn = func(_ search.Searcher, _ index.Readers) (doc.Iterator, error) {
+	e.newIteratorFn = func(
+		_ search.Query,
+		_ search.Searcher,
+		_ index.Readers,
+		_ *explainer,
+	) (doc.Iterator, error) {
 		return newTestIterator(), nil
 	}
 
@@ -71,3 +77,43 @@ func TestExecutor(t *testing.T) {
 	err = e.Close()
 	require.NoError(t, err)
 }
+
+func TestExecutorExecuteExplain(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var (
+		q  = search.NewMockQuery(mockCtrl)
+		r  = index.NewMockReader(mockCtrl)
+		rs = index.Readers{r}
+	)
+	gomock.InOrder(
+		q.EXPECT().Searcher().Return(nil, nil),
+
+		r.EXPECT().Close().Return(nil),
+	)
+
+	e := NewExecutor(rs).(*executor)
+
+	// Override newIteratorFn to ensure the explanation is passed through.
+	explanation := &search.Explanation{}
+	e.newIteratorFn = func(
+		_ search.Query,
+		s search.Searcher,
+		_ index.Readers,
+		ex *explainer,
+	) (doc.Iterator, error) {
+		require.True(t, explanation == ex.explanation)
+		require.True(t, s == ex.searcher)
+		return newTestIterator(), nil
+	}
+
+	it, err := e.ExecuteExplain(q, explanation, time.Now)
+	require.NoError(t, err)
+
+	err = it.Close()
+	require.NoError(t, err)
+
+	err = e.Close()
+	require.NoError(t, err)
+}
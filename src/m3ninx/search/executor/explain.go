@@ -0,0 +1,121 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/m3ninx/index"
+	"github.com/m3db/m3/src/m3ninx/postings"
+	"github.com/m3db/m3/src/m3ninx/search"
+	"github.com/m3db/m3/src/x/clock"
+)
+
+// explainer records how a query is evaluated against each reader the query
+// is executed over.
+type explainer struct {
+	explanation *search.Explanation
+	searcher    *explainSearcher
+	nowFn       clock.NowFn
+}
+
+// explainSearcher is the searcher of an explained query, it records the
+// size of the postings list matched by each search of the query and the time
+// taken to match it. The searchers of the queries a composite query is
+// composed of are explain searchers themselves so that the sub-queries are
+// explained as they are evaluated.
+type explainSearcher struct {
+	query      search.Query
+	searcher   search.Searcher
+	subQueries []*explainSearcher
+	nowFn      clock.NowFn
+
+	searched     bool
+	postingsSize int
+	duration     time.Duration
+}
+
+func newExplainSearcher(
+	q search.Query,
+	nowFn clock.NowFn,
+) (*explainSearcher, error) {
+	s := &explainSearcher{
+		query: q,
+		nowFn: nowFn,
+	}
+
+	composite, ok := q.(search.CompositeQuery)
+	if !ok {
+		searcher, err := q.Searcher()
+		if err != nil {
+			return nil, err
+		}
+		s.searcher = searcher
+		return s, nil
+	}
+
+	searcher, err := composite.SearcherWith(func(sub search.Query) (search.Searcher, error) {
+		subSearcher, err := newExplainSearcher(sub, nowFn)
+		if err != nil {
+			return nil, err
+		}
+		s.subQueries = append(s.subQueries, subSearcher)
+		return subSearcher, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.searcher = searcher
+	return s, nil
+}
+
+func (s *explainSearcher) Search(r index.Reader) (postings.List, error) {
+	start := s.nowFn()
+	pl, err := s.searcher.Search(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.searched = true
+	s.postingsSize = pl.Len()
+	s.duration = s.nowFn().Sub(start)
+	return pl, nil
+}
+
+// explainSubQueries returns the explanations of the sub-queries searched
+// since it was last called. Sub-queries that were not searched, such as
+// when the results of the query were cached, are omitted.
+func (s *explainSearcher) explainSubQueries() []search.QueryExplanation {
+	var explanations []search.QueryExplanation
+	for _, sub := range s.subQueries {
+		if !sub.searched {
+			continue
+		}
+		sub.searched = false
+		explanations = append(explanations, search.QueryExplanation{
+			Query:        sub.query.String(),
+			PostingsSize: sub.postingsSize,
+			Duration:     sub.duration,
+			SubQueries:   sub.explainSubQueries(),
+		})
+	}
+	return explanations
+}
@@ -23,13 +23,18 @@ package executor
 import (
 	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/index"
+	"github.com/m3db/m3/src/m3ninx/postings"
 	"github.com/m3db/m3/src/m3ninx/search"
 )
 
 type iterator struct {
+	query    search.Query
 	searcher search.Searcher
 	readers  index.Readers
 
+	// explainer is only set when the query is being explained.
+	explainer *explainer
+
 	idx      int
 	currDoc  doc.Document
 	currIter doc.Iterator
@@ -38,11 +43,18 @@ type iterator struct {
 	closed bool
 }
 
-func newIterator(s search.Searcher, rs index.Readers) (doc.Iterator, error) {
+func newIterator(
+	q search.Query,
+	s search.Searcher,
+	rs index.Readers,
+	ex *explainer,
+) (doc.Iterator, error) {
 	it := &iterator{
-		searcher: s,
-		readers:  rs,
-		idx:      -1,
+		query:     q,
+		searcher:  s,
+		readers:   rs,
+		explainer: ex,
+		idx:       -1,
 	}
 
 	currIter, _, err := it.nextIter()
@@ -109,15 +121,24 @@ func (it *iterator) Close() error {
 
 // nextIter gets the next document iterator by getting the next postings list from
 // the it's searcher and then getting the documents for that postings list from the
-// corresponding reader associated with that postings list.
+// corresponding reader associated with that postings list. Readers which may cache
+// the results of whole queries are searched through the cache.
 func (it *iterator) nextIter() (doc.Iterator, bool, error) {
 	it.idx++
 	if it.idx >= len(it.readers) {
 		return nil, false, nil
 	}
 
-	reader := it.readers[it.idx]
-	pl, err := it.searcher.Search(reader)
+	var (
+		reader = it.readers[it.idx]
+		pl     postings.List
+		err    error
+	)
+	if it.explainer != nil {
+		pl, err = it.searchExplain(reader)
+	} else {
+		pl, err = searchReader(reader, it.query, it.searcher)
+	}
 	if err != nil {
 		return nil, false, err
 	}
@@ -129,3 +150,54 @@ func (it *iterator) nextIter() (doc.Iterator, bool, error) {
 
 	return iter, true, nil
 }
+
+// searchExplain searches the reader for the query and records the postings
+// list size, duration and cache usage of the search in the explanation, along
+// with the postings list size and duration of each sub-query of composite
+// queries as they were evaluated by the search.
+func (it *iterator) searchExplain(reader index.Reader) (postings.List, error) {
+	var (
+		statsReader, hasStats    = reader.(search.CacheStatsReader)
+		hitsBefore, missesBefore int
+		nowFn                    = it.explainer.nowFn
+	)
+	if hasStats {
+		hitsBefore, missesBefore = statsReader.CacheStats()
+	}
+
+	start := nowFn()
+	pl, err := searchReader(reader, it.query, it.searcher)
+	if err != nil {
+		return nil, err
+	}
+
+	segment := search.SegmentExplanation{
+		Query: search.QueryExplanation{
+			Query:        it.query.String(),
+			PostingsSize: pl.Len(),
+			Duration:     nowFn().Sub(start),
+			SubQueries:   it.explainer.searcher.explainSubQueries(),
+		},
+	}
+	if hasStats {
+		hitsAfter, missesAfter := statsReader.CacheStats()
+		segment.CacheHits = hitsAfter - hitsBefore
+		segment.CacheMisses = missesAfter - missesBefore
+	}
+
+	it.explainer.explanation.Segments = append(it.explainer.explanation.Segments, segment)
+	return pl, nil
+}
+
+// searchReader searches the reader for the query, readers which may cache the
+// results of whole queries are searched through the cache.
+func searchReader(
+	reader index.Reader,
+	q search.Query,
+	s search.Searcher,
+) (postings.List, error) {
+	if readThrough, ok := reader.(search.ReadThroughSegmentSearcher); ok {
+		return readThrough.Search(q, s)
+	}
+	return s.Search(reader)
+}
@@ -22,9 +22,11 @@ package executor
 
 import (
 	"testing"
+	"time"
 
 	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/index"
+	"github.com/m3db/m3/src/m3ninx/postings"
 	"github.com/m3db/m3/src/m3ninx/postings/roaring"
 	"github.com/m3db/m3/src/m3ninx/search"
 
@@ -105,7 +107,7 @@ func TestIterator(t *testing.T) {
 	readers := index.Readers{firstReader, secondReader}
 
 	// Construct iterator and run tests.
-	iter, err := newIterator(searcher, readers)
+	iter, err := newIterator(search.NewMockQuery(mockCtrl), searcher, readers, nil)
 	require.NoError(t, err)
 
 	require.True(t, iter.Next())
@@ -119,3 +121,172 @@ func TestIterator(t *testing.T) {
 	require.NoError(t, iter.Err())
 	require.NoError(t, iter.Close())
 }
+
+type testReadThroughReader struct {
+	*index.MockReader
+	*search.MockReadThroughSegmentSearcher
+}
+
+func TestIteratorReadThroughSegmentSearcher(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pl := roaring.NewPostingsList()
+	require.NoError(t, pl.Insert(42))
+
+	d := doc.Document{
+		Fields: []doc.Field{
+			doc.Field{
+				Name:  []byte("apple"),
+				Value: []byte("red"),
+			},
+		},
+	}
+
+	docIter := doc.NewMockIterator(mockCtrl)
+	gomock.InOrder(
+		docIter.EXPECT().Next().Return(true),
+		docIter.EXPECT().Current().Return(d),
+		docIter.EXPECT().Next().Return(false),
+		docIter.EXPECT().Err().Return(nil),
+		docIter.EXPECT().Close().Return(nil),
+	)
+
+	var (
+		query    = search.NewMockQuery(mockCtrl)
+		searcher = search.NewMockSearcher(mockCtrl)
+		reader   = testReadThroughReader{
+			MockReader:                     index.NewMockReader(mockCtrl),
+			MockReadThroughSegmentSearcher: search.NewMockReadThroughSegmentSearcher(mockCtrl),
+		}
+	)
+
+	// The searcher should not be used directly since the reader searches
+	// through its cache.
+	gomock.InOrder(
+		reader.MockReadThroughSegmentSearcher.EXPECT().Search(query, searcher).Return(pl, nil),
+		reader.MockReader.EXPECT().Docs(pl).Return(docIter, nil),
+	)
+
+	iter, err := newIterator(query, searcher, index.Readers{reader}, nil)
+	require.NoError(t, err)
+
+	require.True(t, iter.Next())
+	require.Equal(t, d, iter.Current())
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+	require.NoError(t, iter.Close())
+}
+
+type testCompositeQuery struct {
+	*search.MockQuery
+	*search.MockCompositeQuery
+}
+
+type testExplainReader struct {
+	*index.MockReader
+	*search.MockReadThroughSegmentSearcher
+	*search.MockCacheStatsReader
+}
+
+func TestIteratorExplain(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pl := roaring.NewPostingsList()
+	require.NoError(t, pl.Insert(42))
+	subPL := roaring.NewPostingsList()
+	require.NoError(t, subPL.Insert(42))
+	require.NoError(t, subPL.Insert(47))
+
+	docIter := doc.NewMockIterator(mockCtrl)
+	gomock.InOrder(
+		docIter.EXPECT().Next().Return(false),
+		docIter.EXPECT().Err().Return(nil),
+		docIter.EXPECT().Close().Return(nil),
+	)
+
+	var (
+		query = testCompositeQuery{
+			MockQuery:          search.NewMockQuery(mockCtrl),
+			MockCompositeQuery: search.NewMockCompositeQuery(mockCtrl),
+		}
+		subQuery    = search.NewMockQuery(mockCtrl)
+		subSearcher = search.NewMockSearcher(mockCtrl)
+		searcher    = search.NewMockSearcher(mockCtrl)
+		reader      = testExplainReader{
+			MockReader:                     index.NewMockReader(mockCtrl),
+			MockReadThroughSegmentSearcher: search.NewMockReadThroughSegmentSearcher(mockCtrl),
+			MockCacheStatsReader:           search.NewMockCacheStatsReader(mockCtrl),
+		}
+		explainSubSearcher search.Searcher
+		// Every reading of the clock advances it by a second.
+		now   = time.Now()
+		nowFn = func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		}
+	)
+
+	gomock.InOrder(
+		query.MockCompositeQuery.EXPECT().SearcherWith(gomock.Any()).DoAndReturn(
+			func(fn search.NewSearcherFn) (search.Searcher, error) {
+				var err error
+				explainSubSearcher, err = fn(subQuery)
+				return searcher, err
+			}),
+		subQuery.EXPECT().Searcher().Return(subSearcher, nil),
+	)
+	s, err := newExplainSearcher(query, nowFn)
+	require.NoError(t, err)
+
+	// The results of the query are not cached so the query is evaluated by
+	// searching for the sub-query.
+	gomock.InOrder(
+		reader.MockCacheStatsReader.EXPECT().CacheStats().Return(1, 2),
+		reader.MockReadThroughSegmentSearcher.EXPECT().Search(query, s).DoAndReturn(
+			func(_ search.Query, s search.Searcher) (postings.List, error) {
+				return s.Search(reader)
+			}),
+		searcher.EXPECT().Search(reader).DoAndReturn(
+			func(r index.Reader) (postings.List, error) {
+				if _, err := explainSubSearcher.Search(r); err != nil {
+					return nil, err
+				}
+				return pl, nil
+			}),
+		subSearcher.EXPECT().Search(reader).Return(subPL, nil),
+		query.MockQuery.EXPECT().String().Return("conjunction(a)"),
+		subQuery.EXPECT().String().Return("a"),
+		reader.MockCacheStatsReader.EXPECT().CacheStats().Return(4, 3),
+		reader.MockReader.EXPECT().Docs(pl).Return(docIter, nil),
+	)
+
+	var (
+		explanation search.Explanation
+		ex          = &explainer{
+			explanation: &explanation,
+			searcher:    s,
+			nowFn:       nowFn,
+		}
+	)
+	iter, err := newIterator(query, s, index.Readers{reader}, ex)
+	require.NoError(t, err)
+
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+	require.NoError(t, iter.Close())
+
+	require.Equal(t, 1, len(explanation.Segments))
+	segment := explanation.Segments[0]
+	require.Equal(t, 3, segment.CacheHits)
+	require.Equal(t, 1, segment.CacheMisses)
+	require.Equal(t, "conjunction(a)", segment.Query.Query)
+	require.Equal(t, 1, segment.Query.PostingsSize)
+	require.Equal(t, 5*time.Second, segment.Query.Duration)
+	require.Equal(t, 1, len(segment.Query.SubQueries))
+	require.Equal(t, "a", segment.Query.SubQueries[0].Query)
+	require.Equal(t, 2, segment.Query.SubQueries[0].PostingsSize)
+	require.Equal(t, time.Second, segment.Query.SubQueries[0].Duration)
+	require.Nil(t, segment.Query.SubQueries[0].SubQueries)
+}
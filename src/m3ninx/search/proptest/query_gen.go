@@ -136,6 +136,67 @@ func GenRegexpQuery(docs []doc.Document) gopter.Gen {
 	}
 }
 
+// GenPrefixQuery generates a prefix query.
+func GenPrefixQuery(docs []doc.Document) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		fieldName, fieldValue := fieldNameAndValue(genParams, docs)
+		idx := genParams.NextUint64() % uint64(len(fieldValue)+1)
+		q := query.NewPrefixQuery(fieldName, fieldValue[:idx])
+		return gopter.NewGenResult(q, gopter.NoShrinker)
+	}
+}
+
+// GenRangeQuery generates a lexicographic range query bounded by the values
+// of two documents for the same field.
+func GenRangeQuery(docs []doc.Document) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		fieldName, min := fieldNameAndValue(genParams, docs)
+		_, max := fieldNameAndValue(genParams, docs)
+		if bytes.Compare(min, max) > 0 {
+			min, max = max, min
+		}
+		var (
+			minInclusive = genParams.NextBool()
+			maxInclusive = genParams.NextBool()
+		)
+		q := query.NewRangeQuery(fieldName, min, max, minInclusive, maxInclusive)
+		return gopter.NewGenResult(q, gopter.NoShrinker)
+	}
+}
+
+// GenCaseInsensitiveTermQuery generates a case-insensitive term query with a
+// randomly cased term.
+func GenCaseInsensitiveTermQuery(docs []doc.Document) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		fieldName, fieldValue := fieldNameAndValue(genParams, docs)
+		term := fieldValue
+		if genParams.NextBool() {
+			term = bytes.ToUpper(fieldValue)
+		}
+		q, err := query.NewCaseInsensitiveTermQuery(fieldName, term)
+		if err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(q, gopter.NoShrinker)
+	}
+}
+
+// GenFuzzyQuery generates a fuzzy query.
+func GenFuzzyQuery(docs []doc.Document) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		fieldName, fieldValue := fieldNameAndValue(genParams, docs)
+		if len(fieldValue) > 16 {
+			fieldValue = fieldValue[:16]
+		}
+		maxEdits := int(genParams.NextUint64() % 3)
+		q, err := query.NewFuzzyQuery(fieldName, fieldValue, maxEdits)
+		if err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(q, gopter.NoShrinker)
+	}
+}
+
 // GenNegationQuery generates a negation query.
 func GenNegationQuery(docs []doc.Document) gopter.Gen {
 	return gen.OneGenOf(
@@ -183,6 +244,10 @@ func GenQuery(docs []doc.Document) gopter.Gen {
 		GenFieldQuery(docs),
 		GenTermQuery(docs),
 		GenRegexpQuery(docs),
+		GenPrefixQuery(docs),
+		GenRangeQuery(docs),
+		GenCaseInsensitiveTermQuery(docs),
+		GenFuzzyQuery(docs),
 		GenNegationQuery(docs),
 		GenConjunctionQuery(docs),
 		GenDisjunctionQuery(docs))
@@ -58,11 +58,30 @@ func unmarshal(q *querypb.Query) (search.Query, error) {
 		return NewFieldQuery(q.Field.Field), nil
 
 	case *querypb.Query_Term:
+		if q.Term.CaseInsensitive {
+			return NewCaseInsensitiveTermQuery(q.Term.Field, q.Term.Term)
+		}
 		return NewTermQuery(q.Term.Field, q.Term.Term), nil
 
 	case *querypb.Query_Regexp:
+		if q.Regexp.CaseInsensitive {
+			return NewCaseInsensitiveRegexpQuery(q.Regexp.Field, q.Regexp.Regexp)
+		}
 		return NewRegexpQuery(q.Regexp.Field, q.Regexp.Regexp)
 
+	case *querypb.Query_Prefix:
+		return NewPrefixQuery(q.Prefix.Field, q.Prefix.Prefix), nil
+
+	case *querypb.Query_Range:
+		r := q.Range
+		if r.Numeric {
+			return NewNumericRangeQuery(r.Field, r.Min, r.Max, r.MinInclusive, r.MaxInclusive)
+		}
+		return NewRangeQuery(r.Field, r.Min, r.Max, r.MinInclusive, r.MaxInclusive), nil
+
+	case *querypb.Query_Fuzzy:
+		return NewFuzzyQuery(q.Fuzzy.Field, q.Fuzzy.Term, int(q.Fuzzy.MaxEdits))
+
 	case *querypb.Query_Negation:
 		inner, err := unmarshal(q.Negation.Query)
 		if err != nil {
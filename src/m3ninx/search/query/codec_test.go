@@ -45,10 +45,34 @@ func TestMarshal(t *testing.T) {
 			name:  "term query",
 			query: NewTermQuery([]byte("fruit"), []byte("apple")),
 		},
+		{
+			name:  "case insensitive term query",
+			query: MustCreateCaseInsensitiveTermQuery([]byte("fruit"), []byte("Apple")),
+		},
 		{
 			name:  "regexp query",
 			query: MustCreateRegexpQuery([]byte("fruit"), []byte(".*ple")),
 		},
+		{
+			name:  "case insensitive regexp query",
+			query: MustCreateCaseInsensitiveRegexpQuery([]byte("fruit"), []byte("A.*ple")),
+		},
+		{
+			name:  "fuzzy query",
+			query: MustCreateFuzzyQuery([]byte("fruit"), []byte("aple"), 1),
+		},
+		{
+			name:  "prefix query",
+			query: NewPrefixQuery([]byte("fruit"), []byte("app")),
+		},
+		{
+			name:  "range query",
+			query: NewRangeQuery([]byte("fruit"), []byte("apple"), nil, true, false),
+		},
+		{
+			name:  "numeric range query",
+			query: mustCreateNumericRangeQuery([]byte("size"), []byte("1"), []byte("10.5"), false, true),
+		},
 		{
 			name:  "negation query",
 			query: NewNegationQuery(NewTermQuery([]byte("fruit"), []byte("apple"))),
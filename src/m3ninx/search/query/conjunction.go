@@ -67,17 +67,25 @@ func NewConjunctionQuery(queries []search.Query) search.Query {
 
 // Searcher returns a searcher over the provided readers.
 func (q *ConjuctionQuery) Searcher() (search.Searcher, error) {
+	return q.SearcherWith(newSearcher)
+}
+
+// SearcherWith returns a searcher over the provided readers which uses the
+// given function to get the searchers of the queries of the conjunction.
+// Negated queries are searched for the documents they negate, which are
+// then removed from the documents matched by the conjunction.
+func (q *ConjuctionQuery) SearcherWith(fn search.NewSearcherFn) (search.Searcher, error) {
 	switch {
 	case len(q.queries) == 0:
 		return searcher.NewEmptySearcher(), nil
 
 	case len(q.queries) == 1 && len(q.negations) == 0:
-		return q.queries[0].Searcher()
+		return fn(q.queries[0])
 	}
 
 	qsrs := make(search.Searchers, 0, len(q.queries))
 	for _, q := range q.queries {
-		sr, err := q.Searcher()
+		sr, err := fn(q)
 		if err != nil {
 			return nil, err
 		}
@@ -86,7 +94,7 @@ func (q *ConjuctionQuery) Searcher() (search.Searcher, error) {
 
 	nsrs := make(search.Searchers, 0, len(q.negations))
 	for _, q := range q.negations {
-		sr, err := q.Searcher()
+		sr, err := fn(q)
 		if err != nil {
 			return nil, err
 		}
@@ -126,3 +126,27 @@ func TestConjunctionQueryEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestConjunctionQuerySearcherWith(t *testing.T) {
+	var (
+		term     = NewTermQuery([]byte("fruit"), []byte("apple"))
+		regexp   = MustCreateRegexpQuery([]byte("color"), []byte("r.*"))
+		negated  = NewTermQuery([]byte("vegetable"), []byte("carrot"))
+		negation = NewNegationQuery(negated)
+		q        = NewConjunctionQuery([]search.Query{term, negation, regexp})
+	)
+
+	composite, ok := q.(search.CompositeQuery)
+	require.True(t, ok)
+
+	var subQueries []search.Query
+	_, err := composite.SearcherWith(func(sub search.Query) (search.Searcher, error) {
+		subQueries = append(subQueries, sub)
+		return sub.Searcher()
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(subQueries))
+	require.True(t, subQueries[0].Equal(term))
+	require.True(t, subQueries[1].Equal(regexp))
+	require.True(t, subQueries[2].Equal(negated))
+}
@@ -54,17 +54,23 @@ func NewDisjunctionQuery(queries []search.Query) search.Query {
 
 // Searcher returns a searcher over the provided readers.
 func (q *DisjuctionQuery) Searcher() (search.Searcher, error) {
+	return q.SearcherWith(newSearcher)
+}
+
+// SearcherWith returns a searcher over the provided readers which uses the
+// given function to get the searchers of the queries of the disjunction.
+func (q *DisjuctionQuery) SearcherWith(fn search.NewSearcherFn) (search.Searcher, error) {
 	switch len(q.queries) {
 	case 0:
 		return searcher.NewEmptySearcher(), nil
 
 	case 1:
-		return q.queries[0].Searcher()
+		return fn(q.queries[0])
 	}
 
 	srs := make(search.Searchers, 0, len(q.queries))
 	for _, q := range q.queries {
-		sr, err := q.Searcher()
+		sr, err := fn(q)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,126 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package query
+
+import (
+	"testing"
+
+	"github.com/m3db/m3/src/m3ninx/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuzzyQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		field, term []byte
+		maxEdits    int
+		expectErr   bool
+	}{
+		{
+			name:     "valid field, term and max edits should not return an error",
+			field:    []byte("fruit"),
+			term:     []byte("apple"),
+			maxEdits: 2,
+		},
+		{
+			name:      "negative max edits should return an error",
+			field:     []byte("fruit"),
+			term:      []byte("apple"),
+			maxEdits:  -1,
+			expectErr: true,
+		},
+		{
+			name:      "too many max edits should return an error",
+			field:     []byte("fruit"),
+			term:      []byte("apple"),
+			maxEdits:  3,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := NewFuzzyQuery(test.field, test.term, test.maxEdits)
+
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, err = q.Searcher()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFuzzyQueryEqual(t *testing.T) {
+	tests := []struct {
+		name        string
+		left, right search.Query
+		expected    bool
+	}{
+		{
+			name:     "same field, term and max edits",
+			left:     MustCreateFuzzyQuery([]byte("fruit"), []byte("apple"), 1),
+			right:    MustCreateFuzzyQuery([]byte("fruit"), []byte("apple"), 1),
+			expected: true,
+		},
+		{
+			name: "singular conjunction query",
+			left: MustCreateFuzzyQuery([]byte("fruit"), []byte("apple"), 1),
+			right: NewConjunctionQuery([]search.Query{
+				MustCreateFuzzyQuery([]byte("fruit"), []byte("apple"), 1),
+			}),
+			expected: true,
+		},
+		{
+			name:     "different field",
+			left:     MustCreateFuzzyQuery([]byte("fruit"), []byte("apple"), 1),
+			right:    MustCreateFuzzyQuery([]byte("food"), []byte("apple"), 1),
+			expected: false,
+		},
+		{
+			name:     "different term",
+			left:     MustCreateFuzzyQuery([]byte("fruit"), []byte("apple"), 1),
+			right:    MustCreateFuzzyQuery([]byte("fruit"), []byte("apply"), 1),
+			expected: false,
+		},
+		{
+			name:     "different max edits",
+			left:     MustCreateFuzzyQuery([]byte("fruit"), []byte("apple"), 1),
+			right:    MustCreateFuzzyQuery([]byte("fruit"), []byte("apple"), 2),
+			expected: false,
+		},
+		{
+			name:     "term query",
+			left:     MustCreateFuzzyQuery([]byte("fruit"), []byte("apple"), 0),
+			right:    NewTermQuery([]byte("fruit"), []byte("apple")),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.left.Equal(test.right))
+		})
+	}
+}
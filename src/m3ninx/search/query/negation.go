@@ -42,7 +42,13 @@ func NewNegationQuery(q search.Query) search.Query {
 
 // Searcher returns a searcher over the provided readers.
 func (q *NegationQuery) Searcher() (search.Searcher, error) {
-	s, err := q.query.Searcher()
+	return q.SearcherWith(newSearcher)
+}
+
+// SearcherWith returns a searcher over the provided readers which uses the
+// given function to get the searcher of the negated query.
+func (q *NegationQuery) SearcherWith(fn search.NewSearcherFn) (search.Searcher, error) {
+	s, err := fn(q.query)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,137 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package query
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/m3db/m3/src/m3ninx/generated/proto/querypb"
+	"github.com/m3db/m3/src/m3ninx/index"
+	"github.com/m3db/m3/src/m3ninx/search"
+	"github.com/m3db/m3/src/m3ninx/search/searcher"
+)
+
+// RangeQuery finds documents which have a term within a range, with terms
+// compared either lexicographically or numerically.
+type RangeQuery struct {
+	field        []byte
+	min          []byte
+	max          []byte
+	minInclusive bool
+	maxInclusive bool
+	numeric      bool
+	compiled     index.TermRange
+}
+
+// NewRangeQuery constructs a new RangeQuery for the given field which matches
+// terms that sort between min and max byte-wise. An empty bound is unbounded.
+func NewRangeQuery(
+	field, min, max []byte,
+	minInclusive, maxInclusive bool,
+) search.Query {
+	return &RangeQuery{
+		field:        field,
+		min:          min,
+		max:          max,
+		minInclusive: minInclusive,
+		maxInclusive: maxInclusive,
+		compiled:     index.NewLexicographicTermRange(min, max, minInclusive, maxInclusive),
+	}
+}
+
+// NewNumericRangeQuery constructs a new RangeQuery for the given field which
+// matches terms that parse as numbers between min and max. An empty bound is
+// unbounded.
+func NewNumericRangeQuery(
+	field, min, max []byte,
+	minInclusive, maxInclusive bool,
+) (search.Query, error) {
+	compiled, err := index.NewNumericTermRange(min, max, minInclusive, maxInclusive)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RangeQuery{
+		field:        field,
+		min:          min,
+		max:          max,
+		minInclusive: minInclusive,
+		maxInclusive: maxInclusive,
+		numeric:      true,
+		compiled:     compiled,
+	}, nil
+}
+
+// Searcher returns a searcher over the provided readers.
+func (q *RangeQuery) Searcher() (search.Searcher, error) {
+	return searcher.NewRangeSearcher(q.field, q.compiled), nil
+}
+
+// Equal reports whether q is equivalent to o.
+func (q *RangeQuery) Equal(o search.Query) bool {
+	o, ok := singular(o)
+	if !ok {
+		return false
+	}
+
+	inner, ok := o.(*RangeQuery)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(q.field, inner.field) &&
+		bytes.Equal(q.min, inner.min) &&
+		bytes.Equal(q.max, inner.max) &&
+		q.minInclusive == inner.minInclusive &&
+		q.maxInclusive == inner.maxInclusive &&
+		q.numeric == inner.numeric
+}
+
+// ToProto returns the Protobuf query struct corresponding to the range query.
+func (q *RangeQuery) ToProto() *querypb.Query {
+	rng := querypb.RangeQuery{
+		Field:        q.field,
+		Min:          q.min,
+		Max:          q.max,
+		MinInclusive: q.minInclusive,
+		MaxInclusive: q.maxInclusive,
+		Numeric:      q.numeric,
+	}
+
+	return &querypb.Query{
+		Query: &querypb.Query_Range{Range: &rng},
+	}
+}
+
+func (q *RangeQuery) String() string {
+	name := "range"
+	if q.numeric {
+		name = "numeric_range"
+	}
+	lower, upper := "(", ")"
+	if q.minInclusive {
+		lower = "["
+	}
+	if q.maxInclusive {
+		upper = "]"
+	}
+	return fmt.Sprintf("%s(%s, %s%s, %s%s)", name, q.field, lower, q.min, q.max, upper)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package query
+
+import (
+	"testing"
+
+	"github.com/m3db/m3/src/m3ninx/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumericRangeQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		min, max  []byte
+		expectErr bool
+	}{
+		{
+			name: "numeric bounds should not return an error",
+			min:  []byte("-1.5"),
+			max:  []byte("1e3"),
+		},
+		{
+			name: "unbounded range should not return an error",
+		},
+		{
+			name:      "invalid min should return an error",
+			min:       []byte("one"),
+			expectErr: true,
+		},
+		{
+			name:      "invalid max should return an error",
+			max:       []byte("ten"),
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := NewNumericRangeQuery([]byte("size"), test.min, test.max, true, true)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, err = q.Searcher()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRangeQueryEqual(t *testing.T) {
+	tests := []struct {
+		name        string
+		left, right search.Query
+		expected    bool
+	}{
+		{
+			name:     "same field and bounds",
+			left:     NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("pear"), true, false),
+			right:    NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("pear"), true, false),
+			expected: true,
+		},
+		{
+			name: "singular disjunction query",
+			left: NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("pear"), true, false),
+			right: NewDisjunctionQuery([]search.Query{
+				NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("pear"), true, false),
+			}),
+			expected: true,
+		},
+		{
+			name:     "different bounds",
+			left:     NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("pear"), true, false),
+			right:    NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("plum"), true, false),
+			expected: false,
+		},
+		{
+			name:     "different inclusivity",
+			left:     NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("pear"), true, false),
+			right:    NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("pear"), true, true),
+			expected: false,
+		},
+		{
+			name:     "numeric and lexicographic",
+			left:     NewRangeQuery([]byte("size"), []byte("1"), []byte("10"), true, true),
+			right:    mustCreateNumericRangeQuery([]byte("size"), []byte("1"), []byte("10"), true, true),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.left.Equal(test.right))
+		})
+	}
+}
+
+func mustCreateNumericRangeQuery(
+	field, min, max []byte,
+	minInclusive, maxInclusive bool,
+) search.Query {
+	q, err := NewNumericRangeQuery(field, min, max, minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
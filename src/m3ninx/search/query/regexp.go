@@ -32,22 +32,36 @@ import (
 
 // RegexpQuery finds documents which match the given regular expression.
 type RegexpQuery struct {
-	field    []byte
-	regexp   []byte
-	compiled index.CompiledRegex
+	field           []byte
+	regexp          []byte
+	caseInsensitive bool
+	compiled        index.CompiledRegex
 }
 
 // NewRegexpQuery constructs a new query for the given regular expression.
 func NewRegexpQuery(field, regexp []byte) (search.Query, error) {
-	compiled, err := index.CompileRegex(regexp)
+	return newRegexpQuery(field, regexp, false)
+}
+
+// NewCaseInsensitiveRegexpQuery constructs a new query for the given regular
+// expression which matches regardless of case.
+func NewCaseInsensitiveRegexpQuery(field, regexp []byte) (search.Query, error) {
+	return newRegexpQuery(field, regexp, true)
+}
+
+func newRegexpQuery(field, regexp []byte, caseInsensitive bool) (search.Query, error) {
+	compiled, err := index.CompileRegexWithOptions(regexp, index.CompileRegexOptions{
+		CaseInsensitive: caseInsensitive,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &RegexpQuery{
-		field:    field,
-		regexp:   regexp,
-		compiled: compiled,
+		field:           field,
+		regexp:          regexp,
+		caseInsensitive: caseInsensitive,
+		compiled:        compiled,
 	}, nil
 }
 
@@ -60,6 +74,16 @@ func MustCreateRegexpQuery(field, regexp []byte) search.Query {
 	return q
 }
 
+// MustCreateCaseInsensitiveRegexpQuery is like NewCaseInsensitiveRegexpQuery but
+// panics if the query cannot be created.
+func MustCreateCaseInsensitiveRegexpQuery(field, regexp []byte) search.Query {
+	q, err := NewCaseInsensitiveRegexpQuery(field, regexp)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
 // Searcher returns a searcher over the provided readers.
 func (q *RegexpQuery) Searcher() (search.Searcher, error) {
 	return searcher.NewRegexpSearcher(q.field, q.compiled), nil
@@ -77,14 +101,16 @@ func (q *RegexpQuery) Equal(o search.Query) bool {
 		return false
 	}
 
-	return bytes.Equal(q.field, inner.field) && bytes.Equal(q.regexp, inner.regexp)
+	return bytes.Equal(q.field, inner.field) && bytes.Equal(q.regexp, inner.regexp) &&
+		q.caseInsensitive == inner.caseInsensitive
 }
 
 // ToProto returns the Protobuf query struct corresponding to the regexp query.
 func (q *RegexpQuery) ToProto() *querypb.Query {
 	regexp := querypb.RegexpQuery{
-		Field:  q.field,
-		Regexp: q.regexp,
+		Field:           q.field,
+		Regexp:          q.regexp,
+		CaseInsensitive: q.caseInsensitive,
 	}
 
 	return &querypb.Query{
@@ -93,5 +119,8 @@ func (q *RegexpQuery) ToProto() *querypb.Query {
 }
 
 func (q *RegexpQuery) String() string {
+	if q.caseInsensitive {
+		return fmt.Sprintf("case_insensitive_regexp(%s, %s)", q.field, q.regexp)
+	}
 	return fmt.Sprintf("regexp(%s, %s)", q.field, q.regexp)
 }
@@ -64,6 +64,18 @@ func TestRegexpQuery(t *testing.T) {
 	}
 }
 
+func TestCaseInsensitiveRegexpQuery(t *testing.T) {
+	q, err := NewCaseInsensitiveRegexpQuery([]byte("fruit"), []byte("A.*ple"))
+	require.NoError(t, err)
+	require.Equal(t, "case_insensitive_regexp(fruit, A.*ple)", q.String())
+
+	_, err = q.Searcher()
+	require.NoError(t, err)
+
+	_, err = NewCaseInsensitiveRegexpQuery([]byte("fruit"), []byte("(*]ple"))
+	require.Error(t, err)
+}
+
 func TestRegexpQueryEqual(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -104,6 +116,12 @@ func TestRegexpQueryEqual(t *testing.T) {
 			right:    MustCreateRegexpQuery([]byte("fruit"), []byte(".*na")),
 			expected: false,
 		},
+		{
+			name:     "different case sensitivity",
+			left:     MustCreateRegexpQuery([]byte("fruit"), []byte(".*ple")),
+			right:    MustCreateCaseInsensitiveRegexpQuery([]byte("fruit"), []byte(".*ple")),
+			expected: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -23,16 +23,20 @@ package query
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 
 	"github.com/m3db/m3/src/m3ninx/generated/proto/querypb"
+	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/search"
 	"github.com/m3db/m3/src/m3ninx/search/searcher"
 )
 
 // TermQuery finds document which match the given term exactly.
 type TermQuery struct {
-	field []byte
-	term  []byte
+	field           []byte
+	term            []byte
+	caseInsensitive bool
+	compiled        index.CompiledRegex
 }
 
 // NewTermQuery constructs a new TermQuery for the given field and term.
@@ -43,8 +47,42 @@ func NewTermQuery(field, term []byte) search.Query {
 	}
 }
 
+// NewCaseInsensitiveTermQuery constructs a new TermQuery for the given field and
+// term which matches the term regardless of case.
+func NewCaseInsensitiveTermQuery(field, term []byte) (search.Query, error) {
+	// NB: terms are stored as is, so matching a term regardless of case requires
+	// a case-insensitive regexp matching the term literally.
+	literal := []byte(regexp.QuoteMeta(string(term)))
+	compiled, err := index.CompileRegexWithOptions(literal, index.CompileRegexOptions{
+		CaseInsensitive: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TermQuery{
+		field:           field,
+		term:            term,
+		caseInsensitive: true,
+		compiled:        compiled,
+	}, nil
+}
+
+// MustCreateCaseInsensitiveTermQuery is like NewCaseInsensitiveTermQuery but panics
+// if the query cannot be created.
+func MustCreateCaseInsensitiveTermQuery(field, term []byte) search.Query {
+	q, err := NewCaseInsensitiveTermQuery(field, term)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
 // Searcher returns a searcher over the provided readers.
 func (q *TermQuery) Searcher() (search.Searcher, error) {
+	if q.caseInsensitive {
+		return searcher.NewRegexpSearcher(q.field, q.compiled), nil
+	}
 	return searcher.NewTermSearcher(q.field, q.term), nil
 }
 
@@ -60,14 +98,16 @@ func (q *TermQuery) Equal(o search.Query) bool {
 		return false
 	}
 
-	return bytes.Equal(q.field, inner.field) && bytes.Equal(q.term, inner.term)
+	return bytes.Equal(q.field, inner.field) && bytes.Equal(q.term, inner.term) &&
+		q.caseInsensitive == inner.caseInsensitive
 }
 
 // ToProto returns the Protobuf query struct corresponding to the term query.
 func (q *TermQuery) ToProto() *querypb.Query {
 	term := querypb.TermQuery{
-		Field: q.field,
-		Term:  q.term,
+		Field:           q.field,
+		Term:            q.term,
+		CaseInsensitive: q.caseInsensitive,
 	}
 
 	return &querypb.Query{
@@ -76,5 +116,8 @@ func (q *TermQuery) ToProto() *querypb.Query {
 }
 
 func (q *TermQuery) String() string {
+	if q.caseInsensitive {
+		return fmt.Sprintf("case_insensitive_term(%s, %s)", q.field, q.term)
+	}
 	return fmt.Sprintf("term(%s, %s)", q.field, q.term)
 }
@@ -49,6 +49,24 @@ func TestTermQuery(t *testing.T) {
 	}
 }
 
+func TestCaseInsensitiveTermQuery(t *testing.T) {
+	q, err := NewCaseInsensitiveTermQuery([]byte("service"), []byte("api.v1"))
+	require.NoError(t, err)
+	require.Equal(t, "case_insensitive_term(service, api.v1)", q.String())
+
+	_, err = q.Searcher()
+	require.NoError(t, err)
+
+	// The term must be matched literally regardless of case.
+	compiled := q.(*TermQuery).compiled.Simple
+	for _, term := range []string{"api.v1", "API.V1", "Api.v1"} {
+		require.True(t, compiled.MatchString(term), term)
+	}
+	for _, term := range []string{"apixv1", "api.v12", "my-api.v1"} {
+		require.False(t, compiled.MatchString(term), term)
+	}
+}
+
 func TestTermQueryEqual(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -89,6 +107,18 @@ func TestTermQueryEqual(t *testing.T) {
 			right:    NewTermQuery([]byte("fruit"), []byte("banana")),
 			expected: false,
 		},
+		{
+			name:     "same case insensitive field and term",
+			left:     MustCreateCaseInsensitiveTermQuery([]byte("fruit"), []byte("apple")),
+			right:    MustCreateCaseInsensitiveTermQuery([]byte("fruit"), []byte("apple")),
+			expected: true,
+		},
+		{
+			name:     "different case sensitivity",
+			left:     NewTermQuery([]byte("fruit"), []byte("apple")),
+			right:    MustCreateCaseInsensitiveTermQuery([]byte("fruit"), []byte("apple")),
+			expected: false,
+		},
 	}
 
 	for _, test := range tests {
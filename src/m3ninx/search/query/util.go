@@ -52,6 +52,12 @@ func singular(q search.Query) (search.Query, bool) {
 	return q, true
 }
 
+// newSearcher returns the searcher of the query, it is used by composite
+// queries to get the searchers of the queries they are composed of.
+func newSearcher(q search.Query) (search.Searcher, error) {
+	return q.Searcher()
+}
+
 // join concatenates a slice of queries.
 func join(qs []search.Query) string {
 	switch len(qs) {
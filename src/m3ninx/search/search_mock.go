@@ -31,6 +31,7 @@ import (
 	"github.com/m3db/m3/src/m3ninx/generated/proto/querypb"
 	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/postings"
+	"github.com/m3db/m3/src/x/clock"
 
 	"github.com/golang/mock/gomock"
 )
@@ -73,6 +74,21 @@ func (mr *MockExecutorMockRecorder) Execute(q interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockExecutor)(nil).Execute), q)
 }
 
+// ExecuteExplain mocks base method
+func (m *MockExecutor) ExecuteExplain(q Query, explanation *Explanation, nowFn clock.NowFn) (doc.Iterator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteExplain", q, explanation, nowFn)
+	ret0, _ := ret[0].(doc.Iterator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteExplain indicates an expected call of ExecuteExplain
+func (mr *MockExecutorMockRecorder) ExecuteExplain(q, explanation, nowFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteExplain", reflect.TypeOf((*MockExecutor)(nil).ExecuteExplain), q, explanation, nowFn)
+}
+
 // Close mocks base method
 func (m *MockExecutor) Close() error {
 	m.ctrl.T.Helper()
@@ -167,6 +183,44 @@ func (mr *MockQueryMockRecorder) ToProto() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToProto", reflect.TypeOf((*MockQuery)(nil).ToProto))
 }
 
+// MockCompositeQuery is a mock of CompositeQuery interface
+type MockCompositeQuery struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompositeQueryMockRecorder
+}
+
+// MockCompositeQueryMockRecorder is the mock recorder for MockCompositeQuery
+type MockCompositeQueryMockRecorder struct {
+	mock *MockCompositeQuery
+}
+
+// NewMockCompositeQuery creates a new mock instance
+func NewMockCompositeQuery(ctrl *gomock.Controller) *MockCompositeQuery {
+	mock := &MockCompositeQuery{ctrl: ctrl}
+	mock.recorder = &MockCompositeQueryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCompositeQuery) EXPECT() *MockCompositeQueryMockRecorder {
+	return m.recorder
+}
+
+// SearcherWith mocks base method
+func (m *MockCompositeQuery) SearcherWith(fn NewSearcherFn) (Searcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearcherWith", fn)
+	ret0, _ := ret[0].(Searcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearcherWith indicates an expected call of SearcherWith
+func (mr *MockCompositeQueryMockRecorder) SearcherWith(fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearcherWith", reflect.TypeOf((*MockCompositeQuery)(nil).SearcherWith), fn)
+}
+
 // MockSearcher is a mock of Searcher interface
 type MockSearcher struct {
 	ctrl     *gomock.Controller
@@ -204,3 +258,79 @@ func (mr *MockSearcherMockRecorder) Search(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearcher)(nil).Search), arg0)
 }
+
+// MockReadThroughSegmentSearcher is a mock of ReadThroughSegmentSearcher interface
+type MockReadThroughSegmentSearcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadThroughSegmentSearcherMockRecorder
+}
+
+// MockReadThroughSegmentSearcherMockRecorder is the mock recorder for MockReadThroughSegmentSearcher
+type MockReadThroughSegmentSearcherMockRecorder struct {
+	mock *MockReadThroughSegmentSearcher
+}
+
+// NewMockReadThroughSegmentSearcher creates a new mock instance
+func NewMockReadThroughSegmentSearcher(ctrl *gomock.Controller) *MockReadThroughSegmentSearcher {
+	mock := &MockReadThroughSegmentSearcher{ctrl: ctrl}
+	mock.recorder = &MockReadThroughSegmentSearcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockReadThroughSegmentSearcher) EXPECT() *MockReadThroughSegmentSearcherMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method
+func (m *MockReadThroughSegmentSearcher) Search(query Query, searcher Searcher) (postings.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", query, searcher)
+	ret0, _ := ret[0].(postings.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockReadThroughSegmentSearcherMockRecorder) Search(query, searcher interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockReadThroughSegmentSearcher)(nil).Search), query, searcher)
+}
+
+// MockCacheStatsReader is a mock of CacheStatsReader interface
+type MockCacheStatsReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheStatsReaderMockRecorder
+}
+
+// MockCacheStatsReaderMockRecorder is the mock recorder for MockCacheStatsReader
+type MockCacheStatsReaderMockRecorder struct {
+	mock *MockCacheStatsReader
+}
+
+// NewMockCacheStatsReader creates a new mock instance
+func NewMockCacheStatsReader(ctrl *gomock.Controller) *MockCacheStatsReader {
+	mock := &MockCacheStatsReader{ctrl: ctrl}
+	mock.recorder = &MockCacheStatsReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCacheStatsReader) EXPECT() *MockCacheStatsReaderMockRecorder {
+	return m.recorder
+}
+
+// CacheStats mocks base method
+func (m *MockCacheStatsReader) CacheStats() (int, int) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheStats")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	return ret0, ret1
+}
+
+// CacheStats indicates an expected call of CacheStats
+func (mr *MockCacheStatsReaderMockRecorder) CacheStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheStats", reflect.TypeOf((*MockCacheStatsReader)(nil).CacheStats))
+}
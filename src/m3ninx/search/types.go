@@ -22,11 +22,13 @@ package search
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/generated/proto/querypb"
 	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/postings"
+	"github.com/m3db/m3/src/x/clock"
 )
 
 // Executor is responsible for executing queries over a snapshot.
@@ -34,6 +36,11 @@ type Executor interface {
 	// Execute executes a query over the Executor's snapshot.
 	Execute(q Query) (doc.Iterator, error)
 
+	// ExecuteExplain executes a query over the Executor's snapshot, recording
+	// how the query was evaluated against each segment in the explanation
+	// using the given function to time the evaluation.
+	ExecuteExplain(q Query, explanation *Explanation, nowFn clock.NowFn) (doc.Iterator, error)
+
 	// Close closes the iterator.
 	Close() error
 }
@@ -52,6 +59,16 @@ type Query interface {
 	ToProto() *querypb.Query
 }
 
+// CompositeQuery is a query composed of other queries.
+type CompositeQuery interface {
+	// SearcherWith returns a Searcher for executing the query which uses the
+	// given function to get the Searchers of the queries it is composed of.
+	SearcherWith(fn NewSearcherFn) (Searcher, error)
+}
+
+// NewSearcherFn returns a Searcher for executing the given query.
+type NewSearcherFn func(q Query) (Searcher, error)
+
 // Searcher executes a query against a given Reader. It returns the postings lists
 // of the documents it matches for the given segment.
 type Searcher interface {
@@ -61,3 +78,41 @@ type Searcher interface {
 
 // Searchers is a slice of Searcher.
 type Searchers []Searcher
+
+// ReadThroughSegmentSearcher is implemented by readers that may cache the results of
+// whole queries, it searches the reader using the searcher for the query unless the
+// query's results are already cached.
+type ReadThroughSegmentSearcher interface {
+	// Search returns the postings list of the documents matched by the query, using
+	// the given searcher for the query if the results are not cached.
+	Search(query Query, searcher Searcher) (postings.List, error)
+}
+
+// CacheStatsReader is implemented by readers that cache postings lists and can
+// report how many lookups were served by the cache.
+type CacheStatsReader interface {
+	// CacheStats returns the number of cache hits and misses of the reader.
+	CacheStats() (hits int, misses int)
+}
+
+// Explanation describes how a query was evaluated against each segment
+// it was executed over.
+type Explanation struct {
+	Segments []SegmentExplanation `json:"segments"`
+}
+
+// SegmentExplanation describes how a query was evaluated against a segment.
+type SegmentExplanation struct {
+	Query       QueryExplanation `json:"query"`
+	CacheHits   int              `json:"cacheHits"`
+	CacheMisses int              `json:"cacheMisses"`
+}
+
+// QueryExplanation describes the postings list matched by a query and the
+// time taken to match it, along with the explanations of its sub-queries.
+type QueryExplanation struct {
+	Query        string             `json:"query"`
+	PostingsSize int                `json:"postingsSize"`
+	Duration     time.Duration      `json:"duration"`
+	SubQueries   []QueryExplanation `json:"subQueries,omitempty"`
+}
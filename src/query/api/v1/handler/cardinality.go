@@ -0,0 +1,218 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/util"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// CardinalityURL is the url to report the series cardinality of tags.
+	CardinalityURL = "/api/v1/cardinality"
+
+	// CardinalityHTTPMethod is the HTTP method used with this resource.
+	CardinalityHTTPMethod = http.MethodGet
+
+	cardinalityNamespaceParam   = "namespace"
+	cardinalityStartParam       = "start"
+	cardinalityEndParam         = "end"
+	cardinalityFieldsLimitParam = "fields_limit"
+	cardinalityValuesLimitParam = "values_limit"
+
+	defaultCardinalityFieldsLimit = 20
+	defaultCardinalityValuesLimit = 10
+	defaultCardinalityRange       = time.Hour
+)
+
+var errCardinalityNoClusters = errors.New("no M3DB clusters configured")
+
+// CardinalityHandler reports the approximate number of series per tag name
+// and tag value of a namespace, which is useful to find tags responsible
+// for cardinality explosions.
+type CardinalityHandler struct {
+	clusters       m3.Clusters
+	instrumentOpts instrument.Options
+	nowFn          func() time.Time
+}
+
+// CardinalityResponse is the response of the cardinality endpoint.
+type CardinalityResponse struct {
+	Namespace string             `json:"namespace"`
+	Start     time.Time          `json:"start"`
+	End       time.Time          `json:"end"`
+	Tags      []CardinalityField `json:"tags"`
+}
+
+// CardinalityField is the approximate cardinality of a tag name.
+type CardinalityField struct {
+	Name string `json:"name"`
+	// Series is the approximate number of series with the tag.
+	Series uint64 `json:"series"`
+	// Values is the approximate number of distinct values of the tag.
+	Values    uint64             `json:"values"`
+	TopValues []CardinalityValue `json:"topValues"`
+}
+
+// CardinalityValue is the approximate cardinality of a tag value.
+type CardinalityValue struct {
+	Value string `json:"value"`
+	// Series is the approximate number of series with the tag value.
+	Series uint64 `json:"series"`
+}
+
+// NewCardinalityHandler returns a new instance of the cardinality handler.
+func NewCardinalityHandler(opts options.HandlerOptions) http.Handler {
+	return &CardinalityHandler{
+		clusters:       opts.Clusters(),
+		instrumentOpts: opts.InstrumentOpts(),
+		nowFn:          time.Now,
+	}
+}
+
+func (h *CardinalityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+
+	if h.clusters == nil {
+		xhttp.Error(w, errCardinalityNoClusters, http.StatusBadRequest)
+		return
+	}
+
+	ns, opts, parseErr := h.parseParams(r)
+	if parseErr != nil {
+		logger.Error("unable to parse request", zap.Error(parseErr.Inner()))
+		xhttp.Error(w, parseErr.Inner(), parseErr.Code())
+		return
+	}
+
+	result, err := ns.Session().Cardinality(ns.NamespaceID(), opts)
+	if err != nil {
+		logger.Error("cardinality query error",
+			zap.Error(err),
+			zap.Stringer("namespace", ns.NamespaceID()))
+		code := http.StatusInternalServerError
+		if client.IsBadRequestError(err) {
+			code = http.StatusBadRequest
+		}
+		xhttp.Error(w, err, code)
+		return
+	}
+
+	resp := CardinalityResponse{
+		Namespace: ns.NamespaceID().String(),
+		Start:     opts.StartInclusive,
+		End:       opts.EndExclusive,
+		Tags:      make([]CardinalityField, 0, len(result.Fields)),
+	}
+	for _, field := range result.Fields {
+		values := make([]CardinalityValue, 0, len(field.TopValues))
+		for _, value := range field.TopValues {
+			values = append(values, CardinalityValue{
+				Value:  string(value.Value),
+				Series: value.Series.Estimate(),
+			})
+		}
+		resp.Tags = append(resp.Tags, CardinalityField{
+			Name:      string(field.Field),
+			Series:    field.Series.Estimate(),
+			Values:    field.Values.Estimate(),
+			TopValues: values,
+		})
+	}
+
+	xhttp.WriteJSONResponse(w, resp, logger)
+}
+
+func (h *CardinalityHandler) parseParams(
+	r *http.Request,
+) (m3.ClusterNamespace, index.CardinalityOptions, *xhttp.ParseError) {
+	var (
+		now  = h.nowFn()
+		opts = index.CardinalityOptions{
+			StartInclusive: now.Add(-defaultCardinalityRange),
+			EndExclusive:   now,
+			FieldsLimit:    defaultCardinalityFieldsLimit,
+			ValuesLimit:    defaultCardinalityValuesLimit,
+		}
+		err error
+	)
+
+	ns := h.clusters.UnaggregatedClusterNamespace()
+	if name := r.FormValue(cardinalityNamespaceParam); name != "" {
+		ns = nil
+		for _, clusterNamespace := range h.clusters.ClusterNamespaces() {
+			if clusterNamespace.NamespaceID().String() == name {
+				ns = clusterNamespace
+				break
+			}
+		}
+		if ns == nil {
+			return nil, opts, xhttp.NewParseError(
+				fmt.Errorf("unknown namespace: %s", name), http.StatusBadRequest)
+		}
+	}
+
+	if str := r.FormValue(cardinalityStartParam); str != "" {
+		opts.StartInclusive, err = util.ParseTimeString(str)
+		if err != nil {
+			return nil, opts, xhttp.NewParseError(err, http.StatusBadRequest)
+		}
+	}
+	if str := r.FormValue(cardinalityEndParam); str != "" {
+		opts.EndExclusive, err = util.ParseTimeString(str)
+		if err != nil {
+			return nil, opts, xhttp.NewParseError(err, http.StatusBadRequest)
+		}
+	}
+	if !opts.StartInclusive.Before(opts.EndExclusive) {
+		return nil, opts, xhttp.NewParseError(
+			errors.New("start must be before end"), http.StatusBadRequest)
+	}
+
+	if str := r.FormValue(cardinalityFieldsLimitParam); str != "" {
+		opts.FieldsLimit, err = strconv.Atoi(str)
+		if err != nil {
+			return nil, opts, xhttp.NewParseError(err, http.StatusBadRequest)
+		}
+	}
+	if str := r.FormValue(cardinalityValuesLimitParam); str != "" {
+		opts.ValuesLimit, err = strconv.Atoi(str)
+		if err != nil {
+			return nil, opts, xhttp.NewParseError(err, http.StatusBadRequest)
+		}
+	}
+
+	return ns, opts, nil
+}
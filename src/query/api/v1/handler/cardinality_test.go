@@ -0,0 +1,125 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCardinalityHandler(
+	t *testing.T,
+	session client.Session,
+	now time.Time,
+) http.Handler {
+	clusters, err := m3.NewClusters(m3.UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("metrics"),
+		Session:     session,
+		Retention:   48 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	return &CardinalityHandler{
+		clusters:       clusters,
+		instrumentOpts: instrument.NewOptions(),
+		nowFn:          func() time.Time { return now },
+	}
+}
+
+func TestCardinalityHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now     = time.Now().Truncate(time.Second)
+		session = client.NewMockSession(ctrl)
+		handler = newTestCardinalityHandler(t, session, now)
+		tracker = index.NewCardinalityTracker()
+	)
+	for i := 0; i < 3; i++ {
+		tracker.Add(doc.Document{
+			ID: []byte(fmt.Sprintf("series-%d", i)),
+			Fields: []doc.Field{
+				{Name: []byte("city"), Value: []byte("nyc")},
+			},
+		})
+	}
+
+	session.EXPECT().
+		Cardinality(ident.NewIDMatcher("metrics"), index.CardinalityOptions{
+			StartInclusive: now.Add(-defaultCardinalityRange),
+			EndExclusive:   now,
+			FieldsLimit:    5,
+			ValuesLimit:    defaultCardinalityValuesLimit,
+		}).
+		Return(tracker.Result(index.CardinalityOptions{}), nil)
+
+	req := httptest.NewRequest(CardinalityHTTPMethod,
+		CardinalityURL+"?namespace=metrics&fields_limit=5", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	var resp CardinalityResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Equal(t, "metrics", resp.Namespace)
+	assert.Equal(t, []CardinalityField{
+		{
+			Name:      "city",
+			Series:    3,
+			Values:    1,
+			TopValues: []CardinalityValue{{Value: "nyc", Series: 3}},
+		},
+	}, resp.Tags)
+}
+
+func TestCardinalityHandlerInvalidParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := newTestCardinalityHandler(t, client.NewMockSession(ctrl), time.Now())
+	for _, query := range []string{
+		"namespace=unknown",
+		"start=foo",
+		"start=2000&end=1000",
+		"values_limit=foo",
+	} {
+		req := httptest.NewRequest(CardinalityHTTPMethod, CardinalityURL+"?"+query, nil)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, query)
+	}
+}
@@ -48,6 +48,7 @@ import (
 const (
 	queryParam          = "query"
 	filterNameTagsParam = "tag"
+	matchParam          = "match"
 	errFormatStr        = "error parsing param: %s, error: %v"
 	maxTimeout          = 5 * time.Minute
 	tolerance           = 0.0000001
@@ -178,6 +179,24 @@ func ParseTagCompletionParamsToQueries(
 		}
 	}
 
+	// If there is a match type field present, parse it and match the
+	// tag values of the queries with it. Otherwise, default to matching
+	// the tag values as regular expressions.
+	matchType := models.MatchRegexp
+	if match := r.FormValue(matchParam); match != "" {
+		switch match {
+		case "regexp":
+			// no-op
+		case "caseInsensitive":
+			matchType = models.MatchCaseInsensitiveRegexp
+		case "fuzzy":
+			matchType = models.MatchFuzzy
+		default:
+			return tagCompletionQueries, xhttp.NewParseError(
+				errors.ErrInvalidMatchParamError, http.StatusBadRequest)
+		}
+	}
+
 	tagCompletionQueries.NameOnly = nameOnly
 	queries, err := parseTagCompletionQueries(r)
 	if err != nil {
@@ -198,6 +217,13 @@ func ParseTagCompletionParamsToQueries(
 			return tagCompletionQueries, xhttp.NewParseError(err, http.StatusBadRequest)
 		}
 
+		for i := range matchers {
+			// NB: matchers without a value only restrict the tag name.
+			if len(matchers[i].Value) > 0 {
+				matchers[i].Type = matchType
+			}
+		}
+
 		tagQuery.TagMatchers = matchers
 		filterNameTags := r.Form[filterNameTagsParam]
 		tagQuery.FilterNameTags = make([][]byte, len(filterNameTags))
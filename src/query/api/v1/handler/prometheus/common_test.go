@@ -111,6 +111,41 @@ func TestTimeoutParseWithGetRequestParam(t *testing.T) {
 	assert.Equal(t, timeout, time.Millisecond)
 }
 
+func TestParseTagCompletionParamsToQueriesMatch(t *testing.T) {
+	tests := []struct {
+		match    string
+		expected models.MatchType
+	}{
+		{"", models.MatchRegexp},
+		{"regexp", models.MatchRegexp},
+		{"caseInsensitive", models.MatchCaseInsensitiveRegexp},
+		{"fuzzy", models.MatchFuzzy},
+	}
+
+	for _, tt := range tests {
+		params := url.Values{}
+		params.Add("query", "a:b c")
+		if tt.match != "" {
+			params.Add("match", tt.match)
+		}
+
+		req := httptest.NewRequest("GET", "/dummy?"+params.Encode(), nil)
+		queries, err := ParseTagCompletionParamsToQueries(req)
+		require.Nil(t, err)
+		require.Len(t, queries.Queries, 1)
+
+		matchers := queries.Queries[0].TagMatchers
+		require.Len(t, matchers, 2)
+		assert.Equal(t, tt.expected, matchers[0].Type, tt.match)
+		// Matchers without a value only restrict the tag name.
+		assert.Equal(t, models.MatchRegexp, matchers[1].Type, tt.match)
+	}
+
+	req := httptest.NewRequest("GET", "/dummy?query=a:b&match=invalid", nil)
+	_, err := ParseTagCompletionParamsToQueries(req)
+	require.NotNil(t, err)
+}
+
 type writer struct {
 	value string
 }
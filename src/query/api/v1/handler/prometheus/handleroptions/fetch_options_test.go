@@ -50,6 +50,7 @@ func TestFetchOptionsBuilder(t *testing.T) {
 		expectedLimit    int
 		expectedRestrict *storage.RestrictQueryOptions
 		expectedLookback *expectedLookback
+		expectedExplain  bool
 		expectedErr      bool
 	}{
 		{
@@ -120,6 +121,20 @@ func TestFetchOptionsBuilder(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "explain",
+			headers: map[string]string{
+				ExplainHeader: "true",
+			},
+			expectedExplain: true,
+		},
+		{
+			name: "bad explain",
+			headers: map[string]string{
+				ExplainHeader: "foo",
+			},
+			expectedErr: true,
+		},
 		{
 			name:  "can set lookback duration",
 			query: "lookback=10s",
@@ -183,6 +198,7 @@ func TestFetchOptionsBuilder(t *testing.T) {
 					require.NotNil(t, opts.LookbackDuration)
 					require.Equal(t, test.expectedLookback.value, *opts.LookbackDuration)
 				}
+				require.Equal(t, test.expectedExplain, opts.Explain)
 			} else {
 				require.Error(t, err)
 			}
@@ -21,13 +21,17 @@
 package handleroptions
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/m3db/m3/src/query/block"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAddWarningHeaders(t *testing.T) {
@@ -57,3 +61,68 @@ func TestAddWarningHeaders(t *testing.T) {
 	assert.Equal(t, 1, len(recorder.Header()))
 	assert.Equal(t, ex, recorder.Header().Get(LimitHeader))
 }
+
+func TestAddExplainHeader(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	meta := block.NewResultMetadata()
+	AddExplainHeader(recorder, meta)
+	assert.Equal(t, 0, len(recorder.Header()))
+
+	recorder = httptest.NewRecorder()
+	meta.Explanations = []block.Explanation{
+		{Namespace: "foo", Explanation: []byte(`{"query":"bar"}`)},
+	}
+	ex := `[{"namespace":"foo","explanation":{"query":"bar"}}]`
+	AddExplainHeader(recorder, meta)
+	assert.Equal(t, 1, len(recorder.Header()))
+	assert.Equal(t, ex, recorder.Header().Get(ExplainHeader))
+}
+
+func TestAddExplainHeaderTruncates(t *testing.T) {
+	explanation := block.Explanation{
+		Namespace:   "foo",
+		Explanation: []byte(`"` + strings.Repeat("a", 1000) + `"`),
+	}
+	meta := block.NewResultMetadata()
+	for i := 0; i < 10; i++ {
+		meta.Explanations = append(meta.Explanations, explanation)
+	}
+
+	recorder := httptest.NewRecorder()
+	AddExplainHeader(recorder, meta)
+	header := recorder.Header().Get(ExplainHeader)
+	assert.True(t, len(header) <= maxExplainHeaderSize)
+
+	var explanations []block.Explanation
+	require.NoError(t, json.Unmarshal([]byte(header), &explanations))
+	assert.Equal(t, meta.Explanations[:3], explanations)
+	assert.Equal(t, "7", recorder.Header().Get(ExplainTruncatedHeader))
+}
+
+func TestAddExplainHeaderTruncatesFastestBlocks(t *testing.T) {
+	padding := strings.Repeat("a", 1500)
+	newBlock := func(duration time.Duration) string {
+		return fmt.Sprintf(`{"duration":%d,"segments":["%s"]}`, duration, padding)
+	}
+	meta := block.NewResultMetadata()
+	meta.Explanations = []block.Explanation{
+		{
+			Namespace: "foo",
+			Explanation: []byte(`{"query":"bar","blocks":[` +
+				newBlock(time.Second) + `,` +
+				newBlock(3*time.Second) + `,` +
+				newBlock(2*time.Second) + `]}`),
+		},
+	}
+
+	recorder := httptest.NewRecorder()
+	AddExplainHeader(recorder, meta)
+	header := recorder.Header().Get(ExplainHeader)
+	assert.True(t, len(header) <= maxExplainHeaderSize)
+
+	ex := `[{"namespace":"foo","explanation":{"blocks":[` +
+		newBlock(3*time.Second) + `,` + newBlock(2*time.Second) +
+		`],"query":"bar"}}]`
+	assert.Equal(t, ex, header)
+	assert.Equal(t, "1", recorder.Header().Get(ExplainTruncatedHeader))
+}
@@ -21,8 +21,13 @@
 package handleroptions
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/m3db/m3/src/query/block"
 )
@@ -82,6 +87,16 @@ const (
 	// the number of time series returned by each storage node.
 	LimitMaxSeriesHeader = M3HeaderPrefix + "Limit-Max-Series"
 
+	// ExplainHeader when set to true on a query explains how the index
+	// queries issued by the query were evaluated, the explanation is returned
+	// in JSON format in the same header of the response.
+	ExplainHeader = M3HeaderPrefix + "Explain"
+
+	// ExplainTruncatedHeader is the number of index blocks, or of
+	// explanations without blocks, omitted from the explain header of the
+	// response to keep it within maxExplainHeaderSize.
+	ExplainTruncatedHeader = M3HeaderPrefix + "Explain-Truncated"
+
 	// UnaggregatedStoragePolicy specifies the unaggregated storage policy.
 	UnaggregatedStoragePolicy = "unaggregated"
 
@@ -133,3 +148,194 @@ func AddWarningHeaders(w http.ResponseWriter, meta block.ResultMetadata) {
 
 	w.Header().Set(LimitHeader, strings.Join(warnings, ","))
 }
+
+// maxExplainHeaderSize is the maximum size of the explain header, well
+// within the header size limits of common proxies and clients.
+const maxExplainHeaderSize = 4096
+
+// AddExplainHeader adds the explanations present in the result's metadata.
+// Explanations that do not fit within the maximum header size are truncated
+// by omitting the index blocks that were the quickest to query, which are
+// counted in the explain truncated header. No-op if the query was not
+// explained.
+func AddExplainHeader(w http.ResponseWriter, meta block.ResultMetadata) {
+	if len(meta.Explanations) == 0 {
+		return
+	}
+
+	explanations, err := json.Marshal(meta.Explanations)
+	if err != nil {
+		return
+	}
+
+	omitted := 0
+	if len(explanations) > maxExplainHeaderSize {
+		explanations, omitted, err = truncateExplanations(meta.Explanations)
+		if err != nil {
+			return
+		}
+	}
+
+	w.Header().Set(ExplainHeader, string(explanations))
+	if omitted > 0 {
+		w.Header().Set(ExplainTruncatedHeader, strconv.Itoa(omitted))
+	}
+}
+
+// explainedBlock is the explanation of an index block queried by an
+// explained query.
+type explainedBlock struct {
+	explanation int
+	encoded     json.RawMessage
+	duration    time.Duration
+	included    bool
+}
+
+// truncateExplanations encodes the explanations within maxExplainHeaderSize
+// and returns the number of blocks omitted. Every explanation is kept with
+// at least its query, then blocks are added slowest first until the header
+// is full, so every block kept was slower to query than every block omitted.
+// Explanations that do not fit at all, or that have no blocks and do not
+// fit as a whole, are omitted and each of their blocks, or the explanation
+// itself if it has none, is counted as omitted.
+func truncateExplanations(
+	explanations []block.Explanation,
+) ([]byte, int, error) {
+	var (
+		fields   = make([]map[string]json.RawMessage, len(explanations))
+		included = make([]bool, len(explanations))
+		blocks   []explainedBlock
+		// Account for the opening bracket, each explanation then accounts
+		// for the separator or closing bracket following it.
+		size    = 1
+		omitted int
+	)
+	for i, explanation := range explanations {
+		var encodedBlocks []json.RawMessage
+		fields[i], encodedBlocks = splitExplanationBlocks(explanation.Explanation)
+		first := len(blocks)
+		for _, encoded := range encodedBlocks {
+			// Re-encode the block to account for its size once escaped.
+			normalized, err := json.Marshal(encoded)
+			if err != nil {
+				return nil, 0, err
+			}
+			var timing struct {
+				Duration time.Duration `json:"duration"`
+			}
+			if err := json.Unmarshal(encoded, &timing); err != nil {
+				return nil, 0, err
+			}
+			blocks = append(blocks, explainedBlock{
+				explanation: i,
+				encoded:     normalized,
+				duration:    timing.Duration,
+			})
+		}
+
+		skeleton, err := encodeExplanation(explanation, fields[i], nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		if size+len(skeleton)+1 > maxExplainHeaderSize {
+			if n := len(blocks) - first; n > 0 {
+				omitted += n
+			} else {
+				omitted++
+			}
+			continue
+		}
+		included[i] = true
+		size += len(skeleton) + 1
+	}
+
+	slowest := make([]int, 0, len(blocks))
+	for i, b := range blocks {
+		if included[b.explanation] {
+			slowest = append(slowest, i)
+		}
+	}
+	sort.SliceStable(slowest, func(i, j int) bool {
+		return blocks[slowest[i]].duration > blocks[slowest[j]].duration
+	})
+	full := false
+	for _, i := range slowest {
+		// Each block accounts for the separator preceding it, which the first
+		// block of an explanation does not need.
+		if full || size+len(blocks[i].encoded)+1 > maxExplainHeaderSize {
+			full = true
+			omitted++
+			continue
+		}
+		blocks[i].included = true
+		size += len(blocks[i].encoded) + 1
+	}
+
+	kept := make([][]json.RawMessage, len(explanations))
+	for _, b := range blocks {
+		if b.included {
+			kept[b.explanation] = append(kept[b.explanation], b.encoded)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, explanation := range explanations {
+		if !included[i] {
+			continue
+		}
+		encoded, err := encodeExplanation(explanation, fields[i], kept[i])
+		if err != nil {
+			return nil, 0, err
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(encoded)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), omitted, nil
+}
+
+// splitExplanationBlocks returns the fields of an explanation and the
+// blocks it lists, or no fields if the explanation does not list blocks.
+func splitExplanationBlocks(
+	explanation json.RawMessage,
+) (map[string]json.RawMessage, []json.RawMessage) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(explanation, &fields); err != nil {
+		return nil, nil
+	}
+	encodedBlocks, ok := fields["blocks"]
+	if !ok {
+		return nil, nil
+	}
+	var blocks []json.RawMessage
+	if err := json.Unmarshal(encodedBlocks, &blocks); err != nil {
+		return nil, nil
+	}
+	return fields, blocks
+}
+
+// encodeExplanation encodes the explanation with only the given blocks if
+// its fields were split from its blocks, or as is otherwise.
+func encodeExplanation(
+	explanation block.Explanation,
+	fields map[string]json.RawMessage,
+	blocks []json.RawMessage,
+) ([]byte, error) {
+	if fields != nil {
+		if blocks == nil {
+			blocks = []json.RawMessage{}
+		}
+		encodedBlocks, err := json.Marshal(blocks)
+		if err != nil {
+			return nil, err
+		}
+		fields["blocks"] = encodedBlocks
+		if explanation.Explanation, err = json.Marshal(fields); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(explanation)
+}
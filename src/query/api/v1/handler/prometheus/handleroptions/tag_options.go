@@ -43,6 +43,12 @@ func matchByName(name string) (models.MatchType, error) {
 		t = models.MatchField
 	case "NOTEXISTS":
 		t = models.MatchNotField
+	case "CASEINSENSITIVEEQUAL":
+		t = models.MatchCaseInsensitiveEqual
+	case "CASEINSENSITIVEREGEXP":
+		t = models.MatchCaseInsensitiveRegexp
+	case "FUZZY":
+		t = models.MatchFuzzy
 	case "ALL":
 		return t, errors.New("ALL type not supported as a tag matcher restriction")
 	default:
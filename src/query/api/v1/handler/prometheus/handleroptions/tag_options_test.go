@@ -122,6 +122,24 @@ func TestParse(t *testing.T) {
 			},
 			false,
 		},
+		{
+			`{
+			"match":[
+				{"name":"a", "value":"b", "type":"CASEINSENSITIVEEQUAL"},
+				{"name":"c", "value":"d", "type":"CASEINSENSITIVEREGEXP"},
+				{"name":"e", "value":"f", "type":"FUZZY"}
+			]
+		}`,
+			&storage.RestrictByTag{
+				Restrict: models.Matchers{
+					mustMatcher("a", "b", models.MatchCaseInsensitiveEqual),
+					mustMatcher("c", "d", models.MatchCaseInsensitiveRegexp),
+					mustMatcher("e", "f", models.MatchFuzzy),
+				},
+				Strip: toStrip("a", "c", "e"),
+			},
+			false,
+		},
 		{`{"match":[{}]}`, nil, true},
 		{`{"match":[{"type":"ALL"}]}`, nil, true},
 		{`{"match":[{"type":"invalid"}]}`, nil, true},
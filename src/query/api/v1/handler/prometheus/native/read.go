@@ -133,6 +133,7 @@ func (h *promReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "application/json")
 	handleroptions.AddWarningHeaders(w, result.Meta)
+	handleroptions.AddExplainHeader(w, result.Meta)
 	h.promReadMetrics.fetchSuccess.Inc(1)
 
 	if h.instant {
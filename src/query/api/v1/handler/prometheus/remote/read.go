@@ -227,6 +227,7 @@ func WriteSnappyCompressed(
 	w.Header().Set("Content-Type", "application/x-protobuf")
 	w.Header().Set("Content-Encoding", "snappy")
 	handleroptions.AddWarningHeaders(w, readResult.Meta)
+	handleroptions.AddExplainHeader(w, readResult.Meta)
 
 	compressed := snappy.Encode(nil, data)
 	if _, err := w.Write(compressed); err != nil {
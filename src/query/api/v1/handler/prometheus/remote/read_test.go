@@ -269,7 +269,7 @@ func TestQueryKillOnTimeout(t *testing.T) {
 func TestReadErrorMetricsCount(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	storage, session := m3.NewStorageAndSession(t, ctrl)
-	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, client.FetchResponseMetadata{Exhaustive: true}, fmt.Errorf("unable to get data"))
 	session.EXPECT().IteratorPools().
 		Return(nil, nil)
@@ -193,6 +193,11 @@ func (h *Handler) RegisterRoutes() error {
 		wrapped(m3json.NewWriteJSONHandler(h.options)).ServeHTTP,
 	).Methods(m3json.JSONWriteHTTPMethod)
 
+	// Cardinality endpoint.
+	h.router.HandleFunc(handler.CardinalityURL,
+		wrapped(handler.NewCardinalityHandler(h.options)).ServeHTTP,
+	).Methods(handler.CardinalityHTTPMethod)
+
 	// Tag completion endpoints.
 	h.router.HandleFunc(native.CompleteTagsURL,
 		wrapped(native.NewCompleteTagsHandler(h.options)).ServeHTTP,
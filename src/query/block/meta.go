@@ -21,6 +21,7 @@
 package block
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/m3db/m3/src/query/models"
@@ -65,6 +66,16 @@ type ResultMetadata struct {
 	Warnings Warnings
 	// Resolutions is a list of resolutions for series obtained by this query.
 	Resolutions []int64
+	// Explanations is a list of explanations of how the index queries issued
+	// by this query were evaluated, only set when the query is explained.
+	Explanations []Explanation
+}
+
+// Explanation is an explanation of how an index query was evaluated by the
+// storage of a namespace, the explanation itself is encoded as JSON.
+type Explanation struct {
+	Namespace   string          `json:"namespace"`
+	Explanation json.RawMessage `json:"explanation"`
 }
 
 // NewResultMetadata creates a new result metadata.
@@ -94,6 +105,19 @@ func combineResolutions(a, b []int64) []int64 {
 	return nil
 }
 
+func combineExplanations(a, b []Explanation) []Explanation {
+	if len(a) == 0 {
+		return b
+	}
+	if len(b) == 0 {
+		return a
+	}
+
+	combined := make([]Explanation, 0, len(a)+len(b))
+	combined = append(combined, a...)
+	return append(combined, b...)
+}
+
 func combineWarnings(a, b Warnings) Warnings {
 	if len(a) == 0 {
 		if len(b) != 0 {
@@ -115,10 +139,11 @@ func combineWarnings(a, b Warnings) Warnings {
 // CombineMetadata combines two result metadatas.
 func (m ResultMetadata) CombineMetadata(other ResultMetadata) ResultMetadata {
 	meta := ResultMetadata{
-		LocalOnly:   m.LocalOnly && other.LocalOnly,
-		Exhaustive:  m.Exhaustive && other.Exhaustive,
-		Warnings:    combineWarnings(m.Warnings, other.Warnings),
-		Resolutions: combineResolutions(m.Resolutions, other.Resolutions),
+		LocalOnly:    m.LocalOnly && other.LocalOnly,
+		Exhaustive:   m.Exhaustive && other.Exhaustive,
+		Warnings:     combineWarnings(m.Warnings, other.Warnings),
+		Resolutions:  combineResolutions(m.Resolutions, other.Resolutions),
+		Explanations: combineExplanations(m.Explanations, other.Explanations),
 	}
 
 	return meta
@@ -134,3 +134,25 @@ func TestMergeResolutions(t *testing.T) {
 	require.Equal(t, 6, len(merge.Resolutions))
 	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6}, merge.Resolutions)
 }
+
+func TestMergeExplanations(t *testing.T) {
+	var (
+		a = Explanation{Namespace: "a", Explanation: []byte(`{"query":"a"}`)}
+		b = Explanation{Namespace: "b", Explanation: []byte(`{"query":"b"}`)}
+	)
+
+	merge := ResultMetadata{}.CombineMetadata(ResultMetadata{})
+	assert.Nil(t, merge.Explanations)
+
+	merge = ResultMetadata{}.CombineMetadata(
+		ResultMetadata{Explanations: []Explanation{b}})
+	assert.Equal(t, []Explanation{b}, merge.Explanations)
+
+	merge = ResultMetadata{Explanations: []Explanation{a}}.CombineMetadata(
+		ResultMetadata{})
+	assert.Equal(t, []Explanation{a}, merge.Explanations)
+
+	merge = ResultMetadata{Explanations: []Explanation{a}}.CombineMetadata(
+		ResultMetadata{Explanations: []Explanation{b}})
+	assert.Equal(t, []Explanation{a, b}, merge.Explanations)
+}
@@ -34,6 +34,9 @@ var (
 	// ErrInvalidResultParamError is returned when result field for complete tag request
 	// is an unexpected value
 	ErrInvalidResultParamError = errors.New("invalid 'result' type for complete tag request")
+	// ErrInvalidMatchParamError is returned when match field for complete tag request
+	// is an unexpected value
+	ErrInvalidMatchParamError = errors.New("invalid 'match' type for complete tag request")
 	// ErrNoName is returned when no name param is provided in the resource path
 	ErrNoName = errors.New("invalid path with no name present")
 	// ErrInvalidMatchers is returned when invalid matchers are provided
@@ -44,6 +44,12 @@ func (m MatchType) String() string {
 		return "!-"
 	case MatchAll:
 		return "*"
+	case MatchCaseInsensitiveEqual:
+		return "=*"
+	case MatchCaseInsensitiveRegexp:
+		return "=~*"
+	case MatchFuzzy:
+		return "~"
 	default:
 		return "unknown match type"
 	}
@@ -61,8 +67,12 @@ func NewMatcher(t MatchType, n, v []byte) (Matcher, error) {
 		return Matcher{}, errors.New("name must be set unless using MatchAll")
 	}
 
-	if t == MatchRegexp || t == MatchNotRegexp {
-		re, err := regexp.Compile("^(?:" + string(v) + ")$")
+	if t == MatchRegexp || t == MatchNotRegexp || t == MatchCaseInsensitiveRegexp {
+		flags := ""
+		if t == MatchCaseInsensitiveRegexp {
+			flags = "(?i)"
+		}
+		re, err := regexp.Compile(flags + "^(?:" + string(v) + ")$")
 		if err != nil {
 			return Matcher{}, err
 		}
@@ -73,6 +83,20 @@ func NewMatcher(t MatchType, n, v []byte) (Matcher, error) {
 	return m, nil
 }
 
+// FuzzyMaxEdits returns the maximum number of edits a value may be from the
+// value of a MatchFuzzy matcher to match, which scales with the length of the
+// value so that short values are not matched by unrelated values.
+func FuzzyMaxEdits(value []byte) int {
+	switch n := len(value); {
+	case n <= 2:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
 func (m Matcher) String() string {
 	return fmt.Sprintf("%s%s%q", m.Name, m.Type, m.Value)
 }
@@ -37,6 +37,26 @@ func TestMatcherString(t *testing.T) {
 
 func TestMatchType(t *testing.T) {
 	require.Equal(t, MatchEqual.String(), "=")
+	require.Equal(t, MatchCaseInsensitiveEqual.String(), "=*")
+	require.Equal(t, MatchCaseInsensitiveRegexp.String(), "=~*")
+	require.Equal(t, MatchFuzzy.String(), "~")
+}
+
+func TestNewCaseInsensitiveRegexpMatcher(t *testing.T) {
+	_, err := NewMatcher(MatchCaseInsensitiveRegexp, []byte("foo"), []byte("ba(r"))
+	require.Error(t, err)
+
+	m, err := NewMatcher(MatchCaseInsensitiveRegexp, []byte("foo"), []byte("ba.*"))
+	require.NoError(t, err)
+	assert.True(t, m.re.MatchString("BAR"))
+	assert.False(t, m.re.MatchString("FOOBAR"))
+}
+
+func TestFuzzyMaxEdits(t *testing.T) {
+	assert.Equal(t, 0, FuzzyMaxEdits([]byte("ab")))
+	assert.Equal(t, 1, FuzzyMaxEdits([]byte("abc")))
+	assert.Equal(t, 1, FuzzyMaxEdits([]byte("abcde")))
+	assert.Equal(t, 2, FuzzyMaxEdits([]byte("abcdef")))
 }
 
 func TestMatchersFromEmptyString(t *testing.T) {
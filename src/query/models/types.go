@@ -125,6 +125,15 @@ const (
 	MatchField
 	MatchNotField
 	MatchAll
+	// MatchCaseInsensitiveEqual matches values equal to the matcher value
+	// regardless of case.
+	MatchCaseInsensitiveEqual
+	// MatchCaseInsensitiveRegexp matches values matching the matcher regexp
+	// regardless of case.
+	MatchCaseInsensitiveRegexp
+	// MatchFuzzy matches values within a small number of edits of the matcher
+	// value, see FuzzyMaxEdits.
+	MatchFuzzy
 )
 
 // Matcher models the matching of a label.
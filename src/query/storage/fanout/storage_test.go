@@ -87,13 +87,13 @@ func setupFanoutRead(t *testing.T, output bool, response ...*fetchResponse) stor
 	store1, session1 := m3.NewStorageAndSession(t, ctrl)
 	store2, session2 := m3.NewStorageAndSession(t, ctrl)
 
-	session1.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session1.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(response[0].result, client.FetchResponseMetadata{Exhaustive: true}, response[0].err)
-	session2.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session2.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(response[len(response)-1].result, client.FetchResponseMetadata{Exhaustive: true}, response[len(response)-1].err)
-	session1.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+	session1.EXPECT().FetchTaggedIDsContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, client.FetchResponseMetadata{Exhaustive: false}, errs.ErrNotImplemented)
-	session2.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+	session2.EXPECT().FetchTaggedIDsContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, client.FetchResponseMetadata{Exhaustive: false}, errs.ErrNotImplemented)
 	session1.EXPECT().IteratorPools().
 		Return(nil, nil).AnyTimes()
@@ -118,9 +118,9 @@ func setupFanoutWrite(t *testing.T, output bool, errs ...error) storage.Storage
 			gomock.Any(), gomock.Any(), gomock.Any()).Return(errs[0])
 	session1.EXPECT().IteratorPools().
 		Return(nil, nil).AnyTimes()
-	session1.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+	session1.EXPECT().FetchTaggedIDsContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, client.FetchResponseMetadata{Exhaustive: true}, errs[0]).AnyTimes()
-	session1.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).
+	session1.EXPECT().AggregateContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, client.FetchResponseMetadata{Exhaustive: true}, errs[0]).AnyTimes()
 
 	session2.EXPECT().
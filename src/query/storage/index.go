@@ -208,6 +208,19 @@ func matcherToQuery(matcher models.Matcher) (idx.Query, error) {
 	case models.MatchAll:
 		return idx.NewAllQuery(), nil
 
+	case models.MatchCaseInsensitiveEqual:
+		return idx.NewCaseInsensitiveTermQuery(matcher.Name, matcher.Value)
+
+	case models.MatchCaseInsensitiveRegexp:
+		if bytes.Equal(dotStar, matcher.Value) {
+			return idx.NewFieldQuery(matcher.Name), nil
+		}
+		return idx.NewCaseInsensitiveRegexpQuery(matcher.Name, matcher.Value)
+
+	case models.MatchFuzzy:
+		return idx.NewFuzzyQuery(matcher.Name, matcher.Value,
+			models.FuzzyMaxEdits(matcher.Value))
+
 	default:
 		return idx.Query{}, fmt.Errorf("unsupported query type: %v", matcher)
 	}
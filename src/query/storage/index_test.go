@@ -175,6 +175,50 @@ func TestFetchQueryToM3Query(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "case insensitive exact match",
+			expected: "case_insensitive_term(t1, v1)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchCaseInsensitiveEqual,
+					Name:  []byte("t1"),
+					Value: []byte("v1"),
+				},
+			},
+		},
+		{
+			name:     "case insensitive regexp match",
+			expected: "case_insensitive_regexp(t1, v1)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchCaseInsensitiveRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("v1"),
+				},
+			},
+		},
+		{
+			name:     "case insensitive regexp match -> field",
+			expected: "field(t1)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchCaseInsensitiveRegexp,
+					Name:  []byte("t1"),
+					Value: []byte(".*"),
+				},
+			},
+		},
+		{
+			name:     "fuzzy match",
+			expected: "fuzzy(t1, checkout, 2)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchFuzzy,
+					Name:  []byte("t1"),
+					Value: []byte("checkout"),
+				},
+			},
+		},
 		{
 			name:     "all matchers",
 			expected: "all()",
@@ -86,6 +86,10 @@ type ClusterStaticNamespaceConfiguration struct {
 	// the namespace.
 	Downsample *DownsampleClusterStaticNamespaceConfiguration `yaml:"downsample"`
 
+	// Histograms specifies whether the namespace stores native histograms,
+	// which are expanded into bucket, sum and count series when read.
+	Histograms bool `yaml:"histograms"`
+
 	// StorageMetricsType is the namespace type.
 	//
 	// Deprecated: Use "Type" field when specifying config instead, it is
@@ -284,6 +288,7 @@ func (c ClustersStaticConfiguration) NewClusters(
 		NamespaceID: ident.StringID(unaggregatedClusterNamespaceCfg.namespace.Namespace),
 		Session:     unaggregatedClusterNamespaceCfg.result.session,
 		Retention:   unaggregatedClusterNamespaceCfg.namespace.Retention,
+		Histograms:  unaggregatedClusterNamespaceCfg.namespace.Histograms,
 	}
 
 	for i, cfg := range aggregatedClusterNamespacesCfgs {
@@ -305,6 +310,7 @@ func (c ClustersStaticConfiguration) NewClusters(
 				Retention:   n.Retention,
 				Resolution:  n.Resolution,
 				Downsample:  &downsampleOpts,
+				Histograms:  n.Histograms,
 			}
 			aggregatedClusterNamespaces = append(aggregatedClusterNamespaces, def)
 		}
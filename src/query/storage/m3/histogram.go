@@ -0,0 +1,314 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	dts "github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	histogramBucketSuffix = "_bucket"
+	histogramSumSuffix    = "_sum"
+	histogramCountSuffix  = "_count"
+	histogramBucketTag    = "le"
+	histogramInfBound     = "+Inf"
+)
+
+// expandHistograms reads the series of a namespace with native histograms
+// enabled and expands every series encoded as native histograms into
+// Prometheus style cumulative bucket series with an "le" tag per upper
+// bound, and sum and count series, with the metric name suffixed the same
+// way Prometheus client libraries expose histograms. Series without
+// histograms are returned with their values unchanged. The provided
+// iterators are closed once they have been read.
+func expandHistograms(
+	iters encoding.SeriesIterators,
+	tagOptions models.TagOptions,
+) (encoding.SeriesIterators, error) {
+	defer iters.Close()
+
+	if tagOptions == nil {
+		tagOptions = models.NewTagOptions()
+	}
+
+	result := make([]encoding.SeriesIterator, 0, iters.Len())
+	for _, iter := range iters.Iters() {
+		expanded, err := expandHistogramSeries(iter, tagOptions)
+		if err != nil {
+			for _, it := range result {
+				it.Close()
+			}
+			return nil, err
+		}
+		result = append(result, expanded...)
+	}
+
+	return encoding.NewSeriesIterators(result, nil), nil
+}
+
+// histogramSeries accumulates the datapoints of a series read from storage.
+type histogramSeries struct {
+	id         string
+	namespace  string
+	tags       []ident.Tag
+	start      time.Time
+	end        time.Time
+	datapoints []dts.Datapoint
+	units      []xtime.Unit
+	histograms []histogram.Histogram
+}
+
+func expandHistogramSeries(
+	iter encoding.SeriesIterator,
+	tagOptions models.TagOptions,
+) ([]encoding.SeriesIterator, error) {
+	series := histogramSeries{
+		id:        iter.ID().String(),
+		namespace: iter.Namespace().String(),
+		start:     iter.Start(),
+		end:       iter.End(),
+	}
+
+	tags := iter.Tags().Duplicate()
+	for tags.Next() {
+		tag := tags.Current()
+		series.tags = append(series.tags,
+			ident.StringTag(tag.Name.String(), tag.Value.String()))
+	}
+	err := tags.Err()
+	tags.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var isHistogram bool
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if len(series.datapoints) == 0 {
+			isHistogram = histogram.IsHistogramAnnotation(annotation)
+		}
+		series.datapoints = append(series.datapoints, dp)
+		series.units = append(series.units, unit)
+		if !isHistogram {
+			continue
+		}
+
+		// Iterators only return the annotation when it differs from the
+		// one of the previous datapoint, in which case the histogram is
+		// the same as the previous one.
+		if len(annotation) == 0 {
+			series.histograms = append(series.histograms,
+				series.histograms[len(series.histograms)-1])
+			continue
+		}
+
+		var h histogram.Histogram
+		// Unmarshal allocates new buckets since the histogram has none yet,
+		// which is required since the annotation is only valid until the
+		// next datapoint.
+		if err := histogram.Unmarshal(annotation, &h); err != nil {
+			return nil, fmt.Errorf("unable to read histogram of series %s: %v",
+				series.id, err)
+		}
+		series.histograms = append(series.histograms, h)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	if !isHistogram {
+		expanded, err := series.newIterator(series.id, series.tags,
+			func(i int) float64 {
+				return series.datapoints[i].Value
+			})
+		if err != nil {
+			return nil, err
+		}
+		return []encoding.SeriesIterator{expanded}, nil
+	}
+
+	return series.expand(tagOptions)
+}
+
+// upperBounds returns the sorted union of all bucket upper bounds, excluding
+// +Inf which is always implicitly present.
+func (s *histogramSeries) upperBounds() []float64 {
+	seen := make(map[float64]struct{})
+	for _, h := range s.histograms {
+		for _, b := range h.Buckets {
+			if math.IsInf(b.UpperBound, 1) {
+				continue
+			}
+			seen[b.UpperBound] = struct{}{}
+		}
+	}
+	bounds := make([]float64, 0, len(seen))
+	for bound := range seen {
+		bounds = append(bounds, bound)
+	}
+	sort.Float64s(bounds)
+	return bounds
+}
+
+// expandedSeries describes a series a histogram series is expanded into.
+type expandedSeries struct {
+	id    string
+	tags  []ident.Tag
+	value func(i int) float64
+}
+
+func (s *histogramSeries) expand(
+	tagOptions models.TagOptions,
+) ([]encoding.SeriesIterator, error) {
+	bounds := s.upperBounds()
+	series := make([]expandedSeries, 0, len(bounds)+3)
+	for _, bound := range bounds {
+		bound := bound
+		le := strconv.FormatFloat(bound, 'f', -1, 64)
+		series = append(series, expandedSeries{
+			id:   s.id + histogramBucketSuffix + "," + histogramBucketTag + "=" + le,
+			tags: s.expandedTags(tagOptions, histogramBucketSuffix, le),
+			value: func(i int) float64 {
+				var cumulative uint64
+				for _, b := range s.histograms[i].Buckets {
+					if b.UpperBound > bound {
+						break
+					}
+					cumulative += b.Count
+				}
+				return float64(cumulative)
+			},
+		})
+	}
+
+	series = append(series,
+		expandedSeries{
+			id: s.id + histogramBucketSuffix + "," + histogramBucketTag +
+				"=" + histogramInfBound,
+			tags: s.expandedTags(tagOptions, histogramBucketSuffix, histogramInfBound),
+			value: func(i int) float64 {
+				return float64(s.histograms[i].Count)
+			},
+		},
+		expandedSeries{
+			id:   s.id + histogramSumSuffix,
+			tags: s.expandedTags(tagOptions, histogramSumSuffix, ""),
+			value: func(i int) float64 {
+				return s.histograms[i].Sum
+			},
+		},
+		expandedSeries{
+			id:   s.id + histogramCountSuffix,
+			tags: s.expandedTags(tagOptions, histogramCountSuffix, ""),
+			value: func(i int) float64 {
+				return float64(s.histograms[i].Count)
+			},
+		},
+	)
+
+	result := make([]encoding.SeriesIterator, 0, len(series))
+	for _, e := range series {
+		iter, err := s.newIterator(e.id, e.tags, e.value)
+		if err != nil {
+			for _, it := range result {
+				it.Close()
+			}
+			return nil, err
+		}
+		result = append(result, iter)
+	}
+	return result, nil
+}
+
+// expandedTags returns a copy of the tags with the metric name suffixed and,
+// if set, the bucket tag added while keeping the tags sorted.
+func (s *histogramSeries) expandedTags(
+	tagOptions models.TagOptions,
+	suffix string,
+	le string,
+) []ident.Tag {
+	metricName := tagOptions.MetricName()
+	result := make([]ident.Tag, 0, len(s.tags)+1)
+	for _, tag := range s.tags {
+		if bytes.Equal(tag.Name.Bytes(), metricName) {
+			tag = ident.StringTag(tag.Name.String(), tag.Value.String()+suffix)
+		}
+		result = append(result, tag)
+	}
+
+	if le != "" {
+		result = append(result, ident.StringTag(histogramBucketTag, le))
+		sort.SliceStable(result, func(i, j int) bool {
+			return bytes.Compare(result[i].Name.Bytes(), result[j].Name.Bytes()) < 0
+		})
+	}
+	return result
+}
+
+// newIterator encodes the value returned for each datapoint of the series
+// and returns an iterator over them.
+func (s *histogramSeries) newIterator(
+	id string,
+	tags []ident.Tag,
+	value func(i int) float64,
+) (encoding.SeriesIterator, error) {
+	encodingOpts := encoding.NewOptions()
+	enc := m3tsz.NewEncoder(s.start, nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+	for i, dp := range s.datapoints {
+		dp.Value = value(i)
+		if err := enc.Encode(dp, s.units[i], nil); err != nil {
+			enc.Close()
+			return nil, err
+		}
+	}
+
+	replica := encoding.NewMultiReaderIterator(
+		func(r io.Reader, _ namespace.SchemaDescr) encoding.ReaderIterator {
+			return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+		}, nil)
+	replica.Reset([]xio.SegmentReader{xio.NewSegmentReader(enc.Discard())},
+		s.start, s.end.Sub(s.start), nil)
+
+	return encoding.NewSeriesIterator(encoding.SeriesIteratorOptions{
+		ID:             ident.StringID(id),
+		Namespace:      ident.StringID(s.namespace),
+		Tags:           ident.NewTagsIterator(ident.NewTags(tags...)),
+		Replicas:       []encoding.MultiReaderIterator{replica},
+		StartInclusive: xtime.ToUnixNano(s.start),
+		EndExclusive:   xtime.ToUnixNano(s.end),
+	}, nil), nil
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	dts "github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testExpandedSeries struct {
+	id     string
+	tags   map[string]string
+	values []float64
+}
+
+func newTestSeriesIterator(
+	id string,
+	tags ident.Tags,
+	start time.Time,
+	enc encoding.Encoder,
+) encoding.SeriesIterator {
+	encodingOpts := encoding.NewOptions()
+	replica := encoding.NewMultiReaderIterator(
+		func(r io.Reader, _ namespace.SchemaDescr) encoding.ReaderIterator {
+			return histogram.NewReaderIteratorWithFallback(r,
+				m3tsz.NewReaderIterator(nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts),
+				encodingOpts)
+		}, nil)
+	replica.Reset([]xio.SegmentReader{xio.NewSegmentReader(enc.Discard())},
+		start, time.Hour, nil)
+
+	return encoding.NewSeriesIterator(encoding.SeriesIteratorOptions{
+		ID:             ident.StringID(id),
+		Namespace:      ident.StringID("metrics"),
+		Tags:           ident.NewTagsIterator(tags),
+		Replicas:       []encoding.MultiReaderIterator{replica},
+		StartInclusive: xtime.ToUnixNano(start),
+		EndExclusive:   xtime.ToUnixNano(start.Add(time.Hour)),
+	}, nil)
+}
+
+func readTestExpandedSeries(
+	t *testing.T,
+	iters encoding.SeriesIterators,
+) []testExpandedSeries {
+	var result []testExpandedSeries
+	for _, iter := range iters.Iters() {
+		series := testExpandedSeries{
+			id:   iter.ID().String(),
+			tags: make(map[string]string),
+		}
+
+		var lastName string
+		tags := iter.Tags()
+		for tags.Next() {
+			tag := tags.Current()
+			require.True(t, lastName < tag.Name.String(), "tags not sorted")
+			lastName = tag.Name.String()
+			series.tags[tag.Name.String()] = tag.Value.String()
+		}
+		require.NoError(t, tags.Err())
+
+		for iter.Next() {
+			dp, _, _ := iter.Current()
+			series.values = append(series.values, dp.Value)
+		}
+		require.NoError(t, iter.Err())
+		result = append(result, series)
+	}
+	return result
+}
+
+func TestExpandHistograms(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	histograms := []histogram.Histogram{
+		{
+			Count: 3,
+			Sum:   2.5,
+			Buckets: []histogram.Bucket{
+				{UpperBound: 0.5, Count: 1},
+				{UpperBound: 1, Count: 1},
+			},
+		},
+		{
+			Count: 5,
+			Sum:   4,
+			Buckets: []histogram.Bucket{
+				{UpperBound: 1, Count: 4},
+			},
+		},
+	}
+
+	encodingOpts := encoding.NewOptions()
+	histogramEnc := histogram.NewEncoder(start, encodingOpts)
+	for i, h := range histograms {
+		dp := dts.Datapoint{Timestamp: start.Add(time.Duration(i) * time.Second)}
+		require.NoError(t, histogramEnc.Encode(dp, xtime.Second, h.MarshalAppend(nil)))
+	}
+
+	// Merged blocks are re-encoded with M3TSZ, which only returns the
+	// annotation of a datapoint when it changed.
+	mergedEnc := m3tsz.NewEncoder(start, nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+	for i := 0; i < 2; i++ {
+		dp := dts.Datapoint{Timestamp: start.Add(time.Duration(i) * time.Second)}
+		require.NoError(t, mergedEnc.Encode(dp, xtime.Second, histograms[0].MarshalAppend(nil)))
+	}
+
+	plainEnc := m3tsz.NewEncoder(start, nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+	for i := 0; i < 2; i++ {
+		dp := dts.Datapoint{Timestamp: start.Add(time.Duration(i) * time.Second), Value: float64(i + 1)}
+		require.NoError(t, plainEnc.Encode(dp, xtime.Second, nil))
+	}
+
+	iters := encoding.NewSeriesIterators([]encoding.SeriesIterator{
+		newTestSeriesIterator("latency", ident.NewTags(
+			ident.StringTag("__name__", "latency"),
+			ident.StringTag("zone", "a"),
+		), start, histogramEnc),
+		newTestSeriesIterator("merged", ident.NewTags(
+			ident.StringTag("__name__", "merged"),
+		), start, mergedEnc),
+		newTestSeriesIterator("requests", ident.NewTags(
+			ident.StringTag("__name__", "requests"),
+		), start, plainEnc),
+	}, nil)
+
+	expanded, err := expandHistograms(iters, models.NewTagOptions())
+	require.NoError(t, err)
+	defer expanded.Close()
+
+	expected := []testExpandedSeries{
+		{
+			id:     "latency_bucket,le=0.5",
+			tags:   map[string]string{"__name__": "latency_bucket", "le": "0.5", "zone": "a"},
+			values: []float64{1, 0},
+		},
+		{
+			id:     "latency_bucket,le=1",
+			tags:   map[string]string{"__name__": "latency_bucket", "le": "1", "zone": "a"},
+			values: []float64{2, 4},
+		},
+		{
+			id:     "latency_bucket,le=+Inf",
+			tags:   map[string]string{"__name__": "latency_bucket", "le": "+Inf", "zone": "a"},
+			values: []float64{3, 5},
+		},
+		{
+			id:     "latency_sum",
+			tags:   map[string]string{"__name__": "latency_sum", "zone": "a"},
+			values: []float64{2.5, 4},
+		},
+		{
+			id:     "latency_count",
+			tags:   map[string]string{"__name__": "latency_count", "zone": "a"},
+			values: []float64{3, 5},
+		},
+		{
+			id:     "merged_bucket,le=0.5",
+			tags:   map[string]string{"__name__": "merged_bucket", "le": "0.5"},
+			values: []float64{1, 1},
+		},
+		{
+			id:     "merged_bucket,le=1",
+			tags:   map[string]string{"__name__": "merged_bucket", "le": "1"},
+			values: []float64{2, 2},
+		},
+		{
+			id:     "merged_bucket,le=+Inf",
+			tags:   map[string]string{"__name__": "merged_bucket", "le": "+Inf"},
+			values: []float64{3, 3},
+		},
+		{
+			id:     "merged_sum",
+			tags:   map[string]string{"__name__": "merged_sum"},
+			values: []float64{2.5, 2.5},
+		},
+		{
+			id:     "merged_count",
+			tags:   map[string]string{"__name__": "merged_count"},
+			values: []float64{3, 3},
+		},
+		{
+			id:     "requests",
+			tags:   map[string]string{"__name__": "requests"},
+			values: []float64{1, 2},
+		},
+	}
+	assert.Equal(t, expected, readTestExpandedSeries(t, expanded))
+}
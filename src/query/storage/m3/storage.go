@@ -22,12 +22,14 @@ package m3
 
 import (
 	"context"
+	"encoding/json"
 	goerrors "errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/errors"
@@ -310,13 +312,15 @@ func (s *m3storage) fetchCompressed(
 		namespace := namespace // Capture var
 		wg.Add(1)
 		go func() {
-			_, span, sampled := xcontext.StartSampledTraceSpan(ctx,
+			spanCtx, span, sampled := xcontext.StartSampledTraceSpan(ctx,
 				tracepoint.FetchCompressedFetchTagged)
 			defer span.Finish()
 
 			session := namespace.Session()
 			namespaceID := namespace.NamespaceID()
-			iters, metadata, err := session.FetchTagged(namespaceID, m3query, opts)
+			nsOpts := explainQueryOptions(opts, options.Explain)
+			iters, metadata, err := session.FetchTaggedContext(spanCtx,
+				namespaceID, m3query, nsOpts)
 			if err == nil && sampled {
 				span.LogFields(
 					log.String("namespace", namespaceID.String()),
@@ -327,8 +331,15 @@ func (s *m3storage) fetchCompressed(
 				)
 			}
 
+			if err == nil && namespace.Options().HistogramsEnabled() {
+				// Expand native histograms so that both PromQL and remote
+				// read see Prometheus style bucket, sum and count series.
+				iters, err = expandHistograms(iters, s.opts.TagOptions())
+			}
+
 			blockMeta := block.NewResultMetadata()
 			blockMeta.Exhaustive = metadata.Exhaustive
+			addExplanation(&blockMeta, namespaceID, nsOpts.Explain)
 			fetchResult := SeriesFetchResult{
 				SeriesIterators: iters,
 				Metadata:        blockMeta,
@@ -457,7 +468,7 @@ func (s *m3storage) CompleteTags(
 	for _, namespace := range namespaces {
 		namespace := namespace // Capture var
 		go func() {
-			_, span, sampled := xcontext.StartSampledTraceSpan(ctx,
+			spanCtx, span, sampled := xcontext.StartSampledTraceSpan(ctx,
 				tracepoint.CompleteTagsAggregate)
 			defer func() {
 				span.Finish()
@@ -466,7 +477,8 @@ func (s *m3storage) CompleteTags(
 
 			session := namespace.Session()
 			namespaceID := namespace.NamespaceID()
-			aggTagIter, metadata, err := session.Aggregate(namespaceID, m3query, aggOpts)
+			aggTagIter, metadata, err := session.AggregateContext(spanCtx,
+				namespaceID, m3query, aggOpts)
 			if err != nil {
 				multiErr.add(err)
 				return
@@ -580,13 +592,15 @@ func (s *m3storage) SearchCompressed(
 	for _, namespace := range namespaces {
 		namespace := namespace // Capture var
 		go func() {
-			_, span, sampled := xcontext.StartSampledTraceSpan(ctx,
+			spanCtx, span, sampled := xcontext.StartSampledTraceSpan(ctx,
 				tracepoint.SearchCompressedFetchTaggedIDs)
 			defer span.Finish()
 
 			session := namespace.Session()
 			namespaceID := namespace.NamespaceID()
-			iter, metadata, err := session.FetchTaggedIDs(namespaceID, m3query, m3opts)
+			nsOpts := explainQueryOptions(m3opts, options.Explain)
+			iter, metadata, err := session.FetchTaggedIDsContext(spanCtx,
+				namespaceID, m3query, nsOpts)
 			if err == nil && sampled {
 				span.LogFields(
 					log.String("namespace", namespaceID.String()),
@@ -599,6 +613,7 @@ func (s *m3storage) SearchCompressed(
 
 			blockMeta := block.NewResultMetadata()
 			blockMeta.Exhaustive = metadata.Exhaustive
+			addExplanation(&blockMeta, namespaceID, nsOpts.Explain)
 			result.Add(iter, blockMeta, err)
 			wg.Done()
 		}()
@@ -610,6 +625,39 @@ func (s *m3storage) SearchCompressed(
 	return tagResult, result.Close, err
 }
 
+// explainQueryOptions returns the options to query a namespace with, each
+// namespace is given its own explanation when the query is explained.
+func explainQueryOptions(opts index.QueryOptions, explain bool) index.QueryOptions {
+	if explain {
+		opts.Explain = &index.QueryExplanation{}
+	}
+	return opts
+}
+
+// addExplanation adds the explanation of the query of a namespace to the
+// result metadata, an explanation that cannot be encoded is reported as a
+// warning rather than failing the query.
+func addExplanation(
+	meta *block.ResultMetadata,
+	namespaceID ident.ID,
+	explain *index.QueryExplanation,
+) {
+	if explain == nil {
+		return
+	}
+
+	data, err := json.Marshal(explain)
+	if err != nil {
+		meta.AddWarning("explain", err.Error())
+		return
+	}
+
+	meta.Explanations = append(meta.Explanations, block.Explanation{
+		Namespace:   namespaceID.String(),
+		Explanation: data,
+	})
+}
+
 func (s *m3storage) Write(
 	ctx context.Context,
 	query *storage.WriteQuery,
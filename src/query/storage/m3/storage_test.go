@@ -22,6 +22,7 @@ package m3
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"strings"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/test/seriesiter"
@@ -291,7 +293,7 @@ func TestLocalRead(t *testing.T) {
 	testTags := seriesiter.GenerateTag()
 
 	session := sessions.unaggregated1MonthRetention
-	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(seriesiter.NewMockSeriesIters(ctrl, testTags, 1, 2),
 			testFetchResponseMetadata, nil)
 	session.EXPECT().IteratorPools().
@@ -303,6 +305,44 @@ func TestLocalRead(t *testing.T) {
 	assertFetchResult(t, results, testTags)
 }
 
+func TestLocalReadExplain(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+	store, sessions := setup(t, ctrl)
+	testTags := seriesiter.GenerateTag()
+
+	session := sessions.unaggregated1MonthRetention
+	session.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ ident.ID,
+			q index.Query,
+			opts index.QueryOptions,
+		) (encoding.SeriesIterators, client.FetchResponseMetadata, error) {
+			require.NotNil(t, opts.Explain)
+			opts.Explain.Query = q.String()
+			return seriesiter.NewMockSeriesIters(ctrl, testTags, 1, 2),
+				testFetchResponseMetadata, nil
+		})
+	session.EXPECT().IteratorPools().
+		Return(newTestIteratorPools(ctrl), nil).AnyTimes()
+
+	searchReq := newFetchReq()
+	opts := buildFetchOpts()
+	opts.Explain = true
+	results, err := store.FetchProm(context.TODO(), searchReq, opts)
+	require.NoError(t, err)
+	assertFetchResult(t, results, testTags)
+
+	require.Equal(t, 1, len(results.Metadata.Explanations))
+	explanation := results.Metadata.Explanations[0]
+	assert.Equal(t, "metrics_unaggregated", explanation.Namespace)
+
+	var decoded index.QueryExplanation
+	require.NoError(t, json.Unmarshal(explanation.Explanation, &decoded))
+	assert.NotEmpty(t, decoded.Query)
+}
+
 func TestLocalReadExceedsRetention(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -310,7 +350,7 @@ func TestLocalReadExceedsRetention(t *testing.T) {
 	testTag := seriesiter.GenerateTag()
 
 	session := sessions.aggregated1YearRetention10MinuteResolution
-	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(seriesiter.NewMockSeriesIters(ctrl, testTag, 1, 2),
 			testFetchResponseMetadata, nil)
 	session.EXPECT().IteratorPools().Return(nil, nil).AnyTimes()
@@ -336,13 +376,13 @@ func TestLocalReadExceedsUnaggregatedRetentionWithinAggregatedRetention(t *testi
 	testTag := seriesiter.GenerateTag()
 
 	session := sessions.aggregated3MonthRetention5MinuteResolution
-	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(seriesiter.NewMockSeriesIters(ctrl, testTag, 1, 2),
 			testFetchResponseMetadata, nil)
 	session.EXPECT().IteratorPools().Return(newTestIteratorPools(ctrl), nil).AnyTimes()
 
 	session = sessions.aggregatedPartial6MonthRetention1MinuteResolution
-	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(encoding.EmptySeriesIterators,
 			testFetchResponseMetadata, nil)
 	session.EXPECT().IteratorPools().Return(newTestIteratorPools(ctrl), nil).AnyTimes()
@@ -382,13 +422,13 @@ func TestLocalReadExceedsAggregatedButNotUnaggregatedAndPartialAggregated(t *tes
 	testTag := seriesiter.GenerateTag()
 
 	session := unaggregated1MonthRetention
-	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(seriesiter.NewMockSeriesIters(ctrl, testTag, 1, 2),
 			testFetchResponseMetadata, nil)
 	session.EXPECT().IteratorPools().Return(newTestIteratorPools(ctrl), nil).AnyTimes()
 
 	session = aggregatedPartial6MonthRetention1MinuteResolution
-	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(encoding.EmptySeriesIterators,
 			testFetchResponseMetadata, nil)
 	session.EXPECT().IteratorPools().Return(newTestIteratorPools(ctrl), nil).AnyTimes()
@@ -434,13 +474,13 @@ func TestLocalReadExceedsAggregatedAndPartialAggregated(t *testing.T) {
 	testTag := seriesiter.GenerateTag()
 
 	session := aggregated3MonthRetention5MinuteResolution
-	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(seriesiter.NewMockSeriesIters(ctrl, testTag, 1, 2),
 			testFetchResponseMetadata, nil)
 	session.EXPECT().IteratorPools().Return(newTestIteratorPools(ctrl), nil).AnyTimes()
 
 	session = aggregatedPartial6MonthRetention1MinuteResolution
-	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+	session.EXPECT().FetchTaggedContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(encoding.EmptySeriesIterators,
 			testFetchResponseMetadata, nil)
 	session.EXPECT().IteratorPools().Return(newTestIteratorPools(ctrl), nil).AnyTimes()
@@ -470,7 +510,7 @@ func TestLocalSearchError(t *testing.T) {
 	defer ctrl.Finish()
 	store, sessions := setup(t, ctrl)
 	sessions.forEach(func(session *client.MockSession) {
-		session.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+		session.EXPECT().FetchTaggedIDsContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, client.FetchResponseMetadata{Exhaustive: false}, fmt.Errorf("an error"))
 		session.EXPECT().IteratorPools().
 			Return(nil, nil).AnyTimes()
@@ -539,7 +579,7 @@ func TestLocalSearchSuccess(t *testing.T) {
 				iter.EXPECT().Err().Return(nil),
 				iter.EXPECT().Finalize(),
 			)
-			session.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+			session.EXPECT().FetchTaggedIDsContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(iter, testFetchResponseMetadata, nil)
 			session.EXPECT().IteratorPools().
 				Return(nil, nil).AnyTimes()
@@ -562,7 +602,7 @@ func TestLocalSearchSuccess(t *testing.T) {
 			iter.EXPECT().Finalize(),
 		)
 
-		session.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+		session.EXPECT().FetchTaggedIDsContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(iter, testFetchResponseMetadata, nil)
 
 		session.EXPECT().IteratorPools().
@@ -677,7 +717,7 @@ func TestLocalCompleteTagsSuccess(t *testing.T) {
 				iter.EXPECT().Err().Return(nil),
 				iter.EXPECT().Finalize(),
 			)
-			session.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).
+			session.EXPECT().AggregateContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(iter, testFetchResponseMetadata, nil)
 			return
 		}
@@ -695,7 +735,7 @@ func TestLocalCompleteTagsSuccess(t *testing.T) {
 			iter.EXPECT().Finalize(),
 		)
 
-		session.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).
+		session.EXPECT().AggregateContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(iter, testFetchResponseMetadata, nil)
 	})
 
@@ -755,7 +795,7 @@ func TestLocalCompleteTagsSuccessFinalize(t *testing.T) {
 		}),
 	)
 
-	unagg.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).
+	unagg.EXPECT().AggregateContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(iter, testFetchResponseMetadata, nil)
 
 	req := newCompleteTagsReq()
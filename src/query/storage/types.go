@@ -132,6 +132,9 @@ type FetchOptions struct {
 	// IncludeResolution if set, appends resolution information to fetch results.
 	// Currently only used for graphite queries.
 	IncludeResolution bool
+	// Explain if set, appends an explanation of how the index query was
+	// evaluated by each namespace to the fetch result metadata.
+	Explain bool
 	// Timeout is the timeout for the request.
 	Timeout time.Duration
 }
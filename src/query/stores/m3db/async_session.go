@@ -21,6 +21,7 @@
 package m3db
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -29,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/x/ident"
 	xtime "github.com/m3db/m3/src/x/time"
 )
@@ -105,6 +107,19 @@ func (s *AsyncSession) Write(namespace, id ident.ID, t time.Time, value float64,
 	return s.session.Write(namespace, id, t, value, unit, annotation)
 }
 
+// WriteContext writes a value to the database for an ID with the given
+// context.
+func (s *AsyncSession) WriteContext(ctx context.Context, namespace, id ident.ID,
+	t time.Time, value float64, unit xtime.Unit, annotation []byte) error {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return s.err
+	}
+
+	return s.session.WriteContext(ctx, namespace, id, t, value, unit, annotation)
+}
+
 // WriteTagged writes a value to the database for an ID and given tags.
 func (s *AsyncSession) WriteTagged(namespace, id ident.ID, tags ident.TagIterator,
 	t time.Time, value float64, unit xtime.Unit, annotation []byte) error {
@@ -117,6 +132,31 @@ func (s *AsyncSession) WriteTagged(namespace, id ident.ID, tags ident.TagIterato
 	return s.session.WriteTagged(namespace, id, tags, t, value, unit, annotation)
 }
 
+// WriteTaggedContext writes a value to the database for an ID and given tags
+// with the given context.
+func (s *AsyncSession) WriteTaggedContext(ctx context.Context, namespace, id ident.ID,
+	tags ident.TagIterator, t time.Time, value float64, unit xtime.Unit,
+	annotation []byte) error {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return s.err
+	}
+
+	return s.session.WriteTaggedContext(ctx, namespace, id, tags, t, value, unit, annotation)
+}
+
+// WriteDocument writes a document to an index only namespace.
+func (s *AsyncSession) WriteDocument(namespace ident.ID, d doc.Document, t time.Time) error {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return s.err
+	}
+
+	return s.session.WriteDocument(namespace, d, t)
+}
+
 // Fetch fetches values from the database for an ID.
 func (s *AsyncSession) Fetch(namespace, id ident.ID, startInclusive,
 	endExclusive time.Time) (encoding.SeriesIterator, error) {
@@ -129,6 +169,19 @@ func (s *AsyncSession) Fetch(namespace, id ident.ID, startInclusive,
 	return s.session.Fetch(namespace, id, startInclusive, endExclusive)
 }
 
+// FetchContext fetches values from the database for an ID with the given
+// context.
+func (s *AsyncSession) FetchContext(ctx context.Context, namespace, id ident.ID,
+	startInclusive, endExclusive time.Time) (encoding.SeriesIterator, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return s.session.FetchContext(ctx, namespace, id, startInclusive, endExclusive)
+}
+
 // FetchIDs fetches values from the database for a set of IDs.
 func (s *AsyncSession) FetchIDs(namespace ident.ID, ids ident.Iterator,
 	startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error) {
@@ -141,6 +194,19 @@ func (s *AsyncSession) FetchIDs(namespace ident.ID, ids ident.Iterator,
 	return s.session.FetchIDs(namespace, ids, startInclusive, endExclusive)
 }
 
+// FetchIDsContext fetches values from the database for a set of IDs with the
+// given context.
+func (s *AsyncSession) FetchIDsContext(ctx context.Context, namespace ident.ID,
+	ids ident.Iterator, startInclusive, endExclusive time.Time) (encoding.SeriesIterators, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return s.session.FetchIDsContext(ctx, namespace, ids, startInclusive, endExclusive)
+}
+
 // FetchTagged resolves the provided query to known IDs, and
 // fetches the data for them.
 func (s *AsyncSession) FetchTagged(namespace ident.ID, q index.Query,
@@ -154,6 +220,19 @@ func (s *AsyncSession) FetchTagged(namespace ident.ID, q index.Query,
 	return s.session.FetchTagged(namespace, q, opts)
 }
 
+// FetchTaggedContext resolves the provided query to known IDs, and
+// fetches the data for them with the given context.
+func (s *AsyncSession) FetchTaggedContext(ctx context.Context, namespace ident.ID, q index.Query,
+	opts index.QueryOptions) (encoding.SeriesIterators, client.FetchResponseMetadata, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, client.FetchResponseMetadata{}, s.err
+	}
+
+	return s.session.FetchTaggedContext(ctx, namespace, q, opts)
+}
+
 // FetchTaggedIDs resolves the provided query to known IDs.
 func (s *AsyncSession) FetchTaggedIDs(namespace ident.ID, q index.Query,
 	opts index.QueryOptions) (client.TaggedIDsIterator, client.FetchResponseMetadata, error) {
@@ -166,6 +245,45 @@ func (s *AsyncSession) FetchTaggedIDs(namespace ident.ID, q index.Query,
 	return s.session.FetchTaggedIDs(namespace, q, opts)
 }
 
+// FetchTaggedIDsContext resolves the provided query to known IDs with the
+// given context.
+func (s *AsyncSession) FetchTaggedIDsContext(ctx context.Context, namespace ident.ID, q index.Query,
+	opts index.QueryOptions) (client.TaggedIDsIterator, client.FetchResponseMetadata, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, client.FetchResponseMetadata{}, s.err
+	}
+
+	return s.session.FetchTaggedIDsContext(ctx, namespace, q, opts)
+}
+
+// FetchTaggedPage resolves the provided query to known IDs, and fetches the
+// data for a single page of them.
+func (s *AsyncSession) FetchTaggedPage(ctx context.Context, namespace ident.ID, q index.Query,
+	opts index.QueryOptions, pageToken []byte) (encoding.SeriesIterators, client.FetchResponseMetadata, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, client.FetchResponseMetadata{}, s.err
+	}
+
+	return s.session.FetchTaggedPage(ctx, namespace, q, opts, pageToken)
+}
+
+// FetchTaggedIDsPage resolves the provided query to known IDs for a single
+// page of them.
+func (s *AsyncSession) FetchTaggedIDsPage(ctx context.Context, namespace ident.ID, q index.Query,
+	opts index.QueryOptions, pageToken []byte) (client.TaggedIDsIterator, client.FetchResponseMetadata, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, client.FetchResponseMetadata{}, s.err
+	}
+
+	return s.session.FetchTaggedIDsPage(ctx, namespace, q, opts, pageToken)
+}
+
 // Aggregate aggregates values from the database for the given set of constraints.
 func (s *AsyncSession) Aggregate(
 	namespace ident.ID,
@@ -181,6 +299,54 @@ func (s *AsyncSession) Aggregate(
 	return s.session.Aggregate(namespace, q, opts)
 }
 
+// AggregateContext aggregates values from the database for the given set of
+// constraints with the given context.
+func (s *AsyncSession) AggregateContext(
+	ctx context.Context,
+	namespace ident.ID,
+	q index.Query,
+	opts index.AggregationOptions,
+) (client.AggregatedTagsIterator, client.FetchResponseMetadata, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, client.FetchResponseMetadata{}, s.err
+	}
+
+	return s.session.AggregateContext(ctx, namespace, q, opts)
+}
+
+// Cardinality returns the approximate series cardinality per tag name and
+// tag value of the namespace, merged across all hosts.
+func (s *AsyncSession) Cardinality(
+	namespace ident.ID,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return index.CardinalityResult{}, s.err
+	}
+
+	return s.session.Cardinality(namespace, opts)
+}
+
+// CardinalityContext returns the approximate series cardinality of the
+// namespace with the given context.
+func (s *AsyncSession) CardinalityContext(
+	ctx context.Context,
+	namespace ident.ID,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return index.CardinalityResult{}, s.err
+	}
+
+	return s.session.CardinalityContext(ctx, namespace, opts)
+}
+
 // ShardID returns the given shard for an ID for callers
 // to easily discern what shard is failing when operations
 // for given IDs begin failing.
@@ -205,6 +371,17 @@ func (s *AsyncSession) IteratorPools() (encoding.IteratorPools, error) {
 	return s.session.IteratorPools()
 }
 
+// HostQueueSaturation returns the saturation of the queue of writes pending
+// to each host.
+func (s *AsyncSession) HostQueueSaturation() ([]client.HostQueueSaturation, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.session.HostQueueSaturation()
+}
+
 // Close closes the session.
 func (s *AsyncSession) Close() error {
 	s.RLock()
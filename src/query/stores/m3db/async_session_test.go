@@ -21,6 +21,7 @@
 package m3db
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -159,6 +160,19 @@ func TestAsyncSessionInitialized(t *testing.T) {
 	_, _, err = asyncSession.Aggregate(namespace, index.Query{}, index.AggregationOptions{})
 	assert.NoError(t, err)
 
+	ctx := context.Background()
+	mockSession.EXPECT().WriteTaggedContext(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	err = asyncSession.WriteTaggedContext(ctx, nil, nil, nil, time.Now(), 0, xtime.Second, nil)
+	assert.NoError(t, err)
+
+	mockSession.EXPECT().FetchTaggedContext(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, client.FetchResponseMetadata{Exhaustive: false}, nil)
+	_, _, err = asyncSession.FetchTaggedContext(ctx, namespace, index.Query{}, index.QueryOptions{})
+	assert.NoError(t, err)
+
+	mockSession.EXPECT().AggregateContext(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, client.FetchResponseMetadata{Exhaustive: false}, nil)
+	_, _, err = asyncSession.AggregateContext(ctx, namespace, index.Query{}, index.AggregationOptions{})
+	assert.NoError(t, err)
+
 	mockSession.EXPECT().ShardID(gomock.Any()).Return(uint32(0), nil)
 	_, err = asyncSession.ShardID(nil)
 	assert.NoError(t, err)
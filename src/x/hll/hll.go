@@ -0,0 +1,279 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package hll provides a HyperLogLog sketch for approximate distinct counting.
+package hll
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+const (
+	// MinPrecision is the minimum supported sketch precision.
+	MinPrecision = 4
+	// MaxPrecision is the maximum supported sketch precision.
+	MaxPrecision = 16
+	// DefaultPrecision is the default sketch precision, resulting in a
+	// standard error of roughly 1.6%.
+	DefaultPrecision = 12
+
+	encodingVersion = 1
+	kindSparse      = 0
+	kindDense       = 1
+	headerLen       = 3
+	sparseEntryLen  = 3
+)
+
+var errPrecisionMismatch = errors.New("hll: cannot merge sketches with different precisions")
+
+// Sketch is a HyperLogLog sketch. Sketches start out with a sparse
+// representation that only tracks the registers that have been set, which
+// keeps sketches for low cardinality sets small, and switch to a dense
+// register array once the sparse representation would be larger.
+// Sketch is not safe for concurrent use.
+type Sketch struct {
+	precision uint8
+	sparse    map[uint16]uint8
+	dense     []uint8
+}
+
+// New returns a new empty sketch with the given precision.
+func New(precision int) (*Sketch, error) {
+	if precision < MinPrecision || precision > MaxPrecision {
+		return nil, fmt.Errorf("hll: precision %d out of range [%d, %d]",
+			precision, MinPrecision, MaxPrecision)
+	}
+	return &Sketch{
+		precision: uint8(precision),
+		sparse:    make(map[uint16]uint8),
+	}, nil
+}
+
+// NewDefault returns a new empty sketch with the default precision.
+func NewDefault() *Sketch {
+	s, _ := New(DefaultPrecision)
+	return s
+}
+
+// Precision returns the precision of the sketch.
+func (s *Sketch) Precision() int {
+	return int(s.precision)
+}
+
+// Add adds a hashed value to the sketch, the hash should be uniformly
+// distributed over all 64 bits.
+func (s *Sketch) Add(hash uint64) {
+	var (
+		p   = s.precision
+		idx = uint16(hash >> (64 - p))
+		// Count the leading zeros of the remaining bits, the sentinel bit
+		// bounds the result when all remaining bits are zero.
+		rho = uint8(bits.LeadingZeros64(hash<<p|1<<(p-1))) + 1
+	)
+	s.set(idx, rho)
+}
+
+func (s *Sketch) set(idx uint16, rho uint8) {
+	if s.dense != nil {
+		if rho > s.dense[idx] {
+			s.dense[idx] = rho
+		}
+		return
+	}
+	if rho > s.sparse[idx] {
+		s.sparse[idx] = rho
+		if len(s.sparse)*sparseEntryLen > s.registers() {
+			s.toDense()
+		}
+	}
+}
+
+func (s *Sketch) registers() int {
+	return 1 << s.precision
+}
+
+func (s *Sketch) toDense() {
+	s.dense = make([]uint8, s.registers())
+	for idx, rho := range s.sparse {
+		s.dense[idx] = rho
+	}
+	s.sparse = nil
+}
+
+// Estimate returns the estimated number of distinct values added to the sketch.
+func (s *Sketch) Estimate() uint64 {
+	var (
+		m     = float64(s.registers())
+		sum   float64
+		zeros int
+	)
+	if s.dense != nil {
+		for _, rho := range s.dense {
+			sum += 1 / float64(uint64(1)<<rho)
+			if rho == 0 {
+				zeros++
+			}
+		}
+	} else {
+		zeros = s.registers() - len(s.sparse)
+		sum = float64(zeros)
+		for _, rho := range s.sparse {
+			sum += 1 / float64(uint64(1)<<rho)
+		}
+	}
+
+	estimate := alpha(m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Use linear counting for small cardinalities.
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+func alpha(m float64) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/m)
+}
+
+// Merge merges another sketch into this sketch.
+func (s *Sketch) Merge(other *Sketch) error {
+	if s.precision != other.precision {
+		return errPrecisionMismatch
+	}
+	if other.dense != nil {
+		if s.dense == nil {
+			s.toDense()
+		}
+		for idx, rho := range other.dense {
+			if rho > s.dense[idx] {
+				s.dense[idx] = rho
+			}
+		}
+		return nil
+	}
+	for idx, rho := range other.sparse {
+		s.set(idx, rho)
+	}
+	return nil
+}
+
+// Clone returns a copy of the sketch.
+func (s *Sketch) Clone() *Sketch {
+	clone := &Sketch{precision: s.precision}
+	if s.dense != nil {
+		clone.dense = append([]uint8(nil), s.dense...)
+		return clone
+	}
+	clone.sparse = make(map[uint16]uint8, len(s.sparse))
+	for idx, rho := range s.sparse {
+		clone.sparse[idx] = rho
+	}
+	return clone
+}
+
+// MarshalBinary encodes the sketch.
+func (s *Sketch) MarshalBinary() ([]byte, error) {
+	if s.dense != nil {
+		buf := make([]byte, headerLen+len(s.dense))
+		buf[0], buf[1], buf[2] = encodingVersion, s.precision, kindDense
+		copy(buf[headerLen:], s.dense)
+		return buf, nil
+	}
+
+	indexes := make([]int, 0, len(s.sparse))
+	for idx := range s.sparse {
+		indexes = append(indexes, int(idx))
+	}
+	sort.Ints(indexes)
+
+	buf := make([]byte, headerLen+len(indexes)*sparseEntryLen)
+	buf[0], buf[1], buf[2] = encodingVersion, s.precision, kindSparse
+	for i, idx := range indexes {
+		entry := buf[headerLen+i*sparseEntryLen:]
+		binary.BigEndian.PutUint16(entry, uint16(idx))
+		entry[2] = s.sparse[uint16(idx)]
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a sketch encoded with MarshalBinary.
+func (s *Sketch) UnmarshalBinary(data []byte) error {
+	if len(data) < headerLen {
+		return errors.New("hll: encoded sketch too short")
+	}
+	if data[0] != encodingVersion {
+		return fmt.Errorf("hll: unknown encoding version %d", data[0])
+	}
+	precision := int(data[1])
+	if precision < MinPrecision || precision > MaxPrecision {
+		return fmt.Errorf("hll: invalid encoded precision %d", precision)
+	}
+
+	var (
+		payload   = data[headerLen:]
+		registers = 1 << uint(precision)
+		maxRho    = uint8(64-precision) + 1
+	)
+	switch data[2] {
+	case kindDense:
+		if len(payload) != registers {
+			return fmt.Errorf("hll: invalid dense sketch length %d", len(payload))
+		}
+		for _, rho := range payload {
+			if rho > maxRho {
+				return fmt.Errorf("hll: invalid register value %d", rho)
+			}
+		}
+		s.precision = uint8(precision)
+		s.sparse = nil
+		s.dense = append([]uint8(nil), payload...)
+	case kindSparse:
+		if len(payload)%sparseEntryLen != 0 {
+			return fmt.Errorf("hll: invalid sparse sketch length %d", len(payload))
+		}
+		sparse := make(map[uint16]uint8, len(payload)/sparseEntryLen)
+		for i := 0; i < len(payload); i += sparseEntryLen {
+			idx := binary.BigEndian.Uint16(payload[i:])
+			rho := payload[i+2]
+			if int(idx) >= registers || rho > maxRho {
+				return fmt.Errorf("hll: invalid sparse entry %d=%d", idx, rho)
+			}
+			sparse[idx] = rho
+		}
+		s.precision = uint8(precision)
+		s.dense = nil
+		s.sparse = sparse
+	default:
+		return fmt.Errorf("hll: unknown encoding kind %d", data[2])
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package hll
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func hash(i uint64) uint64 {
+	// splitmix64 finalizer to produce well distributed hashes.
+	i += 0x9e3779b97f4a7c15
+	i = (i ^ (i >> 30)) * 0xbf58476d1ce4e5b9
+	i = (i ^ (i >> 27)) * 0x94d049bb133111eb
+	return i ^ (i >> 31)
+}
+
+func requireWithinError(t *testing.T, expected, actual uint64, relErr float64) {
+	diff := math.Abs(float64(actual) - float64(expected))
+	require.True(t, diff <= relErr*float64(expected),
+		"expected %d within %.2f%%, actual %d", expected, relErr*100, actual)
+}
+
+func TestNewInvalidPrecision(t *testing.T) {
+	_, err := New(MinPrecision - 1)
+	require.Error(t, err)
+	_, err = New(MaxPrecision + 1)
+	require.Error(t, err)
+}
+
+func TestSketchEstimate(t *testing.T) {
+	for _, n := range []uint64{0, 1, 10, 100, 1000, 10000, 100000, 1000000} {
+		s := NewDefault()
+		for i := uint64(0); i < n; i++ {
+			s.Add(hash(i))
+			// Duplicates must not affect the estimate.
+			s.Add(hash(i))
+		}
+		if n == 0 {
+			require.Equal(t, uint64(0), s.Estimate())
+			continue
+		}
+		requireWithinError(t, n, s.Estimate(), 0.05)
+	}
+}
+
+func TestSketchSparseToDense(t *testing.T) {
+	s := NewDefault()
+	s.Add(hash(1))
+	require.NotNil(t, s.sparse)
+	require.Nil(t, s.dense)
+
+	for i := uint64(0); i < 10000; i++ {
+		s.Add(hash(i))
+	}
+	require.Nil(t, s.sparse)
+	require.NotNil(t, s.dense)
+}
+
+func TestSketchMerge(t *testing.T) {
+	var (
+		a     = NewDefault()
+		b     = NewDefault()
+		small = NewDefault()
+	)
+	for i := uint64(0); i < 50000; i++ {
+		a.Add(hash(i))
+	}
+	for i := uint64(25000); i < 75000; i++ {
+		b.Add(hash(i))
+	}
+	for i := uint64(100000); i < 100010; i++ {
+		small.Add(hash(i))
+	}
+
+	merged := a.Clone()
+	require.NoError(t, merged.Merge(b))
+	require.NoError(t, merged.Merge(small))
+	requireWithinError(t, 75010, merged.Estimate(), 0.05)
+
+	// Merging a dense sketch into a sparse sketch converts it to dense.
+	sparse := small.Clone()
+	require.NoError(t, sparse.Merge(a))
+	requireWithinError(t, 50010, sparse.Estimate(), 0.05)
+
+	// Sources are not modified by merges.
+	requireWithinError(t, 50000, a.Estimate(), 0.05)
+	requireWithinError(t, 10, small.Estimate(), 0.05)
+
+	other, err := New(DefaultPrecision + 1)
+	require.NoError(t, err)
+	require.Error(t, a.Merge(other))
+}
+
+func TestSketchMarshalRoundTrip(t *testing.T) {
+	for _, n := range []uint64{0, 10, 100000} {
+		s := NewDefault()
+		for i := uint64(0); i < n; i++ {
+			s.Add(hash(i))
+		}
+		data, err := s.MarshalBinary()
+		require.NoError(t, err)
+
+		var decoded Sketch
+		require.NoError(t, decoded.UnmarshalBinary(data))
+		require.Equal(t, s.Precision(), decoded.Precision())
+		require.Equal(t, s.Estimate(), decoded.Estimate())
+
+		reencoded, err := decoded.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, data, reencoded)
+	}
+}
+
+func TestSketchUnmarshalInvalid(t *testing.T) {
+	var s Sketch
+	for _, data := range [][]byte{
+		nil,
+		{encodingVersion + 1, DefaultPrecision, kindSparse},
+		{encodingVersion, MaxPrecision + 1, kindSparse},
+		{encodingVersion, DefaultPrecision, kindDense + 1},
+		{encodingVersion, DefaultPrecision, kindDense, 1, 2},
+		{encodingVersion, DefaultPrecision, kindSparse, 0, 1},
+		{encodingVersion, MinPrecision, kindSparse, 0, 16, 1},
+	} {
+		require.Error(t, s.UnmarshalBinary(data))
+	}
+}